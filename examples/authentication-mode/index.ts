@@ -16,17 +16,20 @@ const vpc = new awsx.ec2.Vpc(`${projectName}-vpc`, {
     }
 });
 
+// The role can also be assumed by the account, so the tests can validate its permissions in the clusters.
+const accountId = pulumi.output(aws.getCallerIdentity({})).accountId;
 const iamRole = new aws.iam.Role(`${projectName}-role`, {
-    assumeRolePolicy: JSON.stringify({
+    assumeRolePolicy: accountId.apply(id => JSON.stringify({
         Version: "2012-10-17",
         Statement: [{
             Action: "sts:AssumeRole",
             Effect: "Allow",
             Principal: {
                 Service: "ec2.amazonaws.com",
+                AWS: `arn:aws:iam::${id}:root`,
             },
         }],
-    })
+    })),
 });
 
 const clusterConfigMap = new eks.Cluster(`${projectName}-cluster-configmap`, {
@@ -106,5 +109,10 @@ export const kubeconfigConfigMap = clusterConfigMap.kubeconfig;
 export const kubeconfigBoth = clusterBoth.kubeconfig;
 export const kubeconfigApi = clusterApi.kubeconfig;
 
+// Export the clusters' kubeconfig for the IAM role.
+export const roleKubeconfigConfigMap = clusterConfigMap.getKubeconfig({ roleArn: iamRole.arn }).result;
+export const roleKubeconfigBoth = clusterBoth.getKubeconfig({ roleArn: iamRole.arn }).result;
+export const roleKubeconfigApi = clusterApi.getKubeconfig({ roleArn: iamRole.arn }).result;
+
 // export the IAM Role ARN
 export const iamRoleArn = iamRole.arn;
@@ -49,6 +49,7 @@ const configMap = new k8s.core.v1.ConfigMap(appName, {
 
 const deployment = new k8s.apps.v1.Deployment(appName, {
     metadata: {
+        name: appName,
         namespace: ns.metadata.name
     },
     spec: {
@@ -12,8 +12,10 @@ const cluster = new eks.Cluster(`${projectName}`, {
     nodeAmiId: "ami-066e69f6f03b5383e",
 });
 
-// Export the cluster's kubeconfig.
+// Export the cluster's kubeconfig, name and OIDC provider.
 export const kubeconfig = cluster.kubeconfig;
+export const clusterName = cluster.eksCluster.name;
+export const oidcProviderArn = cluster.oidcProviderArn;
 
 // Export the cluster OIDC provider URL.
 if (!cluster?.core?.oidcProvider) {
@@ -30,14 +32,19 @@ const appsNamespace = new k8s.core.v1.Namespace("apps", undefined, {provider: pr
 export const appsNamespaceName = appsNamespace.metadata.name;
 
 // Create the new IAM policy for the Service Account using the
-// AssumeRoleWebWebIdentity action.
+// AssumeRoleWebWebIdentity action. The "identity" Service Account is created by
+// the tests to validate that pods receive the credentials of the role.
 const saName = "s3";
+const identitySaName = "identity";
 const saAssumeRolePolicy = aws.iam.getPolicyDocumentOutput({
     statements: [{
         actions: ["sts:AssumeRoleWithWebIdentity"],
         conditions: [{
             test: "StringEquals",
-            values: [pulumi.interpolate`system:serviceaccount:${appsNamespaceName}:${saName}`],
+            values: [
+                pulumi.interpolate`system:serviceaccount:${appsNamespaceName}:${saName}`,
+                pulumi.interpolate`system:serviceaccount:${appsNamespaceName}:${identitySaName}`,
+            ],
             variable: pulumi.interpolate`${cluster.oidcIssuer}:sub`,
         }],
         effect: "Allow",
@@ -51,6 +58,7 @@ const saAssumeRolePolicy = aws.iam.getPolicyDocumentOutput({
 const saRole = new aws.iam.Role(saName, {
     assumeRolePolicy: saAssumeRolePolicy.json,
 });
+export const saRoleArn = saRole.arn;
 
 // Attach the S3 read only access policy.
 const saS3Rpa = new aws.iam.RolePolicyAttachment(saName, {
@@ -1,3 +1,4 @@
+import * as aws from "@pulumi/aws";
 import * as eks from "@pulumi/eks";
 import * as pulumi from "@pulumi/pulumi";
 
@@ -31,6 +32,8 @@ const cluster2 = new eks.Cluster(`${projectName}-2`, {
             type: "gp2",
             default: true,
             encrypted: true,
+            allowVolumeExpansion: true,
+            volumeBindingMode: "WaitForFirstConsumer",
         },
         "mysc1": {
             type: "sc1",
@@ -59,4 +62,16 @@ if (cluster2.core.storageClasses) {
         });
     }
 }
+
+// The storage classes of the cluster use the in-tree EBS provisioner, which is migrated to the EBS CSI driver.
+const ebsCsiDriverPolicy = new aws.iam.RolePolicyAttachment(`${projectName}-2-ebs-csi-driver`, {
+    policyArn: "arn:aws:iam::aws:policy/service-role/AmazonEBSCSIDriverPolicy",
+    role: cluster2.instanceRoles.apply(roles => roles[0].name),
+});
+
+new eks.Addon(`${projectName}-2-ebs-csi-driver`, {
+    cluster: cluster2,
+    addonName: "aws-ebs-csi-driver",
+}, { dependsOn: [ebsCsiDriverPolicy] });
+
 export const kubeconfig2 = cluster2.kubeconfig;
@@ -0,0 +1,236 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import * as pulumi from "@pulumi/pulumi";
+import * as aws from "@pulumi/aws";
+import * as k8s from "@pulumi/kubernetes";
+import { Cluster } from "../cluster";
+import { getRegionFromArn } from "../utilities";
+import { createControllerRole, mergeValues, serviceAccountAnnotations } from "./controller";
+
+/**
+ * The version of the cluster-autoscaler Helm chart that is installed if `chartVersion` is not set.
+ */
+export const defaultClusterAutoscalerChartVersion = "9.46.6";
+
+const chartName = "cluster-autoscaler";
+const chartRepository = "https://kubernetes.github.io/autoscaler";
+
+export interface ClusterAutoscalerOptions {
+    /**
+     * The target EKS cluster.
+     */
+    cluster: Cluster;
+
+    /**
+     * The namespace to install the autoscaler into. Defaults to `kube-system`.
+     */
+    namespace?: pulumi.Input<string>;
+
+    /**
+     * The name of the Kubernetes service account of the autoscaler. Defaults to `cluster-autoscaler`.
+     */
+    serviceAccountName?: pulumi.Input<string>;
+
+    /**
+     * The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+     *
+     * If set, the autoscaler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the
+     * IAM role is associated with the service account with EKS Pod Identity, which requires the
+     * `eks-pod-identity-agent` add-on to be installed on the cluster.
+     */
+    oidcProviderArn?: pulumi.Input<string>;
+
+    /**
+     * The version of the cluster-autoscaler Helm chart. Defaults to `9.46.6`.
+     */
+    chartVersion?: pulumi.Input<string>;
+
+    /**
+     * Custom values for the Helm chart. The values are merged with the values set by the component, which are
+     * `autoDiscovery`, `awsRegion` and `rbac.serviceAccount`.
+     *
+     * The minor version of the autoscaler should match the Kubernetes version of the cluster, it can be set with
+     * `image.tag`.
+     */
+    values?: pulumi.Input<{ [key: string]: any }>;
+}
+
+/**
+ * ClusterAutoscaler installs the Kubernetes Cluster Autoscaler into an EKS cluster. It creates the IAM role of the
+ * autoscaler and installs the cluster-autoscaler Helm chart, which discovers the Auto Scaling Groups tagged for the
+ * cluster. Node groups are tagged for discovery with their `autoscalerDiscovery` option.
+ * For more information see: https://github.com/kubernetes/autoscaler/blob/master/cluster-autoscaler/cloudprovider/aws/README.md
+ */
+export class ClusterAutoscaler extends pulumi.ComponentResource {
+    /**
+     * The IAM policy with the permissions of the autoscaler.
+     */
+    public readonly policy!: pulumi.Output<aws.iam.Policy>;
+
+    /**
+     * The IAM role of the autoscaler.
+     */
+    public readonly role!: pulumi.Output<aws.iam.Role>;
+
+    /**
+     * The Helm release of the autoscaler.
+     */
+    public readonly release!: pulumi.Output<k8s.helm.v3.Release>;
+
+    constructor(
+        name: string,
+        args?: ClusterAutoscalerOptions,
+        opts?: pulumi.ComponentResourceOptions,
+    ) {
+        const type = "eks:index:ClusterAutoscaler";
+
+        if (opts?.urn) {
+            const props = {
+                policy: undefined,
+                role: undefined,
+                release: undefined,
+            };
+            super(type, name, props, opts);
+            return;
+        }
+
+        super(type, name, args, opts);
+
+        if (!args?.cluster) {
+            throw new pulumi.InputPropertyError({
+                propertyPath: "cluster",
+                reason: "the cluster is required",
+            });
+        }
+
+        const cluster = args.cluster;
+        const namespace = args.namespace ?? "kube-system";
+        const serviceAccountName = args.serviceAccountName ?? chartName;
+
+        const { policy, role, dependencies } = createControllerRole(
+            name,
+            {
+                clusterName: cluster.eksCluster.name,
+                namespace,
+                serviceAccountName,
+                oidcProviderArn: args.oidcProviderArn,
+                description: "Permissions of the Kubernetes Cluster Autoscaler",
+                policy: cluster.eksCluster.name.apply(clusterAutoscalerPolicy),
+            },
+            this,
+        );
+
+        const values = pulumi
+            .all([
+                args.values,
+                cluster.eksCluster.name,
+                cluster.eksCluster.arn,
+                serviceAccountName,
+                role.arn,
+            ])
+            .apply(([values, clusterName, clusterArn, sa, roleArn]) =>
+                mergeValues(
+                    {
+                        autoDiscovery: {
+                            clusterName,
+                        },
+                        awsRegion: getRegionFromArn(clusterArn),
+                        rbac: {
+                            serviceAccount: {
+                                create: true,
+                                name: sa,
+                                annotations: serviceAccountAnnotations(args, roleArn),
+                            },
+                        },
+                    },
+                    values,
+                ),
+            );
+
+        const k8sProvider = new k8s.Provider(
+            `${name}-provider`,
+            { kubeconfig: cluster.kubeconfigJson },
+            { parent: this },
+        );
+
+        const release = new k8s.helm.v3.Release(
+            `${name}-release`,
+            {
+                name: chartName,
+                chart: chartName,
+                version: args.chartVersion ?? defaultClusterAutoscalerChartVersion,
+                namespace,
+                repositoryOpts: {
+                    repo: chartRepository,
+                },
+                values,
+            },
+            { parent: this, provider: k8sProvider, dependsOn: dependencies },
+        );
+
+        this.policy = pulumi.output(policy);
+        this.role = pulumi.output(role);
+        this.release = pulumi.output(release);
+        this.registerOutputs({
+            policy: this.policy,
+            role: this.role,
+            release: this.release,
+        });
+    }
+}
+
+/**
+ * Returns the IAM policy of the Cluster Autoscaler. The autoscaler may only scale the Auto Scaling Groups that are
+ * tagged as owned by the cluster.
+ * See https://github.com/kubernetes/autoscaler/blob/master/cluster-autoscaler/cloudprovider/aws/README.md#iam-policy
+ *
+ * @internal
+ */
+export function clusterAutoscalerPolicy(clusterName: string): aws.iam.PolicyDocument {
+    return {
+        Version: "2012-10-17",
+        Statement: [
+            {
+                Effect: "Allow",
+                Action: [
+                    "autoscaling:SetDesiredCapacity",
+                    "autoscaling:TerminateInstanceInAutoScalingGroup",
+                ],
+                Resource: "*",
+                Condition: {
+                    StringEquals: {
+                        [`aws:ResourceTag/k8s.io/cluster-autoscaler/${clusterName}`]: "owned",
+                    },
+                },
+            },
+            {
+                Effect: "Allow",
+                Action: [
+                    "autoscaling:DescribeAutoScalingGroups",
+                    "autoscaling:DescribeAutoScalingInstances",
+                    "autoscaling:DescribeLaunchConfigurations",
+                    "autoscaling:DescribeScalingActivities",
+                    "autoscaling:DescribeTags",
+                    "ec2:DescribeImages",
+                    "ec2:DescribeInstanceTypes",
+                    "ec2:DescribeLaunchTemplateVersions",
+                    "ec2:GetInstanceTypesFromInstanceRequirements",
+                    "eks:DescribeNodegroup",
+                ],
+                Resource: "*",
+            },
+        ],
+    };
+}
@@ -13,7 +13,13 @@
 // limitations under the License.
 
 import * as pulumi from "@pulumi/pulumi";
-import { CniEnvVariables, ComputedEnv, computeEnv, mergeConfigurationValues } from "./cni-addon";
+import {
+    CniEnvVariables,
+    ComputedEnv,
+    computeEnv,
+    mergeConfigurationValues,
+    withCustomNetworking,
+} from "./cni-addon";
 
 describe("computeEnv", () => {
     it("should set default values when no arguments are provided", async () => {
@@ -69,6 +75,39 @@ describe("computeEnv", () => {
     });
 });
 
+describe("withCustomNetworking", () => {
+    it("should not change the options without podSubnets", () => {
+        const args = { clusterName: "cluster", eniMtu: 1500 };
+
+        expect(withCustomNetworking(args)).toBe(args);
+        expect(withCustomNetworking({ ...args, podSubnets: {} })).toEqual({
+            ...args,
+            podSubnets: {},
+        });
+    });
+
+    it("should enable custom networking with the zone label for podSubnets", () => {
+        const result = withCustomNetworking({
+            clusterName: "cluster",
+            podSubnets: { "us-west-2a": "subnet-1", "us-west-2b": "subnet-2" },
+        });
+
+        expect(result.customNetworkConfig).toBe(true);
+        expect(result.eniConfigLabelDef).toEqual("topology.kubernetes.io/zone");
+    });
+
+    it("should keep a custom eniConfigLabelDef", () => {
+        const result = withCustomNetworking({
+            clusterName: "cluster",
+            podSubnets: { "us-west-2a": "subnet-1" },
+            eniConfigLabelDef: "example.com/eni-config",
+        });
+
+        expect(result.customNetworkConfig).toBe(true);
+        expect(result.eniConfigLabelDef).toEqual("example.com/eni-config");
+    });
+});
+
 describe("mergeConfigurationValues", () => {
     it("should merge configuration values correctly", () => {
         const env = { VAR1: "value1", VAR2: "value2" };
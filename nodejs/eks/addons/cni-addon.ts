@@ -81,8 +81,33 @@ export interface VpcCniAddonOptions extends CniEnvVariables {
      * See for more information: [Kubernetes Network Policies](https://kubernetes.io/docs/concepts/services-networking/network-policies/).
      */
     enableNetworkPolicy?: pulumi.Input<boolean>;
+
+    /**
+     * Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses
+     * from, e.g. subnets in a secondary CIDR block of the VPC.
+     *
+     * Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and
+     * `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig`
+     * of their availability zone. Only nodes launched after enabling custom networking use it.
+     *
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
+     */
+    podSubnets?: { [availabilityZone: string]: pulumi.Input<string> };
+
+    /**
+     * The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+     *
+     * Defaults to the node security group of the cluster, or the cluster security group created by EKS if the
+     * cluster has no node security group.
+     */
+    podSecurityGroupIds?: pulumi.Input<pulumi.Input<string>[]>;
 }
 
+/**
+ * The node label the VPC CNI uses to select the `ENIConfig` of a node when `podSubnets` are configured.
+ */
+const availabilityZoneLabel = "topology.kubernetes.io/zone";
+
 export interface CniEnvVariables {
     /**
      * Specifies whether NodePort services are enabled on a worker node's primary network interface. This requires
@@ -267,7 +292,7 @@ export class VpcCniAddon extends pulumi.ComponentResource {
             throw new pulumi.ResourceError("Missing required option 'clusterName'", this);
         }
 
-        const { env, initEnv } = computeEnv(args);
+        const { env, initEnv } = computeEnv(withCustomNetworking(args));
 
         let addonVersion: pulumi.Input<string>;
         if (args.addonVersion) {
@@ -317,9 +342,48 @@ export class VpcCniAddon extends pulumi.ComponentResource {
             this.createDaemonSetPatch(name, args, addon);
         }
 
+        if (args.podSubnets && Object.keys(args.podSubnets).length > 0) {
+            this.createEniConfigs(name, args, args.podSubnets, addon);
+        }
+
         this.registerOutputs({ addon: this.addon });
     }
 
+    // create an ENIConfig per availability zone for custom networking. The ENIConfigs are named after the
+    // availability zone, which allows selecting them with the zone label of the nodes.
+    private createEniConfigs(
+        name: string,
+        args: VpcCniAddonOptions,
+        podSubnets: { [availabilityZone: string]: pulumi.Input<string> },
+        addon: aws.eks.Addon,
+    ): k8s.apiextensions.CustomResource[] {
+        const securityGroupIds =
+            args.podSecurityGroupIds ??
+            aws.eks
+                .getClusterOutput({ name: args.clusterName }, { parent: this })
+                .vpcConfig.apply((vpcConfig) => [vpcConfig.clusterSecurityGroupId]);
+
+        return Object.entries(podSubnets).map(
+            ([availabilityZone, subnetId]) =>
+                new k8s.apiextensions.CustomResource(
+                    `${name}-eniconfig-${availabilityZone}`,
+                    {
+                        apiVersion: "crd.k8s.amazonaws.com/v1alpha1",
+                        kind: "ENIConfig",
+                        metadata: {
+                            name: availabilityZone,
+                        },
+                        spec: {
+                            subnet: subnetId,
+                            securityGroups: securityGroupIds,
+                        },
+                    },
+                    // The ENIConfig CRD is installed by the addon.
+                    { parent: this, dependsOn: [addon] },
+                ),
+        );
+    }
+
     // create a SSA patch to set options that are not configurable via the addon
     private createDaemonSetPatch(
         name: string,
@@ -369,6 +433,21 @@ export type ComputedEnv = {
     initEnv: Record<string, pulumi.Output<string>>;
 };
 
+/**
+ * withCustomNetworking enables custom networking if `podSubnets` are configured and makes the VPC CNI select the
+ * `ENIConfig` of a node by its availability zone, unless a different label is configured.
+ */
+export function withCustomNetworking(args: VpcCniAddonOptions): CniEnvVariables {
+    if (!args.podSubnets || Object.keys(args.podSubnets).length === 0) {
+        return args;
+    }
+    return {
+        ...args,
+        customNetworkConfig: true,
+        eniConfigLabelDef: args.eniConfigLabelDef ?? availabilityZoneLabel,
+    };
+}
+
 export function computeEnv(args: CniEnvVariables): ComputedEnv {
     const env: { name: string; value: pulumi.Output<string> }[] = [];
     const initEnv: { name: string; value: pulumi.Output<string> }[] = [];
@@ -0,0 +1,96 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import { irsaAssumeRolePolicy, mergeValues } from "./controller";
+
+describe("irsaAssumeRolePolicy", () => {
+    it("should scope the trust policy to the service account", () => {
+        const policy = irsaAssumeRolePolicy(
+            "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/EXAMPLE",
+            "kube-system",
+            "aws-load-balancer-controller",
+        );
+
+        expect(policy.Statement).toEqual([
+            {
+                Effect: "Allow",
+                Principal: {
+                    Federated:
+                        "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/EXAMPLE",
+                },
+                Action: "sts:AssumeRoleWithWebIdentity",
+                Condition: {
+                    StringEquals: {
+                        "oidc.eks.us-west-2.amazonaws.com/id/EXAMPLE:sub":
+                            "system:serviceaccount:kube-system:aws-load-balancer-controller",
+                        "oidc.eks.us-west-2.amazonaws.com/id/EXAMPLE:aud": "sts.amazonaws.com",
+                    },
+                },
+            },
+        ]);
+    });
+
+    it.each(["", "arn:aws:iam::123456789012:role/example", "oidc.eks.us-west-2.amazonaws.com"])(
+        "should reject '%s'",
+        (oidcProviderArn) => {
+            expect(() =>
+                irsaAssumeRolePolicy(
+                    oidcProviderArn,
+                    "kube-system",
+                    "aws-load-balancer-controller",
+                ),
+            ).toThrow("is not the ARN of an IAM OpenID Connect provider");
+        },
+    );
+});
+
+describe("mergeValues", () => {
+    it("should merge nested values", () => {
+        const merged = mergeValues(
+            {
+                clusterName: "example",
+                serviceAccount: { create: true, name: "aws-load-balancer-controller" },
+            },
+            {
+                replicaCount: 1,
+                serviceAccount: { annotations: { team: "platform" } },
+            },
+        );
+
+        expect(merged).toEqual({
+            clusterName: "example",
+            replicaCount: 1,
+            serviceAccount: {
+                create: true,
+                name: "aws-load-balancer-controller",
+                annotations: { team: "platform" },
+            },
+        });
+    });
+
+    it("should let custom values replace the values of the component", () => {
+        const merged = mergeValues(
+            { region: "us-west-2", serviceAccount: { create: true } },
+            { region: "us-east-1", serviceAccount: null },
+        );
+
+        expect(merged).toEqual({ region: "us-east-1", serviceAccount: null });
+    });
+
+    it("should return the values of the component without custom values", () => {
+        expect(mergeValues({ clusterName: "example" }, undefined)).toEqual({
+            clusterName: "example",
+        });
+    });
+});
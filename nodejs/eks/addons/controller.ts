@@ -0,0 +1,211 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import * as pulumi from "@pulumi/pulumi";
+import * as aws from "@pulumi/aws";
+import { isObject } from "../utilities";
+
+/**
+ * ControllerRoleArgs describes the IAM role of a controller that runs in the cluster.
+ *
+ * @internal
+ */
+export interface ControllerRoleArgs {
+    clusterName: pulumi.Input<string>;
+    namespace: pulumi.Input<string>;
+    serviceAccountName: pulumi.Input<string>;
+
+    /**
+     * The ARN of the IAM OpenID Connect provider of the cluster. If set, the role is assumed with IRSA, otherwise
+     * with EKS Pod Identity.
+     */
+    oidcProviderArn?: pulumi.Input<string>;
+
+    /**
+     * The description of the IAM policy.
+     */
+    description: string;
+
+    /**
+     * The IAM policy document with the permissions of the controller.
+     */
+    policy: pulumi.Input<aws.iam.PolicyDocument>;
+}
+
+/**
+ * ControllerRole contains the IAM resources of a controller.
+ *
+ * @internal
+ */
+export interface ControllerRole {
+    policy: aws.iam.Policy;
+    role: aws.iam.Role;
+
+    /**
+     * The resources that have to exist before the controller is installed, so it starts with its permissions.
+     */
+    dependencies: pulumi.Resource[];
+}
+
+/**
+ * Creates the IAM role of a controller and binds it to the service account of the controller, either with IAM roles
+ * for service accounts (IRSA) or with EKS Pod Identity.
+ *
+ * @internal
+ */
+export function createControllerRole(
+    name: string,
+    args: ControllerRoleArgs,
+    parent: pulumi.Resource,
+    provider?: pulumi.ProviderResource,
+): ControllerRole {
+    const useIrsa = args.oidcProviderArn !== undefined;
+
+    const policy = new aws.iam.Policy(
+        `${name}-policy`,
+        {
+            description: args.description,
+            policy: pulumi.output(args.policy).apply((policy) => JSON.stringify(policy)),
+        },
+        { parent, provider },
+    );
+
+    const role = new aws.iam.Role(
+        `${name}-role`,
+        {
+            assumeRolePolicy: useIrsa
+                ? pulumi
+                      .all([args.oidcProviderArn!, args.namespace, args.serviceAccountName])
+                      .apply(([oidcProviderArn, namespace, serviceAccountName]) =>
+                          JSON.stringify(
+                              irsaAssumeRolePolicy(oidcProviderArn, namespace, serviceAccountName),
+                          ),
+                      )
+                : JSON.stringify(podIdentityAssumeRolePolicy),
+        },
+        { parent, provider },
+    );
+
+    const dependencies: pulumi.Resource[] = [
+        new aws.iam.RolePolicyAttachment(
+            `${name}-policy-attachment`,
+            { role: role.name, policyArn: policy.arn },
+            { parent, provider },
+        ),
+    ];
+    if (!useIrsa) {
+        dependencies.push(
+            new aws.eks.PodIdentityAssociation(
+                `${name}-pod-identity`,
+                {
+                    clusterName: args.clusterName,
+                    namespace: args.namespace,
+                    serviceAccount: args.serviceAccountName,
+                    roleArn: role.arn,
+                },
+                { parent, provider },
+            ),
+        );
+    }
+
+    return { policy, role, dependencies };
+}
+
+/**
+ * Returns the annotations of the service account of a controller. Service accounts of controllers using IRSA are
+ * annotated with their role.
+ *
+ * @internal
+ */
+export function serviceAccountAnnotations(
+    args: Pick<ControllerRoleArgs, "oidcProviderArn">,
+    roleArn: string,
+): { [key: string]: string } {
+    return args.oidcProviderArn !== undefined ? { "eks.amazonaws.com/role-arn": roleArn } : {};
+}
+
+/**
+ * The trust policy that allows EKS Pod Identity to assume the role of a controller.
+ *
+ * @internal
+ */
+export const podIdentityAssumeRolePolicy: aws.iam.PolicyDocument = {
+    Version: "2012-10-17",
+    Statement: [
+        {
+            Effect: "Allow",
+            Principal: {
+                Service: "pods.eks.amazonaws.com",
+            },
+            Action: ["sts:AssumeRole", "sts:TagSession"],
+        },
+    ],
+};
+
+/**
+ * Returns the trust policy that allows the service account of a controller to assume the role with IRSA.
+ *
+ * @internal
+ */
+export function irsaAssumeRolePolicy(
+    oidcProviderArn: string,
+    namespace: string,
+    serviceAccountName: string,
+): aws.iam.PolicyDocument {
+    // The issuer is the part of the ARN after the resource type, e.g. `oidc.eks.us-west-2.amazonaws.com/id/EXAMPLE`.
+    const [, issuer] = oidcProviderArn.split(":oidc-provider/");
+    if (!oidcProviderArn.startsWith("arn:") || !issuer) {
+        throw new pulumi.InputPropertyError({
+            propertyPath: "oidcProviderArn",
+            reason: `'${oidcProviderArn}' is not the ARN of an IAM OpenID Connect provider`,
+        });
+    }
+
+    return {
+        Version: "2012-10-17",
+        Statement: [
+            {
+                Effect: "Allow",
+                Principal: {
+                    Federated: oidcProviderArn,
+                },
+                Action: "sts:AssumeRoleWithWebIdentity",
+                Condition: {
+                    StringEquals: {
+                        [`${issuer}:sub`]: `system:serviceaccount:${namespace}:${serviceAccountName}`,
+                        [`${issuer}:aud`]: "sts.amazonaws.com",
+                    },
+                },
+            },
+        ],
+    };
+}
+
+/**
+ * Merges the custom Helm values into the values set by a component. Nested objects are merged recursively, all
+ * other custom values replace the values of the component.
+ *
+ * @internal
+ */
+export function mergeValues(
+    defaults: Record<string, any>,
+    values: Record<string, any> | undefined,
+): Record<string, any> {
+    const merged: Record<string, any> = { ...defaults };
+    for (const [key, value] of Object.entries(values ?? {})) {
+        merged[key] =
+            isObject(merged[key]) && isObject(value) ? mergeValues(merged[key], value) : value;
+    }
+    return merged;
+}
@@ -15,3 +15,13 @@
 export { Addon } from "./addon";
 export { VpcCniAddon, VpcCniAddonOptions } from "./cni-addon";
 export { stringifyAddonConfiguration } from "./addon";
+export {
+    ClusterAutoscaler,
+    ClusterAutoscalerOptions,
+    defaultClusterAutoscalerChartVersion,
+} from "./cluster-autoscaler";
+export {
+    LoadBalancerController,
+    LoadBalancerControllerOptions,
+    defaultLoadBalancerControllerChartVersion,
+} from "./load-balancer-controller";
@@ -0,0 +1,256 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import * as aws from "@pulumi/aws";
+
+const clusterTag = "elbv2.k8s.aws/cluster";
+
+/**
+ * Returns the IAM policy of the AWS Load Balancer Controller for the given partition.
+ * The policy follows the upstream policy at https://github.com/kubernetes-sigs/aws-load-balancer-controller/blob/main/docs/install/iam_policy.json
+ *
+ * @internal
+ */
+export function loadBalancerControllerPolicy(partition: string): aws.iam.PolicyDocument {
+    const arn = (resource: string) => `arn:${partition}:${resource}`;
+    const targetGroupsAndLoadBalancers = [
+        arn("elasticloadbalancing:*:*:targetgroup/*/*"),
+        arn("elasticloadbalancing:*:*:loadbalancer/net/*/*"),
+        arn("elasticloadbalancing:*:*:loadbalancer/app/*/*"),
+    ];
+
+    return {
+        Version: "2012-10-17",
+        Statement: [
+            {
+                Effect: "Allow",
+                Action: ["iam:CreateServiceLinkedRole"],
+                Resource: "*",
+                Condition: {
+                    StringEquals: {
+                        "iam:AWSServiceName": "elasticloadbalancing.amazonaws.com",
+                    },
+                },
+            },
+            {
+                Effect: "Allow",
+                Action: [
+                    "ec2:DescribeAccountAttributes",
+                    "ec2:DescribeAddresses",
+                    "ec2:DescribeAvailabilityZones",
+                    "ec2:DescribeInternetGateways",
+                    "ec2:DescribeVpcs",
+                    "ec2:DescribeVpcPeeringConnections",
+                    "ec2:DescribeSubnets",
+                    "ec2:DescribeSecurityGroups",
+                    "ec2:DescribeInstances",
+                    "ec2:DescribeNetworkInterfaces",
+                    "ec2:DescribeTags",
+                    "ec2:GetCoipPoolUsage",
+                    "ec2:DescribeCoipPools",
+                    "ec2:GetSecurityGroupsForVpc",
+                    "ec2:DescribeIpamPools",
+                    "ec2:DescribeRouteTables",
+                    "elasticloadbalancing:DescribeLoadBalancers",
+                    "elasticloadbalancing:DescribeLoadBalancerAttributes",
+                    "elasticloadbalancing:DescribeListeners",
+                    "elasticloadbalancing:DescribeListenerCertificates",
+                    "elasticloadbalancing:DescribeSSLPolicies",
+                    "elasticloadbalancing:DescribeRules",
+                    "elasticloadbalancing:DescribeTargetGroups",
+                    "elasticloadbalancing:DescribeTargetGroupAttributes",
+                    "elasticloadbalancing:DescribeTargetHealth",
+                    "elasticloadbalancing:DescribeTags",
+                    "elasticloadbalancing:DescribeTrustStores",
+                    "elasticloadbalancing:DescribeListenerAttributes",
+                    "elasticloadbalancing:DescribeCapacityReservation",
+                ],
+                Resource: "*",
+            },
+            {
+                Effect: "Allow",
+                Action: [
+                    "cognito-idp:DescribeUserPoolClient",
+                    "acm:ListCertificates",
+                    "acm:DescribeCertificate",
+                    "iam:ListServerCertificates",
+                    "iam:GetServerCertificate",
+                    "waf-regional:GetWebACL",
+                    "waf-regional:GetWebACLForResource",
+                    "waf-regional:AssociateWebACL",
+                    "waf-regional:DisassociateWebACL",
+                    "wafv2:GetWebACL",
+                    "wafv2:GetWebACLForResource",
+                    "wafv2:AssociateWebACL",
+                    "wafv2:DisassociateWebACL",
+                    "shield:GetSubscriptionState",
+                    "shield:DescribeProtection",
+                    "shield:CreateProtection",
+                    "shield:DeleteProtection",
+                ],
+                Resource: "*",
+            },
+            {
+                Effect: "Allow",
+                Action: ["ec2:AuthorizeSecurityGroupIngress", "ec2:RevokeSecurityGroupIngress"],
+                Resource: "*",
+            },
+            {
+                Effect: "Allow",
+                Action: ["ec2:CreateSecurityGroup"],
+                Resource: "*",
+            },
+            {
+                Effect: "Allow",
+                Action: ["ec2:CreateTags"],
+                Resource: arn("ec2:*:*:security-group/*"),
+                Condition: {
+                    StringEquals: {
+                        "ec2:CreateAction": "CreateSecurityGroup",
+                    },
+                    Null: {
+                        [`aws:RequestTag/${clusterTag}`]: "false",
+                    },
+                },
+            },
+            {
+                Effect: "Allow",
+                Action: ["ec2:CreateTags", "ec2:DeleteTags"],
+                Resource: arn("ec2:*:*:security-group/*"),
+                Condition: {
+                    Null: {
+                        [`aws:RequestTag/${clusterTag}`]: "true",
+                        [`aws:ResourceTag/${clusterTag}`]: "false",
+                    },
+                },
+            },
+            {
+                Effect: "Allow",
+                Action: [
+                    "ec2:AuthorizeSecurityGroupIngress",
+                    "ec2:RevokeSecurityGroupIngress",
+                    "ec2:DeleteSecurityGroup",
+                ],
+                Resource: "*",
+                Condition: {
+                    Null: {
+                        [`aws:ResourceTag/${clusterTag}`]: "false",
+                    },
+                },
+            },
+            {
+                Effect: "Allow",
+                Action: [
+                    "elasticloadbalancing:CreateLoadBalancer",
+                    "elasticloadbalancing:CreateTargetGroup",
+                ],
+                Resource: "*",
+                Condition: {
+                    Null: {
+                        [`aws:RequestTag/${clusterTag}`]: "false",
+                    },
+                },
+            },
+            {
+                Effect: "Allow",
+                Action: [
+                    "elasticloadbalancing:CreateListener",
+                    "elasticloadbalancing:DeleteListener",
+                    "elasticloadbalancing:CreateRule",
+                    "elasticloadbalancing:DeleteRule",
+                ],
+                Resource: "*",
+            },
+            {
+                Effect: "Allow",
+                Action: ["elasticloadbalancing:AddTags", "elasticloadbalancing:RemoveTags"],
+                Resource: targetGroupsAndLoadBalancers,
+                Condition: {
+                    Null: {
+                        [`aws:RequestTag/${clusterTag}`]: "true",
+                        [`aws:ResourceTag/${clusterTag}`]: "false",
+                    },
+                },
+            },
+            {
+                Effect: "Allow",
+                Action: ["elasticloadbalancing:AddTags", "elasticloadbalancing:RemoveTags"],
+                Resource: [
+                    arn("elasticloadbalancing:*:*:listener/net/*/*/*"),
+                    arn("elasticloadbalancing:*:*:listener/app/*/*/*"),
+                    arn("elasticloadbalancing:*:*:listener-rule/net/*/*/*"),
+                    arn("elasticloadbalancing:*:*:listener-rule/app/*/*/*"),
+                ],
+            },
+            {
+                Effect: "Allow",
+                Action: [
+                    "elasticloadbalancing:ModifyLoadBalancerAttributes",
+                    "elasticloadbalancing:SetIpAddressType",
+                    "elasticloadbalancing:SetSecurityGroups",
+                    "elasticloadbalancing:SetSubnets",
+                    "elasticloadbalancing:DeleteLoadBalancer",
+                    "elasticloadbalancing:ModifyTargetGroup",
+                    "elasticloadbalancing:ModifyTargetGroupAttributes",
+                    "elasticloadbalancing:DeleteTargetGroup",
+                    "elasticloadbalancing:ModifyListenerAttributes",
+                    "elasticloadbalancing:ModifyCapacityReservation",
+                    "elasticloadbalancing:ModifyIpPools",
+                ],
+                Resource: "*",
+                Condition: {
+                    Null: {
+                        [`aws:ResourceTag/${clusterTag}`]: "false",
+                    },
+                },
+            },
+            {
+                Effect: "Allow",
+                Action: ["elasticloadbalancing:AddTags"],
+                Resource: targetGroupsAndLoadBalancers,
+                Condition: {
+                    StringEquals: {
+                        "elasticloadbalancing:CreateAction": [
+                            "CreateTargetGroup",
+                            "CreateLoadBalancer",
+                        ],
+                    },
+                    Null: {
+                        [`aws:RequestTag/${clusterTag}`]: "false",
+                    },
+                },
+            },
+            {
+                Effect: "Allow",
+                Action: [
+                    "elasticloadbalancing:RegisterTargets",
+                    "elasticloadbalancing:DeregisterTargets",
+                ],
+                Resource: arn("elasticloadbalancing:*:*:targetgroup/*/*"),
+            },
+            {
+                Effect: "Allow",
+                Action: [
+                    "elasticloadbalancing:SetWebAcl",
+                    "elasticloadbalancing:ModifyListener",
+                    "elasticloadbalancing:AddListenerCertificates",
+                    "elasticloadbalancing:RemoveListenerCertificates",
+                    "elasticloadbalancing:ModifyRule",
+                    "elasticloadbalancing:SetRulePriorities",
+                ],
+                Resource: "*",
+            },
+        ],
+    };
+}
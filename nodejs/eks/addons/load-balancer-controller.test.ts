@@ -0,0 +1,48 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import * as pulumi from "@pulumi/pulumi";
+import { knownSubnetIds } from "./load-balancer-controller";
+import { loadBalancerControllerPolicy } from "./load-balancer-controller-policy";
+
+describe("loadBalancerControllerPolicy", () => {
+    it("should use the partition in the resource ARNs", () => {
+        const policy = JSON.stringify(loadBalancerControllerPolicy("aws-cn"));
+
+        expect(policy).toContain("arn:aws-cn:ec2:*:*:security-group/*");
+        expect(policy).not.toContain("arn:aws:");
+    });
+});
+
+describe("knownSubnetIds", () => {
+    it("should return the subnet IDs", () => {
+        expect(knownSubnetIds(["subnet-a", "subnet-b"], "publicSubnetIds")).toEqual([
+            "subnet-a",
+            "subnet-b",
+        ]);
+    });
+
+    it("should not tag any subnets if unset", () => {
+        expect(knownSubnetIds(undefined, "publicSubnetIds")).toEqual([]);
+    });
+
+    test.each([
+        ["an output", pulumi.output(["subnet-a"])],
+        ["an output in the subnet IDs", ["subnet-a", pulumi.output("subnet-b")]],
+    ])("should reject %s", (_, subnetIds) => {
+        expect(() => knownSubnetIds(subnetIds, "privateSubnetIds")).toThrow(
+            "The subnet IDs must be known during preview.",
+        );
+    });
+});
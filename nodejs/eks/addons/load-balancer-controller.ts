@@ -0,0 +1,261 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import * as pulumi from "@pulumi/pulumi";
+import * as aws from "@pulumi/aws";
+import * as k8s from "@pulumi/kubernetes";
+import { Cluster } from "../cluster";
+import { getRegionFromArn } from "../utilities";
+import { createControllerRole, mergeValues, serviceAccountAnnotations } from "./controller";
+import { loadBalancerControllerPolicy } from "./load-balancer-controller-policy";
+
+/**
+ * The version of the aws-load-balancer-controller Helm chart that is installed if `chartVersion` is not set.
+ */
+export const defaultLoadBalancerControllerChartVersion = "1.13.0";
+
+const chartName = "aws-load-balancer-controller";
+const chartRepository = "https://aws.github.io/eks-charts";
+
+export interface LoadBalancerControllerOptions {
+    /**
+     * The target EKS cluster.
+     */
+    cluster: Cluster;
+
+    /**
+     * The namespace to install the controller into. Defaults to `kube-system`.
+     */
+    namespace?: pulumi.Input<string>;
+
+    /**
+     * The name of the Kubernetes service account of the controller. Defaults to `aws-load-balancer-controller`.
+     */
+    serviceAccountName?: pulumi.Input<string>;
+
+    /**
+     * The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+     *
+     * If set, the controller assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the
+     * IAM role is associated with the service account with EKS Pod Identity, which requires the
+     * `eks-pod-identity-agent` add-on to be installed on the cluster.
+     */
+    oidcProviderArn?: pulumi.Input<string>;
+
+    /**
+     * The version of the aws-load-balancer-controller Helm chart. Defaults to `1.13.0`.
+     */
+    chartVersion?: pulumi.Input<string>;
+
+    /**
+     * Custom values for the Helm chart. The values are merged with the values set by the component, which are
+     * `clusterName`, `vpcId`, `region` and `serviceAccount`.
+     */
+    values?: pulumi.Input<{ [key: string]: any }>;
+
+    /**
+     * The name of the Helm release. Defaults to `aws-load-balancer-controller`.
+     */
+    releaseName?: pulumi.Input<string>;
+
+    /**
+     * The public subnets to tag with `kubernetes.io/role/elb`, which makes the controller place internet-facing
+     * load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program
+     * have to be tagged where they are created instead. If unset, no subnets are tagged.
+     */
+    publicSubnetIds?: pulumi.Input<pulumi.Input<string>[]>;
+
+    /**
+     * The private subnets to tag with `kubernetes.io/role/internal-elb`, which makes the controller place internal
+     * load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program
+     * have to be tagged where they are created instead. If unset, no subnets are tagged.
+     */
+    privateSubnetIds?: pulumi.Input<pulumi.Input<string>[]>;
+}
+
+/**
+ * LoadBalancerController installs the AWS Load Balancer Controller into an EKS cluster. It creates the IAM role of the
+ * controller, installs the aws-load-balancer-controller Helm chart and tags the given subnets, so the controller
+ * can discover them.
+ * For more information see: https://docs.aws.amazon.com/eks/latest/userguide/aws-load-balancer-controller.html
+ */
+export class LoadBalancerController extends pulumi.ComponentResource {
+    /**
+     * The IAM policy with the permissions of the controller.
+     */
+    public readonly policy!: pulumi.Output<aws.iam.Policy>;
+
+    /**
+     * The IAM role of the controller.
+     */
+    public readonly role!: pulumi.Output<aws.iam.Role>;
+
+    /**
+     * The Helm release of the controller.
+     */
+    public readonly release!: pulumi.Output<k8s.helm.v3.Release>;
+
+    constructor(
+        name: string,
+        args?: LoadBalancerControllerOptions,
+        opts?: pulumi.ComponentResourceOptions,
+    ) {
+        const type = "eks:index:LoadBalancerController";
+
+        if (opts?.urn) {
+            const props = {
+                policy: undefined,
+                role: undefined,
+                release: undefined,
+            };
+            super(type, name, props, opts);
+            return;
+        }
+
+        super(type, name, args, opts);
+
+        if (!args?.cluster) {
+            throw new pulumi.InputPropertyError({
+                propertyPath: "cluster",
+                reason: "the cluster is required",
+            });
+        }
+
+        this.tagSubnets(
+            `${name}-public-subnet`,
+            knownSubnetIds(args.publicSubnetIds, "publicSubnetIds"),
+            "kubernetes.io/role/elb",
+        );
+        this.tagSubnets(
+            `${name}-private-subnet`,
+            knownSubnetIds(args.privateSubnetIds, "privateSubnetIds"),
+            "kubernetes.io/role/internal-elb",
+        );
+
+        const cluster = args.cluster;
+        const namespace = args.namespace ?? "kube-system";
+        const serviceAccountName = args.serviceAccountName ?? chartName;
+
+        const { policy, role, dependencies } = createControllerRole(
+            name,
+            {
+                clusterName: cluster.eksCluster.name,
+                namespace,
+                serviceAccountName,
+                oidcProviderArn: args.oidcProviderArn,
+                description: "Permissions of the AWS Load Balancer Controller",
+                policy: aws
+                    .getPartitionOutput({}, { parent: this })
+                    .partition.apply(loadBalancerControllerPolicy),
+            },
+            this,
+        );
+
+        const values = pulumi
+            .all([
+                args.values,
+                cluster.eksCluster.name,
+                cluster.eksCluster.arn,
+                cluster.core.vpcId,
+                serviceAccountName,
+                role.arn,
+            ])
+            .apply(([values, clusterName, clusterArn, vpcId, sa, roleArn]) =>
+                mergeValues(
+                    {
+                        clusterName,
+                        vpcId,
+                        region: getRegionFromArn(clusterArn),
+                        serviceAccount: {
+                            create: true,
+                            name: sa,
+                            annotations: serviceAccountAnnotations(args, roleArn),
+                        },
+                    },
+                    values,
+                ),
+            );
+
+        const k8sProvider = new k8s.Provider(
+            `${name}-provider`,
+            { kubeconfig: cluster.kubeconfigJson },
+            { parent: this },
+        );
+
+        const release = new k8s.helm.v3.Release(
+            `${name}-release`,
+            {
+                name: args.releaseName ?? chartName,
+                chart: chartName,
+                version: args.chartVersion ?? defaultLoadBalancerControllerChartVersion,
+                namespace,
+                repositoryOpts: {
+                    repo: chartRepository,
+                },
+                values,
+            },
+            { parent: this, provider: k8sProvider, dependsOn: dependencies },
+        );
+
+        this.policy = pulumi.output(policy);
+        this.role = pulumi.output(role);
+        this.release = pulumi.output(release);
+        this.registerOutputs({
+            policy: this.policy,
+            role: this.role,
+            release: this.release,
+        });
+    }
+
+    // tags the subnets with the given role tag. The subnets are tagged individually instead of through the subnets
+    // themselves, because the subnets are usually managed by a different program than the cluster.
+    // The tags are named by subnet ID, so reordering the subnets doesn't replace them.
+    private tagSubnets(name: string, subnetIds: string[], key: string): aws.ec2.Tag[] {
+        return subnetIds.map(
+            (subnetId) =>
+                new aws.ec2.Tag(
+                    `${name}-${subnetId}`,
+                    { resourceId: subnetId, key, value: "1" },
+                    { parent: this },
+                ),
+        );
+    }
+}
+
+/**
+ * Returns the subnet IDs to tag. The tags are named by subnet ID, so the IDs must be known while the program runs.
+ * Creating the tags once the IDs are resolved would hide them from previews.
+ *
+ * @internal
+ */
+export function knownSubnetIds(
+    subnetIds: pulumi.Input<pulumi.Input<string>[]> | undefined,
+    propertyPath: string,
+): string[] {
+    if (subnetIds === undefined) {
+        return [];
+    }
+    if (
+        !Array.isArray(subnetIds) ||
+        !subnetIds.every((id): id is string => typeof id === "string")
+    ) {
+        throw new pulumi.InputPropertyError({
+            propertyPath,
+            reason:
+                "The subnet IDs must be known during preview. Tag subnets that are created in the same program " +
+                "where they are created instead.",
+        });
+    }
+    return subnetIds;
+}
@@ -13,7 +13,18 @@
 // limitations under the License.
 
 import * as aws from "@pulumi/aws";
-import { ClusterOptions, createCore } from "./cluster";
+import * as pulumi from "@pulumi/pulumi";
+import {
+    Cluster,
+    ClusterOptions,
+    createCore,
+    encryptionKeyPolicy,
+    generateStaticKubeconfig,
+    getTokenExecConfig,
+    KubeconfigTokenTool,
+    parseExecCredential,
+    retrieveBearerToken,
+} from "./cluster";
 
 describe("createCore", () => {
     const name = "test-cluster";
@@ -61,4 +72,308 @@ describe("createCore", () => {
             "Access entries are required when using EKS Auto Mode. Use the authentication mode 'API' or 'API_AND_CONFIG_MAP'.",
         );
     });
+
+    it("should throw an error if both encryptionConfig and encryptionConfigKeyArn are set", () => {
+        const rawArgs = {
+            encryptionConfig: {},
+            encryptionConfigKeyArn: "arn:aws:kms:us-west-2:123456789012:key/1234",
+        } satisfies ClusterOptions;
+
+        expect(() => createCore(name, rawArgs, undefined as any)).toThrow(
+            "encryptionConfig and encryptionConfigKeyArn are mutually exclusive, and cannot both be set.",
+        );
+    });
+
+    it("should throw an error if the control plane log group is managed without a cluster name", () => {
+        const rawArgs = {
+            enabledClusterLogTypes: ["api", "audit"],
+            clusterLogRetentionDays: 30,
+        } satisfies ClusterOptions;
+
+        expect(() => createCore(name, rawArgs, undefined as any)).toThrow(
+            "clusterLogRetentionDays and clusterLogKmsKeyArn require the cluster name to be set, because the log group has to be created before the cluster.",
+        );
+    });
+});
+
+describe("createCore cluster configuration", () => {
+    const resourceInputs = new Map<string, any>();
+
+    beforeAll(() => {
+        pulumi.runtime.setMocks(
+            {
+                newResource: function (args: pulumi.runtime.MockResourceArgs): {
+                    id: string;
+                    state: any;
+                } {
+                    resourceInputs.set(args.type, args.inputs);
+                    if (args.type === "aws:eks/cluster:Cluster") {
+                        return {
+                            id: args.name + "_id",
+                            state: {
+                                ...args.inputs,
+                                arn: "arn:aws:eks:us-west-2:123456789012:cluster/test-cluster",
+                                endpoint: "https://test-cluster.eks.amazonaws.com",
+                                certificateAuthority: { data: "Y2VydGlmaWNhdGUtYXV0aG9yaXR5" },
+                            },
+                        };
+                    }
+                    return {
+                        id: args.name + "_id",
+                        state: args.inputs,
+                    };
+                },
+                call: function (args: pulumi.runtime.MockCallArgs): pulumi.runtime.MockCallResult {
+                    return args.inputs;
+                },
+            },
+            "project",
+            "stack",
+            false, // Sets the flag `dryRun`, which indicates if pulumi is running in preview mode.
+        );
+    });
+
+    beforeEach(() => {
+        resourceInputs.clear();
+    });
+
+    it("should pass zonalShiftConfig and controlPlaneScalingConfig to the cluster and the core data", async () => {
+        const core = createCore(
+            "test-cluster",
+            {
+                vpcId: "vpc-123",
+                subnetIds: ["subnet-123", "subnet-456"],
+                authenticationMode: "API",
+                skipDefaultNodeGroup: true,
+                skipDefaultSecurityGroups: true,
+                useDefaultVpcCni: true,
+                kubeProxyAddonOptions: { enabled: false },
+                zonalShiftConfig: { enabled: true },
+                controlPlaneScalingConfig: { tier: "tier-xl" },
+            },
+            undefined as any,
+        );
+
+        expect(await promisify(core.zonalShiftConfig)).toEqual({ enabled: true });
+        expect(await promisify(core.controlPlaneScalingConfig)).toEqual({ tier: "tier-xl" });
+
+        const clusterArgs = resourceInputs.get("aws:eks/cluster:Cluster");
+        expect(clusterArgs.zonalShiftConfig).toEqual({ enabled: true });
+        expect(clusterArgs.controlPlaneScalingConfig).toEqual({ tier: "tier-xl" });
+    });
+});
+
+describe("encryptionKeyPolicy", () => {
+    const rootArn = "arn:aws:iam::123456789012:root";
+    const clusterRoleArn = "arn:aws:iam::123456789012:role/cluster-role";
+
+    it("should grant the cluster role usage of the key", () => {
+        const policy = encryptionKeyPolicy(rootArn, clusterRoleArn, []);
+
+        expect(policy.Statement.map((s: any) => s.Sid)).toEqual([
+            "EnableIAMUserPermissions",
+            "AllowClusterRoleToUseTheKey",
+            "AllowClusterRoleToManageGrants",
+        ]);
+        expect(policy.Statement[0]).toMatchObject({ Principal: { AWS: rootArn }, Action: "kms:*" });
+        expect(policy.Statement[1]).toMatchObject({
+            Principal: { AWS: clusterRoleArn },
+            Action: expect.arrayContaining(["kms:Encrypt", "kms:Decrypt", "kms:DescribeKey"]),
+        });
+        expect(policy.Statement[2]).toMatchObject({
+            Principal: { AWS: clusterRoleArn },
+            Condition: { Bool: { "kms:GrantIsForAWSResource": "true" } },
+        });
+    });
+
+    it("should grant key administrators administrative access", () => {
+        const admins = ["arn:aws:iam::123456789012:role/admin"];
+        const policy = encryptionKeyPolicy(rootArn, clusterRoleArn, admins);
+
+        expect(policy.Statement[1]).toMatchObject({
+            Sid: "AllowKeyAdministration",
+            Principal: { AWS: admins },
+            Action: expect.arrayContaining(["kms:ScheduleKeyDeletion", "kms:Put*"]),
+        });
+        expect(policy.Statement[1].Action).not.toContain("kms:Decrypt");
+    });
+});
+
+describe("getTokenExecConfig", () => {
+    it("should use aws eks get-token by default", () => {
+        const exec = getTokenExecConfig("my-cluster", "us-west-2", true, {
+            roleArn: "arn:aws:iam::123456789012:role/admin",
+            profileName: "dev",
+            env: { GRANTED_ALIAS_CONFIGURED: "true" },
+        });
+
+        expect(exec.command).toBe("aws");
+        expect(exec.args).toEqual([
+            "--region",
+            "us-west-2",
+            "eks",
+            "get-token",
+            "--cluster-name",
+            "my-cluster",
+            "--output",
+            "json",
+            "--role",
+            "arn:aws:iam::123456789012:role/admin",
+            "--profile",
+            "dev",
+        ]);
+        expect(exec.env).toEqual([
+            {
+                name: "KUBERNETES_EXEC_INFO",
+                value: `{"apiVersion": "client.authentication.k8s.io/v1beta1"}`,
+            },
+            { name: "GRANTED_ALIAS_CONFIGURED", value: "true" },
+        ]);
+    });
+
+    it("should omit the profile if it should not be included", () => {
+        const exec = getTokenExecConfig("my-cluster", "us-west-2", false, { profileName: "dev" });
+
+        expect(exec.args).not.toContain("--profile");
+    });
+
+    it("should prefer the explicit region over the cluster region", () => {
+        const exec = getTokenExecConfig("my-cluster", "us-west-2", true, { region: "eu-central-1" });
+
+        expect(exec.args.slice(0, 2)).toEqual(["--region", "eu-central-1"]);
+    });
+
+    it("should support aws-iam-authenticator with session name and external ID", () => {
+        const exec = getTokenExecConfig("my-cluster", "us-west-2", true, {
+            tokenTool: KubeconfigTokenTool.AwsIamAuthenticator,
+            roleArn: "arn:aws:iam::123456789012:role/ci",
+            roleSessionName: "ci-pipeline",
+            externalId: "external-id",
+            profileName: "ci",
+        });
+
+        expect(exec.command).toBe("aws-iam-authenticator");
+        expect(exec.args).toEqual([
+            "token",
+            "--cluster-id",
+            "my-cluster",
+            "--region",
+            "us-west-2",
+            "--role",
+            "arn:aws:iam::123456789012:role/ci",
+            "--session-name",
+            "ci-pipeline",
+            "--external-id",
+            "external-id",
+        ]);
+        expect(exec.env).toContainEqual({ name: "AWS_PROFILE", value: "ci" });
+    });
+
+    it("should throw an error if an external ID is used with aws eks get-token", () => {
+        expect(() =>
+            getTokenExecConfig("my-cluster", "us-west-2", true, { externalId: "external-id" }),
+        ).toThrow("'externalId' is not supported by 'aws eks get-token'");
+    });
 });
+
+describe("parseExecCredential", () => {
+    it("should return the token and its expiry", () => {
+        const output = JSON.stringify({
+            kind: "ExecCredential",
+            apiVersion: "client.authentication.k8s.io/v1beta1",
+            spec: {},
+            status: {
+                expirationTimestamp: "2024-01-01T00:14:00Z",
+                token: "k8s-aws-v1.aHR0cHM6Ly9zdHMuYW1hem9uYXdzLmNvbQ",
+            },
+        });
+
+        expect(parseExecCredential(output)).toEqual({
+            token: "k8s-aws-v1.aHR0cHM6Ly9zdHMuYW1hem9uYXdzLmNvbQ",
+            expirationTimestamp: "2024-01-01T00:14:00Z",
+        });
+    });
+
+    it("should throw if the output has no token", () => {
+        const output = JSON.stringify({ kind: "ExecCredential", status: {} });
+
+        expect(() => parseExecCredential(output)).toThrow(
+            "The token tool did not return an ExecCredential with a token and expirationTimestamp.",
+        );
+    });
+
+    it("should throw if the output is not JSON", () => {
+        expect(() => parseExecCredential("Unable to locate credentials")).toThrow(
+            "Failed to parse the token of the token tool",
+        );
+    });
+});
+
+describe("retrieveBearerToken", () => {
+    it("should return the token printed by the token tool", async () => {
+        const credential = JSON.stringify({
+            kind: "ExecCredential",
+            status: { token: "k8s-aws-v1.token", expirationTimestamp: "2024-01-01T00:14:00Z" },
+        });
+
+        const token = await retrieveBearerToken({
+            command: process.execPath,
+            args: ["-e", "process.stdout.write(process.env.EXEC_CREDENTIAL)"],
+            env: [{ name: "EXEC_CREDENTIAL", value: credential }],
+        });
+
+        expect(token).toEqual({
+            token: "k8s-aws-v1.token",
+            expirationTimestamp: "2024-01-01T00:14:00Z",
+        });
+    });
+
+    it("should reject if the token tool fails", async () => {
+        await expect(
+            retrieveBearerToken({
+                command: process.execPath,
+                args: ["-e", "process.exit(1)"],
+                env: [],
+            }),
+        ).rejects.toThrow(`Failed to retrieve a token with '${process.execPath}'`);
+    });
+});
+
+describe("getStaticKubeconfig", () => {
+    it("should not run the token tool while the cluster is unknown", async () => {
+        const cluster = {
+            eksCluster: {
+                name: pulumi.output(pulumi.unknown),
+                arn: pulumi.output("arn:aws:eks:us-west-2:123456789012:cluster/test-cluster"),
+                endpoint: pulumi.output("https://test-cluster.eks.amazonaws.com"),
+                certificateAuthority: { data: pulumi.output("Y2VydA==") },
+            },
+        } as unknown as Cluster;
+
+        const staticKubeconfig = Cluster.prototype.getStaticKubeconfig.call(cluster, {});
+
+        // Outputs don't expose whether they are known, so the test awaits the underlying promise.
+        expect(await (staticKubeconfig.kubeconfig as any).isKnown).toBe(false);
+    });
+});
+
+describe("generateStaticKubeconfig", () => {
+    it("should use the bearer token instead of an exec plugin", () => {
+        const kubeconfig = generateStaticKubeconfig(
+            "https://example.eks.amazonaws.com",
+            "Y2VydA==",
+            "k8s-aws-v1.token",
+        );
+
+        expect(kubeconfig.clusters[0].cluster).toEqual({
+            server: "https://example.eks.amazonaws.com",
+            "certificate-authority-data": "Y2VydA==",
+        });
+        expect(kubeconfig.users).toEqual([{ name: "aws", user: { token: "k8s-aws-v1.token" } }]);
+    });
+});
+
+function promisify<T>(output: pulumi.Output<T> | undefined): Promise<T> {
+    expect(output).toBeDefined();
+    return new Promise((resolve) => output!.apply(resolve));
+}
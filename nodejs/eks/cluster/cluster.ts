@@ -16,11 +16,13 @@ import * as aws from "@pulumi/aws";
 import * as k8s from "@pulumi/kubernetes";
 import * as pulumi from "@pulumi/pulumi";
 
+import * as childProcess from "child_process";
 import * as http from "http";
 import * as https from "https";
 import * as HttpsProxyAgent from "https-proxy-agent";
 import * as process from "process";
 import * as url from "url";
+import { promisify } from "util";
 
 import {
     createAccessEntries,
@@ -43,6 +45,7 @@ import { createStorageClass, EBSVolumeType, StorageClass } from "./storageclass"
 import { InputTags, UserStorageClasses } from "../utils";
 import { stringifyAddonConfiguration, VpcCniAddon, VpcCniAddonOptions } from "../addons";
 import { getRegionFromArn } from "../utilities";
+import { VpcCniNetworking } from "../nodes/maxpods";
 
 /**
  * RoleMapping describes a mapping from an AWS IAM role to a Kubernetes user and groups.
@@ -127,8 +130,51 @@ export interface KubeconfigOptions {
      * setting.
      */
     profileName?: pulumi.Input<string>;
+    /**
+     * Session name to use when assuming `roleArn`.
+     *
+     * Only supported by the `aws-iam-authenticator` token tool.
+     */
+    roleSessionName?: pulumi.Input<string>;
+    /**
+     * External ID to pass when assuming `roleArn`. Required by roles whose trust policy
+     * has an `sts:ExternalId` condition.
+     *
+     * Only supported by the `aws-iam-authenticator` token tool.
+     */
+    externalId?: pulumi.Input<string>;
+    /**
+     * AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+     */
+    region?: pulumi.Input<string>;
+    /**
+     * Additional environment variables to set for the exec plugin that retrieves the token, e.g. to
+     * configure credential wrappers like granted or aws-vault.
+     */
+    env?: pulumi.Input<{ [key: string]: pulumi.Input<string> }>;
+    /**
+     * The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+     */
+    tokenTool?: pulumi.Input<KubeconfigTokenTool>;
 }
 
+/* eslint-disable-next-line */ // Generating the enum object for KubeconfigTokenTool like codegen does
+export const KubeconfigTokenTool = {
+    /**
+     * Use `aws eks get-token` of the AWS CLI to retrieve the token.
+     */
+    Aws: "aws",
+    /**
+     * Use `aws-iam-authenticator token` to retrieve the token.
+     */
+    AwsIamAuthenticator: "aws-iam-authenticator",
+} as const;
+
+/**
+ * The exec plugin used by a kubeconfig to retrieve a token for the cluster.
+ */
+export type KubeconfigTokenTool = (typeof KubeconfigTokenTool)[keyof typeof KubeconfigTokenTool]; // eslint-disable-line no-redeclare
+
 /**
  * CoreData defines the core set of data associated with an EKS cluster, including the network in which it runs.
  */
@@ -148,14 +194,21 @@ export interface CoreData {
     storageClasses?: UserStorageClasses;
     kubeconfig?: pulumi.Output<any>;
     vpcCni?: VpcCniAddon;
+    vpcCniNetworking?: pulumi.Output<VpcCniNetworking>;
     tags?: InputTags;
     nodeSecurityGroupTags?: InputTags;
     fargateProfile: pulumi.Output<aws.eks.FargateProfile | undefined>;
     oidcProvider?: aws.iam.OpenIdConnectProvider;
     encryptionConfig?: pulumi.Output<aws.types.output.eks.ClusterEncryptionConfig>;
+    clusterLogGroup?: aws.cloudwatch.LogGroup;
     clusterIamRole: pulumi.Output<aws.iam.Role>;
     accessEntries?: pulumi.Output<AccessEntry[]>;
     autoModeNodeRoleName: pulumi.Output<string>;
+    upgradePolicy?: pulumi.Output<aws.types.output.eks.ClusterUpgradePolicy>;
+    zonalShiftConfig?: pulumi.Output<aws.types.output.eks.ClusterZonalShiftConfig | undefined>;
+    controlPlaneScalingConfig?: pulumi.Output<
+        aws.types.output.eks.ClusterControlPlaneScalingConfig | undefined
+    >;
 }
 
 function createOrGetInstanceProfile(
@@ -187,37 +240,40 @@ function createOrGetInstanceProfile(
 }
 
 // ExecEnvVar sets the environment variables using an exec-based auth plugin.
-interface ExecEnvVar {
+/** @internal */
+export interface ExecEnvVar {
     /**
      * Name of the auth exec environment variable.
      */
-    name: pulumi.Input<string>;
+    name: string;
 
     /**
      * Value of the auth exec environment variable.
      */
-    value: pulumi.Input<string>;
+    value: string;
 }
 
 /** @internal */
-export function generateKubeconfig(
-    clusterName: pulumi.Input<string>,
-    clusterEndpoint: pulumi.Input<string>,
-    region: pulumi.Input<string>,
+export interface TokenExecConfig {
+    command: string;
+    args: string[];
+    env: ExecEnvVar[];
+}
+
+/**
+ * getTokenExecConfig returns the exec plugin configuration a kubeconfig uses to retrieve a token for the
+ * cluster with the given (resolved) kubeconfig options.
+ *
+ * @internal
+ */
+export function getTokenExecConfig(
+    clusterName: string,
+    region: string,
     includeProfile: boolean,
-    certData?: pulumi.Input<string>,
-    opts?: KubeconfigOptions,
-) {
-    let args = [
-        "--region",
-        region,
-        "eks",
-        "get-token",
-        "--cluster-name",
-        clusterName,
-        "--output",
-        "json",
-    ];
+    opts?: pulumi.Unwrap<KubeconfigOptions>,
+): TokenExecConfig {
+    const tokenTool = opts?.tokenTool ?? KubeconfigTokenTool.Aws;
+    const tokenRegion = opts?.region ?? region;
     const env: ExecEnvVar[] = [
         {
             name: "KUBERNETES_EXEC_INFO",
@@ -225,18 +281,84 @@ export function generateKubeconfig(
         },
     ];
 
-    if (opts?.roleArn) {
-        args = [...args, "--role", opts.roleArn];
+    let command: string;
+    let args: string[];
+    switch (tokenTool) {
+        case KubeconfigTokenTool.Aws:
+            for (const prop of ["roleSessionName", "externalId"] as const) {
+                if (opts?.[prop]) {
+                    throw new pulumi.InputPropertyError({
+                        propertyPath: prop,
+                        reason: `'${prop}' is not supported by 'aws eks get-token'. Set 'tokenTool' to '${KubeconfigTokenTool.AwsIamAuthenticator}' or use an AWS profile that assumes the role instead.`,
+                    });
+                }
+            }
+
+            command = "aws";
+            args = [
+                "--region",
+                tokenRegion,
+                "eks",
+                "get-token",
+                "--cluster-name",
+                clusterName,
+                "--output",
+                "json",
+            ];
+            if (opts?.roleArn) {
+                args = [...args, "--role", opts.roleArn];
+            }
+            if (includeProfile && opts?.profileName) {
+                // Use --profile instead of AWS_PROFILE because the latter can be
+                // overridden by ambient credentials:
+                // https://docs.aws.amazon.com/cli/latest/topic/config-vars.html#id1
+                args = [...args, "--profile", opts.profileName];
+            }
+            break;
+        case KubeconfigTokenTool.AwsIamAuthenticator:
+            command = "aws-iam-authenticator";
+            args = ["token", "--cluster-id", clusterName, "--region", tokenRegion];
+            if (opts?.roleArn) {
+                args = [...args, "--role", opts.roleArn];
+            }
+            if (opts?.roleSessionName) {
+                args = [...args, "--session-name", opts.roleSessionName];
+            }
+            if (opts?.externalId) {
+                args = [...args, "--external-id", opts.externalId];
+            }
+            if (includeProfile && opts?.profileName) {
+                // aws-iam-authenticator has no profile flag and relies on the AWS SDK environment instead.
+                env.push({ name: "AWS_PROFILE", value: opts.profileName });
+            }
+            break;
+        default:
+            throw new pulumi.InputPropertyError({
+                propertyPath: "tokenTool",
+                reason: `Unsupported token tool '${tokenTool}'. Allowed values are: ${Object.values(
+                    KubeconfigTokenTool,
+                ).join(", ")}.`,
+            });
     }
 
-    if (includeProfile && opts?.profileName) {
-        // Use --profile instead of AWS_PROFILE because the latter can be
-        // overridden by ambient credentials:
-        // https://docs.aws.amazon.com/cli/latest/topic/config-vars.html#id1
-        args = [...args, "--profile", opts.profileName];
+    for (const [name, value] of Object.entries(opts?.env ?? {})) {
+        env.push({ name, value });
     }
 
-    return pulumi.all([args, env]).apply(([tokenArgs, envvars]) => {
+    return { command, args, env };
+}
+
+/** @internal */
+export function generateKubeconfig(
+    clusterName: pulumi.Input<string>,
+    clusterEndpoint: pulumi.Input<string>,
+    region: pulumi.Input<string>,
+    includeProfile: boolean,
+    certData?: pulumi.Input<string>,
+    opts?: KubeconfigOptions,
+) {
+    return pulumi.all([clusterName, region, opts]).apply(([clusterName, region, opts]) => {
+        const exec = getTokenExecConfig(clusterName, region, includeProfile, opts);
         return {
             apiVersion: "v1",
             clusters: [
@@ -265,9 +387,9 @@ export function generateKubeconfig(
                     user: {
                         exec: {
                             apiVersion: "client.authentication.k8s.io/v1beta1",
-                            command: "aws",
-                            args: tokenArgs,
-                            env: envvars,
+                            command: exec.command,
+                            args: exec.args,
+                            env: exec.env,
                         },
                     },
                 },
@@ -276,6 +398,114 @@ export function generateKubeconfig(
     });
 }
 
+/**
+ * StaticKubeconfig is a kubeconfig that authenticates with a bearer token instead of an exec plugin.
+ */
+export interface StaticKubeconfig {
+    /**
+     * The stringified kubeconfig containing the bearer token for the cluster.
+     */
+    kubeconfig: pulumi.Output<string>;
+    /**
+     * The time at which the token expires, in RFC 3339 format.
+     */
+    expirationTimestamp: pulumi.Output<string>;
+}
+
+/** @internal */
+export interface BearerToken {
+    token: string;
+    expirationTimestamp: string;
+}
+
+/**
+ * parseExecCredential extracts the bearer token from the `ExecCredential` printed by a token tool.
+ *
+ * @internal
+ */
+export function parseExecCredential(output: string): BearerToken {
+    let credential: any;
+    try {
+        credential = JSON.parse(output);
+    } catch (err) {
+        throw new Error(`Failed to parse the token of the token tool: ${(err as Error).message}`);
+    }
+
+    const token = credential?.status?.token;
+    const expirationTimestamp = credential?.status?.expirationTimestamp;
+    if (!token || !expirationTimestamp) {
+        throw new Error(
+            "The token tool did not return an ExecCredential with a token and expirationTimestamp.",
+        );
+    }
+    return { token, expirationTimestamp };
+}
+
+const execFile = promisify(childProcess.execFile);
+
+/**
+ * retrieveBearerToken runs the token tool of the exec config and returns the bearer token it prints. The token tool
+ * runs asynchronously, so it doesn't block the event loop.
+ *
+ * @internal
+ */
+export async function retrieveBearerToken(exec: TokenExecConfig): Promise<BearerToken> {
+    if (exec.command === "aws") {
+        assertCompatibleAWSCLIExists();
+    }
+
+    const env: NodeJS.ProcessEnv = { ...process.env };
+    for (const { name, value } of exec.env) {
+        env[name] = value;
+    }
+
+    let output: string;
+    try {
+        const { stdout } = await execFile(exec.command, exec.args, { encoding: "utf8", env });
+        output = stdout;
+    } catch (err) {
+        throw new Error(
+            `Failed to retrieve a token with '${exec.command}': ${(err as Error).message}`,
+        );
+    }
+    return parseExecCredential(output);
+}
+
+/** @internal */
+export function generateStaticKubeconfig(clusterEndpoint: string, certData: string, token: string) {
+    return {
+        apiVersion: "v1",
+        clusters: [
+            {
+                cluster: {
+                    server: clusterEndpoint,
+                    "certificate-authority-data": certData,
+                },
+                name: "kubernetes",
+            },
+        ],
+        contexts: [
+            {
+                context: {
+                    cluster: "kubernetes",
+                    user: "aws",
+                },
+                name: "aws",
+            },
+        ],
+        "current-context": "aws",
+        kind: "Config",
+        users: [
+            {
+                name: "aws",
+                user: {
+                    token,
+                },
+            },
+        ],
+    };
+}
+
 export interface ClusterCreationRoleProviderOptions {
     region?: pulumi.Input<aws.Region>;
     profile?: pulumi.Input<string>;
@@ -287,6 +517,116 @@ export interface EksAutoModeOptions {
     computeConfig?: pulumi.Input<aws.types.input.eks.ClusterComputeConfig>;
 }
 
+/**
+ * EncryptionConfigOptions configures the KMS key the cluster creates for envelope encryption of
+ * Kubernetes secrets.
+ */
+export interface EncryptionConfigOptions {
+    /**
+     * Whether to enable automatic rotation of the key material. Defaults to `true`.
+     */
+    enableKeyRotation?: pulumi.Input<boolean>;
+    /**
+     * The period in days between automatic rotations of the key material. Must be between 90 and 2560.
+     * Defaults to 365 days.
+     */
+    rotationPeriodInDays?: pulumi.Input<number>;
+    /**
+     * The waiting period in days before the key is deleted after the cluster is destroyed. Must be
+     * between 7 and 30. Defaults to 30 days.
+     */
+    deletionWindowInDays?: pulumi.Input<number>;
+    /**
+     * The name of the alias of the key. Must start with `alias/`. Defaults to
+     * `alias/eks/<clusterName>`.
+     */
+    aliasName?: pulumi.Input<string>;
+    /**
+     * The ARNs of IAM principals that are allowed to administer the key, e.g. to change its policy or
+     * schedule its deletion. The AWS account always retains full access to the key so that IAM
+     * policies can grant access to it as well.
+     */
+    keyAdministratorArns?: pulumi.Input<pulumi.Input<string>[]>;
+}
+
+/**
+ * encryptionKeyPolicy returns the key policy of the KMS key used to encrypt the secrets of a cluster.
+ * It grants the cluster role the permissions EKS needs to use the key for envelope encryption.
+ *
+ * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/envelope-encryption.html
+ *
+ * @internal
+ */
+export function encryptionKeyPolicy(
+    accountRootArn: string,
+    clusterRoleArn: string,
+    keyAdministratorArns: string[],
+): aws.iam.PolicyDocument {
+    const statements: aws.iam.PolicyStatement[] = [
+        {
+            Sid: "EnableIAMUserPermissions",
+            Effect: "Allow",
+            Principal: { AWS: accountRootArn },
+            Action: "kms:*",
+            Resource: "*",
+        },
+    ];
+    if (keyAdministratorArns.length > 0) {
+        statements.push({
+            Sid: "AllowKeyAdministration",
+            Effect: "Allow",
+            Principal: { AWS: keyAdministratorArns },
+            Action: [
+                "kms:CancelKeyDeletion",
+                "kms:Create*",
+                "kms:Delete*",
+                "kms:Describe*",
+                "kms:Disable*",
+                "kms:Enable*",
+                "kms:Get*",
+                "kms:List*",
+                "kms:Put*",
+                "kms:Revoke*",
+                "kms:ScheduleKeyDeletion",
+                "kms:TagResource",
+                "kms:UntagResource",
+                "kms:Update*",
+            ],
+            Resource: "*",
+        });
+    }
+    statements.push(
+        {
+            Sid: "AllowClusterRoleToUseTheKey",
+            Effect: "Allow",
+            Principal: { AWS: clusterRoleArn },
+            Action: [
+                "kms:Encrypt",
+                "kms:Decrypt",
+                "kms:ReEncrypt*",
+                "kms:GenerateDataKey*",
+                "kms:DescribeKey",
+            ],
+            Resource: "*",
+        },
+        {
+            Sid: "AllowClusterRoleToManageGrants",
+            Effect: "Allow",
+            Principal: { AWS: clusterRoleArn },
+            Action: ["kms:CreateGrant", "kms:ListGrants", "kms:RevokeGrant"],
+            Resource: "*",
+            Condition: {
+                Bool: { "kms:GrantIsForAWSResource": "true" },
+            },
+        },
+    );
+
+    return {
+        Version: "2012-10-17",
+        Statement: statements,
+    };
+}
+
 /**
  * ClusterCreationRoleProvider is a component that wraps creating a role provider that can be passed to
  * `new eks.Cluster("test", { creationRoleProvider: ... })`. This can be used to provide a
@@ -455,6 +795,18 @@ export function createCore(
         );
     }
 
+    if (args.encryptionConfig && args.encryptionConfigKeyArn) {
+        throw new Error(
+            "encryptionConfig and encryptionConfigKeyArn are mutually exclusive, and cannot both be set.",
+        );
+    }
+
+    if ((args.clusterLogRetentionDays !== undefined || args.clusterLogKmsKeyArn) && !args.name) {
+        throw new Error(
+            "clusterLogRetentionDays and clusterLogKmsKeyArn require the cluster name to be set, because the log group has to be created before the cluster.",
+        );
+    }
+
     if (args.autoMode?.enabled && !supportsAccessEntries(args.authenticationMode)) {
         throw new pulumi.ResourceError(
             "Access entries are required when using EKS Auto Mode. Use the authentication mode 'API' or 'API_AND_CONFIG_MAP'.",
@@ -590,8 +942,43 @@ export function createCore(
         | pulumi.Output<aws.types.output.eks.ClusterEncryptionConfigProvider>
         | undefined;
     let encryptionConfig: pulumi.Output<aws.types.output.eks.ClusterEncryptionConfig> | undefined;
-    if (args.encryptionConfigKeyArn) {
-        encryptionProvider = pulumi.output(args.encryptionConfigKeyArn).apply(
+    let encryptionKey: aws.kms.Key | undefined;
+    if (args.encryptionConfig) {
+        const clusterRoleArn = args.serviceRole
+            ? pulumi.output(args.serviceRole).arn
+            : eksServiceRole!.directRole.arn;
+        const accountId = aws.getCallerIdentityOutput({}, { parent, provider }).accountId;
+        encryptionKey = new aws.kms.Key(
+            `${name}-encryptionKey`,
+            {
+                description: `Envelope encryption of Kubernetes secrets for the EKS cluster ${name}`,
+                enableKeyRotation: args.encryptionConfig.enableKeyRotation ?? true,
+                rotationPeriodInDays: args.encryptionConfig.rotationPeriodInDays,
+                deletionWindowInDays: args.encryptionConfig.deletionWindowInDays,
+                policy: pulumi
+                    .all([
+                        partition,
+                        accountId,
+                        clusterRoleArn,
+                        args.encryptionConfig.keyAdministratorArns ?? [],
+                    ])
+                    .apply(([partition, accountId, clusterRoleArn, keyAdministratorArns]) =>
+                        JSON.stringify(
+                            encryptionKeyPolicy(
+                                `arn:${partition}:iam::${accountId}:root`,
+                                clusterRoleArn,
+                                keyAdministratorArns,
+                            ),
+                        ),
+                    ),
+                tags: args.tags,
+            },
+            { parent, provider },
+        );
+    }
+    const encryptionKeyArn = encryptionKey ? encryptionKey.arn : args.encryptionConfigKeyArn;
+    if (encryptionKeyArn) {
+        encryptionProvider = pulumi.output(encryptionKeyArn).apply(
             (keyArn) =>
                 <aws.types.output.eks.ClusterEncryptionConfigProvider>{
                     keyArn,
@@ -683,6 +1070,23 @@ export function createCore(
                   kubernetesNetworkConfig,
               };
 
+    // EKS creates the control plane log group with infinite retention if it does not exist yet, so
+    // it has to be created before the cluster in order to manage its retention and encryption.
+    // The cluster depends on the log group so that the log group is only deleted after the cluster.
+    let clusterLogGroup: aws.cloudwatch.LogGroup | undefined;
+    if (args.clusterLogRetentionDays !== undefined || args.clusterLogKmsKeyArn) {
+        clusterLogGroup = new aws.cloudwatch.LogGroup(
+            `${name}-clusterLogGroup`,
+            {
+                name: pulumi.interpolate`/aws/eks/${args.name}/cluster`,
+                retentionInDays: args.clusterLogRetentionDays,
+                kmsKeyId: args.clusterLogKmsKeyArn,
+                tags: args.tags,
+            },
+            { parent, provider },
+        );
+    }
+
     // Create the EKS cluster
     const eksCluster = new aws.eks.Cluster(
         `${name}-eksCluster`,
@@ -740,6 +1144,8 @@ export function createCore(
                   }
                 : undefined,
             upgradePolicy: args.upgradePolicy,
+            zonalShiftConfig: args.zonalShiftConfig,
+            controlPlaneScalingConfig: args.controlPlaneScalingConfig,
             deletionProtection: args.deletionProtection,
         },
         {
@@ -757,10 +1163,24 @@ export function createCore(
                 // Ensure the service roles are created before the cluster and all policies are attached.
                 ...(eksServiceRole ? [eksServiceRole.resolvedRole] : []),
                 ...(eksAutoNodeRole ? [eksAutoNodeRole.resolvedRole] : []),
+                ...(clusterLogGroup ? [clusterLogGroup] : []),
             ],
         },
     );
 
+    if (encryptionKey) {
+        const aliasName =
+            args.encryptionConfig?.aliasName ?? pulumi.interpolate`alias/eks/${eksCluster.name}`;
+        new aws.kms.Alias(
+            `${name}-encryptionKeyAlias`,
+            {
+                name: aliasName,
+                targetKeyId: encryptionKey.keyId,
+            },
+            { parent: encryptionKey, provider },
+        );
+    }
+
     const kubeProxyAddonEnabled = args.kubeProxyAddonOptions?.enabled ?? !args.autoMode?.enabled;
     if (kubeProxyAddonEnabled) {
         const kubeProxyVersion: pulumi.Output<string> = args.kubeProxyAddonOptions?.version
@@ -1086,6 +1506,38 @@ export function createCore(
         }
     }
 
+    // Create default node group security group and cluster ingress rule. They're created before the VPC CNI
+    // addon because the ENIConfigs for custom networking use the node security group.
+    let nodeSecurityGroup: aws.ec2.SecurityGroup | undefined;
+    if (!skipDefaultSecurityGroups) {
+        if (!eksClusterSecurityGroup) {
+            throw new pulumi.ResourceError(
+                "clusterSecurityGroup is required when creating the default node group.",
+                parent,
+            );
+        }
+
+        let eksClusterIngressRule: aws.ec2.SecurityGroupRule;
+        [nodeSecurityGroup, eksClusterIngressRule] = createNodeGroupSecurityGroup(
+            name,
+            {
+                vpcId: vpcId,
+                clusterSecurityGroupId: eksClusterSecurityGroup.id,
+                eksCluster: eksCluster,
+                tags: pulumi.all([args.tags, args.nodeSecurityGroupTags]).apply(
+                    ([tags, nodeSecurityGroupTags]) =>
+                        <aws.Tags>{
+                            ...nodeSecurityGroupTags,
+                            ...tags,
+                        },
+                ),
+            },
+            parent,
+        );
+        nodeGroupOptions.nodeSecurityGroup = nodeSecurityGroup;
+        nodeGroupOptions.clusterIngressRule = eksClusterIngressRule;
+    }
+
     // Create the VPC CNI addon if the user has not explicitly disabled it. The VPC CNI addon is enabled by default
     // unless EKS Auto Mode is enabled.
     const vpcCniAddonEnabled =
@@ -1096,6 +1548,11 @@ export function createCore(
               `${name}-vpc-cni`,
               {
                   ...args.vpcCniOptions,
+                  podSecurityGroupIds:
+                      args.vpcCniOptions?.podSecurityGroupIds ??
+                      (nodeSecurityGroup
+                          ? [nodeSecurityGroup.id]
+                          : eksCluster.vpcConfig.apply((c) => [c.clusterSecurityGroupId])),
                   clusterName: eksCluster.name,
                   clusterVersion: eksCluster.version,
                   tags: args.tags,
@@ -1111,6 +1568,25 @@ export function createCore(
           )
         : undefined;
 
+    // The node groups need to know about prefix delegation and custom networking to compute the maximum number of
+    // pods of their nodes.
+    const podSubnets = args.vpcCniOptions?.podSubnets;
+    const vpcCniNetworking = vpcCni
+        ? pulumi
+              .all([
+                  args.vpcCniOptions?.enablePrefixDelegation,
+                  args.vpcCniOptions?.customNetworkConfig,
+                  args.vpcCniOptions?.warmPrefixTarget,
+              ])
+              .apply(([enablePrefixDelegation, customNetworkConfig, warmPrefixTarget]) => ({
+                  prefixDelegation: enablePrefixDelegation ?? false,
+                  customNetworking:
+                      (customNetworkConfig ?? false) ||
+                      (podSubnets !== undefined && Object.keys(podSubnets).length > 0),
+                  warmPrefixTarget,
+              }))
+        : undefined;
+
     const fargateProfile: pulumi.Output<aws.eks.FargateProfile | undefined> = pulumi
         .output(args.fargate)
         .apply((argsFargate) => {
@@ -1276,6 +1752,7 @@ export function createCore(
         provider: k8sProvider,
         awsProvider: provider,
         vpcCni: vpcCni,
+        vpcCniNetworking: vpcCniNetworking,
         instanceRoles: instanceRoles,
         eksNodeAccess: eksNodeAccess,
         tags: args.tags,
@@ -1284,9 +1761,13 @@ export function createCore(
         fargateProfile: fargateProfile,
         oidcProvider: oidcProvider,
         encryptionConfig: encryptionConfig,
+        clusterLogGroup: clusterLogGroup,
         clusterIamRole: pulumi.output(args.serviceRole ?? eksServiceRole?.directRole!),
         accessEntries: createdAccessEntries ? pulumi.output(createdAccessEntries) : undefined,
         autoModeNodeRoleName: eksAutoNodeRole?.directRole.name ?? pulumi.output(""),
+        upgradePolicy: eksCluster.upgradePolicy,
+        zonalShiftConfig: eksCluster.zonalShiftConfig,
+        controlPlaneScalingConfig: eksCluster.controlPlaneScalingConfig,
     };
 }
 
@@ -1744,6 +2225,29 @@ export interface ClusterOptions {
      */
     enabledClusterLogTypes?: pulumi.Input<pulumi.Input<string>[]>;
 
+    /**
+     * The number of days to retain the control plane logs in the `/aws/eks/<name>/cluster` log group.
+     * Possible values are: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827,
+     * 2192, 2557, 2922, 3288, 3653, and 0. If set to 0, the logs never expire.
+     *
+     * When set, the log group is created and managed by this component before the cluster is created.
+     * This requires `name` to be set. Set `name` to the name of the existing cluster when enabling this
+     * for an existing cluster, and import the log group if EKS already created it.
+     */
+    clusterLogRetentionDays?: pulumi.Input<number>;
+
+    /**
+     * The ARN of the KMS key to encrypt the control plane logs in the `/aws/eks/<name>/cluster` log group with.
+     * The key policy must allow the CloudWatch Logs service principal of the region to use the key.
+     *
+     * When set, the log group is created and managed by this component before the cluster is created.
+     * This requires `name` to be set.
+     *
+     * See for more details:
+     * - https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/encrypt-log-data-kms.html
+     */
+    clusterLogKmsKeyArn?: pulumi.Input<string>;
+
     /**
      * Install default unmanaged add-ons, such as `aws-cni`, `kube-proxy`, and CoreDNS during cluster creation.
      * If `false`, you must manually install desired add-ons. Changing this value will force a new cluster to be created. Defaults to `true`
@@ -1868,6 +2372,16 @@ export interface ClusterOptions {
      */
     encryptionConfigKeyArn?: pulumi.Input<string>;
 
+    /**
+     * Creates a KMS key and alias for the encryption configuration of the cluster. The key policy grants
+     * the cluster role the permissions it needs to use the key for envelope encryption of Kubernetes secrets.
+     *
+     * Mutually exclusive with `encryptionConfigKeyArn`.
+     * See for more details:
+     * - https://docs.aws.amazon.com/eks/latest/userguide/envelope-encryption.html
+     */
+    encryptionConfig?: EncryptionConfigOptions;
+
     /**
      * The CIDR block to assign Kubernetes service IP addresses from. If you don't specify a block, Kubernetes assigns
      * addresses from either the 10.100.0.0/16 or 172.20.0.0/16 CIDR blocks. We recommend that you specify a block that
@@ -1920,6 +2434,22 @@ export interface ClusterOptions {
      */
     upgradePolicy?: aws.types.input.eks.ClusterUpgradePolicy;
 
+    /**
+     * The zonal shift configuration of the cluster. Enabling zonal shift lets Amazon Application Recovery
+     * Controller (ARC) move traffic away from an impaired Availability Zone.
+     *
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/zone-shift.html
+     */
+    zonalShiftConfig?: pulumi.Input<aws.types.input.eks.ClusterZonalShiftConfig>;
+
+    /**
+     * The scaling configuration of the control plane. Setting a provisioned `tier` reserves control plane capacity
+     * for the cluster instead of scaling it on demand.
+     *
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/eks-provisioned-control-plane.html
+     */
+    controlPlaneScalingConfig?: pulumi.Input<aws.types.input.eks.ClusterControlPlaneScalingConfig>;
+
     /**
      * Whether to enable deletion protection for the cluster. When enabled, the cluster cannot be deleted unless deletion protection is first disabled. Default: `false`.
      */
@@ -2115,37 +2645,14 @@ export function createCluster(
     // Create the core resources required by the cluster.
     const core = createCore(name, args, self, opts?.provider);
 
-    let nodeSecurityGroup: aws.ec2.SecurityGroup | undefined;
-    let eksClusterIngressRule: aws.ec2.SecurityGroupRule | undefined;
+    // The default node group security group and cluster ingress rule are created by createCore.
     const skipDefaultSecurityGroups = args.skipDefaultSecurityGroups ?? args.autoMode?.enabled;
-    if (!skipDefaultSecurityGroups) {
-        if (!core.clusterSecurityGroup) {
-            throw new pulumi.ResourceError(
-                "clusterSecurityGroup is required when creating the default node group.",
-                self,
-            );
-        }
-
-        // Create default node group security group and cluster ingress rule.
-        [nodeSecurityGroup, eksClusterIngressRule] = createNodeGroupSecurityGroup(
-            name,
-            {
-                vpcId: core.vpcId,
-                clusterSecurityGroupId: core.clusterSecurityGroup.id,
-                eksCluster: core.cluster,
-                tags: pulumi.all([args.tags, args.nodeSecurityGroupTags]).apply(
-                    ([tags, nodeSecurityGroupTags]) =>
-                        <aws.Tags>{
-                            ...nodeSecurityGroupTags,
-                            ...tags,
-                        },
-                ),
-            },
-            self,
-        );
-        core.nodeGroupOptions.nodeSecurityGroup = nodeSecurityGroup;
-        core.nodeGroupOptions.clusterIngressRule = eksClusterIngressRule;
-    }
+    const nodeSecurityGroup = !skipDefaultSecurityGroups
+        ? core.nodeGroupOptions.nodeSecurityGroup
+        : undefined;
+    const eksClusterIngressRule = !skipDefaultSecurityGroups
+        ? core.nodeGroupOptions.clusterIngressRule
+        : undefined;
 
     const skipDefaultNodeGroup =
         args.skipDefaultNodeGroup || args.fargate || args.autoMode?.enabled;
@@ -2333,4 +2840,28 @@ export class Cluster extends pulumi.ComponentResource {
         );
         return pulumi.output(kc).apply(JSON.stringify);
     }
+
+    getStaticKubeconfig(args: KubeconfigOptions): StaticKubeconfig {
+        const region = this.eksCluster.arn.apply(getRegionFromArn);
+        // apply doesn't run the callback while any of the inputs are unknown, e.g. during the preview of a new
+        // cluster, so the token tool only runs once the cluster exists and the kubeconfig stays unknown until then.
+        const bearerToken = pulumi.secret(
+            pulumi
+                .all([this.eksCluster.name, region, args])
+                .apply(([clusterName, region, opts]) =>
+                    retrieveBearerToken(getTokenExecConfig(clusterName, region, true, opts)),
+                ),
+        );
+        const kubeconfig = pulumi
+            .all([this.eksCluster.endpoint, this.eksCluster.certificateAuthority.data, bearerToken])
+            .apply(([endpoint, certData, bearerToken]) =>
+                JSON.stringify(generateStaticKubeconfig(endpoint, certData, bearerToken.token)),
+            );
+        return {
+            kubeconfig,
+            expirationTimestamp: pulumi.unsecret(
+                bearerToken.apply((bearerToken) => bearerToken.expirationTimestamp),
+            ),
+        };
+    }
 }
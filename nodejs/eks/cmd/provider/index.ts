@@ -15,6 +15,7 @@
 import * as pulumi from "@pulumi/pulumi";
 import { readFileSync } from "fs";
 import { Cluster } from "../../cluster";
+import { KubeconfigOptions } from "../../cluster/cluster";
 import { VpcCniAddon } from "../../addons/cni-addon";
 import { clusterCreationRoleProviderProviderFactory, clusterProviderFactory } from "./cluster";
 import { cniAddonProviderFactory } from "./cni-addon";
@@ -26,6 +27,10 @@ import {
 import { randomSuffixProviderFactory } from "./randomSuffix";
 import { nodeGroupSecurityGroupProviderFactory } from "./securitygroup";
 import { managedAddonProviderFactory } from "./addon";
+import { loadBalancerControllerProviderFactory } from "./load-balancer-controller";
+import { clusterAutoscalerProviderFactory } from "./cluster-autoscaler";
+import { autoModeNodeClassProviderFactory, autoModeNodePoolProviderFactory } from "./automode";
+import { getOptimizedAmi } from "../../nodes/ami";
 import * as utilities from "../../utilities";
 
 class Provider implements pulumi.provider.Provider {
@@ -41,6 +46,10 @@ class Provider implements pulumi.provider.Provider {
         "eks:index:RandomSuffix": randomSuffixProviderFactory,
         "eks:index:VpcCniAddon": cniAddonProviderFactory,
         "eks:index:Addon": managedAddonProviderFactory,
+        "eks:index:LoadBalancerController": loadBalancerControllerProviderFactory,
+        "eks:index:ClusterAutoscaler": clusterAutoscalerProviderFactory,
+        "eks:index:AutoModeNodeClass": autoModeNodeClassProviderFactory,
+        "eks:index:AutoModeNodePool": autoModeNodePoolProviderFactory,
     };
 
     constructor(readonly version: string, readonly schema: string) {
@@ -62,21 +71,46 @@ class Provider implements pulumi.provider.Provider {
 
     async call(token: string, inputs: pulumi.Inputs): Promise<pulumi.provider.InvokeResult> {
         switch (token) {
-            case "eks:index:Cluster/getKubeconfig":
+            case "eks:index:Cluster/getKubeconfig": {
                 const self: Cluster = inputs.__self__;
-                const result = self.getKubeconfig({
-                    profileName: inputs.profileName,
-                    roleArn: inputs.roleArn,
-                });
+                const result = self.getKubeconfig(kubeconfigOptions(inputs));
                 return {
                     outputs: { result },
                 };
+            }
+
+            case "eks:index:Cluster/getStaticKubeconfig": {
+                const self: Cluster = inputs.__self__;
+                const { kubeconfig, expirationTimestamp } = self.getStaticKubeconfig(
+                    kubeconfigOptions(inputs),
+                );
+                return {
+                    outputs: { kubeconfig, expirationTimestamp },
+                };
+            }
 
             default:
                 throw new Error(`unknown method ${token}`);
         }
     }
 
+    async invoke(token: string, inputs: any): Promise<pulumi.provider.InvokeResult> {
+        switch (token) {
+            case "eks:index:getOptimizedAmi":
+                return {
+                    outputs: await getOptimizedAmi({
+                        kubernetesVersion: inputs.kubernetesVersion,
+                        amiType: inputs.amiType,
+                        releaseVersion: inputs.releaseVersion,
+                        region: inputs.region,
+                    }),
+                };
+
+            default:
+                throw new Error(`unknown function ${token}`);
+        }
+    }
+
     check(urn: pulumi.URN, olds: any, news: any): Promise<pulumi.provider.CheckResult> {
         const provider = this.getProviderForURN(urn);
         if (!provider) {
@@ -167,6 +201,18 @@ class Provider implements pulumi.provider.Provider {
     }
 }
 
+function kubeconfigOptions(inputs: pulumi.Inputs): KubeconfigOptions {
+    return {
+        profileName: inputs.profileName,
+        roleArn: inputs.roleArn,
+        roleSessionName: inputs.roleSessionName,
+        externalId: inputs.externalId,
+        region: inputs.region,
+        env: inputs.env,
+        tokenTool: inputs.tokenTool,
+    };
+}
+
 function unknownResourceRejectedPromise<T>(type: string): Promise<T> {
     return Promise.reject(new Error(`unknown resource type ${type}`));
 }
@@ -14,9 +14,12 @@
 
 import {
     AmiType,
+    amiReleaseDate,
     DEFAULT_OS,
+    getAmiMetadata,
     getAmiType,
     getOperatingSystem,
+    getOptimizedAmi,
     OperatingSystem,
     toAmiType,
 } from "./ami";
@@ -107,3 +110,88 @@ describe("getAmiType", () => {
         }).toThrow("No AMI type found for OS: AL2, GPU support: true, architecture: arm64");
     });
 });
+
+describe("amiReleaseDate", () => {
+    test("should extract the build date from release versions", () => {
+        expect(amiReleaseDate("1.30.4-20240917")).toBe("20240917");
+        expect(amiReleaseDate("20240917")).toBe("20240917");
+        expect(amiReleaseDate("v20240917")).toBe("20240917");
+    });
+
+    test("should throw an error for release versions without a build date", () => {
+        expect(() => amiReleaseDate("1.30.4")).toThrow(
+            "Cannot determine the AMI build date of release version '1.30.4'",
+        );
+    });
+});
+
+describe("pinnedSsmParameterName", () => {
+    test.each([
+        [
+            AmiType.AL2X86_64,
+            "1.30.4-20240917",
+            "/aws/service/eks/optimized-ami/1.30/amazon-linux-2/amazon-eks-node-1.30-v20240917/image_id",
+        ],
+        [
+            AmiType.AL2X86_64GPU,
+            "1.30.4-20240917",
+            "/aws/service/eks/optimized-ami/1.30/amazon-linux-2-gpu/amazon-eks-gpu-node-1.30-v20240917/image_id",
+        ],
+        [
+            AmiType.AL2Arm64,
+            "1.30.4-20240917",
+            "/aws/service/eks/optimized-ami/1.30/amazon-linux-2-arm64/amazon-eks-arm64-node-1.30-v20240917/image_id",
+        ],
+        [
+            AmiType.AL2023X86_64Standard,
+            "1.30.4-20240917",
+            "/aws/service/eks/optimized-ami/1.30/amazon-linux-2023/x86_64/standard/amazon-eks-node-al2023-x86_64-standard-1.30-v20240917/image_id",
+        ],
+        [
+            AmiType.AL2023Arm64Standard,
+            "1.30.4-20240917",
+            "/aws/service/eks/optimized-ami/1.30/amazon-linux-2023/arm64/standard/amazon-eks-node-al2023-arm64-standard-1.30-v20240917/image_id",
+        ],
+        [
+            AmiType.AL2023X86_64Nvidia,
+            "1.30.4-20240917",
+            "/aws/service/eks/optimized-ami/1.30/amazon-linux-2023/x86_64/nvidia/amazon-eks-node-al2023-x86_64-nvidia-1.30-v20240917/image_id",
+        ],
+        [
+            AmiType.BottlerocketX86_64,
+            "1.22.0",
+            "/aws/service/bottlerocket/aws-k8s-1.30/x86_64/1.22.0/image_id",
+        ],
+        [
+            AmiType.BottlerocketArm64Nvidia,
+            "1.22.0",
+            "/aws/service/bottlerocket/aws-k8s-1.30-nvidia/arm64/1.22.0/image_id",
+        ],
+    ])("should return the pinned SSM parameter of %s", (amiType, releaseVersion, expected) => {
+        expect(getAmiMetadata(amiType).pinnedSsmParameterName("1.30", releaseVersion)).toBe(
+            expected,
+        );
+    });
+});
+
+describe("getOptimizedAmi", () => {
+    test("should require a region", async () => {
+        await expect(
+            getOptimizedAmi({
+                kubernetesVersion: "1.30",
+                amiType: AmiType.AL2023X86_64Standard,
+                region: "",
+            }),
+        ).rejects.toThrow("The region is required to resolve the optimized AMI.");
+    });
+
+    test("should reject unknown AMI types", async () => {
+        await expect(
+            getOptimizedAmi({
+                kubernetesVersion: "1.30",
+                amiType: "WINDOWS_CORE_2022_x86_64",
+                region: "us-west-2",
+            }),
+        ).rejects.toThrow("Cannot resolve the optimized AMI for unknown AMI type");
+    });
+});
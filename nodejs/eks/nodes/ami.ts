@@ -13,6 +13,9 @@
 // limitations under the License.
 
 import * as pulumi from "@pulumi/pulumi";
+import * as childProcess from "child_process";
+import { promisify } from "util";
+import { assertCompatibleAWSCLIExists } from "../dependencies";
 
 export type CpuArchitecture = "arm64" | "x86_64";
 export type ClusterVersion = string;
@@ -36,6 +39,10 @@ export interface AmiMetadata {
     architecture: CpuArchitecture;
     // Function to get the SSM parameter name of the AMI
     ssmParameterName: (arg: ClusterVersion) => string;
+    // Function to get the SSM parameter name of the release version of the recommended AMI
+    releaseVersionParameterName: (arg: ClusterVersion) => string;
+    // Function to get the SSM parameter name of the AMI for a specific release version
+    pinnedSsmParameterName: (clusterVersion: ClusterVersion, releaseVersion: string) => string;
 
     // Some AMI types were previously exposed by a part of their SSM parameter name. This only works for AL2 & AL2023, so to support the
     // other AMIs we need to refer to them by their EKS AMI type. This is for backwards compatibility.
@@ -50,6 +57,10 @@ const amiTypeMetadata: { [key in AmiType]: AmiMetadata } = {
         architecture: "x86_64",
         ssmParameterName: (clusterVersion: ClusterVersion) =>
             `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2/recommended/image_id`,
+        releaseVersionParameterName: (clusterVersion: ClusterVersion) =>
+            `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2/recommended/release_version`,
+        pinnedSsmParameterName: (clusterVersion: ClusterVersion, releaseVersion: string) =>
+            `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2/amazon-eks-node-${clusterVersion}-v${amiReleaseDate(releaseVersion)}/image_id`,
 
         aliases: ["amazon-linux-2"],
     },
@@ -59,6 +70,10 @@ const amiTypeMetadata: { [key in AmiType]: AmiMetadata } = {
         architecture: "x86_64",
         ssmParameterName: (clusterVersion: ClusterVersion) =>
             `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2-gpu/recommended/image_id`,
+        releaseVersionParameterName: (clusterVersion: ClusterVersion) =>
+            `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2-gpu/recommended/release_version`,
+        pinnedSsmParameterName: (clusterVersion: ClusterVersion, releaseVersion: string) =>
+            `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2-gpu/amazon-eks-gpu-node-${clusterVersion}-v${amiReleaseDate(releaseVersion)}/image_id`,
 
         aliases: ["amazon-linux-2-gpu"],
     },
@@ -68,6 +83,10 @@ const amiTypeMetadata: { [key in AmiType]: AmiMetadata } = {
         architecture: "arm64",
         ssmParameterName: (clusterVersion: ClusterVersion) =>
             `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2-arm64/recommended/image_id`,
+        releaseVersionParameterName: (clusterVersion: ClusterVersion) =>
+            `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2-arm64/recommended/release_version`,
+        pinnedSsmParameterName: (clusterVersion: ClusterVersion, releaseVersion: string) =>
+            `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2-arm64/amazon-eks-arm64-node-${clusterVersion}-v${amiReleaseDate(releaseVersion)}/image_id`,
 
         aliases: ["amazon-linux-2-arm"],
     },
@@ -77,6 +96,10 @@ const amiTypeMetadata: { [key in AmiType]: AmiMetadata } = {
         architecture: "x86_64",
         ssmParameterName: (clusterVersion: ClusterVersion) =>
             `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2023/x86_64/standard/recommended/image_id`,
+        releaseVersionParameterName: (clusterVersion: ClusterVersion) =>
+            `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2023/x86_64/standard/recommended/release_version`,
+        pinnedSsmParameterName: (clusterVersion: ClusterVersion, releaseVersion: string) =>
+            `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2023/x86_64/standard/amazon-eks-node-al2023-x86_64-standard-${clusterVersion}-v${amiReleaseDate(releaseVersion)}/image_id`,
 
         aliases: ["amazon-linux-2023/x86_64/standard"],
     },
@@ -86,6 +109,10 @@ const amiTypeMetadata: { [key in AmiType]: AmiMetadata } = {
         architecture: "arm64",
         ssmParameterName: (clusterVersion: ClusterVersion) =>
             `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2023/arm64/standard/recommended/image_id`,
+        releaseVersionParameterName: (clusterVersion: ClusterVersion) =>
+            `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2023/arm64/standard/recommended/release_version`,
+        pinnedSsmParameterName: (clusterVersion: ClusterVersion, releaseVersion: string) =>
+            `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2023/arm64/standard/amazon-eks-node-al2023-arm64-standard-${clusterVersion}-v${amiReleaseDate(releaseVersion)}/image_id`,
 
         aliases: ["amazon-linux-2023/arm64/standard"],
     },
@@ -95,6 +122,10 @@ const amiTypeMetadata: { [key in AmiType]: AmiMetadata } = {
         architecture: "x86_64",
         ssmParameterName: (clusterVersion: ClusterVersion) =>
             `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2023/x86_64/nvidia/recommended/image_id`,
+        releaseVersionParameterName: (clusterVersion: ClusterVersion) =>
+            `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2023/x86_64/nvidia/recommended/release_version`,
+        pinnedSsmParameterName: (clusterVersion: ClusterVersion, releaseVersion: string) =>
+            `/aws/service/eks/optimized-ami/${clusterVersion}/amazon-linux-2023/x86_64/nvidia/amazon-eks-node-al2023-x86_64-nvidia-${clusterVersion}-v${amiReleaseDate(releaseVersion)}/image_id`,
 
         aliases: ["amazon-linux-2023/x86_64/nvidia"],
     },
@@ -104,6 +135,10 @@ const amiTypeMetadata: { [key in AmiType]: AmiMetadata } = {
         architecture: "arm64",
         ssmParameterName: (clusterVersion: ClusterVersion) =>
             `/aws/service/bottlerocket/aws-k8s-${clusterVersion}/arm64/latest/image_id`,
+        releaseVersionParameterName: (clusterVersion: ClusterVersion) =>
+            `/aws/service/bottlerocket/aws-k8s-${clusterVersion}/arm64/latest/image_version`,
+        pinnedSsmParameterName: (clusterVersion: ClusterVersion, releaseVersion: string) =>
+            `/aws/service/bottlerocket/aws-k8s-${clusterVersion}/arm64/${releaseVersion}/image_id`,
     },
     BOTTLEROCKET_x86_64: {
         os: OperatingSystem.Bottlerocket,
@@ -111,6 +146,10 @@ const amiTypeMetadata: { [key in AmiType]: AmiMetadata } = {
         architecture: "x86_64",
         ssmParameterName: (clusterVersion: ClusterVersion) =>
             `/aws/service/bottlerocket/aws-k8s-${clusterVersion}/x86_64/latest/image_id`,
+        releaseVersionParameterName: (clusterVersion: ClusterVersion) =>
+            `/aws/service/bottlerocket/aws-k8s-${clusterVersion}/x86_64/latest/image_version`,
+        pinnedSsmParameterName: (clusterVersion: ClusterVersion, releaseVersion: string) =>
+            `/aws/service/bottlerocket/aws-k8s-${clusterVersion}/x86_64/${releaseVersion}/image_id`,
     },
     BOTTLEROCKET_ARM_64_NVIDIA: {
         os: OperatingSystem.Bottlerocket,
@@ -118,6 +157,10 @@ const amiTypeMetadata: { [key in AmiType]: AmiMetadata } = {
         architecture: "arm64",
         ssmParameterName: (clusterVersion: ClusterVersion) =>
             `/aws/service/bottlerocket/aws-k8s-${clusterVersion}-nvidia/arm64/latest/image_id`,
+        releaseVersionParameterName: (clusterVersion: ClusterVersion) =>
+            `/aws/service/bottlerocket/aws-k8s-${clusterVersion}-nvidia/arm64/latest/image_version`,
+        pinnedSsmParameterName: (clusterVersion: ClusterVersion, releaseVersion: string) =>
+            `/aws/service/bottlerocket/aws-k8s-${clusterVersion}-nvidia/arm64/${releaseVersion}/image_id`,
     },
     BOTTLEROCKET_x86_64_NVIDIA: {
         os: OperatingSystem.Bottlerocket,
@@ -125,6 +168,10 @@ const amiTypeMetadata: { [key in AmiType]: AmiMetadata } = {
         architecture: "x86_64",
         ssmParameterName: (clusterVersion: ClusterVersion) =>
             `/aws/service/bottlerocket/aws-k8s-${clusterVersion}-nvidia/x86_64/latest/image_id`,
+        releaseVersionParameterName: (clusterVersion: ClusterVersion) =>
+            `/aws/service/bottlerocket/aws-k8s-${clusterVersion}-nvidia/x86_64/latest/image_version`,
+        pinnedSsmParameterName: (clusterVersion: ClusterVersion, releaseVersion: string) =>
+            `/aws/service/bottlerocket/aws-k8s-${clusterVersion}-nvidia/x86_64/${releaseVersion}/image_id`,
     },
 };
 
@@ -259,3 +306,107 @@ export function getAmiType(
         parent,
     );
 }
+
+/**
+ * Extracts the build date of an EKS optimized AMI from its release version. Release versions are
+ * formatted as `<kubernetes version>-<build date>` (e.g. `1.30.4-20240917`). The build date on
+ * its own (with or without a leading `v`) is accepted as well.
+ *
+ * @param releaseVersion - The release version of the AMI.
+ * @returns The build date of the AMI, e.g. `20240917`.
+ * @throws {pulumi.InputPropertyError} If the release version does not contain a build date.
+ */
+export function amiReleaseDate(releaseVersion: string): string {
+    const date = releaseVersion.split("-").pop()!.replace(/^v/, "");
+    if (!/^\d{8}$/.test(date)) {
+        throw new pulumi.InputPropertyError({
+            propertyPath: "releaseVersion",
+            reason: `Cannot determine the AMI build date of release version '${releaseVersion}'. Expected a release version like '1.30.4-20240917'.`,
+        });
+    }
+    return date;
+}
+
+export interface GetOptimizedAmiArgs {
+    kubernetesVersion: string;
+    amiType: string;
+    releaseVersion?: string;
+    region: string;
+}
+
+export interface GetOptimizedAmiResult {
+    amiId: string;
+    releaseVersion: string;
+    ssmParameterName: string;
+}
+
+/**
+ * getOptimizedAmi resolves the EKS optimized AMI for the given Kubernetes version and AMI type.
+ * If no release version is given, the release version of the currently recommended AMI is
+ * resolved first so that the returned SSM parameter always points to a pinned AMI release.
+ *
+ * The SSM parameters are read with the AWS CLI because provider functions do not have access to the
+ * Pulumi engine and thus cannot use invokes of the AWS provider. The region is required and always
+ * passed explicitly so that the lookup never falls back to the region configured for the CLI.
+ *
+ * See: https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
+ */
+export async function getOptimizedAmi(args: GetOptimizedAmiArgs): Promise<GetOptimizedAmiResult> {
+    const amiType = toAmiType(args.amiType);
+    if (!amiType) {
+        throw new pulumi.InputPropertyError({
+            propertyPath: "amiType",
+            reason: `Cannot resolve the optimized AMI for unknown AMI type: ${args.amiType}`,
+        });
+    }
+    if (!args.region) {
+        throw new pulumi.InputPropertyError({
+            propertyPath: "region",
+            reason: "The region is required to resolve the optimized AMI.",
+        });
+    }
+
+    const metadata = getAmiMetadata(amiType);
+    const releaseVersion =
+        args.releaseVersion ??
+        (await getSsmParameter(
+            metadata.releaseVersionParameterName(args.kubernetesVersion),
+            args.region,
+        ));
+    const ssmParameterName = metadata.pinnedSsmParameterName(
+        args.kubernetesVersion,
+        releaseVersion,
+    );
+
+    return {
+        amiId: await getSsmParameter(ssmParameterName, args.region),
+        releaseVersion,
+        ssmParameterName,
+    };
+}
+
+const execFile = promisify(childProcess.execFile);
+
+async function getSsmParameter(name: string, region: string): Promise<string> {
+    assertCompatibleAWSCLIExists();
+
+    const cmdArgs = [
+        "ssm",
+        "get-parameter",
+        "--name",
+        name,
+        "--region",
+        region,
+        "--query",
+        "Parameter.Value",
+        "--output",
+        "text",
+    ];
+
+    try {
+        const { stdout } = await execFile("aws", cmdArgs, { encoding: "utf8" });
+        return stdout.trim();
+    } catch (err) {
+        throw new Error(`Failed to read SSM parameter '${name}': ${(err as Error).message}`);
+    }
+}
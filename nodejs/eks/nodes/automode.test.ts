@@ -0,0 +1,110 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import { autoNodePolicyArn, nodeClassSpec, nodePoolSpec, roleNameFromArn } from "./automode";
+
+describe("roleNameFromArn", () => {
+    it("should return the name of the role", () => {
+        expect(roleNameFromArn("arn:aws:iam::123456789012:role/my-role")).toBe("my-role");
+    });
+
+    it("should strip the path of the role", () => {
+        expect(roleNameFromArn("arn:aws:iam::123456789012:role/nodes/my-role")).toBe("my-role");
+    });
+
+    it("should reject ARNs of other resources", () => {
+        expect(() => roleNameFromArn("arn:aws:iam::123456789012:user/my-user")).toThrow(
+            "is not the ARN of an IAM role",
+        );
+    });
+});
+
+describe("autoNodePolicyArn", () => {
+    it("should use the partition of the node role", () => {
+        expect(autoNodePolicyArn("arn:aws-cn:iam::123456789012:role/my-role")).toBe(
+            "arn:aws-cn:eks::aws:cluster-access-policy/AmazonEKSAutoNodePolicy",
+        );
+    });
+});
+
+describe("nodeClassSpec", () => {
+    it("should omit unset settings", () => {
+        expect(
+            nodeClassSpec({
+                role: "my-role",
+                subnetSelectorTerms: [{ id: "subnet-1" }],
+                securityGroupSelectorTerms: [{ tags: { "karpenter.sh/discovery": "my-cluster" } }],
+                ephemeralStorage: { size: "80Gi", kmsKeyId: "arn:aws:kms:us-west-2:123:key/1" },
+            }),
+        ).toEqual({
+            role: "my-role",
+            subnetSelectorTerms: [{ id: "subnet-1" }],
+            securityGroupSelectorTerms: [{ tags: { "karpenter.sh/discovery": "my-cluster" } }],
+            ephemeralStorage: { size: "80Gi", kmsKeyID: "arn:aws:kms:us-west-2:123:key/1" },
+        });
+    });
+
+    it("should require a role", () => {
+        expect(() => nodeClassSpec({ role: "" })).toThrow("a nodeRoleArn is required");
+    });
+});
+
+describe("nodePoolSpec", () => {
+    it("should reference the default NodeClass", () => {
+        expect(nodePoolSpec({ nodeClassName: "default" })).toEqual({
+            template: {
+                metadata: {},
+                spec: {
+                    nodeClassRef: {
+                        group: "eks.amazonaws.com",
+                        kind: "NodeClass",
+                        name: "default",
+                    },
+                    requirements: [],
+                },
+            },
+        });
+    });
+
+    it("should convert taints and disruption budgets", () => {
+        const spec = nodePoolSpec({
+            nodeClassName: "gpu",
+            requirements: [
+                { key: "eks.amazonaws.com/instance-category", operator: "In", values: ["g"] },
+            ],
+            labels: { workload: "gpu" },
+            taints: { "nvidia.com/gpu": { value: "true", effect: "NoSchedule" } },
+            disruption: {
+                consolidationPolicy: "WhenEmpty",
+                budgets: [{ nodes: "0", schedule: "0 9 * * mon-fri", duration: "8h" }],
+            },
+            limits: { cpu: "100" },
+            weight: 10,
+        });
+
+        expect(spec.template.metadata).toEqual({ labels: { workload: "gpu" } });
+        expect(spec.template.spec.taints).toEqual([
+            { key: "nvidia.com/gpu", value: "true", effect: "NoSchedule" },
+        ]);
+        expect(spec.template.spec.requirements).toEqual([
+            { key: "eks.amazonaws.com/instance-category", operator: "In", values: ["g"] },
+        ]);
+        expect(spec.disruption).toEqual({
+            consolidationPolicy: "WhenEmpty",
+            budgets: [{ nodes: "0", schedule: "0 9 * * mon-fri", duration: "8h" }],
+        });
+        expect(spec.limits).toEqual({ cpu: "100" });
+        expect(spec.weight).toBe(10);
+    });
+});
@@ -0,0 +1,619 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import * as pulumi from "@pulumi/pulumi";
+import * as aws from "@pulumi/aws";
+import * as k8s from "@pulumi/kubernetes";
+import { Cluster } from "../cluster";
+import { AccessEntryType } from "../cluster/cluster";
+import { createAccessEntries } from "../cluster/authenticationMode";
+import { InputTags } from "../utils";
+import { Taint } from "./nodegroup";
+
+/**
+ * The name of the NodeClass EKS Auto Mode creates for the built-in node pools.
+ */
+const defaultNodeClassName = "default";
+
+/**
+ * AutoModeSelectorTerm selects subnets or security groups by their ID, name or tags. The conditions of a term are
+ * ANDed, multiple terms are ORed.
+ */
+export interface AutoModeSelectorTerm {
+    /**
+     * The ID of the subnet or security group.
+     */
+    id?: pulumi.Input<string>;
+
+    /**
+     * The name of the security group. Not supported for subnets.
+     */
+    name?: pulumi.Input<string>;
+
+    /**
+     * The tags the subnets or security groups must have. A value of `*` matches any value.
+     */
+    tags?: InputTags;
+}
+
+/**
+ * AutoModeEphemeralStorage configures the volume that holds the ephemeral storage of the nodes.
+ */
+export interface AutoModeEphemeralStorage {
+    /**
+     * The size of the volume, e.g. `80Gi`.
+     */
+    size?: pulumi.Input<string>;
+
+    /**
+     * The IOPS of the volume.
+     */
+    iops?: pulumi.Input<number>;
+
+    /**
+     * The throughput of the volume in MiB/s.
+     */
+    throughput?: pulumi.Input<number>;
+
+    /**
+     * The ARN of the KMS key that encrypts the volume.
+     */
+    kmsKeyId?: pulumi.Input<string>;
+}
+
+export interface AutoModeNodeClassOptions {
+    /**
+     * The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+     */
+    cluster: Cluster;
+
+    /**
+     * The ARN of the IAM role of the nodes. Defaults to the node role of EKS Auto Mode created by the cluster.
+     *
+     * An access entry of type `EC2` with the `AmazonEKSAutoNodePolicy` access policy is created for custom node
+     * roles, which allows their nodes to join the cluster.
+     */
+    nodeRoleArn?: pulumi.Input<string>;
+
+    /**
+     * The subnets to launch nodes into. Defaults to the subnets of the cluster.
+     */
+    subnetSelectorTerms?: pulumi.Input<pulumi.Input<AutoModeSelectorTerm>[]>;
+
+    /**
+     * The security groups of the nodes. Defaults to the cluster security group.
+     */
+    securityGroupSelectorTerms?: pulumi.Input<pulumi.Input<AutoModeSelectorTerm>[]>;
+
+    /**
+     * The source NAT policy of the pod traffic leaving the VPC, `Random` or `Disabled`. Defaults to `Random`.
+     */
+    snatPolicy?: pulumi.Input<string>;
+
+    /**
+     * The default network policy of the pods, `DefaultAllow` or `DefaultDeny`. Defaults to `DefaultAllow`.
+     */
+    networkPolicy?: pulumi.Input<string>;
+
+    /**
+     * Whether to log the network policy decisions, `Enabled` or `Disabled`. Defaults to `Disabled`.
+     */
+    networkPolicyEventLogs?: pulumi.Input<string>;
+
+    /**
+     * The ephemeral storage of the nodes.
+     */
+    ephemeralStorage?: pulumi.Input<AutoModeEphemeralStorage>;
+
+    /**
+     * The tags to apply to the EC2 resources created for the nodes.
+     */
+    tags?: InputTags;
+}
+
+/**
+ * AutoModeNodeClass creates an EKS Auto Mode NodeClass, which configures the networking, storage and IAM role of the
+ * nodes of the node pools that reference it.
+ * For more information see: https://docs.aws.amazon.com/eks/latest/userguide/create-node-class.html
+ */
+export class AutoModeNodeClass extends pulumi.ComponentResource {
+    /**
+     * The NodeClass custom resource.
+     */
+    public readonly nodeClass!: pulumi.Output<k8s.apiextensions.CustomResource>;
+
+    /**
+     * The name of the NodeClass, which node pools reference with `nodeClassName`.
+     */
+    public readonly nodeClassName!: pulumi.Output<string>;
+
+    /**
+     * The access entry of the custom node role, if `nodeRoleArn` is set.
+     */
+    public readonly accessEntry!: pulumi.Output<aws.eks.AccessEntry | undefined>;
+
+    constructor(
+        name: string,
+        args?: AutoModeNodeClassOptions,
+        opts?: pulumi.ComponentResourceOptions,
+    ) {
+        const type = "eks:index:AutoModeNodeClass";
+
+        if (opts?.urn) {
+            const props = {
+                nodeClass: undefined,
+                nodeClassName: undefined,
+                accessEntry: undefined,
+            };
+            super(type, name, props, opts);
+            return;
+        }
+
+        super(type, name, args, opts);
+
+        if (!args?.cluster) {
+            throw new pulumi.InputPropertyError({
+                propertyPath: "cluster",
+                reason: "the cluster is required",
+            });
+        }
+
+        const cluster = args.cluster;
+
+        let accessEntry: aws.eks.AccessEntry | undefined;
+        let role: pulumi.Input<string> = cluster.autoModeNodeRoleName;
+        if (args.nodeRoleArn !== undefined) {
+            const nodeRoleArn = pulumi.output(args.nodeRoleArn);
+            role = nodeRoleArn.apply(roleNameFromArn);
+            [accessEntry] = createAccessEntries(
+                name,
+                cluster.eksCluster.name,
+                {
+                    node: {
+                        principalArn: nodeRoleArn,
+                        type: AccessEntryType.EC2,
+                        accessPolicies: {
+                            autoNode: {
+                                policyArn: nodeRoleArn.apply(autoNodePolicyArn),
+                                accessScope: { type: "cluster" },
+                            },
+                        },
+                    },
+                },
+                { parent: this },
+            );
+        }
+
+        const spec = pulumi
+            .all([
+                role,
+                args.subnetSelectorTerms ??
+                    cluster.core.subnetIds.apply((ids) => ids.map((id) => ({ id }))),
+                args.securityGroupSelectorTerms ??
+                    cluster.eksCluster.vpcConfig.apply((c) => [{ id: c.clusterSecurityGroupId }]),
+                args.snatPolicy,
+                args.networkPolicy,
+                args.networkPolicyEventLogs,
+                args.ephemeralStorage,
+                args.tags,
+            ])
+            .apply(
+                ([
+                    role,
+                    subnetSelectorTerms,
+                    securityGroupSelectorTerms,
+                    snatPolicy,
+                    networkPolicy,
+                    networkPolicyEventLogs,
+                    ephemeralStorage,
+                    tags,
+                ]) =>
+                    nodeClassSpec({
+                        role,
+                        subnetSelectorTerms,
+                        securityGroupSelectorTerms,
+                        snatPolicy,
+                        networkPolicy,
+                        networkPolicyEventLogs,
+                        ephemeralStorage,
+                        tags,
+                    }),
+            );
+
+        const k8sProvider = new k8s.Provider(
+            `${name}-provider`,
+            { kubeconfig: cluster.kubeconfigJson },
+            { parent: this },
+        );
+
+        const nodeClass = new k8s.apiextensions.CustomResource(
+            name,
+            {
+                apiVersion: "eks.amazonaws.com/v1",
+                kind: "NodeClass",
+                spec,
+            },
+            {
+                parent: this,
+                provider: k8sProvider,
+                dependsOn: accessEntry ? [accessEntry] : undefined,
+            },
+        );
+
+        this.nodeClass = pulumi.output(nodeClass);
+        this.nodeClassName = nodeClass.metadata.name;
+        this.accessEntry = pulumi.output(accessEntry);
+        this.registerOutputs({
+            nodeClass: this.nodeClass,
+            nodeClassName: this.nodeClassName,
+            accessEntry: this.accessEntry,
+        });
+    }
+}
+
+/**
+ * AutoModeNodeRequirement restricts the instances of a node pool by a well-known label, e.g.
+ * `eks.amazonaws.com/instance-category` or `karpenter.sh/capacity-type`.
+ */
+export interface AutoModeNodeRequirement {
+    /**
+     * The label key.
+     */
+    key: pulumi.Input<string>;
+
+    /**
+     * The operator, one of `In`, `NotIn`, `Exists`, `DoesNotExist`, `Gt` and `Lt`.
+     */
+    operator: pulumi.Input<string>;
+
+    /**
+     * The label values.
+     */
+    values?: pulumi.Input<pulumi.Input<string>[]>;
+
+    /**
+     * The minimum number of distinct values the node pool has to be able to choose from.
+     */
+    minValues?: pulumi.Input<number>;
+}
+
+/**
+ * AutoModeDisruptionBudget limits the number of nodes that may be disrupted at the same time.
+ */
+export interface AutoModeDisruptionBudget {
+    /**
+     * The number or percentage of nodes that may be disrupted, e.g. `10%`. `0` blocks disruptions.
+     */
+    nodes: pulumi.Input<string>;
+
+    /**
+     * The cron schedule at which the budget becomes active, e.g. `0 9 * * mon-fri`. Requires `duration`.
+     */
+    schedule?: pulumi.Input<string>;
+
+    /**
+     * How long the budget is active after each schedule, e.g. `8h`.
+     */
+    duration?: pulumi.Input<string>;
+
+    /**
+     * The disruption reasons the budget applies to, `Underutilized`, `Empty` or `Drifted`. Defaults to all
+     * reasons.
+     */
+    reasons?: pulumi.Input<pulumi.Input<string>[]>;
+}
+
+/**
+ * AutoModeDisruption configures how nodes of a node pool are consolidated and replaced.
+ */
+export interface AutoModeDisruption {
+    /**
+     * Which nodes are consolidated, `WhenEmptyOrUnderutilized` or `WhenEmpty`. Defaults to
+     * `WhenEmptyOrUnderutilized`.
+     */
+    consolidationPolicy?: pulumi.Input<string>;
+
+    /**
+     * How long to wait after a pod was added to or removed from a node before consolidating it, e.g. `30s`, or
+     * `Never`. Defaults to `0s`.
+     */
+    consolidateAfter?: pulumi.Input<string>;
+
+    /**
+     * The disruption budgets of the node pool.
+     */
+    budgets?: pulumi.Input<pulumi.Input<AutoModeDisruptionBudget>[]>;
+}
+
+export interface AutoModeNodePoolOptions {
+    /**
+     * The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+     */
+    cluster: Cluster;
+
+    /**
+     * The name of the NodeClass of the nodes, e.g. `nodeClass.nodeClassName`. Defaults to `default`, the NodeClass
+     * of the built-in node pools.
+     */
+    nodeClassName?: pulumi.Input<string>;
+
+    /**
+     * The requirements the instances of the node pool have to fulfill.
+     */
+    requirements?: pulumi.Input<pulumi.Input<AutoModeNodeRequirement>[]>;
+
+    /**
+     * The labels of the nodes.
+     */
+    labels?: pulumi.Input<{ [key: string]: pulumi.Input<string> }>;
+
+    /**
+     * The taints of the nodes, keyed by the taint key.
+     */
+    taints?: pulumi.Input<{ [key: string]: pulumi.Input<Taint> }>;
+
+    /**
+     * The taints of the nodes that are removed by a daemon once the node is ready, keyed by the taint key.
+     */
+    startupTaints?: pulumi.Input<{ [key: string]: pulumi.Input<Taint> }>;
+
+    /**
+     * How long a node lives before it is replaced, e.g. `336h`, or `Never`. Defaults to `336h`.
+     */
+    expireAfter?: pulumi.Input<string>;
+
+    /**
+     * How long a node may take to drain before it is terminated forcefully, e.g. `24h`.
+     */
+    terminationGracePeriod?: pulumi.Input<string>;
+
+    /**
+     * The disruption settings of the node pool.
+     */
+    disruption?: pulumi.Input<AutoModeDisruption>;
+
+    /**
+     * The maximum resources of all nodes of the node pool, e.g. `{ cpu: "1000", memory: "1000Gi" }`.
+     */
+    limits?: pulumi.Input<{ [key: string]: pulumi.Input<string> }>;
+
+    /**
+     * The priority of the node pool. Node pools with a higher weight are preferred.
+     */
+    weight?: pulumi.Input<number>;
+}
+
+/**
+ * AutoModeNodePool creates an EKS Auto Mode NodePool, which defines which nodes EKS Auto Mode launches for pending
+ * pods and when it disrupts them.
+ * For more information see: https://docs.aws.amazon.com/eks/latest/userguide/create-node-pool.html
+ */
+export class AutoModeNodePool extends pulumi.ComponentResource {
+    /**
+     * The NodePool custom resource.
+     */
+    public readonly nodePool!: pulumi.Output<k8s.apiextensions.CustomResource>;
+
+    /**
+     * The name of the NodePool.
+     */
+    public readonly nodePoolName!: pulumi.Output<string>;
+
+    constructor(
+        name: string,
+        args?: AutoModeNodePoolOptions,
+        opts?: pulumi.ComponentResourceOptions,
+    ) {
+        const type = "eks:index:AutoModeNodePool";
+
+        if (opts?.urn) {
+            const props = {
+                nodePool: undefined,
+                nodePoolName: undefined,
+            };
+            super(type, name, props, opts);
+            return;
+        }
+
+        super(type, name, args, opts);
+
+        if (!args?.cluster) {
+            throw new pulumi.InputPropertyError({
+                propertyPath: "cluster",
+                reason: "the cluster is required",
+            });
+        }
+
+        const spec = pulumi
+            .all([
+                args.nodeClassName ?? defaultNodeClassName,
+                args.requirements,
+                args.labels,
+                args.taints,
+                args.startupTaints,
+                args.expireAfter,
+                args.terminationGracePeriod,
+                args.disruption,
+                args.limits,
+                args.weight,
+            ])
+            .apply(
+                ([
+                    nodeClassName,
+                    requirements,
+                    labels,
+                    taints,
+                    startupTaints,
+                    expireAfter,
+                    terminationGracePeriod,
+                    disruption,
+                    limits,
+                    weight,
+                ]) =>
+                    nodePoolSpec({
+                        nodeClassName,
+                        requirements,
+                        labels,
+                        taints,
+                        startupTaints,
+                        expireAfter,
+                        terminationGracePeriod,
+                        disruption,
+                        limits,
+                        weight,
+                    }),
+            );
+
+        const k8sProvider = new k8s.Provider(
+            `${name}-provider`,
+            { kubeconfig: args.cluster.kubeconfigJson },
+            { parent: this },
+        );
+
+        const nodePool = new k8s.apiextensions.CustomResource(
+            name,
+            {
+                apiVersion: "karpenter.sh/v1",
+                kind: "NodePool",
+                spec,
+            },
+            { parent: this, provider: k8sProvider },
+        );
+
+        this.nodePool = pulumi.output(nodePool);
+        this.nodePoolName = nodePool.metadata.name;
+        this.registerOutputs({
+            nodePool: this.nodePool,
+            nodePoolName: this.nodePoolName,
+        });
+    }
+}
+
+/**
+ * Returns the name of the IAM role with the given ARN. Role ARNs may contain a path,
+ * e.g. `arn:aws:iam::123456789012:role/path/name`.
+ *
+ * @internal
+ */
+export function roleNameFromArn(roleArn: string): string {
+    const [, resource] = roleArn.split(":role/");
+    if (!roleArn.startsWith("arn:") || !resource) {
+        throw new pulumi.InputPropertyError({
+            propertyPath: "nodeRoleArn",
+            reason: `'${roleArn}' is not the ARN of an IAM role`,
+        });
+    }
+    return resource.split("/").pop()!;
+}
+
+/**
+ * Returns the ARN of the access policy that allows Auto Mode nodes to join the cluster, in the partition of the
+ * given node role.
+ *
+ * @internal
+ */
+export function autoNodePolicyArn(nodeRoleArn: string): string {
+    const partition = nodeRoleArn.split(":")[1];
+    return `arn:${partition}:eks::aws:cluster-access-policy/AmazonEKSAutoNodePolicy`;
+}
+
+/**
+ * Returns the spec of a NodeClass. Unset settings are omitted, so EKS Auto Mode applies its defaults. The role is
+ * empty if the cluster doesn't create the node role of EKS Auto Mode and no custom node role is set.
+ *
+ * @internal
+ */
+export function nodeClassSpec(
+    args: pulumi.Unwrap<Omit<AutoModeNodeClassOptions, "cluster" | "nodeRoleArn">> & {
+        role: string;
+    },
+): Record<string, any> {
+    if (!args.role) {
+        throw new pulumi.InputPropertyError({
+            propertyPath: "nodeRoleArn",
+            reason: "a nodeRoleArn is required if the cluster doesn't create the node role of EKS Auto Mode",
+        });
+    }
+
+    return omitUndefined({
+        role: args.role,
+        subnetSelectorTerms: args.subnetSelectorTerms?.map(selectorTerm),
+        securityGroupSelectorTerms: args.securityGroupSelectorTerms?.map(selectorTerm),
+        snatPolicy: args.snatPolicy,
+        networkPolicy: args.networkPolicy,
+        networkPolicyEventLogs: args.networkPolicyEventLogs,
+        ephemeralStorage: args.ephemeralStorage
+            ? omitUndefined({
+                  size: args.ephemeralStorage.size,
+                  iops: args.ephemeralStorage.iops,
+                  throughput: args.ephemeralStorage.throughput,
+                  kmsKeyID: args.ephemeralStorage.kmsKeyId,
+              })
+            : undefined,
+        tags: args.tags,
+    });
+}
+
+/**
+ * Returns the spec of a NodePool. Unset settings are omitted, so EKS Auto Mode applies its defaults.
+ *
+ * @internal
+ */
+export function nodePoolSpec(
+    args: pulumi.Unwrap<Omit<AutoModeNodePoolOptions, "cluster">> & { nodeClassName: string },
+): Record<string, any> {
+    const toTaints = (taints: { [key: string]: pulumi.Unwrap<Taint> } | undefined) =>
+        taints
+            ? Object.entries(taints).map(([key, taint]) => ({
+                  key,
+                  value: taint.value,
+                  effect: taint.effect,
+              }))
+            : undefined;
+
+    return omitUndefined({
+        template: {
+            metadata: args.labels ? { labels: args.labels } : {},
+            spec: omitUndefined({
+                nodeClassRef: {
+                    group: "eks.amazonaws.com",
+                    kind: "NodeClass",
+                    name: args.nodeClassName,
+                },
+                requirements: (args.requirements ?? []).map((r) => omitUndefined({ ...r })),
+                taints: toTaints(args.taints),
+                startupTaints: toTaints(args.startupTaints),
+                expireAfter: args.expireAfter,
+                terminationGracePeriod: args.terminationGracePeriod,
+            }),
+        },
+        disruption: args.disruption
+            ? omitUndefined({
+                  consolidationPolicy: args.disruption.consolidationPolicy,
+                  consolidateAfter: args.disruption.consolidateAfter,
+                  budgets: args.disruption.budgets?.map((b) => omitUndefined({ ...b })),
+              })
+            : undefined,
+        limits: args.limits,
+        weight: args.weight,
+    });
+}
+
+function selectorTerm(term: pulumi.Unwrap<AutoModeSelectorTerm>): Record<string, any> {
+    return omitUndefined({ id: term.id, name: term.name, tags: term.tags });
+}
+
+function omitUndefined(obj: Record<string, any>): Record<string, any> {
+    return Object.fromEntries(Object.entries(obj).filter(([, value]) => value !== undefined));
+}
@@ -0,0 +1,133 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {
+    bottlerocketConfigToSettings,
+    mergeBottlerocketSettings,
+    validateBottlerocketConfig,
+} from "./bottlerocket";
+
+describe("validateBottlerocketConfig", () => {
+    it("should accept a valid config", () => {
+        const errors = validateBottlerocketConfig(
+            {
+                kubernetes: {
+                    nodeTaints: { dedicated: "gpu:NoSchedule", spot: ":PreferNoSchedule" },
+                    evictionHard: { "memory.available": "100Mi" },
+                    evictionSoft: { "nodefs.available": "15%" },
+                    evictionSoftGracePeriod: { "nodefs.available": "1m" },
+                    maxPods: 110,
+                    imageGcHighThresholdPercent: 85,
+                    imageGcLowThresholdPercent: 80,
+                },
+                hostContainers: { admin: { enabled: true } },
+                kernel: { sysctl: { "net.ipv4.ip_forward": "1" }, lockdown: "integrity" },
+                bootstrapContainers: {
+                    setup: { source: "example.com/setup:latest", mode: "once" },
+                },
+                containerRegistry: {
+                    mirrors: [{ registry: "docker.io", endpoints: ["https://mirror.example.com"] }],
+                },
+            },
+            "bottlerocketConfig",
+        );
+        expect(errors).toEqual([]);
+    });
+
+    it("should report unknown settings with their property path", () => {
+        const errors = validateBottlerocketConfig(
+            {
+                kubernetes: { "max-pods": 110 },
+                hostContainers: { admin: { enable: true } },
+                kernal: {},
+            } as any,
+            "bottlerocketConfig",
+        );
+        expect(errors.map((e) => e.propertyPath)).toEqual([
+            "bottlerocketConfig.kernal",
+            "bottlerocketConfig.kubernetes.max-pods",
+            "bottlerocketConfig.hostContainers.admin.enable",
+        ]);
+    });
+
+    it("should report invalid values", () => {
+        const errors = validateBottlerocketConfig(
+            {
+                kubernetes: {
+                    nodeTaints: { dedicated: "gpu" },
+                    evictionHard: { "memory.free": "100Mi" },
+                    evictionSoft: { "memory.available": "200Mi" },
+                    maxPods: 0,
+                    imageGcHighThresholdPercent: 50,
+                    imageGcLowThresholdPercent: 60,
+                },
+                kernel: { lockdown: "strict" },
+                bootstrapContainers: { setup: { source: "", mode: "sometimes" } },
+                containerRegistry: { mirrors: [{ registry: "docker.io", endpoints: [] }] },
+            },
+            "bottlerocketConfig",
+        );
+        expect(errors.map((e) => e.propertyPath)).toEqual([
+            "bottlerocketConfig.kubernetes.nodeTaints.dedicated",
+            "bottlerocketConfig.kubernetes.evictionHard.memory.free",
+            "bottlerocketConfig.kubernetes.evictionSoft.memory.available",
+            "bottlerocketConfig.kubernetes.maxPods",
+            "bottlerocketConfig.kubernetes.imageGcLowThresholdPercent",
+            "bottlerocketConfig.kernel.lockdown",
+            "bottlerocketConfig.bootstrapContainers.setup.source",
+            "bottlerocketConfig.bootstrapContainers.setup.mode",
+            "bottlerocketConfig.containerRegistry.mirrors[0].endpoints",
+        ]);
+    });
+});
+
+describe("bottlerocketConfigToSettings", () => {
+    it("should convert the config to Bottlerocket settings", () => {
+        const settings = bottlerocketConfigToSettings({
+            kubernetes: { maxPods: 110, imageGcHighThresholdPercent: 85 },
+            hostContainers: { admin: { enabled: true, userData: "dXNlcg==" } },
+            bootstrapContainers: { setup: { source: "example.com/setup:latest", essential: true } },
+            containerRegistry: {
+                mirrors: [{ registry: "docker.io", endpoints: ["https://mirror.example.com"] }],
+            },
+        });
+        expect(settings).toEqual({
+            settings: {
+                kubernetes: { "max-pods": 110, "image-gc-high-threshold-percent": 85 },
+                "host-containers": { admin: { enabled: true, "user-data": "dXNlcg==" } },
+                "bootstrap-containers": {
+                    setup: { source: "example.com/setup:latest", essential: true },
+                },
+                "container-registry": {
+                    mirrors: [{ registry: "docker.io", endpoint: ["https://mirror.example.com"] }],
+                },
+            },
+        });
+    });
+});
+
+describe("mergeBottlerocketSettings", () => {
+    it("should give the overrides precedence", () => {
+        const merged = mergeBottlerocketSettings(
+            { settings: { kubernetes: { "max-pods": 110, "node-labels": { a: "b" } } } },
+            { settings: { kubernetes: { "max-pods": 50 }, "host-containers": {} } },
+        );
+        expect(merged).toEqual({
+            settings: {
+                kubernetes: { "max-pods": 50, "node-labels": { a: "b" } },
+                "host-containers": {},
+            },
+        });
+    });
+});
@@ -0,0 +1,450 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import * as pulumi from "@pulumi/pulumi";
+
+import { isObject } from "../utilities";
+
+/**
+ * Typed configuration of the most common Bottlerocket settings.
+ * See for more details: https://bottlerocket.dev/en/os/1.20.x/api/settings/
+ */
+export interface BottlerocketConfig {
+    /**
+     * Settings of the kubelet, i.e. `settings.kubernetes`.
+     */
+    kubernetes?: BottlerocketKubernetesSettings;
+
+    /**
+     * Settings of the admin and control host containers, i.e. `settings.host-containers`.
+     */
+    hostContainers?: BottlerocketHostContainers;
+
+    /**
+     * Settings of the kernel, i.e. `settings.kernel`.
+     */
+    kernel?: BottlerocketKernelSettings;
+
+    /**
+     * Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+     */
+    bootstrapContainers?: { [name: string]: BottlerocketBootstrapContainer };
+
+    /**
+     * Settings of the container registries, i.e. `settings.container-registry`.
+     */
+    containerRegistry?: BottlerocketContainerRegistry;
+}
+
+export interface BottlerocketKubernetesSettings {
+    /**
+     * Labels the node registers with.
+     */
+    nodeLabels?: pulumi.Input<{ [key: string]: pulumi.Input<string> }>;
+
+    /**
+     * Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+     */
+    nodeTaints?: pulumi.Input<{ [key: string]: pulumi.Input<string> }>;
+
+    /**
+     * Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+     */
+    evictionHard?: pulumi.Input<{ [signal: string]: pulumi.Input<string> }>;
+
+    /**
+     * Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in
+     * `evictionSoftGracePeriod`.
+     */
+    evictionSoft?: pulumi.Input<{ [signal: string]: pulumi.Input<string> }>;
+
+    /**
+     * Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+     */
+    evictionSoftGracePeriod?: pulumi.Input<{ [signal: string]: pulumi.Input<string> }>;
+
+    /**
+     * The maximum number of pods the kubelet runs on the node.
+     */
+    maxPods?: pulumi.Input<number>;
+
+    /**
+     * The disk usage in percent after which image garbage collection always runs.
+     */
+    imageGcHighThresholdPercent?: pulumi.Input<number>;
+
+    /**
+     * The disk usage in percent before which image garbage collection never runs.
+     */
+    imageGcLowThresholdPercent?: pulumi.Input<number>;
+}
+
+export interface BottlerocketHostContainers {
+    /**
+     * The admin container, used for troubleshooting the node.
+     */
+    admin?: BottlerocketHostContainer;
+
+    /**
+     * The control container, used to access the Bottlerocket API through AWS SSM.
+     */
+    control?: BottlerocketHostContainer;
+}
+
+export interface BottlerocketHostContainer {
+    /**
+     * Whether the host container runs.
+     */
+    enabled?: pulumi.Input<boolean>;
+
+    /**
+     * Whether the host container has elevated privileges.
+     */
+    superpowered?: pulumi.Input<boolean>;
+
+    /**
+     * The image of the host container.
+     */
+    source?: pulumi.Input<string>;
+
+    /**
+     * Base64 encoded user data passed to the host container.
+     */
+    userData?: pulumi.Input<string>;
+}
+
+export interface BottlerocketKernelSettings {
+    /**
+     * Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: "1"`.
+     */
+    sysctl?: pulumi.Input<{ [key: string]: pulumi.Input<string> }>;
+
+    /**
+     * The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+     */
+    lockdown?: pulumi.Input<string>;
+}
+
+export interface BottlerocketBootstrapContainer {
+    /**
+     * The image of the bootstrap container.
+     */
+    source: pulumi.Input<string>;
+
+    /**
+     * When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+     */
+    mode?: pulumi.Input<string>;
+
+    /**
+     * Whether the node fails to boot if the bootstrap container fails.
+     */
+    essential?: pulumi.Input<boolean>;
+
+    /**
+     * Base64 encoded user data passed to the bootstrap container.
+     */
+    userData?: pulumi.Input<string>;
+}
+
+export interface BottlerocketContainerRegistry {
+    /**
+     * Mirrors to pull images from instead of the original registries.
+     */
+    mirrors?: pulumi.Input<pulumi.Input<BottlerocketRegistryMirror>[]>;
+}
+
+export interface BottlerocketRegistryMirror {
+    /**
+     * The registry to mirror, e.g. `docker.io`.
+     */
+    registry: pulumi.Input<string>;
+
+    /**
+     * The endpoints of the mirror, in order of preference.
+     */
+    endpoints: pulumi.Input<pulumi.Input<string>[]>;
+}
+
+const evictionSignals = [
+    "memory.available",
+    "nodefs.available",
+    "nodefs.inodesFree",
+    "imagefs.available",
+    "imagefs.inodesFree",
+    "pid.available",
+];
+const taintEffects = ["NoSchedule", "PreferNoSchedule", "NoExecute"];
+const bootstrapContainerModes = ["always", "once", "off"];
+const lockdownModes = ["none", "integrity", "confidentiality"];
+
+// Maps the properties of the typed config to the Bottlerocket setting names.
+const sectionKeys = {
+    config: {
+        kubernetes: "kubernetes",
+        hostContainers: "host-containers",
+        kernel: "kernel",
+        bootstrapContainers: "bootstrap-containers",
+        containerRegistry: "container-registry",
+    },
+    kubernetes: {
+        nodeLabels: "node-labels",
+        nodeTaints: "node-taints",
+        evictionHard: "eviction-hard",
+        evictionSoft: "eviction-soft",
+        evictionSoftGracePeriod: "eviction-soft-grace-period",
+        maxPods: "max-pods",
+        imageGcHighThresholdPercent: "image-gc-high-threshold-percent",
+        imageGcLowThresholdPercent: "image-gc-low-threshold-percent",
+    },
+    hostContainers: { admin: "admin", control: "control" },
+    hostContainer: {
+        enabled: "enabled",
+        superpowered: "superpowered",
+        source: "source",
+        userData: "user-data",
+    },
+    kernel: { sysctl: "sysctl", lockdown: "lockdown" },
+    bootstrapContainer: {
+        source: "source",
+        mode: "mode",
+        essential: "essential",
+        userData: "user-data",
+    },
+    containerRegistry: { mirrors: "mirrors" },
+    registryMirror: { registry: "registry", endpoints: "endpoint" },
+};
+
+/**
+ * Validates the typed Bottlerocket config. Unknown keys and invalid values are reported with their property path, so
+ * mistakes are caught during preview instead of nodes failing to join the cluster.
+ *
+ * @param config - The unwrapped Bottlerocket config.
+ * @param propertyPath - The property path of the config, used as prefix of the reported property paths.
+ * @returns The validation errors, empty if the config is valid.
+ */
+export function validateBottlerocketConfig(
+    config: pulumi.Unwrap<BottlerocketConfig> | undefined,
+    propertyPath: string,
+): pulumi.InputPropertyErrorDetails[] {
+    const errors: pulumi.InputPropertyErrorDetails[] = [];
+    if (config === undefined) {
+        return errors;
+    }
+
+    const error = (path: string, reason: string) =>
+        errors.push({ propertyPath: `${propertyPath}.${path}`, reason });
+    const checkKeys = (value: unknown, keys: object, path: string) => {
+        if (value === undefined) {
+            return false;
+        }
+        if (!isObject(value)) {
+            errors.push({
+                propertyPath: path ? `${propertyPath}.${path}` : propertyPath,
+                reason: "Expected an object.",
+            });
+            return false;
+        }
+        for (const key of Object.keys(value)) {
+            if (!(key in keys)) {
+                const prefix = path ? `${path}.` : "";
+                error(
+                    prefix + key,
+                    `Unknown Bottlerocket setting. Valid settings are: ${Object.keys(keys).join(", ")}.`,
+                );
+            }
+        }
+        return true;
+    };
+
+    if (!checkKeys(config, sectionKeys.config, "")) {
+        return errors;
+    }
+
+    const kubernetes = config.kubernetes;
+    if (checkKeys(kubernetes, sectionKeys.kubernetes, "kubernetes")) {
+        for (const [key, taint] of Object.entries(kubernetes!.nodeTaints ?? {})) {
+            const effect = taint.split(":")[1];
+            if (!taint.includes(":") || !taintEffects.includes(effect)) {
+                error(
+                    `kubernetes.nodeTaints.${key}`,
+                    `Taints must have the format 'value:effect' with one of the effects ${taintEffects.join(", ")}.`,
+                );
+            }
+        }
+        const evictionSections = [
+            "evictionHard",
+            "evictionSoft",
+            "evictionSoftGracePeriod",
+        ] as const;
+        for (const section of evictionSections) {
+            for (const signal of Object.keys(kubernetes![section] ?? {})) {
+                if (!evictionSignals.includes(signal)) {
+                    error(
+                        `kubernetes.${section}.${signal}`,
+                        `Unknown eviction signal. Valid signals are: ${evictionSignals.join(", ")}.`,
+                    );
+                }
+            }
+        }
+        for (const signal of Object.keys(kubernetes!.evictionSoft ?? {})) {
+            if (!(signal in (kubernetes!.evictionSoftGracePeriod ?? {}))) {
+                error(
+                    `kubernetes.evictionSoft.${signal}`,
+                    "Soft eviction thresholds require a grace period in evictionSoftGracePeriod.",
+                );
+            }
+        }
+        const maxPods = kubernetes!.maxPods;
+        if (maxPods !== undefined && (!Number.isInteger(maxPods) || maxPods < 1)) {
+            error("kubernetes.maxPods", "The maximum number of pods must be a positive integer.");
+        }
+        const high = kubernetes!.imageGcHighThresholdPercent;
+        const low = kubernetes!.imageGcLowThresholdPercent;
+        for (const [key, percent] of [
+            ["imageGcHighThresholdPercent", high],
+            ["imageGcLowThresholdPercent", low],
+        ] as const) {
+            if (percent === undefined) {
+                continue;
+            }
+            if (!Number.isInteger(percent) || percent < 0 || percent > 100) {
+                error(
+                    `kubernetes.${key}`,
+                    "Image garbage collection thresholds must be between 0 and 100.",
+                );
+            }
+        }
+        if (high !== undefined && low !== undefined && low >= high) {
+            error(
+                "kubernetes.imageGcLowThresholdPercent",
+                "The low image garbage collection threshold must be lower than the high threshold.",
+            );
+        }
+    }
+
+    const hostContainers = config.hostContainers;
+    if (checkKeys(hostContainers, sectionKeys.hostContainers, "hostContainers")) {
+        for (const name of ["admin", "control"] as const) {
+            checkKeys(hostContainers![name], sectionKeys.hostContainer, `hostContainers.${name}`);
+        }
+    }
+
+    const kernel = config.kernel;
+    if (checkKeys(kernel, sectionKeys.kernel, "kernel")) {
+        if (kernel!.lockdown !== undefined && !lockdownModes.includes(kernel!.lockdown)) {
+            error("kernel.lockdown", `Valid lockdown modes are: ${lockdownModes.join(", ")}.`);
+        }
+    }
+
+    for (const [name, container] of Object.entries(config.bootstrapContainers ?? {})) {
+        const path = `bootstrapContainers.${name}`;
+        if (checkKeys(container, sectionKeys.bootstrapContainer, path)) {
+            if (!container.source) {
+                error(`${path}.source`, "Bootstrap containers require a source image.");
+            }
+            if (container.mode !== undefined && !bootstrapContainerModes.includes(container.mode)) {
+                error(
+                    `${path}.mode`,
+                    `Valid bootstrap container modes are: ${bootstrapContainerModes.join(", ")}.`,
+                );
+            }
+        }
+    }
+
+    const containerRegistry = config.containerRegistry;
+    if (checkKeys(containerRegistry, sectionKeys.containerRegistry, "containerRegistry")) {
+        (containerRegistry!.mirrors ?? []).forEach((mirror, i) => {
+            const path = `containerRegistry.mirrors[${i}]`;
+            if (checkKeys(mirror, sectionKeys.registryMirror, path)) {
+                if (!mirror.registry) {
+                    error(`${path}.registry`, "Registry mirrors require the registry to mirror.");
+                }
+                if (!mirror.endpoints || mirror.endpoints.length === 0) {
+                    error(`${path}.endpoints`, "Registry mirrors require at least one endpoint.");
+                }
+            }
+        });
+    }
+
+    return errors;
+}
+
+/**
+ * Converts the typed Bottlerocket config to Bottlerocket settings, e.g. `kubernetes.maxPods` to
+ * `settings.kubernetes.max-pods`. The config is expected to be validated with `validateBottlerocketConfig`.
+ */
+export function bottlerocketConfigToSettings(config: pulumi.Unwrap<BottlerocketConfig>): object {
+    const settings: Record<string, any> = {};
+
+    if (config.kubernetes) {
+        settings.kubernetes = renameKeys(config.kubernetes, sectionKeys.kubernetes);
+    }
+    if (config.hostContainers) {
+        settings["host-containers"] = Object.fromEntries(
+            Object.entries(config.hostContainers)
+                .filter(([_, container]) => container !== undefined)
+                .map(([name, container]) => [
+                    name,
+                    renameKeys(container!, sectionKeys.hostContainer),
+                ]),
+        );
+    }
+    if (config.kernel) {
+        settings.kernel = renameKeys(config.kernel, sectionKeys.kernel);
+    }
+    if (config.bootstrapContainers) {
+        settings["bootstrap-containers"] = Object.fromEntries(
+            Object.entries(config.bootstrapContainers).map(([name, container]) => [
+                name,
+                renameKeys(container, sectionKeys.bootstrapContainer),
+            ]),
+        );
+    }
+    if (config.containerRegistry?.mirrors) {
+        settings["container-registry"] = {
+            mirrors: config.containerRegistry.mirrors.map((mirror) =>
+                renameKeys(mirror, sectionKeys.registryMirror),
+            ),
+        };
+    }
+
+    return { settings };
+}
+
+/**
+ * Merges Bottlerocket settings recursively. Values of `overrides` take precedence over the ones of `base`.
+ */
+export function mergeBottlerocketSettings(base: object, overrides: object | undefined): object {
+    if (!overrides) {
+        return base;
+    }
+
+    const result: Record<string, any> = { ...base };
+    for (const [key, value] of Object.entries(overrides)) {
+        result[key] =
+            isObject(result[key]) && isObject(value)
+                ? mergeBottlerocketSettings(result[key], value)
+                : value;
+    }
+    return result;
+}
+
+function renameKeys(obj: object, keys: { [key: string]: string }): Record<string, any> {
+    return Object.fromEntries(
+        Object.entries(obj)
+            .filter(([_, value]) => value !== undefined)
+            .map(([key, value]) => [keys[key] ?? key, value]),
+    );
+}
@@ -22,3 +22,9 @@ export {
     NodeGroupV2,
 } from "./nodegroup";
 export { NodeGroupSecurityGroup, createNodeGroupSecurityGroup } from "./securitygroup";
+export {
+    AutoModeNodeClass,
+    AutoModeNodeClassOptions,
+    AutoModeNodePool,
+    AutoModeNodePoolOptions,
+} from "./automode";
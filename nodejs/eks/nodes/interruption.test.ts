@@ -0,0 +1,97 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {
+    interruptionEventPattern,
+    interruptionEvents,
+    interruptionQueuePolicy,
+    nodeTerminationHandlerManagedTag,
+    nodeTerminationHandlerPolicy,
+    nodeTerminationHandlerValues,
+} from "./interruption";
+
+describe("interruptionEventPattern", () => {
+    it("should only match the termination lifecycle actions of the node group", () => {
+        expect(interruptionEventPattern("asg-termination", "my-asg")).toEqual({
+            source: ["aws.autoscaling"],
+            "detail-type": ["EC2 Instance-terminate Lifecycle Action"],
+            detail: {
+                AutoScalingGroupName: ["my-asg"],
+            },
+        });
+    });
+
+    it("should match Spot interruption warnings and rebalance recommendations", () => {
+        const detailTypes = interruptionEvents.map(
+            (event) => interruptionEventPattern(event, "my-asg")["detail-type"][0],
+        );
+
+        expect(detailTypes).toEqual([
+            "EC2 Instance-terminate Lifecycle Action",
+            "EC2 Spot Instance Interruption Warning",
+            "EC2 Instance Rebalance Recommendation",
+        ]);
+    });
+});
+
+describe("nodeTerminationHandlerManagedTag", () => {
+    it("should be the managed tag of the aws-node-termination-handler of the node group", () => {
+        const values = nodeTerminationHandlerValues(
+            "my-node-group",
+            "https://sqs.us-west-2.amazonaws.com/123456789012/my-queue",
+            "us-west-2",
+            "my-node-group-node-termination-handler",
+            {},
+        );
+
+        expect(values.checkTagBeforeDraining).toBe(true);
+        expect(values.managedTag).toEqual(nodeTerminationHandlerManagedTag("my-node-group"));
+    });
+
+    it("should differ between node groups", () => {
+        expect(nodeTerminationHandlerManagedTag("spot")).not.toEqual(
+            nodeTerminationHandlerManagedTag("on-demand"),
+        );
+    });
+});
+
+describe("interruptionQueuePolicy", () => {
+    it("should allow EventBridge to send messages to the queue", () => {
+        const queueArn = "arn:aws:sqs:us-west-2:123456789012:my-queue";
+
+        expect(interruptionQueuePolicy(queueArn).Statement).toEqual([
+            {
+                Effect: "Allow",
+                Principal: {
+                    Service: ["events.amazonaws.com", "sqs.amazonaws.com"],
+                },
+                Action: "sqs:SendMessage",
+                Resource: queueArn,
+            },
+        ]);
+    });
+});
+
+describe("nodeTerminationHandlerPolicy", () => {
+    it("should only allow receiving messages from the queue of the node group", () => {
+        const queueArn = "arn:aws:sqs:us-west-2:123456789012:my-queue";
+        const statements = nodeTerminationHandlerPolicy(queueArn).Statement as any[];
+
+        expect(statements).toContainEqual({
+            Effect: "Allow",
+            Action: ["sqs:DeleteMessage", "sqs:ReceiveMessage"],
+            Resource: queueArn,
+        });
+    });
+});
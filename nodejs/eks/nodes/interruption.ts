@@ -0,0 +1,316 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import * as pulumi from "@pulumi/pulumi";
+import * as aws from "@pulumi/aws";
+import * as k8s from "@pulumi/kubernetes";
+import { createControllerRole, mergeValues, serviceAccountAnnotations } from "../addons/controller";
+import { CoreData } from "../cluster";
+import { getRegionFromArn } from "../utilities";
+
+/**
+ * The version of the aws-node-termination-handler Helm chart that is installed if `chartVersion` is not set.
+ */
+export const defaultNodeTerminationHandlerChartVersion = "0.27.0";
+
+const chart = "oci://public.ecr.aws/aws-ec2/helm/aws-node-termination-handler";
+
+/**
+ * Returns the tag that marks the instances of a node group whose interruptions are handled by its
+ * aws-node-termination-handler. Every node group uses its own tag, because Spot interruption warnings and rebalance
+ * recommendations are delivered to the queues of all node groups.
+ *
+ * @internal
+ */
+export function nodeTerminationHandlerManagedTag(name: string): string {
+    return `aws-node-termination-handler/${name}`;
+}
+
+/**
+ * InterruptionHandling configures how a node group handles Spot interruptions and Auto Scaling Group termination
+ * events. The events are delivered to an SQS queue, which aws-node-termination-handler processes in queue mode to
+ * cordon and drain the affected nodes before their instances are terminated.
+ * See for more details: https://github.com/aws/aws-node-termination-handler#queue-processor
+ */
+export interface InterruptionHandling {
+    /**
+     * The namespace to install aws-node-termination-handler into. Defaults to `kube-system`.
+     */
+    namespace?: pulumi.Input<string>;
+
+    /**
+     * The name of the Kubernetes service account of aws-node-termination-handler. Defaults to
+     * `<name>-node-termination-handler`, where `<name>` is the name of the node group.
+     */
+    serviceAccountName?: pulumi.Input<string>;
+
+    /**
+     * The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+     *
+     * If set, aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA).
+     * Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the
+     * `eks-pod-identity-agent` add-on to be installed on the cluster.
+     */
+    oidcProviderArn?: pulumi.Input<string>;
+
+    /**
+     * The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+     */
+    chartVersion?: pulumi.Input<string>;
+
+    /**
+     * Custom values for the Helm chart. The values are merged with the values set by the component, which are
+     * `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+     */
+    values?: pulumi.Input<{ [key: string]: any }>;
+
+    /**
+     * The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it
+     * is terminated anyway. Defaults to 300.
+     */
+    heartbeatTimeout?: pulumi.Input<number>;
+}
+
+/**
+ * Creates the resources that handle the interruptions of the instances of an Auto Scaling Group: an SQS queue, the
+ * EventBridge rules that deliver Spot interruption warnings, rebalance recommendations and termination lifecycle
+ * actions to the queue, the termination lifecycle hook of the group and aws-node-termination-handler, which drains
+ * the nodes.
+ *
+ * @internal
+ */
+export function createInterruptionHandling(
+    name: string,
+    args: InterruptionHandling,
+    autoScalingGroup: aws.autoscaling.Group,
+    core: pulumi.Output<pulumi.Unwrap<CoreData>>,
+    parent: pulumi.ComponentResource,
+    provider?: pulumi.ProviderResource,
+): k8s.helm.v3.Release {
+    const queue = new aws.sqs.Queue(
+        `${name}-interruption-queue`,
+        {
+            messageRetentionSeconds: 300,
+            sqsManagedSseEnabled: true,
+        },
+        { parent, provider },
+    );
+
+    new aws.sqs.QueuePolicy(
+        `${name}-interruption-queue-policy`,
+        {
+            queueUrl: queue.url,
+            policy: queue.arn.apply((arn) => JSON.stringify(interruptionQueuePolicy(arn))),
+        },
+        { parent, provider },
+    );
+
+    const targets = interruptionEvents.map((event) => {
+        const rule = new aws.cloudwatch.EventRule(
+            `${name}-${event}`,
+            {
+                eventPattern: autoScalingGroup.name.apply((asgName) =>
+                    JSON.stringify(interruptionEventPattern(event, asgName)),
+                ),
+            },
+            { parent, provider },
+        );
+        return new aws.cloudwatch.EventTarget(
+            `${name}-${event}`,
+            { rule: rule.name, arn: queue.arn },
+            { parent, provider },
+        );
+    });
+
+    const hook = new aws.autoscaling.LifecycleHook(
+        `${name}-termination-hook`,
+        {
+            autoscalingGroupName: autoScalingGroup.name,
+            lifecycleTransition: "autoscaling:EC2_INSTANCE_TERMINATING",
+            defaultResult: "CONTINUE",
+            heartbeatTimeout: args.heartbeatTimeout ?? 300,
+        },
+        { parent, provider },
+    );
+
+    const namespace = args.namespace ?? "kube-system";
+    const serviceAccountName = args.serviceAccountName ?? `${name}-node-termination-handler`;
+
+    const { role, dependencies } = createControllerRole(
+        `${name}-node-termination-handler`,
+        {
+            clusterName: core.cluster.name,
+            namespace,
+            serviceAccountName,
+            oidcProviderArn: args.oidcProviderArn,
+            description: "Permissions of aws-node-termination-handler",
+            policy: queue.arn.apply(nodeTerminationHandlerPolicy),
+        },
+        parent,
+        provider,
+    );
+
+    const values = pulumi
+        .all([args.values, core.cluster.arn, queue.url, serviceAccountName, role.arn])
+        .apply(([values, clusterArn, queueUrl, sa, roleArn]) =>
+            mergeValues(
+                nodeTerminationHandlerValues(
+                    name,
+                    queueUrl,
+                    getRegionFromArn(clusterArn),
+                    sa,
+                    serviceAccountAnnotations(args, roleArn),
+                ),
+                values,
+            ),
+        );
+
+    const k8sProvider = new k8s.Provider(
+        `${name}-node-termination-handler-provider`,
+        { kubeconfig: pulumi.jsonStringify(core.kubeconfig) },
+        { parent },
+    );
+
+    return new k8s.helm.v3.Release(
+        `${name}-node-termination-handler`,
+        {
+            chart,
+            version: args.chartVersion ?? defaultNodeTerminationHandlerChartVersion,
+            namespace,
+            values,
+        },
+        {
+            parent,
+            provider: k8sProvider,
+            dependsOn: [...dependencies, ...targets, hook],
+        },
+    );
+}
+
+/**
+ * Returns the values of the aws-node-termination-handler Helm chart that are set by the component. The handler only
+ * drains the instances that carry the managed tag of its node group.
+ *
+ * @internal
+ */
+export function nodeTerminationHandlerValues(
+    name: string,
+    queueUrl: string,
+    region: string,
+    serviceAccountName: string,
+    annotations: { [key: string]: string },
+): { [key: string]: any } {
+    return {
+        enableSqsTerminationDraining: true,
+        queueURL: queueUrl,
+        awsRegion: region,
+        checkTagBeforeDraining: true,
+        managedTag: nodeTerminationHandlerManagedTag(name),
+        serviceAccount: {
+            create: true,
+            name: serviceAccountName,
+            annotations,
+        },
+    };
+}
+
+/**
+ * The events that are delivered to the interruption queue of a node group.
+ *
+ * @internal
+ */
+export const interruptionEvents = ["asg-termination", "spot-interruption", "rebalance"] as const;
+
+/**
+ * Returns the EventBridge event pattern of an event that is delivered to the interruption queue of a node group.
+ * Spot interruption warnings and rebalance recommendations cannot be filtered by Auto Scaling Group,
+ * aws-node-termination-handler ignores the events of instances that aren't tagged as managed by it.
+ *
+ * @internal
+ */
+export function interruptionEventPattern(
+    event: (typeof interruptionEvents)[number],
+    autoScalingGroupName: string,
+): any {
+    switch (event) {
+        case "asg-termination":
+            return {
+                source: ["aws.autoscaling"],
+                "detail-type": ["EC2 Instance-terminate Lifecycle Action"],
+                detail: {
+                    AutoScalingGroupName: [autoScalingGroupName],
+                },
+            };
+        case "spot-interruption":
+            return {
+                source: ["aws.ec2"],
+                "detail-type": ["EC2 Spot Instance Interruption Warning"],
+            };
+        case "rebalance":
+            return {
+                source: ["aws.ec2"],
+                "detail-type": ["EC2 Instance Rebalance Recommendation"],
+            };
+    }
+}
+
+/**
+ * Returns the policy of the interruption queue, which allows EventBridge to deliver events to the queue.
+ *
+ * @internal
+ */
+export function interruptionQueuePolicy(queueArn: string): aws.iam.PolicyDocument {
+    return {
+        Version: "2012-10-17",
+        Statement: [
+            {
+                Effect: "Allow",
+                Principal: {
+                    Service: ["events.amazonaws.com", "sqs.amazonaws.com"],
+                },
+                Action: "sqs:SendMessage",
+                Resource: queueArn,
+            },
+        ],
+    };
+}
+
+/**
+ * Returns the IAM policy of aws-node-termination-handler in queue mode.
+ * See https://github.com/aws/aws-node-termination-handler/blob/main/docs/queue-processor-mode.md#set-up-iam-policy
+ *
+ * @internal
+ */
+export function nodeTerminationHandlerPolicy(queueArn: string): aws.iam.PolicyDocument {
+    return {
+        Version: "2012-10-17",
+        Statement: [
+            {
+                Effect: "Allow",
+                Action: [
+                    "autoscaling:CompleteLifecycleAction",
+                    "autoscaling:DescribeAutoScalingInstances",
+                    "autoscaling:DescribeTags",
+                    "ec2:DescribeInstances",
+                ],
+                Resource: "*",
+            },
+            {
+                Effect: "Allow",
+                Action: ["sqs:DeleteMessage", "sqs:ReceiveMessage"],
+                Resource: queueArn,
+            },
+        ],
+    };
+}
@@ -0,0 +1,133 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import * as pulumi from "@pulumi/pulumi";
+import * as fs from "fs";
+import * as path from "path";
+import {
+    computeMaxPods,
+    computeMaxPodsForInstanceTypes,
+    instanceLimits,
+    prefixDelegationMaxPods,
+} from "./maxpods";
+
+describe("computeMaxPods", () => {
+    test.each([
+        ["m5.large", false, false, 29],
+        ["t3.medium", false, false, 17],
+        ["m5.24xlarge", false, false, 250],
+        ["m5.large", false, true, 20],
+        ["m5.large", true, false, 110],
+        ["t3.nano", true, false, 34],
+        ["t3.micro", true, true, 18],
+        ["c5.9xlarge", true, false, 250],
+    ])(
+        "%s with prefix delegation %s and custom networking %s should run %d pods",
+        (instanceType, prefixDelegation, customNetworking, expected) => {
+            expect(computeMaxPods(instanceType, { prefixDelegation, customNetworking })).toBe(
+                expected,
+            );
+        },
+    );
+
+    it("should return undefined for unknown instance types", () => {
+        const networking = { prefixDelegation: false, customNetworking: false };
+        expect(computeMaxPods("x9.unknown", networking)).toBeUndefined();
+    });
+
+    it("should return undefined for prefix delegation on Xen instance types", () => {
+        const networking = { prefixDelegation: true, customNetworking: false };
+        expect(computeMaxPods("m4.large", networking)).toBeUndefined();
+    });
+
+    it("should reject warm prefix targets the instance type can't hold", () => {
+        const networking = { prefixDelegation: true, customNetworking: false };
+        expect(computeMaxPods("t3.nano", { ...networking, warmPrefixTarget: 2 })).toBe(34);
+        expect(() => computeMaxPods("t3.nano", { ...networking, warmPrefixTarget: 3 })).toThrow(
+            "warm prefix target 3 exceeds the 2 prefixes instance type t3.nano can hold",
+        );
+    });
+});
+
+describe("computeMaxPodsForInstanceTypes", () => {
+    it("should return the lowest value of the instance types", () => {
+        const networking = { prefixDelegation: true, customNetworking: false };
+        expect(computeMaxPodsForInstanceTypes(["t3.nano", "m5.large"], networking)).toBe(34);
+    });
+
+    it("should return undefined if any instance type is unknown", () => {
+        const networking = { prefixDelegation: true, customNetworking: false };
+        const instanceTypes = ["m5.large", "x9.unknown"];
+        expect(computeMaxPodsForInstanceTypes(instanceTypes, networking)).toBeUndefined();
+    });
+});
+
+describe("prefixDelegationMaxPods", () => {
+    it("should only compute the max pods with prefix delegation", () => {
+        const withoutPrefixDelegation = { prefixDelegation: false, customNetworking: true };
+        const withPrefixDelegation = { prefixDelegation: true, customNetworking: true };
+
+        expect(prefixDelegationMaxPods(["m5.large"], undefined)).toBeUndefined();
+        expect(prefixDelegationMaxPods(["m5.large"], withoutPrefixDelegation)).toBeUndefined();
+        expect(prefixDelegationMaxPods(["m5.large"], withPrefixDelegation)).toBe(110);
+    });
+
+    it("should warn if the max pods of an instance type are unknown", () => {
+        const warn = jest.spyOn(pulumi.log, "warn").mockImplementation(() => Promise.resolve());
+        const networking = { prefixDelegation: true, customNetworking: false };
+
+        expect(prefixDelegationMaxPods(["m5.large", "x9.unknown"], networking)).toBeUndefined();
+        expect(warn).toHaveBeenCalledWith(
+            expect.stringContaining("for instance types x9.unknown:"),
+            undefined,
+        );
+        warn.mockRestore();
+    });
+
+    it("should fail if the warm prefix target exceeds the prefixes of an instance type", () => {
+        const networking = { prefixDelegation: true, customNetworking: false, warmPrefixTarget: 3 };
+        expect(() => prefixDelegationMaxPods(["t3.nano"], networking)).toThrow(
+            "warm prefix target 3 exceeds the 2 prefixes instance type t3.nano can hold",
+        );
+    });
+});
+
+describe("instanceLimits", () => {
+    it("should match the limits of the Go SDK", () => {
+        const goLimits = fs
+            .readFileSync(path.join(__dirname, "../../../sdk/go/eks/maxpods/limits.txt"), "utf8")
+            .split("\n")
+            .map((line) => line.trim())
+            .filter((line) => line !== "" && !line.startsWith("#"))
+            .map((line) => line.split(/\s+/))
+            .reduce((acc, [instanceType, enis, ipv4PerEni, vcpus, hypervisor]) => {
+                acc[instanceType] = [Number(enis), Number(ipv4PerEni), Number(vcpus), hypervisor];
+                return acc;
+            }, {} as { [instanceType: string]: [number, number, number, string] });
+
+        expect(instanceLimits).toEqual(goLimits);
+    });
+
+    test.each([
+        "c8g.large",
+        "m8g.xlarge",
+        "r8g.medium",
+        "i4i.2xlarge",
+        "g6.xlarge",
+        "inf2.xlarge",
+        "trn1.2xlarge",
+    ])("should contain %s", (instanceType) => {
+        expect(instanceLimits[instanceType]).toBeDefined();
+    });
+});
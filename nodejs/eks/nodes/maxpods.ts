@@ -0,0 +1,626 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import * as pulumi from "@pulumi/pulumi";
+
+/**
+ * The VPC CNI features that affect the maximum number of pods of a node.
+ */
+export interface VpcCniNetworking {
+    prefixDelegation: boolean;
+    customNetworking: boolean;
+    // The number of prefixes the VPC CNI keeps available on a node. It doesn't reduce the number of pods, but it
+    // can't exceed the number of prefixes a node can hold.
+    warmPrefixTarget?: number;
+}
+
+// the number of IP addresses of the /28 prefixes assigned to network interfaces with prefix delegation
+const ipv4PrefixSize = 16;
+// aws-node and kube-proxy use the host network and don't need an IP address of the VPC CNI
+const hostNetworkPods = 2;
+
+/**
+ * Computes the maximum number of pods for nodes of the given instance type, i.e. the value of the kubelet's
+ * `--max-pods` flag. The calculation follows the max-pods calculator of the EKS AMIs and is capped at 110 pods for
+ * instances with less than 30 vCPUs and 250 pods otherwise.
+ * See: https://github.com/awslabs/amazon-eks-ami/blob/main/templates/al2/runtime/max-pods-calculator.sh
+ *
+ * This is the equivalent of the `maxpods` package of the Go SDK.
+ *
+ * @returns The maximum number of pods, or undefined if the instance type is unknown or doesn't support prefix
+ * delegation while it's enabled.
+ * @throws {Error} If the warm prefix target exceeds the number of prefixes the instance type can hold.
+ */
+export function computeMaxPods(
+    instanceType: string,
+    networking: VpcCniNetworking,
+): number | undefined {
+    const limits = instanceLimits[instanceType];
+    if (!limits) {
+        return undefined;
+    }
+
+    const [enis, ipv4PerEni, vcpus, hypervisor] = limits;
+    if (networking.prefixDelegation && hypervisor !== "nitro") {
+        return undefined;
+    }
+
+    // the primary network interface isn't used for pods with custom networking
+    const podEnis = networking.customNetworking ? enis - 1 : enis;
+    // the primary IP address of every network interface can't be assigned to pods
+    const slots = podEnis * (ipv4PerEni - 1);
+    if (slots <= 0) {
+        return undefined;
+    }
+
+    const warmPrefixTarget = networking.warmPrefixTarget ?? 0;
+    if (networking.prefixDelegation && warmPrefixTarget > slots) {
+        throw new Error(
+            `warm prefix target ${warmPrefixTarget} exceeds the ${slots} prefixes instance type ${instanceType} can hold`,
+        );
+    }
+
+    const addressesPerSlot = networking.prefixDelegation ? ipv4PrefixSize : 1;
+    const maxPods = slots * addressesPerSlot + hostNetworkPods;
+    return Math.min(maxPods, vcpus < 30 ? 110 : 250);
+}
+
+/**
+ * Computes the maximum number of pods that nodes of all the given instance types can run. This is the lowest value
+ * of the instance types, or undefined if it's unknown for any of them.
+ */
+export function computeMaxPodsForInstanceTypes(
+    instanceTypes: string[],
+    networking: VpcCniNetworking,
+): number | undefined {
+    const values = instanceTypes.map((instanceType) => computeMaxPods(instanceType, networking));
+    if (values.length === 0 || values.some((value) => value === undefined)) {
+        return undefined;
+    }
+    return Math.min(...(values as number[]));
+}
+
+/**
+ * Computes the maximum number of pods for nodes of the given instance types if prefix delegation is enabled. The AMIs
+ * are not aware of prefix delegation and would limit the nodes to the number of pods without it. Otherwise the AMIs
+ * compute the maximum number of pods themselves, so undefined is returned.
+ *
+ * A warning is logged if the maximum number of pods cannot be computed for any of the instance types, because the
+ * nodes then fall back to the value of the AMI.
+ *
+ * @throws {pulumi.ResourceError} If the warm prefix target exceeds the number of prefixes an instance type can hold.
+ */
+export function prefixDelegationMaxPods(
+    instanceTypes: string[],
+    networking: VpcCniNetworking | undefined,
+    parent?: pulumi.Resource,
+): number | undefined {
+    if (!networking?.prefixDelegation) {
+        return undefined;
+    }
+
+    let maxPods: number | undefined;
+    try {
+        maxPods = computeMaxPodsForInstanceTypes(instanceTypes, networking);
+    } catch (e) {
+        throw new pulumi.ResourceError(
+            `Cannot compute the maximum number of pods with prefix delegation: ${(e as Error).message}.`,
+            parent,
+        );
+    }
+
+    if (maxPods === undefined) {
+        const unsupported = instanceTypes.filter(
+            (instanceType) => computeMaxPods(instanceType, networking) === undefined,
+        );
+        pulumi.log.warn(
+            `Cannot compute the maximum number of pods with prefix delegation for instance types ` +
+                `${unsupported.join(", ")}: they are either unknown or not built on the Nitro System. The nodes ` +
+                `use the maximum number of pods of the AMI, which does not account for prefix delegation. Set ` +
+                `'--max-pods' in 'kubeletExtraArgs' to override it.`,
+            parent,
+        );
+    }
+    return maxPods;
+}
+
+/**
+ * The network interface limits of EC2 instance types: the maximum number of network interfaces, the IPv4 addresses
+ * per network interface, the default number of vCPUs and the hypervisor.
+ *
+ * Keep in sync with sdk/go/eks/maxpods/limits.txt, maxpods.test.ts checks that both tables match.
+ */
+export const instanceLimits: { [instanceType: string]: [number, number, number, "nitro" | "xen"] } = {
+    "c4.2xlarge": [4, 15, 8, "xen"],
+    "c4.4xlarge": [8, 30, 16, "xen"],
+    "c4.8xlarge": [8, 30, 36, "xen"],
+    "c4.large": [3, 10, 2, "xen"],
+    "c4.xlarge": [4, 15, 4, "xen"],
+    "c5.12xlarge": [8, 30, 48, "nitro"],
+    "c5.18xlarge": [15, 50, 72, "nitro"],
+    "c5.24xlarge": [15, 50, 96, "nitro"],
+    "c5.2xlarge": [4, 15, 8, "nitro"],
+    "c5.4xlarge": [8, 30, 16, "nitro"],
+    "c5.9xlarge": [8, 30, 36, "nitro"],
+    "c5.large": [3, 10, 2, "nitro"],
+    "c5.metal": [15, 50, 96, "nitro"],
+    "c5.xlarge": [4, 15, 4, "nitro"],
+    "c5d.12xlarge": [8, 30, 48, "nitro"],
+    "c5d.18xlarge": [15, 50, 72, "nitro"],
+    "c5d.24xlarge": [15, 50, 96, "nitro"],
+    "c5d.2xlarge": [4, 15, 8, "nitro"],
+    "c5d.4xlarge": [8, 30, 16, "nitro"],
+    "c5d.9xlarge": [8, 30, 36, "nitro"],
+    "c5d.large": [3, 10, 2, "nitro"],
+    "c5d.metal": [15, 50, 96, "nitro"],
+    "c5d.xlarge": [4, 15, 4, "nitro"],
+    "c5n.18xlarge": [15, 50, 72, "nitro"],
+    "c5n.2xlarge": [4, 15, 8, "nitro"],
+    "c5n.4xlarge": [8, 30, 16, "nitro"],
+    "c5n.9xlarge": [8, 30, 36, "nitro"],
+    "c5n.large": [3, 10, 2, "nitro"],
+    "c5n.metal": [15, 50, 72, "nitro"],
+    "c5n.xlarge": [4, 15, 4, "nitro"],
+    "c6a.12xlarge": [8, 30, 48, "nitro"],
+    "c6a.16xlarge": [15, 50, 64, "nitro"],
+    "c6a.24xlarge": [15, 50, 96, "nitro"],
+    "c6a.2xlarge": [4, 15, 8, "nitro"],
+    "c6a.32xlarge": [15, 50, 128, "nitro"],
+    "c6a.48xlarge": [15, 50, 192, "nitro"],
+    "c6a.4xlarge": [8, 30, 16, "nitro"],
+    "c6a.8xlarge": [8, 30, 32, "nitro"],
+    "c6a.large": [3, 10, 2, "nitro"],
+    "c6a.metal": [15, 50, 192, "nitro"],
+    "c6a.xlarge": [4, 15, 4, "nitro"],
+    "c6g.12xlarge": [8, 30, 48, "nitro"],
+    "c6g.16xlarge": [15, 50, 64, "nitro"],
+    "c6g.2xlarge": [4, 15, 8, "nitro"],
+    "c6g.4xlarge": [8, 30, 16, "nitro"],
+    "c6g.8xlarge": [8, 30, 32, "nitro"],
+    "c6g.large": [3, 10, 2, "nitro"],
+    "c6g.medium": [2, 4, 1, "nitro"],
+    "c6g.metal": [15, 50, 64, "nitro"],
+    "c6g.xlarge": [4, 15, 4, "nitro"],
+    "c6gd.12xlarge": [8, 30, 48, "nitro"],
+    "c6gd.16xlarge": [15, 50, 64, "nitro"],
+    "c6gd.2xlarge": [4, 15, 8, "nitro"],
+    "c6gd.4xlarge": [8, 30, 16, "nitro"],
+    "c6gd.8xlarge": [8, 30, 32, "nitro"],
+    "c6gd.large": [3, 10, 2, "nitro"],
+    "c6gd.medium": [2, 4, 1, "nitro"],
+    "c6gd.metal": [15, 50, 64, "nitro"],
+    "c6gd.xlarge": [4, 15, 4, "nitro"],
+    "c6i.12xlarge": [8, 30, 48, "nitro"],
+    "c6i.16xlarge": [15, 50, 64, "nitro"],
+    "c6i.24xlarge": [15, 50, 96, "nitro"],
+    "c6i.2xlarge": [4, 15, 8, "nitro"],
+    "c6i.32xlarge": [15, 50, 128, "nitro"],
+    "c6i.4xlarge": [8, 30, 16, "nitro"],
+    "c6i.8xlarge": [8, 30, 32, "nitro"],
+    "c6i.large": [3, 10, 2, "nitro"],
+    "c6i.metal": [15, 50, 128, "nitro"],
+    "c6i.xlarge": [4, 15, 4, "nitro"],
+    "c6id.12xlarge": [8, 30, 48, "nitro"],
+    "c6id.16xlarge": [15, 50, 64, "nitro"],
+    "c6id.24xlarge": [15, 50, 96, "nitro"],
+    "c6id.2xlarge": [4, 15, 8, "nitro"],
+    "c6id.32xlarge": [15, 50, 128, "nitro"],
+    "c6id.4xlarge": [8, 30, 16, "nitro"],
+    "c6id.8xlarge": [8, 30, 32, "nitro"],
+    "c6id.large": [3, 10, 2, "nitro"],
+    "c6id.metal": [15, 50, 128, "nitro"],
+    "c6id.xlarge": [4, 15, 4, "nitro"],
+    "c7a.12xlarge": [8, 30, 48, "nitro"],
+    "c7a.16xlarge": [15, 50, 64, "nitro"],
+    "c7a.24xlarge": [15, 50, 96, "nitro"],
+    "c7a.2xlarge": [4, 15, 8, "nitro"],
+    "c7a.32xlarge": [15, 50, 128, "nitro"],
+    "c7a.48xlarge": [15, 50, 192, "nitro"],
+    "c7a.4xlarge": [8, 30, 16, "nitro"],
+    "c7a.8xlarge": [8, 30, 32, "nitro"],
+    "c7a.large": [3, 10, 2, "nitro"],
+    "c7a.metal": [15, 50, 192, "nitro"],
+    "c7a.xlarge": [4, 15, 4, "nitro"],
+    "c7g.12xlarge": [8, 30, 48, "nitro"],
+    "c7g.16xlarge": [15, 50, 64, "nitro"],
+    "c7g.2xlarge": [4, 15, 8, "nitro"],
+    "c7g.4xlarge": [8, 30, 16, "nitro"],
+    "c7g.8xlarge": [8, 30, 32, "nitro"],
+    "c7g.large": [3, 10, 2, "nitro"],
+    "c7g.medium": [2, 4, 1, "nitro"],
+    "c7g.metal": [15, 50, 64, "nitro"],
+    "c7g.xlarge": [4, 15, 4, "nitro"],
+    "c7gd.12xlarge": [8, 30, 48, "nitro"],
+    "c7gd.16xlarge": [15, 50, 64, "nitro"],
+    "c7gd.2xlarge": [4, 15, 8, "nitro"],
+    "c7gd.4xlarge": [8, 30, 16, "nitro"],
+    "c7gd.8xlarge": [8, 30, 32, "nitro"],
+    "c7gd.large": [3, 10, 2, "nitro"],
+    "c7gd.medium": [2, 4, 1, "nitro"],
+    "c7gd.metal": [15, 50, 64, "nitro"],
+    "c7gd.xlarge": [4, 15, 4, "nitro"],
+    "c7i.12xlarge": [8, 30, 48, "nitro"],
+    "c7i.16xlarge": [15, 50, 64, "nitro"],
+    "c7i.24xlarge": [15, 50, 96, "nitro"],
+    "c7i.2xlarge": [4, 15, 8, "nitro"],
+    "c7i.48xlarge": [15, 50, 192, "nitro"],
+    "c7i.4xlarge": [8, 30, 16, "nitro"],
+    "c7i.8xlarge": [8, 30, 32, "nitro"],
+    "c7i.large": [3, 10, 2, "nitro"],
+    "c7i.metal-24xl": [15, 50, 96, "nitro"],
+    "c7i.metal-48xl": [15, 50, 192, "nitro"],
+    "c7i.xlarge": [4, 15, 4, "nitro"],
+    "c8g.12xlarge": [8, 30, 48, "nitro"],
+    "c8g.16xlarge": [15, 50, 64, "nitro"],
+    "c8g.24xlarge": [15, 50, 96, "nitro"],
+    "c8g.2xlarge": [4, 15, 8, "nitro"],
+    "c8g.48xlarge": [15, 50, 192, "nitro"],
+    "c8g.4xlarge": [8, 30, 16, "nitro"],
+    "c8g.8xlarge": [8, 30, 32, "nitro"],
+    "c8g.large": [3, 10, 2, "nitro"],
+    "c8g.medium": [2, 4, 1, "nitro"],
+    "c8g.xlarge": [4, 15, 4, "nitro"],
+    "g4dn.12xlarge": [8, 30, 48, "nitro"],
+    "g4dn.16xlarge": [4, 15, 64, "nitro"],
+    "g4dn.2xlarge": [3, 10, 8, "nitro"],
+    "g4dn.4xlarge": [3, 10, 16, "nitro"],
+    "g4dn.8xlarge": [4, 15, 32, "nitro"],
+    "g4dn.metal": [15, 50, 96, "nitro"],
+    "g4dn.xlarge": [3, 10, 4, "nitro"],
+    "g5.12xlarge": [15, 50, 48, "nitro"],
+    "g5.16xlarge": [8, 30, 64, "nitro"],
+    "g5.24xlarge": [15, 50, 96, "nitro"],
+    "g5.2xlarge": [4, 15, 8, "nitro"],
+    "g5.48xlarge": [7, 50, 192, "nitro"],
+    "g5.4xlarge": [8, 30, 16, "nitro"],
+    "g5.8xlarge": [8, 30, 32, "nitro"],
+    "g5.xlarge": [4, 15, 4, "nitro"],
+    "g6.12xlarge": [8, 30, 48, "nitro"],
+    "g6.16xlarge": [15, 50, 64, "nitro"],
+    "g6.24xlarge": [15, 50, 96, "nitro"],
+    "g6.2xlarge": [4, 15, 8, "nitro"],
+    "g6.48xlarge": [15, 50, 192, "nitro"],
+    "g6.4xlarge": [8, 30, 16, "nitro"],
+    "g6.8xlarge": [8, 30, 32, "nitro"],
+    "g6.xlarge": [4, 15, 4, "nitro"],
+    "i4i.12xlarge": [8, 30, 48, "nitro"],
+    "i4i.16xlarge": [15, 50, 64, "nitro"],
+    "i4i.24xlarge": [15, 50, 96, "nitro"],
+    "i4i.2xlarge": [4, 15, 8, "nitro"],
+    "i4i.32xlarge": [15, 50, 128, "nitro"],
+    "i4i.4xlarge": [8, 30, 16, "nitro"],
+    "i4i.8xlarge": [8, 30, 32, "nitro"],
+    "i4i.large": [3, 10, 2, "nitro"],
+    "i4i.metal": [15, 50, 128, "nitro"],
+    "i4i.xlarge": [4, 15, 4, "nitro"],
+    "inf2.24xlarge": [15, 50, 96, "nitro"],
+    "inf2.48xlarge": [15, 50, 192, "nitro"],
+    "inf2.8xlarge": [4, 15, 32, "nitro"],
+    "inf2.xlarge": [4, 15, 4, "nitro"],
+    "m4.10xlarge": [8, 30, 40, "xen"],
+    "m4.16xlarge": [8, 30, 64, "xen"],
+    "m4.2xlarge": [4, 15, 8, "xen"],
+    "m4.4xlarge": [8, 30, 16, "xen"],
+    "m4.large": [2, 10, 2, "xen"],
+    "m4.xlarge": [4, 15, 4, "xen"],
+    "m5.12xlarge": [8, 30, 48, "nitro"],
+    "m5.16xlarge": [15, 50, 64, "nitro"],
+    "m5.24xlarge": [15, 50, 96, "nitro"],
+    "m5.2xlarge": [4, 15, 8, "nitro"],
+    "m5.4xlarge": [8, 30, 16, "nitro"],
+    "m5.8xlarge": [8, 30, 32, "nitro"],
+    "m5.large": [3, 10, 2, "nitro"],
+    "m5.metal": [15, 50, 96, "nitro"],
+    "m5.xlarge": [4, 15, 4, "nitro"],
+    "m5a.12xlarge": [8, 30, 48, "nitro"],
+    "m5a.16xlarge": [15, 50, 64, "nitro"],
+    "m5a.24xlarge": [15, 50, 96, "nitro"],
+    "m5a.2xlarge": [4, 15, 8, "nitro"],
+    "m5a.4xlarge": [8, 30, 16, "nitro"],
+    "m5a.8xlarge": [8, 30, 32, "nitro"],
+    "m5a.large": [3, 10, 2, "nitro"],
+    "m5a.xlarge": [4, 15, 4, "nitro"],
+    "m5d.12xlarge": [8, 30, 48, "nitro"],
+    "m5d.16xlarge": [15, 50, 64, "nitro"],
+    "m5d.24xlarge": [15, 50, 96, "nitro"],
+    "m5d.2xlarge": [4, 15, 8, "nitro"],
+    "m5d.4xlarge": [8, 30, 16, "nitro"],
+    "m5d.8xlarge": [8, 30, 32, "nitro"],
+    "m5d.large": [3, 10, 2, "nitro"],
+    "m5d.metal": [15, 50, 96, "nitro"],
+    "m5d.xlarge": [4, 15, 4, "nitro"],
+    "m5n.12xlarge": [8, 30, 48, "nitro"],
+    "m5n.16xlarge": [15, 50, 64, "nitro"],
+    "m5n.24xlarge": [15, 50, 96, "nitro"],
+    "m5n.2xlarge": [4, 15, 8, "nitro"],
+    "m5n.4xlarge": [8, 30, 16, "nitro"],
+    "m5n.8xlarge": [8, 30, 32, "nitro"],
+    "m5n.large": [3, 10, 2, "nitro"],
+    "m5n.metal": [15, 50, 96, "nitro"],
+    "m5n.xlarge": [4, 15, 4, "nitro"],
+    "m6a.12xlarge": [8, 30, 48, "nitro"],
+    "m6a.16xlarge": [15, 50, 64, "nitro"],
+    "m6a.24xlarge": [15, 50, 96, "nitro"],
+    "m6a.2xlarge": [4, 15, 8, "nitro"],
+    "m6a.32xlarge": [15, 50, 128, "nitro"],
+    "m6a.48xlarge": [15, 50, 192, "nitro"],
+    "m6a.4xlarge": [8, 30, 16, "nitro"],
+    "m6a.8xlarge": [8, 30, 32, "nitro"],
+    "m6a.large": [3, 10, 2, "nitro"],
+    "m6a.metal": [15, 50, 192, "nitro"],
+    "m6a.xlarge": [4, 15, 4, "nitro"],
+    "m6g.12xlarge": [8, 30, 48, "nitro"],
+    "m6g.16xlarge": [15, 50, 64, "nitro"],
+    "m6g.2xlarge": [4, 15, 8, "nitro"],
+    "m6g.4xlarge": [8, 30, 16, "nitro"],
+    "m6g.8xlarge": [8, 30, 32, "nitro"],
+    "m6g.large": [3, 10, 2, "nitro"],
+    "m6g.medium": [2, 4, 1, "nitro"],
+    "m6g.metal": [15, 50, 64, "nitro"],
+    "m6g.xlarge": [4, 15, 4, "nitro"],
+    "m6gd.12xlarge": [8, 30, 48, "nitro"],
+    "m6gd.16xlarge": [15, 50, 64, "nitro"],
+    "m6gd.2xlarge": [4, 15, 8, "nitro"],
+    "m6gd.4xlarge": [8, 30, 16, "nitro"],
+    "m6gd.8xlarge": [8, 30, 32, "nitro"],
+    "m6gd.large": [3, 10, 2, "nitro"],
+    "m6gd.medium": [2, 4, 1, "nitro"],
+    "m6gd.metal": [15, 50, 64, "nitro"],
+    "m6gd.xlarge": [4, 15, 4, "nitro"],
+    "m6i.12xlarge": [8, 30, 48, "nitro"],
+    "m6i.16xlarge": [15, 50, 64, "nitro"],
+    "m6i.24xlarge": [15, 50, 96, "nitro"],
+    "m6i.2xlarge": [4, 15, 8, "nitro"],
+    "m6i.32xlarge": [15, 50, 128, "nitro"],
+    "m6i.4xlarge": [8, 30, 16, "nitro"],
+    "m6i.8xlarge": [8, 30, 32, "nitro"],
+    "m6i.large": [3, 10, 2, "nitro"],
+    "m6i.metal": [15, 50, 128, "nitro"],
+    "m6i.xlarge": [4, 15, 4, "nitro"],
+    "m6id.12xlarge": [8, 30, 48, "nitro"],
+    "m6id.16xlarge": [15, 50, 64, "nitro"],
+    "m6id.24xlarge": [15, 50, 96, "nitro"],
+    "m6id.2xlarge": [4, 15, 8, "nitro"],
+    "m6id.32xlarge": [15, 50, 128, "nitro"],
+    "m6id.4xlarge": [8, 30, 16, "nitro"],
+    "m6id.8xlarge": [8, 30, 32, "nitro"],
+    "m6id.large": [3, 10, 2, "nitro"],
+    "m6id.metal": [15, 50, 128, "nitro"],
+    "m6id.xlarge": [4, 15, 4, "nitro"],
+    "m7a.12xlarge": [8, 30, 48, "nitro"],
+    "m7a.16xlarge": [15, 50, 64, "nitro"],
+    "m7a.24xlarge": [15, 50, 96, "nitro"],
+    "m7a.2xlarge": [4, 15, 8, "nitro"],
+    "m7a.32xlarge": [15, 50, 128, "nitro"],
+    "m7a.48xlarge": [15, 50, 192, "nitro"],
+    "m7a.4xlarge": [8, 30, 16, "nitro"],
+    "m7a.8xlarge": [8, 30, 32, "nitro"],
+    "m7a.large": [3, 10, 2, "nitro"],
+    "m7a.metal": [15, 50, 192, "nitro"],
+    "m7a.xlarge": [4, 15, 4, "nitro"],
+    "m7g.12xlarge": [8, 30, 48, "nitro"],
+    "m7g.16xlarge": [15, 50, 64, "nitro"],
+    "m7g.2xlarge": [4, 15, 8, "nitro"],
+    "m7g.4xlarge": [8, 30, 16, "nitro"],
+    "m7g.8xlarge": [8, 30, 32, "nitro"],
+    "m7g.large": [3, 10, 2, "nitro"],
+    "m7g.medium": [2, 4, 1, "nitro"],
+    "m7g.metal": [15, 50, 64, "nitro"],
+    "m7g.xlarge": [4, 15, 4, "nitro"],
+    "m7gd.12xlarge": [8, 30, 48, "nitro"],
+    "m7gd.16xlarge": [15, 50, 64, "nitro"],
+    "m7gd.2xlarge": [4, 15, 8, "nitro"],
+    "m7gd.4xlarge": [8, 30, 16, "nitro"],
+    "m7gd.8xlarge": [8, 30, 32, "nitro"],
+    "m7gd.large": [3, 10, 2, "nitro"],
+    "m7gd.medium": [2, 4, 1, "nitro"],
+    "m7gd.metal": [15, 50, 64, "nitro"],
+    "m7gd.xlarge": [4, 15, 4, "nitro"],
+    "m7i.12xlarge": [8, 30, 48, "nitro"],
+    "m7i.16xlarge": [15, 50, 64, "nitro"],
+    "m7i.24xlarge": [15, 50, 96, "nitro"],
+    "m7i.2xlarge": [4, 15, 8, "nitro"],
+    "m7i.48xlarge": [15, 50, 192, "nitro"],
+    "m7i.4xlarge": [8, 30, 16, "nitro"],
+    "m7i.8xlarge": [8, 30, 32, "nitro"],
+    "m7i.large": [3, 10, 2, "nitro"],
+    "m7i.metal-24xl": [15, 50, 96, "nitro"],
+    "m7i.metal-48xl": [15, 50, 192, "nitro"],
+    "m7i.xlarge": [4, 15, 4, "nitro"],
+    "m8g.12xlarge": [8, 30, 48, "nitro"],
+    "m8g.16xlarge": [15, 50, 64, "nitro"],
+    "m8g.24xlarge": [15, 50, 96, "nitro"],
+    "m8g.2xlarge": [4, 15, 8, "nitro"],
+    "m8g.48xlarge": [15, 50, 192, "nitro"],
+    "m8g.4xlarge": [8, 30, 16, "nitro"],
+    "m8g.8xlarge": [8, 30, 32, "nitro"],
+    "m8g.large": [3, 10, 2, "nitro"],
+    "m8g.medium": [2, 4, 1, "nitro"],
+    "m8g.xlarge": [4, 15, 4, "nitro"],
+    "p3.16xlarge": [8, 30, 64, "xen"],
+    "p3.2xlarge": [4, 15, 8, "xen"],
+    "p3.8xlarge": [8, 30, 32, "xen"],
+    "p4d.24xlarge": [15, 50, 96, "nitro"],
+    "p5.48xlarge": [15, 50, 192, "nitro"],
+    "r4.16xlarge": [15, 50, 64, "xen"],
+    "r4.2xlarge": [4, 15, 8, "xen"],
+    "r4.4xlarge": [8, 30, 16, "xen"],
+    "r4.8xlarge": [8, 30, 32, "xen"],
+    "r4.large": [3, 10, 2, "xen"],
+    "r4.xlarge": [4, 15, 4, "xen"],
+    "r5.12xlarge": [8, 30, 48, "nitro"],
+    "r5.16xlarge": [15, 50, 64, "nitro"],
+    "r5.24xlarge": [15, 50, 96, "nitro"],
+    "r5.2xlarge": [4, 15, 8, "nitro"],
+    "r5.4xlarge": [8, 30, 16, "nitro"],
+    "r5.8xlarge": [8, 30, 32, "nitro"],
+    "r5.large": [3, 10, 2, "nitro"],
+    "r5.metal": [15, 50, 96, "nitro"],
+    "r5.xlarge": [4, 15, 4, "nitro"],
+    "r5a.12xlarge": [8, 30, 48, "nitro"],
+    "r5a.16xlarge": [15, 50, 64, "nitro"],
+    "r5a.24xlarge": [15, 50, 96, "nitro"],
+    "r5a.2xlarge": [4, 15, 8, "nitro"],
+    "r5a.4xlarge": [8, 30, 16, "nitro"],
+    "r5a.8xlarge": [8, 30, 32, "nitro"],
+    "r5a.large": [3, 10, 2, "nitro"],
+    "r5a.xlarge": [4, 15, 4, "nitro"],
+    "r5d.12xlarge": [8, 30, 48, "nitro"],
+    "r5d.16xlarge": [15, 50, 64, "nitro"],
+    "r5d.24xlarge": [15, 50, 96, "nitro"],
+    "r5d.2xlarge": [4, 15, 8, "nitro"],
+    "r5d.4xlarge": [8, 30, 16, "nitro"],
+    "r5d.8xlarge": [8, 30, 32, "nitro"],
+    "r5d.large": [3, 10, 2, "nitro"],
+    "r5d.metal": [15, 50, 96, "nitro"],
+    "r5d.xlarge": [4, 15, 4, "nitro"],
+    "r5n.12xlarge": [8, 30, 48, "nitro"],
+    "r5n.16xlarge": [15, 50, 64, "nitro"],
+    "r5n.24xlarge": [15, 50, 96, "nitro"],
+    "r5n.2xlarge": [4, 15, 8, "nitro"],
+    "r5n.4xlarge": [8, 30, 16, "nitro"],
+    "r5n.8xlarge": [8, 30, 32, "nitro"],
+    "r5n.large": [3, 10, 2, "nitro"],
+    "r5n.metal": [15, 50, 96, "nitro"],
+    "r5n.xlarge": [4, 15, 4, "nitro"],
+    "r6a.12xlarge": [8, 30, 48, "nitro"],
+    "r6a.16xlarge": [15, 50, 64, "nitro"],
+    "r6a.24xlarge": [15, 50, 96, "nitro"],
+    "r6a.2xlarge": [4, 15, 8, "nitro"],
+    "r6a.32xlarge": [15, 50, 128, "nitro"],
+    "r6a.48xlarge": [15, 50, 192, "nitro"],
+    "r6a.4xlarge": [8, 30, 16, "nitro"],
+    "r6a.8xlarge": [8, 30, 32, "nitro"],
+    "r6a.large": [3, 10, 2, "nitro"],
+    "r6a.metal": [15, 50, 192, "nitro"],
+    "r6a.xlarge": [4, 15, 4, "nitro"],
+    "r6g.12xlarge": [8, 30, 48, "nitro"],
+    "r6g.16xlarge": [15, 50, 64, "nitro"],
+    "r6g.2xlarge": [4, 15, 8, "nitro"],
+    "r6g.4xlarge": [8, 30, 16, "nitro"],
+    "r6g.8xlarge": [8, 30, 32, "nitro"],
+    "r6g.large": [3, 10, 2, "nitro"],
+    "r6g.medium": [2, 4, 1, "nitro"],
+    "r6g.metal": [15, 50, 64, "nitro"],
+    "r6g.xlarge": [4, 15, 4, "nitro"],
+    "r6gd.12xlarge": [8, 30, 48, "nitro"],
+    "r6gd.16xlarge": [15, 50, 64, "nitro"],
+    "r6gd.2xlarge": [4, 15, 8, "nitro"],
+    "r6gd.4xlarge": [8, 30, 16, "nitro"],
+    "r6gd.8xlarge": [8, 30, 32, "nitro"],
+    "r6gd.large": [3, 10, 2, "nitro"],
+    "r6gd.medium": [2, 4, 1, "nitro"],
+    "r6gd.metal": [15, 50, 64, "nitro"],
+    "r6gd.xlarge": [4, 15, 4, "nitro"],
+    "r6i.12xlarge": [8, 30, 48, "nitro"],
+    "r6i.16xlarge": [15, 50, 64, "nitro"],
+    "r6i.24xlarge": [15, 50, 96, "nitro"],
+    "r6i.2xlarge": [4, 15, 8, "nitro"],
+    "r6i.32xlarge": [15, 50, 128, "nitro"],
+    "r6i.4xlarge": [8, 30, 16, "nitro"],
+    "r6i.8xlarge": [8, 30, 32, "nitro"],
+    "r6i.large": [3, 10, 2, "nitro"],
+    "r6i.metal": [15, 50, 128, "nitro"],
+    "r6i.xlarge": [4, 15, 4, "nitro"],
+    "r6id.12xlarge": [8, 30, 48, "nitro"],
+    "r6id.16xlarge": [15, 50, 64, "nitro"],
+    "r6id.24xlarge": [15, 50, 96, "nitro"],
+    "r6id.2xlarge": [4, 15, 8, "nitro"],
+    "r6id.32xlarge": [15, 50, 128, "nitro"],
+    "r6id.4xlarge": [8, 30, 16, "nitro"],
+    "r6id.8xlarge": [8, 30, 32, "nitro"],
+    "r6id.large": [3, 10, 2, "nitro"],
+    "r6id.metal": [15, 50, 128, "nitro"],
+    "r6id.xlarge": [4, 15, 4, "nitro"],
+    "r7a.12xlarge": [8, 30, 48, "nitro"],
+    "r7a.16xlarge": [15, 50, 64, "nitro"],
+    "r7a.24xlarge": [15, 50, 96, "nitro"],
+    "r7a.2xlarge": [4, 15, 8, "nitro"],
+    "r7a.32xlarge": [15, 50, 128, "nitro"],
+    "r7a.48xlarge": [15, 50, 192, "nitro"],
+    "r7a.4xlarge": [8, 30, 16, "nitro"],
+    "r7a.8xlarge": [8, 30, 32, "nitro"],
+    "r7a.large": [3, 10, 2, "nitro"],
+    "r7a.metal": [15, 50, 192, "nitro"],
+    "r7a.xlarge": [4, 15, 4, "nitro"],
+    "r7g.12xlarge": [8, 30, 48, "nitro"],
+    "r7g.16xlarge": [15, 50, 64, "nitro"],
+    "r7g.2xlarge": [4, 15, 8, "nitro"],
+    "r7g.4xlarge": [8, 30, 16, "nitro"],
+    "r7g.8xlarge": [8, 30, 32, "nitro"],
+    "r7g.large": [3, 10, 2, "nitro"],
+    "r7g.medium": [2, 4, 1, "nitro"],
+    "r7g.metal": [15, 50, 64, "nitro"],
+    "r7g.xlarge": [4, 15, 4, "nitro"],
+    "r7gd.12xlarge": [8, 30, 48, "nitro"],
+    "r7gd.16xlarge": [15, 50, 64, "nitro"],
+    "r7gd.2xlarge": [4, 15, 8, "nitro"],
+    "r7gd.4xlarge": [8, 30, 16, "nitro"],
+    "r7gd.8xlarge": [8, 30, 32, "nitro"],
+    "r7gd.large": [3, 10, 2, "nitro"],
+    "r7gd.medium": [2, 4, 1, "nitro"],
+    "r7gd.metal": [15, 50, 64, "nitro"],
+    "r7gd.xlarge": [4, 15, 4, "nitro"],
+    "r7i.12xlarge": [8, 30, 48, "nitro"],
+    "r7i.16xlarge": [15, 50, 64, "nitro"],
+    "r7i.24xlarge": [15, 50, 96, "nitro"],
+    "r7i.2xlarge": [4, 15, 8, "nitro"],
+    "r7i.48xlarge": [15, 50, 192, "nitro"],
+    "r7i.4xlarge": [8, 30, 16, "nitro"],
+    "r7i.8xlarge": [8, 30, 32, "nitro"],
+    "r7i.large": [3, 10, 2, "nitro"],
+    "r7i.metal-24xl": [15, 50, 96, "nitro"],
+    "r7i.metal-48xl": [15, 50, 192, "nitro"],
+    "r7i.xlarge": [4, 15, 4, "nitro"],
+    "r8g.12xlarge": [8, 30, 48, "nitro"],
+    "r8g.16xlarge": [15, 50, 64, "nitro"],
+    "r8g.24xlarge": [15, 50, 96, "nitro"],
+    "r8g.2xlarge": [4, 15, 8, "nitro"],
+    "r8g.48xlarge": [15, 50, 192, "nitro"],
+    "r8g.4xlarge": [8, 30, 16, "nitro"],
+    "r8g.8xlarge": [8, 30, 32, "nitro"],
+    "r8g.large": [3, 10, 2, "nitro"],
+    "r8g.medium": [2, 4, 1, "nitro"],
+    "r8g.xlarge": [4, 15, 4, "nitro"],
+    "t2.2xlarge": [3, 15, 8, "xen"],
+    "t2.large": [3, 12, 2, "xen"],
+    "t2.medium": [3, 6, 2, "xen"],
+    "t2.micro": [2, 2, 1, "xen"],
+    "t2.nano": [2, 2, 1, "xen"],
+    "t2.small": [3, 4, 1, "xen"],
+    "t2.xlarge": [3, 15, 4, "xen"],
+    "t3.2xlarge": [4, 15, 8, "nitro"],
+    "t3.large": [3, 12, 2, "nitro"],
+    "t3.medium": [3, 6, 2, "nitro"],
+    "t3.micro": [2, 2, 2, "nitro"],
+    "t3.nano": [2, 2, 2, "nitro"],
+    "t3.small": [3, 4, 2, "nitro"],
+    "t3.xlarge": [4, 15, 4, "nitro"],
+    "t3a.2xlarge": [4, 15, 8, "nitro"],
+    "t3a.large": [3, 12, 2, "nitro"],
+    "t3a.medium": [3, 6, 2, "nitro"],
+    "t3a.micro": [2, 2, 2, "nitro"],
+    "t3a.nano": [2, 2, 2, "nitro"],
+    "t3a.small": [3, 4, 2, "nitro"],
+    "t3a.xlarge": [4, 15, 4, "nitro"],
+    "t4g.2xlarge": [4, 15, 8, "nitro"],
+    "t4g.large": [3, 12, 2, "nitro"],
+    "t4g.medium": [3, 6, 2, "nitro"],
+    "t4g.micro": [2, 2, 2, "nitro"],
+    "t4g.nano": [2, 2, 2, "nitro"],
+    "t4g.small": [3, 4, 2, "nitro"],
+    "t4g.xlarge": [4, 15, 4, "nitro"],
+    "trn1.2xlarge": [4, 15, 8, "nitro"],
+    "trn1.32xlarge": [5, 50, 128, "nitro"],
+};
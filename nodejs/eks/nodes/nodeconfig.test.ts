@@ -0,0 +1,94 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import { renderNodeConfig, validateNodeConfig } from "./nodeconfig";
+
+describe("validateNodeConfig", () => {
+    it("should accept a valid config", () => {
+        const errors = validateNodeConfig(
+            {
+                kubelet: { config: { maxPods: 110 }, flags: ["--node-labels=team=a"] },
+                containerd: { config: "[plugins]\n" },
+                instance: {
+                    localStorage: {
+                        strategy: "Mount",
+                        mountPath: "/mnt/k8s-disks",
+                        disabledMounts: ["PodLogs"],
+                    },
+                },
+            },
+            "nodeConfig",
+        );
+        expect(errors).toEqual([]);
+    });
+
+    it("should report unknown settings with their property path", () => {
+        const errors = validateNodeConfig(
+            {
+                kubelet: { flag: [] },
+                instance: { localStorage: { strategy: "RAID0", path: "/mnt" } },
+                cluster: {},
+            } as any,
+            "nodeConfig",
+        );
+        expect(errors.map((e) => e.propertyPath)).toEqual([
+            "nodeConfig.cluster",
+            "nodeConfig.kubelet.flag",
+            "nodeConfig.instance.localStorage.path",
+        ]);
+    });
+
+    it("should report invalid values", () => {
+        const errors = validateNodeConfig(
+            {
+                kubelet: { flags: ["max-pods=110"] },
+                instance: {
+                    localStorage: {
+                        strategy: "RAID5",
+                        mountPath: "mnt",
+                        disabledMounts: ["Logs"],
+                    },
+                },
+            },
+            "nodeConfig",
+        );
+        expect(errors.map((e) => e.propertyPath)).toEqual([
+            "nodeConfig.kubelet.flags[0]",
+            "nodeConfig.instance.localStorage.strategy",
+            "nodeConfig.instance.localStorage.mountPath",
+            "nodeConfig.instance.localStorage.disabledMounts[0]",
+        ]);
+    });
+});
+
+describe("renderNodeConfig", () => {
+    it("should render a NodeConfig document", () => {
+        const part = renderNodeConfig({
+            kubelet: { config: { maxPods: 110 } },
+            instance: { localStorage: { strategy: "RAID0", mountPath: undefined } },
+        });
+        expect(part.contentType).toEqual("application/node.eks.aws");
+        expect(part.content).toEqual(`---
+apiVersion: node.eks.aws/v1alpha1
+kind: NodeConfig
+spec:
+  kubelet:
+    config:
+      maxPods: 110
+  instance:
+    localStorage:
+      strategy: RAID0
+`);
+    });
+});
@@ -0,0 +1,192 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import * as pulumi from "@pulumi/pulumi";
+import * as jsyaml from "js-yaml";
+
+import { isObject } from "../utilities";
+
+/**
+ * Typed nodeadm `NodeConfig`. The cluster details are configured by the provider.
+ * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+ */
+export interface NodeConfig {
+    /**
+     * Configuration of the kubelet.
+     */
+    kubelet?: NodeConfigKubelet;
+
+    /**
+     * Configuration of containerd.
+     */
+    containerd?: NodeConfigContainerd;
+
+    /**
+     * Configuration of the EC2 instance.
+     */
+    instance?: NodeConfigInstance;
+}
+
+export interface NodeConfigKubelet {
+    /**
+     * Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+     * See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/
+     */
+    config?: pulumi.Input<{ [key: string]: any }>;
+
+    /**
+     * Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+     */
+    flags?: pulumi.Input<pulumi.Input<string>[]>;
+}
+
+export interface NodeConfigContainerd {
+    /**
+     * Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+     */
+    config?: pulumi.Input<string>;
+
+    /**
+     * The OCI runtime specification containers are created with.
+     */
+    baseRuntimeSpec?: pulumi.Input<{ [key: string]: any }>;
+}
+
+export interface NodeConfigInstance {
+    /**
+     * Configuration of the local instance store volumes.
+     */
+    localStorage?: NodeConfigLocalStorage;
+}
+
+export interface NodeConfigLocalStorage {
+    /**
+     * How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+     */
+    strategy: pulumi.Input<string>;
+
+    /**
+     * The path the instance store volumes are mounted at.
+     */
+    mountPath?: pulumi.Input<string>;
+
+    /**
+     * Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+     */
+    disabledMounts?: pulumi.Input<pulumi.Input<string>[]>;
+}
+
+const localStorageStrategies = ["RAID0", "RAID10", "Mount"];
+const disabledMountValues = ["Containerd", "PodLogs"];
+
+// The valid keys of every section of the NodeConfig.
+const sectionKeys: { [section: string]: string[] } = {
+    "": ["kubelet", "containerd", "instance"],
+    kubelet: ["config", "flags"],
+    containerd: ["config", "baseRuntimeSpec"],
+    instance: ["localStorage"],
+    "instance.localStorage": ["strategy", "mountPath", "disabledMounts"],
+};
+
+/**
+ * Validates the typed NodeConfig. Unknown keys and invalid values are reported with their property path.
+ *
+ * @param config - The unwrapped NodeConfig.
+ * @param propertyPath - The property path of the config, used as prefix of the reported property paths.
+ * @returns The validation errors, empty if the config is valid.
+ */
+export function validateNodeConfig(
+    config: pulumi.Unwrap<NodeConfig> | undefined,
+    propertyPath: string,
+): pulumi.InputPropertyErrorDetails[] {
+    const errors: pulumi.InputPropertyErrorDetails[] = [];
+    if (config === undefined) {
+        return errors;
+    }
+
+    const error = (path: string, reason: string) =>
+        errors.push({ propertyPath: path ? `${propertyPath}.${path}` : propertyPath, reason });
+
+    for (const [section, keys] of Object.entries(sectionKeys)) {
+        const value = section === "" ? config : getPath(config, section);
+        if (value === undefined) {
+            continue;
+        }
+        if (!isObject(value)) {
+            error(section, "Expected an object.");
+            continue;
+        }
+        for (const key of Object.keys(value)) {
+            if (!keys.includes(key)) {
+                error(
+                    section ? `${section}.${key}` : key,
+                    `Unknown NodeConfig setting. Valid settings are: ${keys.join(", ")}.`,
+                );
+            }
+        }
+    }
+
+    (config.kubelet?.flags ?? []).forEach((flag, i) => {
+        if (!flag.startsWith("--")) {
+            error(`kubelet.flags[${i}]`, "Kubelet flags must start with '--'.");
+        }
+    });
+
+    const localStorage = config.instance?.localStorage;
+    if (localStorage) {
+        if (!localStorageStrategies.includes(localStorage.strategy)) {
+            error(
+                "instance.localStorage.strategy",
+                `Valid local storage strategies are: ${localStorageStrategies.join(", ")}.`,
+            );
+        }
+        if (localStorage.mountPath !== undefined && !localStorage.mountPath.startsWith("/")) {
+            error("instance.localStorage.mountPath", "The mount path must be an absolute path.");
+        }
+        (localStorage.disabledMounts ?? []).forEach((mount, i) => {
+            if (!disabledMountValues.includes(mount)) {
+                error(
+                    `instance.localStorage.disabledMounts[${i}]`,
+                    `Valid disabled mounts are: ${disabledMountValues.join(", ")}.`,
+                );
+            }
+        });
+    }
+
+    return errors;
+}
+
+/**
+ * Renders the typed NodeConfig as `application/node.eks.aws` part of the nodeadm user data. nodeadm merges it with
+ * the other NodeConfig parts.
+ */
+export function renderNodeConfig(config: pulumi.Unwrap<NodeConfig>): {
+    contentType: string;
+    content: string;
+} {
+    const nodeConfig = {
+        apiVersion: "node.eks.aws/v1alpha1",
+        kind: "NodeConfig",
+        spec: config,
+    };
+    return {
+        contentType: "application/node.eks.aws",
+        // skipInvalid omits unset optional properties instead of failing on undefined values
+        content: "---\n" + jsyaml.dump(nodeConfig, { skipInvalid: true }),
+    };
+}
+
+function getPath(obj: any, path: string): unknown {
+    return path.split(".").reduce((value, key) => (isObject(value) ? value[key] : undefined), obj);
+}
@@ -659,7 +659,174 @@ describe("resolveInstanceProfileName", function () {
     });
 });
 
+describe("autoscalerDiscoveryTags", function () {
+    test("should tag the node group for auto-discovery", () => {
+        expect(ng.autoscalerDiscoveryTags("example", undefined, undefined)).toEqual({
+            "k8s.io/cluster-autoscaler/enabled": "true",
+            "k8s.io/cluster-autoscaler/example": "owned",
+        });
+    });
+
+    test("should add node template tags for the labels and taints", () => {
+        const tags = ng.autoscalerDiscoveryTags(
+            "example",
+            { "node.kubernetes.io/lifecycle": "spot", team: "platform" },
+            {
+                dedicated: { value: "gpu", effect: "NoSchedule" },
+                "example.com/evict": { value: "", effect: "NoExecute" },
+            },
+        );
+
+        expect(tags).toEqual({
+            "k8s.io/cluster-autoscaler/enabled": "true",
+            "k8s.io/cluster-autoscaler/example": "owned",
+            "k8s.io/cluster-autoscaler/node-template/label/node.kubernetes.io/lifecycle": "spot",
+            "k8s.io/cluster-autoscaler/node-template/label/team": "platform",
+            "k8s.io/cluster-autoscaler/node-template/taint/dedicated": "gpu:NoSchedule",
+            "k8s.io/cluster-autoscaler/node-template/taint/example.com/evict": ":NoExecute",
+        });
+    });
+});
+
+describe("scalingIgnoreChanges", function () {
+    test.each<[boolean | undefined, boolean | undefined, string[] | undefined]>([
+        [true, undefined, ["desiredCapacity"]],
+        [true, true, ["desiredCapacity"]],
+        [false, true, undefined],
+        [false, undefined, undefined],
+        [undefined, true, ["desiredCapacity"]],
+        [undefined, undefined, undefined],
+    ])(
+        "ignoreScalingChanges %p with autoscalerDiscovery %p should ignore %p",
+        (ignoreScalingChanges, autoscalerDiscovery, expected) => {
+            expect(
+                ng.scalingIgnoreChanges(
+                    { ignoreScalingChanges, autoscalerDiscovery },
+                    "desiredCapacity",
+                ),
+            ).toEqual(expected);
+        },
+    );
+
+    test("should ignore the desired size of managed node groups", () => {
+        expect(
+            ng.scalingIgnoreChanges({ autoscalerDiscovery: true }, "scalingConfig.desiredSize"),
+        ).toEqual(["scalingConfig.desiredSize"]);
+        expect(
+            ng.scalingIgnoreChanges(
+                { ignoreScalingChanges: false, autoscalerDiscovery: true },
+                "scalingConfig.desiredSize",
+            ),
+        ).toBeUndefined();
+    });
+});
+
+describe("validateUserDataConfigs", function () {
+    const invalidBottlerocketConfig = { kubernetes: { maxPods: 0 } };
+    const invalidNodeConfig = { instance: { localStorage: { strategy: "RAID5" } } };
+
+    test("should add the errors of configs without outputs to the validation errors", () => {
+        const errors: pulumi.InputPropertyErrorDetails[] = [];
+        ng.validateUserDataConfigs({ bottlerocketConfig: invalidBottlerocketConfig }, errors);
+
+        expect(errors.map((e) => e.propertyPath)).toEqual([
+            "bottlerocketConfig.kubernetes.maxPods",
+        ]);
+    });
+
+    test("should reject invalid configs with outputs once they resolve", async () => {
+        const errors: pulumi.InputPropertyErrorDetails[] = [];
+        const configs = ng.validateUserDataConfigs(
+            { bottlerocketConfig: { kubernetes: { maxPods: pulumi.output(0) } } },
+            errors,
+        );
+
+        expect(errors).toEqual([]);
+        await expect(outputPromise(configs)).rejects.toThrow("Invalid arguments for node group");
+    });
+
+    test("should return valid configs", async () => {
+        const errors: pulumi.InputPropertyErrorDetails[] = [];
+        const configs = ng.validateUserDataConfigs(
+            { bottlerocketConfig: { kubernetes: { maxPods: pulumi.output(110) } } },
+            errors,
+        );
+
+        expect(errors).toEqual([]);
+        expect(await promisify(configs)).toEqual({
+            bottlerocketConfig: { kubernetes: { maxPods: 110 } },
+        });
+    });
+
+    test("should add the errors of node configs without outputs to the validation errors", () => {
+        const errors: pulumi.InputPropertyErrorDetails[] = [];
+        ng.validateUserDataConfigs({ nodeConfig: invalidNodeConfig }, errors);
+
+        expect(errors.map((e) => e.propertyPath)).toEqual([
+            "nodeConfig.instance.localStorage.strategy",
+        ]);
+    });
+
+    test("should reject invalid node configs with outputs once they resolve", async () => {
+        const errors: pulumi.InputPropertyErrorDetails[] = [];
+        const configs = ng.validateUserDataConfigs(
+            { nodeConfig: { instance: { localStorage: { strategy: pulumi.output("RAID5") } } } },
+            errors,
+        );
+
+        expect(errors).toEqual([]);
+        await expect(outputPromise(configs)).rejects.toThrow("Invalid arguments for node group");
+    });
+
+    test("should fail the managed node group if the Bottlerocket config is invalid", async () => {
+        expect(() => {
+            ng.createManagedNodeGroup(
+                "test",
+                {
+                    nodeRoleArn: pulumi.output("nodeRoleArn"),
+                    bottlerocketConfig: invalidBottlerocketConfig,
+                },
+                pulumi.output({
+                    cluster: {
+                        version: pulumi.output("1.30"),
+                        accessConfig: pulumi.output({
+                            authenticationMode: "API",
+                        }),
+                    } as aws.eks.Cluster,
+                } as CoreData),
+                undefined as any,
+            );
+        }).toThrow("The input properties for the managed node group are invalid.");
+    });
+
+    test("should fail the managed node group if the node config is invalid", async () => {
+        expect(() => {
+            ng.createManagedNodeGroup(
+                "test",
+                {
+                    nodeRoleArn: pulumi.output("nodeRoleArn"),
+                    nodeConfig: invalidNodeConfig,
+                },
+                pulumi.output({
+                    cluster: {
+                        version: pulumi.output("1.30"),
+                        accessConfig: pulumi.output({
+                            authenticationMode: "API",
+                        }),
+                    } as aws.eks.Cluster,
+                } as CoreData),
+                undefined as any,
+            );
+        }).toThrow("The input properties for the managed node group are invalid.");
+    });
+});
+
 function promisify<T>(output: pulumi.Output<T> | undefined): Promise<T> {
     expect(output).toBeDefined();
     return new Promise((resolve) => output!.apply(resolve));
 }
+
+// Outputs don't expose their rejections, so tests await the underlying promise.
+function outputPromise<T>(output: pulumi.Output<T>): Promise<T> {
+    return (output as any).promise();
+}
@@ -38,7 +38,16 @@ import {
     SelfManagedV2NodeUserDataArgs,
 } from "./userdata";
 import randomSuffix from "../randomSuffix";
+import { containsOutputs, sha1hash } from "../utilities";
 import { DEFAULT_INSTANCE_TYPE, filterEfaSubnets, getEfaNetworkInterfaces } from "./instances";
+import { prefixDelegationMaxPods } from "./maxpods";
+import { BottlerocketConfig, validateBottlerocketConfig } from "./bottlerocket";
+import { NodeConfig, validateNodeConfig } from "./nodeconfig";
+import {
+    createInterruptionHandling,
+    InterruptionHandling,
+    nodeTerminationHandlerManagedTag,
+} from "./interruption";
 
 export type TaintEffect = "NoSchedule" | "NoExecute" | "PreferNoSchedule";
 
@@ -243,6 +252,15 @@ export interface NodeGroupBaseOptions {
      */
     taints?: pulumi.Input<{ [key: string]: pulumi.Input<Taint> }>;
 
+    /**
+     * Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the
+     * `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints`
+     * are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+     *
+     * For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+     */
+    autoscalerDiscovery?: boolean;
+
     /**
      * Extra args to pass to the Kubelet.  Corresponds to the options passed in the `--kubeletExtraArgs` flag to
      * `/etc/eks/bootstrap.sh`.  For example, '--port=10251 --address=0.0.0.0'. Note that the `labels` and `taints`
@@ -335,6 +353,23 @@ export interface NodeGroupBaseOptions {
      */
     bottlerocketSettings?: pulumi.Input<object>;
 
+    /**
+     * Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+     * The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to
+     * configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+     */
+    bottlerocketConfig?: pulumi.Input<BottlerocketConfig>;
+
+    /**
+     * Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into
+     * an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets.
+     * Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+     *
+     * Note: This is only applicable when using AL2023.
+     * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+     */
+    nodeConfig?: pulumi.Input<NodeConfig>;
+
     /**
      * Extra nodeadm configuration sections to be added to the nodeadm user data.
      * This can be shell scripts, nodeadm NodeConfig or any other user data compatible script.
@@ -401,6 +436,16 @@ export interface NodeGroupV2Options extends NodeGroupOptions {
      * See EKS best practices for more details: https://aws.github.io/aws-eks-best-practices/cluster-autoscaling/
      */
     ignoreScalingChanges?: boolean;
+
+    /**
+     * Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that
+     * receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the
+     * Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in
+     * queue mode, which cordons and drains the affected nodes.
+     *
+     * See for more details: https://github.com/aws/aws-node-termination-handler
+     */
+    interruptionHandling?: InterruptionHandling;
 }
 
 /**
@@ -586,6 +631,7 @@ function createNodeGroup(
     provider?: pulumi.ProviderResource,
 ): NodeGroupData {
     const validationErrors: pulumi.InputPropertyErrorDetails[] = [];
+    const userDataConfigs = validateUserDataConfigs(args, validationErrors);
 
     const instanceProfileName = core.apply((c) => resolveInstanceProfileName(args, c));
 
@@ -761,20 +807,39 @@ function createNodeGroup(
     };
 
     const userdata = pulumi
-        .all([awsRegion, clusterMetadata, cfnStackName, os, nodeadmExtraOptions, nodegroupInputs])
-        .apply(([region, clusterMetadata, stackName, os, nodeadmExtraOptions, nodegroupInputs]) => {
-            const userDataArgs: SelfManagedV1NodeUserDataArgs = {
-                ...nodegroupInputs,
-                nodeGroupType: "self-managed-v1",
-                awsRegion: region.name,
+        .all([
+            awsRegion,
+            clusterMetadata,
+            cfnStackName,
+            os,
+            nodeadmExtraOptions,
+            nodegroupInputs,
+            userDataConfigs,
+        ])
+        .apply(
+            ([
+                region,
+                clusterMetadata,
                 stackName,
+                os,
                 nodeadmExtraOptions,
-                extraUserData: nodegroupInputs.nodeUserData,
-                userDataOverride: nodegroupInputs.nodeUserDataOverride,
-            };
+                nodegroupInputs,
+                userDataConfigs,
+            ]) => {
+                const userDataArgs: SelfManagedV1NodeUserDataArgs = {
+                    ...nodegroupInputs,
+                    ...userDataConfigs,
+                    nodeGroupType: "self-managed-v1",
+                    awsRegion: region.name,
+                    stackName,
+                    nodeadmExtraOptions,
+                    extraUserData: nodegroupInputs.nodeUserData,
+                    userDataOverride: nodegroupInputs.nodeUserDataOverride,
+                };
 
-            return createUserData(os, clusterMetadata, userDataArgs, parent);
-        });
+                return createUserData(os, clusterMetadata, userDataArgs, parent);
+            },
+        );
 
     const version = pulumi.output(args.version || core.cluster.version);
 
@@ -980,12 +1045,15 @@ function createNodeGroup(
         minInstancesInService = 0;
     }
     const autoScalingGroupTags: InputTags = pulumi
-        .all([eksCluster.name, args.autoScalingGroupTags])
+        .all([eksCluster.name, args.autoScalingGroupTags, args.labels, args.taints])
         .apply(
-            ([clusterName, asgTags]) =>
+            ([clusterName, asgTags, labels, taints]) =>
                 <aws.Tags>{
                     Name: `${clusterName}-worker`,
                     [`kubernetes.io/cluster/${clusterName}`]: "owned",
+                    ...(args.autoscalerDiscovery
+                        ? autoscalerDiscoveryTags(clusterName, labels, taints)
+                        : {}),
                     ...asgTags,
                 },
         );
@@ -1073,6 +1141,7 @@ export function createNodeGroupV2(
     provider?: pulumi.ProviderResource,
 ): NodeGroupV2Data {
     const validationErrors: pulumi.InputPropertyErrorDetails[] = [];
+    const userDataConfigs = validateUserDataConfigs(args, validationErrors);
 
     const instanceProfileName = core.apply((c) => resolveInstanceProfileName(args, c));
 
@@ -1231,6 +1300,13 @@ export function createNodeGroupV2(
             return nodeadmExtraOptions ? pulumi.all(nodeadmExtraOptions) : undefined;
         });
 
+    // With prefix delegation the nodes can run more pods than the AMIs assume.
+    const maxPods = pulumi
+        .all([args.instanceType || DEFAULT_INSTANCE_TYPE, core.vpcCniNetworking])
+        .apply(([instanceType, networking]) =>
+            prefixDelegationMaxPods([instanceType], networking, parent),
+        );
+
     const nodegroupInputs = {
         nodeUserData: args.nodeUserData,
         nodeUserDataOverride: args.nodeUserDataOverride,
@@ -1239,22 +1315,33 @@ export function createNodeGroupV2(
         bootstrapExtraArgs: args.bootstrapExtraArgs,
         labels: args.labels,
         taints: args.taints,
+        maxPods,
     };
 
     const userdata = pulumi
-        .all([clusterMetadata, name, os, nodeadmExtraOptions, nodegroupInputs])
-        .apply(([clusterMetadata, stackName, os, nodeadmExtraOptions, nodegroupInputs]) => {
-            const userDataArgs: SelfManagedV2NodeUserDataArgs = {
-                ...nodegroupInputs,
-                nodeGroupType: "self-managed-v2",
+        .all([clusterMetadata, name, os, nodeadmExtraOptions, nodegroupInputs, userDataConfigs])
+        .apply(
+            ([
+                clusterMetadata,
                 stackName,
+                os,
                 nodeadmExtraOptions,
-                extraUserData: nodegroupInputs.nodeUserData,
-                userDataOverride: nodegroupInputs.nodeUserDataOverride,
-            };
+                nodegroupInputs,
+                userDataConfigs,
+            ]) => {
+                const userDataArgs: SelfManagedV2NodeUserDataArgs = {
+                    ...nodegroupInputs,
+                    ...userDataConfigs,
+                    nodeGroupType: "self-managed-v2",
+                    stackName,
+                    nodeadmExtraOptions,
+                    extraUserData: nodegroupInputs.nodeUserData,
+                    userDataOverride: nodegroupInputs.nodeUserDataOverride,
+                };
 
-            return createUserData(os, clusterMetadata, userDataArgs, parent);
-        })
+                return createUserData(os, clusterMetadata, userDataArgs, parent);
+            },
+        )
         .apply((x) => Buffer.from(x, "utf-8").toString("base64")); // Launch Templates require user data to be passed as base64.
 
     const version = pulumi.output(args.version || core.cluster.version);
@@ -1478,12 +1565,24 @@ export function createNodeGroupV2(
     }
 
     const asgTags = pulumi
-        .all([eksCluster.name, args.autoScalingGroupTags])
-        .apply(([clusterName, tags]) => inputTagsToASGTags(clusterName, tags));
+        .all([eksCluster.name, args.autoScalingGroupTags, args.labels, args.taints])
+        .apply(([clusterName, tags, labels, taints]) =>
+            inputTagsToASGTags(clusterName, {
+                ...(args.autoscalerDiscovery
+                    ? autoscalerDiscoveryTags(clusterName, labels, taints)
+                    : {}),
+                // aws-node-termination-handler only drains the instances that are tagged as managed by it.
+                ...(args.interruptionHandling
+                    ? { [nodeTerminationHandlerManagedTag(name)]: "" }
+                    : {}),
+                ...tags,
+            }),
+        );
 
     const launchTemplateVersion = nodeLaunchTemplate.latestVersion.apply((v) => v.toString());
 
-    const ignoreScalingChanges = args.ignoreScalingChanges ? ["desiredCapacity"] : undefined;
+    // The Cluster Autoscaler changes the desired capacity of the node groups it discovers.
+    const ignoreScalingChanges = scalingIgnoreChanges(args, "desiredCapacity");
     const asGroup = new aws.autoscaling.Group(
         name,
         {
@@ -1507,6 +1606,10 @@ export function createNodeGroupV2(
         { parent, dependsOn: nodeGroupDeps, provider, ignoreChanges: ignoreScalingChanges },
     );
 
+    if (args.interruptionHandling) {
+        createInterruptionHandling(name, args.interruptionHandling, asGroup, core, parent, provider);
+    }
+
     return {
         nodeSecurityGroup: nodeSecurityGroup,
         nodeSecurityGroupId: nodeSecurityGroupId,
@@ -1515,6 +1618,60 @@ export function createNodeGroupV2(
     };
 }
 
+/**
+ * Returns the properties of a node group to ignore changes to if its desired size is managed outside of Pulumi, i.e.
+ * if `ignoreScalingChanges` is set or, unless it is explicitly disabled, if `autoscalerDiscovery` is set.
+ *
+ * @internal
+ */
+export function scalingIgnoreChanges(
+    args: { ignoreScalingChanges?: boolean; autoscalerDiscovery?: boolean },
+    desiredSizeProperty: string,
+): string[] | undefined {
+    return (args.ignoreScalingChanges ?? args.autoscalerDiscovery)
+        ? [desiredSizeProperty]
+        : undefined;
+}
+
+/**
+ * Returns the tags that let the Cluster Autoscaler discover the Auto Scaling Group of a node group, including the
+ * node template tags for scaling the group up from zero.
+ * See https://github.com/kubernetes/autoscaler/blob/master/cluster-autoscaler/cloudprovider/aws/README.md#auto-discovery-setup
+ *
+ * @internal
+ */
+export function autoscalerDiscoveryTags(
+    clusterName: string,
+    labels: { [key: string]: string } | undefined,
+    taints: { [key: string]: pulumi.Unwrap<Taint> } | undefined,
+): { [key: string]: string } {
+    return {
+        "k8s.io/cluster-autoscaler/enabled": "true",
+        [`k8s.io/cluster-autoscaler/${clusterName}`]: "owned",
+        ...autoscalerNodeTemplateTags(labels, taints),
+    };
+}
+
+/**
+ * Returns the node template tags that tell the Cluster Autoscaler about the labels and taints of the nodes of an Auto
+ * Scaling Group without nodes.
+ *
+ * @internal
+ */
+export function autoscalerNodeTemplateTags(
+    labels: { [key: string]: string } | undefined,
+    taints: { [key: string]: pulumi.Unwrap<Taint> } | undefined,
+): { [key: string]: string } {
+    const tags: { [key: string]: string } = {};
+    for (const [key, value] of Object.entries(labels ?? {})) {
+        tags[`k8s.io/cluster-autoscaler/node-template/label/${key}`] = value;
+    }
+    for (const [key, taint] of Object.entries(taints ?? {})) {
+        tags[`k8s.io/cluster-autoscaler/node-template/taint/${key}`] = `${taint.value}:${taint.effect}`;
+    }
+    return tags;
+}
+
 function inputTagsToASGTags(
     clusterName: string,
     tags: InputTags | undefined,
@@ -1741,6 +1898,15 @@ export type ManagedNodeGroupOptions = Omit<
      */
     ignoreScalingChanges?: boolean;
 
+    /**
+     * Tags the Auto Scaling Group of the node group with the `labels` and `taints` as node template tags of the Cluster
+     * Autoscaler, so it can scale the node group up from zero. EKS tags the Auto Scaling Groups of managed node groups
+     * for the auto-discovery of the Cluster Autoscaler itself.
+     *
+     * This implies `ignoreScalingChanges` unless it is explicitly disabled.
+     */
+    autoscalerDiscovery?: boolean;
+
     /**
      * The type of OS to use for the node group. Will be used to determine the right EKS optimized AMI to use based on the
      * instance types and gpu configuration. Valid values are `AL2`, `AL2023` and `Bottlerocket`.
@@ -1762,6 +1928,23 @@ export type ManagedNodeGroupOptions = Omit<
      */
     bottlerocketSettings?: pulumi.Input<object>;
 
+    /**
+     * Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+     * The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to
+     * configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+     */
+    bottlerocketConfig?: pulumi.Input<BottlerocketConfig>;
+
+    /**
+     * Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into
+     * an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets.
+     * Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+     *
+     * Note: This is only applicable when using AL2023.
+     * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+     */
+    nodeConfig?: pulumi.Input<NodeConfig>;
+
     /**
      * User specified code to run on node startup. This is expected to handle the full AWS EKS node bootstrapping.
      * If omitted, the provider will configure the user data.
@@ -1891,6 +2074,7 @@ export function createManagedNodeGroup(
     }
 
     const validationErrors: pulumi.InputPropertyErrorDetails[] = [];
+    const userDataConfigs = validateUserDataConfigs(args, validationErrors);
 
     if (args.nodeRole && args.nodeRoleArn) {
         validationErrors.push({
@@ -2026,6 +2210,8 @@ export function createManagedNodeGroup(
         kubeletExtraArgs: args.kubeletExtraArgs,
         bootstrapExtraArgs: args.bootstrapExtraArgs,
         bottlerocketSettings: args.bottlerocketSettings,
+        bottlerocketConfig: args.bottlerocketConfig,
+        nodeConfig: args.nodeConfig,
         nodeadmExtraOptions: args.nodeadmExtraOptions,
     };
 
@@ -2050,6 +2236,7 @@ export function createManagedNodeGroup(
         launchTemplate = createMNGCustomLaunchTemplate(
             name,
             args,
+            userDataConfigs,
             core,
             placementGroup?.name,
             parent,
@@ -2082,9 +2269,8 @@ export function createManagedNodeGroup(
         );
     }
 
-    const ignoreScalingChanges = args.ignoreScalingChanges
-        ? ["scalingConfig.desiredSize"]
-        : undefined;
+    // The Cluster Autoscaler changes the desired size of the node groups it discovers.
+    const ignoreScalingChanges = scalingIgnoreChanges(args, "scalingConfig.desiredSize");
 
     // Make the aws-auth configmap a dependency of the node group.
     const ngDeps = core.apply((c) => (c.eksNodeAccess !== undefined ? [c.eksNodeAccess] : []));
@@ -2119,12 +2305,59 @@ export function createManagedNodeGroup(
         { parent: parent, dependsOn: ngDeps, provider, ignoreChanges: ignoreScalingChanges },
     );
 
+    if (args.autoscalerDiscovery) {
+        tagManagedNodeGroupForAutoscaler(name, nodeGroup, args, parent, provider);
+    }
+
     return {
         nodeGroup,
         placementGroupName: placementGroup?.name ?? "",
     };
 }
 
+// EKS tags the Auto Scaling Groups of a managed node group for auto-discovery, but not with the labels and taints of
+// the nodes. Those are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+// The Auto Scaling Groups are only known once EKS created the node group, so the tags are created at apply time.
+// Label and taint keys can contain characters like `/`, so the tags are named by a hash of the group and tag key.
+function tagManagedNodeGroupForAutoscaler(
+    name: string,
+    nodeGroup: aws.eks.NodeGroup,
+    args: Omit<ManagedNodeGroupOptions, "cluster">,
+    parent: pulumi.Resource,
+    provider?: pulumi.ProviderResource,
+): pulumi.Output<aws.autoscaling.Tag[]> {
+    const autoScalingGroupNames = nodeGroup.resources.apply((resources) =>
+        resources.flatMap((resource) => resource.autoscalingGroups.map((group) => group.name)),
+    );
+
+    return pulumi
+        .all([autoScalingGroupNames, args.labels, args.taints])
+        .apply(([groupNames, labels, taints]) => {
+            const tags = autoscalerNodeTemplateTags(
+                labels,
+                Object.fromEntries(
+                    (taints ?? []).map((taint) => [
+                        taint.key,
+                        { value: taint.value ?? "", effect: mapMngTaintEffect(taint.effect) },
+                    ]),
+                ),
+            );
+            return groupNames.flatMap((groupName) =>
+                Object.entries(tags).map(
+                    ([key, value]) =>
+                        new aws.autoscaling.Tag(
+                            `${name}-${sha1hash(`${groupName}/${key}`)}`,
+                            {
+                                autoscalingGroupName: groupName,
+                                tag: { key, value, propagateAtLaunch: false },
+                            },
+                            { parent, provider },
+                        ),
+                ),
+            );
+        });
+}
+
 const customLaunchTemplateArgs: (keyof Omit<ManagedNodeGroupOptions, "cluster">)[] = [
     ...customUserDataArgs,
     "enableIMDSv2",
@@ -2143,6 +2376,7 @@ function requiresCustomLaunchTemplate(args: Omit<ManagedNodeGroupOptions, "clust
 function createMNGCustomLaunchTemplate(
     name: string,
     args: Omit<ManagedNodeGroupOptions, "cluster">,
+    userDataConfigs: pulumi.Output<pulumi.Unwrap<UserDataConfigs>>,
     core: pulumi.Output<pulumi.Unwrap<CoreData>>,
     placementGroupName: pulumi.Input<string> | undefined,
     parent: pulumi.Resource,
@@ -2181,6 +2415,8 @@ function createMNGCustomLaunchTemplate(
         kubeletExtraArgs: args.kubeletExtraArgs,
         bootstrapExtraArgs: args.bootstrapExtraArgs,
         bottlerocketSettings: args.bottlerocketSettings,
+        bottlerocketConfig: args.bottlerocketConfig,
+        nodeConfig: args.nodeConfig,
         nodeadmExtraOptions: args.nodeadmExtraOptions,
     };
 
@@ -2190,38 +2426,36 @@ function createMNGCustomLaunchTemplate(
             return nodeadmExtraOptions ? pulumi.all(nodeadmExtraOptions) : undefined;
         });
 
+    // With prefix delegation the nodes can run more pods than the AMIs assume. All instance types of the node group
+    // share the user data, so the lowest value applies.
+    const maxPods = pulumi
+        .all([args.instanceTypes ?? [DEFAULT_INSTANCE_TYPE], core.vpcCniNetworking])
+        .apply(([instanceTypes, networking]) =>
+            prefixDelegationMaxPods(instanceTypes, networking, parent),
+        );
+
     let userData: pulumi.Output<string> | undefined;
     // when amiId is provided, we need to create a custom user data script because
     // EKS will not provide default user data when an AMI ID is provided.
     if (requiresCustomUserData(customUserDataArgs) || args.userData || args.amiId) {
+        const nodegroupInputs = {
+            labels: args.labels,
+            taints,
+            bottlerocketSettings: args.bottlerocketSettings,
+            userDataOverride: args.userData,
+            maxPods,
+        };
+
         userData = pulumi
-            .all([
-                clusterMetadata,
-                os,
-                args.labels,
-                taints,
-                args.bottlerocketSettings,
-                args.userData,
-                nodeadmExtraOptions,
-            ])
+            .all([clusterMetadata, os, nodeadmExtraOptions, nodegroupInputs, userDataConfigs])
             .apply(
-                ([
-                    clusterMetadata,
-                    os,
-                    labels,
-                    taints,
-                    bottlerocketSettings,
-                    userDataOverride,
-                    nodeadmExtraOptions,
-                ]) => {
+                ([clusterMetadata, os, nodeadmExtraOptions, nodegroupInputs, userDataConfigs]) => {
                     const userDataArgs: ManagedNodeUserDataArgs = {
+                        ...nodegroupInputs,
+                        ...userDataConfigs,
                         nodeGroupType: "managed",
                         kubeletExtraArgs: args.kubeletExtraArgs,
                         bootstrapExtraArgs: args.bootstrapExtraArgs,
-                        labels,
-                        taints,
-                        bottlerocketSettings,
-                        userDataOverride,
                         nodeadmExtraOptions,
                     };
 
@@ -2300,6 +2534,56 @@ function createMNGCustomLaunchTemplate(
     );
 }
 
+/**
+ * The typed Bottlerocket and nodeadm configs the user data of a node group is rendered from.
+ */
+interface UserDataConfigs {
+    bottlerocketConfig?: pulumi.Input<BottlerocketConfig>;
+    nodeConfig?: pulumi.Input<NodeConfig>;
+}
+
+/**
+ * Validates the typed Bottlerocket and nodeadm configs, so invalid settings don't make the nodes fail to join the
+ * cluster. Configs without outputs are validated promptly and their errors are added to `validationErrors`. Otherwise
+ * they are validated once they resolve and the returned configs reject if they are invalid. The user data must be
+ * rendered from the returned configs, so invalid configs fail the node group instead of being rendered.
+ *
+ * @internal
+ */
+export function validateUserDataConfigs(
+    args: UserDataConfigs,
+    validationErrors: pulumi.InputPropertyErrorDetails[],
+): pulumi.Output<pulumi.Unwrap<UserDataConfigs>> {
+    const configs: UserDataConfigs = {
+        bottlerocketConfig: args.bottlerocketConfig,
+        nodeConfig: args.nodeConfig,
+    };
+    if (!containsOutputs(configs)) {
+        validationErrors.push(...userDataConfigErrors(configs as pulumi.Unwrap<UserDataConfigs>));
+        return pulumi.output(configs);
+    }
+
+    return pulumi.output(configs).apply((configs) => {
+        const errors = userDataConfigErrors(configs);
+        if (errors.length > 0) {
+            throw new pulumi.InputPropertiesError({
+                message: "Invalid arguments for node group",
+                errors: errors,
+            });
+        }
+        return configs;
+    });
+}
+
+function userDataConfigErrors(
+    configs: pulumi.Unwrap<UserDataConfigs>,
+): pulumi.InputPropertyErrorDetails[] {
+    return [
+        ...validateBottlerocketConfig(configs.bottlerocketConfig, "bottlerocketConfig"),
+        ...validateNodeConfig(configs.nodeConfig, "nodeConfig"),
+    ];
+}
+
 /**
  * Maps an EKS taint effect enum to its corresponding Kubernetes taint effect.
  *
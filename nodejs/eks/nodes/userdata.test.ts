@@ -71,6 +71,8 @@ describe("requiresCustomUserData", () => {
     });
 });
 
+// The snapshots of createUserData are the golden files of the Go user data renderer in provider/pkg/userdata, whose
+// tests fail if they differ. Update the golden files together with the snapshots.
 describe("createUserData", () => {
     describe("linux", () => {
         it("should return the correct user data for a basic NodeGroup v1", () => {
@@ -667,6 +669,258 @@ describe("createUserData", () => {
     });
 });
 
+const clusterMetadata = {
+    name: "example-cluster",
+    apiServerEndpoint:
+        "https://71E3210BB45D2B930AAA878706C3E369.sk1.us-west-2.eks.amazonaws.com",
+    certificateAuthority: "Y2VydGlmaWNhdGUtYXV0aG9yaXR5",
+    serviceCidr: "10.100.0.0/16",
+};
+
+describe("createUserData with maxPods", () => {
+    it("should add the max-pods kubelet flag for linux", () => {
+        const userDataArgs = {
+            nodeGroupType: "managed",
+            maxPods: 110,
+        } as ManagedNodeUserDataArgs;
+
+        const userData = createUserData(
+            OperatingSystem.AL2,
+            clusterMetadata,
+            userDataArgs,
+            undefined,
+        );
+        expect(userData).toContain("--kubelet-extra-args --max-pods=110");
+    });
+
+    it("should add the max-pods kubelet flag for nodeadm", () => {
+        const userDataArgs = {
+            nodeGroupType: "self-managed-v2",
+            stackName: "example-cluster",
+            maxPods: 110,
+        } as SelfManagedV2NodeUserDataArgs;
+
+        const userData = createUserData(
+            OperatingSystem.AL2023,
+            clusterMetadata,
+            userDataArgs,
+            undefined,
+        );
+        expect(userData).toContain("- '--max-pods=110'");
+    });
+
+    it("should not override the max-pods flag of kubeletExtraArgs", () => {
+        const userDataArgs = {
+            nodeGroupType: "managed",
+            kubeletExtraArgs: "--max-pods=50",
+            maxPods: 110,
+        } as ManagedNodeUserDataArgs;
+
+        const userData = createUserData(
+            OperatingSystem.AL2023,
+            clusterMetadata,
+            userDataArgs,
+            undefined,
+        );
+        expect(userData).toContain("- '--max-pods=50'");
+        expect(userData).not.toContain("--max-pods=110");
+    });
+
+    it("should set max-pods for Bottlerocket unless configured in the settings", () => {
+        const create = (bottlerocketSettings: object | undefined) =>
+            createUserData(
+                OperatingSystem.Bottlerocket,
+                clusterMetadata,
+                {
+                    nodeGroupType: "managed",
+                    maxPods: 110,
+                    bottlerocketSettings,
+                } as ManagedNodeUserDataArgs,
+                undefined,
+            );
+
+        expect(create(undefined)).toContain("max-pods = 110");
+        expect(create({ settings: { kubernetes: { "max-pods": 50 } } })).toContain("max-pods = 50");
+    });
+
+    it("should render max-pods for a ManagedNodeGroup with linux", () => {
+        const userDataArgs = {
+            nodeGroupType: "managed",
+            kubeletExtraArgs: "--v=2",
+            labels: { ondemand: "true" },
+            maxPods: 110,
+        } as ManagedNodeUserDataArgs;
+
+        const userData = createUserData(
+            OperatingSystem.AL2,
+            clusterMetadata,
+            userDataArgs,
+            undefined,
+        );
+        expect(userData).toMatchSnapshot();
+    });
+
+    it("should render max-pods for a NodeGroup v2 with nodeadm", () => {
+        const userDataArgs = {
+            nodeGroupType: "self-managed-v2",
+            stackName: "example-cluster",
+            labels: { ondemand: "true" },
+            maxPods: 110,
+        } as SelfManagedV2NodeUserDataArgs;
+
+        const userData = createUserData(
+            OperatingSystem.AL2023,
+            clusterMetadata,
+            userDataArgs,
+            undefined,
+        );
+        expect(userData).toMatchSnapshot();
+    });
+});
+
+describe("createUserData with bottlerocketConfig", () => {
+    it("should render the typed config for Bottlerocket", () => {
+        const userDataArgs = {
+            nodeGroupType: "managed",
+            bottlerocketConfig: {
+                kubernetes: { maxPods: 50 },
+                kernel: { sysctl: { "net.ipv4.ip_forward": "1" } },
+            },
+        } as ManagedNodeUserDataArgs;
+
+        const userData = createUserData(
+            OperatingSystem.Bottlerocket,
+            clusterMetadata,
+            userDataArgs,
+            undefined,
+        );
+        expect(userData).toContain("max-pods = 50");
+        expect(userData).toContain(`"net.ipv4.ip_forward" = "1"`);
+        expect(userData).toContain(`cluster-name = "example-cluster"`);
+    });
+
+    it("should give bottlerocketSettings precedence over the typed config", () => {
+        const userDataArgs = {
+            nodeGroupType: "managed",
+            bottlerocketConfig: { kubernetes: { maxPods: 50 } },
+            bottlerocketSettings: { settings: { kubernetes: { "max-pods": 60 } } },
+        } as ManagedNodeUserDataArgs;
+
+        const userData = createUserData(
+            OperatingSystem.Bottlerocket,
+            clusterMetadata,
+            userDataArgs,
+            undefined,
+        );
+        expect(userData).toContain("max-pods = 60");
+        expect(userData).not.toContain("max-pods = 50");
+    });
+
+    it("should merge the typed config with bottlerocketSettings", () => {
+        const userDataArgs = {
+            nodeGroupType: "managed",
+            bottlerocketConfig: {
+                kubernetes: { maxPods: 50, evictionHard: { "memory.available": "15%" } },
+                kernel: { sysctl: { "net.ipv4.ip_forward": "1" } },
+                containerRegistry: {
+                    mirrors: [{ registry: "docker.io", endpoints: ["https://mirror.example.com"] }],
+                },
+            },
+            bottlerocketSettings: {
+                settings: {
+                    kubernetes: { "max-pods": 60 },
+                    "host-containers": { admin: { enabled: true } },
+                },
+            },
+            maxPods: 110,
+        } as ManagedNodeUserDataArgs;
+
+        const userData = createUserData(
+            OperatingSystem.Bottlerocket,
+            clusterMetadata,
+            userDataArgs,
+            undefined,
+        );
+        expect(userData).toMatchSnapshot();
+    });
+
+    it("should throw an error for other operating systems", () => {
+        const userDataArgs = {
+            nodeGroupType: "managed",
+            bottlerocketConfig: { kubernetes: { maxPods: 50 } },
+        } as ManagedNodeUserDataArgs;
+
+        expect(() =>
+            createUserData(OperatingSystem.AL2023, clusterMetadata, userDataArgs, undefined),
+        ).toThrow(
+            "The 'bottlerocketConfig' argument is not supported for nodeadm based user data.",
+        );
+    });
+});
+
+describe("createUserData with nodeConfig", () => {
+    it("should render the typed NodeConfig as nodeadm part", () => {
+        const userDataArgs = {
+            nodeGroupType: "managed",
+            nodeConfig: {
+                kubelet: { config: { shutdownGracePeriod: "30s" }, flags: ["--v=2"] },
+                instance: { localStorage: { strategy: "RAID0" } },
+            },
+        } as ManagedNodeUserDataArgs;
+
+        const userData = createUserData(
+            OperatingSystem.AL2023,
+            clusterMetadata,
+            userDataArgs,
+            undefined,
+        );
+        expect(userData).toContain("Content-Type: application/node.eks.aws");
+        expect(userData).toContain("shutdownGracePeriod: 30s");
+        expect(userData).toContain("- '--v=2'");
+        expect(userData).toContain("strategy: RAID0");
+    });
+
+    it("should render the typed NodeConfig after the generated parts", () => {
+        const userDataArgs = {
+            nodeGroupType: "managed",
+            labels: { ondemand: "true" },
+            nodeConfig: {
+                kubelet: {
+                    config: { shutdownGracePeriod: "30s", cpuCFSQuota: false },
+                    flags: ["--v=2"],
+                },
+                containerd: {
+                    config: `[plugins."io.containerd.grpc.v1.cri"]\nenable_cdi = true\n`,
+                },
+                instance: { localStorage: { strategy: "RAID0" } },
+            },
+            maxPods: 110,
+        } as ManagedNodeUserDataArgs;
+
+        const userData = createUserData(
+            OperatingSystem.AL2023,
+            clusterMetadata,
+            userDataArgs,
+            undefined,
+        );
+        expect(userData).toMatchSnapshot();
+    });
+
+    it("should throw an error for other operating systems", () => {
+        const userDataArgs = {
+            nodeGroupType: "managed",
+            nodeConfig: { kubelet: { flags: ["--v=2"] } },
+        } as ManagedNodeUserDataArgs;
+
+        expect(() =>
+            createUserData(OperatingSystem.Bottlerocket, clusterMetadata, userDataArgs, undefined),
+        ).toThrow("The 'nodeConfig' argument is not supported with Bottlerocket.");
+        expect(() =>
+            createUserData(OperatingSystem.AL2, clusterMetadata, userDataArgs, undefined),
+        ).toThrow("The 'nodeConfig' argument is not supported for Linux based user data.");
+    });
+});
+
 describe("getClusterDnsIp", () => {
     test.each([
         ["10.100.0.0/16", "10.100.0.10"],
@@ -20,6 +20,12 @@ import * as jsyaml from "js-yaml";
 import * as toml from "@iarna/toml";
 import * as ipaddr from "ipaddr.js";
 import { isObject } from "../utilities";
+import {
+    BottlerocketConfig,
+    bottlerocketConfigToSettings,
+    mergeBottlerocketSettings,
+} from "./bottlerocket";
+import { NodeConfig, renderNodeConfig } from "./nodeconfig";
 
 // linux is the default user data type for AMIs that use the eks bootstrap script. (e.g. AL2)
 // nodeadm is the user data type for AMIs that use nodeadm to bootstrap the node. (e.g. AL2023)
@@ -58,6 +64,12 @@ interface BaseUserDataArgs {
     labels: { [key: string]: string } | undefined;
     taints: { [key: string]: Taint } | undefined;
 
+    /**
+     * The maximum number of pods the kubelet runs on the node. Takes precedence over the value computed by the AMI,
+     * but not over a `--max-pods` flag in `kubeletExtraArgs` or a `max-pods` Bottlerocket setting.
+     */
+    maxPods?: number;
+
     /**
      * User specified code to run on node startup. This code is expected to
      * handle the full AWS EKS bootstrapping code.
@@ -66,6 +78,8 @@ interface BaseUserDataArgs {
      */
     userDataOverride: string | undefined;
     bottlerocketSettings: object | undefined;
+    bottlerocketConfig?: pulumi.Unwrap<BottlerocketConfig>;
+    nodeConfig?: pulumi.Unwrap<NodeConfig>;
     nodeadmExtraOptions:
         | {
               content: string;
@@ -89,6 +103,8 @@ interface BaseSelfManagedNodeUserDataArgs extends BaseUserDataArgs {
 
 type CustomUserDataArgs = Pick<UserDataArgs, "bootstrapExtraArgs" | "kubeletExtraArgs"> & {
     bottlerocketSettings: pulumi.Input<object> | undefined;
+    bottlerocketConfig?: pulumi.Input<BottlerocketConfig>;
+    nodeConfig?: pulumi.Input<NodeConfig>;
     nodeadmExtraOptions: pulumi.Input<pulumi.Input<NodeadmOptions>[]> | undefined;
 };
 
@@ -136,6 +152,8 @@ export const customUserDataArgs: (keyof CustomUserDataArgs)[] = [
     "bootstrapExtraArgs",
     "kubeletExtraArgs",
     "bottlerocketSettings",
+    "bottlerocketConfig",
+    "nodeConfig",
     "nodeadmExtraOptions",
 ];
 
@@ -197,6 +215,20 @@ function createLinuxUserData(
         );
     }
 
+    if (args.bottlerocketConfig) {
+        throw new pulumi.ResourceError(
+            "The 'bottlerocketConfig' argument is not supported for Linux based user data.",
+            parent,
+        );
+    }
+
+    if (args.nodeConfig) {
+        throw new pulumi.ResourceError(
+            "The 'nodeConfig' argument is not supported for Linux based user data.",
+            parent,
+        );
+    }
+
     // build the bootstrap arguments, they can also include kubelet flags if the user has provided them
     const kubeletExtraArgs = buildKubeletFlags(args);
     let bootstrapExtraArgs = args.bootstrapExtraArgs ? " " + args.bootstrapExtraArgs : "";
@@ -265,6 +297,13 @@ function createNodeadmUserData(
         );
     }
 
+    if (args.bottlerocketConfig) {
+        throw new pulumi.ResourceError(
+            "The 'bottlerocketConfig' argument is not supported for nodeadm based user data.",
+            parent,
+        );
+    }
+
     if (args.bootstrapExtraArgs && args.bootstrapExtraArgs !== "") {
         throw new pulumi.ResourceError(
             "The 'bootstrapExtraArgs' argument is not supported for nodeadm based user data.",
@@ -316,6 +355,11 @@ function createNodeadmUserData(
         });
     }
 
+    // add the typed NodeConfig if provided, the extra nodeadm options can still override it
+    if (args.nodeConfig) {
+        parts.push(renderNodeConfig(args.nodeConfig));
+    }
+
     // add extra nodeadm options if provided
     if (args.nodeadmExtraOptions) {
         for (const option of args.nodeadmExtraOptions) {
@@ -370,6 +414,7 @@ ${part.content}
 /**
  * Builds the kubelet flags based on the provided arguments. If the user has provided labels or taints, they will be
  * added to the kubelet flags. This ensures that the kubelet registers the node with the correct labels and taints.
+ * The maximum number of pods is added as well, unless the user has already set it in `kubeletExtraArgs`.
  *
  * @param args - The UserDataArgs object containing the arguments.
  * @returns An array of strings representing the kubelet flags.
@@ -400,6 +445,9 @@ function buildKubeletFlags(args: UserDataArgs): string[] {
             kubeletExtraArgs.push("--register-with-taints=" + parts.join(","));
         }
     }
+    if (args.maxPods && !kubeletExtraArgs.some((arg) => arg.startsWith("--max-pods"))) {
+        kubeletExtraArgs.push(`--max-pods=${args.maxPods}`);
+    }
     return kubeletExtraArgs;
 }
 
@@ -426,6 +474,13 @@ function createBottlerocketUserData(
         );
     }
 
+    if (args.nodeConfig) {
+        throw new pulumi.ResourceError(
+            "The 'nodeConfig' argument is not supported with Bottlerocket.",
+            parent,
+        );
+    }
+
     if (isSelfManagedNodeUserDataArgs(args) && args.extraUserData && args.extraUserData !== "") {
         throw new pulumi.ResourceError(
             "Bottlerocket does not support running scripts as part of the user data. If you need to run scripts, please use a different OS.",
@@ -451,6 +506,12 @@ function createBottlerocketUserData(
         });
     }
 
+    if (args.maxPods) {
+        Object.assign(baseConfig.settings.kubernetes, {
+            "max-pods": args.maxPods,
+        });
+    }
+
     if (args.taints) {
         const taints = {};
         const records = Object.entries(args.taints).map(([key, taint]) => {
@@ -464,7 +525,11 @@ function createBottlerocketUserData(
         });
     }
 
-    const bottlerocketSettings: any = args.bottlerocketSettings ?? {};
+    // the typed config gets converted to settings, the untyped settings take precedence over it
+    const bottlerocketSettings: any = mergeBottlerocketSettings(
+        args.bottlerocketConfig ? bottlerocketConfigToSettings(args.bottlerocketConfig) : {},
+        args.bottlerocketSettings,
+    );
 
     if (!("settings" in bottlerocketSettings && isObject(bottlerocketSettings.settings))) {
         bottlerocketSettings.settings = {};
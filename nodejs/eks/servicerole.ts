@@ -14,15 +14,8 @@
 
 import * as aws from "@pulumi/aws";
 import * as pulumi from "@pulumi/pulumi";
-import * as crypto from "crypto";
 
-// sha1hash returns a partial SHA1 hash of the input string.
-function sha1hash(s: string): string {
-    const shasum: crypto.Hash = crypto.createHash("sha1");
-    shasum.update(s);
-    // Limit the size of hashes to ensure we generate shorter/ resource names.
-    return shasum.digest("hex").substring(0, 8);
-}
+import { sha1hash } from "./utilities";
 
 /**
  * ServiceRoleArgs describe the parameters to a ServiceRole component.
@@ -12,7 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-import { getRegionFromArn } from "./utilities";
+import * as pulumi from "@pulumi/pulumi";
+import { containsOutputs, getRegionFromArn, sha1hash } from "./utilities";
 
 describe("getRegionFromArn", () => {
     test.each([
@@ -45,3 +46,35 @@ describe("getRegionFromArn", () => {
         expect(() => getRegionFromArn(arn)).toThrow("Invalid ARN: ''");
     });
 });
+
+describe("sha1hash", () => {
+    it("should return a short hash that can be used in resource names", () => {
+        const hash = sha1hash(
+            "k8s.io/cluster-autoscaler/node-template/label/node.kubernetes.io/role",
+        );
+        expect(hash).toMatch(/^[0-9a-f]{8}$/);
+    });
+
+    it("should distinguish keys that only differ in special characters", () => {
+        expect(sha1hash("label/a.b")).not.toEqual(sha1hash("label/a/b"));
+    });
+});
+
+describe("containsOutputs", () => {
+    test.each([
+        ["undefined", undefined],
+        ["a string", "value"],
+        ["a plain object", { kubernetes: { maxPods: 110 }, flags: ["--v=2"] }],
+    ])("should return false for %s", (_, value) => {
+        expect(containsOutputs(value)).toBe(false);
+    });
+
+    test.each([
+        ["an output", pulumi.output("value")],
+        ["a promise", Promise.resolve("value")],
+        ["a nested output", { kubernetes: { maxPods: pulumi.output(110) } }],
+        ["an output in an array", { flags: ["--v=2", pulumi.output("--node-labels=a=b")] }],
+    ])("should return true for %s", (_, value) => {
+        expect(containsOutputs(value)).toBe(true);
+    });
+});
@@ -12,6 +12,9 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+import * as pulumi from "@pulumi/pulumi";
+import * as crypto from "crypto";
+
 /** @internal */
 export function getVersion(): string {
     let version = require("./package.json").version;
@@ -23,10 +26,35 @@ export function getVersion(): string {
     return version;
 }
 
+// sha1hash returns a partial SHA1 hash of the input string.
+export function sha1hash(s: string): string {
+    const shasum: crypto.Hash = crypto.createHash("sha1");
+    shasum.update(s);
+    // Limit the size of hashes to ensure we generate shorter/ resource names.
+    return shasum.digest("hex").substring(0, 8);
+}
+
 export function isObject(obj: any): obj is Record<string, any> {
     return obj !== null && typeof obj === "object" && !Array.isArray(obj);
 }
 
+/**
+ * Returns whether the value contains outputs or promises at any depth, i.e. whether it can only be inspected in an
+ * apply.
+ */
+export function containsOutputs(value: any): boolean {
+    if (pulumi.Output.isInstance(value) || value instanceof Promise) {
+        return true;
+    }
+    if (Array.isArray(value)) {
+        return value.some(containsOutputs);
+    }
+    if (isObject(value)) {
+        return Object.values(value).some(containsOutputs);
+    }
+    return false;
+}
+
 /**
  * Extracts the AWS region from an Amazon Resource Name (ARN).
  *
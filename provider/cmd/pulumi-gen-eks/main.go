@@ -128,6 +128,7 @@ func rootCmd() *cobra.Command {
 		},
 	}
 	cmd.PersistentFlags().StringVarP(&outDir, "out", "o", "", "Emit the generated code to this directory")
+	cmd.AddCommand(userDataCmd())
 	return cmd
 }
 
@@ -208,33 +209,102 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 					"- https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-role.html\n" +
 					"- https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-profiles.html",
 				Inputs: &schema.ObjectTypeSpec{
+					Properties: kubeconfigMethodInputProperties(),
+					Required:   []string{"__self__"},
+				},
+				Outputs: &schema.ObjectTypeSpec{
 					Properties: map[string]schema.PropertySpec{
-						"__self__": {
-							TypeSpec: schema.TypeSpec{Ref: "#/resources/eks:index:Cluster"},
+						"result": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The kubeconfig for the cluster.",
 						},
-						"roleArn": {
-							Description: "Role ARN to assume instead of the default AWS credential provider " +
-								"chain.\n\n" +
-								"The role is passed to kubeconfig as an authentication exec argument.",
+					},
+					Required: []string{"result"},
+				},
+			},
+			"eks:index:Cluster/getStaticKubeconfig": {
+				Description: "Generate a kubeconfig that authenticates with a static bearer token instead of an exec " +
+					"plugin. This is useful for consumers that cannot run the AWS CLI, e.g. Argo CD cluster " +
+					"secrets or services using a Kubernetes client library.\n\n" +
+					"The token is a pre-signed STS `GetCallerIdentity` request retrieved with the configured " +
+					"`tokenTool` when the program runs, so the tool needs to be installed where Pulumi runs. " +
+					"Tokens are short-lived (15 minutes) and a new token is generated on every update, so " +
+					"consumers need to refresh the kubeconfig before `expirationTimestamp`.\n\n" +
+					"See for more details:\n" +
+					"- https://docs.aws.amazon.com/eks/latest/userguide/cluster-auth.html\n" +
+					"- https://github.com/kubernetes-sigs/aws-iam-authenticator#api-authorization-from-outside-a-cluster",
+				Inputs: &schema.ObjectTypeSpec{
+					Properties: kubeconfigMethodInputProperties(),
+					Required:   []string{"__self__"},
+				},
+				Outputs: &schema.ObjectTypeSpec{
+					Properties: map[string]schema.PropertySpec{
+						"kubeconfig": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The stringified kubeconfig containing the bearer token for the cluster.",
+							Secret:      true,
+						},
+						"expirationTimestamp": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The time at which the token expires, in RFC 3339 format.",
+						},
+					},
+					Required: []string{"kubeconfig", "expirationTimestamp"},
+				},
+			},
+			"eks:index:getOptimizedAmi": {
+				Description: "Resolves the EKS optimized AMI for a Kubernetes version and AMI type. The AMI is looked " +
+					"up via the public SSM parameters published by AWS, the same way node groups resolve their AMI " +
+					"when no `amiId` is provided.\n\n" +
+					"If no `releaseVersion` is specified, the release version of the currently recommended AMI is " +
+					"used. The resolved release version is returned so that it can be pinned, e.g. to roll out AMI " +
+					"updates in a controlled fashion by feeding the `amiId` into `NodeGroupV2.amiId`.\n\n" +
+					"The SSM parameters are read using the AWS CLI, which needs to be installed and configured " +
+					"with credentials for the account of the stack. The region is always passed explicitly.\n\n" +
+					"See for more details: https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html",
+				Inputs: &schema.ObjectTypeSpec{
+					Properties: map[string]schema.PropertySpec{
+						"kubernetesVersion": {
+							Description: "The Kubernetes version of the AMI, e.g. `1.31`.",
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+						},
+						"amiType": {
+							Description: "The AMI type to resolve the AMI for.",
+							TypeSpec:    schema.TypeSpec{Ref: "#/types/eks:index:AmiType"},
+						},
+						"releaseVersion": {
+							Description: "The release version of the AMI to resolve. For Amazon Linux AMIs this is " +
+								"the version reported by EKS, e.g. `1.31.0-20240917`. For Bottlerocket AMIs this is " +
+								"the Bottlerocket version, e.g. `1.22.0`.\n\n" +
+								"Defaults to the release version of the currently recommended AMI.",
 							TypeSpec: schema.TypeSpec{Type: "string"},
 						},
-						"profileName": {
-							Description: "AWS credential profile name to always use instead of the default AWS " +
-								"credential provider chain.\n\n" +
-								"The profile is passed to kubeconfig as an authentication environment setting.",
+						"region": {
+							Description: "The AWS region to resolve the AMI in. This should be the region of the " +
+								"stack, e.g. the `aws:region` config value.",
 							TypeSpec: schema.TypeSpec{Type: "string"},
 						},
 					},
-					Required: []string{"__self__"},
+					Required: []string{"kubernetesVersion", "amiType", "region"},
 				},
 				Outputs: &schema.ObjectTypeSpec{
 					Properties: map[string]schema.PropertySpec{
-						"result": {
+						"amiId": {
+							Description: "The ID of the AMI.",
 							TypeSpec:    schema.TypeSpec{Type: "string"},
-							Description: "The kubeconfig for the cluster.",
+						},
+						"releaseVersion": {
+							Description: "The release version of the AMI.",
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+						},
+						"ssmParameterName": {
+							Description: "The name of the SSM parameter the AMI ID was read from. The parameter is " +
+								"specific to the release version of the AMI and does not change when new AMIs " +
+								"are released.",
+							TypeSpec: schema.TypeSpec{Type: "string"},
 						},
 					},
-					Required: []string{"result"},
+					Required: []string{"amiId", "releaseVersion", "ssmParameterName"},
 				},
 			},
 		},
@@ -668,6 +738,24 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 							"of values are: [\"api\", \"audit\", \"authenticator\", \"controllerManager\", " +
 							"\"scheduler\"]. By default it is off.",
 					},
+					"clusterLogRetentionDays": {
+						TypeSpec: schema.TypeSpec{Type: "integer"},
+						Description: "The number of days to retain the control plane logs in the `/aws/eks/<name>/cluster` log group. " +
+							"Possible values are: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, " +
+							"2557, 2922, 3288, 3653, and 0. If set to 0, the logs never expire.\n\n" +
+							"When set, the log group is created and managed by this component before the cluster is created. " +
+							"This requires `name` to be set. Set `name` to the name of the existing cluster when enabling this " +
+							"for an existing cluster, and import the log group if EKS already created it.",
+					},
+					"clusterLogKmsKeyArn": {
+						TypeSpec: schema.TypeSpec{Type: "string"},
+						Description: "The ARN of the KMS key to encrypt the control plane logs in the `/aws/eks/<name>/cluster` log group with. " +
+							"The key policy must allow the CloudWatch Logs service principal of the region to use the key.\n\n" +
+							"When set, the log group is created and managed by this component before the cluster is created. " +
+							"This requires `name` to be set.\n\n" +
+							"See for more details:\n" +
+							"- https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/encrypt-log-data-kms.html",
+					},
 					"bootstrapSelfManagedAddons": {
 						TypeSpec: schema.TypeSpec{Type: "boolean"},
 						Description: "Install default unmanaged add-ons, such as `aws-cni`, `kube-proxy`, and CoreDNS during cluster creation. " +
@@ -768,6 +856,17 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 							"See for more details:\n" +
 							"- https://aws.amazon.com/about-aws/whats-new/2020/03/amazon-eks-adds-envelope-encryption-for-secrets-with-aws-kms/",
 					},
+					"encryptionConfig": {
+						TypeSpec: schema.TypeSpec{
+							Plain: true,
+							Ref:   "#/types/eks:index:EncryptionConfigOptions",
+						},
+						Description: "Creates a KMS key and alias for the encryption configuration of the cluster. The key policy grants " +
+							"the cluster role the permissions it needs to use the key for envelope encryption of Kubernetes secrets.\n\n" +
+							"Mutually exclusive with `encryptionConfigKeyArn`.\n" +
+							"See for more details:\n" +
+							"- https://docs.aws.amazon.com/eks/latest/userguide/envelope-encryption.html",
+					},
 					"ipFamily": {
 						TypeSpec: schema.TypeSpec{Type: "string"},
 						Description: "The IP family used to assign Kubernetes pod and service addresses. Valid values are `ipv4` (default) and `ipv6`.\n" +
@@ -845,13 +944,26 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 						TypeSpec:    schema.TypeSpec{Ref: awsRef("#/types/aws:eks%2FClusterUpgradePolicy:ClusterUpgradePolicy", dependencies.Aws)},
 						Description: `The cluster's upgrade policy. Valid support types are "STANDARD" and "EXTENDED". Defaults to "EXTENDED".`,
 					},
+					"zonalShiftConfig": {
+						TypeSpec: schema.TypeSpec{Ref: awsRef("#/types/aws:eks%2FClusterZonalShiftConfig:ClusterZonalShiftConfig", dependencies.Aws)},
+						Description: "The zonal shift configuration of the cluster. Enabling zonal shift lets Amazon Application Recovery " +
+							"Controller (ARC) move traffic away from an impaired Availability Zone.\n\n" +
+							"See for more details: https://docs.aws.amazon.com/eks/latest/userguide/zone-shift.html",
+					},
+					"controlPlaneScalingConfig": {
+						TypeSpec: schema.TypeSpec{Ref: awsRef("#/types/aws:eks%2FClusterControlPlaneScalingConfig:ClusterControlPlaneScalingConfig", dependencies.Aws)},
+						Description: "The scaling configuration of the control plane. Setting a provisioned `tier` reserves control plane " +
+							"capacity for the cluster instead of scaling it on demand.\n\n" +
+							"See for more details: https://docs.aws.amazon.com/eks/latest/userguide/eks-provisioned-control-plane.html",
+					},
 					"deletionProtection": {
 						TypeSpec:    schema.TypeSpec{Type: "boolean"},
 						Description: "Whether to enable deletion protection for the cluster. When enabled, the cluster cannot be deleted unless deletion protection is first disabled. Default: `false`.",
 					},
 				},
 				Methods: map[string]string{
-					"getKubeconfig": "eks:index:Cluster/getKubeconfig",
+					"getKubeconfig":       "eks:index:Cluster/getKubeconfig",
+					"getStaticKubeconfig": "eks:index:Cluster/getStaticKubeconfig",
 				},
 			},
 			"eks:index:ClusterCreationRoleProvider": {
@@ -1094,6 +1206,12 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 							"  - settings.kubernetes.cluster-dns-ip\n\n" +
 							"For an overview of the available settings, see https://bottlerocket.dev/en/os/1.20.x/api/settings/.",
 					},
+					"bottlerocketConfig": {
+						TypeSpec: schema.TypeSpec{Ref: "#/types/eks:index:BottlerocketConfig"},
+						Description: "Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.\n" +
+							"The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. " +
+							"Settings in `bottlerocketSettings` take precedence over it.",
+					},
 					"userData": {
 						TypeSpec: schema.TypeSpec{Type: "string"},
 						Description: "User specified code to run on node startup. This is expected to handle " +
@@ -1114,6 +1232,14 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 							"Note: `amiId` is mutually exclusive with `gpu` and `amiType`.\n\n" +
 							"See for more details: https://docs.aws.amazon.com/eks/latest/userguide/eks-optimized-ami.html.",
 					},
+					"nodeConfig": {
+						TypeSpec: schema.TypeSpec{Ref: "#/types/eks:index:NodeConfig"},
+						Description: "Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. " +
+							"It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. " +
+							"Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.\n\n" +
+							"Note: This is only applicable when using AL2023.\n" +
+							"See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/",
+					},
 					"nodeadmExtraOptions": {
 						TypeSpec: schema.TypeSpec{
 							Type:  "array",
@@ -1141,6 +1267,16 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 						Description: "Whether to ignore changes to the desired size of the Auto Scaling Group. This is useful when using Cluster Autoscaler.\n\n" +
 							"See [EKS best practices](https://aws.github.io/aws-eks-best-practices/cluster-autoscaling/) for more details.",
 					},
+					"autoscalerDiscovery": {
+						TypeSpec: schema.TypeSpec{
+							Type:  "boolean",
+							Plain: true,
+						},
+						Description: "Tags the Auto Scaling Group of the node group with the `labels` and `taints` as node template tags " +
+							"of the Cluster Autoscaler, so it can scale the node group up from zero. EKS tags the Auto Scaling Groups " +
+							"of managed node groups for the auto-discovery of the Cluster Autoscaler itself.\n\n" +
+							"This implies `ignoreScalingChanges` unless it is explicitly disabled.",
+					},
 					"placementGroupAvailabilityZone": {
 						TypeSpec: schema.TypeSpec{
 							Type: "string",
@@ -1345,6 +1481,243 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 				},
 				RequiredInputs: []string{"addonName", "cluster"},
 			},
+			"eks:index:AutoModeNodeClass": {
+				IsComponent: true,
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Description: "AutoModeNodeClass creates an EKS Auto Mode NodeClass, which configures the networking, storage " +
+						"and IAM role of the nodes of the node pools that reference it.\n" +
+						"For more information see: https://docs.aws.amazon.com/eks/latest/userguide/create-node-class.html",
+					Properties: map[string]schema.PropertySpec{
+						"nodeClass": {
+							TypeSpec:    schema.TypeSpec{Ref: k8sRef("#/resources/kubernetes:apiextensions.k8s.io:CustomResource", dependencies.Kubernetes)},
+							Description: "The NodeClass custom resource.",
+						},
+						"nodeClassName": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The name of the NodeClass, which node pools reference with `nodeClassName`.",
+						},
+						"accessEntry": {
+							TypeSpec:    schema.TypeSpec{Ref: awsRef("#/resources/aws:eks%2FaccessEntry:AccessEntry", dependencies.Aws)},
+							Description: "The access entry of the custom node role, if `nodeRoleArn` is set.",
+						},
+					},
+					Required: []string{"nodeClass", "nodeClassName"},
+				},
+				InputProperties: map[string]schema.PropertySpec{
+					"cluster": {
+						TypeSpec:    schema.TypeSpec{Ref: "#/resources/eks:index:Cluster"},
+						Description: "The target EKS cluster. EKS Auto Mode must be enabled on the cluster.",
+					},
+					"nodeRoleArn": {
+						TypeSpec: schema.TypeSpec{Type: "string"},
+						Description: "The ARN of the IAM role of the nodes. Defaults to the node role of EKS Auto Mode created by the cluster.\n\n" +
+							"An access entry of type `EC2` with the `AmazonEKSAutoNodePolicy` access policy is created for custom node roles, " +
+							"which allows their nodes to join the cluster.",
+					},
+					"subnetSelectorTerms": {
+						TypeSpec: schema.TypeSpec{
+							Type:  "array",
+							Items: &schema.TypeSpec{Ref: "#/types/eks:index:AutoModeSelectorTerm"},
+						},
+						Description: "The subnets to launch nodes into. Defaults to the subnets of the cluster.",
+					},
+					"securityGroupSelectorTerms": {
+						TypeSpec: schema.TypeSpec{
+							Type:  "array",
+							Items: &schema.TypeSpec{Ref: "#/types/eks:index:AutoModeSelectorTerm"},
+						},
+						Description: "The security groups of the nodes. Defaults to the cluster security group.",
+					},
+					"snatPolicy": {
+						TypeSpec:    schema.TypeSpec{Type: "string"},
+						Description: "The source NAT policy of the pod traffic leaving the VPC, `Random` or `Disabled`. Defaults to `Random`.",
+					},
+					"networkPolicy": {
+						TypeSpec:    schema.TypeSpec{Type: "string"},
+						Description: "The default network policy of the pods, `DefaultAllow` or `DefaultDeny`. Defaults to `DefaultAllow`.",
+					},
+					"networkPolicyEventLogs": {
+						TypeSpec:    schema.TypeSpec{Type: "string"},
+						Description: "Whether to log the network policy decisions, `Enabled` or `Disabled`. Defaults to `Disabled`.",
+					},
+					"ephemeralStorage": {
+						TypeSpec:    schema.TypeSpec{Ref: "#/types/eks:index:AutoModeEphemeralStorage"},
+						Description: "The ephemeral storage of the nodes.",
+					},
+					"tags": {
+						TypeSpec: schema.TypeSpec{
+							Type:                 "object",
+							AdditionalProperties: &schema.TypeSpec{Type: "string"},
+						},
+						Description: "The tags to apply to the EC2 resources created for the nodes.",
+					},
+				},
+				RequiredInputs: []string{"cluster"},
+			},
+			"eks:index:AutoModeNodePool": {
+				IsComponent: true,
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Description: "AutoModeNodePool creates an EKS Auto Mode NodePool, which defines which nodes EKS Auto Mode " +
+						"launches for pending pods and when it disrupts them.\n" +
+						"For more information see: https://docs.aws.amazon.com/eks/latest/userguide/create-node-pool.html",
+					Properties: map[string]schema.PropertySpec{
+						"nodePool": {
+							TypeSpec:    schema.TypeSpec{Ref: k8sRef("#/resources/kubernetes:apiextensions.k8s.io:CustomResource", dependencies.Kubernetes)},
+							Description: "The NodePool custom resource.",
+						},
+						"nodePoolName": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The name of the NodePool.",
+						},
+					},
+					Required: []string{"nodePool", "nodePoolName"},
+				},
+				InputProperties: map[string]schema.PropertySpec{
+					"cluster": {
+						TypeSpec:    schema.TypeSpec{Ref: "#/resources/eks:index:Cluster"},
+						Description: "The target EKS cluster. EKS Auto Mode must be enabled on the cluster.",
+					},
+					"nodeClassName": {
+						TypeSpec: schema.TypeSpec{Type: "string"},
+						Description: "The name of the NodeClass of the nodes, e.g. `nodeClass.nodeClassName`. Defaults to `default`, " +
+							"the NodeClass of the built-in node pools.",
+					},
+					"requirements": {
+						TypeSpec: schema.TypeSpec{
+							Type:  "array",
+							Items: &schema.TypeSpec{Ref: "#/types/eks:index:AutoModeNodeRequirement"},
+						},
+						Description: "The requirements the instances of the node pool have to fulfill.",
+					},
+					"labels": {
+						TypeSpec: schema.TypeSpec{
+							Type:                 "object",
+							AdditionalProperties: &schema.TypeSpec{Type: "string"},
+						},
+						Description: "The labels of the nodes.",
+					},
+					"taints": {
+						TypeSpec: schema.TypeSpec{
+							Type:                 "object",
+							AdditionalProperties: &schema.TypeSpec{Ref: "#/types/eks:index:Taint"},
+						},
+						Description: "The taints of the nodes, keyed by the taint key.",
+					},
+					"startupTaints": {
+						TypeSpec: schema.TypeSpec{
+							Type:                 "object",
+							AdditionalProperties: &schema.TypeSpec{Ref: "#/types/eks:index:Taint"},
+						},
+						Description: "The taints of the nodes that are removed by a daemon once the node is ready, keyed by the taint key.",
+					},
+					"expireAfter": {
+						TypeSpec:    schema.TypeSpec{Type: "string"},
+						Description: "How long a node lives before it is replaced, e.g. `336h`, or `Never`. Defaults to `336h`.",
+					},
+					"terminationGracePeriod": {
+						TypeSpec:    schema.TypeSpec{Type: "string"},
+						Description: "How long a node may take to drain before it is terminated forcefully, e.g. `24h`.",
+					},
+					"disruption": {
+						TypeSpec:    schema.TypeSpec{Ref: "#/types/eks:index:AutoModeDisruption"},
+						Description: "The disruption settings of the node pool.",
+					},
+					"limits": {
+						TypeSpec: schema.TypeSpec{
+							Type:                 "object",
+							AdditionalProperties: &schema.TypeSpec{Type: "string"},
+						},
+						Description: "The maximum resources of all nodes of the node pool, e.g. `{ cpu: \"1000\", memory: \"1000Gi\" }`.",
+					},
+					"weight": {
+						TypeSpec:    schema.TypeSpec{Type: "integer"},
+						Description: "The priority of the node pool. Node pools with a higher weight are preferred.",
+					},
+				},
+				RequiredInputs: []string{"cluster"},
+			},
+			"eks:index:ClusterAutoscaler": {
+				IsComponent: true,
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Description: "ClusterAutoscaler installs the Kubernetes Cluster Autoscaler into an EKS cluster. " +
+						"It creates the IAM role of the autoscaler and installs the cluster-autoscaler Helm chart, which " +
+						"discovers the Auto Scaling Groups tagged for the cluster. Node groups are tagged for discovery " +
+						"with their `autoscalerDiscovery` option.\n" +
+						"For more information see: https://github.com/kubernetes/autoscaler/blob/master/cluster-autoscaler/cloudprovider/aws/README.md",
+					Properties: map[string]schema.PropertySpec{
+						"policy": {
+							TypeSpec:    schema.TypeSpec{Ref: awsRef("#/resources/aws:iam%2Fpolicy:Policy", dependencies.Aws)},
+							Description: "The IAM policy with the permissions of the autoscaler.",
+						},
+						"role": {
+							TypeSpec:    schema.TypeSpec{Ref: awsRef("#/resources/aws:iam%2Frole:Role", dependencies.Aws)},
+							Description: "The IAM role of the autoscaler.",
+						},
+						"release": {
+							TypeSpec:    schema.TypeSpec{Ref: k8sRef("#/resources/kubernetes:helm.sh%2Fv3:Release", dependencies.Kubernetes)},
+							Description: "The Helm release of the autoscaler.",
+						},
+					},
+					Required: []string{"policy", "role", "release"},
+				},
+				InputProperties: controllerProperties("autoscaler", "cluster-autoscaler", "cluster-autoscaler", "9.46.6",
+					[]string{"autoDiscovery", "awsRegion", "rbac.serviceAccount"},
+					map[string]schema.PropertySpec{}),
+				RequiredInputs: []string{"cluster"},
+			},
+			"eks:index:LoadBalancerController": {
+				IsComponent: true,
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Description: "LoadBalancerController installs the AWS Load Balancer Controller into an EKS cluster. " +
+						"It creates the IAM role of the controller, installs the aws-load-balancer-controller Helm chart and " +
+						"tags the given subnets, so the controller can discover them.\n" +
+						"For more information see: https://docs.aws.amazon.com/eks/latest/userguide/aws-load-balancer-controller.html",
+					Properties: map[string]schema.PropertySpec{
+						"policy": {
+							TypeSpec:    schema.TypeSpec{Ref: awsRef("#/resources/aws:iam%2Fpolicy:Policy", dependencies.Aws)},
+							Description: "The IAM policy with the permissions of the controller.",
+						},
+						"role": {
+							TypeSpec:    schema.TypeSpec{Ref: awsRef("#/resources/aws:iam%2Frole:Role", dependencies.Aws)},
+							Description: "The IAM role of the controller.",
+						},
+						"release": {
+							TypeSpec:    schema.TypeSpec{Ref: k8sRef("#/resources/kubernetes:helm.sh%2Fv3:Release", dependencies.Kubernetes)},
+							Description: "The Helm release of the controller.",
+						},
+					},
+					Required: []string{"policy", "role", "release"},
+				},
+				InputProperties: controllerProperties("controller", "aws-load-balancer-controller",
+					"aws-load-balancer-controller", "1.13.0", []string{"clusterName", "vpcId", "region", "serviceAccount"},
+					map[string]schema.PropertySpec{
+						"publicSubnetIds": {
+							TypeSpec: schema.TypeSpec{
+								Type:  "array",
+								Items: &schema.TypeSpec{Type: "string"},
+							},
+							Description: "The public subnets to tag with `kubernetes.io/role/elb`, which makes the controller place " +
+								"internet-facing load balancers into them. The subnet IDs must be known during preview, so subnets " +
+								"created in the same program have to be tagged where they are created instead. If unset, no subnets " +
+								"are tagged.",
+						},
+						"privateSubnetIds": {
+							TypeSpec: schema.TypeSpec{
+								Type:  "array",
+								Items: &schema.TypeSpec{Type: "string"},
+							},
+							Description: "The private subnets to tag with `kubernetes.io/role/internal-elb`, which makes the " +
+								"controller place internal load balancers into them. The subnet IDs must be known during preview, so " +
+								"subnets created in the same program have to be tagged where they are created instead. If unset, no " +
+								"subnets are tagged.",
+						},
+						"releaseName": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The name of the Helm release. Defaults to `aws-load-balancer-controller`.",
+						},
+					}),
+				RequiredInputs: []string{"cluster"},
+			},
 		},
 
 		Types: map[string]schema.ComplexTypeSpec{
@@ -1447,6 +1820,10 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 						"encryptionConfig": {
 							TypeSpec: schema.TypeSpec{Ref: awsRef("#/types/aws:eks%2FClusterEncryptionConfig:ClusterEncryptionConfig", dependencies.Aws)},
 						},
+						"clusterLogGroup": {
+							TypeSpec:    schema.TypeSpec{Ref: awsRef("#/resources/aws:cloudwatch%2FlogGroup:LogGroup", dependencies.Aws)},
+							Description: "The log group of the control plane logs, if it is managed by the cluster.",
+						},
 						"clusterIamRole": {
 							Description: "The IAM Role attached to the EKS Cluster",
 							TypeSpec:    schema.TypeSpec{Ref: awsRef("#/resources/aws:iam%2Frole:Role", dependencies.Aws)},
@@ -1460,6 +1837,22 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 							},
 							Description: "The access entries added to the cluster.",
 						},
+						"vpcCniNetworking": {
+							TypeSpec:    schema.TypeSpec{Ref: "#/types/eks:index:VpcCniNetworking"},
+							Description: "The VPC CNI settings that affect the maximum number of pods of the nodes.",
+						},
+						"upgradePolicy": {
+							TypeSpec:    schema.TypeSpec{Ref: awsRef("#/types/aws:eks%2FClusterUpgradePolicy:ClusterUpgradePolicy", dependencies.Aws)},
+							Description: "The upgrade policy of the cluster, which determines whether it gets extended support.",
+						},
+						"zonalShiftConfig": {
+							TypeSpec:    schema.TypeSpec{Ref: awsRef("#/types/aws:eks%2FClusterZonalShiftConfig:ClusterZonalShiftConfig", dependencies.Aws)},
+							Description: "The zonal shift configuration of the cluster.",
+						},
+						"controlPlaneScalingConfig": {
+							TypeSpec:    schema.TypeSpec{Ref: awsRef("#/types/aws:eks%2FClusterControlPlaneScalingConfig:ClusterControlPlaneScalingConfig", dependencies.Aws)},
+							Description: "The scaling configuration of the control plane, including its provisioned tier.",
+						},
 					},
 					Required: []string{
 						"cluster",
@@ -1473,6 +1866,23 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 					},
 				},
 			},
+			"eks:index:VpcCniNetworking": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type:        "object",
+					Description: "The VPC CNI settings that affect the maximum number of pods of the nodes.",
+					Properties: map[string]schema.PropertySpec{
+						"prefixDelegation": {
+							TypeSpec:    schema.TypeSpec{Type: "boolean"},
+							Description: "Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.",
+						},
+						"customNetworking": {
+							TypeSpec:    schema.TypeSpec{Type: "boolean"},
+							Description: "Whether pods get their IP addresses from the subnets of ENIConfigs.",
+						},
+					},
+					Required: []string{"prefixDelegation", "customNetworking"},
+				},
+			},
 			"eks:index:CreationRoleProvider": {
 				ObjectTypeSpec: schema.ObjectTypeSpec{
 					Type: "object",
@@ -1533,6 +1943,41 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 					Required: []string{"enabled"},
 				},
 			},
+			"eks:index:EncryptionConfigOptions": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type:        "object",
+					Description: "Configures the KMS key the cluster creates for envelope encryption of Kubernetes secrets.",
+					Properties: map[string]schema.PropertySpec{
+						"enableKeyRotation": {
+							TypeSpec:    schema.TypeSpec{Type: "boolean"},
+							Description: "Whether to enable automatic rotation of the key material. Defaults to `true`.",
+						},
+						"rotationPeriodInDays": {
+							TypeSpec: schema.TypeSpec{Type: "integer"},
+							Description: "The period in days between automatic rotations of the key material. Must be between 90 and 2560. " +
+								"Defaults to 365 days.",
+						},
+						"deletionWindowInDays": {
+							TypeSpec: schema.TypeSpec{Type: "integer"},
+							Description: "The waiting period in days before the key is deleted after the cluster is destroyed. Must be " +
+								"between 7 and 30. Defaults to 30 days.",
+						},
+						"aliasName": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The name of the alias of the key. Must start with `alias/`. Defaults to `alias/eks/<clusterName>`.",
+						},
+						"keyAdministratorArns": {
+							TypeSpec: schema.TypeSpec{
+								Type:  "array",
+								Items: &schema.TypeSpec{Type: "string"},
+							},
+							Description: "The ARNs of IAM principals that are allowed to administer the key, e.g. to change its policy or " +
+								"schedule its deletion. The AWS account always retains full access to the key so that IAM policies can " +
+								"grant access to it as well.",
+						},
+					},
+				},
+			},
 			"eks:index:ClusterComputeConfig": {
 				ObjectTypeSpec: schema.ObjectTypeSpec{
 					Type: "object",
@@ -1634,6 +2079,34 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 								"credential provider chain.\n\nThe profile is passed to kubeconfig as an " +
 								"authentication environment setting.",
 						},
+						"roleSessionName": {
+							TypeSpec: schema.TypeSpec{Type: "string"},
+							Description: "Session name to use when assuming `roleArn`.\n\n" +
+								"Only supported by the `aws-iam-authenticator` token tool.",
+						},
+						"externalId": {
+							TypeSpec: schema.TypeSpec{Type: "string"},
+							Description: "External ID to pass when assuming `roleArn`. Required by roles whose " +
+								"trust policy has an `sts:ExternalId` condition.\n\n" +
+								"Only supported by the `aws-iam-authenticator` token tool.",
+						},
+						"region": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.",
+						},
+						"env": {
+							TypeSpec: schema.TypeSpec{
+								Type:                 "object",
+								AdditionalProperties: &schema.TypeSpec{Type: "string"},
+							},
+							Description: "Additional environment variables to set for the exec plugin that retrieves " +
+								"the token, e.g. to configure credential wrappers like granted or aws-vault.",
+						},
+						"tokenTool": {
+							TypeSpec: schema.TypeSpec{Ref: "#/types/eks:index:KubeconfigTokenTool"},
+							Description: "The tool the kubeconfig uses to retrieve a token for the cluster. " +
+								"Defaults to `aws`, i.e. `aws eks get-token`.",
+						},
 					},
 				},
 			},
@@ -1774,42 +2247,183 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 					Required: []string{"type"},
 				},
 			},
-			"eks:index:Taint": {
+			"eks:index:AutoModeSelectorTerm": {
 				ObjectTypeSpec: schema.ObjectTypeSpec{
 					Type: "object",
-					Description: "Represents a Kubernetes `taint` to apply to all Nodes in a NodeGroup. " +
-						"See https://kubernetes.io/docs/concepts/configuration/taint-and-toleration/.",
+					Description: "AutoModeSelectorTerm selects subnets or security groups by their ID, name or tags. " +
+						"The conditions of a term are ANDed, multiple terms are ORed.",
 					Properties: map[string]schema.PropertySpec{
-						"value": {
-							TypeSpec: schema.TypeSpec{
-								Type: "string",
-							},
-							Description: "The value of the taint.",
+						"id": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The ID of the subnet or security group.",
 						},
-						"effect": {
+						"name": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The name of the security group. Not supported for subnets.",
+						},
+						"tags": {
 							TypeSpec: schema.TypeSpec{
-								Type: "string", // TODO strict string enum: "NoSchedule" | "NoExecute" | "PreferNoSchedule".
+								Type:                 "object",
+								AdditionalProperties: &schema.TypeSpec{Type: "string"},
 							},
-							Description: "The effect of the taint.",
+							Description: "The tags the subnets or security groups must have. A value of `*` matches any value.",
 						},
 					},
-					Required: []string{
-						"value",
-						"effect",
-					},
 				},
 			},
-			"eks:index:UserMapping": {
+			"eks:index:AutoModeEphemeralStorage": {
 				ObjectTypeSpec: schema.ObjectTypeSpec{
 					Type:        "object",
-					Description: "Describes a mapping from an AWS IAM user to a Kubernetes user and groups.",
+					Description: "AutoModeEphemeralStorage configures the volume that holds the ephemeral storage of the nodes.",
 					Properties: map[string]schema.PropertySpec{
-						"userArn": {
-							TypeSpec:    schema.TypeSpec{Type: "string"}, // TODO enum `aws.ARN`.
-							Description: "The ARN of the IAM user to add.",
+						"size": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The size of the volume, e.g. `80Gi`.",
 						},
-						"username": {
-							TypeSpec: schema.TypeSpec{Type: "string"},
+						"iops": {
+							TypeSpec:    schema.TypeSpec{Type: "integer"},
+							Description: "The IOPS of the volume.",
+						},
+						"throughput": {
+							TypeSpec:    schema.TypeSpec{Type: "integer"},
+							Description: "The throughput of the volume in MiB/s.",
+						},
+						"kmsKeyId": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The ARN of the KMS key that encrypts the volume.",
+						},
+					},
+				},
+			},
+			"eks:index:AutoModeNodeRequirement": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type: "object",
+					Description: "AutoModeNodeRequirement restricts the instances of a node pool by a well-known label, " +
+						"e.g. `eks.amazonaws.com/instance-category` or `karpenter.sh/capacity-type`.",
+					Properties: map[string]schema.PropertySpec{
+						"key": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The label key.",
+						},
+						"operator": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The operator, one of `In`, `NotIn`, `Exists`, `DoesNotExist`, `Gt` and `Lt`.",
+						},
+						"values": {
+							TypeSpec: schema.TypeSpec{
+								Type:  "array",
+								Items: &schema.TypeSpec{Type: "string"},
+							},
+							Description: "The label values.",
+						},
+						"minValues": {
+							TypeSpec:    schema.TypeSpec{Type: "integer"},
+							Description: "The minimum number of distinct values the node pool has to be able to choose from.",
+						},
+					},
+					Required: []string{"key", "operator"},
+				},
+			},
+			"eks:index:AutoModeDisruptionBudget": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type:        "object",
+					Description: "AutoModeDisruptionBudget limits the number of nodes that may be disrupted at the same time.",
+					Properties: map[string]schema.PropertySpec{
+						"nodes": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The number or percentage of nodes that may be disrupted, e.g. `10%`. `0` blocks disruptions.",
+						},
+						"schedule": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The cron schedule at which the budget becomes active, e.g. `0 9 * * mon-fri`. Requires `duration`.",
+						},
+						"duration": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "How long the budget is active after each schedule, e.g. `8h`.",
+						},
+						"reasons": {
+							TypeSpec: schema.TypeSpec{
+								Type:  "array",
+								Items: &schema.TypeSpec{Type: "string"},
+							},
+							Description: "The disruption reasons the budget applies to, `Underutilized`, `Empty` or `Drifted`. " +
+								"Defaults to all reasons.",
+						},
+					},
+					Required: []string{"nodes"},
+				},
+			},
+			"eks:index:AutoModeDisruption": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type:        "object",
+					Description: "AutoModeDisruption configures how nodes of a node pool are consolidated and replaced.",
+					Properties: map[string]schema.PropertySpec{
+						"consolidationPolicy": {
+							TypeSpec: schema.TypeSpec{Type: "string"},
+							Description: "Which nodes are consolidated, `WhenEmptyOrUnderutilized` or `WhenEmpty`. " +
+								"Defaults to `WhenEmptyOrUnderutilized`.",
+						},
+						"consolidateAfter": {
+							TypeSpec: schema.TypeSpec{Type: "string"},
+							Description: "How long to wait after a pod was added to or removed from a node before consolidating it, " +
+								"e.g. `30s`, or `Never`. Defaults to `0s`.",
+						},
+						"budgets": {
+							TypeSpec: schema.TypeSpec{
+								Type:  "array",
+								Items: &schema.TypeSpec{Ref: "#/types/eks:index:AutoModeDisruptionBudget"},
+							},
+							Description: "The disruption budgets of the node pool.",
+						},
+					},
+				},
+			},
+			"eks:index:Taint": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type: "object",
+					Description: "Represents a Kubernetes `taint` to apply to all Nodes in a NodeGroup. " +
+						"See https://kubernetes.io/docs/concepts/configuration/taint-and-toleration/.",
+					Properties: map[string]schema.PropertySpec{
+						"value": {
+							TypeSpec: schema.TypeSpec{
+								Type: "string",
+							},
+							Description: "The value of the taint.",
+						},
+						"effect": {
+							TypeSpec: schema.TypeSpec{
+								Type: "string", // TODO strict string enum: "NoSchedule" | "NoExecute" | "PreferNoSchedule".
+							},
+							Description: "The effect of the taint.",
+						},
+					},
+					Required: []string{
+						"value",
+						"effect",
+					},
+				},
+			},
+			"eks:index:InterruptionHandling": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type: "object",
+					Description: "InterruptionHandling configures how a node group handles Spot interruptions and Auto Scaling " +
+						"Group termination events. The events are delivered to an SQS queue, which aws-node-termination-handler " +
+						"processes in queue mode to cordon and drain the affected nodes before their instances are terminated.\n" +
+						"See for more details: https://github.com/aws/aws-node-termination-handler#queue-processor",
+					Properties: interruptionHandlingProperties(),
+				},
+			},
+			"eks:index:UserMapping": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type:        "object",
+					Description: "Describes a mapping from an AWS IAM user to a Kubernetes user and groups.",
+					Properties: map[string]schema.PropertySpec{
+						"userArn": {
+							TypeSpec:    schema.TypeSpec{Type: "string"}, // TODO enum `aws.ARN`.
+							Description: "The ARN of the IAM user to add.",
+						},
+						"username": {
+							TypeSpec: schema.TypeSpec{Type: "string"},
 							Description: "The user name within Kubernetes to map to the IAM user. By default, the " +
 								"user name is the ARN of the IAM user.",
 						},
@@ -1993,6 +2607,24 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 					},
 				},
 			},
+			"eks:index:KubeconfigTokenTool": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type:        "string",
+					Description: "The exec plugin used by a kubeconfig to retrieve a token for the cluster.",
+				},
+				Enum: []schema.EnumValueSpec{
+					{
+						Name:        "Aws",
+						Value:       "aws",
+						Description: "Use `aws eks get-token` of the AWS CLI to retrieve the token.",
+					},
+					{
+						Name:        "AwsIamAuthenticator",
+						Value:       "aws-iam-authenticator",
+						Description: "Use `aws-iam-authenticator token` to retrieve the token.",
+					},
+				},
+			},
 			"eks:index:OperatingSystem": {
 				ObjectTypeSpec: schema.ObjectTypeSpec{
 					Type: "string",
@@ -2086,6 +2718,311 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 					},
 				},
 			},
+			"eks:index:BottlerocketConfig": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type: "object",
+					Description: "Typed configuration of the most common Bottlerocket settings.\n\n" +
+						"See for more details: https://bottlerocket.dev/en/os/1.20.x/api/settings/.",
+					Properties: map[string]schema.PropertySpec{
+						"kubernetes": {
+							TypeSpec:    schema.TypeSpec{Ref: "#/types/eks:index:BottlerocketKubernetesSettings"},
+							Description: "Settings of the kubelet, i.e. `settings.kubernetes`.",
+						},
+						"hostContainers": {
+							TypeSpec:    schema.TypeSpec{Ref: "#/types/eks:index:BottlerocketHostContainers"},
+							Description: "Settings of the admin and control host containers, i.e. `settings.host-containers`.",
+						},
+						"kernel": {
+							TypeSpec:    schema.TypeSpec{Ref: "#/types/eks:index:BottlerocketKernelSettings"},
+							Description: "Settings of the kernel, i.e. `settings.kernel`.",
+						},
+						"bootstrapContainers": {
+							TypeSpec: schema.TypeSpec{
+								Type:                 "object",
+								AdditionalProperties: &schema.TypeSpec{Ref: "#/types/eks:index:BottlerocketBootstrapContainer"},
+							},
+							Description: "Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.",
+						},
+						"containerRegistry": {
+							TypeSpec:    schema.TypeSpec{Ref: "#/types/eks:index:BottlerocketContainerRegistry"},
+							Description: "Settings of the container registries, i.e. `settings.container-registry`.",
+						},
+					},
+				},
+			},
+			"eks:index:BottlerocketKubernetesSettings": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type:        "object",
+					Description: "Settings of the kubelet on Bottlerocket nodes.",
+					Properties: map[string]schema.PropertySpec{
+						"nodeLabels": {
+							TypeSpec: schema.TypeSpec{
+								Type:                 "object",
+								AdditionalProperties: &schema.TypeSpec{Type: "string"},
+							},
+							Description: "Labels the node registers with.",
+						},
+						"nodeTaints": {
+							TypeSpec: schema.TypeSpec{
+								Type:                 "object",
+								AdditionalProperties: &schema.TypeSpec{Type: "string"},
+							},
+							Description: "Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.",
+						},
+						"evictionHard": {
+							TypeSpec: schema.TypeSpec{
+								Type:                 "object",
+								AdditionalProperties: &schema.TypeSpec{Type: "string"},
+							},
+							Description: "Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.",
+						},
+						"evictionSoft": {
+							TypeSpec: schema.TypeSpec{
+								Type:                 "object",
+								AdditionalProperties: &schema.TypeSpec{Type: "string"},
+							},
+							Description: "Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.",
+						},
+						"evictionSoftGracePeriod": {
+							TypeSpec: schema.TypeSpec{
+								Type:                 "object",
+								AdditionalProperties: &schema.TypeSpec{Type: "string"},
+							},
+							Description: "Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.",
+						},
+						"maxPods": {
+							TypeSpec:    schema.TypeSpec{Type: "integer"},
+							Description: "The maximum number of pods the kubelet runs on the node.",
+						},
+						"imageGcHighThresholdPercent": {
+							TypeSpec:    schema.TypeSpec{Type: "integer"},
+							Description: "The disk usage in percent after which image garbage collection always runs.",
+						},
+						"imageGcLowThresholdPercent": {
+							TypeSpec:    schema.TypeSpec{Type: "integer"},
+							Description: "The disk usage in percent before which image garbage collection never runs.",
+						},
+					},
+				},
+			},
+			"eks:index:BottlerocketHostContainers": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type:        "object",
+					Description: "Settings of the Bottlerocket host containers.",
+					Properties: map[string]schema.PropertySpec{
+						"admin": {
+							TypeSpec:    schema.TypeSpec{Ref: "#/types/eks:index:BottlerocketHostContainer"},
+							Description: "The admin container, used for troubleshooting the node.",
+						},
+						"control": {
+							TypeSpec:    schema.TypeSpec{Ref: "#/types/eks:index:BottlerocketHostContainer"},
+							Description: "The control container, used to access the Bottlerocket API through AWS SSM.",
+						},
+					},
+				},
+			},
+			"eks:index:BottlerocketHostContainer": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type:        "object",
+					Description: "Settings of a Bottlerocket host container.",
+					Properties: map[string]schema.PropertySpec{
+						"enabled": {
+							TypeSpec:    schema.TypeSpec{Type: "boolean"},
+							Description: "Whether the host container runs.",
+						},
+						"superpowered": {
+							TypeSpec:    schema.TypeSpec{Type: "boolean"},
+							Description: "Whether the host container has elevated privileges.",
+						},
+						"source": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The image of the host container.",
+						},
+						"userData": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "Base64 encoded user data passed to the host container.",
+						},
+					},
+				},
+			},
+			"eks:index:BottlerocketKernelSettings": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type:        "object",
+					Description: "Settings of the Bottlerocket kernel.",
+					Properties: map[string]schema.PropertySpec{
+						"sysctl": {
+							TypeSpec: schema.TypeSpec{
+								Type:                 "object",
+								AdditionalProperties: &schema.TypeSpec{Type: "string"},
+							},
+							Description: "Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: \"1\"`.",
+						},
+						"lockdown": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.",
+						},
+					},
+				},
+			},
+			"eks:index:BottlerocketBootstrapContainer": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type:        "object",
+					Description: "A Bottlerocket bootstrap container, which runs before the kubelet starts.",
+					Properties: map[string]schema.PropertySpec{
+						"source": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The image of the bootstrap container.",
+						},
+						"mode": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "When the bootstrap container runs. Valid values are `always`, `once` and `off`.",
+						},
+						"essential": {
+							TypeSpec:    schema.TypeSpec{Type: "boolean"},
+							Description: "Whether the node fails to boot if the bootstrap container fails.",
+						},
+						"userData": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "Base64 encoded user data passed to the bootstrap container.",
+						},
+					},
+					Required: []string{"source"},
+				},
+			},
+			"eks:index:BottlerocketContainerRegistry": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type:        "object",
+					Description: "Settings of the container registries of Bottlerocket nodes.",
+					Properties: map[string]schema.PropertySpec{
+						"mirrors": {
+							TypeSpec: schema.TypeSpec{
+								Type:  "array",
+								Items: &schema.TypeSpec{Ref: "#/types/eks:index:BottlerocketRegistryMirror"},
+							},
+							Description: "Mirrors to pull images from instead of the original registries.",
+						},
+					},
+				},
+			},
+			"eks:index:BottlerocketRegistryMirror": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type:        "object",
+					Description: "A mirror of a container registry.",
+					Properties: map[string]schema.PropertySpec{
+						"registry": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The registry to mirror, e.g. `docker.io`.",
+						},
+						"endpoints": {
+							TypeSpec: schema.TypeSpec{
+								Type:  "array",
+								Items: &schema.TypeSpec{Type: "string"},
+							},
+							Description: "The endpoints of the mirror, in order of preference.",
+						},
+					},
+					Required: []string{"registry", "endpoints"},
+				},
+			},
+			"eks:index:NodeConfig": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type: "object",
+					Description: "Typed nodeadm `NodeConfig`. The cluster details are configured by the provider.\n\n" +
+						"See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/.",
+					Properties: map[string]schema.PropertySpec{
+						"kubelet": {
+							TypeSpec:    schema.TypeSpec{Ref: "#/types/eks:index:NodeConfigKubelet"},
+							Description: "Configuration of the kubelet.",
+						},
+						"containerd": {
+							TypeSpec:    schema.TypeSpec{Ref: "#/types/eks:index:NodeConfigContainerd"},
+							Description: "Configuration of containerd.",
+						},
+						"instance": {
+							TypeSpec:    schema.TypeSpec{Ref: "#/types/eks:index:NodeConfigInstance"},
+							Description: "Configuration of the EC2 instance.",
+						},
+					},
+				},
+			},
+			"eks:index:NodeConfigKubelet": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type:        "object",
+					Description: "Configuration of the kubelet in a nodeadm `NodeConfig`.",
+					Properties: map[string]schema.PropertySpec{
+						"config": {
+							TypeSpec: schema.TypeSpec{
+								Type:                 "object",
+								AdditionalProperties: &schema.TypeSpec{Ref: "pulumi.json#/Any"},
+							},
+							Description: "Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.\n\n" +
+								"See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.",
+						},
+						"flags": {
+							TypeSpec: schema.TypeSpec{
+								Type:  "array",
+								Items: &schema.TypeSpec{Type: "string"},
+							},
+							Description: "Command line flags of the kubelet, e.g. `--node-labels=key=value`.",
+						},
+					},
+				},
+			},
+			"eks:index:NodeConfigContainerd": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type:        "object",
+					Description: "Configuration of containerd in a nodeadm `NodeConfig`.",
+					Properties: map[string]schema.PropertySpec{
+						"config": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "Inline containerd configuration in TOML format, merged with the defaults of nodeadm.",
+						},
+						"baseRuntimeSpec": {
+							TypeSpec: schema.TypeSpec{
+								Type:                 "object",
+								AdditionalProperties: &schema.TypeSpec{Ref: "pulumi.json#/Any"},
+							},
+							Description: "The OCI runtime specification containers are created with.",
+						},
+					},
+				},
+			},
+			"eks:index:NodeConfigInstance": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type:        "object",
+					Description: "Configuration of the EC2 instance in a nodeadm `NodeConfig`.",
+					Properties: map[string]schema.PropertySpec{
+						"localStorage": {
+							TypeSpec:    schema.TypeSpec{Ref: "#/types/eks:index:NodeConfigLocalStorage"},
+							Description: "Configuration of the local instance store volumes.",
+						},
+					},
+				},
+			},
+			"eks:index:NodeConfigLocalStorage": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Type:        "object",
+					Description: "Configuration of the local instance store volumes in a nodeadm `NodeConfig`.",
+					Properties: map[string]schema.PropertySpec{
+						"strategy": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.",
+						},
+						"mountPath": {
+							TypeSpec:    schema.TypeSpec{Type: "string"},
+							Description: "The path the instance store volumes are mounted at.",
+						},
+						"disabledMounts": {
+							TypeSpec: schema.TypeSpec{
+								Type:  "array",
+								Items: &schema.TypeSpec{Type: "string"},
+							},
+							Description: "Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.",
+						},
+					},
+					Required: []string{"strategy"},
+				},
+			},
 			"eks:index:NodeadmOptions": {
 				ObjectTypeSpec: schema.ObjectTypeSpec{
 					Type: "object",
@@ -2229,7 +3166,7 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 			"csharp": rawMessage(map[string]interface{}{
 				"packageReferences": map[string]string{
 					"Pulumi":            "3.*",
-					"Pulumi.Aws":        "7.*",
+					"Pulumi.Aws":        dependencies.Aws,
 					"Pulumi.Kubernetes": "4.*",
 				},
 				"liftSingleValueMethodReturns": true,
@@ -2286,6 +3223,55 @@ func generateSchema(version semver.Version, outdir string) schema.PackageSpec {
 	}
 }
 
+// kubeconfigMethodInputProperties returns the inputs shared by the Cluster methods that generate a kubeconfig.
+func kubeconfigMethodInputProperties() map[string]schema.PropertySpec {
+	return map[string]schema.PropertySpec{
+		"__self__": {
+			TypeSpec: schema.TypeSpec{Ref: "#/resources/eks:index:Cluster"},
+		},
+		"roleArn": {
+			Description: "Role ARN to assume instead of the default AWS credential provider " +
+				"chain.\n\n" +
+				"The role is passed to kubeconfig as an authentication exec argument.",
+			TypeSpec: schema.TypeSpec{Type: "string"},
+		},
+		"profileName": {
+			Description: "AWS credential profile name to always use instead of the default AWS " +
+				"credential provider chain.\n\n" +
+				"The profile is passed to kubeconfig as an authentication environment setting.",
+			TypeSpec: schema.TypeSpec{Type: "string"},
+		},
+		"roleSessionName": {
+			TypeSpec: schema.TypeSpec{Type: "string"},
+			Description: "Session name to use when assuming `roleArn`.\n\n" +
+				"Only supported by the `aws-iam-authenticator` token tool.",
+		},
+		"externalId": {
+			TypeSpec: schema.TypeSpec{Type: "string"},
+			Description: "External ID to pass when assuming `roleArn`. Required by roles whose " +
+				"trust policy has an `sts:ExternalId` condition.\n\n" +
+				"Only supported by the `aws-iam-authenticator` token tool.",
+		},
+		"region": {
+			TypeSpec:    schema.TypeSpec{Type: "string"},
+			Description: "AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.",
+		},
+		"env": {
+			TypeSpec: schema.TypeSpec{
+				Type:                 "object",
+				AdditionalProperties: &schema.TypeSpec{Type: "string"},
+			},
+			Description: "Additional environment variables to set for the exec plugin that retrieves " +
+				"the token, e.g. to configure credential wrappers like granted or aws-vault.",
+		},
+		"tokenTool": {
+			TypeSpec: schema.TypeSpec{Ref: "#/types/eks:index:KubeconfigTokenTool"},
+			Description: "The tool the kubeconfig uses to retrieve a token for the cluster. " +
+				"Defaults to `aws`, i.e. `aws eks get-token`.",
+		},
+	}
+}
+
 //nolint:lll
 func nodeGroupProperties(cluster, v2 bool, awsVersion string) map[string]schema.PropertySpec {
 	props := map[string]schema.PropertySpec{
@@ -2453,6 +3439,16 @@ func nodeGroupProperties(cluster, v2 bool, awsVersion string) map[string]schema.
 			Description: "Custom k8s node taints to be attached to each worker node. Adds the given " +
 				"taints to the `--register-with-taints` kubelet argument",
 		},
+		"autoscalerDiscovery": {
+			TypeSpec: schema.TypeSpec{
+				Type:  "boolean",
+				Plain: true,
+			},
+			Description: "Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the " +
+				"`k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` " +
+				"and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.\n\n" +
+				"For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.",
+		},
 		"kubeletExtraArgs": {
 			TypeSpec: schema.TypeSpec{
 				Type: "string",
@@ -2554,6 +3550,20 @@ func nodeGroupProperties(cluster, v2 bool, awsVersion string) map[string]schema.
 				"  - settings.kubernetes.cluster-dns-ip\n\n" +
 				"For an overview of the available settings, see https://bottlerocket.dev/en/os/1.20.x/api/settings/.",
 		},
+		"bottlerocketConfig": {
+			TypeSpec: schema.TypeSpec{Ref: "#/types/eks:index:BottlerocketConfig"},
+			Description: "Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.\n" +
+				"The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. " +
+				"Settings in `bottlerocketSettings` take precedence over it.",
+		},
+		"nodeConfig": {
+			TypeSpec: schema.TypeSpec{Ref: "#/types/eks:index:NodeConfig"},
+			Description: "Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. " +
+				"It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. " +
+				"Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.\n\n" +
+				"Note: This is only applicable when using AL2023.\n" +
+				"See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/",
+		},
 		"nodeadmExtraOptions": {
 			TypeSpec: schema.TypeSpec{
 				Type:  "array",
@@ -2612,11 +3622,91 @@ func nodeGroupProperties(cluster, v2 bool, awsVersion string) map[string]schema.
 			Description: "Whether to ignore changes to the desired size of the Auto Scaling Group. This is useful when using Cluster Autoscaler.\n\n" +
 				"See [EKS best practices](https://aws.github.io/aws-eks-best-practices/cluster-autoscaling/) for more details.",
 		}
+
+		props["interruptionHandling"] = schema.PropertySpec{
+			TypeSpec: schema.TypeSpec{
+				Ref:   "#/types/eks:index:InterruptionHandling",
+				Plain: true,
+			},
+			Description: "Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS " +
+				"queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle " +
+				"actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs " +
+				"aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.\n\n" +
+				"See for more details: https://github.com/aws/aws-node-termination-handler",
+		}
 	}
 
 	return props
 }
 
+// controllerProperties returns the input properties of the components that install a controller with Helm. The
+// name is how the descriptions refer to the controller, componentValues are the chart values set by the component.
+func controllerProperties(name, serviceAccountName, chart, chartVersion string, componentValues []string,
+	props map[string]schema.PropertySpec,
+) map[string]schema.PropertySpec {
+	values := make([]string, len(componentValues))
+	for i, v := range componentValues {
+		values[i] = "`" + v + "`"
+	}
+
+	props["cluster"] = schema.PropertySpec{
+		TypeSpec: schema.TypeSpec{
+			Ref: "#/resources/eks:index:Cluster",
+		},
+		Description: "The target EKS cluster.",
+	}
+	props["namespace"] = schema.PropertySpec{
+		TypeSpec:    schema.TypeSpec{Type: "string"},
+		Description: fmt.Sprintf("The namespace to install the %s into. Defaults to `kube-system`.", name),
+	}
+	props["serviceAccountName"] = schema.PropertySpec{
+		TypeSpec: schema.TypeSpec{Type: "string"},
+		Description: fmt.Sprintf("The name of the Kubernetes service account of the %s. Defaults to `%s`.",
+			name, serviceAccountName),
+	}
+	props["oidcProviderArn"] = schema.PropertySpec{
+		TypeSpec: schema.TypeSpec{Type: "string"},
+		Description: "The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.\n\n" +
+			fmt.Sprintf("If set, the %s assumes its IAM role with IAM roles for service accounts (IRSA). ", name) +
+			"Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the " +
+			"`eks-pod-identity-agent` add-on to be installed on the cluster.",
+	}
+	props["chartVersion"] = schema.PropertySpec{
+		TypeSpec:    schema.TypeSpec{Type: "string"},
+		Description: fmt.Sprintf("The version of the %s Helm chart. Defaults to `%s`.", chart, chartVersion),
+	}
+	props["values"] = schema.PropertySpec{
+		TypeSpec: schema.TypeSpec{
+			Type:                 "object",
+			AdditionalProperties: &schema.TypeSpec{Ref: "pulumi.json#/Any"},
+		},
+		Description: "Custom values for the Helm chart. The values are merged with the values set by the component, " +
+			fmt.Sprintf("which are %s and %s.", strings.Join(values[:len(values)-1], ", "), values[len(values)-1]),
+	}
+	return props
+}
+
+// interruptionHandlingProperties returns the properties of the InterruptionHandling type. They are the properties of
+// the controller components, except for the cluster, which is the cluster of the node group.
+func interruptionHandlingProperties() map[string]schema.PropertySpec {
+	props := controllerProperties("aws-node-termination-handler", "", "aws-node-termination-handler", "0.27.0",
+		[]string{"enableSqsTerminationDraining", "queueURL", "awsRegion", "serviceAccount"},
+		map[string]schema.PropertySpec{
+			"heartbeatTimeout": {
+				TypeSpec: schema.TypeSpec{Type: "integer"},
+				Description: "The time in seconds an instance waits in the `Terminating:Wait` state for its node to be " +
+					"drained, before it is terminated anyway. Defaults to 300.",
+			},
+		})
+	delete(props, "cluster")
+	props["serviceAccountName"] = schema.PropertySpec{
+		TypeSpec: schema.TypeSpec{Type: "string"},
+		Description: "The name of the Kubernetes service account of aws-node-termination-handler. Defaults to " +
+			"`<name>-node-termination-handler`, where `<name>` is the name of the node group.",
+	}
+	return props
+}
+
 // vpcCniProperties returns a map of properties that can be used by either the VpcCni resource or VpcCniOptions type.
 // When kubeconfig is set to true, the kubeconfig property is included in the map (for the VpcCni resource).
 func vpcCniProperties(cluster bool) map[string]schema.PropertySpec {
@@ -2689,6 +3779,28 @@ func vpcCniProperties(cluster bool) map[string]schema.PropertySpec {
 				"Ref: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html " +
 				"(step 5(c))\n\nDefaults to the official AWS CNI image in ECR.",
 		},
+		"podSubnets": {
+			TypeSpec: schema.TypeSpec{
+				Type:                 "object",
+				AdditionalProperties: &schema.TypeSpec{Type: "string"},
+				Plain:                true,
+			},
+			Description: "Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP " +
+				"addresses from, e.g. subnets in a secondary CIDR block of the VPC.\n\n" +
+				"Setting this enables custom networking: an `ENIConfig` named after each availability zone is created " +
+				"and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the " +
+				"`ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.\n\n" +
+				"See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html",
+		},
+		"podSecurityGroupIds": {
+			TypeSpec: schema.TypeSpec{
+				Type:  "array",
+				Items: &schema.TypeSpec{Type: "string"},
+			},
+			Description: "The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.\n\n" +
+				"Defaults to the node security group of the cluster, or the cluster security group created by EKS if " +
+				"the cluster has no node security group.",
+		},
 		"enablePodEni": {
 			TypeSpec: schema.TypeSpec{Type: "boolean"},
 			Description: "Specifies whether to allow IPAMD to add the `vpc.amazonaws.com/has-trunk-attached` label to " +
@@ -0,0 +1,83 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi-eks/provider/v4/pkg/userdata"
+)
+
+const userDataExample = `  echo '{
+    "nodeGroupType": "NodeGroupV2",
+    "operatingSystem": "AL2023",
+    "cluster": {
+      "name": "example",
+      "apiServerEndpoint": "https://example.eks.amazonaws.com",
+      "certificateAuthority": "...",
+      "serviceCidr": "10.100.0.0/16"
+    },
+    "kubeletExtraArgs": "--max-pods=110"
+  }' | pulumi-gen-eks userdata`
+
+func userDataCmd() *cobra.Command {
+	var encode bool
+	cmd := &cobra.Command{
+		Use:   "userdata [file]",
+		Short: "Render the user data of a node group",
+		Long: "Render the user data that NodeGroup, NodeGroupV2 and ManagedNodeGroup create for the node group " +
+			"arguments in the given JSON file, or stdin if no file is given.\n\nThe arguments mirror the inputs " +
+			"of the components, plus the cluster metadata the components read from the cluster.",
+		Example: userDataExample,
+		Args:    cobra.MaximumNArgs(1),
+		// main prints the errors, the usage does not help with invalid node group arguments
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var data []byte
+			var err error
+			if len(args) == 1 && args[0] != "-" {
+				data, err = os.ReadFile(args[0])
+			} else {
+				data, err = io.ReadAll(cmd.InOrStdin())
+			}
+			if err != nil {
+				return err
+			}
+
+			userDataArgs, err := userdata.ParseArgs(data)
+			if err != nil {
+				return err
+			}
+			rendered, err := userdata.Render(userDataArgs)
+			if err != nil {
+				return err
+			}
+			if encode {
+				rendered = base64.StdEncoding.EncodeToString([]byte(rendered)) + "\n"
+			}
+			_, err = fmt.Fprint(cmd.OutOrStdout(), rendered)
+			return err
+		},
+	}
+	cmd.Flags().BoolVar(&encode, "base64", false,
+		"Encode the user data in base64, like the user data of the launch template")
+	return cmd
+}
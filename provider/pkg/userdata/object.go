@@ -0,0 +1,221 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package userdata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// object is a JSON object that remembers the insertion order of its keys. The component renders JavaScript objects,
+// so the key order of the rendered documents has to follow the JavaScript property order.
+type object struct {
+	keys   []string
+	values map[string]any
+}
+
+func newObject() *object {
+	return &object{values: map[string]any{}}
+}
+
+func (o *object) set(key string, value any) {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = value
+}
+
+func (o *object) get(key string) (any, bool) {
+	value, ok := o.values[key]
+	return value, ok
+}
+
+func (o *object) len() int {
+	return len(o.keys)
+}
+
+// Keys returns the keys in the order of `Object.keys` in JavaScript: keys that are array indices come first in
+// ascending order, followed by the other keys in insertion order.
+func (o *object) Keys() []string {
+	var indices, names []string
+	for _, key := range o.keys {
+		if isArrayIndex(key) {
+			indices = append(indices, key)
+		} else {
+			names = append(names, key)
+		}
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		a, _ := strconv.ParseUint(indices[i], 10, 32)
+		b, _ := strconv.ParseUint(indices[j], 10, 32)
+		return a < b
+	})
+	return append(indices, names...)
+}
+
+// clone returns a shallow copy of the object.
+func (o *object) clone() *object {
+	c := newObject()
+	for _, key := range o.keys {
+		c.set(key, o.values[key])
+	}
+	return c
+}
+
+func isArrayIndex(key string) bool {
+	n, err := strconv.ParseUint(key, 10, 32)
+	return err == nil && n < math.MaxUint32 && strconv.FormatUint(n, 10) == key
+}
+
+// decodeJSON decodes JSON into nil, bool, float64, string, []any and *object values.
+func decodeJSON(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	value, err := decodeValue(dec)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err == nil {
+		return nil, fmt.Errorf("unexpected data after the JSON value")
+	}
+	return value, nil
+}
+
+func decodeValue(dec *json.Decoder) (any, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch token := token.(type) {
+	case json.Delim:
+		switch token {
+		case '{':
+			obj := newObject()
+			for dec.More() {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				value, err := decodeValue(dec)
+				if err != nil {
+					return nil, err
+				}
+				obj.set(key.(string), value)
+			}
+			_, err := dec.Token()
+			return obj, err
+		case '[':
+			arr := []any{}
+			for dec.More() {
+				value, err := decodeValue(dec)
+				if err != nil {
+					return nil, err
+				}
+				arr = append(arr, value)
+			}
+			_, err := dec.Token()
+			return arr, err
+		}
+		return nil, fmt.Errorf("unexpected delimiter %q", token)
+	case json.Number:
+		return token.Float64()
+	default:
+		return token, nil
+	}
+}
+
+// isInteger mirrors `Number.isInteger(value) && !Object.is(value, -0)`.
+func isInteger(value float64) bool {
+	return value == math.Trunc(value) && !math.IsInf(value, 0) && !(value == 0 && math.Signbit(value))
+}
+
+// formatNumber mirrors `Number.prototype.toString()` for finite numbers.
+func formatNumber(value float64) string {
+	abs := math.Abs(value)
+	if abs != 0 && (abs >= 1e21 || abs < 1e-6) {
+		s := strconv.FormatFloat(value, 'e', -1, 64)
+		mantissa, exponent, _ := strings.Cut(s, "e")
+		sign := exponent[0]
+		exponent = strings.TrimLeft(exponent[1:], "0")
+		return mantissa + "e" + string(sign) + exponent
+	}
+	if value == 0 {
+		return "0"
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// sortKeysLocale returns the keys sorted like `Array.prototype.sort` with `String.prototype.localeCompare`.
+func sortKeysLocale(keys []string) []string {
+	sorted := append([]string(nil), keys...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return localeCompare(sorted[i], sorted[j]) < 0
+	})
+	return sorted
+}
+
+// asciiCollation is the order of the printable ASCII characters in the root collation of the Unicode CLDR, which is
+// what `localeCompare` uses by default. Letters are listed once, their case only matters if the strings are
+// otherwise equal.
+const asciiCollation = " _-,;:!?.'\"()[]{}@*/\\&#%`^+<=>|~$0123456789abcdefghijklmnopqrstuvwxyz"
+
+// localeCompare approximates `String.prototype.localeCompare` for the setting names of Bottlerocket: characters are
+// compared by their primary weight first, lower case letters sort before upper case ones if the strings are equal
+// otherwise.
+func localeCompare(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	for i := 0; i < len(ra) && i < len(rb); i++ {
+		if wa, wb := collationWeight(ra[i]), collationWeight(rb[i]); wa != wb {
+			if wa < wb {
+				return -1
+			}
+			return 1
+		}
+	}
+	if len(ra) != len(rb) {
+		if len(ra) < len(rb) {
+			return -1
+		}
+		return 1
+	}
+	for i := range ra {
+		if ra[i] != rb[i] {
+			if unicode.IsLower(ra[i]) && unicode.IsUpper(rb[i]) {
+				return -1
+			}
+			if unicode.IsUpper(ra[i]) && unicode.IsLower(rb[i]) {
+				return 1
+			}
+			if ra[i] < rb[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func collationWeight(r rune) int {
+	if idx := strings.IndexRune(asciiCollation, unicode.ToLower(r)); idx >= 0 && r < unicode.MaxASCII {
+		return idx
+	}
+	return len(asciiCollation) + int(r)
+}
@@ -0,0 +1,303 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package userdata
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"unicode/utf16"
+)
+
+// The TOML emitter below is a port of `stringify` of @iarna/toml, which the component uses to render the Bottlerocket
+// settings.
+
+type tomlType string
+
+const (
+	tomlNull    tomlType = "null"
+	tomlInteger tomlType = "integer"
+	tomlFloat   tomlType = "float"
+	tomlBoolean tomlType = "boolean"
+	tomlString  tomlType = "string"
+	tomlArray   tomlType = "array"
+	tomlTable   tomlType = "table"
+)
+
+func typeOfTOML(value any) tomlType {
+	switch value := value.(type) {
+	case nil:
+		return tomlNull
+	case float64:
+		if isInteger(value) {
+			return tomlInteger
+		}
+		return tomlFloat
+	case bool:
+		return tomlBoolean
+	case string:
+		return tomlString
+	case []any:
+		return tomlArray
+	default:
+		return tomlTable
+	}
+}
+
+// stringifyTOML mirrors `toml.stringify(value)`.
+func stringifyTOML(value *object) (string, error) {
+	return stringifyTOMLObject("", "", value)
+}
+
+func stringifyTOMLObject(prefix, indent string, obj *object) (string, error) {
+	var inlineKeys, complexKeys []string
+	for _, key := range obj.Keys() {
+		if isTOMLInline(obj.values[key]) {
+			inlineKeys = append(inlineKeys, key)
+		} else {
+			complexKeys = append(complexKeys, key)
+		}
+	}
+
+	var result []string
+	for _, key := range inlineKeys {
+		value := obj.values[key]
+		if typeOfTOML(value) == tomlNull {
+			continue
+		}
+		inline, err := stringifyTOMLAnyInline(value, true)
+		if err != nil {
+			return "", err
+		}
+		result = append(result, indent+stringifyTOMLKey(key)+" = "+inline)
+	}
+	if len(result) > 0 {
+		result = append(result, "")
+	}
+
+	complexIndent := ""
+	if prefix != "" && len(inlineKeys) > 0 {
+		complexIndent = indent + "  "
+	}
+	for _, key := range complexKeys {
+		complex, err := stringifyTOMLComplex(prefix, complexIndent, key, obj.values[key])
+		if err != nil {
+			return "", err
+		}
+		result = append(result, complex)
+	}
+	return strings.Join(result, "\n"), nil
+}
+
+func isTOMLInline(value any) bool {
+	switch typeOfTOML(value) {
+	case tomlArray:
+		arr := value.([]any)
+		return len(arr) == 0 || typeOfTOML(arr[0]) != tomlTable
+	case tomlTable:
+		return value.(*object).len() == 0
+	default:
+		return true
+	}
+}
+
+var bareTOMLKey = regexp.MustCompile(`^[-A-Za-z0-9_]+$`)
+
+func stringifyTOMLKey(key string) string {
+	if bareTOMLKey.MatchString(key) {
+		return key
+	}
+	return stringifyTOMLBasicString(key)
+}
+
+func stringifyTOMLBasicString(str string) string {
+	return `"` + strings.ReplaceAll(escapeTOMLString(str), `"`, `\"`) + `"`
+}
+
+var tomlControlCharacter = regexp.MustCompile("[\u0000-\u001f\u007f]")
+
+func escapeTOMLString(str string) string {
+	str = strings.NewReplacer(`\`, `\\`, "\b", `\b`, "\t", `\t`, "\n", `\n`, "\f", `\f`, "\r", `\r`).Replace(str)
+	// @iarna/toml only escapes the first remaining control character
+	if loc := tomlControlCharacter.FindStringIndex(str); loc != nil {
+		str = str[:loc[0]] + fmt.Sprintf(`\u%04x`, str[loc[0]]) + str[loc[1]:]
+	}
+	return str
+}
+
+func stringifyTOMLMultilineString(str string) string {
+	lines := strings.Split(str, "\n")
+	for i, line := range lines {
+		// replaces /"(?="")/g, i.e. every quote followed by two quotes
+		escaped := escapeTOMLString(line)
+		var b strings.Builder
+		for j := 0; j < len(escaped); j++ {
+			if escaped[j] == '"' && strings.HasPrefix(escaped[j+1:], `""`) {
+				b.WriteString(`\"`)
+			} else {
+				b.WriteByte(escaped[j])
+			}
+		}
+		lines[i] = b.String()
+	}
+	escaped := strings.Join(lines, "\n")
+	if strings.HasSuffix(escaped, `"`) {
+		escaped += "\\\n"
+	}
+	return `"""` + "\n" + escaped + `"""`
+}
+
+func stringifyTOMLAnyInline(value any, multilineOk bool) (string, error) {
+	if str, ok := value.(string); ok {
+		if multilineOk && strings.Contains(str, "\n") {
+			return stringifyTOMLMultilineString(str), nil
+		} else if !strings.ContainsAny(str, "\b\t\n\f\r'") && strings.Contains(str, `"`) {
+			return "'" + str + "'", nil
+		}
+	}
+	return stringifyTOMLInline(value)
+}
+
+func stringifyTOMLInline(value any) (string, error) {
+	switch value := value.(type) {
+	case string:
+		return stringifyTOMLBasicString(value), nil
+	case float64:
+		if isInteger(value) {
+			return stringifyTOMLInteger(formatNumber(value)), nil
+		}
+		return stringifyTOMLFloat(value), nil
+	case bool:
+		return fmt.Sprint(value), nil
+	case []any:
+		var values []any
+		for _, v := range value {
+			if v != nil && !(typeOfTOML(v) == tomlFloat && math.IsNaN(v.(float64))) {
+				values = append(values, v)
+			}
+		}
+		return stringifyTOMLInlineArray(values)
+	case *object:
+		return stringifyTOMLInlineTable(value)
+	default:
+		return "", fmt.Errorf("can only stringify objects, not %s", typeOfTOML(value))
+	}
+}
+
+var integerGroups = regexp.MustCompile(`[0-9]+`)
+
+// stringifyTOMLInteger separates the thousands of every number with underscores, e.g. `1500` becomes `1_500`.
+func stringifyTOMLInteger(str string) string {
+	return integerGroups.ReplaceAllStringFunc(str, func(digits string) string {
+		var b strings.Builder
+		for i, d := range digits {
+			if i > 0 && (len(digits)-i)%3 == 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(d)
+		}
+		return b.String()
+	})
+}
+
+func stringifyTOMLFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "inf"
+	case math.IsInf(value, -1):
+		return "-inf"
+	case math.IsNaN(value):
+		return "nan"
+	case value == 0 && math.Signbit(value):
+		return "-0.0"
+	}
+	integer, decimals, ok := strings.Cut(formatNumber(value), ".")
+	if !ok {
+		decimals = "0"
+	}
+	return stringifyTOMLInteger(integer) + "." + decimals
+}
+
+func stringifyTOMLInlineArray(values []any) (string, error) {
+	stringified := make([]string, len(values))
+	for i, v := range values {
+		s, err := stringifyTOMLInline(v)
+		if err != nil {
+			return "", err
+		}
+		stringified[i] = s
+	}
+	joined := strings.Join(stringified, ", ")
+	if len(utf16.Encode([]rune(joined))) > 60 || strings.Contains(strings.Join(stringified, ","), "\n") {
+		return "[\n  " + strings.Join(stringified, ",\n  ") + "\n]", nil
+	}
+	if len(stringified) > 0 {
+		return "[ " + joined + " ]", nil
+	}
+	return "[ ]", nil
+}
+
+func stringifyTOMLInlineTable(obj *object) (string, error) {
+	var result []string
+	for _, key := range obj.Keys() {
+		inline, err := stringifyTOMLAnyInline(obj.values[key], false)
+		if err != nil {
+			return "", err
+		}
+		result = append(result, stringifyTOMLKey(key)+" = "+inline)
+	}
+	if len(result) > 0 {
+		return "{ " + strings.Join(result, ", ") + " }", nil
+	}
+	return "{ }", nil
+}
+
+func stringifyTOMLComplex(prefix, indent, key string, value any) (string, error) {
+	fullKey := prefix + stringifyTOMLKey(key)
+	if arr, ok := value.([]any); ok {
+		var result strings.Builder
+		for _, item := range arr {
+			table, ok := item.(*object)
+			if !ok {
+				return "", fmt.Errorf("can only stringify objects, not %s", typeOfTOML(item))
+			}
+			if result.Len() > 0 {
+				result.WriteString("\n")
+			}
+			result.WriteString(indent + "[[" + fullKey + "]]\n")
+			s, err := stringifyTOMLObject(fullKey+".", indent, table)
+			if err != nil {
+				return "", err
+			}
+			result.WriteString(s)
+		}
+		return result.String(), nil
+	}
+
+	table := value.(*object)
+	result := ""
+	for _, k := range table.Keys() {
+		if isTOMLInline(table.values[k]) {
+			result = indent + "[" + fullKey + "]\n"
+			break
+		}
+	}
+	s, err := stringifyTOMLObject(fullKey+".", indent, table)
+	if err != nil {
+		return "", err
+	}
+	return result + s, nil
+}
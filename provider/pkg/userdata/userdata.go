@@ -0,0 +1,791 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package userdata renders the user data of node groups offline, exactly like the `NodeGroup`, `NodeGroupV2` and
+// `ManagedNodeGroup` components do. It is a port of `nodejs/eks/nodes/userdata.ts` and needs to be kept in sync with
+// it.
+package userdata
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"strings"
+)
+
+// OperatingSystem is the AMI family of the nodes.
+type OperatingSystem string
+
+const (
+	AL2          OperatingSystem = "AL2"
+	AL2023       OperatingSystem = "AL2023"
+	Bottlerocket OperatingSystem = "Bottlerocket"
+
+	// DefaultOperatingSystem is the operating system of node groups that specify neither `operatingSystem` nor
+	// `amiType`.
+	DefaultOperatingSystem = AL2023
+)
+
+// NodeGroupType is the component that creates the node group.
+type NodeGroupType string
+
+const (
+	// NodeGroup is a self-managed node group backed by a CloudFormation stack.
+	NodeGroup NodeGroupType = "NodeGroup"
+	// NodeGroupV2 is a self-managed node group backed by an auto scaling group.
+	NodeGroupV2 NodeGroupType = "NodeGroupV2"
+	// ManagedNodeGroup is an EKS managed node group with a custom launch template.
+	ManagedNodeGroup NodeGroupType = "ManagedNodeGroup"
+)
+
+// ErrEKSGeneratedUserData is returned for managed node groups without custom user data. EKS generates the user data
+// of those node groups.
+var ErrEKSGeneratedUserData = errors.New("the managed node group does not use custom user data, EKS generates " +
+	"the user data when creating the nodes")
+
+// ClusterMetadata are the properties of the cluster the nodes join.
+type ClusterMetadata struct {
+	// Name is the name of the EKS cluster.
+	Name string `json:"name"`
+	// APIServerEndpoint is the endpoint of the Kubernetes API server.
+	APIServerEndpoint string `json:"apiServerEndpoint"`
+	// CertificateAuthority is the base64 encoded certificate authority data of the cluster.
+	CertificateAuthority string `json:"certificateAuthority"`
+	// ServiceCIDR is the CIDR block Kubernetes service IP addresses are assigned from.
+	ServiceCIDR string `json:"serviceCidr"`
+}
+
+// NodeadmOptions is an additional part of the nodeadm user data.
+type NodeadmOptions struct {
+	Content     string `json:"content"`
+	ContentType string `json:"contentType"`
+}
+
+// Args are the inputs of a node group that affect its user data. They are named like the properties of the node
+// group components, object valued properties are kept as raw JSON to preserve the order of their keys.
+type Args struct {
+	// NodeGroupType is the component that creates the node group.
+	NodeGroupType NodeGroupType `json:"nodeGroupType"`
+	// Cluster are the properties of the cluster the nodes join.
+	Cluster ClusterMetadata `json:"cluster"`
+
+	OperatingSystem OperatingSystem `json:"operatingSystem,omitempty"`
+	AmiType         string          `json:"amiType,omitempty"`
+	AmiID           string          `json:"amiId,omitempty"`
+
+	// StackName is the name of the CloudFormation stack of `NodeGroup` components.
+	StackName string `json:"stackName,omitempty"`
+	// AWSRegion is the region of the CloudFormation stack of `NodeGroup` components.
+	AWSRegion string `json:"awsRegion,omitempty"`
+
+	NodeUserData         string `json:"nodeUserData,omitempty"`
+	NodeUserDataOverride string `json:"nodeUserDataOverride,omitempty"`
+	// UserData is the user data override of `ManagedNodeGroup` components.
+	UserData string `json:"userData,omitempty"`
+
+	KubeletExtraArgs   string `json:"kubeletExtraArgs,omitempty"`
+	BootstrapExtraArgs string `json:"bootstrapExtraArgs,omitempty"`
+
+	Labels json.RawMessage `json:"labels,omitempty"`
+	// Taints is a map of taints for self-managed node groups and a list of taints for managed node groups.
+	Taints               json.RawMessage  `json:"taints,omitempty"`
+	BottlerocketSettings json.RawMessage  `json:"bottlerocketSettings,omitempty"`
+	BottlerocketConfig   json.RawMessage  `json:"bottlerocketConfig,omitempty"`
+	NodeConfig           json.RawMessage  `json:"nodeConfig,omitempty"`
+	NodeadmExtraOptions  []NodeadmOptions `json:"nodeadmExtraOptions,omitempty"`
+
+	// MaxPods is the maximum number of pods the components compute for nodes with prefix delegation.
+	MaxPods int `json:"maxPods,omitempty"`
+}
+
+// ParseArgs parses node group inputs from JSON. Unknown properties are reported as errors.
+func ParseArgs(data []byte) (Args, error) {
+	var args Args
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&args); err != nil {
+		return Args{}, fmt.Errorf("parsing node group inputs: %w", err)
+	}
+	return args, nil
+}
+
+type taint struct {
+	value  string
+	effect string
+}
+
+// userDataArgs are the decoded arguments, the equivalent of `UserDataArgs` of the component.
+type userDataArgs struct {
+	Args
+	labels               *object
+	taints               *object
+	bottlerocketSettings *object
+	bottlerocketConfig   *object
+	nodeConfig           *object
+	extraUserData        string
+	userDataOverride     string
+}
+
+// Render returns the user data of the node group, or ErrEKSGeneratedUserData if EKS generates it.
+func Render(args Args) (string, error) {
+	os, err := ResolveOperatingSystem(args.AmiType, args.OperatingSystem)
+	if err != nil {
+		return "", err
+	}
+
+	a := userDataArgs{Args: args}
+	if a.labels, err = decodeObject("labels", args.Labels); err != nil {
+		return "", err
+	}
+	if a.bottlerocketSettings, err = decodeObject("bottlerocketSettings", args.BottlerocketSettings); err != nil {
+		return "", err
+	}
+	if a.bottlerocketConfig, err = decodeObject("bottlerocketConfig", args.BottlerocketConfig); err != nil {
+		return "", err
+	}
+	if a.nodeConfig, err = decodeObject("nodeConfig", args.NodeConfig); err != nil {
+		return "", err
+	}
+
+	switch args.NodeGroupType {
+	case NodeGroup, NodeGroupV2:
+		if a.taints, err = decodeObject("taints", args.Taints); err != nil {
+			return "", err
+		}
+		a.extraUserData = args.NodeUserData
+		a.userDataOverride = args.NodeUserDataOverride
+	case ManagedNodeGroup:
+		if a.taints, err = decodeManagedTaints(args.Taints); err != nil {
+			return "", err
+		}
+		a.userDataOverride = args.UserData
+		// when amiId is provided, the component creates custom user data because EKS does not provide default user
+		// data for custom AMIs.
+		if !requiresCustomUserData(args) && args.UserData == "" && args.AmiID == "" {
+			return "", ErrEKSGeneratedUserData
+		}
+	default:
+		return "", fmt.Errorf("unknown node group type %q, expected one of %s, %s or %s",
+			args.NodeGroupType, NodeGroup, NodeGroupV2, ManagedNodeGroup)
+	}
+
+	return createUserData(os, a)
+}
+
+func requiresCustomUserData(args Args) bool {
+	return args.KubeletExtraArgs != "" || args.BootstrapExtraArgs != "" || len(args.BottlerocketSettings) > 0 ||
+		len(args.BottlerocketConfig) > 0 || len(args.NodeConfig) > 0 || args.NodeadmExtraOptions != nil
+}
+
+func decodeObject(property string, data json.RawMessage) (*object, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	value, err := decodeJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", property, err)
+	}
+	switch value := value.(type) {
+	case nil:
+		return nil, nil
+	case *object:
+		return value, nil
+	default:
+		return nil, fmt.Errorf("%s must be an object", property)
+	}
+}
+
+var managedTaintEffects = map[string]string{
+	"NO_SCHEDULE":        "NoSchedule",
+	"NO_EXECUTE":         "NoExecute",
+	"PREFER_NO_SCHEDULE": "PreferNoSchedule",
+}
+
+// decodeManagedTaints converts the taints of managed node groups to the taints of self-managed node groups.
+func decodeManagedTaints(data json.RawMessage) (*object, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var taints []struct {
+		Key    string `json:"key"`
+		Value  string `json:"value"`
+		Effect string `json:"effect"`
+	}
+	if err := json.Unmarshal(data, &taints); err != nil {
+		return nil, fmt.Errorf("parsing taints: %w", err)
+	}
+	result := newObject()
+	for _, t := range taints {
+		effect, ok := managedTaintEffects[t.Effect]
+		if !ok {
+			return nil, fmt.Errorf("invalid taint effect: %s. Must be one of NO_SCHEDULE, NO_EXECUTE, "+
+				"PREFER_NO_SCHEDULE", t.Effect)
+		}
+		result.set(t.Key, taint{value: t.Value, effect: effect})
+	}
+	return result, nil
+}
+
+// taintsOf returns the taints in the order of their keys.
+func (a userDataArgs) taintsOf() ([]string, []taint, error) {
+	if a.taints == nil {
+		return nil, nil, nil
+	}
+	keys := a.taints.Keys()
+	taints := make([]taint, len(keys))
+	for i, key := range keys {
+		switch t := a.taints.values[key].(type) {
+		case taint:
+			taints[i] = t
+		case *object:
+			value, _ := t.get("value")
+			effect, _ := t.get("effect")
+			valueStr, _ := value.(string)
+			effectStr, ok := effect.(string)
+			if !ok {
+				return nil, nil, fmt.Errorf("the taint %q needs an effect", key)
+			}
+			taints[i] = taint{value: valueStr, effect: effectStr}
+		default:
+			return nil, nil, fmt.Errorf("the taint %q must be an object", key)
+		}
+	}
+	return keys, taints, nil
+}
+
+func createUserData(os OperatingSystem, args userDataArgs) (string, error) {
+	// if the user has provided a custom user data script, use that
+	if args.userDataOverride != "" {
+		return args.userDataOverride, nil
+	}
+
+	switch os {
+	case AL2:
+		return createLinuxUserData(args)
+	case AL2023:
+		return createNodeadmUserData(args)
+	case Bottlerocket:
+		return createBottlerocketUserData(args)
+	default:
+		return "", fmt.Errorf("unknown operating system: %s", os)
+	}
+}
+
+func createLinuxUserData(args userDataArgs) (string, error) {
+	if args.NodeGroupType != NodeGroup && args.bottlerocketSettings != nil {
+		return "", errors.New("the 'bottlerocketSettings' argument is not supported for Linux based user data")
+	}
+	if args.bottlerocketConfig != nil {
+		return "", errors.New("the 'bottlerocketConfig' argument is not supported for Linux based user data")
+	}
+	if args.nodeConfig != nil {
+		return "", errors.New("the 'nodeConfig' argument is not supported for Linux based user data")
+	}
+
+	// build the bootstrap arguments, they can also include kubelet flags if the user has provided them
+	kubeletExtraArgs, err := buildKubeletFlags(args)
+	if err != nil {
+		return "", err
+	}
+	bootstrapExtraArgs := ""
+	if args.BootstrapExtraArgs != "" {
+		bootstrapExtraArgs = " " + args.BootstrapExtraArgs
+	}
+	if len(kubeletExtraArgs) == 1 {
+		// For backward compatibility with previous versions of this package, don't wrap a single argument with `''`.
+		bootstrapExtraArgs += " --kubelet-extra-args " + kubeletExtraArgs[0]
+	} else if len(kubeletExtraArgs) > 1 {
+		bootstrapExtraArgs += " --kubelet-extra-args '" + strings.Join(kubeletExtraArgs, " ") + "'"
+	}
+
+	cluster := args.Cluster
+	baseUserData := "#!/bin/bash\n\n/etc/eks/bootstrap.sh --apiserver-endpoint \"" + cluster.APIServerEndpoint +
+		"\" --b64-cluster-ca \"" + cluster.CertificateAuthority + "\" \"" + cluster.Name + "\"" + bootstrapExtraArgs
+
+	// managed node groups must be in multi-part MIME format
+	if args.NodeGroupType == ManagedNodeGroup {
+		return "MIME-Version: 1.0\n" +
+			"Content-Type: multipart/mixed; boundary=\"==MYBOUNDARY==\"\n\n" +
+			"--==MYBOUNDARY==\n" +
+			"Content-Type: text/x-shellscript; charset=\"us-ascii\"\n\n" +
+			baseUserData + "\n" +
+			"--==MYBOUNDARY==--", nil
+	}
+
+	extraUserData := ""
+	if args.extraUserData != "" {
+		extraUserData = fmt.Sprintf("cat >/opt/user-data <<%[1]s-user-data\n%[2]s\n%[1]s-user-data\n"+
+			"chmod +x /opt/user-data\n/opt/user-data\n", args.StackName, args.extraUserData)
+	}
+
+	// We always add extraUserData to the user data script, even if it's empty. This is for backwards compatibility.
+	userData := baseUserData + "\n" + extraUserData + "\n"
+
+	// self-managed-v1 based node groups signal CloudFormation that the nodes have been created.
+	if args.NodeGroupType != NodeGroup {
+		return userData, nil
+	}
+	return userData + cfnSignal(args) + "\n", nil
+}
+
+func cfnSignal(args userDataArgs) string {
+	return fmt.Sprintf("/opt/aws/bin/cfn-signal --exit-code $? --stack %s --resource NodeGroup --region %s",
+		args.StackName, args.AWSRegion)
+}
+
+func nodeConfigDocument(spec any) *object {
+	doc := newObject()
+	doc.set("apiVersion", "node.eks.aws/v1alpha1")
+	doc.set("kind", "NodeConfig")
+	doc.set("spec", spec)
+	return doc
+}
+
+// createNodeadmUserData renders a multi-part MIME document that contains EKS NodeConfig as YAML and optional shell
+// scripts. See: https://awslabs.github.io/amazon-eks-ami/nodeadm/
+func createNodeadmUserData(args userDataArgs) (string, error) {
+	if args.NodeGroupType != NodeGroup && args.bottlerocketSettings != nil {
+		return "", errors.New("the 'bottlerocketSettings' argument is not supported for nodeadm based user data")
+	}
+	if args.bottlerocketConfig != nil {
+		return "", errors.New("the 'bottlerocketConfig' argument is not supported for nodeadm based user data")
+	}
+	if args.BootstrapExtraArgs != "" {
+		return "", errors.New("the 'bootstrapExtraArgs' argument is not supported for nodeadm based user data")
+	}
+
+	cluster := newObject()
+	cluster.set("name", args.Cluster.Name)
+	cluster.set("apiServerEndpoint", args.Cluster.APIServerEndpoint)
+	cluster.set("certificateAuthority", args.Cluster.CertificateAuthority)
+	cluster.set("cidr", args.Cluster.ServiceCIDR)
+	spec := newObject()
+	spec.set("cluster", cluster)
+
+	var parts []NodeadmOptions
+	addNodeConfig := func(spec any) error {
+		content, err := dumpYAML(nodeConfigDocument(spec))
+		if err != nil {
+			return err
+		}
+		parts = append(parts, NodeadmOptions{ContentType: "application/node.eks.aws", Content: "---\n" + content})
+		return nil
+	}
+	if err := addNodeConfig(spec); err != nil {
+		return "", err
+	}
+
+	// nodeadm config gets iteratively merged together, so we can add a new section for the kubelet flags
+	kubeletFlags, err := buildKubeletFlags(args)
+	if err != nil {
+		return "", err
+	}
+	if len(kubeletFlags) > 0 {
+		flags := make([]any, len(kubeletFlags))
+		for i, flag := range kubeletFlags {
+			flags[i] = flag
+		}
+		kubelet := newObject()
+		kubelet.set("flags", flags)
+		spec := newObject()
+		spec.set("kubelet", kubelet)
+		if err := addNodeConfig(spec); err != nil {
+			return "", err
+		}
+	}
+
+	// add the typed NodeConfig if provided, the extra nodeadm options can still override it
+	if args.nodeConfig != nil {
+		if err := addNodeConfig(args.nodeConfig); err != nil {
+			return "", err
+		}
+	}
+
+	parts = append(parts, args.NodeadmExtraOptions...)
+
+	if args.NodeGroupType != ManagedNodeGroup && args.extraUserData != "" {
+		parts = append(parts, NodeadmOptions{
+			ContentType: `text/x-shellscript; charset="us-ascii"`,
+			Content:     args.extraUserData,
+		})
+	}
+
+	if args.NodeGroupType == NodeGroup {
+		parts = append(parts, NodeadmOptions{
+			ContentType: `text/x-shellscript; charset="us-ascii"`,
+			Content:     "#!/bin/bash\n\n" + cfnSignal(args) + "\n",
+		})
+	}
+
+	return assembleNodeadmUserData(parts), nil
+}
+
+func assembleNodeadmUserData(parts []NodeadmOptions) string {
+	const boundary = "BOUNDARY"
+	var userData strings.Builder
+	fmt.Fprintf(&userData, "MIME-Version: 1.0\nContent-Type: multipart/mixed; boundary=\"%s\"\n\n", boundary)
+	for _, part := range parts {
+		fmt.Fprintf(&userData, "--%s\nContent-Type: %s\n\n%s\n", boundary, part.ContentType, part.Content)
+	}
+	fmt.Fprintf(&userData, "--%s--\n", boundary)
+	return userData.String()
+}
+
+// buildKubeletFlags returns the kubelet flags of the node. Labels and taints are added as flags so that the kubelet
+// registers the node with them. The maximum number of pods is added as well, unless it's set in `kubeletExtraArgs`.
+func buildKubeletFlags(args userDataArgs) ([]string, error) {
+	var flags []string
+	if args.KubeletExtraArgs != "" {
+		flags = strings.Split(args.KubeletExtraArgs, " ")
+	}
+
+	if args.labels != nil {
+		var parts []string
+		for _, key := range args.labels.Keys() {
+			parts = append(parts, key+"="+jsString(args.labels.values[key]))
+		}
+		if len(parts) > 0 {
+			flags = append(flags, "--node-labels="+strings.Join(parts, ","))
+		}
+	}
+
+	keys, taints, err := args.taintsOf()
+	if err != nil {
+		return nil, err
+	}
+	var parts []string
+	for i, key := range keys {
+		// taints are represented as key=value:effect or key:effect if value is empty
+		if taints[i].value != "" {
+			parts = append(parts, key+"="+taints[i].value+":"+taints[i].effect)
+		} else {
+			parts = append(parts, key+":"+taints[i].effect)
+		}
+	}
+	if len(parts) > 0 {
+		flags = append(flags, "--register-with-taints="+strings.Join(parts, ","))
+	}
+
+	if args.MaxPods != 0 {
+		hasMaxPods := false
+		for _, flag := range flags {
+			hasMaxPods = hasMaxPods || strings.HasPrefix(flag, "--max-pods")
+		}
+		if !hasMaxPods {
+			flags = append(flags, fmt.Sprintf("--max-pods=%d", args.MaxPods))
+		}
+	}
+	return flags, nil
+}
+
+// jsString converts a value to a string like string concatenation in JavaScript.
+func jsString(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return value
+	case float64:
+		return formatNumber(value)
+	case bool:
+		return fmt.Sprint(value)
+	case *object:
+		return "[object Object]"
+	case []any:
+		items := make([]string, len(value))
+		for i, item := range value {
+			if item != nil {
+				items[i] = jsString(item)
+			}
+		}
+		return strings.Join(items, ",")
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// createBottlerocketUserData renders the Bottlerocket settings as TOML. The base settings get merged with the user
+// defined ones. See: https://bottlerocket.dev/en/os/1.20.x/api/settings/
+func createBottlerocketUserData(args userDataArgs) (string, error) {
+	if args.BootstrapExtraArgs != "" {
+		return "", errors.New("the 'bootstrapExtraArgs' argument is not supported with Bottlerocket")
+	}
+	if args.KubeletExtraArgs != "" {
+		return "", errors.New("the 'kubeletExtraArgs' argument is not supported with Bottlerocket")
+	}
+	if args.nodeConfig != nil {
+		return "", errors.New("the 'nodeConfig' argument is not supported with Bottlerocket")
+	}
+	if args.NodeGroupType != ManagedNodeGroup && args.extraUserData != "" {
+		return "", errors.New("bottlerocket does not support running scripts as part of the user data, " +
+			"if you need to run scripts, please use a different OS")
+	}
+
+	clusterDNSIP, err := ClusterDNSIP(args.Cluster.ServiceCIDR)
+	if err != nil {
+		return "", err
+	}
+	kubernetes := newObject()
+	kubernetes.set("cluster-name", args.Cluster.Name)
+	kubernetes.set("api-server", args.Cluster.APIServerEndpoint)
+	kubernetes.set("cluster-certificate", args.Cluster.CertificateAuthority)
+	kubernetes.set("cluster-dns-ip", clusterDNSIP)
+
+	if args.labels != nil {
+		kubernetes.set("node-labels", args.labels)
+	}
+	if args.MaxPods != 0 {
+		kubernetes.set("max-pods", float64(args.MaxPods))
+	}
+	keys, taints, err := args.taintsOf()
+	if err != nil {
+		return "", err
+	}
+	if args.taints != nil {
+		nodeTaints := newObject()
+		for i, key := range keys {
+			// empty taint values are represented as an empty string
+			nodeTaints.set(key, taints[i].value+":"+taints[i].effect)
+		}
+		kubernetes.set("node-taints", nodeTaints)
+	}
+
+	// the typed config gets converted to settings, the untyped settings take precedence over it
+	base := newObject()
+	if args.bottlerocketConfig != nil {
+		base = bottlerocketConfigToSettings(args.bottlerocketConfig)
+	}
+	settingsDoc := mergeBottlerocketSettings(base, args.bottlerocketSettings)
+
+	settings, ok := objectValue(settingsDoc, "settings")
+	if !ok {
+		settings = newObject()
+	} else {
+		settings = settings.clone()
+	}
+	settingsDoc.set("settings", settings)
+	userKubernetes, ok := objectValue(settings, "kubernetes")
+	if !ok {
+		userKubernetes = newObject()
+	}
+
+	if args.NodeGroupType == NodeGroup {
+		cloudformation := newObject()
+		cloudformation.set("should-signal", true)
+		cloudformation.set("stack-name", args.StackName)
+		cloudformation.set("logical-resource-id", "NodeGroup")
+		if existing, ok := objectValue(settings, "cloudformation"); ok {
+			for _, key := range existing.keys {
+				cloudformation.set(key, existing.values[key])
+			}
+		}
+		settings.set("cloudformation", cloudformation)
+	}
+
+	// merge the base settings with the user provided settings
+	merged := kubernetes.clone()
+	for _, key := range userKubernetes.keys {
+		merged.set(key, userKubernetes.values[key])
+	}
+	settings.set("kubernetes", merged)
+
+	return stringifyTOML(normalizeProperties(settingsDoc).(*object))
+}
+
+func objectValue(obj *object, key string) (*object, bool) {
+	value, _ := obj.get(key)
+	o, ok := value.(*object)
+	return o, ok
+}
+
+// normalizeProperties sorts the keys of all objects, objects in arrays are left as they are.
+func normalizeProperties(value any) any {
+	obj, ok := value.(*object)
+	if !ok {
+		return value
+	}
+	result := newObject()
+	for _, key := range sortKeysLocale(obj.keys) {
+		result.set(key, normalizeProperties(obj.values[key]))
+	}
+	return result
+}
+
+// bottlerocketSettingNames maps the properties of the typed Bottlerocket config to the Bottlerocket setting names.
+var bottlerocketSettingNames = map[string]map[string]string{
+	"kubernetes": {
+		"nodeLabels":                  "node-labels",
+		"nodeTaints":                  "node-taints",
+		"evictionHard":                "eviction-hard",
+		"evictionSoft":                "eviction-soft",
+		"evictionSoftGracePeriod":     "eviction-soft-grace-period",
+		"maxPods":                     "max-pods",
+		"imageGcHighThresholdPercent": "image-gc-high-threshold-percent",
+		"imageGcLowThresholdPercent":  "image-gc-low-threshold-percent",
+	},
+	"hostContainer":      {"userData": "user-data"},
+	"bootstrapContainer": {"userData": "user-data"},
+	"registryMirror":     {"endpoints": "endpoint"},
+}
+
+// bottlerocketConfigToSettings converts the typed Bottlerocket config to Bottlerocket settings.
+func bottlerocketConfigToSettings(config *object) *object {
+	settings := newObject()
+
+	if kubernetes, ok := objectValue(config, "kubernetes"); ok {
+		settings.set("kubernetes", renameKeys(kubernetes, bottlerocketSettingNames["kubernetes"]))
+	}
+	if hostContainers, ok := objectValue(config, "hostContainers"); ok {
+		containers := newObject()
+		for _, name := range hostContainers.Keys() {
+			if container, ok := hostContainers.values[name].(*object); ok {
+				containers.set(name, renameKeys(container, bottlerocketSettingNames["hostContainer"]))
+			}
+		}
+		settings.set("host-containers", containers)
+	}
+	if kernel, ok := objectValue(config, "kernel"); ok {
+		settings.set("kernel", renameKeys(kernel, nil))
+	}
+	if bootstrapContainers, ok := objectValue(config, "bootstrapContainers"); ok {
+		containers := newObject()
+		for _, name := range bootstrapContainers.Keys() {
+			if container, ok := bootstrapContainers.values[name].(*object); ok {
+				containers.set(name, renameKeys(container, bottlerocketSettingNames["bootstrapContainer"]))
+			}
+		}
+		settings.set("bootstrap-containers", containers)
+	}
+	if containerRegistry, ok := objectValue(config, "containerRegistry"); ok {
+		if mirrors, ok := containerRegistry.values["mirrors"].([]any); ok {
+			renamed := make([]any, len(mirrors))
+			for i, mirror := range mirrors {
+				if m, ok := mirror.(*object); ok {
+					renamed[i] = renameKeys(m, bottlerocketSettingNames["registryMirror"])
+				} else {
+					renamed[i] = mirror
+				}
+			}
+			registry := newObject()
+			registry.set("mirrors", renamed)
+			settings.set("container-registry", registry)
+		}
+	}
+
+	result := newObject()
+	result.set("settings", settings)
+	return result
+}
+
+// renameKeys renames the keys of the object, unset (null) properties are dropped.
+func renameKeys(obj *object, names map[string]string) *object {
+	result := newObject()
+	for _, key := range obj.Keys() {
+		if obj.values[key] == nil {
+			continue
+		}
+		name, ok := names[key]
+		if !ok {
+			name = key
+		}
+		result.set(name, obj.values[key])
+	}
+	return result
+}
+
+// mergeBottlerocketSettings merges Bottlerocket settings recursively. Values of overrides take precedence over the
+// ones of base.
+func mergeBottlerocketSettings(base, overrides *object) *object {
+	if overrides == nil {
+		return base
+	}
+	result := base.clone()
+	for _, key := range overrides.Keys() {
+		value := overrides.values[key]
+		existing, existingIsObject := result.values[key].(*object)
+		if valueObject, ok := value.(*object); ok && existingIsObject {
+			result.set(key, mergeBottlerocketSettings(existing, valueObject))
+		} else {
+			result.set(key, value)
+		}
+	}
+	return result
+}
+
+// ClusterDNSIP returns the IP address of the cluster DNS service, which is the 10th IP address of the service CIDR.
+func ClusterDNSIP(serviceCIDR string) (string, error) {
+	prefix, err := netip.ParsePrefix(serviceCIDR)
+	if err != nil {
+		return "", fmt.Errorf("couldn't calculate the cluster dns ip based on the service CIDR: %w", err)
+	}
+	network := prefix.Masked().Addr()
+	ip := new(big.Int).SetBytes(network.AsSlice())
+	ip.Add(ip, big.NewInt(10))
+
+	buf := make([]byte, network.BitLen()/8)
+	if ip.BitLen() > len(buf)*8 {
+		return "", fmt.Errorf("couldn't calculate the cluster dns ip based on the service CIDR: %s is out of range",
+			serviceCIDR)
+	}
+	addr, _ := netip.AddrFromSlice(ip.FillBytes(buf))
+	return addr.String(), nil
+}
+
+// amiTypeOperatingSystems maps the AMI types and their legacy aliases to their operating system.
+var amiTypeOperatingSystems = map[string]OperatingSystem{
+	"AL2_x86_64":                        AL2,
+	"AL2_x86_64_GPU":                    AL2,
+	"AL2_ARM_64":                        AL2,
+	"amazon-linux-2":                    AL2,
+	"amazon-linux-2-gpu":                AL2,
+	"amazon-linux-2-arm":                AL2,
+	"AL2023_x86_64_STANDARD":            AL2023,
+	"AL2023_ARM_64_STANDARD":            AL2023,
+	"AL2023_x86_64_NVIDIA":              AL2023,
+	"amazon-linux-2023/x86_64/standard": AL2023,
+	"amazon-linux-2023/arm64/standard":  AL2023,
+	"amazon-linux-2023/x86_64/nvidia":   AL2023,
+	"BOTTLEROCKET_ARM_64":               Bottlerocket,
+	"BOTTLEROCKET_x86_64":               Bottlerocket,
+	"BOTTLEROCKET_ARM_64_NVIDIA":        Bottlerocket,
+	"BOTTLEROCKET_x86_64_NVIDIA":        Bottlerocket,
+}
+
+// ResolveOperatingSystem returns the operating system of the nodes like the components do: the AMI type takes
+// precedence over the operating system and both need to match if both are set.
+func ResolveOperatingSystem(amiType string, operatingSystem OperatingSystem) (OperatingSystem, error) {
+	if amiType == "" {
+		switch operatingSystem {
+		case "":
+			return DefaultOperatingSystem, nil
+		case AL2, AL2023, Bottlerocket:
+			return operatingSystem, nil
+		default:
+			return "", fmt.Errorf("unknown operating system %q", operatingSystem)
+		}
+	}
+
+	resolved, ok := amiTypeOperatingSystems[amiType]
+	if !ok {
+		return "", fmt.Errorf("cannot determine OS of unknown AMI type: %s", amiType)
+	}
+	if operatingSystem != "" && operatingSystem != resolved {
+		return "", fmt.Errorf("operating system '%s' does not match the detected operating system '%s' of AMI "+
+			"type '%s'", operatingSystem, resolved, amiType)
+	}
+	return resolved, nil
+}
@@ -0,0 +1,557 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package userdata
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// The golden files are the snapshots of nodejs/eks/nodes/userdata.test.ts, so the user data rendered by this
+// package is verified against the user data of the components. TestGoldenFilesMatchSnapshots keeps them in sync.
+func TestRenderGolden(t *testing.T) {
+	t.Parallel()
+
+	inputs, err := filepath.Glob(filepath.Join("testdata", "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inputs) == 0 {
+		t.Fatal("no test cases found")
+	}
+
+	for _, input := range inputs {
+		name := strings.TrimSuffix(filepath.Base(input), ".json")
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := os.ReadFile(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected, err := os.ReadFile(strings.TrimSuffix(input, ".json") + ".golden")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			args, err := ParseArgs(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			actual, err := Render(args)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != string(expected) {
+				t.Errorf("unexpected user data, expected:\n%s\ngot:\n%s", expected, actual)
+			}
+		})
+	}
+}
+
+// snapshotFile holds the jest snapshots of the user data rendered by the components.
+var snapshotFile = filepath.Join(
+	"..", "..", "..", "nodejs", "eks", "nodes", "__snapshots__", "userdata.test.ts.snap")
+
+// goldenSnapshots maps the golden files to the jest snapshots they are copies of.
+//
+//nolint:lll
+var goldenSnapshots = map[string]string{
+	"al2-managed":                        "createUserData linux should return the correct user data for a basic ManagedNodeGroup 1",
+	"al2-managed-empty-taint":            "createUserData linux should support empty taint values 1",
+	"al2-managed-max-pods":               "createUserData with maxPods should render max-pods for a ManagedNodeGroup with linux 1",
+	"al2-nodegroup":                      "createUserData linux should return the correct user data for a basic NodeGroup v1 1",
+	"al2-nodegroupv2":                    "createUserData linux should return the correct user data for a basic NodeGroup v2 1",
+	"al2023-managed":                     "createUserData nodeadm should return the correct user data for a basic ManagedNodeGroup 1",
+	"al2023-managed-empty-taint":         "createUserData nodeadm should support empty taint values 1",
+	"al2023-managed-node-config":         "createUserData with nodeConfig should render the typed NodeConfig after the generated parts 1",
+	"al2023-managed-nodeadm-options":     "createUserData nodeadm should allow adding extra nodeadm options for a ManagedNodeGroup 1",
+	"al2023-nodegroup":                   "createUserData nodeadm should return the correct user data for a basic NodeGroup v1 1",
+	"al2023-nodegroup-nodeadm-options":   "createUserData nodeadm should allow adding extra nodeadm options for a NodeGroup 1",
+	"al2023-nodegroupv2":                 "createUserData nodeadm should return the correct user data for a basic NodeGroup v2 1",
+	"al2023-nodegroupv2-max-pods":        "createUserData with maxPods should render max-pods for a NodeGroup v2 with nodeadm 1",
+	"al2023-nodegroupv2-nodeadm-options": "createUserData nodeadm should allow adding extra nodeadm options for a NodeGroupV2 1",
+	"bottlerocket-managed":               "createUserData bottlerocket should return the correct user data for a basic ManagedNodeGroup 1",
+	"bottlerocket-managed-additional":    "createUserData bottlerocket should allow adding additional configuration 1",
+	"bottlerocket-managed-config":        "createUserData with bottlerocketConfig should merge the typed config with bottlerocketSettings 1",
+	"bottlerocket-managed-empty-taint":   "createUserData bottlerocket should support empty taint values 1",
+	"bottlerocket-managed-override":      "createUserData bottlerocket should allow overwriting base configuration 1",
+	"bottlerocket-nodegroupv2":           "createUserData bottlerocket should return the correct user data for a basic NodeGroup v2 1",
+}
+
+// snapshotPattern matches the string snapshots of a jest snapshot file, e.g. exports[`name 1`] = `"value"`;
+var snapshotPattern = regexp.MustCompile("(?s)exports\\[`(.*?)`\\] = `\n\"(.*?)\"\n`;")
+
+// readSnapshots returns the string snapshots of a jest snapshot file by name.
+func readSnapshots(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	// jest escapes backticks, backslashes and template placeholders in the snapshot template literals.
+	unescape := strings.NewReplacer("\\`", "`", "\\\\", "\\", "\\${", "${")
+	snapshots := map[string]string{}
+	for _, match := range snapshotPattern.FindAllStringSubmatch(string(data), -1) {
+		snapshots[unescape.Replace(match[1])] = unescape.Replace(match[2])
+	}
+	return snapshots, nil
+}
+
+// The golden files are copies of the jest snapshots, so changes to the user data of either the components or this
+// package fail the tests until both render the same user data again.
+func TestGoldenFilesMatchSnapshots(t *testing.T) {
+	t.Parallel()
+
+	snapshots, err := readSnapshots(snapshotFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) == 0 {
+		t.Fatalf("no snapshots found in %s", snapshotFile)
+	}
+
+	goldens, err := filepath.Glob(filepath.Join("testdata", "*.golden"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	covered := map[string]bool{}
+	for _, golden := range goldens {
+		name := strings.TrimSuffix(filepath.Base(golden), ".golden")
+		snapshotName, ok := goldenSnapshots[name]
+		if !ok {
+			t.Errorf("golden file %s is not mapped to a snapshot", golden)
+			continue
+		}
+		covered[snapshotName] = true
+
+		snapshot, ok := snapshots[snapshotName]
+		if !ok {
+			t.Errorf("golden file %s is mapped to the missing snapshot %q", golden, snapshotName)
+			continue
+		}
+		expected, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if snapshot != string(expected) {
+			t.Errorf("golden file %s differs from snapshot %q, expected:\n%s\ngot:\n%s",
+				golden, snapshotName, snapshot, expected)
+		}
+	}
+
+	for snapshotName := range snapshots {
+		if !covered[snapshotName] {
+			t.Errorf("snapshot %q has no golden file", snapshotName)
+		}
+	}
+}
+
+func TestRenderNodeConfig(t *testing.T) {
+	t.Parallel()
+
+	args, err := ParseArgs([]byte(`{
+		"nodeGroupType": "NodeGroupV2",
+		"cluster": {"name": "example", "apiServerEndpoint": "https://example.com", "certificateAuthority": "Y2E=",
+			"serviceCidr": "10.100.0.0/16"},
+		"maxPods": 110,
+		"nodeConfig": {
+			"kubelet": {"config": {"shutdownGracePeriod": "30s", "cpuCFSQuota": false}},
+			"containerd": {"config": "[plugins.\"io.containerd.grpc.v1.cri\"]\nenable_cdi = true\n"},
+			"instance": {"localStorage": {"strategy": "RAID0"}}
+		}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual, err := Render(args)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `--BOUNDARY
+Content-Type: application/node.eks.aws
+
+---
+apiVersion: node.eks.aws/v1alpha1
+kind: NodeConfig
+spec:
+  kubelet:
+    flags:
+      - '--max-pods=110'
+
+--BOUNDARY
+Content-Type: application/node.eks.aws
+
+---
+apiVersion: node.eks.aws/v1alpha1
+kind: NodeConfig
+spec:
+  kubelet:
+    config:
+      shutdownGracePeriod: 30s
+      cpuCFSQuota: false
+  containerd:
+    config: |
+      [plugins."io.containerd.grpc.v1.cri"]
+      enable_cdi = true
+  instance:
+    localStorage:
+      strategy: RAID0
+
+--BOUNDARY--
+`
+	if !strings.HasSuffix(actual, expected) {
+		t.Errorf("unexpected user data:\n%s", actual)
+	}
+}
+
+func TestRenderBottlerocketConfig(t *testing.T) {
+	t.Parallel()
+
+	args, err := ParseArgs([]byte(`{
+		"nodeGroupType": "NodeGroup",
+		"operatingSystem": "Bottlerocket",
+		"stackName": "example-stack",
+		"cluster": {"name": "example", "apiServerEndpoint": "https://example.com", "certificateAuthority": "Y2E=",
+			"serviceCidr": "fd00::/108"},
+		"bottlerocketConfig": {
+			"kubernetes": {"maxPods": 50},
+			"containerRegistry": {"mirrors": [{"registry": "docker.io", "endpoints": ["https://mirror.example.com"]}]}
+		},
+		"bottlerocketSettings": {"settings": {"kubernetes": {"max-pods": 60}}}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual, err := Render(args)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `[settings.cloudformation]
+logical-resource-id = "NodeGroup"
+should-signal = true
+stack-name = "example-stack"
+
+[[settings.container-registry.mirrors]]
+registry = "docker.io"
+endpoint = [ "https://mirror.example.com" ]
+
+[settings.kubernetes]
+api-server = "https://example.com"
+cluster-certificate = "Y2E="
+cluster-dns-ip = "fd00::a"
+cluster-name = "example"
+max-pods = 60
+`
+	if actual != expected {
+		t.Errorf("unexpected user data:\n%s", actual)
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		args     string
+		expected string
+	}{
+		{
+			name:     "bootstrapExtraArgs with nodeadm",
+			args:     `{"nodeGroupType": "NodeGroupV2", "bootstrapExtraArgs": "--enable-docker-bridge=true"}`,
+			expected: "the 'bootstrapExtraArgs' argument is not supported for nodeadm based user data",
+		},
+		{
+			name:     "kubeletExtraArgs with Bottlerocket",
+			args:     `{"nodeGroupType": "NodeGroupV2", "operatingSystem": "Bottlerocket", "kubeletExtraArgs": "--v=2"}`,
+			expected: "the 'kubeletExtraArgs' argument is not supported with Bottlerocket",
+		},
+		{
+			name:     "nodeConfig with AL2",
+			args:     `{"nodeGroupType": "NodeGroupV2", "operatingSystem": "AL2", "nodeConfig": {}}`,
+			expected: "the 'nodeConfig' argument is not supported for Linux based user data",
+		},
+		{
+			name:     "mismatching AMI type",
+			args:     `{"nodeGroupType": "NodeGroupV2", "operatingSystem": "AL2", "amiType": "AL2023_x86_64_STANDARD"}`,
+			expected: "operating system 'AL2' does not match the detected operating system 'AL2023'",
+		},
+		{
+			name:     "invalid managed taint effect",
+			args:     `{"nodeGroupType": "ManagedNodeGroup", "taints": [{"key": "a", "effect": "NoSchedule"}]}`,
+			expected: "invalid taint effect: NoSchedule",
+		},
+		{
+			name:     "unknown node group type",
+			args:     `{"nodeGroupType": "Fargate"}`,
+			expected: `unknown node group type "Fargate"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args, err := ParseArgs([]byte(tt.args))
+			if err != nil {
+				t.Fatal(err)
+			}
+			args.Cluster.ServiceCIDR = "10.100.0.0/16"
+			_, err = Render(args)
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("expected an error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+
+	_, err := Render(Args{NodeGroupType: ManagedNodeGroup, Labels: []byte(`{"a": "b"}`)})
+	if !errors.Is(err, ErrEKSGeneratedUserData) {
+		t.Errorf("expected ErrEKSGeneratedUserData, got %v", err)
+	}
+
+	_, err = ParseArgs([]byte(`{"nodeGroupType": "NodeGroupV2", "kubeletExtraArg": "--v=2"}`))
+	if err == nil {
+		t.Error("expected an error for the unknown property")
+	}
+}
+
+func TestClusterDNSIP(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"10.100.0.0/16":            "10.100.0.10",
+		"172.20.0.0/16":            "172.20.0.10",
+		"10.0.0.0/8":               "10.0.0.10",
+		"203.0.113.100/27":         "203.0.113.106",
+		"2001:db8::/32":            "2001:db8::a",
+		"fd00::/64":                "fd00::a",
+		"2001:0db8:abcd:0012::/64": "2001:db8:abcd:12::a",
+		"fe80::/64":                "fe80::a",
+	}
+	for serviceCIDR, expected := range tests {
+		actual, err := ClusterDNSIP(serviceCIDR)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", serviceCIDR, err)
+		} else if actual != expected {
+			t.Errorf("expected cluster DNS IP %s for %s, got %s", expected, serviceCIDR, actual)
+		}
+	}
+
+	if _, err := ClusterDNSIP("255.255.255.255/32"); err == nil {
+		t.Error("expected an error for a cluster DNS IP out of range")
+	}
+	if _, err := ClusterDNSIP("not-a-cidr"); err == nil {
+		t.Error("expected an error for an invalid CIDR")
+	}
+}
+
+func TestDumpYAML(t *testing.T) {
+	t.Parallel()
+
+	value, err := decodeJSON([]byte(`{
+		"plain": "value",
+		"quoted": ["1.28", "true", "", "yes", "- item", "key: value", "2024-01-01"],
+		"numbers": [1, 1.5, 1e-7],
+		"long": "` + strings.TrimSpace(strings.Repeat("word ", 20)) + `",
+		"empty": {"object": {}, "array": []},
+		"10": "array index keys come first",
+		"nested": [{"a": 1, "b": [true, null]}]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual, err := dumpYAML(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `'10': array index keys come first
+plain: value
+quoted:
+  - '1.28'
+  - 'true'
+  - ''
+  - 'yes'
+  - '- item'
+  - 'key: value'
+  - '2024-01-01'
+numbers:
+  - 1
+  - 1.5
+  - 1.e-7
+long: >-
+  word word word word word word word word word word word word word word word
+  word word word word word
+empty:
+  object: {}
+  array: []
+nested:
+  - a: 1
+    b:
+      - true
+      - null
+`
+	if actual != expected {
+		t.Errorf("unexpected YAML:\n%s", actual)
+	}
+}
+
+func TestDumpYAMLQuoting(t *testing.T) {
+	t.Parallel()
+
+	value, err := decodeJSON([]byte(`{
+		"apostrophe": "it's",
+		"indicator": "- it's",
+		"doubleQuote": "say \"hi\"",
+		"colon": "a: b",
+		"comment": "a #b",
+		"leadingSpace": " padded",
+		"tab": "a\tb",
+		"control": "a\u0001b",
+		"clip": "line one\nline two\n",
+		"strip": "line one\nline two",
+		"indented": "  indented\nline",
+		"null": "null",
+		"octal": "0o17",
+		"hex": "0x1F",
+		"exponent": "1e3",
+		"tilde": "~",
+		"alias": "*anchor",
+		"unicode": "ключ",
+		"emptyKey": {"": "v"}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual, err := dumpYAML(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `apostrophe: it's
+indicator: '- it''s'
+doubleQuote: say "hi"
+colon: 'a: b'
+comment: 'a #b'
+leadingSpace: ' padded'
+tab: "a\tb"
+control: "a\x01b"
+clip: |
+  line one
+  line two
+strip: |-
+  line one
+  line two
+indented: |2-
+    indented
+  line
+'null': 'null'
+octal: '0o17'
+hex: '0x1F'
+exponent: '1e3'
+tilde: '~'
+alias: '*anchor'
+unicode: ключ
+emptyKey:
+  '': v
+`
+	if actual != expected {
+		t.Errorf("unexpected YAML:\n%s", actual)
+	}
+}
+
+func TestStringifyTOML(t *testing.T) {
+	t.Parallel()
+
+	value, err := decodeJSON([]byte(`{
+		"plain": "value",
+		"doubleQuote": "say \"hi\"",
+		"bothQuotes": "it's \"quoted\"",
+		"backslash": "C:\\path",
+		"tab": "a\tb",
+		"control": "a\u0001b",
+		"multiline": "line one\nline \"\"\"two\"",
+		"integer": 1500,
+		"float": 2.5,
+		"bool": false,
+		"short": ["a", "b"],
+		"long": ["https://mirror-one.example.com", "https://mirror-two.example.com"],
+		"empty": [],
+		"dotted.key": "x",
+		"ключ": "unicode",
+		"tables": [{"a": 1}],
+		"table": {"nested": {"key": "v"}, "empty": {}}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual, err := stringifyTOML(value.(*object))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `plain = "value"
+doubleQuote = 'say "hi"'
+bothQuotes = "it's \"quoted\""
+backslash = "C:\\path"
+tab = "a\tb"
+control = "a\u0001b"
+multiline = """
+line one
+line \"""two"\
+"""
+integer = 1_500
+float = 2.5
+bool = false
+short = [ "a", "b" ]
+long = [
+  "https://mirror-one.example.com",
+  "https://mirror-two.example.com"
+]
+empty = [ ]
+"dotted.key" = "x"
+"ключ" = "unicode"
+
+[[tables]]
+a = 1
+
+[table]
+empty = { }
+
+  [table.nested]
+  key = "v"
+`
+	if actual != expected {
+		t.Errorf("unexpected TOML:\n%s", actual)
+	}
+
+	if _, err := stringifyTOML(mustDecodeObject(t, `{"a": [{"b": 1}, 2]}`)); err == nil {
+		t.Error("expected an error for an array mixing tables and values")
+	}
+}
+
+func mustDecodeObject(t *testing.T, data string) *object {
+	t.Helper()
+	value, err := decodeJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value.(*object)
+}
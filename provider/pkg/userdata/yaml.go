@@ -0,0 +1,483 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package userdata
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"unicode/utf16"
+)
+
+// The YAML emitter below is a port of `dump` of js-yaml 4 with its default options, which the component uses to
+// render the nodeadm NodeConfig parts. Rendering byte for byte identical documents lets users diff the output of this
+// package with the user data of their launch templates.
+
+const (
+	yamlIndent    = 2
+	yamlLineWidth = 80
+)
+
+type scalarStyle int
+
+const (
+	stylePlain scalarStyle = iota
+	styleSingle
+	styleLiteral
+	styleFolded
+	styleDouble
+)
+
+// dumpYAML mirrors `jsyaml.dump(value)`. Undefined values are represented by omitting them from objects.
+func dumpYAML(value any) (string, error) {
+	dump, err := writeYAMLNode(0, value, true, false)
+	if err != nil {
+		return "", err
+	}
+	return dump + "\n", nil
+}
+
+func writeYAMLNode(level int, value any, compact, isKey bool) (string, error) {
+	switch value := value.(type) {
+	case nil:
+		return "null", nil
+	case bool:
+		if value {
+			return "true", nil
+		}
+		return "false", nil
+	case float64:
+		return representYAMLNumber(value), nil
+	case string:
+		return writeYAMLScalar(value, level, isKey), nil
+	case *object:
+		if value.len() == 0 {
+			return "{}", nil
+		}
+		return writeYAMLBlockMapping(level, value, compact)
+	case []any:
+		if len(value) == 0 {
+			return "[]", nil
+		}
+		return writeYAMLBlockSequence(level, value, compact)
+	default:
+		return "", fmt.Errorf("unacceptable kind of an object to dump: %T", value)
+	}
+}
+
+func representYAMLNumber(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return ".nan"
+	case math.IsInf(value, 1):
+		return ".inf"
+	case math.IsInf(value, -1):
+		return "-.inf"
+	case value == 0 && math.Signbit(value):
+		return "-0.0"
+	}
+	res := formatNumber(value)
+	if !isInteger(value) && scientificWithoutDot.MatchString(res) {
+		res = strings.Replace(res, "e", ".e", 1)
+	}
+	return res
+}
+
+var scientificWithoutDot = regexp.MustCompile(`^[-+]?[0-9]+e`)
+
+func generateNextLine(level int) string {
+	return "\n" + strings.Repeat(" ", yamlIndent*level)
+}
+
+func writeYAMLBlockMapping(level int, obj *object, compact bool) (string, error) {
+	var result strings.Builder
+	for _, key := range obj.Keys() {
+		var pair strings.Builder
+		if !compact || result.Len() != 0 {
+			pair.WriteString(generateNextLine(level))
+		}
+
+		keyDump, err := writeYAMLNode(level+1, key, true, true)
+		if err != nil {
+			return "", err
+		}
+		explicitPair := len(keyDump) > 1024
+		if explicitPair {
+			if strings.HasPrefix(keyDump, "\n") {
+				pair.WriteString("?")
+			} else {
+				pair.WriteString("? ")
+			}
+		}
+		pair.WriteString(keyDump)
+		if explicitPair {
+			pair.WriteString(generateNextLine(level))
+		}
+
+		valueDump, err := writeYAMLNode(level+1, obj.values[key], explicitPair, false)
+		if err != nil {
+			return "", err
+		}
+		if strings.HasPrefix(valueDump, "\n") {
+			pair.WriteString(":")
+		} else {
+			pair.WriteString(": ")
+		}
+		pair.WriteString(valueDump)
+		result.WriteString(pair.String())
+	}
+	return result.String(), nil
+}
+
+func writeYAMLBlockSequence(level int, arr []any, compact bool) (string, error) {
+	var result strings.Builder
+	for _, item := range arr {
+		dump, err := writeYAMLNode(level+1, item, true, false)
+		if err != nil {
+			return "", err
+		}
+		if !compact || result.Len() != 0 {
+			result.WriteString(generateNextLine(level))
+		}
+		if strings.HasPrefix(dump, "\n") {
+			result.WriteString("-")
+		} else {
+			result.WriteString("- ")
+		}
+		result.WriteString(dump)
+	}
+	return result.String(), nil
+}
+
+var deprecatedBooleans = map[string]bool{
+	"y": true, "Y": true, "yes": true, "Yes": true, "YES": true, "on": true, "On": true, "ON": true,
+	"n": true, "N": true, "no": true, "No": true, "NO": true, "off": true, "Off": true, "OFF": true,
+}
+
+var deprecatedBase60 = regexp.MustCompile(`^[-+]?[0-9_]+(?::[0-9_]+)+(?:\.[0-9_]*)?$`)
+
+func writeYAMLScalar(str string, level int, isKey bool) string {
+	if str == "" {
+		return "''"
+	}
+	if deprecatedBooleans[str] || deprecatedBase60.MatchString(str) {
+		return "'" + str + "'"
+	}
+
+	indent := yamlIndent * max(1, level)
+	// As indentation gets deeper, let the width decrease monotonically to the lower bound min(lineWidth, 40).
+	lineWidth := max(min(yamlLineWidth, 40), yamlLineWidth-indent)
+
+	switch chooseScalarStyle(str, isKey, lineWidth) {
+	case stylePlain:
+		return str
+	case styleSingle:
+		return "'" + strings.ReplaceAll(str, "'", "''") + "'"
+	case styleLiteral:
+		return "|" + blockHeader(str) + dropEndingNewline(indentString(str, indent))
+	case styleFolded:
+		return ">" + blockHeader(str) + dropEndingNewline(indentString(foldString(str, lineWidth), indent))
+	default:
+		return `"` + escapeYAMLString(str) + `"`
+	}
+}
+
+func chooseScalarStyle(str string, singleLineOnly bool, lineWidth int) scalarStyle {
+	units := utf16.Encode([]rune(str))
+	runes := []rune(str)
+	hasLineBreak, hasFoldableLine := false, false
+	previousLineBreak := -1
+	plain := isPlainSafeFirst(runes[0]) && isPlainSafeLast(runes[len(runes)-1])
+
+	i := 0
+	prev := rune(-1)
+	for _, c := range runes {
+		if !singleLineOnly && c == '\n' {
+			hasLineBreak = true
+			hasFoldableLine = hasFoldableLine || (i-previousLineBreak-1 > lineWidth && units[previousLineBreak+1] != ' ')
+			previousLineBreak = i
+		} else if !isPrintable(c) {
+			return styleDouble
+		}
+		plain = plain && isPlainSafe(c, prev)
+		prev = c
+		i += utf16.RuneLen(c)
+	}
+	if !singleLineOnly {
+		hasFoldableLine = hasFoldableLine || (i-previousLineBreak-1 > lineWidth && units[previousLineBreak+1] != ' ')
+	}
+
+	if !hasLineBreak && !hasFoldableLine {
+		if plain && !testImplicitResolving(str) {
+			return stylePlain
+		}
+		return styleSingle
+	}
+	if hasFoldableLine {
+		return styleFolded
+	}
+	return styleLiteral
+}
+
+func isWhitespace(c rune) bool {
+	return c == ' ' || c == '\t'
+}
+
+func isPrintable(c rune) bool {
+	return (0x20 <= c && c <= 0x7E) ||
+		(0xA1 <= c && c <= 0xD7FF && c != 0x2028 && c != 0x2029) ||
+		(0xE000 <= c && c <= 0xFFFD && c != 0xFEFF) ||
+		(0x10000 <= c && c <= 0x10FFFF)
+}
+
+func isNsCharOrWhitespace(c rune) bool {
+	return isPrintable(c) && c != 0xFEFF && c != '\r' && c != '\n'
+}
+
+// isPlainSafe mirrors js-yaml for block context, which is the only context of the emitter.
+func isPlainSafe(c, prev rune) bool {
+	cIsNsCharOrWhitespace := isNsCharOrWhitespace(c)
+	cIsNsChar := cIsNsCharOrWhitespace && !isWhitespace(c)
+	return (cIsNsCharOrWhitespace && c != '#' && !(prev == ':' && !cIsNsChar)) ||
+		(prev >= 0 && isNsCharOrWhitespace(prev) && !isWhitespace(prev) && c == '#') ||
+		(prev == ':' && cIsNsChar)
+}
+
+func isPlainSafeFirst(c rune) bool {
+	return isPrintable(c) && c != 0xFEFF && !isWhitespace(c) && !strings.ContainsRune("-?:,[]{}#&*!|=>'\"%@`", c)
+}
+
+func isPlainSafeLast(c rune) bool {
+	return !isWhitespace(c) && c != ':'
+}
+
+var (
+	yamlNull      = regexp.MustCompile(`^(?:~|null|Null|NULL)$`)
+	yamlBool      = regexp.MustCompile(`^(?:true|True|TRUE|false|False|FALSE)$`)
+	yamlFloat     = regexp.MustCompile(`^(?:[-+]?(?:[0-9][0-9_]*)(?:\.[0-9_]*)?(?:[eE][-+]?[0-9]+)?|\.[0-9_]+(?:[eE][-+]?[0-9]+)?|[-+]?\.(?:inf|Inf|INF)|\.(?:nan|NaN|NAN))$`) //nolint:lll
+	yamlDate      = regexp.MustCompile(`^[0-9][0-9][0-9][0-9]-[0-9][0-9]-[0-9][0-9]$`)
+	yamlTimestamp = regexp.MustCompile(`^[0-9][0-9][0-9][0-9]-[0-9][0-9]?-[0-9][0-9]?(?:[Tt]|[ \t]+)[0-9][0-9]?:[0-9][0-9]:[0-9][0-9](?:\.[0-9]*)?(?:[ \t]*(?:Z|[-+][0-9][0-9]?(?::[0-9][0-9])?))?$`) //nolint:lll
+)
+
+// testImplicitResolving reports whether the string would be read as another type than string by the default schema.
+func testImplicitResolving(str string) bool {
+	return yamlNull.MatchString(str) || yamlBool.MatchString(str) || resolveYAMLInteger(str) ||
+		(yamlFloat.MatchString(str) && !strings.HasSuffix(str, "_")) ||
+		yamlDate.MatchString(str) || yamlTimestamp.MatchString(str) || str == "<<"
+}
+
+func resolveYAMLInteger(data string) bool {
+	if data == "" {
+		return false
+	}
+	index := 0
+	ch := data[index]
+	next := func() byte {
+		index++
+		if index < len(data) {
+			return data[index]
+		}
+		return 0
+	}
+	if ch == '-' || ch == '+' {
+		ch = next()
+	}
+
+	digitsOfBase := func(isDigit func(byte) bool) bool {
+		index++
+		hasDigits := false
+		for ; index < len(data); index++ {
+			ch = data[index]
+			if ch == '_' {
+				continue
+			}
+			if !isDigit(ch) {
+				return false
+			}
+			hasDigits = true
+		}
+		return hasDigits && ch != '_'
+	}
+
+	if ch == '0' {
+		if index+1 == len(data) {
+			return true
+		}
+		ch = next()
+		switch ch {
+		case 'b':
+			return digitsOfBase(func(c byte) bool { return c == '0' || c == '1' })
+		case 'x':
+			return digitsOfBase(func(c byte) bool {
+				return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+			})
+		case 'o':
+			return digitsOfBase(func(c byte) bool { return '0' <= c && c <= '7' })
+		}
+	}
+
+	if ch == '_' {
+		return false
+	}
+	hasDigits := false
+	for ; index < len(data); index++ {
+		ch = data[index]
+		if ch == '_' {
+			continue
+		}
+		if ch < '0' || ch > '9' {
+			return false
+		}
+		hasDigits = true
+	}
+	return hasDigits && ch != '_'
+}
+
+func blockHeader(str string) string {
+	indentIndicator := ""
+	if strings.HasPrefix(strings.TrimLeft(str, "\n"), " ") {
+		indentIndicator = fmt.Sprint(yamlIndent)
+	}
+	clip := strings.HasSuffix(str, "\n")
+	keep := clip && (strings.HasSuffix(str, "\n\n") || str == "\n")
+	chomp := "-"
+	if keep {
+		chomp = "+"
+	} else if clip {
+		chomp = ""
+	}
+	return indentIndicator + chomp + "\n"
+}
+
+func dropEndingNewline(str string) string {
+	return strings.TrimSuffix(str, "\n")
+}
+
+func indentString(str string, spaces int) string {
+	ind := strings.Repeat(" ", spaces)
+	var result strings.Builder
+	for _, line := range strings.SplitAfter(str, "\n") {
+		if line != "" && line != "\n" {
+			result.WriteString(ind)
+		}
+		result.WriteString(line)
+	}
+	return result.String()
+}
+
+// foldString folds the lines of the string at spaces so that they don't exceed the width. The computations are done
+// on UTF-16 code units like in JavaScript.
+func foldString(str string, width int) string {
+	units := utf16.Encode([]rune(str))
+	nextLF := indexUnit(units, '\n', 0)
+	if nextLF == -1 {
+		nextLF = len(units)
+	}
+	result := foldLine(units[:nextLF], width)
+
+	prevMoreIndented := len(units) > 0 && (units[0] == '\n' || units[0] == ' ')
+	for position := nextLF; position < len(units); {
+		// match /(\n+)([^\n]*)/
+		start := position
+		for position < len(units) && units[position] == '\n' {
+			position++
+		}
+		prefix := units[start:position]
+		lineEnd := indexUnit(units, '\n', position)
+		if lineEnd == -1 {
+			lineEnd = len(units)
+		}
+		line := units[position:lineEnd]
+		position = lineEnd
+
+		moreIndented := len(line) > 0 && line[0] == ' '
+		result = append(result, prefix...)
+		if !prevMoreIndented && !moreIndented && len(line) > 0 {
+			result = append(result, '\n')
+		}
+		result = append(result, foldLine(line, width)...)
+		prevMoreIndented = moreIndented
+	}
+	return string(utf16.Decode(result))
+}
+
+func foldLine(line []uint16, width int) []uint16 {
+	if len(line) == 0 || line[0] == ' ' {
+		return line
+	}
+
+	var result []uint16
+	start, curr := 0, 0
+	// match / [^ ]/g
+	for next := 0; next+1 < len(line); next++ {
+		if line[next] != ' ' || line[next+1] == ' ' {
+			continue
+		}
+		if next-start > width {
+			end := next
+			if curr > start {
+				end = curr
+			}
+			result = append(result, '\n')
+			result = append(result, line[start:end]...)
+			start = end + 1
+		}
+		curr = next
+		// the regular expression continues after the matched non-space character
+		next++
+	}
+	result = append(result, '\n')
+	if len(line)-start > width && curr > start {
+		result = append(result, line[start:curr]...)
+		result = append(result, '\n')
+		result = append(result, line[curr+1:]...)
+	} else {
+		result = append(result, line[start:]...)
+	}
+	return result[1:]
+}
+
+func indexUnit(units []uint16, unit uint16, from int) int {
+	for i := from; i < len(units); i++ {
+		if units[i] == unit {
+			return i
+		}
+	}
+	return -1
+}
+
+var yamlEscapeSequences = map[rune]string{
+	0x00: `\0`, 0x07: `\a`, 0x08: `\b`, 0x09: `\t`, 0x0A: `\n`, 0x0B: `\v`, 0x0C: `\f`, 0x0D: `\r`, 0x1B: `\e`,
+	0x22: `\"`, 0x5C: `\\`, 0x85: `\N`, 0xA0: `\_`, 0x2028: `\L`, 0x2029: `\P`,
+}
+
+func escapeYAMLString(str string) string {
+	var result strings.Builder
+	for _, c := range str {
+		if seq, ok := yamlEscapeSequences[c]; ok {
+			result.WriteString(seq)
+		} else if isPrintable(c) {
+			result.WriteRune(c)
+		} else if c <= 0xFF {
+			fmt.Fprintf(&result, `\x%02X`, c)
+		} else if c <= 0xFFFF {
+			fmt.Fprintf(&result, `\u%04X`, c)
+		} else {
+			fmt.Fprintf(&result, `\U%08X`, c)
+		}
+	}
+	return result.String()
+}
@@ -0,0 +1,144 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks
+{
+    /// <summary>
+    /// AutoModeNodeClass creates an EKS Auto Mode NodeClass, which configures the networking, storage and IAM role of the nodes of the node pools that reference it.
+    /// For more information see: https://docs.aws.amazon.com/eks/latest/userguide/create-node-class.html
+    /// </summary>
+    [EksResourceType("eks:index:AutoModeNodeClass")]
+    public partial class AutoModeNodeClass : global::Pulumi.ComponentResource
+    {
+        /// <summary>
+        /// The access entry of the custom node role, if `nodeRoleArn` is set.
+        /// </summary>
+        [Output("accessEntry")]
+        public Output<Pulumi.Aws.Eks.AccessEntry?> AccessEntry { get; private set; } = null!;
+
+        /// <summary>
+        /// The NodeClass custom resource.
+        /// </summary>
+        [Output("nodeClass")]
+        public Output<Pulumi.Kubernetes.ApiExtensions.CustomResource> NodeClass { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the NodeClass, which node pools reference with `nodeClassName`.
+        /// </summary>
+        [Output("nodeClassName")]
+        public Output<string> NodeClassName { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a AutoModeNodeClass resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public AutoModeNodeClass(string name, AutoModeNodeClassArgs args, ComponentResourceOptions? options = null)
+            : base("eks:index:AutoModeNodeClass", name, args ?? new AutoModeNodeClassArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class AutoModeNodeClassArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+        /// </summary>
+        [Input("cluster", required: true)]
+        public Input<Pulumi.Eks.Cluster> Cluster { get; set; } = null!;
+
+        /// <summary>
+        /// The ephemeral storage of the nodes.
+        /// </summary>
+        [Input("ephemeralStorage")]
+        public Input<Inputs.AutoModeEphemeralStorageArgs>? EphemeralStorage { get; set; }
+
+        /// <summary>
+        /// The default network policy of the pods, `DefaultAllow` or `DefaultDeny`. Defaults to `DefaultAllow`.
+        /// </summary>
+        [Input("networkPolicy")]
+        public Input<string>? NetworkPolicy { get; set; }
+
+        /// <summary>
+        /// Whether to log the network policy decisions, `Enabled` or `Disabled`. Defaults to `Disabled`.
+        /// </summary>
+        [Input("networkPolicyEventLogs")]
+        public Input<string>? NetworkPolicyEventLogs { get; set; }
+
+        /// <summary>
+        /// The ARN of the IAM role of the nodes. Defaults to the node role of EKS Auto Mode created by the cluster.
+        /// 
+        /// An access entry of type `EC2` with the `AmazonEKSAutoNodePolicy` access policy is created for custom node roles, which allows their nodes to join the cluster.
+        /// </summary>
+        [Input("nodeRoleArn")]
+        public Input<string>? NodeRoleArn { get; set; }
+
+        [Input("securityGroupSelectorTerms")]
+        private InputList<Inputs.AutoModeSelectorTermArgs>? _securityGroupSelectorTerms;
+
+        /// <summary>
+        /// The security groups of the nodes. Defaults to the cluster security group.
+        /// </summary>
+        public InputList<Inputs.AutoModeSelectorTermArgs> SecurityGroupSelectorTerms
+        {
+            get => _securityGroupSelectorTerms ?? (_securityGroupSelectorTerms = new InputList<Inputs.AutoModeSelectorTermArgs>());
+            set => _securityGroupSelectorTerms = value;
+        }
+
+        /// <summary>
+        /// The source NAT policy of the pod traffic leaving the VPC, `Random` or `Disabled`. Defaults to `Random`.
+        /// </summary>
+        [Input("snatPolicy")]
+        public Input<string>? SnatPolicy { get; set; }
+
+        [Input("subnetSelectorTerms")]
+        private InputList<Inputs.AutoModeSelectorTermArgs>? _subnetSelectorTerms;
+
+        /// <summary>
+        /// The subnets to launch nodes into. Defaults to the subnets of the cluster.
+        /// </summary>
+        public InputList<Inputs.AutoModeSelectorTermArgs> SubnetSelectorTerms
+        {
+            get => _subnetSelectorTerms ?? (_subnetSelectorTerms = new InputList<Inputs.AutoModeSelectorTermArgs>());
+            set => _subnetSelectorTerms = value;
+        }
+
+        [Input("tags")]
+        private InputMap<string>? _tags;
+
+        /// <summary>
+        /// The tags to apply to the EC2 resources created for the nodes.
+        /// </summary>
+        public InputMap<string> Tags
+        {
+            get => _tags ?? (_tags = new InputMap<string>());
+            set => _tags = value;
+        }
+
+        public AutoModeNodeClassArgs()
+        {
+        }
+        public static new AutoModeNodeClassArgs Empty => new AutoModeNodeClassArgs();
+    }
+}
@@ -0,0 +1,160 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks
+{
+    /// <summary>
+    /// AutoModeNodePool creates an EKS Auto Mode NodePool, which defines which nodes EKS Auto Mode launches for pending pods and when it disrupts them.
+    /// For more information see: https://docs.aws.amazon.com/eks/latest/userguide/create-node-pool.html
+    /// </summary>
+    [EksResourceType("eks:index:AutoModeNodePool")]
+    public partial class AutoModeNodePool : global::Pulumi.ComponentResource
+    {
+        /// <summary>
+        /// The NodePool custom resource.
+        /// </summary>
+        [Output("nodePool")]
+        public Output<Pulumi.Kubernetes.ApiExtensions.CustomResource> NodePool { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the NodePool.
+        /// </summary>
+        [Output("nodePoolName")]
+        public Output<string> NodePoolName { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a AutoModeNodePool resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public AutoModeNodePool(string name, AutoModeNodePoolArgs args, ComponentResourceOptions? options = null)
+            : base("eks:index:AutoModeNodePool", name, args ?? new AutoModeNodePoolArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class AutoModeNodePoolArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+        /// </summary>
+        [Input("cluster", required: true)]
+        public Input<Pulumi.Eks.Cluster> Cluster { get; set; } = null!;
+
+        /// <summary>
+        /// The disruption settings of the node pool.
+        /// </summary>
+        [Input("disruption")]
+        public Input<Inputs.AutoModeDisruptionArgs>? Disruption { get; set; }
+
+        /// <summary>
+        /// How long a node lives before it is replaced, e.g. `336h`, or `Never`. Defaults to `336h`.
+        /// </summary>
+        [Input("expireAfter")]
+        public Input<string>? ExpireAfter { get; set; }
+
+        [Input("labels")]
+        private InputMap<string>? _labels;
+
+        /// <summary>
+        /// The labels of the nodes.
+        /// </summary>
+        public InputMap<string> Labels
+        {
+            get => _labels ?? (_labels = new InputMap<string>());
+            set => _labels = value;
+        }
+
+        [Input("limits")]
+        private InputMap<string>? _limits;
+
+        /// <summary>
+        /// The maximum resources of all nodes of the node pool, e.g. `{ cpu: "1000", memory: "1000Gi" }`.
+        /// </summary>
+        public InputMap<string> Limits
+        {
+            get => _limits ?? (_limits = new InputMap<string>());
+            set => _limits = value;
+        }
+
+        /// <summary>
+        /// The name of the NodeClass of the nodes, e.g. `nodeClass.nodeClassName`. Defaults to `default`, the NodeClass of the built-in node pools.
+        /// </summary>
+        [Input("nodeClassName")]
+        public Input<string>? NodeClassName { get; set; }
+
+        [Input("requirements")]
+        private InputList<Inputs.AutoModeNodeRequirementArgs>? _requirements;
+
+        /// <summary>
+        /// The requirements the instances of the node pool have to fulfill.
+        /// </summary>
+        public InputList<Inputs.AutoModeNodeRequirementArgs> Requirements
+        {
+            get => _requirements ?? (_requirements = new InputList<Inputs.AutoModeNodeRequirementArgs>());
+            set => _requirements = value;
+        }
+
+        [Input("startupTaints")]
+        private InputMap<Inputs.TaintArgs>? _startupTaints;
+
+        /// <summary>
+        /// The taints of the nodes that are removed by a daemon once the node is ready, keyed by the taint key.
+        /// </summary>
+        public InputMap<Inputs.TaintArgs> StartupTaints
+        {
+            get => _startupTaints ?? (_startupTaints = new InputMap<Inputs.TaintArgs>());
+            set => _startupTaints = value;
+        }
+
+        [Input("taints")]
+        private InputMap<Inputs.TaintArgs>? _taints;
+
+        /// <summary>
+        /// The taints of the nodes, keyed by the taint key.
+        /// </summary>
+        public InputMap<Inputs.TaintArgs> Taints
+        {
+            get => _taints ?? (_taints = new InputMap<Inputs.TaintArgs>());
+            set => _taints = value;
+        }
+
+        /// <summary>
+        /// How long a node may take to drain before it is terminated forcefully, e.g. `24h`.
+        /// </summary>
+        [Input("terminationGracePeriod")]
+        public Input<string>? TerminationGracePeriod { get; set; }
+
+        /// <summary>
+        /// The priority of the node pool. Node pools with a higher weight are preferred.
+        /// </summary>
+        [Input("weight")]
+        public Input<int>? Weight { get; set; }
+
+        public AutoModeNodePoolArgs()
+        {
+        }
+        public static new AutoModeNodePoolArgs Empty => new AutoModeNodePoolArgs();
+    }
+}
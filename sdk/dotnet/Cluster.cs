@@ -200,6 +200,18 @@ namespace Pulumi.Eks
         /// </summary>
         public global::Pulumi.Output<string> GetKubeconfig(ClusterGetKubeconfigArgs? args = null)
             => global::Pulumi.Deployment.Instance.Call<ClusterGetKubeconfigResult>("eks:index:Cluster/getKubeconfig", args ?? new ClusterGetKubeconfigArgs(), this).Apply(v => v.Result);
+
+        /// <summary>
+        /// Generate a kubeconfig that authenticates with a static bearer token instead of an exec plugin. This is useful for consumers that cannot run the AWS CLI, e.g. Argo CD cluster secrets or services using a Kubernetes client library.
+        /// 
+        /// The token is a pre-signed STS `GetCallerIdentity` request retrieved with the configured `tokenTool` when the program runs, so the tool needs to be installed where Pulumi runs. Tokens are short-lived (15 minutes) and a new token is generated on every update, so consumers need to refresh the kubeconfig before `expirationTimestamp`.
+        /// 
+        /// See for more details:
+        /// - https://docs.aws.amazon.com/eks/latest/userguide/cluster-auth.html
+        /// - https://github.com/kubernetes-sigs/aws-iam-authenticator#api-authorization-from-outside-a-cluster
+        /// </summary>
+        public global::Pulumi.Output<ClusterGetStaticKubeconfigResult> GetStaticKubeconfig(ClusterGetStaticKubeconfigArgs? args = null)
+            => global::Pulumi.Deployment.Instance.Call<ClusterGetStaticKubeconfigResult>("eks:index:Cluster/getStaticKubeconfig", args ?? new ClusterGetStaticKubeconfigArgs(), this);
     }
 
     public sealed class ClusterArgs : global::Pulumi.ResourceArgs
@@ -242,6 +254,25 @@ namespace Pulumi.Eks
         [Input("bootstrapSelfManagedAddons")]
         public Input<bool>? BootstrapSelfManagedAddons { get; set; }
 
+        /// <summary>
+        /// The ARN of the KMS key to encrypt the control plane logs in the `/aws/eks/&lt;name&gt;/cluster` log group with. The key policy must allow the CloudWatch Logs service principal of the region to use the key.
+        /// 
+        /// When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set.
+        /// 
+        /// See for more details:
+        /// - https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/encrypt-log-data-kms.html
+        /// </summary>
+        [Input("clusterLogKmsKeyArn")]
+        public Input<string>? ClusterLogKmsKeyArn { get; set; }
+
+        /// <summary>
+        /// The number of days to retain the control plane logs in the `/aws/eks/&lt;name&gt;/cluster` log group. Possible values are: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653, and 0. If set to 0, the logs never expire.
+        /// 
+        /// When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set. Set `name` to the name of the existing cluster when enabling this for an existing cluster, and import the log group if EKS already created it.
+        /// </summary>
+        [Input("clusterLogRetentionDays")]
+        public Input<int>? ClusterLogRetentionDays { get; set; }
+
         /// <summary>
         /// The security group to use for the cluster API endpoint. If not provided, a new security group will be created with full internet egress and ingress from node groups.
         /// 
@@ -274,6 +305,14 @@ namespace Pulumi.Eks
             set => _clusterTags = value;
         }
 
+        /// <summary>
+        /// The scaling configuration of the control plane. Setting a provisioned `tier` reserves control plane capacity for the cluster instead of scaling it on demand.
+        /// 
+        /// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/eks-provisioned-control-plane.html
+        /// </summary>
+        [Input("controlPlaneScalingConfig")]
+        public Input<Pulumi.Aws.Eks.Inputs.ClusterControlPlaneScalingConfigArgs>? ControlPlaneScalingConfig { get; set; }
+
         /// <summary>
         /// Options for managing the `coredns` addon.
         /// </summary>
@@ -343,6 +382,16 @@ namespace Pulumi.Eks
             set => _enabledClusterLogTypes = value;
         }
 
+        /// <summary>
+        /// Creates a KMS key and alias for the encryption configuration of the cluster. The key policy grants the cluster role the permissions it needs to use the key for envelope encryption of Kubernetes secrets.
+        /// 
+        /// Mutually exclusive with `encryptionConfigKeyArn`.
+        /// See for more details:
+        /// - https://docs.aws.amazon.com/eks/latest/userguide/envelope-encryption.html
+        /// </summary>
+        [Input("encryptionConfig")]
+        public Inputs.EncryptionConfigOptionsArgs? EncryptionConfig { get; set; }
+
         /// <summary>
         /// KMS Key ARN to use with the encryption configuration for the cluster.
         /// 
@@ -761,6 +810,14 @@ namespace Pulumi.Eks
         [Input("vpcId")]
         public Input<string>? VpcId { get; set; }
 
+        /// <summary>
+        /// The zonal shift configuration of the cluster. Enabling zonal shift lets Amazon Application Recovery Controller (ARC) move traffic away from an impaired Availability Zone.
+        /// 
+        /// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/zone-shift.html
+        /// </summary>
+        [Input("zonalShiftConfig")]
+        public Input<Pulumi.Aws.Eks.Inputs.ClusterZonalShiftConfigArgs>? ZonalShiftConfig { get; set; }
+
         public ClusterArgs()
         {
         }
@@ -772,6 +829,26 @@ namespace Pulumi.Eks
     /// </summary>
     public sealed class ClusterGetKubeconfigArgs : global::Pulumi.CallArgs
     {
+        [Input("env")]
+        private InputMap<string>? _env;
+
+        /// <summary>
+        /// Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+        /// </summary>
+        public InputMap<string> Env
+        {
+            get => _env ?? (_env = new InputMap<string>());
+            set => _env = value;
+        }
+
+        /// <summary>
+        /// External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+        /// 
+        /// Only supported by the `aws-iam-authenticator` token tool.
+        /// </summary>
+        [Input("externalId")]
+        public Input<string>? ExternalId { get; set; }
+
         /// <summary>
         /// AWS credential profile name to always use instead of the default AWS credential provider chain.
         /// 
@@ -780,6 +857,12 @@ namespace Pulumi.Eks
         [Input("profileName")]
         public Input<string>? ProfileName { get; set; }
 
+        /// <summary>
+        /// AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+        /// </summary>
+        [Input("region")]
+        public Input<string>? Region { get; set; }
+
         /// <summary>
         /// Role ARN to assume instead of the default AWS credential provider chain.
         /// 
@@ -788,6 +871,20 @@ namespace Pulumi.Eks
         [Input("roleArn")]
         public Input<string>? RoleArn { get; set; }
 
+        /// <summary>
+        /// Session name to use when assuming `roleArn`.
+        /// 
+        /// Only supported by the `aws-iam-authenticator` token tool.
+        /// </summary>
+        [Input("roleSessionName")]
+        public Input<string>? RoleSessionName { get; set; }
+
+        /// <summary>
+        /// The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+        /// </summary>
+        [Input("tokenTool")]
+        public Input<Pulumi.Eks.KubeconfigTokenTool>? TokenTool { get; set; }
+
         public ClusterGetKubeconfigArgs()
         {
         }
@@ -811,4 +908,97 @@ namespace Pulumi.Eks
             Result = result;
         }
     }
+
+    /// <summary>
+    /// The set of arguments for the <see cref="Cluster.GetStaticKubeconfig"/> method.
+    /// </summary>
+    public sealed class ClusterGetStaticKubeconfigArgs : global::Pulumi.CallArgs
+    {
+        [Input("env")]
+        private InputMap<string>? _env;
+
+        /// <summary>
+        /// Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+        /// </summary>
+        public InputMap<string> Env
+        {
+            get => _env ?? (_env = new InputMap<string>());
+            set => _env = value;
+        }
+
+        /// <summary>
+        /// External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+        /// 
+        /// Only supported by the `aws-iam-authenticator` token tool.
+        /// </summary>
+        [Input("externalId")]
+        public Input<string>? ExternalId { get; set; }
+
+        /// <summary>
+        /// AWS credential profile name to always use instead of the default AWS credential provider chain.
+        /// 
+        /// The profile is passed to kubeconfig as an authentication environment setting.
+        /// </summary>
+        [Input("profileName")]
+        public Input<string>? ProfileName { get; set; }
+
+        /// <summary>
+        /// AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+        /// </summary>
+        [Input("region")]
+        public Input<string>? Region { get; set; }
+
+        /// <summary>
+        /// Role ARN to assume instead of the default AWS credential provider chain.
+        /// 
+        /// The role is passed to kubeconfig as an authentication exec argument.
+        /// </summary>
+        [Input("roleArn")]
+        public Input<string>? RoleArn { get; set; }
+
+        /// <summary>
+        /// Session name to use when assuming `roleArn`.
+        /// 
+        /// Only supported by the `aws-iam-authenticator` token tool.
+        /// </summary>
+        [Input("roleSessionName")]
+        public Input<string>? RoleSessionName { get; set; }
+
+        /// <summary>
+        /// The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+        /// </summary>
+        [Input("tokenTool")]
+        public Input<Pulumi.Eks.KubeconfigTokenTool>? TokenTool { get; set; }
+
+        public ClusterGetStaticKubeconfigArgs()
+        {
+        }
+        public static new ClusterGetStaticKubeconfigArgs Empty => new ClusterGetStaticKubeconfigArgs();
+    }
+
+    /// <summary>
+    /// The results of the <see cref="Cluster.GetStaticKubeconfig"/> method.
+    /// </summary>
+    [OutputType]
+    public sealed class ClusterGetStaticKubeconfigResult
+    {
+        /// <summary>
+        /// The time at which the token expires, in RFC 3339 format.
+        /// </summary>
+        public readonly string ExpirationTimestamp;
+        /// <summary>
+        /// The stringified kubeconfig containing the bearer token for the cluster.
+        /// </summary>
+        public readonly string Kubeconfig;
+
+        [OutputConstructor]
+        private ClusterGetStaticKubeconfigResult(
+            string expirationTimestamp,
+
+            string kubeconfig)
+        {
+            ExpirationTimestamp = expirationTimestamp;
+            Kubeconfig = kubeconfig;
+        }
+    }
 }
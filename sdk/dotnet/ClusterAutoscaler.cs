@@ -0,0 +1,114 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks
+{
+    /// <summary>
+    /// ClusterAutoscaler installs the Kubernetes Cluster Autoscaler into an EKS cluster. It creates the IAM role of the autoscaler and installs the cluster-autoscaler Helm chart, which discovers the Auto Scaling Groups tagged for the cluster. Node groups are tagged for discovery with their `autoscalerDiscovery` option.
+    /// For more information see: https://github.com/kubernetes/autoscaler/blob/master/cluster-autoscaler/cloudprovider/aws/README.md
+    /// </summary>
+    [EksResourceType("eks:index:ClusterAutoscaler")]
+    public partial class ClusterAutoscaler : global::Pulumi.ComponentResource
+    {
+        /// <summary>
+        /// The IAM policy with the permissions of the autoscaler.
+        /// </summary>
+        [Output("policy")]
+        public Output<Pulumi.Aws.Iam.Policy> Policy { get; private set; } = null!;
+
+        /// <summary>
+        /// The Helm release of the autoscaler.
+        /// </summary>
+        [Output("release")]
+        public Output<Pulumi.Kubernetes.Helm.V3.Release> Release { get; private set; } = null!;
+
+        /// <summary>
+        /// The IAM role of the autoscaler.
+        /// </summary>
+        [Output("role")]
+        public Output<Pulumi.Aws.Iam.Role> Role { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ClusterAutoscaler resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ClusterAutoscaler(string name, ClusterAutoscalerArgs args, ComponentResourceOptions? options = null)
+            : base("eks:index:ClusterAutoscaler", name, args ?? new ClusterAutoscalerArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class ClusterAutoscalerArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The version of the cluster-autoscaler Helm chart. Defaults to `9.46.6`.
+        /// </summary>
+        [Input("chartVersion")]
+        public Input<string>? ChartVersion { get; set; }
+
+        /// <summary>
+        /// The target EKS cluster.
+        /// </summary>
+        [Input("cluster", required: true)]
+        public Input<Pulumi.Eks.Cluster> Cluster { get; set; } = null!;
+
+        /// <summary>
+        /// The namespace to install the autoscaler into. Defaults to `kube-system`.
+        /// </summary>
+        [Input("namespace")]
+        public Input<string>? Namespace { get; set; }
+
+        /// <summary>
+        /// The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+        /// 
+        /// If set, the autoscaler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+        /// </summary>
+        [Input("oidcProviderArn")]
+        public Input<string>? OidcProviderArn { get; set; }
+
+        /// <summary>
+        /// The name of the Kubernetes service account of the autoscaler. Defaults to `cluster-autoscaler`.
+        /// </summary>
+        [Input("serviceAccountName")]
+        public Input<string>? ServiceAccountName { get; set; }
+
+        [Input("values")]
+        private InputMap<object>? _values;
+
+        /// <summary>
+        /// Custom values for the Helm chart. The values are merged with the values set by the component, which are `autoDiscovery`, `awsRegion` and `rbac.serviceAccount`.
+        /// </summary>
+        public InputMap<object> Values
+        {
+            get => _values ?? (_values = new InputMap<object>());
+            set => _values = value;
+        }
+
+        public ClusterAutoscalerArgs()
+        {
+        }
+        public static new ClusterAutoscalerArgs Empty => new ClusterAutoscalerArgs();
+    }
+}
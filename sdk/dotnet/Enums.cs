@@ -187,6 +187,43 @@ For more information and instructions how to upgrade, see https://docs.aws.amazo
         public override string ToString() => _value;
     }
 
+    /// <summary>
+    /// The exec plugin used by a kubeconfig to retrieve a token for the cluster.
+    /// </summary>
+    [EnumType]
+    public readonly struct KubeconfigTokenTool : IEquatable<KubeconfigTokenTool>
+    {
+        private readonly string _value;
+
+        private KubeconfigTokenTool(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Use `aws eks get-token` of the AWS CLI to retrieve the token.
+        /// </summary>
+        public static KubeconfigTokenTool Aws { get; } = new KubeconfigTokenTool("aws");
+        /// <summary>
+        /// Use `aws-iam-authenticator token` to retrieve the token.
+        /// </summary>
+        public static KubeconfigTokenTool AwsIamAuthenticator { get; } = new KubeconfigTokenTool("aws-iam-authenticator");
+
+        public static bool operator ==(KubeconfigTokenTool left, KubeconfigTokenTool right) => left.Equals(right);
+        public static bool operator !=(KubeconfigTokenTool left, KubeconfigTokenTool right) => !left.Equals(right);
+
+        public static explicit operator string(KubeconfigTokenTool value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is KubeconfigTokenTool other && Equals(other);
+        public bool Equals(KubeconfigTokenTool other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
     /// <summary>
     /// The type of EKS optimized Operating System to use for node groups.
     /// 
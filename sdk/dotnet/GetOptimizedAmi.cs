@@ -0,0 +1,150 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks
+{
+    public static class GetOptimizedAmi
+    {
+        /// <summary>
+        /// Resolves the EKS optimized AMI for a Kubernetes version and AMI type. The AMI is looked up via the public SSM parameters published by AWS, the same way node groups resolve their AMI when no `amiId` is provided.
+        /// 
+        /// If no `releaseVersion` is specified, the release version of the currently recommended AMI is used. The resolved release version is returned so that it can be pinned, e.g. to roll out AMI updates in a controlled fashion by feeding the `amiId` into `NodeGroupV2.amiId`.
+        /// 
+        /// The SSM parameters are read using the AWS CLI, which needs to be installed and configured with credentials for the account of the stack. The region is always passed explicitly.
+        /// 
+        /// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
+        /// </summary>
+        public static Task<GetOptimizedAmiResult> InvokeAsync(GetOptimizedAmiArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetOptimizedAmiResult>("eks:index:getOptimizedAmi", args ?? new GetOptimizedAmiArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Resolves the EKS optimized AMI for a Kubernetes version and AMI type. The AMI is looked up via the public SSM parameters published by AWS, the same way node groups resolve their AMI when no `amiId` is provided.
+        /// 
+        /// If no `releaseVersion` is specified, the release version of the currently recommended AMI is used. The resolved release version is returned so that it can be pinned, e.g. to roll out AMI updates in a controlled fashion by feeding the `amiId` into `NodeGroupV2.amiId`.
+        /// 
+        /// The SSM parameters are read using the AWS CLI, which needs to be installed and configured with credentials for the account of the stack. The region is always passed explicitly.
+        /// 
+        /// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
+        /// </summary>
+        public static Output<GetOptimizedAmiResult> Invoke(GetOptimizedAmiInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetOptimizedAmiResult>("eks:index:getOptimizedAmi", args ?? new GetOptimizedAmiInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Resolves the EKS optimized AMI for a Kubernetes version and AMI type. The AMI is looked up via the public SSM parameters published by AWS, the same way node groups resolve their AMI when no `amiId` is provided.
+        /// 
+        /// If no `releaseVersion` is specified, the release version of the currently recommended AMI is used. The resolved release version is returned so that it can be pinned, e.g. to roll out AMI updates in a controlled fashion by feeding the `amiId` into `NodeGroupV2.amiId`.
+        /// 
+        /// The SSM parameters are read using the AWS CLI, which needs to be installed and configured with credentials for the account of the stack. The region is always passed explicitly.
+        /// 
+        /// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
+        /// </summary>
+        public static Output<GetOptimizedAmiResult> Invoke(GetOptimizedAmiInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetOptimizedAmiResult>("eks:index:getOptimizedAmi", args ?? new GetOptimizedAmiInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetOptimizedAmiArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The AMI type to resolve the AMI for.
+        /// </summary>
+        [Input("amiType", required: true)]
+        public Pulumi.Eks.AmiType AmiType { get; set; }
+
+        /// <summary>
+        /// The Kubernetes version of the AMI, e.g. `1.31`.
+        /// </summary>
+        [Input("kubernetesVersion", required: true)]
+        public string KubernetesVersion { get; set; } = null!;
+
+        /// <summary>
+        /// The AWS region to resolve the AMI in. This should be the region of the stack, e.g. the `aws:region` config value.
+        /// </summary>
+        [Input("region", required: true)]
+        public string Region { get; set; } = null!;
+
+        /// <summary>
+        /// The release version of the AMI to resolve. For Amazon Linux AMIs this is the version reported by EKS, e.g. `1.31.0-20240917`. For Bottlerocket AMIs this is the Bottlerocket version, e.g. `1.22.0`.
+        /// 
+        /// Defaults to the release version of the currently recommended AMI.
+        /// </summary>
+        [Input("releaseVersion")]
+        public string? ReleaseVersion { get; set; }
+
+        public GetOptimizedAmiArgs()
+        {
+        }
+        public static new GetOptimizedAmiArgs Empty => new GetOptimizedAmiArgs();
+    }
+
+    public sealed class GetOptimizedAmiInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The AMI type to resolve the AMI for.
+        /// </summary>
+        [Input("amiType", required: true)]
+        public Input<Pulumi.Eks.AmiType> AmiType { get; set; } = null!;
+
+        /// <summary>
+        /// The Kubernetes version of the AMI, e.g. `1.31`.
+        /// </summary>
+        [Input("kubernetesVersion", required: true)]
+        public Input<string> KubernetesVersion { get; set; } = null!;
+
+        /// <summary>
+        /// The AWS region to resolve the AMI in. This should be the region of the stack, e.g. the `aws:region` config value.
+        /// </summary>
+        [Input("region", required: true)]
+        public Input<string> Region { get; set; } = null!;
+
+        /// <summary>
+        /// The release version of the AMI to resolve. For Amazon Linux AMIs this is the version reported by EKS, e.g. `1.31.0-20240917`. For Bottlerocket AMIs this is the Bottlerocket version, e.g. `1.22.0`.
+        /// 
+        /// Defaults to the release version of the currently recommended AMI.
+        /// </summary>
+        [Input("releaseVersion")]
+        public Input<string>? ReleaseVersion { get; set; }
+
+        public GetOptimizedAmiInvokeArgs()
+        {
+        }
+        public static new GetOptimizedAmiInvokeArgs Empty => new GetOptimizedAmiInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetOptimizedAmiResult
+    {
+        /// <summary>
+        /// The ID of the AMI.
+        /// </summary>
+        public readonly string AmiId;
+        /// <summary>
+        /// The release version of the AMI.
+        /// </summary>
+        public readonly string ReleaseVersion;
+        /// <summary>
+        /// The name of the SSM parameter the AMI ID was read from. The parameter is specific to the release version of the AMI and does not change when new AMIs are released.
+        /// </summary>
+        public readonly string SsmParameterName;
+
+        [OutputConstructor]
+        private GetOptimizedAmiResult(
+            string amiId,
+
+            string releaseVersion,
+
+            string ssmParameterName)
+        {
+            AmiId = amiId;
+            ReleaseVersion = releaseVersion;
+            SsmParameterName = ssmParameterName;
+        }
+    }
+}
@@ -0,0 +1,47 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// AutoModeDisruption configures how nodes of a node pool are consolidated and replaced.
+    /// </summary>
+    public sealed class AutoModeDisruptionArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("budgets")]
+        private InputList<Inputs.AutoModeDisruptionBudgetArgs>? _budgets;
+
+        /// <summary>
+        /// The disruption budgets of the node pool.
+        /// </summary>
+        public InputList<Inputs.AutoModeDisruptionBudgetArgs> Budgets
+        {
+            get => _budgets ?? (_budgets = new InputList<Inputs.AutoModeDisruptionBudgetArgs>());
+            set => _budgets = value;
+        }
+
+        /// <summary>
+        /// How long to wait after a pod was added to or removed from a node before consolidating it, e.g. `30s`, or `Never`. Defaults to `0s`.
+        /// </summary>
+        [Input("consolidateAfter")]
+        public Input<string>? ConsolidateAfter { get; set; }
+
+        /// <summary>
+        /// Which nodes are consolidated, `WhenEmptyOrUnderutilized` or `WhenEmpty`. Defaults to `WhenEmptyOrUnderutilized`.
+        /// </summary>
+        [Input("consolidationPolicy")]
+        public Input<string>? ConsolidationPolicy { get; set; }
+
+        public AutoModeDisruptionArgs()
+        {
+        }
+        public static new AutoModeDisruptionArgs Empty => new AutoModeDisruptionArgs();
+    }
+}
@@ -0,0 +1,53 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// AutoModeDisruptionBudget limits the number of nodes that may be disrupted at the same time.
+    /// </summary>
+    public sealed class AutoModeDisruptionBudgetArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// How long the budget is active after each schedule, e.g. `8h`.
+        /// </summary>
+        [Input("duration")]
+        public Input<string>? Duration { get; set; }
+
+        /// <summary>
+        /// The number or percentage of nodes that may be disrupted, e.g. `10%`. `0` blocks disruptions.
+        /// </summary>
+        [Input("nodes", required: true)]
+        public Input<string> Nodes { get; set; } = null!;
+
+        [Input("reasons")]
+        private InputList<string>? _reasons;
+
+        /// <summary>
+        /// The disruption reasons the budget applies to, `Underutilized`, `Empty` or `Drifted`. Defaults to all reasons.
+        /// </summary>
+        public InputList<string> Reasons
+        {
+            get => _reasons ?? (_reasons = new InputList<string>());
+            set => _reasons = value;
+        }
+
+        /// <summary>
+        /// The cron schedule at which the budget becomes active, e.g. `0 9 * * mon-fri`. Requires `duration`.
+        /// </summary>
+        [Input("schedule")]
+        public Input<string>? Schedule { get; set; }
+
+        public AutoModeDisruptionBudgetArgs()
+        {
+        }
+        public static new AutoModeDisruptionBudgetArgs Empty => new AutoModeDisruptionBudgetArgs();
+    }
+}
@@ -0,0 +1,47 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// AutoModeEphemeralStorage configures the volume that holds the ephemeral storage of the nodes.
+    /// </summary>
+    public sealed class AutoModeEphemeralStorageArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The IOPS of the volume.
+        /// </summary>
+        [Input("iops")]
+        public Input<int>? Iops { get; set; }
+
+        /// <summary>
+        /// The ARN of the KMS key that encrypts the volume.
+        /// </summary>
+        [Input("kmsKeyId")]
+        public Input<string>? KmsKeyId { get; set; }
+
+        /// <summary>
+        /// The size of the volume, e.g. `80Gi`.
+        /// </summary>
+        [Input("size")]
+        public Input<string>? Size { get; set; }
+
+        /// <summary>
+        /// The throughput of the volume in MiB/s.
+        /// </summary>
+        [Input("throughput")]
+        public Input<int>? Throughput { get; set; }
+
+        public AutoModeEphemeralStorageArgs()
+        {
+        }
+        public static new AutoModeEphemeralStorageArgs Empty => new AutoModeEphemeralStorageArgs();
+    }
+}
@@ -0,0 +1,53 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// AutoModeNodeRequirement restricts the instances of a node pool by a well-known label, e.g. `eks.amazonaws.com/instance-category` or `karpenter.sh/capacity-type`.
+    /// </summary>
+    public sealed class AutoModeNodeRequirementArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The label key.
+        /// </summary>
+        [Input("key", required: true)]
+        public Input<string> Key { get; set; } = null!;
+
+        /// <summary>
+        /// The minimum number of distinct values the node pool has to be able to choose from.
+        /// </summary>
+        [Input("minValues")]
+        public Input<int>? MinValues { get; set; }
+
+        /// <summary>
+        /// The operator, one of `In`, `NotIn`, `Exists`, `DoesNotExist`, `Gt` and `Lt`.
+        /// </summary>
+        [Input("operator", required: true)]
+        public Input<string> Operator { get; set; } = null!;
+
+        [Input("values")]
+        private InputList<string>? _values;
+
+        /// <summary>
+        /// The label values.
+        /// </summary>
+        public InputList<string> Values
+        {
+            get => _values ?? (_values = new InputList<string>());
+            set => _values = value;
+        }
+
+        public AutoModeNodeRequirementArgs()
+        {
+        }
+        public static new AutoModeNodeRequirementArgs Empty => new AutoModeNodeRequirementArgs();
+    }
+}
@@ -0,0 +1,47 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// AutoModeSelectorTerm selects subnets or security groups by their ID, name or tags. The conditions of a term are ANDed, multiple terms are ORed.
+    /// </summary>
+    public sealed class AutoModeSelectorTermArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The ID of the subnet or security group.
+        /// </summary>
+        [Input("id")]
+        public Input<string>? Id { get; set; }
+
+        /// <summary>
+        /// The name of the security group. Not supported for subnets.
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        [Input("tags")]
+        private InputMap<string>? _tags;
+
+        /// <summary>
+        /// The tags the subnets or security groups must have. A value of `*` matches any value.
+        /// </summary>
+        public InputMap<string> Tags
+        {
+            get => _tags ?? (_tags = new InputMap<string>());
+            set => _tags = value;
+        }
+
+        public AutoModeSelectorTermArgs()
+        {
+        }
+        public static new AutoModeSelectorTermArgs Empty => new AutoModeSelectorTermArgs();
+    }
+}
@@ -0,0 +1,47 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// A Bottlerocket bootstrap container, which runs before the kubelet starts.
+    /// </summary>
+    public sealed class BottlerocketBootstrapContainerArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether the node fails to boot if the bootstrap container fails.
+        /// </summary>
+        [Input("essential")]
+        public Input<bool>? Essential { get; set; }
+
+        /// <summary>
+        /// When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+        /// </summary>
+        [Input("mode")]
+        public Input<string>? Mode { get; set; }
+
+        /// <summary>
+        /// The image of the bootstrap container.
+        /// </summary>
+        [Input("source", required: true)]
+        public Input<string> Source { get; set; } = null!;
+
+        /// <summary>
+        /// Base64 encoded user data passed to the bootstrap container.
+        /// </summary>
+        [Input("userData")]
+        public Input<string>? UserData { get; set; }
+
+        public BottlerocketBootstrapContainerArgs()
+        {
+        }
+        public static new BottlerocketBootstrapContainerArgs Empty => new BottlerocketBootstrapContainerArgs();
+    }
+}
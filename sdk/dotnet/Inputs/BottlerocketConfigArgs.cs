@@ -0,0 +1,61 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// Typed configuration of the most common Bottlerocket settings.
+    /// 
+    /// See for more details: https://bottlerocket.dev/en/os/1.20.x/api/settings/.
+    /// </summary>
+    public sealed class BottlerocketConfigArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("bootstrapContainers")]
+        private InputMap<Inputs.BottlerocketBootstrapContainerArgs>? _bootstrapContainers;
+
+        /// <summary>
+        /// Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+        /// </summary>
+        public InputMap<Inputs.BottlerocketBootstrapContainerArgs> BootstrapContainers
+        {
+            get => _bootstrapContainers ?? (_bootstrapContainers = new InputMap<Inputs.BottlerocketBootstrapContainerArgs>());
+            set => _bootstrapContainers = value;
+        }
+
+        /// <summary>
+        /// Settings of the container registries, i.e. `settings.container-registry`.
+        /// </summary>
+        [Input("containerRegistry")]
+        public Input<Inputs.BottlerocketContainerRegistryArgs>? ContainerRegistry { get; set; }
+
+        /// <summary>
+        /// Settings of the admin and control host containers, i.e. `settings.host-containers`.
+        /// </summary>
+        [Input("hostContainers")]
+        public Input<Inputs.BottlerocketHostContainersArgs>? HostContainers { get; set; }
+
+        /// <summary>
+        /// Settings of the kernel, i.e. `settings.kernel`.
+        /// </summary>
+        [Input("kernel")]
+        public Input<Inputs.BottlerocketKernelSettingsArgs>? Kernel { get; set; }
+
+        /// <summary>
+        /// Settings of the kubelet, i.e. `settings.kubernetes`.
+        /// </summary>
+        [Input("kubernetes")]
+        public Input<Inputs.BottlerocketKubernetesSettingsArgs>? Kubernetes { get; set; }
+
+        public BottlerocketConfigArgs()
+        {
+        }
+        public static new BottlerocketConfigArgs Empty => new BottlerocketConfigArgs();
+    }
+}
@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// Settings of the container registries of Bottlerocket nodes.
+    /// </summary>
+    public sealed class BottlerocketContainerRegistryArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("mirrors")]
+        private InputList<Inputs.BottlerocketRegistryMirrorArgs>? _mirrors;
+
+        /// <summary>
+        /// Mirrors to pull images from instead of the original registries.
+        /// </summary>
+        public InputList<Inputs.BottlerocketRegistryMirrorArgs> Mirrors
+        {
+            get => _mirrors ?? (_mirrors = new InputList<Inputs.BottlerocketRegistryMirrorArgs>());
+            set => _mirrors = value;
+        }
+
+        public BottlerocketContainerRegistryArgs()
+        {
+        }
+        public static new BottlerocketContainerRegistryArgs Empty => new BottlerocketContainerRegistryArgs();
+    }
+}
@@ -0,0 +1,47 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// Settings of a Bottlerocket host container.
+    /// </summary>
+    public sealed class BottlerocketHostContainerArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether the host container runs.
+        /// </summary>
+        [Input("enabled")]
+        public Input<bool>? Enabled { get; set; }
+
+        /// <summary>
+        /// The image of the host container.
+        /// </summary>
+        [Input("source")]
+        public Input<string>? Source { get; set; }
+
+        /// <summary>
+        /// Whether the host container has elevated privileges.
+        /// </summary>
+        [Input("superpowered")]
+        public Input<bool>? Superpowered { get; set; }
+
+        /// <summary>
+        /// Base64 encoded user data passed to the host container.
+        /// </summary>
+        [Input("userData")]
+        public Input<string>? UserData { get; set; }
+
+        public BottlerocketHostContainerArgs()
+        {
+        }
+        public static new BottlerocketHostContainerArgs Empty => new BottlerocketHostContainerArgs();
+    }
+}
@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// Settings of the Bottlerocket host containers.
+    /// </summary>
+    public sealed class BottlerocketHostContainersArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The admin container, used for troubleshooting the node.
+        /// </summary>
+        [Input("admin")]
+        public Input<Inputs.BottlerocketHostContainerArgs>? Admin { get; set; }
+
+        /// <summary>
+        /// The control container, used to access the Bottlerocket API through AWS SSM.
+        /// </summary>
+        [Input("control")]
+        public Input<Inputs.BottlerocketHostContainerArgs>? Control { get; set; }
+
+        public BottlerocketHostContainersArgs()
+        {
+        }
+        public static new BottlerocketHostContainersArgs Empty => new BottlerocketHostContainersArgs();
+    }
+}
@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// Settings of the Bottlerocket kernel.
+    /// </summary>
+    public sealed class BottlerocketKernelSettingsArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+        /// </summary>
+        [Input("lockdown")]
+        public Input<string>? Lockdown { get; set; }
+
+        [Input("sysctl")]
+        private InputMap<string>? _sysctl;
+
+        /// <summary>
+        /// Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: "1"`.
+        /// </summary>
+        public InputMap<string> Sysctl
+        {
+            get => _sysctl ?? (_sysctl = new InputMap<string>());
+            set => _sysctl = value;
+        }
+
+        public BottlerocketKernelSettingsArgs()
+        {
+        }
+        public static new BottlerocketKernelSettingsArgs Empty => new BottlerocketKernelSettingsArgs();
+    }
+}
@@ -0,0 +1,101 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// Settings of the kubelet on Bottlerocket nodes.
+    /// </summary>
+    public sealed class BottlerocketKubernetesSettingsArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("evictionHard")]
+        private InputMap<string>? _evictionHard;
+
+        /// <summary>
+        /// Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+        /// </summary>
+        public InputMap<string> EvictionHard
+        {
+            get => _evictionHard ?? (_evictionHard = new InputMap<string>());
+            set => _evictionHard = value;
+        }
+
+        [Input("evictionSoft")]
+        private InputMap<string>? _evictionSoft;
+
+        /// <summary>
+        /// Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+        /// </summary>
+        public InputMap<string> EvictionSoft
+        {
+            get => _evictionSoft ?? (_evictionSoft = new InputMap<string>());
+            set => _evictionSoft = value;
+        }
+
+        [Input("evictionSoftGracePeriod")]
+        private InputMap<string>? _evictionSoftGracePeriod;
+
+        /// <summary>
+        /// Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+        /// </summary>
+        public InputMap<string> EvictionSoftGracePeriod
+        {
+            get => _evictionSoftGracePeriod ?? (_evictionSoftGracePeriod = new InputMap<string>());
+            set => _evictionSoftGracePeriod = value;
+        }
+
+        /// <summary>
+        /// The disk usage in percent after which image garbage collection always runs.
+        /// </summary>
+        [Input("imageGcHighThresholdPercent")]
+        public Input<int>? ImageGcHighThresholdPercent { get; set; }
+
+        /// <summary>
+        /// The disk usage in percent before which image garbage collection never runs.
+        /// </summary>
+        [Input("imageGcLowThresholdPercent")]
+        public Input<int>? ImageGcLowThresholdPercent { get; set; }
+
+        /// <summary>
+        /// The maximum number of pods the kubelet runs on the node.
+        /// </summary>
+        [Input("maxPods")]
+        public Input<int>? MaxPods { get; set; }
+
+        [Input("nodeLabels")]
+        private InputMap<string>? _nodeLabels;
+
+        /// <summary>
+        /// Labels the node registers with.
+        /// </summary>
+        public InputMap<string> NodeLabels
+        {
+            get => _nodeLabels ?? (_nodeLabels = new InputMap<string>());
+            set => _nodeLabels = value;
+        }
+
+        [Input("nodeTaints")]
+        private InputMap<string>? _nodeTaints;
+
+        /// <summary>
+        /// Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+        /// </summary>
+        public InputMap<string> NodeTaints
+        {
+            get => _nodeTaints ?? (_nodeTaints = new InputMap<string>());
+            set => _nodeTaints = value;
+        }
+
+        public BottlerocketKubernetesSettingsArgs()
+        {
+        }
+        public static new BottlerocketKubernetesSettingsArgs Empty => new BottlerocketKubernetesSettingsArgs();
+    }
+}
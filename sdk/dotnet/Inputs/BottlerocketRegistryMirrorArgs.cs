@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// A mirror of a container registry.
+    /// </summary>
+    public sealed class BottlerocketRegistryMirrorArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("endpoints", required: true)]
+        private InputList<string>? _endpoints;
+
+        /// <summary>
+        /// The endpoints of the mirror, in order of preference.
+        /// </summary>
+        public InputList<string> Endpoints
+        {
+            get => _endpoints ?? (_endpoints = new InputList<string>());
+            set => _endpoints = value;
+        }
+
+        /// <summary>
+        /// The registry to mirror, e.g. `docker.io`.
+        /// </summary>
+        [Input("registry", required: true)]
+        public Input<string> Registry { get; set; } = null!;
+
+        public BottlerocketRegistryMirrorArgs()
+        {
+        }
+        public static new BottlerocketRegistryMirrorArgs Empty => new BottlerocketRegistryMirrorArgs();
+    }
+}
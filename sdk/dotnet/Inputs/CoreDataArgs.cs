@@ -39,9 +39,21 @@ namespace Pulumi.Eks.Inputs
         [Input("clusterIamRole", required: true)]
         public Input<Pulumi.Aws.Iam.Role> ClusterIamRole { get; set; } = null!;
 
+        /// <summary>
+        /// The log group of the control plane logs, if it is managed by the cluster.
+        /// </summary>
+        [Input("clusterLogGroup")]
+        public Input<Pulumi.Aws.CloudWatch.LogGroup>? ClusterLogGroup { get; set; }
+
         [Input("clusterSecurityGroup")]
         public Input<Pulumi.Aws.Ec2.SecurityGroup>? ClusterSecurityGroup { get; set; }
 
+        /// <summary>
+        /// The scaling configuration of the control plane, including its provisioned tier.
+        /// </summary>
+        [Input("controlPlaneScalingConfig")]
+        public Input<Pulumi.Aws.Eks.Inputs.ClusterControlPlaneScalingConfigArgs>? ControlPlaneScalingConfig { get; set; }
+
         [Input("eksNodeAccess")]
         public Input<Pulumi.Kubernetes.Core.V1.ConfigMap>? EksNodeAccess { get; set; }
 
@@ -162,18 +174,36 @@ namespace Pulumi.Eks.Inputs
             set => _tags = value;
         }
 
+        /// <summary>
+        /// The upgrade policy of the cluster, which determines whether it gets extended support.
+        /// </summary>
+        [Input("upgradePolicy")]
+        public Input<Pulumi.Aws.Eks.Inputs.ClusterUpgradePolicyArgs>? UpgradePolicy { get; set; }
+
         /// <summary>
         /// The VPC CNI for the cluster.
         /// </summary>
         [Input("vpcCni")]
         public Input<Pulumi.Eks.VpcCniAddon>? VpcCni { get; set; }
 
+        /// <summary>
+        /// The VPC CNI settings that affect the maximum number of pods of the nodes.
+        /// </summary>
+        [Input("vpcCniNetworking")]
+        public Input<Inputs.VpcCniNetworkingArgs>? VpcCniNetworking { get; set; }
+
         /// <summary>
         /// ID of the cluster's VPC.
         /// </summary>
         [Input("vpcId", required: true)]
         public Input<string> VpcId { get; set; } = null!;
 
+        /// <summary>
+        /// The zonal shift configuration of the cluster.
+        /// </summary>
+        [Input("zonalShiftConfig")]
+        public Input<Pulumi.Aws.Eks.Inputs.ClusterZonalShiftConfigArgs>? ZonalShiftConfig { get; set; }
+
         public CoreDataArgs()
         {
         }
@@ -0,0 +1,59 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// Configures the KMS key the cluster creates for envelope encryption of Kubernetes secrets.
+    /// </summary>
+    public sealed class EncryptionConfigOptionsArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The name of the alias of the key. Must start with `alias/`. Defaults to `alias/eks/&lt;clusterName&gt;`.
+        /// </summary>
+        [Input("aliasName")]
+        public Input<string>? AliasName { get; set; }
+
+        /// <summary>
+        /// The waiting period in days before the key is deleted after the cluster is destroyed. Must be between 7 and 30. Defaults to 30 days.
+        /// </summary>
+        [Input("deletionWindowInDays")]
+        public Input<int>? DeletionWindowInDays { get; set; }
+
+        /// <summary>
+        /// Whether to enable automatic rotation of the key material. Defaults to `true`.
+        /// </summary>
+        [Input("enableKeyRotation")]
+        public Input<bool>? EnableKeyRotation { get; set; }
+
+        [Input("keyAdministratorArns")]
+        private InputList<string>? _keyAdministratorArns;
+
+        /// <summary>
+        /// The ARNs of IAM principals that are allowed to administer the key, e.g. to change its policy or schedule its deletion. The AWS account always retains full access to the key so that IAM policies can grant access to it as well.
+        /// </summary>
+        public InputList<string> KeyAdministratorArns
+        {
+            get => _keyAdministratorArns ?? (_keyAdministratorArns = new InputList<string>());
+            set => _keyAdministratorArns = value;
+        }
+
+        /// <summary>
+        /// The period in days between automatic rotations of the key material. Must be between 90 and 2560. Defaults to 365 days.
+        /// </summary>
+        [Input("rotationPeriodInDays")]
+        public Input<int>? RotationPeriodInDays { get; set; }
+
+        public EncryptionConfigOptionsArgs()
+        {
+        }
+        public static new EncryptionConfigOptionsArgs Empty => new EncryptionConfigOptionsArgs();
+    }
+}
@@ -0,0 +1,68 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// InterruptionHandling configures how a node group handles Spot interruptions and Auto Scaling Group termination events. The events are delivered to an SQS queue, which aws-node-termination-handler processes in queue mode to cordon and drain the affected nodes before their instances are terminated.
+    /// See for more details: https://github.com/aws/aws-node-termination-handler#queue-processor
+    /// </summary>
+    public sealed class InterruptionHandlingArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+        /// </summary>
+        [Input("chartVersion")]
+        public Input<string>? ChartVersion { get; set; }
+
+        /// <summary>
+        /// The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+        /// </summary>
+        [Input("heartbeatTimeout")]
+        public Input<int>? HeartbeatTimeout { get; set; }
+
+        /// <summary>
+        /// The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+        /// </summary>
+        [Input("namespace")]
+        public Input<string>? Namespace { get; set; }
+
+        /// <summary>
+        /// The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+        /// 
+        /// If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+        /// </summary>
+        [Input("oidcProviderArn")]
+        public Input<string>? OidcProviderArn { get; set; }
+
+        /// <summary>
+        /// The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `&lt;name&gt;-node-termination-handler`, where `&lt;name&gt;` is the name of the node group.
+        /// </summary>
+        [Input("serviceAccountName")]
+        public Input<string>? ServiceAccountName { get; set; }
+
+        [Input("values")]
+        private InputMap<object>? _values;
+
+        /// <summary>
+        /// Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+        /// </summary>
+        public InputMap<object> Values
+        {
+            get => _values ?? (_values = new InputMap<object>());
+            set => _values = value;
+        }
+
+        public InterruptionHandlingArgs()
+        {
+        }
+        public static new InterruptionHandlingArgs Empty => new InterruptionHandlingArgs();
+    }
+}
@@ -30,6 +30,26 @@ namespace Pulumi.Eks.Inputs
     /// </summary>
     public sealed class KubeconfigOptionsArgs : global::Pulumi.ResourceArgs
     {
+        [Input("env")]
+        private InputMap<string>? _env;
+
+        /// <summary>
+        /// Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+        /// </summary>
+        public InputMap<string> Env
+        {
+            get => _env ?? (_env = new InputMap<string>());
+            set => _env = value;
+        }
+
+        /// <summary>
+        /// External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+        /// 
+        /// Only supported by the `aws-iam-authenticator` token tool.
+        /// </summary>
+        [Input("externalId")]
+        public Input<string>? ExternalId { get; set; }
+
         /// <summary>
         /// AWS credential profile name to always use instead of the default AWS credential provider chain.
         /// 
@@ -38,6 +58,12 @@ namespace Pulumi.Eks.Inputs
         [Input("profileName")]
         public Input<string>? ProfileName { get; set; }
 
+        /// <summary>
+        /// AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+        /// </summary>
+        [Input("region")]
+        public Input<string>? Region { get; set; }
+
         /// <summary>
         /// Role ARN to assume instead of the default AWS credential provider chain.
         /// 
@@ -46,6 +72,20 @@ namespace Pulumi.Eks.Inputs
         [Input("roleArn")]
         public Input<string>? RoleArn { get; set; }
 
+        /// <summary>
+        /// Session name to use when assuming `roleArn`.
+        /// 
+        /// Only supported by the `aws-iam-authenticator` token tool.
+        /// </summary>
+        [Input("roleSessionName")]
+        public Input<string>? RoleSessionName { get; set; }
+
+        /// <summary>
+        /// The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+        /// </summary>
+        [Input("tokenTool")]
+        public Input<Pulumi.Eks.KubeconfigTokenTool>? TokenTool { get; set; }
+
         public KubeconfigOptionsArgs()
         {
         }
@@ -0,0 +1,43 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// Typed nodeadm `NodeConfig`. The cluster details are configured by the provider.
+    /// 
+    /// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/.
+    /// </summary>
+    public sealed class NodeConfigArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Configuration of containerd.
+        /// </summary>
+        [Input("containerd")]
+        public Input<Inputs.NodeConfigContainerdArgs>? Containerd { get; set; }
+
+        /// <summary>
+        /// Configuration of the EC2 instance.
+        /// </summary>
+        [Input("instance")]
+        public Input<Inputs.NodeConfigInstanceArgs>? Instance { get; set; }
+
+        /// <summary>
+        /// Configuration of the kubelet.
+        /// </summary>
+        [Input("kubelet")]
+        public Input<Inputs.NodeConfigKubeletArgs>? Kubelet { get; set; }
+
+        public NodeConfigArgs()
+        {
+        }
+        public static new NodeConfigArgs Empty => new NodeConfigArgs();
+    }
+}
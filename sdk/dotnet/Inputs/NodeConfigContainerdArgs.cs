@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// Configuration of containerd in a nodeadm `NodeConfig`.
+    /// </summary>
+    public sealed class NodeConfigContainerdArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("baseRuntimeSpec")]
+        private InputMap<object>? _baseRuntimeSpec;
+
+        /// <summary>
+        /// The OCI runtime specification containers are created with.
+        /// </summary>
+        public InputMap<object> BaseRuntimeSpec
+        {
+            get => _baseRuntimeSpec ?? (_baseRuntimeSpec = new InputMap<object>());
+            set => _baseRuntimeSpec = value;
+        }
+
+        /// <summary>
+        /// Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+        /// </summary>
+        [Input("config")]
+        public Input<string>? Config { get; set; }
+
+        public NodeConfigContainerdArgs()
+        {
+        }
+        public static new NodeConfigContainerdArgs Empty => new NodeConfigContainerdArgs();
+    }
+}
@@ -0,0 +1,29 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// Configuration of the EC2 instance in a nodeadm `NodeConfig`.
+    /// </summary>
+    public sealed class NodeConfigInstanceArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Configuration of the local instance store volumes.
+        /// </summary>
+        [Input("localStorage")]
+        public Input<Inputs.NodeConfigLocalStorageArgs>? LocalStorage { get; set; }
+
+        public NodeConfigInstanceArgs()
+        {
+        }
+        public static new NodeConfigInstanceArgs Empty => new NodeConfigInstanceArgs();
+    }
+}
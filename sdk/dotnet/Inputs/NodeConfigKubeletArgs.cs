@@ -0,0 +1,49 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// Configuration of the kubelet in a nodeadm `NodeConfig`.
+    /// </summary>
+    public sealed class NodeConfigKubeletArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("config")]
+        private InputMap<object>? _config;
+
+        /// <summary>
+        /// Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+        /// 
+        /// See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+        /// </summary>
+        public InputMap<object> Config
+        {
+            get => _config ?? (_config = new InputMap<object>());
+            set => _config = value;
+        }
+
+        [Input("flags")]
+        private InputList<string>? _flags;
+
+        /// <summary>
+        /// Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+        /// </summary>
+        public InputList<string> Flags
+        {
+            get => _flags ?? (_flags = new InputList<string>());
+            set => _flags = value;
+        }
+
+        public NodeConfigKubeletArgs()
+        {
+        }
+        public static new NodeConfigKubeletArgs Empty => new NodeConfigKubeletArgs();
+    }
+}
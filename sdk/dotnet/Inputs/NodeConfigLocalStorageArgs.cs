@@ -0,0 +1,47 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// Configuration of the local instance store volumes in a nodeadm `NodeConfig`.
+    /// </summary>
+    public sealed class NodeConfigLocalStorageArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("disabledMounts")]
+        private InputList<string>? _disabledMounts;
+
+        /// <summary>
+        /// Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+        /// </summary>
+        public InputList<string> DisabledMounts
+        {
+            get => _disabledMounts ?? (_disabledMounts = new InputList<string>());
+            set => _disabledMounts = value;
+        }
+
+        /// <summary>
+        /// The path the instance store volumes are mounted at.
+        /// </summary>
+        [Input("mountPath")]
+        public Input<string>? MountPath { get; set; }
+
+        /// <summary>
+        /// How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+        /// </summary>
+        [Input("strategy", required: true)]
+        public Input<string> Strategy { get; set; } = null!;
+
+        public NodeConfigLocalStorageArgs()
+        {
+        }
+        public static new NodeConfigLocalStorageArgs Empty => new NodeConfigLocalStorageArgs();
+    }
+}
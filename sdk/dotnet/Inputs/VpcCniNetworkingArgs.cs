@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Inputs
+{
+
+    /// <summary>
+    /// The VPC CNI settings that affect the maximum number of pods of the nodes.
+    /// </summary>
+    public sealed class VpcCniNetworkingArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether pods get their IP addresses from the subnets of ENIConfigs.
+        /// </summary>
+        [Input("customNetworking", required: true)]
+        public Input<bool> CustomNetworking { get; set; } = null!;
+
+        /// <summary>
+        /// Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+        /// </summary>
+        [Input("prefixDelegation", required: true)]
+        public Input<bool> PrefixDelegation { get; set; } = null!;
+
+        public VpcCniNetworkingArgs()
+        {
+        }
+        public static new VpcCniNetworkingArgs Empty => new VpcCniNetworkingArgs();
+    }
+}
@@ -0,0 +1,144 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks
+{
+    /// <summary>
+    /// LoadBalancerController installs the AWS Load Balancer Controller into an EKS cluster. It creates the IAM role of the controller, installs the aws-load-balancer-controller Helm chart and tags the given subnets, so the controller can discover them.
+    /// For more information see: https://docs.aws.amazon.com/eks/latest/userguide/aws-load-balancer-controller.html
+    /// </summary>
+    [EksResourceType("eks:index:LoadBalancerController")]
+    public partial class LoadBalancerController : global::Pulumi.ComponentResource
+    {
+        /// <summary>
+        /// The IAM policy with the permissions of the controller.
+        /// </summary>
+        [Output("policy")]
+        public Output<Pulumi.Aws.Iam.Policy> Policy { get; private set; } = null!;
+
+        /// <summary>
+        /// The Helm release of the controller.
+        /// </summary>
+        [Output("release")]
+        public Output<Pulumi.Kubernetes.Helm.V3.Release> Release { get; private set; } = null!;
+
+        /// <summary>
+        /// The IAM role of the controller.
+        /// </summary>
+        [Output("role")]
+        public Output<Pulumi.Aws.Iam.Role> Role { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a LoadBalancerController resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public LoadBalancerController(string name, LoadBalancerControllerArgs args, ComponentResourceOptions? options = null)
+            : base("eks:index:LoadBalancerController", name, args ?? new LoadBalancerControllerArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class LoadBalancerControllerArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The version of the aws-load-balancer-controller Helm chart. Defaults to `1.13.0`.
+        /// </summary>
+        [Input("chartVersion")]
+        public Input<string>? ChartVersion { get; set; }
+
+        /// <summary>
+        /// The target EKS cluster.
+        /// </summary>
+        [Input("cluster", required: true)]
+        public Input<Pulumi.Eks.Cluster> Cluster { get; set; } = null!;
+
+        /// <summary>
+        /// The namespace to install the controller into. Defaults to `kube-system`.
+        /// </summary>
+        [Input("namespace")]
+        public Input<string>? Namespace { get; set; }
+
+        /// <summary>
+        /// The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+        /// 
+        /// If set, the controller assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+        /// </summary>
+        [Input("oidcProviderArn")]
+        public Input<string>? OidcProviderArn { get; set; }
+
+        [Input("privateSubnetIds")]
+        private InputList<string>? _privateSubnetIds;
+
+        /// <summary>
+        /// The private subnets to tag with `kubernetes.io/role/internal-elb`, which makes the controller place internal load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+        /// </summary>
+        public InputList<string> PrivateSubnetIds
+        {
+            get => _privateSubnetIds ?? (_privateSubnetIds = new InputList<string>());
+            set => _privateSubnetIds = value;
+        }
+
+        [Input("publicSubnetIds")]
+        private InputList<string>? _publicSubnetIds;
+
+        /// <summary>
+        /// The public subnets to tag with `kubernetes.io/role/elb`, which makes the controller place internet-facing load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+        /// </summary>
+        public InputList<string> PublicSubnetIds
+        {
+            get => _publicSubnetIds ?? (_publicSubnetIds = new InputList<string>());
+            set => _publicSubnetIds = value;
+        }
+
+        /// <summary>
+        /// The name of the Helm release. Defaults to `aws-load-balancer-controller`.
+        /// </summary>
+        [Input("releaseName")]
+        public Input<string>? ReleaseName { get; set; }
+
+        /// <summary>
+        /// The name of the Kubernetes service account of the controller. Defaults to `aws-load-balancer-controller`.
+        /// </summary>
+        [Input("serviceAccountName")]
+        public Input<string>? ServiceAccountName { get; set; }
+
+        [Input("values")]
+        private InputMap<object>? _values;
+
+        /// <summary>
+        /// Custom values for the Helm chart. The values are merged with the values set by the component, which are `clusterName`, `vpcId`, `region` and `serviceAccount`.
+        /// </summary>
+        public InputMap<object> Values
+        {
+            get => _values ?? (_values = new InputMap<object>());
+            set => _values = value;
+        }
+
+        public LoadBalancerControllerArgs()
+        {
+        }
+        public static new LoadBalancerControllerArgs Empty => new LoadBalancerControllerArgs();
+    }
+}
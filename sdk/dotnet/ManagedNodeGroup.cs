@@ -323,6 +323,14 @@ namespace Pulumi.Eks
         [Input("amiType")]
         public Input<string>? AmiType { get; set; }
 
+        /// <summary>
+        /// Tags the Auto Scaling Group of the node group with the `labels` and `taints` as node template tags of the Cluster Autoscaler, so it can scale the node group up from zero. EKS tags the Auto Scaling Groups of managed node groups for the auto-discovery of the Cluster Autoscaler itself.
+        /// 
+        /// This implies `ignoreScalingChanges` unless it is explicitly disabled.
+        /// </summary>
+        [Input("autoscalerDiscovery")]
+        public bool? AutoscalerDiscovery { get; set; }
+
         /// <summary>
         /// Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
         /// 
@@ -331,6 +339,13 @@ namespace Pulumi.Eks
         [Input("bootstrapExtraArgs")]
         public string? BootstrapExtraArgs { get; set; }
 
+        /// <summary>
+        /// Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+        /// The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+        /// </summary>
+        [Input("bottlerocketConfig")]
+        public Input<Inputs.BottlerocketConfigArgs>? BottlerocketConfig { get; set; }
+
         [Input("bottlerocketSettings")]
         private InputMap<object>? _bottlerocketSettings;
 
@@ -455,6 +470,15 @@ namespace Pulumi.Eks
         [Input("launchTemplate")]
         public Input<Pulumi.Aws.Eks.Inputs.NodeGroupLaunchTemplateArgs>? LaunchTemplate { get; set; }
 
+        /// <summary>
+        /// Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+        /// 
+        /// Note: This is only applicable when using AL2023.
+        /// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+        /// </summary>
+        [Input("nodeConfig")]
+        public Input<Inputs.NodeConfigArgs>? NodeConfig { get; set; }
+
         /// <summary>
         /// Name of the EKS Node Group. If omitted, this provider will assign a random, unique name. Conflicts with `nodeGroupNamePrefix`.
         /// </summary>
@@ -113,12 +113,27 @@ namespace Pulumi.Eks
             set => _autoScalingGroupTags = value;
         }
 
+        /// <summary>
+        /// Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/&lt;cluster name&gt;` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+        /// 
+        /// For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+        /// </summary>
+        [Input("autoscalerDiscovery")]
+        public bool? AutoscalerDiscovery { get; set; }
+
         /// <summary>
         /// Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
         /// </summary>
         [Input("bootstrapExtraArgs")]
         public Input<string>? BootstrapExtraArgs { get; set; }
 
+        /// <summary>
+        /// Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+        /// The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+        /// </summary>
+        [Input("bottlerocketConfig")]
+        public Input<Inputs.BottlerocketConfigArgs>? BottlerocketConfig { get; set; }
+
         [Input("bottlerocketSettings")]
         private InputMap<object>? _bottlerocketSettings;
 
@@ -284,6 +299,15 @@ namespace Pulumi.Eks
         [Input("nodeAssociatePublicIpAddress")]
         public Input<bool>? NodeAssociatePublicIpAddress { get; set; }
 
+        /// <summary>
+        /// Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+        /// 
+        /// Note: This is only applicable when using AL2023.
+        /// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+        /// </summary>
+        [Input("nodeConfig")]
+        public Input<Inputs.NodeConfigArgs>? NodeConfig { get; set; }
+
         /// <summary>
         /// Public key material for SSH access to worker nodes. See allowed formats at:
         /// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
@@ -0,0 +1,52 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Outputs
+{
+
+    /// <summary>
+    /// A Bottlerocket bootstrap container, which runs before the kubelet starts.
+    /// </summary>
+    [OutputType]
+    public sealed class BottlerocketBootstrapContainer
+    {
+        /// <summary>
+        /// Whether the node fails to boot if the bootstrap container fails.
+        /// </summary>
+        public readonly bool? Essential;
+        /// <summary>
+        /// When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+        /// </summary>
+        public readonly string? Mode;
+        /// <summary>
+        /// The image of the bootstrap container.
+        /// </summary>
+        public readonly string Source;
+        /// <summary>
+        /// Base64 encoded user data passed to the bootstrap container.
+        /// </summary>
+        public readonly string? UserData;
+
+        [OutputConstructor]
+        private BottlerocketBootstrapContainer(
+            bool? essential,
+
+            string? mode,
+
+            string source,
+
+            string? userData)
+        {
+            Essential = essential;
+            Mode = mode;
+            Source = source;
+            UserData = userData;
+        }
+    }
+}
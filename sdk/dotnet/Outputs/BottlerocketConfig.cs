@@ -0,0 +1,61 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Outputs
+{
+
+    /// <summary>
+    /// Typed configuration of the most common Bottlerocket settings.
+    /// 
+    /// See for more details: https://bottlerocket.dev/en/os/1.20.x/api/settings/.
+    /// </summary>
+    [OutputType]
+    public sealed class BottlerocketConfig
+    {
+        /// <summary>
+        /// Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+        /// </summary>
+        public readonly ImmutableDictionary<string, Outputs.BottlerocketBootstrapContainer>? BootstrapContainers;
+        /// <summary>
+        /// Settings of the container registries, i.e. `settings.container-registry`.
+        /// </summary>
+        public readonly Outputs.BottlerocketContainerRegistry? ContainerRegistry;
+        /// <summary>
+        /// Settings of the admin and control host containers, i.e. `settings.host-containers`.
+        /// </summary>
+        public readonly Outputs.BottlerocketHostContainers? HostContainers;
+        /// <summary>
+        /// Settings of the kernel, i.e. `settings.kernel`.
+        /// </summary>
+        public readonly Outputs.BottlerocketKernelSettings? Kernel;
+        /// <summary>
+        /// Settings of the kubelet, i.e. `settings.kubernetes`.
+        /// </summary>
+        public readonly Outputs.BottlerocketKubernetesSettings? Kubernetes;
+
+        [OutputConstructor]
+        private BottlerocketConfig(
+            ImmutableDictionary<string, Outputs.BottlerocketBootstrapContainer>? bootstrapContainers,
+
+            Outputs.BottlerocketContainerRegistry? containerRegistry,
+
+            Outputs.BottlerocketHostContainers? hostContainers,
+
+            Outputs.BottlerocketKernelSettings? kernel,
+
+            Outputs.BottlerocketKubernetesSettings? kubernetes)
+        {
+            BootstrapContainers = bootstrapContainers;
+            ContainerRegistry = containerRegistry;
+            HostContainers = hostContainers;
+            Kernel = kernel;
+            Kubernetes = kubernetes;
+        }
+    }
+}
@@ -0,0 +1,30 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Outputs
+{
+
+    /// <summary>
+    /// Settings of the container registries of Bottlerocket nodes.
+    /// </summary>
+    [OutputType]
+    public sealed class BottlerocketContainerRegistry
+    {
+        /// <summary>
+        /// Mirrors to pull images from instead of the original registries.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.BottlerocketRegistryMirror> Mirrors;
+
+        [OutputConstructor]
+        private BottlerocketContainerRegistry(ImmutableArray<Outputs.BottlerocketRegistryMirror> mirrors)
+        {
+            Mirrors = mirrors;
+        }
+    }
+}
@@ -0,0 +1,52 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Outputs
+{
+
+    /// <summary>
+    /// Settings of a Bottlerocket host container.
+    /// </summary>
+    [OutputType]
+    public sealed class BottlerocketHostContainer
+    {
+        /// <summary>
+        /// Whether the host container runs.
+        /// </summary>
+        public readonly bool? Enabled;
+        /// <summary>
+        /// The image of the host container.
+        /// </summary>
+        public readonly string? Source;
+        /// <summary>
+        /// Whether the host container has elevated privileges.
+        /// </summary>
+        public readonly bool? Superpowered;
+        /// <summary>
+        /// Base64 encoded user data passed to the host container.
+        /// </summary>
+        public readonly string? UserData;
+
+        [OutputConstructor]
+        private BottlerocketHostContainer(
+            bool? enabled,
+
+            string? source,
+
+            bool? superpowered,
+
+            string? userData)
+        {
+            Enabled = enabled;
+            Source = source;
+            Superpowered = superpowered;
+            UserData = userData;
+        }
+    }
+}
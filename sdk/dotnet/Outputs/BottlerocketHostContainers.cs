@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Outputs
+{
+
+    /// <summary>
+    /// Settings of the Bottlerocket host containers.
+    /// </summary>
+    [OutputType]
+    public sealed class BottlerocketHostContainers
+    {
+        /// <summary>
+        /// The admin container, used for troubleshooting the node.
+        /// </summary>
+        public readonly Outputs.BottlerocketHostContainer? Admin;
+        /// <summary>
+        /// The control container, used to access the Bottlerocket API through AWS SSM.
+        /// </summary>
+        public readonly Outputs.BottlerocketHostContainer? Control;
+
+        [OutputConstructor]
+        private BottlerocketHostContainers(
+            Outputs.BottlerocketHostContainer? admin,
+
+            Outputs.BottlerocketHostContainer? control)
+        {
+            Admin = admin;
+            Control = control;
+        }
+    }
+}
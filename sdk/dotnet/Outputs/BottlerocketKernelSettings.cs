@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Outputs
+{
+
+    /// <summary>
+    /// Settings of the Bottlerocket kernel.
+    /// </summary>
+    [OutputType]
+    public sealed class BottlerocketKernelSettings
+    {
+        /// <summary>
+        /// The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+        /// </summary>
+        public readonly string? Lockdown;
+        /// <summary>
+        /// Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: "1"`.
+        /// </summary>
+        public readonly ImmutableDictionary<string, string>? Sysctl;
+
+        [OutputConstructor]
+        private BottlerocketKernelSettings(
+            string? lockdown,
+
+            ImmutableDictionary<string, string>? sysctl)
+        {
+            Lockdown = lockdown;
+            Sysctl = sysctl;
+        }
+    }
+}
@@ -0,0 +1,80 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Outputs
+{
+
+    /// <summary>
+    /// Settings of the kubelet on Bottlerocket nodes.
+    /// </summary>
+    [OutputType]
+    public sealed class BottlerocketKubernetesSettings
+    {
+        /// <summary>
+        /// Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+        /// </summary>
+        public readonly ImmutableDictionary<string, string>? EvictionHard;
+        /// <summary>
+        /// Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+        /// </summary>
+        public readonly ImmutableDictionary<string, string>? EvictionSoft;
+        /// <summary>
+        /// Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+        /// </summary>
+        public readonly ImmutableDictionary<string, string>? EvictionSoftGracePeriod;
+        /// <summary>
+        /// The disk usage in percent after which image garbage collection always runs.
+        /// </summary>
+        public readonly int? ImageGcHighThresholdPercent;
+        /// <summary>
+        /// The disk usage in percent before which image garbage collection never runs.
+        /// </summary>
+        public readonly int? ImageGcLowThresholdPercent;
+        /// <summary>
+        /// The maximum number of pods the kubelet runs on the node.
+        /// </summary>
+        public readonly int? MaxPods;
+        /// <summary>
+        /// Labels the node registers with.
+        /// </summary>
+        public readonly ImmutableDictionary<string, string>? NodeLabels;
+        /// <summary>
+        /// Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+        /// </summary>
+        public readonly ImmutableDictionary<string, string>? NodeTaints;
+
+        [OutputConstructor]
+        private BottlerocketKubernetesSettings(
+            ImmutableDictionary<string, string>? evictionHard,
+
+            ImmutableDictionary<string, string>? evictionSoft,
+
+            ImmutableDictionary<string, string>? evictionSoftGracePeriod,
+
+            int? imageGcHighThresholdPercent,
+
+            int? imageGcLowThresholdPercent,
+
+            int? maxPods,
+
+            ImmutableDictionary<string, string>? nodeLabels,
+
+            ImmutableDictionary<string, string>? nodeTaints)
+        {
+            EvictionHard = evictionHard;
+            EvictionSoft = evictionSoft;
+            EvictionSoftGracePeriod = evictionSoftGracePeriod;
+            ImageGcHighThresholdPercent = imageGcHighThresholdPercent;
+            ImageGcLowThresholdPercent = imageGcLowThresholdPercent;
+            MaxPods = maxPods;
+            NodeLabels = nodeLabels;
+            NodeTaints = nodeTaints;
+        }
+    }
+}
@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Outputs
+{
+
+    /// <summary>
+    /// A mirror of a container registry.
+    /// </summary>
+    [OutputType]
+    public sealed class BottlerocketRegistryMirror
+    {
+        /// <summary>
+        /// The endpoints of the mirror, in order of preference.
+        /// </summary>
+        public readonly ImmutableArray<string> Endpoints;
+        /// <summary>
+        /// The registry to mirror, e.g. `docker.io`.
+        /// </summary>
+        public readonly string Registry;
+
+        [OutputConstructor]
+        private BottlerocketRegistryMirror(
+            ImmutableArray<string> endpoints,
+
+            string registry)
+        {
+            Endpoints = endpoints;
+            Registry = registry;
+        }
+    }
+}
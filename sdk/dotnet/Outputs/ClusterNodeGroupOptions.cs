@@ -44,10 +44,21 @@ namespace Pulumi.Eks.Outputs
         /// </summary>
         public readonly ImmutableDictionary<string, string>? AutoScalingGroupTags;
         /// <summary>
+        /// Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/&lt;cluster name&gt;` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+        /// 
+        /// For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+        /// </summary>
+        public readonly bool? AutoscalerDiscovery;
+        /// <summary>
         /// Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
         /// </summary>
         public readonly string? BootstrapExtraArgs;
         /// <summary>
+        /// Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+        /// The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+        /// </summary>
+        public readonly Outputs.BottlerocketConfig? BottlerocketConfig;
+        /// <summary>
         /// The configuration settings for Bottlerocket OS.
         /// The settings will get merged with the base settings the provider uses to configure Bottlerocket.
         /// 
@@ -129,6 +140,12 @@ namespace Pulumi.Eks.Outputs
         /// </summary>
         public readonly string? InstanceType;
         /// <summary>
+        /// Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+        /// 
+        /// See for more details: https://github.com/aws/aws-node-termination-handler
+        /// </summary>
+        public readonly Outputs.InterruptionHandling? InterruptionHandling;
+        /// <summary>
         /// Name of the key pair to use for SSH access to worker nodes.
         /// </summary>
         public readonly string? KeyName;
@@ -161,6 +178,13 @@ namespace Pulumi.Eks.Outputs
         /// </summary>
         public readonly bool? NodeAssociatePublicIpAddress;
         /// <summary>
+        /// Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+        /// 
+        /// Note: This is only applicable when using AL2023.
+        /// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+        /// </summary>
+        public readonly Outputs.NodeConfig? NodeConfig;
+        /// <summary>
         /// Public key material for SSH access to worker nodes. See allowed formats at:
         /// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
         /// If not provided, no SSH access is enabled on VMs.
@@ -270,8 +294,12 @@ namespace Pulumi.Eks.Outputs
 
             ImmutableDictionary<string, string>? autoScalingGroupTags,
 
+            bool? autoscalerDiscovery,
+
             string? bootstrapExtraArgs,
 
+            Outputs.BottlerocketConfig? bottlerocketConfig,
+
             ImmutableDictionary<string, object>? bottlerocketSettings,
 
             ImmutableDictionary<string, string>? cloudFormationTags,
@@ -298,6 +326,8 @@ namespace Pulumi.Eks.Outputs
 
             string? instanceType,
 
+            Outputs.InterruptionHandling? interruptionHandling,
+
             string? keyName,
 
             string? kubeletExtraArgs,
@@ -314,6 +344,8 @@ namespace Pulumi.Eks.Outputs
 
             bool? nodeAssociatePublicIpAddress,
 
+            Outputs.NodeConfig? nodeConfig,
+
             string? nodePublicKey,
 
             bool? nodeRootVolumeDeleteOnTermination,
@@ -351,7 +383,9 @@ namespace Pulumi.Eks.Outputs
             AmiId = amiId;
             AmiType = amiType;
             AutoScalingGroupTags = autoScalingGroupTags;
+            AutoscalerDiscovery = autoscalerDiscovery;
             BootstrapExtraArgs = bootstrapExtraArgs;
+            BottlerocketConfig = bottlerocketConfig;
             BottlerocketSettings = bottlerocketSettings;
             CloudFormationTags = cloudFormationTags;
             ClusterIngressRule = clusterIngressRule;
@@ -365,6 +399,7 @@ namespace Pulumi.Eks.Outputs
             InstanceProfile = instanceProfile;
             InstanceProfileName = instanceProfileName;
             InstanceType = instanceType;
+            InterruptionHandling = interruptionHandling;
             KeyName = keyName;
             KubeletExtraArgs = kubeletExtraArgs;
             Labels = labels;
@@ -373,6 +408,7 @@ namespace Pulumi.Eks.Outputs
             MinRefreshPercentage = minRefreshPercentage;
             MinSize = minSize;
             NodeAssociatePublicIpAddress = nodeAssociatePublicIpAddress;
+            NodeConfig = nodeConfig;
             NodePublicKey = nodePublicKey;
             NodeRootVolumeDeleteOnTermination = nodeRootVolumeDeleteOnTermination;
             NodeRootVolumeEncrypted = nodeRootVolumeEncrypted;
@@ -26,7 +26,15 @@ namespace Pulumi.Eks.Outputs
         /// The IAM Role attached to the EKS Cluster
         /// </summary>
         public readonly Pulumi.Aws.Iam.Role ClusterIamRole;
+        /// <summary>
+        /// The log group of the control plane logs, if it is managed by the cluster.
+        /// </summary>
+        public readonly Pulumi.Aws.CloudWatch.LogGroup? ClusterLogGroup;
         public readonly Pulumi.Aws.Ec2.SecurityGroup? ClusterSecurityGroup;
+        /// <summary>
+        /// The scaling configuration of the control plane, including its provisioned tier.
+        /// </summary>
+        public readonly Pulumi.Aws.Eks.Outputs.ClusterControlPlaneScalingConfig? ControlPlaneScalingConfig;
         public readonly Pulumi.Kubernetes.Core.V1.ConfigMap? EksNodeAccess;
         public readonly Pulumi.Aws.Eks.Outputs.ClusterEncryptionConfig? EncryptionConfig;
         /// <summary>
@@ -76,13 +84,25 @@ namespace Pulumi.Eks.Outputs
         /// </summary>
         public readonly ImmutableDictionary<string, string>? Tags;
         /// <summary>
+        /// The upgrade policy of the cluster, which determines whether it gets extended support.
+        /// </summary>
+        public readonly Pulumi.Aws.Eks.Outputs.ClusterUpgradePolicy? UpgradePolicy;
+        /// <summary>
         /// The VPC CNI for the cluster.
         /// </summary>
         public readonly Pulumi.Eks.VpcCniAddon? VpcCni;
         /// <summary>
+        /// The VPC CNI settings that affect the maximum number of pods of the nodes.
+        /// </summary>
+        public readonly Outputs.VpcCniNetworking? VpcCniNetworking;
+        /// <summary>
         /// ID of the cluster's VPC.
         /// </summary>
         public readonly string VpcId;
+        /// <summary>
+        /// The zonal shift configuration of the cluster.
+        /// </summary>
+        public readonly Pulumi.Aws.Eks.Outputs.ClusterZonalShiftConfig? ZonalShiftConfig;
 
         [OutputConstructor]
         private CoreData(
@@ -94,8 +114,12 @@ namespace Pulumi.Eks.Outputs
 
             Pulumi.Aws.Iam.Role clusterIamRole,
 
+            Pulumi.Aws.CloudWatch.LogGroup? clusterLogGroup,
+
             Pulumi.Aws.Ec2.SecurityGroup? clusterSecurityGroup,
 
+            Pulumi.Aws.Eks.Outputs.ClusterControlPlaneScalingConfig? controlPlaneScalingConfig,
+
             Pulumi.Kubernetes.Core.V1.ConfigMap? eksNodeAccess,
 
             Pulumi.Aws.Eks.Outputs.ClusterEncryptionConfig? encryptionConfig,
@@ -126,15 +150,23 @@ namespace Pulumi.Eks.Outputs
 
             ImmutableDictionary<string, string>? tags,
 
+            Pulumi.Aws.Eks.Outputs.ClusterUpgradePolicy? upgradePolicy,
+
             Pulumi.Eks.VpcCniAddon? vpcCni,
 
-            string vpcId)
+            Outputs.VpcCniNetworking? vpcCniNetworking,
+
+            string vpcId,
+
+            Pulumi.Aws.Eks.Outputs.ClusterZonalShiftConfig? zonalShiftConfig)
         {
             AccessEntries = accessEntries;
             AwsProvider = awsProvider;
             Cluster = cluster;
             ClusterIamRole = clusterIamRole;
+            ClusterLogGroup = clusterLogGroup;
             ClusterSecurityGroup = clusterSecurityGroup;
+            ControlPlaneScalingConfig = controlPlaneScalingConfig;
             EksNodeAccess = eksNodeAccess;
             EncryptionConfig = encryptionConfig;
             Endpoint = endpoint;
@@ -150,8 +182,11 @@ namespace Pulumi.Eks.Outputs
             StorageClasses = storageClasses;
             SubnetIds = subnetIds;
             Tags = tags;
+            UpgradePolicy = upgradePolicy;
             VpcCni = vpcCni;
+            VpcCniNetworking = vpcCniNetworking;
             VpcId = vpcId;
+            ZonalShiftConfig = zonalShiftConfig;
         }
     }
 }
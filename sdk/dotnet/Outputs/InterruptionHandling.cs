@@ -0,0 +1,69 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Outputs
+{
+
+    /// <summary>
+    /// InterruptionHandling configures how a node group handles Spot interruptions and Auto Scaling Group termination events. The events are delivered to an SQS queue, which aws-node-termination-handler processes in queue mode to cordon and drain the affected nodes before their instances are terminated.
+    /// See for more details: https://github.com/aws/aws-node-termination-handler#queue-processor
+    /// </summary>
+    [OutputType]
+    public sealed class InterruptionHandling
+    {
+        /// <summary>
+        /// The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+        /// </summary>
+        public readonly string? ChartVersion;
+        /// <summary>
+        /// The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+        /// </summary>
+        public readonly int? HeartbeatTimeout;
+        /// <summary>
+        /// The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+        /// </summary>
+        public readonly string? Namespace;
+        /// <summary>
+        /// The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+        /// 
+        /// If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+        /// </summary>
+        public readonly string? OidcProviderArn;
+        /// <summary>
+        /// The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `&lt;name&gt;-node-termination-handler`, where `&lt;name&gt;` is the name of the node group.
+        /// </summary>
+        public readonly string? ServiceAccountName;
+        /// <summary>
+        /// Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+        /// </summary>
+        public readonly ImmutableDictionary<string, object>? Values;
+
+        [OutputConstructor]
+        private InterruptionHandling(
+            string? chartVersion,
+
+            int? heartbeatTimeout,
+
+            string? @namespace,
+
+            string? oidcProviderArn,
+
+            string? serviceAccountName,
+
+            ImmutableDictionary<string, object>? values)
+        {
+            ChartVersion = chartVersion;
+            HeartbeatTimeout = heartbeatTimeout;
+            Namespace = @namespace;
+            OidcProviderArn = oidcProviderArn;
+            ServiceAccountName = serviceAccountName;
+            Values = values;
+        }
+    }
+}
@@ -0,0 +1,47 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Outputs
+{
+
+    /// <summary>
+    /// Typed nodeadm `NodeConfig`. The cluster details are configured by the provider.
+    /// 
+    /// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/.
+    /// </summary>
+    [OutputType]
+    public sealed class NodeConfig
+    {
+        /// <summary>
+        /// Configuration of containerd.
+        /// </summary>
+        public readonly Outputs.NodeConfigContainerd? Containerd;
+        /// <summary>
+        /// Configuration of the EC2 instance.
+        /// </summary>
+        public readonly Outputs.NodeConfigInstance? Instance;
+        /// <summary>
+        /// Configuration of the kubelet.
+        /// </summary>
+        public readonly Outputs.NodeConfigKubelet? Kubelet;
+
+        [OutputConstructor]
+        private NodeConfig(
+            Outputs.NodeConfigContainerd? containerd,
+
+            Outputs.NodeConfigInstance? instance,
+
+            Outputs.NodeConfigKubelet? kubelet)
+        {
+            Containerd = containerd;
+            Instance = instance;
+            Kubelet = kubelet;
+        }
+    }
+}
@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Outputs
+{
+
+    /// <summary>
+    /// Configuration of containerd in a nodeadm `NodeConfig`.
+    /// </summary>
+    [OutputType]
+    public sealed class NodeConfigContainerd
+    {
+        /// <summary>
+        /// The OCI runtime specification containers are created with.
+        /// </summary>
+        public readonly ImmutableDictionary<string, object>? BaseRuntimeSpec;
+        /// <summary>
+        /// Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+        /// </summary>
+        public readonly string? Config;
+
+        [OutputConstructor]
+        private NodeConfigContainerd(
+            ImmutableDictionary<string, object>? baseRuntimeSpec,
+
+            string? config)
+        {
+            BaseRuntimeSpec = baseRuntimeSpec;
+            Config = config;
+        }
+    }
+}
@@ -0,0 +1,30 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Outputs
+{
+
+    /// <summary>
+    /// Configuration of the EC2 instance in a nodeadm `NodeConfig`.
+    /// </summary>
+    [OutputType]
+    public sealed class NodeConfigInstance
+    {
+        /// <summary>
+        /// Configuration of the local instance store volumes.
+        /// </summary>
+        public readonly Outputs.NodeConfigLocalStorage? LocalStorage;
+
+        [OutputConstructor]
+        private NodeConfigInstance(Outputs.NodeConfigLocalStorage? localStorage)
+        {
+            LocalStorage = localStorage;
+        }
+    }
+}
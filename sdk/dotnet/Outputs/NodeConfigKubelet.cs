@@ -0,0 +1,40 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Outputs
+{
+
+    /// <summary>
+    /// Configuration of the kubelet in a nodeadm `NodeConfig`.
+    /// </summary>
+    [OutputType]
+    public sealed class NodeConfigKubelet
+    {
+        /// <summary>
+        /// Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+        /// 
+        /// See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+        /// </summary>
+        public readonly ImmutableDictionary<string, object>? Config;
+        /// <summary>
+        /// Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+        /// </summary>
+        public readonly ImmutableArray<string> Flags;
+
+        [OutputConstructor]
+        private NodeConfigKubelet(
+            ImmutableDictionary<string, object>? config,
+
+            ImmutableArray<string> flags)
+        {
+            Config = config;
+            Flags = flags;
+        }
+    }
+}
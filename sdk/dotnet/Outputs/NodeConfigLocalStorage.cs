@@ -0,0 +1,45 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Outputs
+{
+
+    /// <summary>
+    /// Configuration of the local instance store volumes in a nodeadm `NodeConfig`.
+    /// </summary>
+    [OutputType]
+    public sealed class NodeConfigLocalStorage
+    {
+        /// <summary>
+        /// Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+        /// </summary>
+        public readonly ImmutableArray<string> DisabledMounts;
+        /// <summary>
+        /// The path the instance store volumes are mounted at.
+        /// </summary>
+        public readonly string? MountPath;
+        /// <summary>
+        /// How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+        /// </summary>
+        public readonly string Strategy;
+
+        [OutputConstructor]
+        private NodeConfigLocalStorage(
+            ImmutableArray<string> disabledMounts,
+
+            string? mountPath,
+
+            string strategy)
+        {
+            DisabledMounts = disabledMounts;
+            MountPath = mountPath;
+            Strategy = strategy;
+        }
+    }
+}
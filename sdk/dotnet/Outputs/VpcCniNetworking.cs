@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Eks.Outputs
+{
+
+    /// <summary>
+    /// The VPC CNI settings that affect the maximum number of pods of the nodes.
+    /// </summary>
+    [OutputType]
+    public sealed class VpcCniNetworking
+    {
+        /// <summary>
+        /// Whether pods get their IP addresses from the subnets of ENIConfigs.
+        /// </summary>
+        public readonly bool CustomNetworking;
+        /// <summary>
+        /// Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+        /// </summary>
+        public readonly bool PrefixDelegation;
+
+        [OutputConstructor]
+        private VpcCniNetworking(
+            bool customNetworking,
+
+            bool prefixDelegation)
+        {
+            CustomNetworking = customNetworking;
+            PrefixDelegation = prefixDelegation;
+        }
+    }
+}
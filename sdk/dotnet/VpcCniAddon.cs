@@ -179,6 +179,36 @@ namespace Pulumi.Eks
         [Input("nodePortSupport")]
         public Input<bool>? NodePortSupport { get; set; }
 
+        [Input("podSecurityGroupIds")]
+        private InputList<string>? _podSecurityGroupIds;
+
+        /// <summary>
+        /// The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+        /// 
+        /// Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+        /// </summary>
+        public InputList<string> PodSecurityGroupIds
+        {
+            get => _podSecurityGroupIds ?? (_podSecurityGroupIds = new InputList<string>());
+            set => _podSecurityGroupIds = value;
+        }
+
+        [Input("podSubnets")]
+        private Dictionary<string, Input<string>>? _podSubnets;
+
+        /// <summary>
+        /// Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+        /// 
+        /// Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+        /// 
+        /// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
+        /// </summary>
+        public Dictionary<string, Input<string>> PodSubnets
+        {
+            get => _podSubnets ?? (_podSubnets = new Dictionary<string, Input<string>>());
+            set => _podSubnets = value;
+        }
+
         /// <summary>
         /// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
         /// </summary>
@@ -139,6 +139,17 @@ type clusterArgs struct {
 	AutoMode *AutoModeOptions `pulumi:"autoMode"`
 	// Install default unmanaged add-ons, such as `aws-cni`, `kube-proxy`, and CoreDNS during cluster creation. If `false`, you must manually install desired add-ons. Changing this value will force a new cluster to be created. Defaults to `true`
 	BootstrapSelfManagedAddons *bool `pulumi:"bootstrapSelfManagedAddons"`
+	// The ARN of the KMS key to encrypt the control plane logs in the `/aws/eks/<name>/cluster` log group with. The key policy must allow the CloudWatch Logs service principal of the region to use the key.
+	//
+	// When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set.
+	//
+	// See for more details:
+	// - https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/encrypt-log-data-kms.html
+	ClusterLogKmsKeyArn *string `pulumi:"clusterLogKmsKeyArn"`
+	// The number of days to retain the control plane logs in the `/aws/eks/<name>/cluster` log group. Possible values are: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653, and 0. If set to 0, the logs never expire.
+	//
+	// When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set. Set `name` to the name of the existing cluster when enabling this for an existing cluster, and import the log group if EKS already created it.
+	ClusterLogRetentionDays *int `pulumi:"clusterLogRetentionDays"`
 	// The security group to use for the cluster API endpoint. If not provided, a new security group will be created with full internet egress and ingress from node groups.
 	//
 	// Note: The security group resource should not contain any inline ingress or egress rules.
@@ -147,6 +158,10 @@ type clusterArgs struct {
 	ClusterSecurityGroupTags map[string]string `pulumi:"clusterSecurityGroupTags"`
 	// The tags to apply to the EKS cluster.
 	ClusterTags map[string]string `pulumi:"clusterTags"`
+	// The scaling configuration of the control plane. Setting a provisioned `tier` reserves control plane capacity for the cluster instead of scaling it on demand.
+	//
+	// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/eks-provisioned-control-plane.html
+	ControlPlaneScalingConfig *eks.ClusterControlPlaneScalingConfig `pulumi:"controlPlaneScalingConfig"`
 	// Options for managing the `coredns` addon.
 	CorednsAddonOptions *CoreDnsAddonOptions `pulumi:"corednsAddonOptions"`
 	// Whether to create the instance role for the EKS cluster. Defaults to true when using the default node group, false otherwise.
@@ -178,6 +193,12 @@ type clusterArgs struct {
 	EnableConfigMapMutable *bool `pulumi:"enableConfigMapMutable"`
 	// Enable EKS control plane logging. This sends logs to cloudwatch. Possible list of values are: ["api", "audit", "authenticator", "controllerManager", "scheduler"]. By default it is off.
 	EnabledClusterLogTypes []string `pulumi:"enabledClusterLogTypes"`
+	// Creates a KMS key and alias for the encryption configuration of the cluster. The key policy grants the cluster role the permissions it needs to use the key for envelope encryption of Kubernetes secrets.
+	//
+	// Mutually exclusive with `encryptionConfigKeyArn`.
+	// See for more details:
+	// - https://docs.aws.amazon.com/eks/latest/userguide/envelope-encryption.html
+	EncryptionConfig *EncryptionConfigOptions `pulumi:"encryptionConfig"`
 	// KMS Key ARN to use with the encryption configuration for the cluster.
 	//
 	// Only available on Kubernetes 1.13+ clusters created after March 6, 2020.
@@ -368,6 +389,10 @@ type clusterArgs struct {
 	VpcCniOptions *VpcCniOptions `pulumi:"vpcCniOptions"`
 	// The VPC in which to create the cluster and its worker nodes. If unset, the cluster will be created in the default VPC.
 	VpcId *string `pulumi:"vpcId"`
+	// The zonal shift configuration of the cluster. Enabling zonal shift lets Amazon Application Recovery Controller (ARC) move traffic away from an impaired Availability Zone.
+	//
+	// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/zone-shift.html
+	ZonalShiftConfig *eks.ClusterZonalShiftConfig `pulumi:"zonalShiftConfig"`
 }
 
 // The set of arguments for constructing a Cluster resource.
@@ -388,6 +413,17 @@ type ClusterArgs struct {
 	AutoMode *AutoModeOptionsArgs
 	// Install default unmanaged add-ons, such as `aws-cni`, `kube-proxy`, and CoreDNS during cluster creation. If `false`, you must manually install desired add-ons. Changing this value will force a new cluster to be created. Defaults to `true`
 	BootstrapSelfManagedAddons pulumi.BoolPtrInput
+	// The ARN of the KMS key to encrypt the control plane logs in the `/aws/eks/<name>/cluster` log group with. The key policy must allow the CloudWatch Logs service principal of the region to use the key.
+	//
+	// When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set.
+	//
+	// See for more details:
+	// - https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/encrypt-log-data-kms.html
+	ClusterLogKmsKeyArn pulumi.StringPtrInput
+	// The number of days to retain the control plane logs in the `/aws/eks/<name>/cluster` log group. Possible values are: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653, and 0. If set to 0, the logs never expire.
+	//
+	// When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set. Set `name` to the name of the existing cluster when enabling this for an existing cluster, and import the log group if EKS already created it.
+	ClusterLogRetentionDays pulumi.IntPtrInput
 	// The security group to use for the cluster API endpoint. If not provided, a new security group will be created with full internet egress and ingress from node groups.
 	//
 	// Note: The security group resource should not contain any inline ingress or egress rules.
@@ -396,6 +432,10 @@ type ClusterArgs struct {
 	ClusterSecurityGroupTags pulumi.StringMapInput
 	// The tags to apply to the EKS cluster.
 	ClusterTags pulumi.StringMapInput
+	// The scaling configuration of the control plane. Setting a provisioned `tier` reserves control plane capacity for the cluster instead of scaling it on demand.
+	//
+	// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/eks-provisioned-control-plane.html
+	ControlPlaneScalingConfig eks.ClusterControlPlaneScalingConfigPtrInput
 	// Options for managing the `coredns` addon.
 	CorednsAddonOptions *CoreDnsAddonOptionsArgs
 	// Whether to create the instance role for the EKS cluster. Defaults to true when using the default node group, false otherwise.
@@ -427,6 +467,12 @@ type ClusterArgs struct {
 	EnableConfigMapMutable pulumi.BoolPtrInput
 	// Enable EKS control plane logging. This sends logs to cloudwatch. Possible list of values are: ["api", "audit", "authenticator", "controllerManager", "scheduler"]. By default it is off.
 	EnabledClusterLogTypes pulumi.StringArrayInput
+	// Creates a KMS key and alias for the encryption configuration of the cluster. The key policy grants the cluster role the permissions it needs to use the key for envelope encryption of Kubernetes secrets.
+	//
+	// Mutually exclusive with `encryptionConfigKeyArn`.
+	// See for more details:
+	// - https://docs.aws.amazon.com/eks/latest/userguide/envelope-encryption.html
+	EncryptionConfig *EncryptionConfigOptionsArgs
 	// KMS Key ARN to use with the encryption configuration for the cluster.
 	//
 	// Only available on Kubernetes 1.13+ clusters created after March 6, 2020.
@@ -617,6 +663,10 @@ type ClusterArgs struct {
 	VpcCniOptions *VpcCniOptionsArgs
 	// The VPC in which to create the cluster and its worker nodes. If unset, the cluster will be created in the default VPC.
 	VpcId pulumi.StringPtrInput
+	// The zonal shift configuration of the cluster. Enabling zonal shift lets Amazon Application Recovery Controller (ARC) move traffic away from an impaired Availability Zone.
+	//
+	// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/zone-shift.html
+	ZonalShiftConfig eks.ClusterZonalShiftConfigPtrInput
 }
 
 func (ClusterArgs) ElementType() reflect.Type {
@@ -640,26 +690,54 @@ func (r *Cluster) GetKubeconfig(ctx *pulumi.Context, args *ClusterGetKubeconfigA
 }
 
 type clusterGetKubeconfigArgs struct {
+	// Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+	Env map[string]string `pulumi:"env"`
+	// External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+	//
+	// Only supported by the `aws-iam-authenticator` token tool.
+	ExternalId *string `pulumi:"externalId"`
 	// AWS credential profile name to always use instead of the default AWS credential provider chain.
 	//
 	// The profile is passed to kubeconfig as an authentication environment setting.
 	ProfileName *string `pulumi:"profileName"`
+	// AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+	Region *string `pulumi:"region"`
 	// Role ARN to assume instead of the default AWS credential provider chain.
 	//
 	// The role is passed to kubeconfig as an authentication exec argument.
 	RoleArn *string `pulumi:"roleArn"`
+	// Session name to use when assuming `roleArn`.
+	//
+	// Only supported by the `aws-iam-authenticator` token tool.
+	RoleSessionName *string `pulumi:"roleSessionName"`
+	// The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+	TokenTool *KubeconfigTokenTool `pulumi:"tokenTool"`
 }
 
 // The set of arguments for the GetKubeconfig method of the Cluster resource.
 type ClusterGetKubeconfigArgs struct {
+	// Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+	Env pulumi.StringMapInput
+	// External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+	//
+	// Only supported by the `aws-iam-authenticator` token tool.
+	ExternalId pulumi.StringPtrInput
 	// AWS credential profile name to always use instead of the default AWS credential provider chain.
 	//
 	// The profile is passed to kubeconfig as an authentication environment setting.
 	ProfileName pulumi.StringPtrInput
+	// AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+	Region pulumi.StringPtrInput
 	// Role ARN to assume instead of the default AWS credential provider chain.
 	//
 	// The role is passed to kubeconfig as an authentication exec argument.
 	RoleArn pulumi.StringPtrInput
+	// Session name to use when assuming `roleArn`.
+	//
+	// Only supported by the `aws-iam-authenticator` token tool.
+	RoleSessionName pulumi.StringPtrInput
+	// The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+	TokenTool KubeconfigTokenToolPtrInput
 }
 
 func (ClusterGetKubeconfigArgs) ElementType() reflect.Type {
@@ -682,6 +760,99 @@ func (o clusterGetKubeconfigResultOutput) Result() pulumi.StringOutput {
 	return o.ApplyT(func(v clusterGetKubeconfigResult) string { return v.Result }).(pulumi.StringOutput)
 }
 
+// Generate a kubeconfig that authenticates with a static bearer token instead of an exec plugin. This is useful for consumers that cannot run the AWS CLI, e.g. Argo CD cluster secrets or services using a Kubernetes client library.
+//
+// The token is a pre-signed STS `GetCallerIdentity` request retrieved with the configured `tokenTool` when the program runs, so the tool needs to be installed where Pulumi runs. Tokens are short-lived (15 minutes) and a new token is generated on every update, so consumers need to refresh the kubeconfig before `expirationTimestamp`.
+//
+// See for more details:
+// - https://docs.aws.amazon.com/eks/latest/userguide/cluster-auth.html
+// - https://github.com/kubernetes-sigs/aws-iam-authenticator#api-authorization-from-outside-a-cluster
+func (r *Cluster) GetStaticKubeconfig(ctx *pulumi.Context, args *ClusterGetStaticKubeconfigArgs) (ClusterGetStaticKubeconfigResultOutput, error) {
+	out, err := ctx.Call("eks:index:Cluster/getStaticKubeconfig", args, ClusterGetStaticKubeconfigResultOutput{}, r)
+	if err != nil {
+		return ClusterGetStaticKubeconfigResultOutput{}, err
+	}
+	return out.(ClusterGetStaticKubeconfigResultOutput), nil
+}
+
+type clusterGetStaticKubeconfigArgs struct {
+	// Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+	Env map[string]string `pulumi:"env"`
+	// External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+	//
+	// Only supported by the `aws-iam-authenticator` token tool.
+	ExternalId *string `pulumi:"externalId"`
+	// AWS credential profile name to always use instead of the default AWS credential provider chain.
+	//
+	// The profile is passed to kubeconfig as an authentication environment setting.
+	ProfileName *string `pulumi:"profileName"`
+	// AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+	Region *string `pulumi:"region"`
+	// Role ARN to assume instead of the default AWS credential provider chain.
+	//
+	// The role is passed to kubeconfig as an authentication exec argument.
+	RoleArn *string `pulumi:"roleArn"`
+	// Session name to use when assuming `roleArn`.
+	//
+	// Only supported by the `aws-iam-authenticator` token tool.
+	RoleSessionName *string `pulumi:"roleSessionName"`
+	// The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+	TokenTool *KubeconfigTokenTool `pulumi:"tokenTool"`
+}
+
+// The set of arguments for the GetStaticKubeconfig method of the Cluster resource.
+type ClusterGetStaticKubeconfigArgs struct {
+	// Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+	Env pulumi.StringMapInput
+	// External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+	//
+	// Only supported by the `aws-iam-authenticator` token tool.
+	ExternalId pulumi.StringPtrInput
+	// AWS credential profile name to always use instead of the default AWS credential provider chain.
+	//
+	// The profile is passed to kubeconfig as an authentication environment setting.
+	ProfileName pulumi.StringPtrInput
+	// AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+	Region pulumi.StringPtrInput
+	// Role ARN to assume instead of the default AWS credential provider chain.
+	//
+	// The role is passed to kubeconfig as an authentication exec argument.
+	RoleArn pulumi.StringPtrInput
+	// Session name to use when assuming `roleArn`.
+	//
+	// Only supported by the `aws-iam-authenticator` token tool.
+	RoleSessionName pulumi.StringPtrInput
+	// The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+	TokenTool KubeconfigTokenToolPtrInput
+}
+
+func (ClusterGetStaticKubeconfigArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*clusterGetStaticKubeconfigArgs)(nil)).Elem()
+}
+
+type ClusterGetStaticKubeconfigResult struct {
+	// The time at which the token expires, in RFC 3339 format.
+	ExpirationTimestamp string `pulumi:"expirationTimestamp"`
+	// The stringified kubeconfig containing the bearer token for the cluster.
+	Kubeconfig string `pulumi:"kubeconfig"`
+}
+
+type ClusterGetStaticKubeconfigResultOutput struct{ *pulumi.OutputState }
+
+func (ClusterGetStaticKubeconfigResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ClusterGetStaticKubeconfigResult)(nil)).Elem()
+}
+
+// The time at which the token expires, in RFC 3339 format.
+func (o ClusterGetStaticKubeconfigResultOutput) ExpirationTimestamp() pulumi.StringOutput {
+	return o.ApplyT(func(v ClusterGetStaticKubeconfigResult) string { return v.ExpirationTimestamp }).(pulumi.StringOutput)
+}
+
+// The stringified kubeconfig containing the bearer token for the cluster.
+func (o ClusterGetStaticKubeconfigResultOutput) Kubeconfig() pulumi.StringOutput {
+	return o.ApplyT(func(v ClusterGetStaticKubeconfigResult) string { return v.Kubeconfig }).(pulumi.StringOutput)
+}
+
 type ClusterInput interface {
 	pulumi.Input
 
@@ -913,6 +1084,7 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*ClusterMapInput)(nil)).Elem(), ClusterMap{})
 	pulumi.RegisterOutputType(ClusterOutput{})
 	pulumi.RegisterOutputType(clusterGetKubeconfigResultOutput{})
+	pulumi.RegisterOutputType(ClusterGetStaticKubeconfigResultOutput{})
 	pulumi.RegisterOutputType(ClusterArrayOutput{})
 	pulumi.RegisterOutputType(ClusterMapOutput{})
 }
@@ -23,10 +23,18 @@ func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi
 	switch typ {
 	case "eks:index:Addon":
 		r = &Addon{}
+	case "eks:index:AutoModeNodeClass":
+		r = &AutoModeNodeClass{}
+	case "eks:index:AutoModeNodePool":
+		r = &AutoModeNodePool{}
 	case "eks:index:Cluster":
 		r = &Cluster{}
+	case "eks:index:ClusterAutoscaler":
+		r = &ClusterAutoscaler{}
 	case "eks:index:ClusterCreationRoleProvider":
 		r = &ClusterCreationRoleProvider{}
+	case "eks:index:LoadBalancerController":
+		r = &LoadBalancerController{}
 	case "eks:index:ManagedNodeGroup":
 		r = &ManagedNodeGroup{}
 	case "eks:index:NodeGroup":
@@ -335,10 +335,17 @@ type managedNodeGroupArgs struct {
 	//
 	// See the AWS documentation (https://docs.aws.amazon.com/eks/latest/APIReference/API_Nodegroup.html#AmazonEKS-Type-Nodegroup-amiType) for valid AMI Types. This provider will only perform drift detection if a configuration value is provided.
 	AmiType *string `pulumi:"amiType"`
+	// Tags the Auto Scaling Group of the node group with the `labels` and `taints` as node template tags of the Cluster Autoscaler, so it can scale the node group up from zero. EKS tags the Auto Scaling Groups of managed node groups for the auto-discovery of the Cluster Autoscaler itself.
+	//
+	// This implies `ignoreScalingChanges` unless it is explicitly disabled.
+	AutoscalerDiscovery *bool `pulumi:"autoscalerDiscovery"`
 	// Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
 	//
 	// Note that this field conflicts with `launchTemplate`.
 	BootstrapExtraArgs *string `pulumi:"bootstrapExtraArgs"`
+	// Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+	// The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+	BottlerocketConfig *BottlerocketConfig `pulumi:"bottlerocketConfig"`
 	// The configuration settings for Bottlerocket OS.
 	// The settings will get merged with the base settings the provider uses to configure Bottlerocket.
 	//
@@ -389,6 +396,11 @@ type managedNodeGroupArgs struct {
 	//
 	// Note: This field is mutually exclusive with `kubeletExtraArgs` and `bootstrapExtraArgs`.
 	LaunchTemplate *eks.NodeGroupLaunchTemplate `pulumi:"launchTemplate"`
+	// Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+	//
+	// Note: This is only applicable when using AL2023.
+	// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+	NodeConfig *NodeConfig `pulumi:"nodeConfig"`
 	// Name of the EKS Node Group. If omitted, this provider will assign a random, unique name. Conflicts with `nodeGroupNamePrefix`.
 	NodeGroupName *string `pulumi:"nodeGroupName"`
 	// Creates a unique name beginning with the specified prefix. Conflicts with `nodeGroupName`.
@@ -465,10 +477,17 @@ type ManagedNodeGroupArgs struct {
 	//
 	// See the AWS documentation (https://docs.aws.amazon.com/eks/latest/APIReference/API_Nodegroup.html#AmazonEKS-Type-Nodegroup-amiType) for valid AMI Types. This provider will only perform drift detection if a configuration value is provided.
 	AmiType pulumi.StringPtrInput
+	// Tags the Auto Scaling Group of the node group with the `labels` and `taints` as node template tags of the Cluster Autoscaler, so it can scale the node group up from zero. EKS tags the Auto Scaling Groups of managed node groups for the auto-discovery of the Cluster Autoscaler itself.
+	//
+	// This implies `ignoreScalingChanges` unless it is explicitly disabled.
+	AutoscalerDiscovery *bool
 	// Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
 	//
 	// Note that this field conflicts with `launchTemplate`.
 	BootstrapExtraArgs *string
+	// Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+	// The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+	BottlerocketConfig BottlerocketConfigPtrInput
 	// The configuration settings for Bottlerocket OS.
 	// The settings will get merged with the base settings the provider uses to configure Bottlerocket.
 	//
@@ -519,6 +538,11 @@ type ManagedNodeGroupArgs struct {
 	//
 	// Note: This field is mutually exclusive with `kubeletExtraArgs` and `bootstrapExtraArgs`.
 	LaunchTemplate eks.NodeGroupLaunchTemplatePtrInput
+	// Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+	//
+	// Note: This is only applicable when using AL2023.
+	// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+	NodeConfig NodeConfigPtrInput
 	// Name of the EKS Node Group. If omitted, this provider will assign a random, unique name. Conflicts with `nodeGroupNamePrefix`.
 	NodeGroupName pulumi.StringPtrInput
 	// Creates a unique name beginning with the specified prefix. Conflicts with `nodeGroupName`.
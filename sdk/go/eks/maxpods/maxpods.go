@@ -0,0 +1,204 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package maxpods computes the maximum number of pods the Amazon VPC CNI can assign IP addresses to on a node,
+// i.e. the value for the kubelet's `--max-pods` flag.
+//
+// The network interface limits of the supported instance types are embedded in the package, so no AWS API calls
+// are needed. The calculation follows the max-pods calculator of the EKS AMIs:
+// https://github.com/awslabs/amazon-eks-ami/blob/main/templates/al2/runtime/max-pods-calculator.sh
+package maxpods
+
+import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed limits.txt
+var limitsData string
+
+// ipv4PrefixSize is the number of IP addresses of the /28 prefixes assigned to network interfaces when prefix
+// delegation is enabled.
+const ipv4PrefixSize = 16
+
+// hostNetworkPods is the number of pods on every node that use the host network and therefore don't need an IP
+// address of the VPC CNI, i.e. aws-node and kube-proxy.
+const hostNetworkPods = 2
+
+const (
+	// smallInstanceMaxPods is the maximum number of pods Kubernetes recommends for nodes with less than 30 vCPUs.
+	smallInstanceMaxPods = 110
+	// largeInstanceMaxPods is the maximum number of pods Kubernetes recommends for nodes with 30 or more vCPUs.
+	largeInstanceMaxPods = 250
+	// largeInstanceMinVCPUs is the number of vCPUs from which on the larger cap applies.
+	largeInstanceMinVCPUs = 30
+)
+
+var (
+	// ErrUnknownInstanceType is returned for instance types whose limits are not known.
+	ErrUnknownInstanceType = errors.New("unknown instance type")
+	// ErrPrefixDelegationUnsupported is returned when prefix delegation is enabled for an instance type that is
+	// not built on the Nitro System.
+	ErrPrefixDelegationUnsupported = errors.New("prefix delegation is only supported on Nitro instance types")
+)
+
+// Hypervisor is the hypervisor an instance type runs on.
+type Hypervisor string
+
+const (
+	HypervisorNitro Hypervisor = "nitro"
+	HypervisorXen   Hypervisor = "xen"
+)
+
+// Limits are the network interface limits of an instance type.
+type Limits struct {
+	// InstanceType is the name of the instance type, e.g. `m5.large`.
+	InstanceType string
+	// ENIs is the maximum number of network interfaces that can be attached to an instance.
+	ENIs int
+	// IPv4PerENI is the maximum number of IPv4 addresses per network interface, including the primary address.
+	IPv4PerENI int
+	// VCPUs is the default number of vCPUs of an instance.
+	VCPUs int
+	// Hypervisor is the hypervisor the instance type runs on.
+	Hypervisor Hypervisor
+}
+
+// Options configure the VPC CNI features that affect the maximum number of pods.
+type Options struct {
+	// PrefixDelegation is whether the VPC CNI assigns /28 prefixes instead of individual IP addresses to network
+	// interfaces, i.e. `enablePrefixDelegation` of the VPC CNI.
+	PrefixDelegation bool
+	// WarmPrefixTarget is the number of prefixes the VPC CNI keeps available on a node, i.e. `warmPrefixTarget` of
+	// the VPC CNI. It doesn't reduce the number of pods, but it can't exceed the number of prefixes a node can hold.
+	WarmPrefixTarget int
+	// CustomNetworking is whether pods get their IP addresses from the subnets of ENIConfigs instead of the subnet
+	// of the node. The primary network interface of the node is not used for pods in this case.
+	CustomNetworking bool
+}
+
+var limits = mustParseLimits(limitsData)
+
+// Lookup returns the network interface limits of the given instance type.
+func Lookup(instanceType string) (Limits, bool) {
+	l, ok := limits[instanceType]
+	return l, ok
+}
+
+// InstanceTypes returns the sorted names of all instance types with known limits.
+func InstanceTypes() []string {
+	names := make([]string, 0, len(limits))
+	for name := range limits {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Compute returns the maximum number of pods for nodes of the given instance type.
+func Compute(instanceType string, opts Options) (int, error) {
+	l, ok := Lookup(instanceType)
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownInstanceType, instanceType)
+	}
+	return ComputeForLimits(l, opts)
+}
+
+// ComputeForLimits returns the maximum number of pods for nodes with the given network interface limits. This can
+// be used for instance types that are not embedded in this package.
+//
+// The result is capped at the number of pods Kubernetes recommends per node: 110 for instances with less than 30
+// vCPUs and 250 otherwise.
+func ComputeForLimits(l Limits, opts Options) (int, error) {
+	if opts.PrefixDelegation && l.Hypervisor != HypervisorNitro {
+		return 0, fmt.Errorf("%w: %q", ErrPrefixDelegationUnsupported, l.InstanceType)
+	}
+	if opts.WarmPrefixTarget < 0 {
+		return 0, fmt.Errorf("warm prefix target must not be negative, got %d", opts.WarmPrefixTarget)
+	}
+
+	enis := l.ENIs
+	if opts.CustomNetworking {
+		enis--
+	}
+	// The primary IP address of every network interface can't be assigned to pods.
+	slots := enis * (l.IPv4PerENI - 1)
+	if slots <= 0 {
+		return 0, fmt.Errorf("instance type %q has no IP addresses available for pods", l.InstanceType)
+	}
+
+	addressesPerSlot := 1
+	if opts.PrefixDelegation {
+		if opts.WarmPrefixTarget > slots {
+			return 0, fmt.Errorf("warm prefix target %d exceeds the %d prefixes instance type %q can hold",
+				opts.WarmPrefixTarget, slots, l.InstanceType)
+		}
+		addressesPerSlot = ipv4PrefixSize
+	}
+
+	maxPods := slots*addressesPerSlot + hostNetworkPods
+	return min(maxPods, podCap(l.VCPUs)), nil
+}
+
+func podCap(vcpus int) int {
+	if vcpus < largeInstanceMinVCPUs {
+		return smallInstanceMaxPods
+	}
+	return largeInstanceMaxPods
+}
+
+func mustParseLimits(data string) map[string]Limits {
+	result, err := parseLimits(data)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func parseLimits(data string) (map[string]Limits, error) {
+	result := map[string]Limits{}
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("line %d: expected 5 fields, got %d", line, len(fields))
+		}
+		var numbers [3]int
+		for i, field := range fields[1:4] {
+			n, err := strconv.Atoi(field)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			numbers[i] = n
+		}
+		result[fields[0]] = Limits{
+			InstanceType: fields[0],
+			ENIs:         numbers[0],
+			IPv4PerENI:   numbers[1],
+			VCPUs:        numbers[2],
+			Hypervisor:   Hypervisor(fields[4]),
+		}
+	}
+	return result, scanner.Err()
+}
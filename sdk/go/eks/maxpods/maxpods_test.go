@@ -0,0 +1,110 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maxpods
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCompute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		instanceType string
+		opts         Options
+		expected     int
+	}{
+		{name: "secondary IPs", instanceType: "m5.large", expected: 29},
+		{name: "small instance", instanceType: "t3.medium", expected: 17},
+		{name: "capped at 250 for large instances", instanceType: "m5.24xlarge", expected: 250},
+		{name: "graviton4", instanceType: "c8g.large", expected: 29},
+		{name: "multiple network cards", instanceType: "trn1.32xlarge", expected: 247},
+		{name: "custom networking", instanceType: "m5.large", opts: Options{CustomNetworking: true}, expected: 20},
+		{name: "prefix delegation capped at 110", instanceType: "m5.large", opts: Options{PrefixDelegation: true}, expected: 110},
+		{name: "prefix delegation on tiny instance", instanceType: "t3.nano", opts: Options{PrefixDelegation: true}, expected: 34},
+		{
+			name:         "prefix delegation with custom networking",
+			instanceType: "t3.micro",
+			opts:         Options{PrefixDelegation: true, CustomNetworking: true},
+			expected:     18,
+		},
+		{
+			name:         "prefix delegation capped at 250",
+			instanceType: "c5.9xlarge",
+			opts:         Options{PrefixDelegation: true, WarmPrefixTarget: 1},
+			expected:     250,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := Compute(tt.instanceType, tt.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != tt.expected {
+				t.Errorf("expected %d max pods for %s, got %d", tt.expected, tt.instanceType, actual)
+			}
+		})
+	}
+}
+
+func TestComputeErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := Compute("x9.unknown", Options{})
+	if !errors.Is(err, ErrUnknownInstanceType) {
+		t.Errorf("expected ErrUnknownInstanceType, got %v", err)
+	}
+
+	_, err = Compute("m4.large", Options{PrefixDelegation: true})
+	if !errors.Is(err, ErrPrefixDelegationUnsupported) {
+		t.Errorf("expected ErrPrefixDelegationUnsupported, got %v", err)
+	}
+
+	_, err = Compute("t3.nano", Options{PrefixDelegation: true, WarmPrefixTarget: 3})
+	if err == nil {
+		t.Error("expected an error for a warm prefix target exceeding the prefixes of the instance")
+	}
+
+	_, err = ComputeForLimits(Limits{InstanceType: "tiny", ENIs: 1, IPv4PerENI: 2, VCPUs: 1}, Options{
+		CustomNetworking: true,
+	})
+	if err == nil {
+		t.Error("expected an error for an instance without IP addresses for pods")
+	}
+}
+
+func TestEmbeddedLimits(t *testing.T) {
+	t.Parallel()
+
+	types := InstanceTypes()
+	if len(types) == 0 {
+		t.Fatal("expected embedded limits")
+	}
+	for _, instanceType := range types {
+		l, _ := Lookup(instanceType)
+		if l.ENIs < 1 || l.IPv4PerENI < 2 || l.VCPUs < 1 {
+			t.Errorf("invalid limits for %s: %+v", instanceType, l)
+		}
+		if l.Hypervisor != HypervisorNitro && l.Hypervisor != HypervisorXen {
+			t.Errorf("invalid hypervisor for %s: %q", instanceType, l.Hypervisor)
+		}
+	}
+}
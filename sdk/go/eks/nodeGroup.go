@@ -74,8 +74,15 @@ type nodeGroupArgs struct {
 	//
 	// Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
 	AutoScalingGroupTags map[string]string `pulumi:"autoScalingGroupTags"`
+	// Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+	//
+	// For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+	AutoscalerDiscovery *bool `pulumi:"autoscalerDiscovery"`
 	// Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
 	BootstrapExtraArgs *string `pulumi:"bootstrapExtraArgs"`
+	// Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+	// The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+	BottlerocketConfig *BottlerocketConfig `pulumi:"bottlerocketConfig"`
 	// The configuration settings for Bottlerocket OS.
 	// The settings will get merged with the base settings the provider uses to configure Bottlerocket.
 	//
@@ -141,6 +148,11 @@ type nodeGroupArgs struct {
 	MinSize *int `pulumi:"minSize"`
 	// Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
 	NodeAssociatePublicIpAddress *bool `pulumi:"nodeAssociatePublicIpAddress"`
+	// Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+	//
+	// Note: This is only applicable when using AL2023.
+	// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+	NodeConfig *NodeConfig `pulumi:"nodeConfig"`
 	// Public key material for SSH access to worker nodes. See allowed formats at:
 	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
 	// If not provided, no SSH access is enabled on VMs.
@@ -233,8 +245,15 @@ type NodeGroupArgs struct {
 	//
 	// Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
 	AutoScalingGroupTags pulumi.StringMapInput
+	// Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+	//
+	// For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+	AutoscalerDiscovery *bool
 	// Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
 	BootstrapExtraArgs pulumi.StringPtrInput
+	// Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+	// The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+	BottlerocketConfig BottlerocketConfigPtrInput
 	// The configuration settings for Bottlerocket OS.
 	// The settings will get merged with the base settings the provider uses to configure Bottlerocket.
 	//
@@ -300,6 +319,11 @@ type NodeGroupArgs struct {
 	MinSize pulumi.IntPtrInput
 	// Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
 	NodeAssociatePublicIpAddress pulumi.BoolPtrInput
+	// Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+	//
+	// Note: This is only applicable when using AL2023.
+	// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+	NodeConfig NodeConfigPtrInput
 	// Public key material for SSH access to worker nodes. See allowed formats at:
 	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
 	// If not provided, no SSH access is enabled on VMs.
@@ -70,8 +70,15 @@ type nodeGroupV2Args struct {
 	//
 	// Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
 	AutoScalingGroupTags map[string]string `pulumi:"autoScalingGroupTags"`
+	// Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+	//
+	// For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+	AutoscalerDiscovery *bool `pulumi:"autoscalerDiscovery"`
 	// Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
 	BootstrapExtraArgs *string `pulumi:"bootstrapExtraArgs"`
+	// Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+	// The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+	BottlerocketConfig *BottlerocketConfig `pulumi:"bottlerocketConfig"`
 	// The configuration settings for Bottlerocket OS.
 	// The settings will get merged with the base settings the provider uses to configure Bottlerocket.
 	//
@@ -129,6 +136,10 @@ type nodeGroupV2Args struct {
 	InstanceProfileName *string `pulumi:"instanceProfileName"`
 	// The instance type to use for the cluster's nodes. Defaults to "t3.medium".
 	InstanceType *string `pulumi:"instanceType"`
+	// Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+	//
+	// See for more details: https://github.com/aws/aws-node-termination-handler
+	InterruptionHandling *InterruptionHandling `pulumi:"interruptionHandling"`
 	// Name of the key pair to use for SSH access to worker nodes.
 	KeyName *string `pulumi:"keyName"`
 	// Extra args to pass to the Kubelet. Corresponds to the options passed in the `--kubeletExtraArgs` flag to `/etc/eks/bootstrap.sh`. For example, '--port=10251 --address=0.0.0.0'. Note that the `labels` and `taints` properties will be applied to this list (using `--node-labels` and `--register-with-taints` respectively) after to the explicit `kubeletExtraArgs`.
@@ -145,6 +156,11 @@ type nodeGroupV2Args struct {
 	MinSize *int `pulumi:"minSize"`
 	// Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
 	NodeAssociatePublicIpAddress *bool `pulumi:"nodeAssociatePublicIpAddress"`
+	// Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+	//
+	// Note: This is only applicable when using AL2023.
+	// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+	NodeConfig *NodeConfig `pulumi:"nodeConfig"`
 	// Public key material for SSH access to worker nodes. See allowed formats at:
 	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
 	// If not provided, no SSH access is enabled on VMs.
@@ -237,8 +253,15 @@ type NodeGroupV2Args struct {
 	//
 	// Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
 	AutoScalingGroupTags pulumi.StringMapInput
+	// Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+	//
+	// For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+	AutoscalerDiscovery *bool
 	// Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
 	BootstrapExtraArgs pulumi.StringPtrInput
+	// Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+	// The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+	BottlerocketConfig BottlerocketConfigPtrInput
 	// The configuration settings for Bottlerocket OS.
 	// The settings will get merged with the base settings the provider uses to configure Bottlerocket.
 	//
@@ -296,6 +319,10 @@ type NodeGroupV2Args struct {
 	InstanceProfileName pulumi.StringPtrInput
 	// The instance type to use for the cluster's nodes. Defaults to "t3.medium".
 	InstanceType pulumi.StringPtrInput
+	// Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+	//
+	// See for more details: https://github.com/aws/aws-node-termination-handler
+	InterruptionHandling *InterruptionHandlingArgs
 	// Name of the key pair to use for SSH access to worker nodes.
 	KeyName pulumi.StringPtrInput
 	// Extra args to pass to the Kubelet. Corresponds to the options passed in the `--kubeletExtraArgs` flag to `/etc/eks/bootstrap.sh`. For example, '--port=10251 --address=0.0.0.0'. Note that the `labels` and `taints` properties will be applied to this list (using `--node-labels` and `--register-with-taints` respectively) after to the explicit `kubeletExtraArgs`.
@@ -312,6 +339,11 @@ type NodeGroupV2Args struct {
 	MinSize pulumi.IntPtrInput
 	// Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
 	NodeAssociatePublicIpAddress pulumi.BoolPtrInput
+	// Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+	//
+	// Note: This is only applicable when using AL2023.
+	// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+	NodeConfig NodeConfigPtrInput
 	// Public key material for SSH access to worker nodes. See allowed formats at:
 	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
 	// If not provided, no SSH access is enabled on VMs.
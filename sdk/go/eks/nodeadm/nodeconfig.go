@@ -0,0 +1,198 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package nodeadm builds and validates nodeadm `NodeConfig` documents offline.
+//
+// The rendered documents can be passed to the `nodeadmExtraOptions` of node groups with the content type
+// ContentType. See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+package nodeadm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// APIVersion is the API version of the NodeConfig documents.
+	APIVersion = "node.eks.aws/v1alpha1"
+	// Kind is the kind of the NodeConfig documents.
+	Kind = "NodeConfig"
+	// ContentType is the MIME type of NodeConfig parts in the user data.
+	ContentType = "application/node.eks.aws"
+)
+
+// LocalStorageStrategy is how the instance store volumes are set up.
+type LocalStorageStrategy string
+
+const (
+	LocalStorageStrategyRAID0  LocalStorageStrategy = "RAID0"
+	LocalStorageStrategyRAID10 LocalStorageStrategy = "RAID10"
+	LocalStorageStrategyMount  LocalStorageStrategy = "Mount"
+)
+
+// DisabledMount is a directory that is not moved to the instance store volumes.
+type DisabledMount string
+
+const (
+	DisabledMountContainerd DisabledMount = "Containerd"
+	DisabledMountPodLogs    DisabledMount = "PodLogs"
+)
+
+// NodeConfig is a nodeadm configuration document.
+type NodeConfig struct {
+	APIVersion string         `yaml:"apiVersion"`
+	Kind       string         `yaml:"kind"`
+	Spec       NodeConfigSpec `yaml:"spec"`
+}
+
+// NodeConfigSpec is the configuration of a node.
+type NodeConfigSpec struct {
+	// Cluster are the details of the cluster the node joins. The node groups of this package configure them.
+	Cluster *ClusterDetails `yaml:"cluster,omitempty"`
+	// Kubelet is the configuration of the kubelet.
+	Kubelet *KubeletOptions `yaml:"kubelet,omitempty"`
+	// Containerd is the configuration of containerd.
+	Containerd *ContainerdOptions `yaml:"containerd,omitempty"`
+	// Instance is the configuration of the EC2 instance.
+	Instance *InstanceOptions `yaml:"instance,omitempty"`
+}
+
+// ClusterDetails are the details of the cluster a node joins.
+type ClusterDetails struct {
+	Name                 string `yaml:"name,omitempty"`
+	APIServerEndpoint    string `yaml:"apiServerEndpoint,omitempty"`
+	CertificateAuthority string `yaml:"certificateAuthority,omitempty"`
+	CIDR                 string `yaml:"cidr,omitempty"`
+}
+
+// KubeletOptions configure the kubelet.
+type KubeletOptions struct {
+	// Config is merged with the kubelet configuration of nodeadm, e.g. `{"maxPods": 110}`.
+	Config map[string]any `yaml:"config,omitempty"`
+	// Flags are command line flags of the kubelet, e.g. `--node-labels=key=value`.
+	Flags []string `yaml:"flags,omitempty"`
+}
+
+// ContainerdOptions configure containerd.
+type ContainerdOptions struct {
+	// Config is inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+	Config string `yaml:"config,omitempty"`
+	// BaseRuntimeSpec is the OCI runtime specification containers are created with.
+	BaseRuntimeSpec map[string]any `yaml:"baseRuntimeSpec,omitempty"`
+}
+
+// InstanceOptions configure the EC2 instance.
+type InstanceOptions struct {
+	// LocalStorage configures the instance store volumes.
+	LocalStorage *LocalStorageOptions `yaml:"localStorage,omitempty"`
+}
+
+// LocalStorageOptions configure the instance store volumes.
+type LocalStorageOptions struct {
+	Strategy       LocalStorageStrategy `yaml:"strategy"`
+	MountPath      string               `yaml:"mountPath,omitempty"`
+	DisabledMounts []DisabledMount      `yaml:"disabledMounts,omitempty"`
+}
+
+// New returns a NodeConfig document with the given spec.
+func New(spec NodeConfigSpec) NodeConfig {
+	return NodeConfig{APIVersion: APIVersion, Kind: Kind, Spec: spec}
+}
+
+// Validate returns all problems of the NodeConfig, or nil if it is valid.
+func (c NodeConfig) Validate() error {
+	var errs []error
+	if c.APIVersion != APIVersion {
+		errs = append(errs, fmt.Errorf("apiVersion must be %q, got %q", APIVersion, c.APIVersion))
+	}
+	if c.Kind != Kind {
+		errs = append(errs, fmt.Errorf("kind must be %q, got %q", Kind, c.Kind))
+	}
+
+	if cluster := c.Spec.Cluster; cluster != nil && cluster.APIServerEndpoint != "" {
+		if u, err := url.Parse(cluster.APIServerEndpoint); err != nil || u.Scheme != "https" || u.Host == "" {
+			errs = append(errs, fmt.Errorf("spec.cluster.apiServerEndpoint must be an https URL, got %q",
+				cluster.APIServerEndpoint))
+		}
+	}
+
+	if kubelet := c.Spec.Kubelet; kubelet != nil {
+		for i, flag := range kubelet.Flags {
+			if !strings.HasPrefix(flag, "--") {
+				errs = append(errs, fmt.Errorf("spec.kubelet.flags[%d] must start with '--', got %q", i, flag))
+			}
+		}
+	}
+
+	if c.Spec.Instance != nil && c.Spec.Instance.LocalStorage != nil {
+		localStorage := c.Spec.Instance.LocalStorage
+		switch localStorage.Strategy {
+		case LocalStorageStrategyRAID0, LocalStorageStrategyRAID10, LocalStorageStrategyMount:
+		default:
+			errs = append(errs, fmt.Errorf("spec.instance.localStorage.strategy must be one of RAID0, RAID10 "+
+				"or Mount, got %q", localStorage.Strategy))
+		}
+		if localStorage.MountPath != "" && !path.IsAbs(localStorage.MountPath) {
+			errs = append(errs, fmt.Errorf("spec.instance.localStorage.mountPath must be an absolute path, got %q",
+				localStorage.MountPath))
+		}
+		for i, mount := range localStorage.DisabledMounts {
+			if mount != DisabledMountContainerd && mount != DisabledMountPodLogs {
+				errs = append(errs, fmt.Errorf("spec.instance.localStorage.disabledMounts[%d] must be one of "+
+					"Containerd or PodLogs, got %q", i, mount))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Render validates the NodeConfig and returns it as YAML document.
+func (c NodeConfig) Render() (string, error) {
+	if err := c.Validate(); err != nil {
+		return "", fmt.Errorf("invalid NodeConfig: %w", err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(c); err != nil {
+		return "", fmt.Errorf("encoding NodeConfig: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("encoding NodeConfig: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// Parse parses and validates a NodeConfig YAML document. Unknown fields are reported as errors.
+func Parse(data []byte) (NodeConfig, error) {
+	var c NodeConfig
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&c); err != nil {
+		return NodeConfig{}, fmt.Errorf("parsing NodeConfig: %w", err)
+	}
+	if err := c.Validate(); err != nil {
+		return NodeConfig{}, fmt.Errorf("invalid NodeConfig: %w", err)
+	}
+	return c, nil
+}
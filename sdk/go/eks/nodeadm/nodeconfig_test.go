@@ -0,0 +1,111 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodeadm
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	config := New(NodeConfigSpec{
+		Kubelet: &KubeletOptions{
+			Config: map[string]any{"maxPods": 110},
+			Flags:  []string{"--node-labels=team=a"},
+		},
+		Instance: &InstanceOptions{
+			LocalStorage: &LocalStorageOptions{Strategy: LocalStorageStrategyRAID0},
+		},
+	})
+
+	actual, err := config.Render()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `---
+apiVersion: node.eks.aws/v1alpha1
+kind: NodeConfig
+spec:
+  kubelet:
+    config:
+      maxPods: 110
+    flags:
+      - --node-labels=team=a
+  instance:
+    localStorage:
+      strategy: RAID0
+`
+	if actual != expected {
+		t.Errorf("unexpected NodeConfig:\n%s", actual)
+	}
+
+	parsed, err := Parse([]byte(actual))
+	if err != nil {
+		t.Fatalf("failed to parse the rendered NodeConfig: %v", err)
+	}
+	if parsed.Spec.Instance.LocalStorage.Strategy != LocalStorageStrategyRAID0 {
+		t.Errorf("expected the strategy to round trip, got %q", parsed.Spec.Instance.LocalStorage.Strategy)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	config := New(NodeConfigSpec{
+		Cluster: &ClusterDetails{APIServerEndpoint: "example.com"},
+		Kubelet: &KubeletOptions{Flags: []string{"node-labels=team=a"}},
+		Instance: &InstanceOptions{
+			LocalStorage: &LocalStorageOptions{
+				Strategy:       "RAID5",
+				MountPath:      "mnt",
+				DisabledMounts: []DisabledMount{"Logs"},
+			},
+		},
+	})
+
+	err := config.Validate()
+	if err == nil {
+		t.Fatal("expected validation errors")
+	}
+	for _, field := range []string{
+		"spec.cluster.apiServerEndpoint",
+		"spec.kubelet.flags[0]",
+		"spec.instance.localStorage.strategy",
+		"spec.instance.localStorage.mountPath",
+		"spec.instance.localStorage.disabledMounts[0]",
+	} {
+		if !strings.Contains(err.Error(), field) {
+			t.Errorf("expected an error for %s, got %v", field, err)
+		}
+	}
+}
+
+func TestParseUnknownFields(t *testing.T) {
+	t.Parallel()
+
+	_, err := Parse([]byte(`apiVersion: node.eks.aws/v1alpha1
+kind: NodeConfig
+spec:
+  kubelet:
+    flag:
+      - --max-pods=110
+`))
+	if err == nil || !strings.Contains(err.Error(), "flag") {
+		t.Errorf("expected an error for the unknown field, got %v", err)
+	}
+}
@@ -210,6 +210,169 @@ const (
 	AmiType_BottlerocketX86_64Nvidia = AmiType("BOTTLEROCKET_x86_64_NVIDIA")
 )
 
+func (AmiType) ElementType() reflect.Type {
+	return reflect.TypeOf((*AmiType)(nil)).Elem()
+}
+
+func (e AmiType) ToAmiTypeOutput() AmiTypeOutput {
+	return pulumi.ToOutput(e).(AmiTypeOutput)
+}
+
+func (e AmiType) ToAmiTypeOutputWithContext(ctx context.Context) AmiTypeOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(AmiTypeOutput)
+}
+
+func (e AmiType) ToAmiTypePtrOutput() AmiTypePtrOutput {
+	return e.ToAmiTypePtrOutputWithContext(context.Background())
+}
+
+func (e AmiType) ToAmiTypePtrOutputWithContext(ctx context.Context) AmiTypePtrOutput {
+	return AmiType(e).ToAmiTypeOutputWithContext(ctx).ToAmiTypePtrOutputWithContext(ctx)
+}
+
+func (e AmiType) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e AmiType) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e AmiType) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e AmiType) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type AmiTypeOutput struct{ *pulumi.OutputState }
+
+func (AmiTypeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AmiType)(nil)).Elem()
+}
+
+func (o AmiTypeOutput) ToAmiTypeOutput() AmiTypeOutput {
+	return o
+}
+
+func (o AmiTypeOutput) ToAmiTypeOutputWithContext(ctx context.Context) AmiTypeOutput {
+	return o
+}
+
+func (o AmiTypeOutput) ToAmiTypePtrOutput() AmiTypePtrOutput {
+	return o.ToAmiTypePtrOutputWithContext(context.Background())
+}
+
+func (o AmiTypeOutput) ToAmiTypePtrOutputWithContext(ctx context.Context) AmiTypePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v AmiType) *AmiType {
+		return &v
+	}).(AmiTypePtrOutput)
+}
+
+func (o AmiTypeOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o AmiTypeOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e AmiType) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o AmiTypeOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o AmiTypeOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e AmiType) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type AmiTypePtrOutput struct{ *pulumi.OutputState }
+
+func (AmiTypePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**AmiType)(nil)).Elem()
+}
+
+func (o AmiTypePtrOutput) ToAmiTypePtrOutput() AmiTypePtrOutput {
+	return o
+}
+
+func (o AmiTypePtrOutput) ToAmiTypePtrOutputWithContext(ctx context.Context) AmiTypePtrOutput {
+	return o
+}
+
+func (o AmiTypePtrOutput) Elem() AmiTypeOutput {
+	return o.ApplyT(func(v *AmiType) AmiType {
+		if v != nil {
+			return *v
+		}
+		var ret AmiType
+		return ret
+	}).(AmiTypeOutput)
+}
+
+func (o AmiTypePtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o AmiTypePtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *AmiType) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// AmiTypeInput is an input type that accepts values of the AmiType enum
+// A concrete instance of `AmiTypeInput` can be one of the following:
+//
+//	AmiType_AL2023X86_64Standard
+//	AmiTypeAL2023Arm64Standard
+//	AmiType_AL2023X86_64Nvidia
+//	AmiTypeBottlerocketArm64
+//	AmiType_BottlerocketX86_64
+//	AmiTypeBottlerocketArm64Nvidia
+//	AmiType_BottlerocketX86_64Nvidia
+type AmiTypeInput interface {
+	pulumi.Input
+
+	ToAmiTypeOutput() AmiTypeOutput
+	ToAmiTypeOutputWithContext(context.Context) AmiTypeOutput
+}
+
+var amiTypePtrType = reflect.TypeOf((**AmiType)(nil)).Elem()
+
+type AmiTypePtrInput interface {
+	pulumi.Input
+
+	ToAmiTypePtrOutput() AmiTypePtrOutput
+	ToAmiTypePtrOutputWithContext(context.Context) AmiTypePtrOutput
+}
+
+type amiTypePtr string
+
+func AmiTypePtr(v string) AmiTypePtrInput {
+	return (*amiTypePtr)(&v)
+}
+
+func (*amiTypePtr) ElementType() reflect.Type {
+	return amiTypePtrType
+}
+
+func (in *amiTypePtr) ToAmiTypePtrOutput() AmiTypePtrOutput {
+	return pulumi.ToOutput(in).(AmiTypePtrOutput)
+}
+
+func (in *amiTypePtr) ToAmiTypePtrOutputWithContext(ctx context.Context) AmiTypePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(AmiTypePtrOutput)
+}
+
 // The authentication mode of the cluster. Valid values are `CONFIG_MAP`, `API` or `API_AND_CONFIG_MAP`.
 //
 // See for more details:
@@ -241,6 +404,174 @@ const (
 	ClusterNodePoolsGeneralPurpose = ClusterNodePools("general-purpose")
 )
 
+// The exec plugin used by a kubeconfig to retrieve a token for the cluster.
+type KubeconfigTokenTool string
+
+const (
+	// Use `aws eks get-token` of the AWS CLI to retrieve the token.
+	KubeconfigTokenToolAws = KubeconfigTokenTool("aws")
+	// Use `aws-iam-authenticator token` to retrieve the token.
+	KubeconfigTokenToolAwsIamAuthenticator = KubeconfigTokenTool("aws-iam-authenticator")
+)
+
+func (KubeconfigTokenTool) ElementType() reflect.Type {
+	return reflect.TypeOf((*KubeconfigTokenTool)(nil)).Elem()
+}
+
+func (e KubeconfigTokenTool) ToKubeconfigTokenToolOutput() KubeconfigTokenToolOutput {
+	return pulumi.ToOutput(e).(KubeconfigTokenToolOutput)
+}
+
+func (e KubeconfigTokenTool) ToKubeconfigTokenToolOutputWithContext(ctx context.Context) KubeconfigTokenToolOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(KubeconfigTokenToolOutput)
+}
+
+func (e KubeconfigTokenTool) ToKubeconfigTokenToolPtrOutput() KubeconfigTokenToolPtrOutput {
+	return e.ToKubeconfigTokenToolPtrOutputWithContext(context.Background())
+}
+
+func (e KubeconfigTokenTool) ToKubeconfigTokenToolPtrOutputWithContext(ctx context.Context) KubeconfigTokenToolPtrOutput {
+	return KubeconfigTokenTool(e).ToKubeconfigTokenToolOutputWithContext(ctx).ToKubeconfigTokenToolPtrOutputWithContext(ctx)
+}
+
+func (e KubeconfigTokenTool) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e KubeconfigTokenTool) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e KubeconfigTokenTool) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e KubeconfigTokenTool) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type KubeconfigTokenToolOutput struct{ *pulumi.OutputState }
+
+func (KubeconfigTokenToolOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*KubeconfigTokenTool)(nil)).Elem()
+}
+
+func (o KubeconfigTokenToolOutput) ToKubeconfigTokenToolOutput() KubeconfigTokenToolOutput {
+	return o
+}
+
+func (o KubeconfigTokenToolOutput) ToKubeconfigTokenToolOutputWithContext(ctx context.Context) KubeconfigTokenToolOutput {
+	return o
+}
+
+func (o KubeconfigTokenToolOutput) ToKubeconfigTokenToolPtrOutput() KubeconfigTokenToolPtrOutput {
+	return o.ToKubeconfigTokenToolPtrOutputWithContext(context.Background())
+}
+
+func (o KubeconfigTokenToolOutput) ToKubeconfigTokenToolPtrOutputWithContext(ctx context.Context) KubeconfigTokenToolPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v KubeconfigTokenTool) *KubeconfigTokenTool {
+		return &v
+	}).(KubeconfigTokenToolPtrOutput)
+}
+
+func (o KubeconfigTokenToolOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o KubeconfigTokenToolOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e KubeconfigTokenTool) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o KubeconfigTokenToolOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o KubeconfigTokenToolOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e KubeconfigTokenTool) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type KubeconfigTokenToolPtrOutput struct{ *pulumi.OutputState }
+
+func (KubeconfigTokenToolPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**KubeconfigTokenTool)(nil)).Elem()
+}
+
+func (o KubeconfigTokenToolPtrOutput) ToKubeconfigTokenToolPtrOutput() KubeconfigTokenToolPtrOutput {
+	return o
+}
+
+func (o KubeconfigTokenToolPtrOutput) ToKubeconfigTokenToolPtrOutputWithContext(ctx context.Context) KubeconfigTokenToolPtrOutput {
+	return o
+}
+
+func (o KubeconfigTokenToolPtrOutput) Elem() KubeconfigTokenToolOutput {
+	return o.ApplyT(func(v *KubeconfigTokenTool) KubeconfigTokenTool {
+		if v != nil {
+			return *v
+		}
+		var ret KubeconfigTokenTool
+		return ret
+	}).(KubeconfigTokenToolOutput)
+}
+
+func (o KubeconfigTokenToolPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o KubeconfigTokenToolPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *KubeconfigTokenTool) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// KubeconfigTokenToolInput is an input type that accepts values of the KubeconfigTokenTool enum
+// A concrete instance of `KubeconfigTokenToolInput` can be one of the following:
+//
+//	KubeconfigTokenToolAws
+//	KubeconfigTokenToolAwsIamAuthenticator
+type KubeconfigTokenToolInput interface {
+	pulumi.Input
+
+	ToKubeconfigTokenToolOutput() KubeconfigTokenToolOutput
+	ToKubeconfigTokenToolOutputWithContext(context.Context) KubeconfigTokenToolOutput
+}
+
+var kubeconfigTokenToolPtrType = reflect.TypeOf((**KubeconfigTokenTool)(nil)).Elem()
+
+type KubeconfigTokenToolPtrInput interface {
+	pulumi.Input
+
+	ToKubeconfigTokenToolPtrOutput() KubeconfigTokenToolPtrOutput
+	ToKubeconfigTokenToolPtrOutputWithContext(context.Context) KubeconfigTokenToolPtrOutput
+}
+
+type kubeconfigTokenToolPtr string
+
+func KubeconfigTokenToolPtr(v string) KubeconfigTokenToolPtrInput {
+	return (*kubeconfigTokenToolPtr)(&v)
+}
+
+func (*kubeconfigTokenToolPtr) ElementType() reflect.Type {
+	return kubeconfigTokenToolPtrType
+}
+
+func (in *kubeconfigTokenToolPtr) ToKubeconfigTokenToolPtrOutput() KubeconfigTokenToolPtrOutput {
+	return pulumi.ToOutput(in).(KubeconfigTokenToolPtrOutput)
+}
+
+func (in *kubeconfigTokenToolPtr) ToKubeconfigTokenToolPtrOutputWithContext(ctx context.Context) KubeconfigTokenToolPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(KubeconfigTokenToolPtrOutput)
+}
+
 // The type of EKS optimized Operating System to use for node groups.
 //
 // See for more details:
@@ -767,6 +1098,10 @@ func (in *resolveConflictsOnUpdatePtr) ToResolveConflictsOnUpdatePtrOutputWithCo
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*AccessEntryTypeInput)(nil)).Elem(), AccessEntryType("STANDARD"))
 	pulumi.RegisterInputType(reflect.TypeOf((*AccessEntryTypePtrInput)(nil)).Elem(), AccessEntryType("STANDARD"))
+	pulumi.RegisterInputType(reflect.TypeOf((*AmiTypeInput)(nil)).Elem(), AmiType("AL2_x86_64"))
+	pulumi.RegisterInputType(reflect.TypeOf((*AmiTypePtrInput)(nil)).Elem(), AmiType("AL2_x86_64"))
+	pulumi.RegisterInputType(reflect.TypeOf((*KubeconfigTokenToolInput)(nil)).Elem(), KubeconfigTokenTool("aws"))
+	pulumi.RegisterInputType(reflect.TypeOf((*KubeconfigTokenToolPtrInput)(nil)).Elem(), KubeconfigTokenTool("aws"))
 	pulumi.RegisterInputType(reflect.TypeOf((*OperatingSystemInput)(nil)).Elem(), OperatingSystem("AL2"))
 	pulumi.RegisterInputType(reflect.TypeOf((*OperatingSystemPtrInput)(nil)).Elem(), OperatingSystem("AL2"))
 	pulumi.RegisterInputType(reflect.TypeOf((*ResolveConflictsOnCreateInput)(nil)).Elem(), ResolveConflictsOnCreate("NONE"))
@@ -775,6 +1110,10 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*ResolveConflictsOnUpdatePtrInput)(nil)).Elem(), ResolveConflictsOnUpdate("NONE"))
 	pulumi.RegisterOutputType(AccessEntryTypeOutput{})
 	pulumi.RegisterOutputType(AccessEntryTypePtrOutput{})
+	pulumi.RegisterOutputType(AmiTypeOutput{})
+	pulumi.RegisterOutputType(AmiTypePtrOutput{})
+	pulumi.RegisterOutputType(KubeconfigTokenToolOutput{})
+	pulumi.RegisterOutputType(KubeconfigTokenToolPtrOutput{})
 	pulumi.RegisterOutputType(OperatingSystemOutput{})
 	pulumi.RegisterOutputType(OperatingSystemPtrOutput{})
 	pulumi.RegisterOutputType(ResolveConflictsOnCreateOutput{})
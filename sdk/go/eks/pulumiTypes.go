@@ -9,6 +9,7 @@ import (
 
 	"github.com/pulumi/pulumi-aws/sdk/v7/go/aws"
 	"github.com/pulumi/pulumi-aws/sdk/v7/go/aws/autoscaling"
+	"github.com/pulumi/pulumi-aws/sdk/v7/go/aws/cloudwatch"
 	"github.com/pulumi/pulumi-aws/sdk/v7/go/aws/ec2"
 	"github.com/pulumi/pulumi-aws/sdk/v7/go/aws/eks"
 	"github.com/pulumi/pulumi-aws/sdk/v7/go/aws/iam"
@@ -297,587 +298,2743 @@ func (o AccessPolicyAssociationMapOutput) MapIndex(k pulumi.StringInput) AccessP
 	}).(AccessPolicyAssociationOutput)
 }
 
-// Configuration Options for EKS Auto Mode. If EKS Auto Mode is enabled, AWS will manage cluster infrastructure on your behalf.
-//
-// For more information, see: https://docs.aws.amazon.com/eks/latest/userguide/automode.html
-type AutoModeOptions struct {
-	// Compute configuration for EKS Auto Mode.
-	ComputeConfig *ClusterComputeConfig `pulumi:"computeConfig"`
-	// Whether to create an IAM role for the EKS Auto Mode node group if none is provided in `computeConfig`.
-	CreateNodeRole *bool `pulumi:"createNodeRole"`
-	// Whether to enable EKS Auto Mode. If enabled, EKS will manage node pools, EBS volumes and Load Balancers for you.
-	// When enabled, the vpc-cni and kube-proxy will not be enabled by default because EKS Auto Mode includes pod networking capabilities.
-	Enabled bool `pulumi:"enabled"`
-}
-
-// Defaults sets the appropriate defaults for AutoModeOptions
-func (val *AutoModeOptions) Defaults() *AutoModeOptions {
-	if val == nil {
-		return nil
-	}
-	tmp := *val
-	if tmp.CreateNodeRole == nil {
-		createNodeRole_ := true
-		tmp.CreateNodeRole = &createNodeRole_
-	}
-	return &tmp
+// AutoModeDisruption configures how nodes of a node pool are consolidated and replaced.
+type AutoModeDisruption struct {
+	// The disruption budgets of the node pool.
+	Budgets []AutoModeDisruptionBudget `pulumi:"budgets"`
+	// How long to wait after a pod was added to or removed from a node before consolidating it, e.g. `30s`, or `Never`. Defaults to `0s`.
+	ConsolidateAfter *string `pulumi:"consolidateAfter"`
+	// Which nodes are consolidated, `WhenEmptyOrUnderutilized` or `WhenEmpty`. Defaults to `WhenEmptyOrUnderutilized`.
+	ConsolidationPolicy *string `pulumi:"consolidationPolicy"`
 }
 
-// AutoModeOptionsInput is an input type that accepts AutoModeOptionsArgs and AutoModeOptionsOutput values.
-// You can construct a concrete instance of `AutoModeOptionsInput` via:
+// AutoModeDisruptionInput is an input type that accepts AutoModeDisruptionArgs and AutoModeDisruptionOutput values.
+// You can construct a concrete instance of `AutoModeDisruptionInput` via:
 //
-//	AutoModeOptionsArgs{...}
-type AutoModeOptionsInput interface {
+//	AutoModeDisruptionArgs{...}
+type AutoModeDisruptionInput interface {
 	pulumi.Input
 
-	ToAutoModeOptionsOutput() AutoModeOptionsOutput
-	ToAutoModeOptionsOutputWithContext(context.Context) AutoModeOptionsOutput
+	ToAutoModeDisruptionOutput() AutoModeDisruptionOutput
+	ToAutoModeDisruptionOutputWithContext(context.Context) AutoModeDisruptionOutput
 }
 
-// Configuration Options for EKS Auto Mode. If EKS Auto Mode is enabled, AWS will manage cluster infrastructure on your behalf.
-//
-// For more information, see: https://docs.aws.amazon.com/eks/latest/userguide/automode.html
-type AutoModeOptionsArgs struct {
-	// Compute configuration for EKS Auto Mode.
-	ComputeConfig ClusterComputeConfigPtrInput `pulumi:"computeConfig"`
-	// Whether to create an IAM role for the EKS Auto Mode node group if none is provided in `computeConfig`.
-	CreateNodeRole *bool `pulumi:"createNodeRole"`
-	// Whether to enable EKS Auto Mode. If enabled, EKS will manage node pools, EBS volumes and Load Balancers for you.
-	// When enabled, the vpc-cni and kube-proxy will not be enabled by default because EKS Auto Mode includes pod networking capabilities.
-	Enabled bool `pulumi:"enabled"`
+// AutoModeDisruption configures how nodes of a node pool are consolidated and replaced.
+type AutoModeDisruptionArgs struct {
+	// The disruption budgets of the node pool.
+	Budgets AutoModeDisruptionBudgetArrayInput `pulumi:"budgets"`
+	// How long to wait after a pod was added to or removed from a node before consolidating it, e.g. `30s`, or `Never`. Defaults to `0s`.
+	ConsolidateAfter pulumi.StringPtrInput `pulumi:"consolidateAfter"`
+	// Which nodes are consolidated, `WhenEmptyOrUnderutilized` or `WhenEmpty`. Defaults to `WhenEmptyOrUnderutilized`.
+	ConsolidationPolicy pulumi.StringPtrInput `pulumi:"consolidationPolicy"`
 }
 
-// Defaults sets the appropriate defaults for AutoModeOptionsArgs
-func (val *AutoModeOptionsArgs) Defaults() *AutoModeOptionsArgs {
-	if val == nil {
-		return nil
-	}
-	tmp := *val
-	if tmp.CreateNodeRole == nil {
-		createNodeRole_ := true
-		tmp.CreateNodeRole = &createNodeRole_
-	}
-	return &tmp
-}
-func (AutoModeOptionsArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*AutoModeOptions)(nil)).Elem()
+func (AutoModeDisruptionArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AutoModeDisruption)(nil)).Elem()
 }
 
-func (i AutoModeOptionsArgs) ToAutoModeOptionsOutput() AutoModeOptionsOutput {
-	return i.ToAutoModeOptionsOutputWithContext(context.Background())
+func (i AutoModeDisruptionArgs) ToAutoModeDisruptionOutput() AutoModeDisruptionOutput {
+	return i.ToAutoModeDisruptionOutputWithContext(context.Background())
 }
 
-func (i AutoModeOptionsArgs) ToAutoModeOptionsOutputWithContext(ctx context.Context) AutoModeOptionsOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(AutoModeOptionsOutput)
+func (i AutoModeDisruptionArgs) ToAutoModeDisruptionOutputWithContext(ctx context.Context) AutoModeDisruptionOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoModeDisruptionOutput)
 }
 
-func (i AutoModeOptionsArgs) ToAutoModeOptionsPtrOutput() AutoModeOptionsPtrOutput {
-	return i.ToAutoModeOptionsPtrOutputWithContext(context.Background())
+func (i AutoModeDisruptionArgs) ToAutoModeDisruptionPtrOutput() AutoModeDisruptionPtrOutput {
+	return i.ToAutoModeDisruptionPtrOutputWithContext(context.Background())
 }
 
-func (i AutoModeOptionsArgs) ToAutoModeOptionsPtrOutputWithContext(ctx context.Context) AutoModeOptionsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(AutoModeOptionsOutput).ToAutoModeOptionsPtrOutputWithContext(ctx)
+func (i AutoModeDisruptionArgs) ToAutoModeDisruptionPtrOutputWithContext(ctx context.Context) AutoModeDisruptionPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoModeDisruptionOutput).ToAutoModeDisruptionPtrOutputWithContext(ctx)
 }
 
-// AutoModeOptionsPtrInput is an input type that accepts AutoModeOptionsArgs, AutoModeOptionsPtr and AutoModeOptionsPtrOutput values.
-// You can construct a concrete instance of `AutoModeOptionsPtrInput` via:
+// AutoModeDisruptionPtrInput is an input type that accepts AutoModeDisruptionArgs, AutoModeDisruptionPtr and AutoModeDisruptionPtrOutput values.
+// You can construct a concrete instance of `AutoModeDisruptionPtrInput` via:
 //
-//	        AutoModeOptionsArgs{...}
+//	        AutoModeDisruptionArgs{...}
 //
 //	or:
 //
 //	        nil
-type AutoModeOptionsPtrInput interface {
+type AutoModeDisruptionPtrInput interface {
 	pulumi.Input
 
-	ToAutoModeOptionsPtrOutput() AutoModeOptionsPtrOutput
-	ToAutoModeOptionsPtrOutputWithContext(context.Context) AutoModeOptionsPtrOutput
+	ToAutoModeDisruptionPtrOutput() AutoModeDisruptionPtrOutput
+	ToAutoModeDisruptionPtrOutputWithContext(context.Context) AutoModeDisruptionPtrOutput
 }
 
-type autoModeOptionsPtrType AutoModeOptionsArgs
+type autoModeDisruptionPtrType AutoModeDisruptionArgs
 
-func AutoModeOptionsPtr(v *AutoModeOptionsArgs) AutoModeOptionsPtrInput {
-	return (*autoModeOptionsPtrType)(v)
+func AutoModeDisruptionPtr(v *AutoModeDisruptionArgs) AutoModeDisruptionPtrInput {
+	return (*autoModeDisruptionPtrType)(v)
 }
 
-func (*autoModeOptionsPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**AutoModeOptions)(nil)).Elem()
+func (*autoModeDisruptionPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**AutoModeDisruption)(nil)).Elem()
 }
 
-func (i *autoModeOptionsPtrType) ToAutoModeOptionsPtrOutput() AutoModeOptionsPtrOutput {
-	return i.ToAutoModeOptionsPtrOutputWithContext(context.Background())
+func (i *autoModeDisruptionPtrType) ToAutoModeDisruptionPtrOutput() AutoModeDisruptionPtrOutput {
+	return i.ToAutoModeDisruptionPtrOutputWithContext(context.Background())
 }
 
-func (i *autoModeOptionsPtrType) ToAutoModeOptionsPtrOutputWithContext(ctx context.Context) AutoModeOptionsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(AutoModeOptionsPtrOutput)
+func (i *autoModeDisruptionPtrType) ToAutoModeDisruptionPtrOutputWithContext(ctx context.Context) AutoModeDisruptionPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoModeDisruptionPtrOutput)
 }
 
-// Configuration Options for EKS Auto Mode. If EKS Auto Mode is enabled, AWS will manage cluster infrastructure on your behalf.
-//
-// For more information, see: https://docs.aws.amazon.com/eks/latest/userguide/automode.html
-type AutoModeOptionsOutput struct{ *pulumi.OutputState }
+// AutoModeDisruption configures how nodes of a node pool are consolidated and replaced.
+type AutoModeDisruptionOutput struct{ *pulumi.OutputState }
 
-func (AutoModeOptionsOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*AutoModeOptions)(nil)).Elem()
+func (AutoModeDisruptionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AutoModeDisruption)(nil)).Elem()
 }
 
-func (o AutoModeOptionsOutput) ToAutoModeOptionsOutput() AutoModeOptionsOutput {
+func (o AutoModeDisruptionOutput) ToAutoModeDisruptionOutput() AutoModeDisruptionOutput {
 	return o
 }
 
-func (o AutoModeOptionsOutput) ToAutoModeOptionsOutputWithContext(ctx context.Context) AutoModeOptionsOutput {
+func (o AutoModeDisruptionOutput) ToAutoModeDisruptionOutputWithContext(ctx context.Context) AutoModeDisruptionOutput {
 	return o
 }
 
-func (o AutoModeOptionsOutput) ToAutoModeOptionsPtrOutput() AutoModeOptionsPtrOutput {
-	return o.ToAutoModeOptionsPtrOutputWithContext(context.Background())
+func (o AutoModeDisruptionOutput) ToAutoModeDisruptionPtrOutput() AutoModeDisruptionPtrOutput {
+	return o.ToAutoModeDisruptionPtrOutputWithContext(context.Background())
 }
 
-func (o AutoModeOptionsOutput) ToAutoModeOptionsPtrOutputWithContext(ctx context.Context) AutoModeOptionsPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v AutoModeOptions) *AutoModeOptions {
+func (o AutoModeDisruptionOutput) ToAutoModeDisruptionPtrOutputWithContext(ctx context.Context) AutoModeDisruptionPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v AutoModeDisruption) *AutoModeDisruption {
 		return &v
-	}).(AutoModeOptionsPtrOutput)
+	}).(AutoModeDisruptionPtrOutput)
 }
 
-// Compute configuration for EKS Auto Mode.
-func (o AutoModeOptionsOutput) ComputeConfig() ClusterComputeConfigPtrOutput {
-	return o.ApplyT(func(v AutoModeOptions) *ClusterComputeConfig { return v.ComputeConfig }).(ClusterComputeConfigPtrOutput)
+// The disruption budgets of the node pool.
+func (o AutoModeDisruptionOutput) Budgets() AutoModeDisruptionBudgetArrayOutput {
+	return o.ApplyT(func(v AutoModeDisruption) []AutoModeDisruptionBudget { return v.Budgets }).(AutoModeDisruptionBudgetArrayOutput)
 }
 
-// Whether to create an IAM role for the EKS Auto Mode node group if none is provided in `computeConfig`.
-func (o AutoModeOptionsOutput) CreateNodeRole() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v AutoModeOptions) *bool { return v.CreateNodeRole }).(pulumi.BoolPtrOutput)
+// How long to wait after a pod was added to or removed from a node before consolidating it, e.g. `30s`, or `Never`. Defaults to `0s`.
+func (o AutoModeDisruptionOutput) ConsolidateAfter() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AutoModeDisruption) *string { return v.ConsolidateAfter }).(pulumi.StringPtrOutput)
 }
 
-// Whether to enable EKS Auto Mode. If enabled, EKS will manage node pools, EBS volumes and Load Balancers for you.
-// When enabled, the vpc-cni and kube-proxy will not be enabled by default because EKS Auto Mode includes pod networking capabilities.
-func (o AutoModeOptionsOutput) Enabled() pulumi.BoolOutput {
-	return o.ApplyT(func(v AutoModeOptions) bool { return v.Enabled }).(pulumi.BoolOutput)
+// Which nodes are consolidated, `WhenEmptyOrUnderutilized` or `WhenEmpty`. Defaults to `WhenEmptyOrUnderutilized`.
+func (o AutoModeDisruptionOutput) ConsolidationPolicy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AutoModeDisruption) *string { return v.ConsolidationPolicy }).(pulumi.StringPtrOutput)
 }
 
-type AutoModeOptionsPtrOutput struct{ *pulumi.OutputState }
+type AutoModeDisruptionPtrOutput struct{ *pulumi.OutputState }
 
-func (AutoModeOptionsPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**AutoModeOptions)(nil)).Elem()
+func (AutoModeDisruptionPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**AutoModeDisruption)(nil)).Elem()
 }
 
-func (o AutoModeOptionsPtrOutput) ToAutoModeOptionsPtrOutput() AutoModeOptionsPtrOutput {
+func (o AutoModeDisruptionPtrOutput) ToAutoModeDisruptionPtrOutput() AutoModeDisruptionPtrOutput {
 	return o
 }
 
-func (o AutoModeOptionsPtrOutput) ToAutoModeOptionsPtrOutputWithContext(ctx context.Context) AutoModeOptionsPtrOutput {
+func (o AutoModeDisruptionPtrOutput) ToAutoModeDisruptionPtrOutputWithContext(ctx context.Context) AutoModeDisruptionPtrOutput {
 	return o
 }
 
-func (o AutoModeOptionsPtrOutput) Elem() AutoModeOptionsOutput {
-	return o.ApplyT(func(v *AutoModeOptions) AutoModeOptions {
+func (o AutoModeDisruptionPtrOutput) Elem() AutoModeDisruptionOutput {
+	return o.ApplyT(func(v *AutoModeDisruption) AutoModeDisruption {
 		if v != nil {
 			return *v
 		}
-		var ret AutoModeOptions
+		var ret AutoModeDisruption
 		return ret
-	}).(AutoModeOptionsOutput)
+	}).(AutoModeDisruptionOutput)
 }
 
-// Compute configuration for EKS Auto Mode.
-func (o AutoModeOptionsPtrOutput) ComputeConfig() ClusterComputeConfigPtrOutput {
-	return o.ApplyT(func(v *AutoModeOptions) *ClusterComputeConfig {
+// The disruption budgets of the node pool.
+func (o AutoModeDisruptionPtrOutput) Budgets() AutoModeDisruptionBudgetArrayOutput {
+	return o.ApplyT(func(v *AutoModeDisruption) []AutoModeDisruptionBudget {
 		if v == nil {
 			return nil
 		}
-		return v.ComputeConfig
-	}).(ClusterComputeConfigPtrOutput)
+		return v.Budgets
+	}).(AutoModeDisruptionBudgetArrayOutput)
 }
 
-// Whether to create an IAM role for the EKS Auto Mode node group if none is provided in `computeConfig`.
-func (o AutoModeOptionsPtrOutput) CreateNodeRole() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *AutoModeOptions) *bool {
+// How long to wait after a pod was added to or removed from a node before consolidating it, e.g. `30s`, or `Never`. Defaults to `0s`.
+func (o AutoModeDisruptionPtrOutput) ConsolidateAfter() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AutoModeDisruption) *string {
 		if v == nil {
 			return nil
 		}
-		return v.CreateNodeRole
-	}).(pulumi.BoolPtrOutput)
+		return v.ConsolidateAfter
+	}).(pulumi.StringPtrOutput)
 }
 
-// Whether to enable EKS Auto Mode. If enabled, EKS will manage node pools, EBS volumes and Load Balancers for you.
-// When enabled, the vpc-cni and kube-proxy will not be enabled by default because EKS Auto Mode includes pod networking capabilities.
-func (o AutoModeOptionsPtrOutput) Enabled() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *AutoModeOptions) *bool {
+// Which nodes are consolidated, `WhenEmptyOrUnderutilized` or `WhenEmpty`. Defaults to `WhenEmptyOrUnderutilized`.
+func (o AutoModeDisruptionPtrOutput) ConsolidationPolicy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AutoModeDisruption) *string {
 		if v == nil {
 			return nil
 		}
-		return &v.Enabled
-	}).(pulumi.BoolPtrOutput)
+		return v.ConsolidationPolicy
+	}).(pulumi.StringPtrOutput)
 }
 
-// Configuration for the compute capability of your EKS Auto Mode cluster.
-type ClusterComputeConfig struct {
-	// Configuration for node pools that defines the compute resources for your EKS Auto Mode cluster. Valid options are `general-purpose` and `system`.
-	//
-	// By default, the built-in `system` and `general-purpose` nodepools are enabled.
-	NodePools []string `pulumi:"nodePools"`
-	// The ARN of the IAM Role EKS will assign to EC2 Managed Instances in your EKS Auto Mode cluster. This value cannot be changed after the compute capability of EKS Auto Mode is enabled.
-	NodeRoleArn *string `pulumi:"nodeRoleArn"`
+// AutoModeDisruptionBudget limits the number of nodes that may be disrupted at the same time.
+type AutoModeDisruptionBudget struct {
+	// How long the budget is active after each schedule, e.g. `8h`.
+	Duration *string `pulumi:"duration"`
+	// The number or percentage of nodes that may be disrupted, e.g. `10%`. `0` blocks disruptions.
+	Nodes string `pulumi:"nodes"`
+	// The disruption reasons the budget applies to, `Underutilized`, `Empty` or `Drifted`. Defaults to all reasons.
+	Reasons []string `pulumi:"reasons"`
+	// The cron schedule at which the budget becomes active, e.g. `0 9 * * mon-fri`. Requires `duration`.
+	Schedule *string `pulumi:"schedule"`
 }
 
-// ClusterComputeConfigInput is an input type that accepts ClusterComputeConfigArgs and ClusterComputeConfigOutput values.
-// You can construct a concrete instance of `ClusterComputeConfigInput` via:
+// AutoModeDisruptionBudgetInput is an input type that accepts AutoModeDisruptionBudgetArgs and AutoModeDisruptionBudgetOutput values.
+// You can construct a concrete instance of `AutoModeDisruptionBudgetInput` via:
 //
-//	ClusterComputeConfigArgs{...}
-type ClusterComputeConfigInput interface {
+//	AutoModeDisruptionBudgetArgs{...}
+type AutoModeDisruptionBudgetInput interface {
 	pulumi.Input
 
-	ToClusterComputeConfigOutput() ClusterComputeConfigOutput
-	ToClusterComputeConfigOutputWithContext(context.Context) ClusterComputeConfigOutput
+	ToAutoModeDisruptionBudgetOutput() AutoModeDisruptionBudgetOutput
+	ToAutoModeDisruptionBudgetOutputWithContext(context.Context) AutoModeDisruptionBudgetOutput
 }
 
-// Configuration for the compute capability of your EKS Auto Mode cluster.
-type ClusterComputeConfigArgs struct {
-	// Configuration for node pools that defines the compute resources for your EKS Auto Mode cluster. Valid options are `general-purpose` and `system`.
-	//
-	// By default, the built-in `system` and `general-purpose` nodepools are enabled.
-	NodePools pulumi.StringArrayInput `pulumi:"nodePools"`
-	// The ARN of the IAM Role EKS will assign to EC2 Managed Instances in your EKS Auto Mode cluster. This value cannot be changed after the compute capability of EKS Auto Mode is enabled.
-	NodeRoleArn pulumi.StringPtrInput `pulumi:"nodeRoleArn"`
+// AutoModeDisruptionBudget limits the number of nodes that may be disrupted at the same time.
+type AutoModeDisruptionBudgetArgs struct {
+	// How long the budget is active after each schedule, e.g. `8h`.
+	Duration pulumi.StringPtrInput `pulumi:"duration"`
+	// The number or percentage of nodes that may be disrupted, e.g. `10%`. `0` blocks disruptions.
+	Nodes pulumi.StringInput `pulumi:"nodes"`
+	// The disruption reasons the budget applies to, `Underutilized`, `Empty` or `Drifted`. Defaults to all reasons.
+	Reasons pulumi.StringArrayInput `pulumi:"reasons"`
+	// The cron schedule at which the budget becomes active, e.g. `0 9 * * mon-fri`. Requires `duration`.
+	Schedule pulumi.StringPtrInput `pulumi:"schedule"`
 }
 
-func (ClusterComputeConfigArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*ClusterComputeConfig)(nil)).Elem()
+func (AutoModeDisruptionBudgetArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AutoModeDisruptionBudget)(nil)).Elem()
 }
 
-func (i ClusterComputeConfigArgs) ToClusterComputeConfigOutput() ClusterComputeConfigOutput {
-	return i.ToClusterComputeConfigOutputWithContext(context.Background())
+func (i AutoModeDisruptionBudgetArgs) ToAutoModeDisruptionBudgetOutput() AutoModeDisruptionBudgetOutput {
+	return i.ToAutoModeDisruptionBudgetOutputWithContext(context.Background())
 }
 
-func (i ClusterComputeConfigArgs) ToClusterComputeConfigOutputWithContext(ctx context.Context) ClusterComputeConfigOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ClusterComputeConfigOutput)
+func (i AutoModeDisruptionBudgetArgs) ToAutoModeDisruptionBudgetOutputWithContext(ctx context.Context) AutoModeDisruptionBudgetOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoModeDisruptionBudgetOutput)
 }
 
-func (i ClusterComputeConfigArgs) ToClusterComputeConfigPtrOutput() ClusterComputeConfigPtrOutput {
-	return i.ToClusterComputeConfigPtrOutputWithContext(context.Background())
+// AutoModeDisruptionBudgetArrayInput is an input type that accepts AutoModeDisruptionBudgetArray and AutoModeDisruptionBudgetArrayOutput values.
+// You can construct a concrete instance of `AutoModeDisruptionBudgetArrayInput` via:
+//
+//	AutoModeDisruptionBudgetArray{ AutoModeDisruptionBudgetArgs{...} }
+type AutoModeDisruptionBudgetArrayInput interface {
+	pulumi.Input
+
+	ToAutoModeDisruptionBudgetArrayOutput() AutoModeDisruptionBudgetArrayOutput
+	ToAutoModeDisruptionBudgetArrayOutputWithContext(context.Context) AutoModeDisruptionBudgetArrayOutput
 }
 
-func (i ClusterComputeConfigArgs) ToClusterComputeConfigPtrOutputWithContext(ctx context.Context) ClusterComputeConfigPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ClusterComputeConfigOutput).ToClusterComputeConfigPtrOutputWithContext(ctx)
+type AutoModeDisruptionBudgetArray []AutoModeDisruptionBudgetInput
+
+func (AutoModeDisruptionBudgetArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AutoModeDisruptionBudget)(nil)).Elem()
 }
 
-// ClusterComputeConfigPtrInput is an input type that accepts ClusterComputeConfigArgs, ClusterComputeConfigPtr and ClusterComputeConfigPtrOutput values.
-// You can construct a concrete instance of `ClusterComputeConfigPtrInput` via:
-//
-//	        ClusterComputeConfigArgs{...}
-//
-//	or:
-//
-//	        nil
-type ClusterComputeConfigPtrInput interface {
-	pulumi.Input
+func (i AutoModeDisruptionBudgetArray) ToAutoModeDisruptionBudgetArrayOutput() AutoModeDisruptionBudgetArrayOutput {
+	return i.ToAutoModeDisruptionBudgetArrayOutputWithContext(context.Background())
+}
 
-	ToClusterComputeConfigPtrOutput() ClusterComputeConfigPtrOutput
-	ToClusterComputeConfigPtrOutputWithContext(context.Context) ClusterComputeConfigPtrOutput
+func (i AutoModeDisruptionBudgetArray) ToAutoModeDisruptionBudgetArrayOutputWithContext(ctx context.Context) AutoModeDisruptionBudgetArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoModeDisruptionBudgetArrayOutput)
 }
 
-type clusterComputeConfigPtrType ClusterComputeConfigArgs
+// AutoModeDisruptionBudget limits the number of nodes that may be disrupted at the same time.
+type AutoModeDisruptionBudgetOutput struct{ *pulumi.OutputState }
 
-func ClusterComputeConfigPtr(v *ClusterComputeConfigArgs) ClusterComputeConfigPtrInput {
-	return (*clusterComputeConfigPtrType)(v)
+func (AutoModeDisruptionBudgetOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AutoModeDisruptionBudget)(nil)).Elem()
 }
 
-func (*clusterComputeConfigPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**ClusterComputeConfig)(nil)).Elem()
+func (o AutoModeDisruptionBudgetOutput) ToAutoModeDisruptionBudgetOutput() AutoModeDisruptionBudgetOutput {
+	return o
 }
 
-func (i *clusterComputeConfigPtrType) ToClusterComputeConfigPtrOutput() ClusterComputeConfigPtrOutput {
-	return i.ToClusterComputeConfigPtrOutputWithContext(context.Background())
+func (o AutoModeDisruptionBudgetOutput) ToAutoModeDisruptionBudgetOutputWithContext(ctx context.Context) AutoModeDisruptionBudgetOutput {
+	return o
 }
 
-func (i *clusterComputeConfigPtrType) ToClusterComputeConfigPtrOutputWithContext(ctx context.Context) ClusterComputeConfigPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ClusterComputeConfigPtrOutput)
+// How long the budget is active after each schedule, e.g. `8h`.
+func (o AutoModeDisruptionBudgetOutput) Duration() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AutoModeDisruptionBudget) *string { return v.Duration }).(pulumi.StringPtrOutput)
 }
 
-// Configuration for the compute capability of your EKS Auto Mode cluster.
-type ClusterComputeConfigOutput struct{ *pulumi.OutputState }
+// The number or percentage of nodes that may be disrupted, e.g. `10%`. `0` blocks disruptions.
+func (o AutoModeDisruptionBudgetOutput) Nodes() pulumi.StringOutput {
+	return o.ApplyT(func(v AutoModeDisruptionBudget) string { return v.Nodes }).(pulumi.StringOutput)
+}
 
-func (ClusterComputeConfigOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*ClusterComputeConfig)(nil)).Elem()
+// The disruption reasons the budget applies to, `Underutilized`, `Empty` or `Drifted`. Defaults to all reasons.
+func (o AutoModeDisruptionBudgetOutput) Reasons() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AutoModeDisruptionBudget) []string { return v.Reasons }).(pulumi.StringArrayOutput)
 }
 
-func (o ClusterComputeConfigOutput) ToClusterComputeConfigOutput() ClusterComputeConfigOutput {
+// The cron schedule at which the budget becomes active, e.g. `0 9 * * mon-fri`. Requires `duration`.
+func (o AutoModeDisruptionBudgetOutput) Schedule() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AutoModeDisruptionBudget) *string { return v.Schedule }).(pulumi.StringPtrOutput)
+}
+
+type AutoModeDisruptionBudgetArrayOutput struct{ *pulumi.OutputState }
+
+func (AutoModeDisruptionBudgetArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AutoModeDisruptionBudget)(nil)).Elem()
+}
+
+func (o AutoModeDisruptionBudgetArrayOutput) ToAutoModeDisruptionBudgetArrayOutput() AutoModeDisruptionBudgetArrayOutput {
 	return o
 }
 
-func (o ClusterComputeConfigOutput) ToClusterComputeConfigOutputWithContext(ctx context.Context) ClusterComputeConfigOutput {
+func (o AutoModeDisruptionBudgetArrayOutput) ToAutoModeDisruptionBudgetArrayOutputWithContext(ctx context.Context) AutoModeDisruptionBudgetArrayOutput {
 	return o
 }
 
-func (o ClusterComputeConfigOutput) ToClusterComputeConfigPtrOutput() ClusterComputeConfigPtrOutput {
-	return o.ToClusterComputeConfigPtrOutputWithContext(context.Background())
+func (o AutoModeDisruptionBudgetArrayOutput) Index(i pulumi.IntInput) AutoModeDisruptionBudgetOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) AutoModeDisruptionBudget {
+		return vs[0].([]AutoModeDisruptionBudget)[vs[1].(int)]
+	}).(AutoModeDisruptionBudgetOutput)
 }
 
-func (o ClusterComputeConfigOutput) ToClusterComputeConfigPtrOutputWithContext(ctx context.Context) ClusterComputeConfigPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v ClusterComputeConfig) *ClusterComputeConfig {
-		return &v
-	}).(ClusterComputeConfigPtrOutput)
+// AutoModeEphemeralStorage configures the volume that holds the ephemeral storage of the nodes.
+type AutoModeEphemeralStorage struct {
+	// The IOPS of the volume.
+	Iops *int `pulumi:"iops"`
+	// The ARN of the KMS key that encrypts the volume.
+	KmsKeyId *string `pulumi:"kmsKeyId"`
+	// The size of the volume, e.g. `80Gi`.
+	Size *string `pulumi:"size"`
+	// The throughput of the volume in MiB/s.
+	Throughput *int `pulumi:"throughput"`
 }
 
-// Configuration for node pools that defines the compute resources for your EKS Auto Mode cluster. Valid options are `general-purpose` and `system`.
+// AutoModeEphemeralStorageInput is an input type that accepts AutoModeEphemeralStorageArgs and AutoModeEphemeralStorageOutput values.
+// You can construct a concrete instance of `AutoModeEphemeralStorageInput` via:
 //
-// By default, the built-in `system` and `general-purpose` nodepools are enabled.
-func (o ClusterComputeConfigOutput) NodePools() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v ClusterComputeConfig) []string { return v.NodePools }).(pulumi.StringArrayOutput)
+//	AutoModeEphemeralStorageArgs{...}
+type AutoModeEphemeralStorageInput interface {
+	pulumi.Input
+
+	ToAutoModeEphemeralStorageOutput() AutoModeEphemeralStorageOutput
+	ToAutoModeEphemeralStorageOutputWithContext(context.Context) AutoModeEphemeralStorageOutput
 }
 
-// The ARN of the IAM Role EKS will assign to EC2 Managed Instances in your EKS Auto Mode cluster. This value cannot be changed after the compute capability of EKS Auto Mode is enabled.
-func (o ClusterComputeConfigOutput) NodeRoleArn() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ClusterComputeConfig) *string { return v.NodeRoleArn }).(pulumi.StringPtrOutput)
+// AutoModeEphemeralStorage configures the volume that holds the ephemeral storage of the nodes.
+type AutoModeEphemeralStorageArgs struct {
+	// The IOPS of the volume.
+	Iops pulumi.IntPtrInput `pulumi:"iops"`
+	// The ARN of the KMS key that encrypts the volume.
+	KmsKeyId pulumi.StringPtrInput `pulumi:"kmsKeyId"`
+	// The size of the volume, e.g. `80Gi`.
+	Size pulumi.StringPtrInput `pulumi:"size"`
+	// The throughput of the volume in MiB/s.
+	Throughput pulumi.IntPtrInput `pulumi:"throughput"`
 }
 
-type ClusterComputeConfigPtrOutput struct{ *pulumi.OutputState }
+func (AutoModeEphemeralStorageArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AutoModeEphemeralStorage)(nil)).Elem()
+}
 
-func (ClusterComputeConfigPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**ClusterComputeConfig)(nil)).Elem()
+func (i AutoModeEphemeralStorageArgs) ToAutoModeEphemeralStorageOutput() AutoModeEphemeralStorageOutput {
+	return i.ToAutoModeEphemeralStorageOutputWithContext(context.Background())
 }
 
-func (o ClusterComputeConfigPtrOutput) ToClusterComputeConfigPtrOutput() ClusterComputeConfigPtrOutput {
-	return o
+func (i AutoModeEphemeralStorageArgs) ToAutoModeEphemeralStorageOutputWithContext(ctx context.Context) AutoModeEphemeralStorageOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoModeEphemeralStorageOutput)
 }
 
-func (o ClusterComputeConfigPtrOutput) ToClusterComputeConfigPtrOutputWithContext(ctx context.Context) ClusterComputeConfigPtrOutput {
-	return o
+func (i AutoModeEphemeralStorageArgs) ToAutoModeEphemeralStoragePtrOutput() AutoModeEphemeralStoragePtrOutput {
+	return i.ToAutoModeEphemeralStoragePtrOutputWithContext(context.Background())
 }
 
-func (o ClusterComputeConfigPtrOutput) Elem() ClusterComputeConfigOutput {
-	return o.ApplyT(func(v *ClusterComputeConfig) ClusterComputeConfig {
-		if v != nil {
-			return *v
-		}
-		var ret ClusterComputeConfig
-		return ret
-	}).(ClusterComputeConfigOutput)
+func (i AutoModeEphemeralStorageArgs) ToAutoModeEphemeralStoragePtrOutputWithContext(ctx context.Context) AutoModeEphemeralStoragePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoModeEphemeralStorageOutput).ToAutoModeEphemeralStoragePtrOutputWithContext(ctx)
 }
 
-// Configuration for node pools that defines the compute resources for your EKS Auto Mode cluster. Valid options are `general-purpose` and `system`.
+// AutoModeEphemeralStoragePtrInput is an input type that accepts AutoModeEphemeralStorageArgs, AutoModeEphemeralStoragePtr and AutoModeEphemeralStoragePtrOutput values.
+// You can construct a concrete instance of `AutoModeEphemeralStoragePtrInput` via:
 //
-// By default, the built-in `system` and `general-purpose` nodepools are enabled.
-func (o ClusterComputeConfigPtrOutput) NodePools() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *ClusterComputeConfig) []string {
-		if v == nil {
-			return nil
-		}
-		return v.NodePools
-	}).(pulumi.StringArrayOutput)
-}
+//	        AutoModeEphemeralStorageArgs{...}
+//
+//	or:
+//
+//	        nil
+type AutoModeEphemeralStoragePtrInput interface {
+	pulumi.Input
 
-// The ARN of the IAM Role EKS will assign to EC2 Managed Instances in your EKS Auto Mode cluster. This value cannot be changed after the compute capability of EKS Auto Mode is enabled.
-func (o ClusterComputeConfigPtrOutput) NodeRoleArn() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ClusterComputeConfig) *string {
-		if v == nil {
-			return nil
-		}
-		return v.NodeRoleArn
-	}).(pulumi.StringPtrOutput)
+	ToAutoModeEphemeralStoragePtrOutput() AutoModeEphemeralStoragePtrOutput
+	ToAutoModeEphemeralStoragePtrOutputWithContext(context.Context) AutoModeEphemeralStoragePtrOutput
 }
 
-// Describes the configuration options accepted by a cluster to create its own node groups.
-type ClusterNodeGroupOptions struct {
-	// The AMI ID to use for the worker nodes.
-	//
-	// Defaults to the latest recommended EKS Optimized Linux AMI from the AWS Systems Manager Parameter Store.
-	//
-	// Note: `amiId` and `gpu` are mutually exclusive.
-	//
-	// See for more details:
-	// - https://docs.aws.amazon.com/eks/latest/userguide/eks-optimized-ami.html.
-	AmiId *string `pulumi:"amiId"`
-	// The AMI Type to use for the worker nodes.
-	//
-	// Only applicable when setting an AMI ID that is of type `arm64`.
-	//
-	// Note: `amiType` and `gpu` are mutually exclusive.
-	AmiType *string `pulumi:"amiType"`
-	// The tags to apply to the NodeGroup's AutoScalingGroup in the CloudFormation Stack.
-	//
-	// Per AWS, all stack-level tags, including automatically created tags, and the `cloudFormationTags` option are propagated to resources that AWS CloudFormation supports, including the AutoScalingGroup. See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-resource-tags.html
-	//
-	// Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
-	AutoScalingGroupTags map[string]string `pulumi:"autoScalingGroupTags"`
-	// Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
-	BootstrapExtraArgs *string `pulumi:"bootstrapExtraArgs"`
-	// The configuration settings for Bottlerocket OS.
-	// The settings will get merged with the base settings the provider uses to configure Bottlerocket.
-	//
-	// This includes:
-	//   - settings.kubernetes.api-server
-	//   - settings.kubernetes.cluster-certificate
-	//   - settings.kubernetes.cluster-name
-	//   - settings.kubernetes.cluster-dns-ip
-	//
-	// For an overview of the available settings, see https://bottlerocket.dev/en/os/1.20.x/api/settings/.
-	BottlerocketSettings map[string]interface{} `pulumi:"bottlerocketSettings"`
-	// The tags to apply to the CloudFormation Stack of the Worker NodeGroup.
-	//
-	// Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
-	CloudFormationTags map[string]string `pulumi:"cloudFormationTags"`
-	// The ingress rule that gives node group access.
-	ClusterIngressRule *ec2.SecurityGroupRule `pulumi:"clusterIngressRule"`
-	// The ID of the ingress rule that gives node group access.
-	ClusterIngressRuleId *string `pulumi:"clusterIngressRuleId"`
-	// The number of worker nodes that should be running in the cluster. Defaults to 2.
-	DesiredCapacity *int `pulumi:"desiredCapacity"`
-	// Enables/disables detailed monitoring of the EC2 instances.
-	//
-	// With detailed monitoring, all metrics, including status check metrics, are available in 1-minute intervals.
-	// When enabled, you can also get aggregated data across groups of similar instances.
-	//
-	// Note: You are charged per metric that is sent to CloudWatch. You are not charged for data storage.
-	// For more information, see "Paid tier" and "Example 1 - EC2 Detailed Monitoring" here https://aws.amazon.com/cloudwatch/pricing/.
-	EnableDetailedMonitoring *bool `pulumi:"enableDetailedMonitoring"`
-	// Encrypt the root block device of the nodes in the node group.
-	EncryptRootBlockDevice *bool `pulumi:"encryptRootBlockDevice"`
-	// Extra security groups to attach on all nodes in this worker node group.
-	//
-	// This additional set of security groups captures any user application rules that will be needed for the nodes.
-	ExtraNodeSecurityGroups []*ec2.SecurityGroup `pulumi:"extraNodeSecurityGroups"`
-	// Use the latest recommended EKS Optimized Linux AMI with GPU support for the worker nodes from the AWS Systems Manager Parameter Store.
-	//
-	// Defaults to false.
-	//
-	// Note: `gpu` and `amiId` are mutually exclusive.
-	//
-	// See for more details:
-	// - https://docs.aws.amazon.com/eks/latest/userguide/eks-optimized-ami.html
-	// - https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
-	Gpu *bool `pulumi:"gpu"`
-	// Whether to ignore changes to the desired size of the Auto Scaling Group. This is useful when using Cluster Autoscaler.
-	//
-	// See [EKS best practices](https://aws.github.io/aws-eks-best-practices/cluster-autoscaling/) for more details.
-	IgnoreScalingChanges *bool `pulumi:"ignoreScalingChanges"`
-	// The IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
-	InstanceProfile *iam.InstanceProfile `pulumi:"instanceProfile"`
-	// The name of the IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
-	InstanceProfileName *string `pulumi:"instanceProfileName"`
-	// The instance type to use for the cluster's nodes. Defaults to "t3.medium".
-	InstanceType *string `pulumi:"instanceType"`
-	// Name of the key pair to use for SSH access to worker nodes.
-	KeyName *string `pulumi:"keyName"`
-	// Extra args to pass to the Kubelet. Corresponds to the options passed in the `--kubeletExtraArgs` flag to `/etc/eks/bootstrap.sh`. For example, '--port=10251 --address=0.0.0.0'. Note that the `labels` and `taints` properties will be applied to this list (using `--node-labels` and `--register-with-taints` respectively) after to the explicit `kubeletExtraArgs`.
-	KubeletExtraArgs *string `pulumi:"kubeletExtraArgs"`
-	// Custom k8s node labels to be attached to each worker node. Adds the given key/value pairs to the `--node-labels` kubelet argument.
-	Labels map[string]string `pulumi:"labels"`
-	// The tag specifications to apply to the launch template.
-	LaunchTemplateTagSpecifications []ec2.LaunchTemplateTagSpecification `pulumi:"launchTemplateTagSpecifications"`
-	// The maximum number of worker nodes running in the cluster. Defaults to 2.
-	MaxSize *int `pulumi:"maxSize"`
-	// The minimum amount of instances that should remain available during an instance refresh, expressed as a percentage. Defaults to 50.
-	MinRefreshPercentage *int `pulumi:"minRefreshPercentage"`
-	// The minimum number of worker nodes running in the cluster. Defaults to 1.
-	MinSize *int `pulumi:"minSize"`
-	// Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
-	NodeAssociatePublicIpAddress *bool `pulumi:"nodeAssociatePublicIpAddress"`
-	// Public key material for SSH access to worker nodes. See allowed formats at:
-	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
-	// If not provided, no SSH access is enabled on VMs.
-	NodePublicKey *string `pulumi:"nodePublicKey"`
-	// Whether the root block device should be deleted on termination of the instance. Defaults to true.
-	NodeRootVolumeDeleteOnTermination *bool `pulumi:"nodeRootVolumeDeleteOnTermination"`
-	// Whether to encrypt a cluster node's root volume. Defaults to false.
-	NodeRootVolumeEncrypted *bool `pulumi:"nodeRootVolumeEncrypted"`
-	// The amount of provisioned IOPS. This is only valid with a volumeType of 'io1'.
-	NodeRootVolumeIops *int `pulumi:"nodeRootVolumeIops"`
-	// The size in GiB of a cluster node's root volume. Defaults to 20.
-	NodeRootVolumeSize *int `pulumi:"nodeRootVolumeSize"`
-	// Provisioned throughput performance in integer MiB/s for a cluster node's root volume. This is only valid with a volumeType of 'gp3'.
-	NodeRootVolumeThroughput *int `pulumi:"nodeRootVolumeThroughput"`
-	// Configured EBS type for a cluster node's root volume. Default is 'gp2'. Supported values are 'standard', 'gp2', 'gp3', 'st1', 'sc1', 'io1'.
-	NodeRootVolumeType *string `pulumi:"nodeRootVolumeType"`
-	// The security group for the worker node group to communicate with the cluster.
-	//
-	// This security group requires specific inbound and outbound rules.
-	//
-	// See for more details:
-	// https://docs.aws.amazon.com/eks/latest/userguide/sec-group-reqs.html
-	//
-	// Note: The `nodeSecurityGroup` option and the cluster option`nodeSecurityGroupTags` are mutually exclusive.
-	NodeSecurityGroup *ec2.SecurityGroup `pulumi:"nodeSecurityGroup"`
-	// The ID of the security group for the worker node group to communicate with the cluster.
-	//
-	// This security group requires specific inbound and outbound rules.
-	//
-	// See for more details:
-	// https://docs.aws.amazon.com/eks/latest/userguide/sec-group-reqs.html
-	//
-	// Note: The `nodeSecurityGroupId` option and the cluster option `nodeSecurityGroupTags` are mutually exclusive.
-	NodeSecurityGroupId *string `pulumi:"nodeSecurityGroupId"`
-	// The set of subnets to override and use for the worker node group.
-	//
-	// Setting this option overrides which subnets to use for the worker node group, regardless if the cluster's `subnetIds` is set, or if `publicSubnetIds` and/or `privateSubnetIds` were set.
-	NodeSubnetIds []string `pulumi:"nodeSubnetIds"`
-	// Extra code to run on node startup. This code will run after the AWS EKS bootstrapping code and before the node signals its readiness to the managing CloudFormation stack. This code must be a typical user data script: critically it must begin with an interpreter directive (i.e. a `#!`).
-	NodeUserData *string `pulumi:"nodeUserData"`
-	// User specified code to run on node startup. This code is expected to handle the full AWS EKS bootstrapping code and signal node readiness to the managing CloudFormation stack. This code must be a complete and executable user data script in bash (Linux) or powershell (Windows).
-	//
-	// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/worker.html
-	NodeUserDataOverride *string `pulumi:"nodeUserDataOverride"`
-	// Extra nodeadm configuration sections to be added to the nodeadm user data. This can be shell scripts, nodeadm NodeConfig or any other user data compatible script. When configuring additional nodeadm NodeConfig sections, they'll be merged with the base settings the provider sets. You can overwrite base settings or provide additional settings this way.
-	// The base settings the provider sets are:
-	//   - cluster.name
-	//   - cluster.apiServerEndpoint
-	//   - cluster.certificateAuthority
-	//   - cluster.cidr
-	//
-	// Note: This is only applicable when using AL2023.
-	// See for more details:
-	//   - https://awslabs.github.io/amazon-eks-ami/nodeadm/
-	//   - https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
-	NodeadmExtraOptions []NodeadmOptions `pulumi:"nodeadmExtraOptions"`
-	// The type of OS to use for the node group. Will be used to determine the right EKS optimized AMI to use based on the instance types and gpu configuration.
-	// Valid values are `RECOMMENDED`, `AL2`, `AL2023` and `Bottlerocket`.
-	//
-	// Defaults to the current recommended OS.
-	OperatingSystem *OperatingSystem `pulumi:"operatingSystem"`
-	// Bidding price for spot instance. If set, only spot instances will be added as worker node.
-	SpotPrice *string `pulumi:"spotPrice"`
-	// Custom k8s node taints to be attached to each worker node. Adds the given taints to the `--register-with-taints` kubelet argument
-	Taints map[string]Taint `pulumi:"taints"`
-	// Desired Kubernetes master / control plane version. If you do not specify a value, the latest available version is used.
-	Version *string `pulumi:"version"`
+type autoModeEphemeralStoragePtrType AutoModeEphemeralStorageArgs
+
+func AutoModeEphemeralStoragePtr(v *AutoModeEphemeralStorageArgs) AutoModeEphemeralStoragePtrInput {
+	return (*autoModeEphemeralStoragePtrType)(v)
 }
 
-// ClusterNodeGroupOptionsInput is an input type that accepts ClusterNodeGroupOptionsArgs and ClusterNodeGroupOptionsOutput values.
-// You can construct a concrete instance of `ClusterNodeGroupOptionsInput` via:
+func (*autoModeEphemeralStoragePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**AutoModeEphemeralStorage)(nil)).Elem()
+}
+
+func (i *autoModeEphemeralStoragePtrType) ToAutoModeEphemeralStoragePtrOutput() AutoModeEphemeralStoragePtrOutput {
+	return i.ToAutoModeEphemeralStoragePtrOutputWithContext(context.Background())
+}
+
+func (i *autoModeEphemeralStoragePtrType) ToAutoModeEphemeralStoragePtrOutputWithContext(ctx context.Context) AutoModeEphemeralStoragePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoModeEphemeralStoragePtrOutput)
+}
+
+// AutoModeEphemeralStorage configures the volume that holds the ephemeral storage of the nodes.
+type AutoModeEphemeralStorageOutput struct{ *pulumi.OutputState }
+
+func (AutoModeEphemeralStorageOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AutoModeEphemeralStorage)(nil)).Elem()
+}
+
+func (o AutoModeEphemeralStorageOutput) ToAutoModeEphemeralStorageOutput() AutoModeEphemeralStorageOutput {
+	return o
+}
+
+func (o AutoModeEphemeralStorageOutput) ToAutoModeEphemeralStorageOutputWithContext(ctx context.Context) AutoModeEphemeralStorageOutput {
+	return o
+}
+
+func (o AutoModeEphemeralStorageOutput) ToAutoModeEphemeralStoragePtrOutput() AutoModeEphemeralStoragePtrOutput {
+	return o.ToAutoModeEphemeralStoragePtrOutputWithContext(context.Background())
+}
+
+func (o AutoModeEphemeralStorageOutput) ToAutoModeEphemeralStoragePtrOutputWithContext(ctx context.Context) AutoModeEphemeralStoragePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v AutoModeEphemeralStorage) *AutoModeEphemeralStorage {
+		return &v
+	}).(AutoModeEphemeralStoragePtrOutput)
+}
+
+// The IOPS of the volume.
+func (o AutoModeEphemeralStorageOutput) Iops() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v AutoModeEphemeralStorage) *int { return v.Iops }).(pulumi.IntPtrOutput)
+}
+
+// The ARN of the KMS key that encrypts the volume.
+func (o AutoModeEphemeralStorageOutput) KmsKeyId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AutoModeEphemeralStorage) *string { return v.KmsKeyId }).(pulumi.StringPtrOutput)
+}
+
+// The size of the volume, e.g. `80Gi`.
+func (o AutoModeEphemeralStorageOutput) Size() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AutoModeEphemeralStorage) *string { return v.Size }).(pulumi.StringPtrOutput)
+}
+
+// The throughput of the volume in MiB/s.
+func (o AutoModeEphemeralStorageOutput) Throughput() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v AutoModeEphemeralStorage) *int { return v.Throughput }).(pulumi.IntPtrOutput)
+}
+
+type AutoModeEphemeralStoragePtrOutput struct{ *pulumi.OutputState }
+
+func (AutoModeEphemeralStoragePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**AutoModeEphemeralStorage)(nil)).Elem()
+}
+
+func (o AutoModeEphemeralStoragePtrOutput) ToAutoModeEphemeralStoragePtrOutput() AutoModeEphemeralStoragePtrOutput {
+	return o
+}
+
+func (o AutoModeEphemeralStoragePtrOutput) ToAutoModeEphemeralStoragePtrOutputWithContext(ctx context.Context) AutoModeEphemeralStoragePtrOutput {
+	return o
+}
+
+func (o AutoModeEphemeralStoragePtrOutput) Elem() AutoModeEphemeralStorageOutput {
+	return o.ApplyT(func(v *AutoModeEphemeralStorage) AutoModeEphemeralStorage {
+		if v != nil {
+			return *v
+		}
+		var ret AutoModeEphemeralStorage
+		return ret
+	}).(AutoModeEphemeralStorageOutput)
+}
+
+// The IOPS of the volume.
+func (o AutoModeEphemeralStoragePtrOutput) Iops() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *AutoModeEphemeralStorage) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Iops
+	}).(pulumi.IntPtrOutput)
+}
+
+// The ARN of the KMS key that encrypts the volume.
+func (o AutoModeEphemeralStoragePtrOutput) KmsKeyId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AutoModeEphemeralStorage) *string {
+		if v == nil {
+			return nil
+		}
+		return v.KmsKeyId
+	}).(pulumi.StringPtrOutput)
+}
+
+// The size of the volume, e.g. `80Gi`.
+func (o AutoModeEphemeralStoragePtrOutput) Size() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AutoModeEphemeralStorage) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Size
+	}).(pulumi.StringPtrOutput)
+}
+
+// The throughput of the volume in MiB/s.
+func (o AutoModeEphemeralStoragePtrOutput) Throughput() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *AutoModeEphemeralStorage) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Throughput
+	}).(pulumi.IntPtrOutput)
+}
+
+// AutoModeNodeRequirement restricts the instances of a node pool by a well-known label, e.g. `eks.amazonaws.com/instance-category` or `karpenter.sh/capacity-type`.
+type AutoModeNodeRequirement struct {
+	// The label key.
+	Key string `pulumi:"key"`
+	// The minimum number of distinct values the node pool has to be able to choose from.
+	MinValues *int `pulumi:"minValues"`
+	// The operator, one of `In`, `NotIn`, `Exists`, `DoesNotExist`, `Gt` and `Lt`.
+	Operator string `pulumi:"operator"`
+	// The label values.
+	Values []string `pulumi:"values"`
+}
+
+// AutoModeNodeRequirementInput is an input type that accepts AutoModeNodeRequirementArgs and AutoModeNodeRequirementOutput values.
+// You can construct a concrete instance of `AutoModeNodeRequirementInput` via:
 //
-//	ClusterNodeGroupOptionsArgs{...}
-type ClusterNodeGroupOptionsInput interface {
+//	AutoModeNodeRequirementArgs{...}
+type AutoModeNodeRequirementInput interface {
 	pulumi.Input
 
-	ToClusterNodeGroupOptionsOutput() ClusterNodeGroupOptionsOutput
-	ToClusterNodeGroupOptionsOutputWithContext(context.Context) ClusterNodeGroupOptionsOutput
+	ToAutoModeNodeRequirementOutput() AutoModeNodeRequirementOutput
+	ToAutoModeNodeRequirementOutputWithContext(context.Context) AutoModeNodeRequirementOutput
 }
 
-// Describes the configuration options accepted by a cluster to create its own node groups.
-type ClusterNodeGroupOptionsArgs struct {
-	// The AMI ID to use for the worker nodes.
-	//
-	// Defaults to the latest recommended EKS Optimized Linux AMI from the AWS Systems Manager Parameter Store.
-	//
-	// Note: `amiId` and `gpu` are mutually exclusive.
-	//
-	// See for more details:
-	// - https://docs.aws.amazon.com/eks/latest/userguide/eks-optimized-ami.html.
-	AmiId pulumi.StringPtrInput `pulumi:"amiId"`
-	// The AMI Type to use for the worker nodes.
-	//
-	// Only applicable when setting an AMI ID that is of type `arm64`.
-	//
-	// Note: `amiType` and `gpu` are mutually exclusive.
-	AmiType pulumi.StringPtrInput `pulumi:"amiType"`
+// AutoModeNodeRequirement restricts the instances of a node pool by a well-known label, e.g. `eks.amazonaws.com/instance-category` or `karpenter.sh/capacity-type`.
+type AutoModeNodeRequirementArgs struct {
+	// The label key.
+	Key pulumi.StringInput `pulumi:"key"`
+	// The minimum number of distinct values the node pool has to be able to choose from.
+	MinValues pulumi.IntPtrInput `pulumi:"minValues"`
+	// The operator, one of `In`, `NotIn`, `Exists`, `DoesNotExist`, `Gt` and `Lt`.
+	Operator pulumi.StringInput `pulumi:"operator"`
+	// The label values.
+	Values pulumi.StringArrayInput `pulumi:"values"`
+}
+
+func (AutoModeNodeRequirementArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AutoModeNodeRequirement)(nil)).Elem()
+}
+
+func (i AutoModeNodeRequirementArgs) ToAutoModeNodeRequirementOutput() AutoModeNodeRequirementOutput {
+	return i.ToAutoModeNodeRequirementOutputWithContext(context.Background())
+}
+
+func (i AutoModeNodeRequirementArgs) ToAutoModeNodeRequirementOutputWithContext(ctx context.Context) AutoModeNodeRequirementOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoModeNodeRequirementOutput)
+}
+
+// AutoModeNodeRequirementArrayInput is an input type that accepts AutoModeNodeRequirementArray and AutoModeNodeRequirementArrayOutput values.
+// You can construct a concrete instance of `AutoModeNodeRequirementArrayInput` via:
+//
+//	AutoModeNodeRequirementArray{ AutoModeNodeRequirementArgs{...} }
+type AutoModeNodeRequirementArrayInput interface {
+	pulumi.Input
+
+	ToAutoModeNodeRequirementArrayOutput() AutoModeNodeRequirementArrayOutput
+	ToAutoModeNodeRequirementArrayOutputWithContext(context.Context) AutoModeNodeRequirementArrayOutput
+}
+
+type AutoModeNodeRequirementArray []AutoModeNodeRequirementInput
+
+func (AutoModeNodeRequirementArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AutoModeNodeRequirement)(nil)).Elem()
+}
+
+func (i AutoModeNodeRequirementArray) ToAutoModeNodeRequirementArrayOutput() AutoModeNodeRequirementArrayOutput {
+	return i.ToAutoModeNodeRequirementArrayOutputWithContext(context.Background())
+}
+
+func (i AutoModeNodeRequirementArray) ToAutoModeNodeRequirementArrayOutputWithContext(ctx context.Context) AutoModeNodeRequirementArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoModeNodeRequirementArrayOutput)
+}
+
+// AutoModeNodeRequirement restricts the instances of a node pool by a well-known label, e.g. `eks.amazonaws.com/instance-category` or `karpenter.sh/capacity-type`.
+type AutoModeNodeRequirementOutput struct{ *pulumi.OutputState }
+
+func (AutoModeNodeRequirementOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AutoModeNodeRequirement)(nil)).Elem()
+}
+
+func (o AutoModeNodeRequirementOutput) ToAutoModeNodeRequirementOutput() AutoModeNodeRequirementOutput {
+	return o
+}
+
+func (o AutoModeNodeRequirementOutput) ToAutoModeNodeRequirementOutputWithContext(ctx context.Context) AutoModeNodeRequirementOutput {
+	return o
+}
+
+// The label key.
+func (o AutoModeNodeRequirementOutput) Key() pulumi.StringOutput {
+	return o.ApplyT(func(v AutoModeNodeRequirement) string { return v.Key }).(pulumi.StringOutput)
+}
+
+// The minimum number of distinct values the node pool has to be able to choose from.
+func (o AutoModeNodeRequirementOutput) MinValues() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v AutoModeNodeRequirement) *int { return v.MinValues }).(pulumi.IntPtrOutput)
+}
+
+// The operator, one of `In`, `NotIn`, `Exists`, `DoesNotExist`, `Gt` and `Lt`.
+func (o AutoModeNodeRequirementOutput) Operator() pulumi.StringOutput {
+	return o.ApplyT(func(v AutoModeNodeRequirement) string { return v.Operator }).(pulumi.StringOutput)
+}
+
+// The label values.
+func (o AutoModeNodeRequirementOutput) Values() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AutoModeNodeRequirement) []string { return v.Values }).(pulumi.StringArrayOutput)
+}
+
+type AutoModeNodeRequirementArrayOutput struct{ *pulumi.OutputState }
+
+func (AutoModeNodeRequirementArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AutoModeNodeRequirement)(nil)).Elem()
+}
+
+func (o AutoModeNodeRequirementArrayOutput) ToAutoModeNodeRequirementArrayOutput() AutoModeNodeRequirementArrayOutput {
+	return o
+}
+
+func (o AutoModeNodeRequirementArrayOutput) ToAutoModeNodeRequirementArrayOutputWithContext(ctx context.Context) AutoModeNodeRequirementArrayOutput {
+	return o
+}
+
+func (o AutoModeNodeRequirementArrayOutput) Index(i pulumi.IntInput) AutoModeNodeRequirementOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) AutoModeNodeRequirement {
+		return vs[0].([]AutoModeNodeRequirement)[vs[1].(int)]
+	}).(AutoModeNodeRequirementOutput)
+}
+
+// Configuration Options for EKS Auto Mode. If EKS Auto Mode is enabled, AWS will manage cluster infrastructure on your behalf.
+//
+// For more information, see: https://docs.aws.amazon.com/eks/latest/userguide/automode.html
+type AutoModeOptions struct {
+	// Compute configuration for EKS Auto Mode.
+	ComputeConfig *ClusterComputeConfig `pulumi:"computeConfig"`
+	// Whether to create an IAM role for the EKS Auto Mode node group if none is provided in `computeConfig`.
+	CreateNodeRole *bool `pulumi:"createNodeRole"`
+	// Whether to enable EKS Auto Mode. If enabled, EKS will manage node pools, EBS volumes and Load Balancers for you.
+	// When enabled, the vpc-cni and kube-proxy will not be enabled by default because EKS Auto Mode includes pod networking capabilities.
+	Enabled bool `pulumi:"enabled"`
+}
+
+// Defaults sets the appropriate defaults for AutoModeOptions
+func (val *AutoModeOptions) Defaults() *AutoModeOptions {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.CreateNodeRole == nil {
+		createNodeRole_ := true
+		tmp.CreateNodeRole = &createNodeRole_
+	}
+	return &tmp
+}
+
+// AutoModeOptionsInput is an input type that accepts AutoModeOptionsArgs and AutoModeOptionsOutput values.
+// You can construct a concrete instance of `AutoModeOptionsInput` via:
+//
+//	AutoModeOptionsArgs{...}
+type AutoModeOptionsInput interface {
+	pulumi.Input
+
+	ToAutoModeOptionsOutput() AutoModeOptionsOutput
+	ToAutoModeOptionsOutputWithContext(context.Context) AutoModeOptionsOutput
+}
+
+// Configuration Options for EKS Auto Mode. If EKS Auto Mode is enabled, AWS will manage cluster infrastructure on your behalf.
+//
+// For more information, see: https://docs.aws.amazon.com/eks/latest/userguide/automode.html
+type AutoModeOptionsArgs struct {
+	// Compute configuration for EKS Auto Mode.
+	ComputeConfig ClusterComputeConfigPtrInput `pulumi:"computeConfig"`
+	// Whether to create an IAM role for the EKS Auto Mode node group if none is provided in `computeConfig`.
+	CreateNodeRole *bool `pulumi:"createNodeRole"`
+	// Whether to enable EKS Auto Mode. If enabled, EKS will manage node pools, EBS volumes and Load Balancers for you.
+	// When enabled, the vpc-cni and kube-proxy will not be enabled by default because EKS Auto Mode includes pod networking capabilities.
+	Enabled bool `pulumi:"enabled"`
+}
+
+// Defaults sets the appropriate defaults for AutoModeOptionsArgs
+func (val *AutoModeOptionsArgs) Defaults() *AutoModeOptionsArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.CreateNodeRole == nil {
+		createNodeRole_ := true
+		tmp.CreateNodeRole = &createNodeRole_
+	}
+	return &tmp
+}
+func (AutoModeOptionsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AutoModeOptions)(nil)).Elem()
+}
+
+func (i AutoModeOptionsArgs) ToAutoModeOptionsOutput() AutoModeOptionsOutput {
+	return i.ToAutoModeOptionsOutputWithContext(context.Background())
+}
+
+func (i AutoModeOptionsArgs) ToAutoModeOptionsOutputWithContext(ctx context.Context) AutoModeOptionsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoModeOptionsOutput)
+}
+
+func (i AutoModeOptionsArgs) ToAutoModeOptionsPtrOutput() AutoModeOptionsPtrOutput {
+	return i.ToAutoModeOptionsPtrOutputWithContext(context.Background())
+}
+
+func (i AutoModeOptionsArgs) ToAutoModeOptionsPtrOutputWithContext(ctx context.Context) AutoModeOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoModeOptionsOutput).ToAutoModeOptionsPtrOutputWithContext(ctx)
+}
+
+// AutoModeOptionsPtrInput is an input type that accepts AutoModeOptionsArgs, AutoModeOptionsPtr and AutoModeOptionsPtrOutput values.
+// You can construct a concrete instance of `AutoModeOptionsPtrInput` via:
+//
+//	        AutoModeOptionsArgs{...}
+//
+//	or:
+//
+//	        nil
+type AutoModeOptionsPtrInput interface {
+	pulumi.Input
+
+	ToAutoModeOptionsPtrOutput() AutoModeOptionsPtrOutput
+	ToAutoModeOptionsPtrOutputWithContext(context.Context) AutoModeOptionsPtrOutput
+}
+
+type autoModeOptionsPtrType AutoModeOptionsArgs
+
+func AutoModeOptionsPtr(v *AutoModeOptionsArgs) AutoModeOptionsPtrInput {
+	return (*autoModeOptionsPtrType)(v)
+}
+
+func (*autoModeOptionsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**AutoModeOptions)(nil)).Elem()
+}
+
+func (i *autoModeOptionsPtrType) ToAutoModeOptionsPtrOutput() AutoModeOptionsPtrOutput {
+	return i.ToAutoModeOptionsPtrOutputWithContext(context.Background())
+}
+
+func (i *autoModeOptionsPtrType) ToAutoModeOptionsPtrOutputWithContext(ctx context.Context) AutoModeOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoModeOptionsPtrOutput)
+}
+
+// Configuration Options for EKS Auto Mode. If EKS Auto Mode is enabled, AWS will manage cluster infrastructure on your behalf.
+//
+// For more information, see: https://docs.aws.amazon.com/eks/latest/userguide/automode.html
+type AutoModeOptionsOutput struct{ *pulumi.OutputState }
+
+func (AutoModeOptionsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AutoModeOptions)(nil)).Elem()
+}
+
+func (o AutoModeOptionsOutput) ToAutoModeOptionsOutput() AutoModeOptionsOutput {
+	return o
+}
+
+func (o AutoModeOptionsOutput) ToAutoModeOptionsOutputWithContext(ctx context.Context) AutoModeOptionsOutput {
+	return o
+}
+
+func (o AutoModeOptionsOutput) ToAutoModeOptionsPtrOutput() AutoModeOptionsPtrOutput {
+	return o.ToAutoModeOptionsPtrOutputWithContext(context.Background())
+}
+
+func (o AutoModeOptionsOutput) ToAutoModeOptionsPtrOutputWithContext(ctx context.Context) AutoModeOptionsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v AutoModeOptions) *AutoModeOptions {
+		return &v
+	}).(AutoModeOptionsPtrOutput)
+}
+
+// Compute configuration for EKS Auto Mode.
+func (o AutoModeOptionsOutput) ComputeConfig() ClusterComputeConfigPtrOutput {
+	return o.ApplyT(func(v AutoModeOptions) *ClusterComputeConfig { return v.ComputeConfig }).(ClusterComputeConfigPtrOutput)
+}
+
+// Whether to create an IAM role for the EKS Auto Mode node group if none is provided in `computeConfig`.
+func (o AutoModeOptionsOutput) CreateNodeRole() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v AutoModeOptions) *bool { return v.CreateNodeRole }).(pulumi.BoolPtrOutput)
+}
+
+// Whether to enable EKS Auto Mode. If enabled, EKS will manage node pools, EBS volumes and Load Balancers for you.
+// When enabled, the vpc-cni and kube-proxy will not be enabled by default because EKS Auto Mode includes pod networking capabilities.
+func (o AutoModeOptionsOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v AutoModeOptions) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+type AutoModeOptionsPtrOutput struct{ *pulumi.OutputState }
+
+func (AutoModeOptionsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**AutoModeOptions)(nil)).Elem()
+}
+
+func (o AutoModeOptionsPtrOutput) ToAutoModeOptionsPtrOutput() AutoModeOptionsPtrOutput {
+	return o
+}
+
+func (o AutoModeOptionsPtrOutput) ToAutoModeOptionsPtrOutputWithContext(ctx context.Context) AutoModeOptionsPtrOutput {
+	return o
+}
+
+func (o AutoModeOptionsPtrOutput) Elem() AutoModeOptionsOutput {
+	return o.ApplyT(func(v *AutoModeOptions) AutoModeOptions {
+		if v != nil {
+			return *v
+		}
+		var ret AutoModeOptions
+		return ret
+	}).(AutoModeOptionsOutput)
+}
+
+// Compute configuration for EKS Auto Mode.
+func (o AutoModeOptionsPtrOutput) ComputeConfig() ClusterComputeConfigPtrOutput {
+	return o.ApplyT(func(v *AutoModeOptions) *ClusterComputeConfig {
+		if v == nil {
+			return nil
+		}
+		return v.ComputeConfig
+	}).(ClusterComputeConfigPtrOutput)
+}
+
+// Whether to create an IAM role for the EKS Auto Mode node group if none is provided in `computeConfig`.
+func (o AutoModeOptionsPtrOutput) CreateNodeRole() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *AutoModeOptions) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.CreateNodeRole
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether to enable EKS Auto Mode. If enabled, EKS will manage node pools, EBS volumes and Load Balancers for you.
+// When enabled, the vpc-cni and kube-proxy will not be enabled by default because EKS Auto Mode includes pod networking capabilities.
+func (o AutoModeOptionsPtrOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *AutoModeOptions) *bool {
+		if v == nil {
+			return nil
+		}
+		return &v.Enabled
+	}).(pulumi.BoolPtrOutput)
+}
+
+// AutoModeSelectorTerm selects subnets or security groups by their ID, name or tags. The conditions of a term are ANDed, multiple terms are ORed.
+type AutoModeSelectorTerm struct {
+	// The ID of the subnet or security group.
+	Id *string `pulumi:"id"`
+	// The name of the security group. Not supported for subnets.
+	Name *string `pulumi:"name"`
+	// The tags the subnets or security groups must have. A value of `*` matches any value.
+	Tags map[string]string `pulumi:"tags"`
+}
+
+// AutoModeSelectorTermInput is an input type that accepts AutoModeSelectorTermArgs and AutoModeSelectorTermOutput values.
+// You can construct a concrete instance of `AutoModeSelectorTermInput` via:
+//
+//	AutoModeSelectorTermArgs{...}
+type AutoModeSelectorTermInput interface {
+	pulumi.Input
+
+	ToAutoModeSelectorTermOutput() AutoModeSelectorTermOutput
+	ToAutoModeSelectorTermOutputWithContext(context.Context) AutoModeSelectorTermOutput
+}
+
+// AutoModeSelectorTerm selects subnets or security groups by their ID, name or tags. The conditions of a term are ANDed, multiple terms are ORed.
+type AutoModeSelectorTermArgs struct {
+	// The ID of the subnet or security group.
+	Id pulumi.StringPtrInput `pulumi:"id"`
+	// The name of the security group. Not supported for subnets.
+	Name pulumi.StringPtrInput `pulumi:"name"`
+	// The tags the subnets or security groups must have. A value of `*` matches any value.
+	Tags pulumi.StringMapInput `pulumi:"tags"`
+}
+
+func (AutoModeSelectorTermArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AutoModeSelectorTerm)(nil)).Elem()
+}
+
+func (i AutoModeSelectorTermArgs) ToAutoModeSelectorTermOutput() AutoModeSelectorTermOutput {
+	return i.ToAutoModeSelectorTermOutputWithContext(context.Background())
+}
+
+func (i AutoModeSelectorTermArgs) ToAutoModeSelectorTermOutputWithContext(ctx context.Context) AutoModeSelectorTermOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoModeSelectorTermOutput)
+}
+
+// AutoModeSelectorTermArrayInput is an input type that accepts AutoModeSelectorTermArray and AutoModeSelectorTermArrayOutput values.
+// You can construct a concrete instance of `AutoModeSelectorTermArrayInput` via:
+//
+//	AutoModeSelectorTermArray{ AutoModeSelectorTermArgs{...} }
+type AutoModeSelectorTermArrayInput interface {
+	pulumi.Input
+
+	ToAutoModeSelectorTermArrayOutput() AutoModeSelectorTermArrayOutput
+	ToAutoModeSelectorTermArrayOutputWithContext(context.Context) AutoModeSelectorTermArrayOutput
+}
+
+type AutoModeSelectorTermArray []AutoModeSelectorTermInput
+
+func (AutoModeSelectorTermArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AutoModeSelectorTerm)(nil)).Elem()
+}
+
+func (i AutoModeSelectorTermArray) ToAutoModeSelectorTermArrayOutput() AutoModeSelectorTermArrayOutput {
+	return i.ToAutoModeSelectorTermArrayOutputWithContext(context.Background())
+}
+
+func (i AutoModeSelectorTermArray) ToAutoModeSelectorTermArrayOutputWithContext(ctx context.Context) AutoModeSelectorTermArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoModeSelectorTermArrayOutput)
+}
+
+// AutoModeSelectorTerm selects subnets or security groups by their ID, name or tags. The conditions of a term are ANDed, multiple terms are ORed.
+type AutoModeSelectorTermOutput struct{ *pulumi.OutputState }
+
+func (AutoModeSelectorTermOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AutoModeSelectorTerm)(nil)).Elem()
+}
+
+func (o AutoModeSelectorTermOutput) ToAutoModeSelectorTermOutput() AutoModeSelectorTermOutput {
+	return o
+}
+
+func (o AutoModeSelectorTermOutput) ToAutoModeSelectorTermOutputWithContext(ctx context.Context) AutoModeSelectorTermOutput {
+	return o
+}
+
+// The ID of the subnet or security group.
+func (o AutoModeSelectorTermOutput) Id() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AutoModeSelectorTerm) *string { return v.Id }).(pulumi.StringPtrOutput)
+}
+
+// The name of the security group. Not supported for subnets.
+func (o AutoModeSelectorTermOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AutoModeSelectorTerm) *string { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+// The tags the subnets or security groups must have. A value of `*` matches any value.
+func (o AutoModeSelectorTermOutput) Tags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v AutoModeSelectorTerm) map[string]string { return v.Tags }).(pulumi.StringMapOutput)
+}
+
+type AutoModeSelectorTermArrayOutput struct{ *pulumi.OutputState }
+
+func (AutoModeSelectorTermArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AutoModeSelectorTerm)(nil)).Elem()
+}
+
+func (o AutoModeSelectorTermArrayOutput) ToAutoModeSelectorTermArrayOutput() AutoModeSelectorTermArrayOutput {
+	return o
+}
+
+func (o AutoModeSelectorTermArrayOutput) ToAutoModeSelectorTermArrayOutputWithContext(ctx context.Context) AutoModeSelectorTermArrayOutput {
+	return o
+}
+
+func (o AutoModeSelectorTermArrayOutput) Index(i pulumi.IntInput) AutoModeSelectorTermOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) AutoModeSelectorTerm {
+		return vs[0].([]AutoModeSelectorTerm)[vs[1].(int)]
+	}).(AutoModeSelectorTermOutput)
+}
+
+// A Bottlerocket bootstrap container, which runs before the kubelet starts.
+type BottlerocketBootstrapContainer struct {
+	// Whether the node fails to boot if the bootstrap container fails.
+	Essential *bool `pulumi:"essential"`
+	// When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+	Mode *string `pulumi:"mode"`
+	// The image of the bootstrap container.
+	Source string `pulumi:"source"`
+	// Base64 encoded user data passed to the bootstrap container.
+	UserData *string `pulumi:"userData"`
+}
+
+// BottlerocketBootstrapContainerInput is an input type that accepts BottlerocketBootstrapContainerArgs and BottlerocketBootstrapContainerOutput values.
+// You can construct a concrete instance of `BottlerocketBootstrapContainerInput` via:
+//
+//	BottlerocketBootstrapContainerArgs{...}
+type BottlerocketBootstrapContainerInput interface {
+	pulumi.Input
+
+	ToBottlerocketBootstrapContainerOutput() BottlerocketBootstrapContainerOutput
+	ToBottlerocketBootstrapContainerOutputWithContext(context.Context) BottlerocketBootstrapContainerOutput
+}
+
+// A Bottlerocket bootstrap container, which runs before the kubelet starts.
+type BottlerocketBootstrapContainerArgs struct {
+	// Whether the node fails to boot if the bootstrap container fails.
+	Essential pulumi.BoolPtrInput `pulumi:"essential"`
+	// When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+	Mode pulumi.StringPtrInput `pulumi:"mode"`
+	// The image of the bootstrap container.
+	Source pulumi.StringInput `pulumi:"source"`
+	// Base64 encoded user data passed to the bootstrap container.
+	UserData pulumi.StringPtrInput `pulumi:"userData"`
+}
+
+func (BottlerocketBootstrapContainerArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*BottlerocketBootstrapContainer)(nil)).Elem()
+}
+
+func (i BottlerocketBootstrapContainerArgs) ToBottlerocketBootstrapContainerOutput() BottlerocketBootstrapContainerOutput {
+	return i.ToBottlerocketBootstrapContainerOutputWithContext(context.Background())
+}
+
+func (i BottlerocketBootstrapContainerArgs) ToBottlerocketBootstrapContainerOutputWithContext(ctx context.Context) BottlerocketBootstrapContainerOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketBootstrapContainerOutput)
+}
+
+// BottlerocketBootstrapContainerMapInput is an input type that accepts BottlerocketBootstrapContainerMap and BottlerocketBootstrapContainerMapOutput values.
+// You can construct a concrete instance of `BottlerocketBootstrapContainerMapInput` via:
+//
+//	BottlerocketBootstrapContainerMap{ "key": BottlerocketBootstrapContainerArgs{...} }
+type BottlerocketBootstrapContainerMapInput interface {
+	pulumi.Input
+
+	ToBottlerocketBootstrapContainerMapOutput() BottlerocketBootstrapContainerMapOutput
+	ToBottlerocketBootstrapContainerMapOutputWithContext(context.Context) BottlerocketBootstrapContainerMapOutput
+}
+
+type BottlerocketBootstrapContainerMap map[string]BottlerocketBootstrapContainerInput
+
+func (BottlerocketBootstrapContainerMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]BottlerocketBootstrapContainer)(nil)).Elem()
+}
+
+func (i BottlerocketBootstrapContainerMap) ToBottlerocketBootstrapContainerMapOutput() BottlerocketBootstrapContainerMapOutput {
+	return i.ToBottlerocketBootstrapContainerMapOutputWithContext(context.Background())
+}
+
+func (i BottlerocketBootstrapContainerMap) ToBottlerocketBootstrapContainerMapOutputWithContext(ctx context.Context) BottlerocketBootstrapContainerMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketBootstrapContainerMapOutput)
+}
+
+// A Bottlerocket bootstrap container, which runs before the kubelet starts.
+type BottlerocketBootstrapContainerOutput struct{ *pulumi.OutputState }
+
+func (BottlerocketBootstrapContainerOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BottlerocketBootstrapContainer)(nil)).Elem()
+}
+
+func (o BottlerocketBootstrapContainerOutput) ToBottlerocketBootstrapContainerOutput() BottlerocketBootstrapContainerOutput {
+	return o
+}
+
+func (o BottlerocketBootstrapContainerOutput) ToBottlerocketBootstrapContainerOutputWithContext(ctx context.Context) BottlerocketBootstrapContainerOutput {
+	return o
+}
+
+// Whether the node fails to boot if the bootstrap container fails.
+func (o BottlerocketBootstrapContainerOutput) Essential() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v BottlerocketBootstrapContainer) *bool { return v.Essential }).(pulumi.BoolPtrOutput)
+}
+
+// When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+func (o BottlerocketBootstrapContainerOutput) Mode() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BottlerocketBootstrapContainer) *string { return v.Mode }).(pulumi.StringPtrOutput)
+}
+
+// The image of the bootstrap container.
+func (o BottlerocketBootstrapContainerOutput) Source() pulumi.StringOutput {
+	return o.ApplyT(func(v BottlerocketBootstrapContainer) string { return v.Source }).(pulumi.StringOutput)
+}
+
+// Base64 encoded user data passed to the bootstrap container.
+func (o BottlerocketBootstrapContainerOutput) UserData() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BottlerocketBootstrapContainer) *string { return v.UserData }).(pulumi.StringPtrOutput)
+}
+
+type BottlerocketBootstrapContainerMapOutput struct{ *pulumi.OutputState }
+
+func (BottlerocketBootstrapContainerMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]BottlerocketBootstrapContainer)(nil)).Elem()
+}
+
+func (o BottlerocketBootstrapContainerMapOutput) ToBottlerocketBootstrapContainerMapOutput() BottlerocketBootstrapContainerMapOutput {
+	return o
+}
+
+func (o BottlerocketBootstrapContainerMapOutput) ToBottlerocketBootstrapContainerMapOutputWithContext(ctx context.Context) BottlerocketBootstrapContainerMapOutput {
+	return o
+}
+
+func (o BottlerocketBootstrapContainerMapOutput) MapIndex(k pulumi.StringInput) BottlerocketBootstrapContainerOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) BottlerocketBootstrapContainer {
+		return vs[0].(map[string]BottlerocketBootstrapContainer)[vs[1].(string)]
+	}).(BottlerocketBootstrapContainerOutput)
+}
+
+// Typed configuration of the most common Bottlerocket settings.
+//
+// See for more details: https://bottlerocket.dev/en/os/1.20.x/api/settings/.
+type BottlerocketConfig struct {
+	// Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+	BootstrapContainers map[string]BottlerocketBootstrapContainer `pulumi:"bootstrapContainers"`
+	// Settings of the container registries, i.e. `settings.container-registry`.
+	ContainerRegistry *BottlerocketContainerRegistry `pulumi:"containerRegistry"`
+	// Settings of the admin and control host containers, i.e. `settings.host-containers`.
+	HostContainers *BottlerocketHostContainers `pulumi:"hostContainers"`
+	// Settings of the kernel, i.e. `settings.kernel`.
+	Kernel *BottlerocketKernelSettings `pulumi:"kernel"`
+	// Settings of the kubelet, i.e. `settings.kubernetes`.
+	Kubernetes *BottlerocketKubernetesSettings `pulumi:"kubernetes"`
+}
+
+// BottlerocketConfigInput is an input type that accepts BottlerocketConfigArgs and BottlerocketConfigOutput values.
+// You can construct a concrete instance of `BottlerocketConfigInput` via:
+//
+//	BottlerocketConfigArgs{...}
+type BottlerocketConfigInput interface {
+	pulumi.Input
+
+	ToBottlerocketConfigOutput() BottlerocketConfigOutput
+	ToBottlerocketConfigOutputWithContext(context.Context) BottlerocketConfigOutput
+}
+
+// Typed configuration of the most common Bottlerocket settings.
+//
+// See for more details: https://bottlerocket.dev/en/os/1.20.x/api/settings/.
+type BottlerocketConfigArgs struct {
+	// Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+	BootstrapContainers BottlerocketBootstrapContainerMapInput `pulumi:"bootstrapContainers"`
+	// Settings of the container registries, i.e. `settings.container-registry`.
+	ContainerRegistry BottlerocketContainerRegistryPtrInput `pulumi:"containerRegistry"`
+	// Settings of the admin and control host containers, i.e. `settings.host-containers`.
+	HostContainers BottlerocketHostContainersPtrInput `pulumi:"hostContainers"`
+	// Settings of the kernel, i.e. `settings.kernel`.
+	Kernel BottlerocketKernelSettingsPtrInput `pulumi:"kernel"`
+	// Settings of the kubelet, i.e. `settings.kubernetes`.
+	Kubernetes BottlerocketKubernetesSettingsPtrInput `pulumi:"kubernetes"`
+}
+
+func (BottlerocketConfigArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*BottlerocketConfig)(nil)).Elem()
+}
+
+func (i BottlerocketConfigArgs) ToBottlerocketConfigOutput() BottlerocketConfigOutput {
+	return i.ToBottlerocketConfigOutputWithContext(context.Background())
+}
+
+func (i BottlerocketConfigArgs) ToBottlerocketConfigOutputWithContext(ctx context.Context) BottlerocketConfigOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketConfigOutput)
+}
+
+func (i BottlerocketConfigArgs) ToBottlerocketConfigPtrOutput() BottlerocketConfigPtrOutput {
+	return i.ToBottlerocketConfigPtrOutputWithContext(context.Background())
+}
+
+func (i BottlerocketConfigArgs) ToBottlerocketConfigPtrOutputWithContext(ctx context.Context) BottlerocketConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketConfigOutput).ToBottlerocketConfigPtrOutputWithContext(ctx)
+}
+
+// BottlerocketConfigPtrInput is an input type that accepts BottlerocketConfigArgs, BottlerocketConfigPtr and BottlerocketConfigPtrOutput values.
+// You can construct a concrete instance of `BottlerocketConfigPtrInput` via:
+//
+//	        BottlerocketConfigArgs{...}
+//
+//	or:
+//
+//	        nil
+type BottlerocketConfigPtrInput interface {
+	pulumi.Input
+
+	ToBottlerocketConfigPtrOutput() BottlerocketConfigPtrOutput
+	ToBottlerocketConfigPtrOutputWithContext(context.Context) BottlerocketConfigPtrOutput
+}
+
+type bottlerocketConfigPtrType BottlerocketConfigArgs
+
+func BottlerocketConfigPtr(v *BottlerocketConfigArgs) BottlerocketConfigPtrInput {
+	return (*bottlerocketConfigPtrType)(v)
+}
+
+func (*bottlerocketConfigPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**BottlerocketConfig)(nil)).Elem()
+}
+
+func (i *bottlerocketConfigPtrType) ToBottlerocketConfigPtrOutput() BottlerocketConfigPtrOutput {
+	return i.ToBottlerocketConfigPtrOutputWithContext(context.Background())
+}
+
+func (i *bottlerocketConfigPtrType) ToBottlerocketConfigPtrOutputWithContext(ctx context.Context) BottlerocketConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketConfigPtrOutput)
+}
+
+// Typed configuration of the most common Bottlerocket settings.
+//
+// See for more details: https://bottlerocket.dev/en/os/1.20.x/api/settings/.
+type BottlerocketConfigOutput struct{ *pulumi.OutputState }
+
+func (BottlerocketConfigOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BottlerocketConfig)(nil)).Elem()
+}
+
+func (o BottlerocketConfigOutput) ToBottlerocketConfigOutput() BottlerocketConfigOutput {
+	return o
+}
+
+func (o BottlerocketConfigOutput) ToBottlerocketConfigOutputWithContext(ctx context.Context) BottlerocketConfigOutput {
+	return o
+}
+
+func (o BottlerocketConfigOutput) ToBottlerocketConfigPtrOutput() BottlerocketConfigPtrOutput {
+	return o.ToBottlerocketConfigPtrOutputWithContext(context.Background())
+}
+
+func (o BottlerocketConfigOutput) ToBottlerocketConfigPtrOutputWithContext(ctx context.Context) BottlerocketConfigPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v BottlerocketConfig) *BottlerocketConfig {
+		return &v
+	}).(BottlerocketConfigPtrOutput)
+}
+
+// Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+func (o BottlerocketConfigOutput) BootstrapContainers() BottlerocketBootstrapContainerMapOutput {
+	return o.ApplyT(func(v BottlerocketConfig) map[string]BottlerocketBootstrapContainer { return v.BootstrapContainers }).(BottlerocketBootstrapContainerMapOutput)
+}
+
+// Settings of the container registries, i.e. `settings.container-registry`.
+func (o BottlerocketConfigOutput) ContainerRegistry() BottlerocketContainerRegistryPtrOutput {
+	return o.ApplyT(func(v BottlerocketConfig) *BottlerocketContainerRegistry { return v.ContainerRegistry }).(BottlerocketContainerRegistryPtrOutput)
+}
+
+// Settings of the admin and control host containers, i.e. `settings.host-containers`.
+func (o BottlerocketConfigOutput) HostContainers() BottlerocketHostContainersPtrOutput {
+	return o.ApplyT(func(v BottlerocketConfig) *BottlerocketHostContainers { return v.HostContainers }).(BottlerocketHostContainersPtrOutput)
+}
+
+// Settings of the kernel, i.e. `settings.kernel`.
+func (o BottlerocketConfigOutput) Kernel() BottlerocketKernelSettingsPtrOutput {
+	return o.ApplyT(func(v BottlerocketConfig) *BottlerocketKernelSettings { return v.Kernel }).(BottlerocketKernelSettingsPtrOutput)
+}
+
+// Settings of the kubelet, i.e. `settings.kubernetes`.
+func (o BottlerocketConfigOutput) Kubernetes() BottlerocketKubernetesSettingsPtrOutput {
+	return o.ApplyT(func(v BottlerocketConfig) *BottlerocketKubernetesSettings { return v.Kubernetes }).(BottlerocketKubernetesSettingsPtrOutput)
+}
+
+type BottlerocketConfigPtrOutput struct{ *pulumi.OutputState }
+
+func (BottlerocketConfigPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**BottlerocketConfig)(nil)).Elem()
+}
+
+func (o BottlerocketConfigPtrOutput) ToBottlerocketConfigPtrOutput() BottlerocketConfigPtrOutput {
+	return o
+}
+
+func (o BottlerocketConfigPtrOutput) ToBottlerocketConfigPtrOutputWithContext(ctx context.Context) BottlerocketConfigPtrOutput {
+	return o
+}
+
+func (o BottlerocketConfigPtrOutput) Elem() BottlerocketConfigOutput {
+	return o.ApplyT(func(v *BottlerocketConfig) BottlerocketConfig {
+		if v != nil {
+			return *v
+		}
+		var ret BottlerocketConfig
+		return ret
+	}).(BottlerocketConfigOutput)
+}
+
+// Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+func (o BottlerocketConfigPtrOutput) BootstrapContainers() BottlerocketBootstrapContainerMapOutput {
+	return o.ApplyT(func(v *BottlerocketConfig) map[string]BottlerocketBootstrapContainer {
+		if v == nil {
+			return nil
+		}
+		return v.BootstrapContainers
+	}).(BottlerocketBootstrapContainerMapOutput)
+}
+
+// Settings of the container registries, i.e. `settings.container-registry`.
+func (o BottlerocketConfigPtrOutput) ContainerRegistry() BottlerocketContainerRegistryPtrOutput {
+	return o.ApplyT(func(v *BottlerocketConfig) *BottlerocketContainerRegistry {
+		if v == nil {
+			return nil
+		}
+		return v.ContainerRegistry
+	}).(BottlerocketContainerRegistryPtrOutput)
+}
+
+// Settings of the admin and control host containers, i.e. `settings.host-containers`.
+func (o BottlerocketConfigPtrOutput) HostContainers() BottlerocketHostContainersPtrOutput {
+	return o.ApplyT(func(v *BottlerocketConfig) *BottlerocketHostContainers {
+		if v == nil {
+			return nil
+		}
+		return v.HostContainers
+	}).(BottlerocketHostContainersPtrOutput)
+}
+
+// Settings of the kernel, i.e. `settings.kernel`.
+func (o BottlerocketConfigPtrOutput) Kernel() BottlerocketKernelSettingsPtrOutput {
+	return o.ApplyT(func(v *BottlerocketConfig) *BottlerocketKernelSettings {
+		if v == nil {
+			return nil
+		}
+		return v.Kernel
+	}).(BottlerocketKernelSettingsPtrOutput)
+}
+
+// Settings of the kubelet, i.e. `settings.kubernetes`.
+func (o BottlerocketConfigPtrOutput) Kubernetes() BottlerocketKubernetesSettingsPtrOutput {
+	return o.ApplyT(func(v *BottlerocketConfig) *BottlerocketKubernetesSettings {
+		if v == nil {
+			return nil
+		}
+		return v.Kubernetes
+	}).(BottlerocketKubernetesSettingsPtrOutput)
+}
+
+// Settings of the container registries of Bottlerocket nodes.
+type BottlerocketContainerRegistry struct {
+	// Mirrors to pull images from instead of the original registries.
+	Mirrors []BottlerocketRegistryMirror `pulumi:"mirrors"`
+}
+
+// BottlerocketContainerRegistryInput is an input type that accepts BottlerocketContainerRegistryArgs and BottlerocketContainerRegistryOutput values.
+// You can construct a concrete instance of `BottlerocketContainerRegistryInput` via:
+//
+//	BottlerocketContainerRegistryArgs{...}
+type BottlerocketContainerRegistryInput interface {
+	pulumi.Input
+
+	ToBottlerocketContainerRegistryOutput() BottlerocketContainerRegistryOutput
+	ToBottlerocketContainerRegistryOutputWithContext(context.Context) BottlerocketContainerRegistryOutput
+}
+
+// Settings of the container registries of Bottlerocket nodes.
+type BottlerocketContainerRegistryArgs struct {
+	// Mirrors to pull images from instead of the original registries.
+	Mirrors BottlerocketRegistryMirrorArrayInput `pulumi:"mirrors"`
+}
+
+func (BottlerocketContainerRegistryArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*BottlerocketContainerRegistry)(nil)).Elem()
+}
+
+func (i BottlerocketContainerRegistryArgs) ToBottlerocketContainerRegistryOutput() BottlerocketContainerRegistryOutput {
+	return i.ToBottlerocketContainerRegistryOutputWithContext(context.Background())
+}
+
+func (i BottlerocketContainerRegistryArgs) ToBottlerocketContainerRegistryOutputWithContext(ctx context.Context) BottlerocketContainerRegistryOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketContainerRegistryOutput)
+}
+
+func (i BottlerocketContainerRegistryArgs) ToBottlerocketContainerRegistryPtrOutput() BottlerocketContainerRegistryPtrOutput {
+	return i.ToBottlerocketContainerRegistryPtrOutputWithContext(context.Background())
+}
+
+func (i BottlerocketContainerRegistryArgs) ToBottlerocketContainerRegistryPtrOutputWithContext(ctx context.Context) BottlerocketContainerRegistryPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketContainerRegistryOutput).ToBottlerocketContainerRegistryPtrOutputWithContext(ctx)
+}
+
+// BottlerocketContainerRegistryPtrInput is an input type that accepts BottlerocketContainerRegistryArgs, BottlerocketContainerRegistryPtr and BottlerocketContainerRegistryPtrOutput values.
+// You can construct a concrete instance of `BottlerocketContainerRegistryPtrInput` via:
+//
+//	        BottlerocketContainerRegistryArgs{...}
+//
+//	or:
+//
+//	        nil
+type BottlerocketContainerRegistryPtrInput interface {
+	pulumi.Input
+
+	ToBottlerocketContainerRegistryPtrOutput() BottlerocketContainerRegistryPtrOutput
+	ToBottlerocketContainerRegistryPtrOutputWithContext(context.Context) BottlerocketContainerRegistryPtrOutput
+}
+
+type bottlerocketContainerRegistryPtrType BottlerocketContainerRegistryArgs
+
+func BottlerocketContainerRegistryPtr(v *BottlerocketContainerRegistryArgs) BottlerocketContainerRegistryPtrInput {
+	return (*bottlerocketContainerRegistryPtrType)(v)
+}
+
+func (*bottlerocketContainerRegistryPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**BottlerocketContainerRegistry)(nil)).Elem()
+}
+
+func (i *bottlerocketContainerRegistryPtrType) ToBottlerocketContainerRegistryPtrOutput() BottlerocketContainerRegistryPtrOutput {
+	return i.ToBottlerocketContainerRegistryPtrOutputWithContext(context.Background())
+}
+
+func (i *bottlerocketContainerRegistryPtrType) ToBottlerocketContainerRegistryPtrOutputWithContext(ctx context.Context) BottlerocketContainerRegistryPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketContainerRegistryPtrOutput)
+}
+
+// Settings of the container registries of Bottlerocket nodes.
+type BottlerocketContainerRegistryOutput struct{ *pulumi.OutputState }
+
+func (BottlerocketContainerRegistryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BottlerocketContainerRegistry)(nil)).Elem()
+}
+
+func (o BottlerocketContainerRegistryOutput) ToBottlerocketContainerRegistryOutput() BottlerocketContainerRegistryOutput {
+	return o
+}
+
+func (o BottlerocketContainerRegistryOutput) ToBottlerocketContainerRegistryOutputWithContext(ctx context.Context) BottlerocketContainerRegistryOutput {
+	return o
+}
+
+func (o BottlerocketContainerRegistryOutput) ToBottlerocketContainerRegistryPtrOutput() BottlerocketContainerRegistryPtrOutput {
+	return o.ToBottlerocketContainerRegistryPtrOutputWithContext(context.Background())
+}
+
+func (o BottlerocketContainerRegistryOutput) ToBottlerocketContainerRegistryPtrOutputWithContext(ctx context.Context) BottlerocketContainerRegistryPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v BottlerocketContainerRegistry) *BottlerocketContainerRegistry {
+		return &v
+	}).(BottlerocketContainerRegistryPtrOutput)
+}
+
+// Mirrors to pull images from instead of the original registries.
+func (o BottlerocketContainerRegistryOutput) Mirrors() BottlerocketRegistryMirrorArrayOutput {
+	return o.ApplyT(func(v BottlerocketContainerRegistry) []BottlerocketRegistryMirror { return v.Mirrors }).(BottlerocketRegistryMirrorArrayOutput)
+}
+
+type BottlerocketContainerRegistryPtrOutput struct{ *pulumi.OutputState }
+
+func (BottlerocketContainerRegistryPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**BottlerocketContainerRegistry)(nil)).Elem()
+}
+
+func (o BottlerocketContainerRegistryPtrOutput) ToBottlerocketContainerRegistryPtrOutput() BottlerocketContainerRegistryPtrOutput {
+	return o
+}
+
+func (o BottlerocketContainerRegistryPtrOutput) ToBottlerocketContainerRegistryPtrOutputWithContext(ctx context.Context) BottlerocketContainerRegistryPtrOutput {
+	return o
+}
+
+func (o BottlerocketContainerRegistryPtrOutput) Elem() BottlerocketContainerRegistryOutput {
+	return o.ApplyT(func(v *BottlerocketContainerRegistry) BottlerocketContainerRegistry {
+		if v != nil {
+			return *v
+		}
+		var ret BottlerocketContainerRegistry
+		return ret
+	}).(BottlerocketContainerRegistryOutput)
+}
+
+// Mirrors to pull images from instead of the original registries.
+func (o BottlerocketContainerRegistryPtrOutput) Mirrors() BottlerocketRegistryMirrorArrayOutput {
+	return o.ApplyT(func(v *BottlerocketContainerRegistry) []BottlerocketRegistryMirror {
+		if v == nil {
+			return nil
+		}
+		return v.Mirrors
+	}).(BottlerocketRegistryMirrorArrayOutput)
+}
+
+// Settings of a Bottlerocket host container.
+type BottlerocketHostContainer struct {
+	// Whether the host container runs.
+	Enabled *bool `pulumi:"enabled"`
+	// The image of the host container.
+	Source *string `pulumi:"source"`
+	// Whether the host container has elevated privileges.
+	Superpowered *bool `pulumi:"superpowered"`
+	// Base64 encoded user data passed to the host container.
+	UserData *string `pulumi:"userData"`
+}
+
+// BottlerocketHostContainerInput is an input type that accepts BottlerocketHostContainerArgs and BottlerocketHostContainerOutput values.
+// You can construct a concrete instance of `BottlerocketHostContainerInput` via:
+//
+//	BottlerocketHostContainerArgs{...}
+type BottlerocketHostContainerInput interface {
+	pulumi.Input
+
+	ToBottlerocketHostContainerOutput() BottlerocketHostContainerOutput
+	ToBottlerocketHostContainerOutputWithContext(context.Context) BottlerocketHostContainerOutput
+}
+
+// Settings of a Bottlerocket host container.
+type BottlerocketHostContainerArgs struct {
+	// Whether the host container runs.
+	Enabled pulumi.BoolPtrInput `pulumi:"enabled"`
+	// The image of the host container.
+	Source pulumi.StringPtrInput `pulumi:"source"`
+	// Whether the host container has elevated privileges.
+	Superpowered pulumi.BoolPtrInput `pulumi:"superpowered"`
+	// Base64 encoded user data passed to the host container.
+	UserData pulumi.StringPtrInput `pulumi:"userData"`
+}
+
+func (BottlerocketHostContainerArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*BottlerocketHostContainer)(nil)).Elem()
+}
+
+func (i BottlerocketHostContainerArgs) ToBottlerocketHostContainerOutput() BottlerocketHostContainerOutput {
+	return i.ToBottlerocketHostContainerOutputWithContext(context.Background())
+}
+
+func (i BottlerocketHostContainerArgs) ToBottlerocketHostContainerOutputWithContext(ctx context.Context) BottlerocketHostContainerOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketHostContainerOutput)
+}
+
+func (i BottlerocketHostContainerArgs) ToBottlerocketHostContainerPtrOutput() BottlerocketHostContainerPtrOutput {
+	return i.ToBottlerocketHostContainerPtrOutputWithContext(context.Background())
+}
+
+func (i BottlerocketHostContainerArgs) ToBottlerocketHostContainerPtrOutputWithContext(ctx context.Context) BottlerocketHostContainerPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketHostContainerOutput).ToBottlerocketHostContainerPtrOutputWithContext(ctx)
+}
+
+// BottlerocketHostContainerPtrInput is an input type that accepts BottlerocketHostContainerArgs, BottlerocketHostContainerPtr and BottlerocketHostContainerPtrOutput values.
+// You can construct a concrete instance of `BottlerocketHostContainerPtrInput` via:
+//
+//	        BottlerocketHostContainerArgs{...}
+//
+//	or:
+//
+//	        nil
+type BottlerocketHostContainerPtrInput interface {
+	pulumi.Input
+
+	ToBottlerocketHostContainerPtrOutput() BottlerocketHostContainerPtrOutput
+	ToBottlerocketHostContainerPtrOutputWithContext(context.Context) BottlerocketHostContainerPtrOutput
+}
+
+type bottlerocketHostContainerPtrType BottlerocketHostContainerArgs
+
+func BottlerocketHostContainerPtr(v *BottlerocketHostContainerArgs) BottlerocketHostContainerPtrInput {
+	return (*bottlerocketHostContainerPtrType)(v)
+}
+
+func (*bottlerocketHostContainerPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**BottlerocketHostContainer)(nil)).Elem()
+}
+
+func (i *bottlerocketHostContainerPtrType) ToBottlerocketHostContainerPtrOutput() BottlerocketHostContainerPtrOutput {
+	return i.ToBottlerocketHostContainerPtrOutputWithContext(context.Background())
+}
+
+func (i *bottlerocketHostContainerPtrType) ToBottlerocketHostContainerPtrOutputWithContext(ctx context.Context) BottlerocketHostContainerPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketHostContainerPtrOutput)
+}
+
+// Settings of a Bottlerocket host container.
+type BottlerocketHostContainerOutput struct{ *pulumi.OutputState }
+
+func (BottlerocketHostContainerOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BottlerocketHostContainer)(nil)).Elem()
+}
+
+func (o BottlerocketHostContainerOutput) ToBottlerocketHostContainerOutput() BottlerocketHostContainerOutput {
+	return o
+}
+
+func (o BottlerocketHostContainerOutput) ToBottlerocketHostContainerOutputWithContext(ctx context.Context) BottlerocketHostContainerOutput {
+	return o
+}
+
+func (o BottlerocketHostContainerOutput) ToBottlerocketHostContainerPtrOutput() BottlerocketHostContainerPtrOutput {
+	return o.ToBottlerocketHostContainerPtrOutputWithContext(context.Background())
+}
+
+func (o BottlerocketHostContainerOutput) ToBottlerocketHostContainerPtrOutputWithContext(ctx context.Context) BottlerocketHostContainerPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v BottlerocketHostContainer) *BottlerocketHostContainer {
+		return &v
+	}).(BottlerocketHostContainerPtrOutput)
+}
+
+// Whether the host container runs.
+func (o BottlerocketHostContainerOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v BottlerocketHostContainer) *bool { return v.Enabled }).(pulumi.BoolPtrOutput)
+}
+
+// The image of the host container.
+func (o BottlerocketHostContainerOutput) Source() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BottlerocketHostContainer) *string { return v.Source }).(pulumi.StringPtrOutput)
+}
+
+// Whether the host container has elevated privileges.
+func (o BottlerocketHostContainerOutput) Superpowered() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v BottlerocketHostContainer) *bool { return v.Superpowered }).(pulumi.BoolPtrOutput)
+}
+
+// Base64 encoded user data passed to the host container.
+func (o BottlerocketHostContainerOutput) UserData() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BottlerocketHostContainer) *string { return v.UserData }).(pulumi.StringPtrOutput)
+}
+
+type BottlerocketHostContainerPtrOutput struct{ *pulumi.OutputState }
+
+func (BottlerocketHostContainerPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**BottlerocketHostContainer)(nil)).Elem()
+}
+
+func (o BottlerocketHostContainerPtrOutput) ToBottlerocketHostContainerPtrOutput() BottlerocketHostContainerPtrOutput {
+	return o
+}
+
+func (o BottlerocketHostContainerPtrOutput) ToBottlerocketHostContainerPtrOutputWithContext(ctx context.Context) BottlerocketHostContainerPtrOutput {
+	return o
+}
+
+func (o BottlerocketHostContainerPtrOutput) Elem() BottlerocketHostContainerOutput {
+	return o.ApplyT(func(v *BottlerocketHostContainer) BottlerocketHostContainer {
+		if v != nil {
+			return *v
+		}
+		var ret BottlerocketHostContainer
+		return ret
+	}).(BottlerocketHostContainerOutput)
+}
+
+// Whether the host container runs.
+func (o BottlerocketHostContainerPtrOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *BottlerocketHostContainer) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Enabled
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The image of the host container.
+func (o BottlerocketHostContainerPtrOutput) Source() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *BottlerocketHostContainer) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Source
+	}).(pulumi.StringPtrOutput)
+}
+
+// Whether the host container has elevated privileges.
+func (o BottlerocketHostContainerPtrOutput) Superpowered() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *BottlerocketHostContainer) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Superpowered
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Base64 encoded user data passed to the host container.
+func (o BottlerocketHostContainerPtrOutput) UserData() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *BottlerocketHostContainer) *string {
+		if v == nil {
+			return nil
+		}
+		return v.UserData
+	}).(pulumi.StringPtrOutput)
+}
+
+// Settings of the Bottlerocket host containers.
+type BottlerocketHostContainers struct {
+	// The admin container, used for troubleshooting the node.
+	Admin *BottlerocketHostContainer `pulumi:"admin"`
+	// The control container, used to access the Bottlerocket API through AWS SSM.
+	Control *BottlerocketHostContainer `pulumi:"control"`
+}
+
+// BottlerocketHostContainersInput is an input type that accepts BottlerocketHostContainersArgs and BottlerocketHostContainersOutput values.
+// You can construct a concrete instance of `BottlerocketHostContainersInput` via:
+//
+//	BottlerocketHostContainersArgs{...}
+type BottlerocketHostContainersInput interface {
+	pulumi.Input
+
+	ToBottlerocketHostContainersOutput() BottlerocketHostContainersOutput
+	ToBottlerocketHostContainersOutputWithContext(context.Context) BottlerocketHostContainersOutput
+}
+
+// Settings of the Bottlerocket host containers.
+type BottlerocketHostContainersArgs struct {
+	// The admin container, used for troubleshooting the node.
+	Admin BottlerocketHostContainerPtrInput `pulumi:"admin"`
+	// The control container, used to access the Bottlerocket API through AWS SSM.
+	Control BottlerocketHostContainerPtrInput `pulumi:"control"`
+}
+
+func (BottlerocketHostContainersArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*BottlerocketHostContainers)(nil)).Elem()
+}
+
+func (i BottlerocketHostContainersArgs) ToBottlerocketHostContainersOutput() BottlerocketHostContainersOutput {
+	return i.ToBottlerocketHostContainersOutputWithContext(context.Background())
+}
+
+func (i BottlerocketHostContainersArgs) ToBottlerocketHostContainersOutputWithContext(ctx context.Context) BottlerocketHostContainersOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketHostContainersOutput)
+}
+
+func (i BottlerocketHostContainersArgs) ToBottlerocketHostContainersPtrOutput() BottlerocketHostContainersPtrOutput {
+	return i.ToBottlerocketHostContainersPtrOutputWithContext(context.Background())
+}
+
+func (i BottlerocketHostContainersArgs) ToBottlerocketHostContainersPtrOutputWithContext(ctx context.Context) BottlerocketHostContainersPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketHostContainersOutput).ToBottlerocketHostContainersPtrOutputWithContext(ctx)
+}
+
+// BottlerocketHostContainersPtrInput is an input type that accepts BottlerocketHostContainersArgs, BottlerocketHostContainersPtr and BottlerocketHostContainersPtrOutput values.
+// You can construct a concrete instance of `BottlerocketHostContainersPtrInput` via:
+//
+//	        BottlerocketHostContainersArgs{...}
+//
+//	or:
+//
+//	        nil
+type BottlerocketHostContainersPtrInput interface {
+	pulumi.Input
+
+	ToBottlerocketHostContainersPtrOutput() BottlerocketHostContainersPtrOutput
+	ToBottlerocketHostContainersPtrOutputWithContext(context.Context) BottlerocketHostContainersPtrOutput
+}
+
+type bottlerocketHostContainersPtrType BottlerocketHostContainersArgs
+
+func BottlerocketHostContainersPtr(v *BottlerocketHostContainersArgs) BottlerocketHostContainersPtrInput {
+	return (*bottlerocketHostContainersPtrType)(v)
+}
+
+func (*bottlerocketHostContainersPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**BottlerocketHostContainers)(nil)).Elem()
+}
+
+func (i *bottlerocketHostContainersPtrType) ToBottlerocketHostContainersPtrOutput() BottlerocketHostContainersPtrOutput {
+	return i.ToBottlerocketHostContainersPtrOutputWithContext(context.Background())
+}
+
+func (i *bottlerocketHostContainersPtrType) ToBottlerocketHostContainersPtrOutputWithContext(ctx context.Context) BottlerocketHostContainersPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketHostContainersPtrOutput)
+}
+
+// Settings of the Bottlerocket host containers.
+type BottlerocketHostContainersOutput struct{ *pulumi.OutputState }
+
+func (BottlerocketHostContainersOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BottlerocketHostContainers)(nil)).Elem()
+}
+
+func (o BottlerocketHostContainersOutput) ToBottlerocketHostContainersOutput() BottlerocketHostContainersOutput {
+	return o
+}
+
+func (o BottlerocketHostContainersOutput) ToBottlerocketHostContainersOutputWithContext(ctx context.Context) BottlerocketHostContainersOutput {
+	return o
+}
+
+func (o BottlerocketHostContainersOutput) ToBottlerocketHostContainersPtrOutput() BottlerocketHostContainersPtrOutput {
+	return o.ToBottlerocketHostContainersPtrOutputWithContext(context.Background())
+}
+
+func (o BottlerocketHostContainersOutput) ToBottlerocketHostContainersPtrOutputWithContext(ctx context.Context) BottlerocketHostContainersPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v BottlerocketHostContainers) *BottlerocketHostContainers {
+		return &v
+	}).(BottlerocketHostContainersPtrOutput)
+}
+
+// The admin container, used for troubleshooting the node.
+func (o BottlerocketHostContainersOutput) Admin() BottlerocketHostContainerPtrOutput {
+	return o.ApplyT(func(v BottlerocketHostContainers) *BottlerocketHostContainer { return v.Admin }).(BottlerocketHostContainerPtrOutput)
+}
+
+// The control container, used to access the Bottlerocket API through AWS SSM.
+func (o BottlerocketHostContainersOutput) Control() BottlerocketHostContainerPtrOutput {
+	return o.ApplyT(func(v BottlerocketHostContainers) *BottlerocketHostContainer { return v.Control }).(BottlerocketHostContainerPtrOutput)
+}
+
+type BottlerocketHostContainersPtrOutput struct{ *pulumi.OutputState }
+
+func (BottlerocketHostContainersPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**BottlerocketHostContainers)(nil)).Elem()
+}
+
+func (o BottlerocketHostContainersPtrOutput) ToBottlerocketHostContainersPtrOutput() BottlerocketHostContainersPtrOutput {
+	return o
+}
+
+func (o BottlerocketHostContainersPtrOutput) ToBottlerocketHostContainersPtrOutputWithContext(ctx context.Context) BottlerocketHostContainersPtrOutput {
+	return o
+}
+
+func (o BottlerocketHostContainersPtrOutput) Elem() BottlerocketHostContainersOutput {
+	return o.ApplyT(func(v *BottlerocketHostContainers) BottlerocketHostContainers {
+		if v != nil {
+			return *v
+		}
+		var ret BottlerocketHostContainers
+		return ret
+	}).(BottlerocketHostContainersOutput)
+}
+
+// The admin container, used for troubleshooting the node.
+func (o BottlerocketHostContainersPtrOutput) Admin() BottlerocketHostContainerPtrOutput {
+	return o.ApplyT(func(v *BottlerocketHostContainers) *BottlerocketHostContainer {
+		if v == nil {
+			return nil
+		}
+		return v.Admin
+	}).(BottlerocketHostContainerPtrOutput)
+}
+
+// The control container, used to access the Bottlerocket API through AWS SSM.
+func (o BottlerocketHostContainersPtrOutput) Control() BottlerocketHostContainerPtrOutput {
+	return o.ApplyT(func(v *BottlerocketHostContainers) *BottlerocketHostContainer {
+		if v == nil {
+			return nil
+		}
+		return v.Control
+	}).(BottlerocketHostContainerPtrOutput)
+}
+
+// Settings of the Bottlerocket kernel.
+type BottlerocketKernelSettings struct {
+	// The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+	Lockdown *string `pulumi:"lockdown"`
+	// Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: "1"`.
+	Sysctl map[string]string `pulumi:"sysctl"`
+}
+
+// BottlerocketKernelSettingsInput is an input type that accepts BottlerocketKernelSettingsArgs and BottlerocketKernelSettingsOutput values.
+// You can construct a concrete instance of `BottlerocketKernelSettingsInput` via:
+//
+//	BottlerocketKernelSettingsArgs{...}
+type BottlerocketKernelSettingsInput interface {
+	pulumi.Input
+
+	ToBottlerocketKernelSettingsOutput() BottlerocketKernelSettingsOutput
+	ToBottlerocketKernelSettingsOutputWithContext(context.Context) BottlerocketKernelSettingsOutput
+}
+
+// Settings of the Bottlerocket kernel.
+type BottlerocketKernelSettingsArgs struct {
+	// The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+	Lockdown pulumi.StringPtrInput `pulumi:"lockdown"`
+	// Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: "1"`.
+	Sysctl pulumi.StringMapInput `pulumi:"sysctl"`
+}
+
+func (BottlerocketKernelSettingsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*BottlerocketKernelSettings)(nil)).Elem()
+}
+
+func (i BottlerocketKernelSettingsArgs) ToBottlerocketKernelSettingsOutput() BottlerocketKernelSettingsOutput {
+	return i.ToBottlerocketKernelSettingsOutputWithContext(context.Background())
+}
+
+func (i BottlerocketKernelSettingsArgs) ToBottlerocketKernelSettingsOutputWithContext(ctx context.Context) BottlerocketKernelSettingsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketKernelSettingsOutput)
+}
+
+func (i BottlerocketKernelSettingsArgs) ToBottlerocketKernelSettingsPtrOutput() BottlerocketKernelSettingsPtrOutput {
+	return i.ToBottlerocketKernelSettingsPtrOutputWithContext(context.Background())
+}
+
+func (i BottlerocketKernelSettingsArgs) ToBottlerocketKernelSettingsPtrOutputWithContext(ctx context.Context) BottlerocketKernelSettingsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketKernelSettingsOutput).ToBottlerocketKernelSettingsPtrOutputWithContext(ctx)
+}
+
+// BottlerocketKernelSettingsPtrInput is an input type that accepts BottlerocketKernelSettingsArgs, BottlerocketKernelSettingsPtr and BottlerocketKernelSettingsPtrOutput values.
+// You can construct a concrete instance of `BottlerocketKernelSettingsPtrInput` via:
+//
+//	        BottlerocketKernelSettingsArgs{...}
+//
+//	or:
+//
+//	        nil
+type BottlerocketKernelSettingsPtrInput interface {
+	pulumi.Input
+
+	ToBottlerocketKernelSettingsPtrOutput() BottlerocketKernelSettingsPtrOutput
+	ToBottlerocketKernelSettingsPtrOutputWithContext(context.Context) BottlerocketKernelSettingsPtrOutput
+}
+
+type bottlerocketKernelSettingsPtrType BottlerocketKernelSettingsArgs
+
+func BottlerocketKernelSettingsPtr(v *BottlerocketKernelSettingsArgs) BottlerocketKernelSettingsPtrInput {
+	return (*bottlerocketKernelSettingsPtrType)(v)
+}
+
+func (*bottlerocketKernelSettingsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**BottlerocketKernelSettings)(nil)).Elem()
+}
+
+func (i *bottlerocketKernelSettingsPtrType) ToBottlerocketKernelSettingsPtrOutput() BottlerocketKernelSettingsPtrOutput {
+	return i.ToBottlerocketKernelSettingsPtrOutputWithContext(context.Background())
+}
+
+func (i *bottlerocketKernelSettingsPtrType) ToBottlerocketKernelSettingsPtrOutputWithContext(ctx context.Context) BottlerocketKernelSettingsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketKernelSettingsPtrOutput)
+}
+
+// Settings of the Bottlerocket kernel.
+type BottlerocketKernelSettingsOutput struct{ *pulumi.OutputState }
+
+func (BottlerocketKernelSettingsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BottlerocketKernelSettings)(nil)).Elem()
+}
+
+func (o BottlerocketKernelSettingsOutput) ToBottlerocketKernelSettingsOutput() BottlerocketKernelSettingsOutput {
+	return o
+}
+
+func (o BottlerocketKernelSettingsOutput) ToBottlerocketKernelSettingsOutputWithContext(ctx context.Context) BottlerocketKernelSettingsOutput {
+	return o
+}
+
+func (o BottlerocketKernelSettingsOutput) ToBottlerocketKernelSettingsPtrOutput() BottlerocketKernelSettingsPtrOutput {
+	return o.ToBottlerocketKernelSettingsPtrOutputWithContext(context.Background())
+}
+
+func (o BottlerocketKernelSettingsOutput) ToBottlerocketKernelSettingsPtrOutputWithContext(ctx context.Context) BottlerocketKernelSettingsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v BottlerocketKernelSettings) *BottlerocketKernelSettings {
+		return &v
+	}).(BottlerocketKernelSettingsPtrOutput)
+}
+
+// The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+func (o BottlerocketKernelSettingsOutput) Lockdown() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BottlerocketKernelSettings) *string { return v.Lockdown }).(pulumi.StringPtrOutput)
+}
+
+// Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: "1"`.
+func (o BottlerocketKernelSettingsOutput) Sysctl() pulumi.StringMapOutput {
+	return o.ApplyT(func(v BottlerocketKernelSettings) map[string]string { return v.Sysctl }).(pulumi.StringMapOutput)
+}
+
+type BottlerocketKernelSettingsPtrOutput struct{ *pulumi.OutputState }
+
+func (BottlerocketKernelSettingsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**BottlerocketKernelSettings)(nil)).Elem()
+}
+
+func (o BottlerocketKernelSettingsPtrOutput) ToBottlerocketKernelSettingsPtrOutput() BottlerocketKernelSettingsPtrOutput {
+	return o
+}
+
+func (o BottlerocketKernelSettingsPtrOutput) ToBottlerocketKernelSettingsPtrOutputWithContext(ctx context.Context) BottlerocketKernelSettingsPtrOutput {
+	return o
+}
+
+func (o BottlerocketKernelSettingsPtrOutput) Elem() BottlerocketKernelSettingsOutput {
+	return o.ApplyT(func(v *BottlerocketKernelSettings) BottlerocketKernelSettings {
+		if v != nil {
+			return *v
+		}
+		var ret BottlerocketKernelSettings
+		return ret
+	}).(BottlerocketKernelSettingsOutput)
+}
+
+// The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+func (o BottlerocketKernelSettingsPtrOutput) Lockdown() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *BottlerocketKernelSettings) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Lockdown
+	}).(pulumi.StringPtrOutput)
+}
+
+// Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: "1"`.
+func (o BottlerocketKernelSettingsPtrOutput) Sysctl() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *BottlerocketKernelSettings) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.Sysctl
+	}).(pulumi.StringMapOutput)
+}
+
+// Settings of the kubelet on Bottlerocket nodes.
+type BottlerocketKubernetesSettings struct {
+	// Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+	EvictionHard map[string]string `pulumi:"evictionHard"`
+	// Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+	EvictionSoft map[string]string `pulumi:"evictionSoft"`
+	// Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+	EvictionSoftGracePeriod map[string]string `pulumi:"evictionSoftGracePeriod"`
+	// The disk usage in percent after which image garbage collection always runs.
+	ImageGcHighThresholdPercent *int `pulumi:"imageGcHighThresholdPercent"`
+	// The disk usage in percent before which image garbage collection never runs.
+	ImageGcLowThresholdPercent *int `pulumi:"imageGcLowThresholdPercent"`
+	// The maximum number of pods the kubelet runs on the node.
+	MaxPods *int `pulumi:"maxPods"`
+	// Labels the node registers with.
+	NodeLabels map[string]string `pulumi:"nodeLabels"`
+	// Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+	NodeTaints map[string]string `pulumi:"nodeTaints"`
+}
+
+// BottlerocketKubernetesSettingsInput is an input type that accepts BottlerocketKubernetesSettingsArgs and BottlerocketKubernetesSettingsOutput values.
+// You can construct a concrete instance of `BottlerocketKubernetesSettingsInput` via:
+//
+//	BottlerocketKubernetesSettingsArgs{...}
+type BottlerocketKubernetesSettingsInput interface {
+	pulumi.Input
+
+	ToBottlerocketKubernetesSettingsOutput() BottlerocketKubernetesSettingsOutput
+	ToBottlerocketKubernetesSettingsOutputWithContext(context.Context) BottlerocketKubernetesSettingsOutput
+}
+
+// Settings of the kubelet on Bottlerocket nodes.
+type BottlerocketKubernetesSettingsArgs struct {
+	// Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+	EvictionHard pulumi.StringMapInput `pulumi:"evictionHard"`
+	// Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+	EvictionSoft pulumi.StringMapInput `pulumi:"evictionSoft"`
+	// Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+	EvictionSoftGracePeriod pulumi.StringMapInput `pulumi:"evictionSoftGracePeriod"`
+	// The disk usage in percent after which image garbage collection always runs.
+	ImageGcHighThresholdPercent pulumi.IntPtrInput `pulumi:"imageGcHighThresholdPercent"`
+	// The disk usage in percent before which image garbage collection never runs.
+	ImageGcLowThresholdPercent pulumi.IntPtrInput `pulumi:"imageGcLowThresholdPercent"`
+	// The maximum number of pods the kubelet runs on the node.
+	MaxPods pulumi.IntPtrInput `pulumi:"maxPods"`
+	// Labels the node registers with.
+	NodeLabels pulumi.StringMapInput `pulumi:"nodeLabels"`
+	// Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+	NodeTaints pulumi.StringMapInput `pulumi:"nodeTaints"`
+}
+
+func (BottlerocketKubernetesSettingsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*BottlerocketKubernetesSettings)(nil)).Elem()
+}
+
+func (i BottlerocketKubernetesSettingsArgs) ToBottlerocketKubernetesSettingsOutput() BottlerocketKubernetesSettingsOutput {
+	return i.ToBottlerocketKubernetesSettingsOutputWithContext(context.Background())
+}
+
+func (i BottlerocketKubernetesSettingsArgs) ToBottlerocketKubernetesSettingsOutputWithContext(ctx context.Context) BottlerocketKubernetesSettingsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketKubernetesSettingsOutput)
+}
+
+func (i BottlerocketKubernetesSettingsArgs) ToBottlerocketKubernetesSettingsPtrOutput() BottlerocketKubernetesSettingsPtrOutput {
+	return i.ToBottlerocketKubernetesSettingsPtrOutputWithContext(context.Background())
+}
+
+func (i BottlerocketKubernetesSettingsArgs) ToBottlerocketKubernetesSettingsPtrOutputWithContext(ctx context.Context) BottlerocketKubernetesSettingsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketKubernetesSettingsOutput).ToBottlerocketKubernetesSettingsPtrOutputWithContext(ctx)
+}
+
+// BottlerocketKubernetesSettingsPtrInput is an input type that accepts BottlerocketKubernetesSettingsArgs, BottlerocketKubernetesSettingsPtr and BottlerocketKubernetesSettingsPtrOutput values.
+// You can construct a concrete instance of `BottlerocketKubernetesSettingsPtrInput` via:
+//
+//	        BottlerocketKubernetesSettingsArgs{...}
+//
+//	or:
+//
+//	        nil
+type BottlerocketKubernetesSettingsPtrInput interface {
+	pulumi.Input
+
+	ToBottlerocketKubernetesSettingsPtrOutput() BottlerocketKubernetesSettingsPtrOutput
+	ToBottlerocketKubernetesSettingsPtrOutputWithContext(context.Context) BottlerocketKubernetesSettingsPtrOutput
+}
+
+type bottlerocketKubernetesSettingsPtrType BottlerocketKubernetesSettingsArgs
+
+func BottlerocketKubernetesSettingsPtr(v *BottlerocketKubernetesSettingsArgs) BottlerocketKubernetesSettingsPtrInput {
+	return (*bottlerocketKubernetesSettingsPtrType)(v)
+}
+
+func (*bottlerocketKubernetesSettingsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**BottlerocketKubernetesSettings)(nil)).Elem()
+}
+
+func (i *bottlerocketKubernetesSettingsPtrType) ToBottlerocketKubernetesSettingsPtrOutput() BottlerocketKubernetesSettingsPtrOutput {
+	return i.ToBottlerocketKubernetesSettingsPtrOutputWithContext(context.Background())
+}
+
+func (i *bottlerocketKubernetesSettingsPtrType) ToBottlerocketKubernetesSettingsPtrOutputWithContext(ctx context.Context) BottlerocketKubernetesSettingsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketKubernetesSettingsPtrOutput)
+}
+
+// Settings of the kubelet on Bottlerocket nodes.
+type BottlerocketKubernetesSettingsOutput struct{ *pulumi.OutputState }
+
+func (BottlerocketKubernetesSettingsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BottlerocketKubernetesSettings)(nil)).Elem()
+}
+
+func (o BottlerocketKubernetesSettingsOutput) ToBottlerocketKubernetesSettingsOutput() BottlerocketKubernetesSettingsOutput {
+	return o
+}
+
+func (o BottlerocketKubernetesSettingsOutput) ToBottlerocketKubernetesSettingsOutputWithContext(ctx context.Context) BottlerocketKubernetesSettingsOutput {
+	return o
+}
+
+func (o BottlerocketKubernetesSettingsOutput) ToBottlerocketKubernetesSettingsPtrOutput() BottlerocketKubernetesSettingsPtrOutput {
+	return o.ToBottlerocketKubernetesSettingsPtrOutputWithContext(context.Background())
+}
+
+func (o BottlerocketKubernetesSettingsOutput) ToBottlerocketKubernetesSettingsPtrOutputWithContext(ctx context.Context) BottlerocketKubernetesSettingsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v BottlerocketKubernetesSettings) *BottlerocketKubernetesSettings {
+		return &v
+	}).(BottlerocketKubernetesSettingsPtrOutput)
+}
+
+// Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+func (o BottlerocketKubernetesSettingsOutput) EvictionHard() pulumi.StringMapOutput {
+	return o.ApplyT(func(v BottlerocketKubernetesSettings) map[string]string { return v.EvictionHard }).(pulumi.StringMapOutput)
+}
+
+// Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+func (o BottlerocketKubernetesSettingsOutput) EvictionSoft() pulumi.StringMapOutput {
+	return o.ApplyT(func(v BottlerocketKubernetesSettings) map[string]string { return v.EvictionSoft }).(pulumi.StringMapOutput)
+}
+
+// Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+func (o BottlerocketKubernetesSettingsOutput) EvictionSoftGracePeriod() pulumi.StringMapOutput {
+	return o.ApplyT(func(v BottlerocketKubernetesSettings) map[string]string { return v.EvictionSoftGracePeriod }).(pulumi.StringMapOutput)
+}
+
+// The disk usage in percent after which image garbage collection always runs.
+func (o BottlerocketKubernetesSettingsOutput) ImageGcHighThresholdPercent() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v BottlerocketKubernetesSettings) *int { return v.ImageGcHighThresholdPercent }).(pulumi.IntPtrOutput)
+}
+
+// The disk usage in percent before which image garbage collection never runs.
+func (o BottlerocketKubernetesSettingsOutput) ImageGcLowThresholdPercent() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v BottlerocketKubernetesSettings) *int { return v.ImageGcLowThresholdPercent }).(pulumi.IntPtrOutput)
+}
+
+// The maximum number of pods the kubelet runs on the node.
+func (o BottlerocketKubernetesSettingsOutput) MaxPods() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v BottlerocketKubernetesSettings) *int { return v.MaxPods }).(pulumi.IntPtrOutput)
+}
+
+// Labels the node registers with.
+func (o BottlerocketKubernetesSettingsOutput) NodeLabels() pulumi.StringMapOutput {
+	return o.ApplyT(func(v BottlerocketKubernetesSettings) map[string]string { return v.NodeLabels }).(pulumi.StringMapOutput)
+}
+
+// Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+func (o BottlerocketKubernetesSettingsOutput) NodeTaints() pulumi.StringMapOutput {
+	return o.ApplyT(func(v BottlerocketKubernetesSettings) map[string]string { return v.NodeTaints }).(pulumi.StringMapOutput)
+}
+
+type BottlerocketKubernetesSettingsPtrOutput struct{ *pulumi.OutputState }
+
+func (BottlerocketKubernetesSettingsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**BottlerocketKubernetesSettings)(nil)).Elem()
+}
+
+func (o BottlerocketKubernetesSettingsPtrOutput) ToBottlerocketKubernetesSettingsPtrOutput() BottlerocketKubernetesSettingsPtrOutput {
+	return o
+}
+
+func (o BottlerocketKubernetesSettingsPtrOutput) ToBottlerocketKubernetesSettingsPtrOutputWithContext(ctx context.Context) BottlerocketKubernetesSettingsPtrOutput {
+	return o
+}
+
+func (o BottlerocketKubernetesSettingsPtrOutput) Elem() BottlerocketKubernetesSettingsOutput {
+	return o.ApplyT(func(v *BottlerocketKubernetesSettings) BottlerocketKubernetesSettings {
+		if v != nil {
+			return *v
+		}
+		var ret BottlerocketKubernetesSettings
+		return ret
+	}).(BottlerocketKubernetesSettingsOutput)
+}
+
+// Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+func (o BottlerocketKubernetesSettingsPtrOutput) EvictionHard() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *BottlerocketKubernetesSettings) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.EvictionHard
+	}).(pulumi.StringMapOutput)
+}
+
+// Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+func (o BottlerocketKubernetesSettingsPtrOutput) EvictionSoft() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *BottlerocketKubernetesSettings) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.EvictionSoft
+	}).(pulumi.StringMapOutput)
+}
+
+// Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+func (o BottlerocketKubernetesSettingsPtrOutput) EvictionSoftGracePeriod() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *BottlerocketKubernetesSettings) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.EvictionSoftGracePeriod
+	}).(pulumi.StringMapOutput)
+}
+
+// The disk usage in percent after which image garbage collection always runs.
+func (o BottlerocketKubernetesSettingsPtrOutput) ImageGcHighThresholdPercent() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *BottlerocketKubernetesSettings) *int {
+		if v == nil {
+			return nil
+		}
+		return v.ImageGcHighThresholdPercent
+	}).(pulumi.IntPtrOutput)
+}
+
+// The disk usage in percent before which image garbage collection never runs.
+func (o BottlerocketKubernetesSettingsPtrOutput) ImageGcLowThresholdPercent() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *BottlerocketKubernetesSettings) *int {
+		if v == nil {
+			return nil
+		}
+		return v.ImageGcLowThresholdPercent
+	}).(pulumi.IntPtrOutput)
+}
+
+// The maximum number of pods the kubelet runs on the node.
+func (o BottlerocketKubernetesSettingsPtrOutput) MaxPods() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *BottlerocketKubernetesSettings) *int {
+		if v == nil {
+			return nil
+		}
+		return v.MaxPods
+	}).(pulumi.IntPtrOutput)
+}
+
+// Labels the node registers with.
+func (o BottlerocketKubernetesSettingsPtrOutput) NodeLabels() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *BottlerocketKubernetesSettings) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.NodeLabels
+	}).(pulumi.StringMapOutput)
+}
+
+// Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+func (o BottlerocketKubernetesSettingsPtrOutput) NodeTaints() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *BottlerocketKubernetesSettings) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.NodeTaints
+	}).(pulumi.StringMapOutput)
+}
+
+// A mirror of a container registry.
+type BottlerocketRegistryMirror struct {
+	// The endpoints of the mirror, in order of preference.
+	Endpoints []string `pulumi:"endpoints"`
+	// The registry to mirror, e.g. `docker.io`.
+	Registry string `pulumi:"registry"`
+}
+
+// BottlerocketRegistryMirrorInput is an input type that accepts BottlerocketRegistryMirrorArgs and BottlerocketRegistryMirrorOutput values.
+// You can construct a concrete instance of `BottlerocketRegistryMirrorInput` via:
+//
+//	BottlerocketRegistryMirrorArgs{...}
+type BottlerocketRegistryMirrorInput interface {
+	pulumi.Input
+
+	ToBottlerocketRegistryMirrorOutput() BottlerocketRegistryMirrorOutput
+	ToBottlerocketRegistryMirrorOutputWithContext(context.Context) BottlerocketRegistryMirrorOutput
+}
+
+// A mirror of a container registry.
+type BottlerocketRegistryMirrorArgs struct {
+	// The endpoints of the mirror, in order of preference.
+	Endpoints pulumi.StringArrayInput `pulumi:"endpoints"`
+	// The registry to mirror, e.g. `docker.io`.
+	Registry pulumi.StringInput `pulumi:"registry"`
+}
+
+func (BottlerocketRegistryMirrorArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*BottlerocketRegistryMirror)(nil)).Elem()
+}
+
+func (i BottlerocketRegistryMirrorArgs) ToBottlerocketRegistryMirrorOutput() BottlerocketRegistryMirrorOutput {
+	return i.ToBottlerocketRegistryMirrorOutputWithContext(context.Background())
+}
+
+func (i BottlerocketRegistryMirrorArgs) ToBottlerocketRegistryMirrorOutputWithContext(ctx context.Context) BottlerocketRegistryMirrorOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketRegistryMirrorOutput)
+}
+
+// BottlerocketRegistryMirrorArrayInput is an input type that accepts BottlerocketRegistryMirrorArray and BottlerocketRegistryMirrorArrayOutput values.
+// You can construct a concrete instance of `BottlerocketRegistryMirrorArrayInput` via:
+//
+//	BottlerocketRegistryMirrorArray{ BottlerocketRegistryMirrorArgs{...} }
+type BottlerocketRegistryMirrorArrayInput interface {
+	pulumi.Input
+
+	ToBottlerocketRegistryMirrorArrayOutput() BottlerocketRegistryMirrorArrayOutput
+	ToBottlerocketRegistryMirrorArrayOutputWithContext(context.Context) BottlerocketRegistryMirrorArrayOutput
+}
+
+type BottlerocketRegistryMirrorArray []BottlerocketRegistryMirrorInput
+
+func (BottlerocketRegistryMirrorArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]BottlerocketRegistryMirror)(nil)).Elem()
+}
+
+func (i BottlerocketRegistryMirrorArray) ToBottlerocketRegistryMirrorArrayOutput() BottlerocketRegistryMirrorArrayOutput {
+	return i.ToBottlerocketRegistryMirrorArrayOutputWithContext(context.Background())
+}
+
+func (i BottlerocketRegistryMirrorArray) ToBottlerocketRegistryMirrorArrayOutputWithContext(ctx context.Context) BottlerocketRegistryMirrorArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BottlerocketRegistryMirrorArrayOutput)
+}
+
+// A mirror of a container registry.
+type BottlerocketRegistryMirrorOutput struct{ *pulumi.OutputState }
+
+func (BottlerocketRegistryMirrorOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BottlerocketRegistryMirror)(nil)).Elem()
+}
+
+func (o BottlerocketRegistryMirrorOutput) ToBottlerocketRegistryMirrorOutput() BottlerocketRegistryMirrorOutput {
+	return o
+}
+
+func (o BottlerocketRegistryMirrorOutput) ToBottlerocketRegistryMirrorOutputWithContext(ctx context.Context) BottlerocketRegistryMirrorOutput {
+	return o
+}
+
+// The endpoints of the mirror, in order of preference.
+func (o BottlerocketRegistryMirrorOutput) Endpoints() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v BottlerocketRegistryMirror) []string { return v.Endpoints }).(pulumi.StringArrayOutput)
+}
+
+// The registry to mirror, e.g. `docker.io`.
+func (o BottlerocketRegistryMirrorOutput) Registry() pulumi.StringOutput {
+	return o.ApplyT(func(v BottlerocketRegistryMirror) string { return v.Registry }).(pulumi.StringOutput)
+}
+
+type BottlerocketRegistryMirrorArrayOutput struct{ *pulumi.OutputState }
+
+func (BottlerocketRegistryMirrorArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]BottlerocketRegistryMirror)(nil)).Elem()
+}
+
+func (o BottlerocketRegistryMirrorArrayOutput) ToBottlerocketRegistryMirrorArrayOutput() BottlerocketRegistryMirrorArrayOutput {
+	return o
+}
+
+func (o BottlerocketRegistryMirrorArrayOutput) ToBottlerocketRegistryMirrorArrayOutputWithContext(ctx context.Context) BottlerocketRegistryMirrorArrayOutput {
+	return o
+}
+
+func (o BottlerocketRegistryMirrorArrayOutput) Index(i pulumi.IntInput) BottlerocketRegistryMirrorOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) BottlerocketRegistryMirror {
+		return vs[0].([]BottlerocketRegistryMirror)[vs[1].(int)]
+	}).(BottlerocketRegistryMirrorOutput)
+}
+
+// Configuration for the compute capability of your EKS Auto Mode cluster.
+type ClusterComputeConfig struct {
+	// Configuration for node pools that defines the compute resources for your EKS Auto Mode cluster. Valid options are `general-purpose` and `system`.
+	//
+	// By default, the built-in `system` and `general-purpose` nodepools are enabled.
+	NodePools []string `pulumi:"nodePools"`
+	// The ARN of the IAM Role EKS will assign to EC2 Managed Instances in your EKS Auto Mode cluster. This value cannot be changed after the compute capability of EKS Auto Mode is enabled.
+	NodeRoleArn *string `pulumi:"nodeRoleArn"`
+}
+
+// ClusterComputeConfigInput is an input type that accepts ClusterComputeConfigArgs and ClusterComputeConfigOutput values.
+// You can construct a concrete instance of `ClusterComputeConfigInput` via:
+//
+//	ClusterComputeConfigArgs{...}
+type ClusterComputeConfigInput interface {
+	pulumi.Input
+
+	ToClusterComputeConfigOutput() ClusterComputeConfigOutput
+	ToClusterComputeConfigOutputWithContext(context.Context) ClusterComputeConfigOutput
+}
+
+// Configuration for the compute capability of your EKS Auto Mode cluster.
+type ClusterComputeConfigArgs struct {
+	// Configuration for node pools that defines the compute resources for your EKS Auto Mode cluster. Valid options are `general-purpose` and `system`.
+	//
+	// By default, the built-in `system` and `general-purpose` nodepools are enabled.
+	NodePools pulumi.StringArrayInput `pulumi:"nodePools"`
+	// The ARN of the IAM Role EKS will assign to EC2 Managed Instances in your EKS Auto Mode cluster. This value cannot be changed after the compute capability of EKS Auto Mode is enabled.
+	NodeRoleArn pulumi.StringPtrInput `pulumi:"nodeRoleArn"`
+}
+
+func (ClusterComputeConfigArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ClusterComputeConfig)(nil)).Elem()
+}
+
+func (i ClusterComputeConfigArgs) ToClusterComputeConfigOutput() ClusterComputeConfigOutput {
+	return i.ToClusterComputeConfigOutputWithContext(context.Background())
+}
+
+func (i ClusterComputeConfigArgs) ToClusterComputeConfigOutputWithContext(ctx context.Context) ClusterComputeConfigOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClusterComputeConfigOutput)
+}
+
+func (i ClusterComputeConfigArgs) ToClusterComputeConfigPtrOutput() ClusterComputeConfigPtrOutput {
+	return i.ToClusterComputeConfigPtrOutputWithContext(context.Background())
+}
+
+func (i ClusterComputeConfigArgs) ToClusterComputeConfigPtrOutputWithContext(ctx context.Context) ClusterComputeConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClusterComputeConfigOutput).ToClusterComputeConfigPtrOutputWithContext(ctx)
+}
+
+// ClusterComputeConfigPtrInput is an input type that accepts ClusterComputeConfigArgs, ClusterComputeConfigPtr and ClusterComputeConfigPtrOutput values.
+// You can construct a concrete instance of `ClusterComputeConfigPtrInput` via:
+//
+//	        ClusterComputeConfigArgs{...}
+//
+//	or:
+//
+//	        nil
+type ClusterComputeConfigPtrInput interface {
+	pulumi.Input
+
+	ToClusterComputeConfigPtrOutput() ClusterComputeConfigPtrOutput
+	ToClusterComputeConfigPtrOutputWithContext(context.Context) ClusterComputeConfigPtrOutput
+}
+
+type clusterComputeConfigPtrType ClusterComputeConfigArgs
+
+func ClusterComputeConfigPtr(v *ClusterComputeConfigArgs) ClusterComputeConfigPtrInput {
+	return (*clusterComputeConfigPtrType)(v)
+}
+
+func (*clusterComputeConfigPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ClusterComputeConfig)(nil)).Elem()
+}
+
+func (i *clusterComputeConfigPtrType) ToClusterComputeConfigPtrOutput() ClusterComputeConfigPtrOutput {
+	return i.ToClusterComputeConfigPtrOutputWithContext(context.Background())
+}
+
+func (i *clusterComputeConfigPtrType) ToClusterComputeConfigPtrOutputWithContext(ctx context.Context) ClusterComputeConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClusterComputeConfigPtrOutput)
+}
+
+// Configuration for the compute capability of your EKS Auto Mode cluster.
+type ClusterComputeConfigOutput struct{ *pulumi.OutputState }
+
+func (ClusterComputeConfigOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ClusterComputeConfig)(nil)).Elem()
+}
+
+func (o ClusterComputeConfigOutput) ToClusterComputeConfigOutput() ClusterComputeConfigOutput {
+	return o
+}
+
+func (o ClusterComputeConfigOutput) ToClusterComputeConfigOutputWithContext(ctx context.Context) ClusterComputeConfigOutput {
+	return o
+}
+
+func (o ClusterComputeConfigOutput) ToClusterComputeConfigPtrOutput() ClusterComputeConfigPtrOutput {
+	return o.ToClusterComputeConfigPtrOutputWithContext(context.Background())
+}
+
+func (o ClusterComputeConfigOutput) ToClusterComputeConfigPtrOutputWithContext(ctx context.Context) ClusterComputeConfigPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ClusterComputeConfig) *ClusterComputeConfig {
+		return &v
+	}).(ClusterComputeConfigPtrOutput)
+}
+
+// Configuration for node pools that defines the compute resources for your EKS Auto Mode cluster. Valid options are `general-purpose` and `system`.
+//
+// By default, the built-in `system` and `general-purpose` nodepools are enabled.
+func (o ClusterComputeConfigOutput) NodePools() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ClusterComputeConfig) []string { return v.NodePools }).(pulumi.StringArrayOutput)
+}
+
+// The ARN of the IAM Role EKS will assign to EC2 Managed Instances in your EKS Auto Mode cluster. This value cannot be changed after the compute capability of EKS Auto Mode is enabled.
+func (o ClusterComputeConfigOutput) NodeRoleArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClusterComputeConfig) *string { return v.NodeRoleArn }).(pulumi.StringPtrOutput)
+}
+
+type ClusterComputeConfigPtrOutput struct{ *pulumi.OutputState }
+
+func (ClusterComputeConfigPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ClusterComputeConfig)(nil)).Elem()
+}
+
+func (o ClusterComputeConfigPtrOutput) ToClusterComputeConfigPtrOutput() ClusterComputeConfigPtrOutput {
+	return o
+}
+
+func (o ClusterComputeConfigPtrOutput) ToClusterComputeConfigPtrOutputWithContext(ctx context.Context) ClusterComputeConfigPtrOutput {
+	return o
+}
+
+func (o ClusterComputeConfigPtrOutput) Elem() ClusterComputeConfigOutput {
+	return o.ApplyT(func(v *ClusterComputeConfig) ClusterComputeConfig {
+		if v != nil {
+			return *v
+		}
+		var ret ClusterComputeConfig
+		return ret
+	}).(ClusterComputeConfigOutput)
+}
+
+// Configuration for node pools that defines the compute resources for your EKS Auto Mode cluster. Valid options are `general-purpose` and `system`.
+//
+// By default, the built-in `system` and `general-purpose` nodepools are enabled.
+func (o ClusterComputeConfigPtrOutput) NodePools() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *ClusterComputeConfig) []string {
+		if v == nil {
+			return nil
+		}
+		return v.NodePools
+	}).(pulumi.StringArrayOutput)
+}
+
+// The ARN of the IAM Role EKS will assign to EC2 Managed Instances in your EKS Auto Mode cluster. This value cannot be changed after the compute capability of EKS Auto Mode is enabled.
+func (o ClusterComputeConfigPtrOutput) NodeRoleArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ClusterComputeConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.NodeRoleArn
+	}).(pulumi.StringPtrOutput)
+}
+
+// Describes the configuration options accepted by a cluster to create its own node groups.
+type ClusterNodeGroupOptions struct {
+	// The AMI ID to use for the worker nodes.
+	//
+	// Defaults to the latest recommended EKS Optimized Linux AMI from the AWS Systems Manager Parameter Store.
+	//
+	// Note: `amiId` and `gpu` are mutually exclusive.
+	//
+	// See for more details:
+	// - https://docs.aws.amazon.com/eks/latest/userguide/eks-optimized-ami.html.
+	AmiId *string `pulumi:"amiId"`
+	// The AMI Type to use for the worker nodes.
+	//
+	// Only applicable when setting an AMI ID that is of type `arm64`.
+	//
+	// Note: `amiType` and `gpu` are mutually exclusive.
+	AmiType *string `pulumi:"amiType"`
+	// The tags to apply to the NodeGroup's AutoScalingGroup in the CloudFormation Stack.
+	//
+	// Per AWS, all stack-level tags, including automatically created tags, and the `cloudFormationTags` option are propagated to resources that AWS CloudFormation supports, including the AutoScalingGroup. See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-resource-tags.html
+	//
+	// Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
+	AutoScalingGroupTags map[string]string `pulumi:"autoScalingGroupTags"`
+	// Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+	//
+	// For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+	AutoscalerDiscovery *bool `pulumi:"autoscalerDiscovery"`
+	// Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
+	BootstrapExtraArgs *string `pulumi:"bootstrapExtraArgs"`
+	// Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+	// The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+	BottlerocketConfig *BottlerocketConfig `pulumi:"bottlerocketConfig"`
+	// The configuration settings for Bottlerocket OS.
+	// The settings will get merged with the base settings the provider uses to configure Bottlerocket.
+	//
+	// This includes:
+	//   - settings.kubernetes.api-server
+	//   - settings.kubernetes.cluster-certificate
+	//   - settings.kubernetes.cluster-name
+	//   - settings.kubernetes.cluster-dns-ip
+	//
+	// For an overview of the available settings, see https://bottlerocket.dev/en/os/1.20.x/api/settings/.
+	BottlerocketSettings map[string]interface{} `pulumi:"bottlerocketSettings"`
+	// The tags to apply to the CloudFormation Stack of the Worker NodeGroup.
+	//
+	// Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
+	CloudFormationTags map[string]string `pulumi:"cloudFormationTags"`
+	// The ingress rule that gives node group access.
+	ClusterIngressRule *ec2.SecurityGroupRule `pulumi:"clusterIngressRule"`
+	// The ID of the ingress rule that gives node group access.
+	ClusterIngressRuleId *string `pulumi:"clusterIngressRuleId"`
+	// The number of worker nodes that should be running in the cluster. Defaults to 2.
+	DesiredCapacity *int `pulumi:"desiredCapacity"`
+	// Enables/disables detailed monitoring of the EC2 instances.
+	//
+	// With detailed monitoring, all metrics, including status check metrics, are available in 1-minute intervals.
+	// When enabled, you can also get aggregated data across groups of similar instances.
+	//
+	// Note: You are charged per metric that is sent to CloudWatch. You are not charged for data storage.
+	// For more information, see "Paid tier" and "Example 1 - EC2 Detailed Monitoring" here https://aws.amazon.com/cloudwatch/pricing/.
+	EnableDetailedMonitoring *bool `pulumi:"enableDetailedMonitoring"`
+	// Encrypt the root block device of the nodes in the node group.
+	EncryptRootBlockDevice *bool `pulumi:"encryptRootBlockDevice"`
+	// Extra security groups to attach on all nodes in this worker node group.
+	//
+	// This additional set of security groups captures any user application rules that will be needed for the nodes.
+	ExtraNodeSecurityGroups []*ec2.SecurityGroup `pulumi:"extraNodeSecurityGroups"`
+	// Use the latest recommended EKS Optimized Linux AMI with GPU support for the worker nodes from the AWS Systems Manager Parameter Store.
+	//
+	// Defaults to false.
+	//
+	// Note: `gpu` and `amiId` are mutually exclusive.
+	//
+	// See for more details:
+	// - https://docs.aws.amazon.com/eks/latest/userguide/eks-optimized-ami.html
+	// - https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
+	Gpu *bool `pulumi:"gpu"`
+	// Whether to ignore changes to the desired size of the Auto Scaling Group. This is useful when using Cluster Autoscaler.
+	//
+	// See [EKS best practices](https://aws.github.io/aws-eks-best-practices/cluster-autoscaling/) for more details.
+	IgnoreScalingChanges *bool `pulumi:"ignoreScalingChanges"`
+	// The IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
+	InstanceProfile *iam.InstanceProfile `pulumi:"instanceProfile"`
+	// The name of the IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
+	InstanceProfileName *string `pulumi:"instanceProfileName"`
+	// The instance type to use for the cluster's nodes. Defaults to "t3.medium".
+	InstanceType *string `pulumi:"instanceType"`
+	// Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+	//
+	// See for more details: https://github.com/aws/aws-node-termination-handler
+	InterruptionHandling *InterruptionHandling `pulumi:"interruptionHandling"`
+	// Name of the key pair to use for SSH access to worker nodes.
+	KeyName *string `pulumi:"keyName"`
+	// Extra args to pass to the Kubelet. Corresponds to the options passed in the `--kubeletExtraArgs` flag to `/etc/eks/bootstrap.sh`. For example, '--port=10251 --address=0.0.0.0'. Note that the `labels` and `taints` properties will be applied to this list (using `--node-labels` and `--register-with-taints` respectively) after to the explicit `kubeletExtraArgs`.
+	KubeletExtraArgs *string `pulumi:"kubeletExtraArgs"`
+	// Custom k8s node labels to be attached to each worker node. Adds the given key/value pairs to the `--node-labels` kubelet argument.
+	Labels map[string]string `pulumi:"labels"`
+	// The tag specifications to apply to the launch template.
+	LaunchTemplateTagSpecifications []ec2.LaunchTemplateTagSpecification `pulumi:"launchTemplateTagSpecifications"`
+	// The maximum number of worker nodes running in the cluster. Defaults to 2.
+	MaxSize *int `pulumi:"maxSize"`
+	// The minimum amount of instances that should remain available during an instance refresh, expressed as a percentage. Defaults to 50.
+	MinRefreshPercentage *int `pulumi:"minRefreshPercentage"`
+	// The minimum number of worker nodes running in the cluster. Defaults to 1.
+	MinSize *int `pulumi:"minSize"`
+	// Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
+	NodeAssociatePublicIpAddress *bool `pulumi:"nodeAssociatePublicIpAddress"`
+	// Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+	//
+	// Note: This is only applicable when using AL2023.
+	// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+	NodeConfig *NodeConfig `pulumi:"nodeConfig"`
+	// Public key material for SSH access to worker nodes. See allowed formats at:
+	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
+	// If not provided, no SSH access is enabled on VMs.
+	NodePublicKey *string `pulumi:"nodePublicKey"`
+	// Whether the root block device should be deleted on termination of the instance. Defaults to true.
+	NodeRootVolumeDeleteOnTermination *bool `pulumi:"nodeRootVolumeDeleteOnTermination"`
+	// Whether to encrypt a cluster node's root volume. Defaults to false.
+	NodeRootVolumeEncrypted *bool `pulumi:"nodeRootVolumeEncrypted"`
+	// The amount of provisioned IOPS. This is only valid with a volumeType of 'io1'.
+	NodeRootVolumeIops *int `pulumi:"nodeRootVolumeIops"`
+	// The size in GiB of a cluster node's root volume. Defaults to 20.
+	NodeRootVolumeSize *int `pulumi:"nodeRootVolumeSize"`
+	// Provisioned throughput performance in integer MiB/s for a cluster node's root volume. This is only valid with a volumeType of 'gp3'.
+	NodeRootVolumeThroughput *int `pulumi:"nodeRootVolumeThroughput"`
+	// Configured EBS type for a cluster node's root volume. Default is 'gp2'. Supported values are 'standard', 'gp2', 'gp3', 'st1', 'sc1', 'io1'.
+	NodeRootVolumeType *string `pulumi:"nodeRootVolumeType"`
+	// The security group for the worker node group to communicate with the cluster.
+	//
+	// This security group requires specific inbound and outbound rules.
+	//
+	// See for more details:
+	// https://docs.aws.amazon.com/eks/latest/userguide/sec-group-reqs.html
+	//
+	// Note: The `nodeSecurityGroup` option and the cluster option`nodeSecurityGroupTags` are mutually exclusive.
+	NodeSecurityGroup *ec2.SecurityGroup `pulumi:"nodeSecurityGroup"`
+	// The ID of the security group for the worker node group to communicate with the cluster.
+	//
+	// This security group requires specific inbound and outbound rules.
+	//
+	// See for more details:
+	// https://docs.aws.amazon.com/eks/latest/userguide/sec-group-reqs.html
+	//
+	// Note: The `nodeSecurityGroupId` option and the cluster option `nodeSecurityGroupTags` are mutually exclusive.
+	NodeSecurityGroupId *string `pulumi:"nodeSecurityGroupId"`
+	// The set of subnets to override and use for the worker node group.
+	//
+	// Setting this option overrides which subnets to use for the worker node group, regardless if the cluster's `subnetIds` is set, or if `publicSubnetIds` and/or `privateSubnetIds` were set.
+	NodeSubnetIds []string `pulumi:"nodeSubnetIds"`
+	// Extra code to run on node startup. This code will run after the AWS EKS bootstrapping code and before the node signals its readiness to the managing CloudFormation stack. This code must be a typical user data script: critically it must begin with an interpreter directive (i.e. a `#!`).
+	NodeUserData *string `pulumi:"nodeUserData"`
+	// User specified code to run on node startup. This code is expected to handle the full AWS EKS bootstrapping code and signal node readiness to the managing CloudFormation stack. This code must be a complete and executable user data script in bash (Linux) or powershell (Windows).
+	//
+	// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/worker.html
+	NodeUserDataOverride *string `pulumi:"nodeUserDataOverride"`
+	// Extra nodeadm configuration sections to be added to the nodeadm user data. This can be shell scripts, nodeadm NodeConfig or any other user data compatible script. When configuring additional nodeadm NodeConfig sections, they'll be merged with the base settings the provider sets. You can overwrite base settings or provide additional settings this way.
+	// The base settings the provider sets are:
+	//   - cluster.name
+	//   - cluster.apiServerEndpoint
+	//   - cluster.certificateAuthority
+	//   - cluster.cidr
+	//
+	// Note: This is only applicable when using AL2023.
+	// See for more details:
+	//   - https://awslabs.github.io/amazon-eks-ami/nodeadm/
+	//   - https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+	NodeadmExtraOptions []NodeadmOptions `pulumi:"nodeadmExtraOptions"`
+	// The type of OS to use for the node group. Will be used to determine the right EKS optimized AMI to use based on the instance types and gpu configuration.
+	// Valid values are `RECOMMENDED`, `AL2`, `AL2023` and `Bottlerocket`.
+	//
+	// Defaults to the current recommended OS.
+	OperatingSystem *OperatingSystem `pulumi:"operatingSystem"`
+	// Bidding price for spot instance. If set, only spot instances will be added as worker node.
+	SpotPrice *string `pulumi:"spotPrice"`
+	// Custom k8s node taints to be attached to each worker node. Adds the given taints to the `--register-with-taints` kubelet argument
+	Taints map[string]Taint `pulumi:"taints"`
+	// Desired Kubernetes master / control plane version. If you do not specify a value, the latest available version is used.
+	Version *string `pulumi:"version"`
+}
+
+// ClusterNodeGroupOptionsInput is an input type that accepts ClusterNodeGroupOptionsArgs and ClusterNodeGroupOptionsOutput values.
+// You can construct a concrete instance of `ClusterNodeGroupOptionsInput` via:
+//
+//	ClusterNodeGroupOptionsArgs{...}
+type ClusterNodeGroupOptionsInput interface {
+	pulumi.Input
+
+	ToClusterNodeGroupOptionsOutput() ClusterNodeGroupOptionsOutput
+	ToClusterNodeGroupOptionsOutputWithContext(context.Context) ClusterNodeGroupOptionsOutput
+}
+
+// Describes the configuration options accepted by a cluster to create its own node groups.
+type ClusterNodeGroupOptionsArgs struct {
+	// The AMI ID to use for the worker nodes.
+	//
+	// Defaults to the latest recommended EKS Optimized Linux AMI from the AWS Systems Manager Parameter Store.
+	//
+	// Note: `amiId` and `gpu` are mutually exclusive.
+	//
+	// See for more details:
+	// - https://docs.aws.amazon.com/eks/latest/userguide/eks-optimized-ami.html.
+	AmiId pulumi.StringPtrInput `pulumi:"amiId"`
+	// The AMI Type to use for the worker nodes.
+	//
+	// Only applicable when setting an AMI ID that is of type `arm64`.
+	//
+	// Note: `amiType` and `gpu` are mutually exclusive.
+	AmiType pulumi.StringPtrInput `pulumi:"amiType"`
 	// The tags to apply to the NodeGroup's AutoScalingGroup in the CloudFormation Stack.
 	//
 	// Per AWS, all stack-level tags, including automatically created tags, and the `cloudFormationTags` option are propagated to resources that AWS CloudFormation supports, including the AutoScalingGroup. See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-resource-tags.html
 	//
 	// Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
 	AutoScalingGroupTags pulumi.StringMapInput `pulumi:"autoScalingGroupTags"`
+	// Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+	//
+	// For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+	AutoscalerDiscovery *bool `pulumi:"autoscalerDiscovery"`
 	// Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
 	BootstrapExtraArgs pulumi.StringPtrInput `pulumi:"bootstrapExtraArgs"`
+	// Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+	// The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+	BottlerocketConfig BottlerocketConfigPtrInput `pulumi:"bottlerocketConfig"`
 	// The configuration settings for Bottlerocket OS.
 	// The settings will get merged with the base settings the provider uses to configure Bottlerocket.
 	//
@@ -933,6 +3090,10 @@ type ClusterNodeGroupOptionsArgs struct {
 	InstanceProfileName pulumi.StringPtrInput `pulumi:"instanceProfileName"`
 	// The instance type to use for the cluster's nodes. Defaults to "t3.medium".
 	InstanceType pulumi.StringPtrInput `pulumi:"instanceType"`
+	// Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+	//
+	// See for more details: https://github.com/aws/aws-node-termination-handler
+	InterruptionHandling *InterruptionHandlingArgs `pulumi:"interruptionHandling"`
 	// Name of the key pair to use for SSH access to worker nodes.
 	KeyName pulumi.StringPtrInput `pulumi:"keyName"`
 	// Extra args to pass to the Kubelet. Corresponds to the options passed in the `--kubeletExtraArgs` flag to `/etc/eks/bootstrap.sh`. For example, '--port=10251 --address=0.0.0.0'. Note that the `labels` and `taints` properties will be applied to this list (using `--node-labels` and `--register-with-taints` respectively) after to the explicit `kubeletExtraArgs`.
@@ -949,6 +3110,11 @@ type ClusterNodeGroupOptionsArgs struct {
 	MinSize pulumi.IntPtrInput `pulumi:"minSize"`
 	// Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
 	NodeAssociatePublicIpAddress pulumi.BoolPtrInput `pulumi:"nodeAssociatePublicIpAddress"`
+	// Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+	//
+	// Note: This is only applicable when using AL2023.
+	// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+	NodeConfig NodeConfigPtrInput `pulumi:"nodeConfig"`
 	// Public key material for SSH access to worker nodes. See allowed formats at:
 	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
 	// If not provided, no SSH access is enabled on VMs.
@@ -1000,905 +3166,2558 @@ type ClusterNodeGroupOptionsArgs struct {
 	//   - cluster.certificateAuthority
 	//   - cluster.cidr
 	//
-	// Note: This is only applicable when using AL2023.
-	// See for more details:
-	//   - https://awslabs.github.io/amazon-eks-ami/nodeadm/
-	//   - https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
-	NodeadmExtraOptions NodeadmOptionsArrayInput `pulumi:"nodeadmExtraOptions"`
-	// The type of OS to use for the node group. Will be used to determine the right EKS optimized AMI to use based on the instance types and gpu configuration.
-	// Valid values are `RECOMMENDED`, `AL2`, `AL2023` and `Bottlerocket`.
+	// Note: This is only applicable when using AL2023.
+	// See for more details:
+	//   - https://awslabs.github.io/amazon-eks-ami/nodeadm/
+	//   - https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+	NodeadmExtraOptions NodeadmOptionsArrayInput `pulumi:"nodeadmExtraOptions"`
+	// The type of OS to use for the node group. Will be used to determine the right EKS optimized AMI to use based on the instance types and gpu configuration.
+	// Valid values are `RECOMMENDED`, `AL2`, `AL2023` and `Bottlerocket`.
+	//
+	// Defaults to the current recommended OS.
+	OperatingSystem OperatingSystemPtrInput `pulumi:"operatingSystem"`
+	// Bidding price for spot instance. If set, only spot instances will be added as worker node.
+	SpotPrice pulumi.StringPtrInput `pulumi:"spotPrice"`
+	// Custom k8s node taints to be attached to each worker node. Adds the given taints to the `--register-with-taints` kubelet argument
+	Taints TaintMapInput `pulumi:"taints"`
+	// Desired Kubernetes master / control plane version. If you do not specify a value, the latest available version is used.
+	Version pulumi.StringPtrInput `pulumi:"version"`
+}
+
+func (ClusterNodeGroupOptionsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ClusterNodeGroupOptions)(nil)).Elem()
+}
+
+func (i ClusterNodeGroupOptionsArgs) ToClusterNodeGroupOptionsOutput() ClusterNodeGroupOptionsOutput {
+	return i.ToClusterNodeGroupOptionsOutputWithContext(context.Background())
+}
+
+func (i ClusterNodeGroupOptionsArgs) ToClusterNodeGroupOptionsOutputWithContext(ctx context.Context) ClusterNodeGroupOptionsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClusterNodeGroupOptionsOutput)
+}
+
+func (i ClusterNodeGroupOptionsArgs) ToClusterNodeGroupOptionsPtrOutput() ClusterNodeGroupOptionsPtrOutput {
+	return i.ToClusterNodeGroupOptionsPtrOutputWithContext(context.Background())
+}
+
+func (i ClusterNodeGroupOptionsArgs) ToClusterNodeGroupOptionsPtrOutputWithContext(ctx context.Context) ClusterNodeGroupOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClusterNodeGroupOptionsOutput).ToClusterNodeGroupOptionsPtrOutputWithContext(ctx)
+}
+
+// ClusterNodeGroupOptionsPtrInput is an input type that accepts ClusterNodeGroupOptionsArgs, ClusterNodeGroupOptionsPtr and ClusterNodeGroupOptionsPtrOutput values.
+// You can construct a concrete instance of `ClusterNodeGroupOptionsPtrInput` via:
+//
+//	        ClusterNodeGroupOptionsArgs{...}
+//
+//	or:
+//
+//	        nil
+type ClusterNodeGroupOptionsPtrInput interface {
+	pulumi.Input
+
+	ToClusterNodeGroupOptionsPtrOutput() ClusterNodeGroupOptionsPtrOutput
+	ToClusterNodeGroupOptionsPtrOutputWithContext(context.Context) ClusterNodeGroupOptionsPtrOutput
+}
+
+type clusterNodeGroupOptionsPtrType ClusterNodeGroupOptionsArgs
+
+func ClusterNodeGroupOptionsPtr(v *ClusterNodeGroupOptionsArgs) ClusterNodeGroupOptionsPtrInput {
+	return (*clusterNodeGroupOptionsPtrType)(v)
+}
+
+func (*clusterNodeGroupOptionsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ClusterNodeGroupOptions)(nil)).Elem()
+}
+
+func (i *clusterNodeGroupOptionsPtrType) ToClusterNodeGroupOptionsPtrOutput() ClusterNodeGroupOptionsPtrOutput {
+	return i.ToClusterNodeGroupOptionsPtrOutputWithContext(context.Background())
+}
+
+func (i *clusterNodeGroupOptionsPtrType) ToClusterNodeGroupOptionsPtrOutputWithContext(ctx context.Context) ClusterNodeGroupOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClusterNodeGroupOptionsPtrOutput)
+}
+
+// Describes the configuration options accepted by a cluster to create its own node groups.
+type ClusterNodeGroupOptionsOutput struct{ *pulumi.OutputState }
+
+func (ClusterNodeGroupOptionsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ClusterNodeGroupOptions)(nil)).Elem()
+}
+
+func (o ClusterNodeGroupOptionsOutput) ToClusterNodeGroupOptionsOutput() ClusterNodeGroupOptionsOutput {
+	return o
+}
+
+func (o ClusterNodeGroupOptionsOutput) ToClusterNodeGroupOptionsOutputWithContext(ctx context.Context) ClusterNodeGroupOptionsOutput {
+	return o
+}
+
+func (o ClusterNodeGroupOptionsOutput) ToClusterNodeGroupOptionsPtrOutput() ClusterNodeGroupOptionsPtrOutput {
+	return o.ToClusterNodeGroupOptionsPtrOutputWithContext(context.Background())
+}
+
+func (o ClusterNodeGroupOptionsOutput) ToClusterNodeGroupOptionsPtrOutputWithContext(ctx context.Context) ClusterNodeGroupOptionsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ClusterNodeGroupOptions) *ClusterNodeGroupOptions {
+		return &v
+	}).(ClusterNodeGroupOptionsPtrOutput)
+}
+
+// The AMI ID to use for the worker nodes.
+//
+// Defaults to the latest recommended EKS Optimized Linux AMI from the AWS Systems Manager Parameter Store.
+//
+// Note: `amiId` and `gpu` are mutually exclusive.
+//
+// See for more details:
+// - https://docs.aws.amazon.com/eks/latest/userguide/eks-optimized-ami.html.
+func (o ClusterNodeGroupOptionsOutput) AmiId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.AmiId }).(pulumi.StringPtrOutput)
+}
+
+// The AMI Type to use for the worker nodes.
+//
+// Only applicable when setting an AMI ID that is of type `arm64`.
+//
+// Note: `amiType` and `gpu` are mutually exclusive.
+func (o ClusterNodeGroupOptionsOutput) AmiType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.AmiType }).(pulumi.StringPtrOutput)
+}
+
+// The tags to apply to the NodeGroup's AutoScalingGroup in the CloudFormation Stack.
+//
+// Per AWS, all stack-level tags, including automatically created tags, and the `cloudFormationTags` option are propagated to resources that AWS CloudFormation supports, including the AutoScalingGroup. See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-resource-tags.html
+//
+// Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
+func (o ClusterNodeGroupOptionsOutput) AutoScalingGroupTags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) map[string]string { return v.AutoScalingGroupTags }).(pulumi.StringMapOutput)
+}
+
+// Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+//
+// For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+func (o ClusterNodeGroupOptionsOutput) AutoscalerDiscovery() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *bool { return v.AutoscalerDiscovery }).(pulumi.BoolPtrOutput)
+}
+
+// Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
+func (o ClusterNodeGroupOptionsOutput) BootstrapExtraArgs() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.BootstrapExtraArgs }).(pulumi.StringPtrOutput)
+}
+
+// Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+// The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+func (o ClusterNodeGroupOptionsOutput) BottlerocketConfig() BottlerocketConfigPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *BottlerocketConfig { return v.BottlerocketConfig }).(BottlerocketConfigPtrOutput)
+}
+
+// The configuration settings for Bottlerocket OS.
+// The settings will get merged with the base settings the provider uses to configure Bottlerocket.
+//
+// This includes:
+//   - settings.kubernetes.api-server
+//   - settings.kubernetes.cluster-certificate
+//   - settings.kubernetes.cluster-name
+//   - settings.kubernetes.cluster-dns-ip
+//
+// For an overview of the available settings, see https://bottlerocket.dev/en/os/1.20.x/api/settings/.
+func (o ClusterNodeGroupOptionsOutput) BottlerocketSettings() pulumi.MapOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) map[string]interface{} { return v.BottlerocketSettings }).(pulumi.MapOutput)
+}
+
+// The tags to apply to the CloudFormation Stack of the Worker NodeGroup.
+//
+// Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
+func (o ClusterNodeGroupOptionsOutput) CloudFormationTags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) map[string]string { return v.CloudFormationTags }).(pulumi.StringMapOutput)
+}
+
+// The ingress rule that gives node group access.
+func (o ClusterNodeGroupOptionsOutput) ClusterIngressRule() ec2.SecurityGroupRuleOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *ec2.SecurityGroupRule { return v.ClusterIngressRule }).(ec2.SecurityGroupRuleOutput)
+}
+
+// The ID of the ingress rule that gives node group access.
+func (o ClusterNodeGroupOptionsOutput) ClusterIngressRuleId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.ClusterIngressRuleId }).(pulumi.StringPtrOutput)
+}
+
+// The number of worker nodes that should be running in the cluster. Defaults to 2.
+func (o ClusterNodeGroupOptionsOutput) DesiredCapacity() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *int { return v.DesiredCapacity }).(pulumi.IntPtrOutput)
+}
+
+// Enables/disables detailed monitoring of the EC2 instances.
+//
+// With detailed monitoring, all metrics, including status check metrics, are available in 1-minute intervals.
+// When enabled, you can also get aggregated data across groups of similar instances.
+//
+// Note: You are charged per metric that is sent to CloudWatch. You are not charged for data storage.
+// For more information, see "Paid tier" and "Example 1 - EC2 Detailed Monitoring" here https://aws.amazon.com/cloudwatch/pricing/.
+func (o ClusterNodeGroupOptionsOutput) EnableDetailedMonitoring() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *bool { return v.EnableDetailedMonitoring }).(pulumi.BoolPtrOutput)
+}
+
+// Encrypt the root block device of the nodes in the node group.
+func (o ClusterNodeGroupOptionsOutput) EncryptRootBlockDevice() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *bool { return v.EncryptRootBlockDevice }).(pulumi.BoolPtrOutput)
+}
+
+// Extra security groups to attach on all nodes in this worker node group.
+//
+// This additional set of security groups captures any user application rules that will be needed for the nodes.
+func (o ClusterNodeGroupOptionsOutput) ExtraNodeSecurityGroups() ec2.SecurityGroupArrayOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) []*ec2.SecurityGroup { return v.ExtraNodeSecurityGroups }).(ec2.SecurityGroupArrayOutput)
+}
+
+// Use the latest recommended EKS Optimized Linux AMI with GPU support for the worker nodes from the AWS Systems Manager Parameter Store.
+//
+// Defaults to false.
+//
+// Note: `gpu` and `amiId` are mutually exclusive.
+//
+// See for more details:
+// - https://docs.aws.amazon.com/eks/latest/userguide/eks-optimized-ami.html
+// - https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
+func (o ClusterNodeGroupOptionsOutput) Gpu() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *bool { return v.Gpu }).(pulumi.BoolPtrOutput)
+}
+
+// Whether to ignore changes to the desired size of the Auto Scaling Group. This is useful when using Cluster Autoscaler.
+//
+// See [EKS best practices](https://aws.github.io/aws-eks-best-practices/cluster-autoscaling/) for more details.
+func (o ClusterNodeGroupOptionsOutput) IgnoreScalingChanges() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *bool { return v.IgnoreScalingChanges }).(pulumi.BoolPtrOutput)
+}
+
+// The IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
+func (o ClusterNodeGroupOptionsOutput) InstanceProfile() iam.InstanceProfileOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *iam.InstanceProfile { return v.InstanceProfile }).(iam.InstanceProfileOutput)
+}
+
+// The name of the IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
+func (o ClusterNodeGroupOptionsOutput) InstanceProfileName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.InstanceProfileName }).(pulumi.StringPtrOutput)
+}
+
+// The instance type to use for the cluster's nodes. Defaults to "t3.medium".
+func (o ClusterNodeGroupOptionsOutput) InstanceType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.InstanceType }).(pulumi.StringPtrOutput)
+}
+
+// Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+//
+// See for more details: https://github.com/aws/aws-node-termination-handler
+func (o ClusterNodeGroupOptionsOutput) InterruptionHandling() InterruptionHandlingPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *InterruptionHandling { return v.InterruptionHandling }).(InterruptionHandlingPtrOutput)
+}
+
+// Name of the key pair to use for SSH access to worker nodes.
+func (o ClusterNodeGroupOptionsOutput) KeyName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.KeyName }).(pulumi.StringPtrOutput)
+}
+
+// Extra args to pass to the Kubelet. Corresponds to the options passed in the `--kubeletExtraArgs` flag to `/etc/eks/bootstrap.sh`. For example, '--port=10251 --address=0.0.0.0'. Note that the `labels` and `taints` properties will be applied to this list (using `--node-labels` and `--register-with-taints` respectively) after to the explicit `kubeletExtraArgs`.
+func (o ClusterNodeGroupOptionsOutput) KubeletExtraArgs() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.KubeletExtraArgs }).(pulumi.StringPtrOutput)
+}
+
+// Custom k8s node labels to be attached to each worker node. Adds the given key/value pairs to the `--node-labels` kubelet argument.
+func (o ClusterNodeGroupOptionsOutput) Labels() pulumi.StringMapOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) map[string]string { return v.Labels }).(pulumi.StringMapOutput)
+}
+
+// The tag specifications to apply to the launch template.
+func (o ClusterNodeGroupOptionsOutput) LaunchTemplateTagSpecifications() ec2.LaunchTemplateTagSpecificationArrayOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) []ec2.LaunchTemplateTagSpecification {
+		return v.LaunchTemplateTagSpecifications
+	}).(ec2.LaunchTemplateTagSpecificationArrayOutput)
+}
+
+// The maximum number of worker nodes running in the cluster. Defaults to 2.
+func (o ClusterNodeGroupOptionsOutput) MaxSize() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *int { return v.MaxSize }).(pulumi.IntPtrOutput)
+}
+
+// The minimum amount of instances that should remain available during an instance refresh, expressed as a percentage. Defaults to 50.
+func (o ClusterNodeGroupOptionsOutput) MinRefreshPercentage() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *int { return v.MinRefreshPercentage }).(pulumi.IntPtrOutput)
+}
+
+// The minimum number of worker nodes running in the cluster. Defaults to 1.
+func (o ClusterNodeGroupOptionsOutput) MinSize() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *int { return v.MinSize }).(pulumi.IntPtrOutput)
+}
+
+// Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
+func (o ClusterNodeGroupOptionsOutput) NodeAssociatePublicIpAddress() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *bool { return v.NodeAssociatePublicIpAddress }).(pulumi.BoolPtrOutput)
+}
+
+// Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+//
+// Note: This is only applicable when using AL2023.
+// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+func (o ClusterNodeGroupOptionsOutput) NodeConfig() NodeConfigPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *NodeConfig { return v.NodeConfig }).(NodeConfigPtrOutput)
+}
+
+// Public key material for SSH access to worker nodes. See allowed formats at:
+// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
+// If not provided, no SSH access is enabled on VMs.
+func (o ClusterNodeGroupOptionsOutput) NodePublicKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.NodePublicKey }).(pulumi.StringPtrOutput)
+}
+
+// Whether the root block device should be deleted on termination of the instance. Defaults to true.
+func (o ClusterNodeGroupOptionsOutput) NodeRootVolumeDeleteOnTermination() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *bool { return v.NodeRootVolumeDeleteOnTermination }).(pulumi.BoolPtrOutput)
+}
+
+// Whether to encrypt a cluster node's root volume. Defaults to false.
+func (o ClusterNodeGroupOptionsOutput) NodeRootVolumeEncrypted() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *bool { return v.NodeRootVolumeEncrypted }).(pulumi.BoolPtrOutput)
+}
+
+// The amount of provisioned IOPS. This is only valid with a volumeType of 'io1'.
+func (o ClusterNodeGroupOptionsOutput) NodeRootVolumeIops() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *int { return v.NodeRootVolumeIops }).(pulumi.IntPtrOutput)
+}
+
+// The size in GiB of a cluster node's root volume. Defaults to 20.
+func (o ClusterNodeGroupOptionsOutput) NodeRootVolumeSize() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *int { return v.NodeRootVolumeSize }).(pulumi.IntPtrOutput)
+}
+
+// Provisioned throughput performance in integer MiB/s for a cluster node's root volume. This is only valid with a volumeType of 'gp3'.
+func (o ClusterNodeGroupOptionsOutput) NodeRootVolumeThroughput() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *int { return v.NodeRootVolumeThroughput }).(pulumi.IntPtrOutput)
+}
+
+// Configured EBS type for a cluster node's root volume. Default is 'gp2'. Supported values are 'standard', 'gp2', 'gp3', 'st1', 'sc1', 'io1'.
+func (o ClusterNodeGroupOptionsOutput) NodeRootVolumeType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.NodeRootVolumeType }).(pulumi.StringPtrOutput)
+}
+
+// The security group for the worker node group to communicate with the cluster.
+//
+// This security group requires specific inbound and outbound rules.
+//
+// See for more details:
+// https://docs.aws.amazon.com/eks/latest/userguide/sec-group-reqs.html
+//
+// Note: The `nodeSecurityGroup` option and the cluster option`nodeSecurityGroupTags` are mutually exclusive.
+func (o ClusterNodeGroupOptionsOutput) NodeSecurityGroup() ec2.SecurityGroupOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *ec2.SecurityGroup { return v.NodeSecurityGroup }).(ec2.SecurityGroupOutput)
+}
+
+// The ID of the security group for the worker node group to communicate with the cluster.
+//
+// This security group requires specific inbound and outbound rules.
+//
+// See for more details:
+// https://docs.aws.amazon.com/eks/latest/userguide/sec-group-reqs.html
+//
+// Note: The `nodeSecurityGroupId` option and the cluster option `nodeSecurityGroupTags` are mutually exclusive.
+func (o ClusterNodeGroupOptionsOutput) NodeSecurityGroupId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.NodeSecurityGroupId }).(pulumi.StringPtrOutput)
+}
+
+// The set of subnets to override and use for the worker node group.
+//
+// Setting this option overrides which subnets to use for the worker node group, regardless if the cluster's `subnetIds` is set, or if `publicSubnetIds` and/or `privateSubnetIds` were set.
+func (o ClusterNodeGroupOptionsOutput) NodeSubnetIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) []string { return v.NodeSubnetIds }).(pulumi.StringArrayOutput)
+}
+
+// Extra code to run on node startup. This code will run after the AWS EKS bootstrapping code and before the node signals its readiness to the managing CloudFormation stack. This code must be a typical user data script: critically it must begin with an interpreter directive (i.e. a `#!`).
+func (o ClusterNodeGroupOptionsOutput) NodeUserData() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.NodeUserData }).(pulumi.StringPtrOutput)
+}
+
+// User specified code to run on node startup. This code is expected to handle the full AWS EKS bootstrapping code and signal node readiness to the managing CloudFormation stack. This code must be a complete and executable user data script in bash (Linux) or powershell (Windows).
+//
+// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/worker.html
+func (o ClusterNodeGroupOptionsOutput) NodeUserDataOverride() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.NodeUserDataOverride }).(pulumi.StringPtrOutput)
+}
+
+// Extra nodeadm configuration sections to be added to the nodeadm user data. This can be shell scripts, nodeadm NodeConfig or any other user data compatible script. When configuring additional nodeadm NodeConfig sections, they'll be merged with the base settings the provider sets. You can overwrite base settings or provide additional settings this way.
+// The base settings the provider sets are:
+//   - cluster.name
+//   - cluster.apiServerEndpoint
+//   - cluster.certificateAuthority
+//   - cluster.cidr
+//
+// Note: This is only applicable when using AL2023.
+// See for more details:
+//   - https://awslabs.github.io/amazon-eks-ami/nodeadm/
+//   - https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+func (o ClusterNodeGroupOptionsOutput) NodeadmExtraOptions() NodeadmOptionsArrayOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) []NodeadmOptions { return v.NodeadmExtraOptions }).(NodeadmOptionsArrayOutput)
+}
+
+// The type of OS to use for the node group. Will be used to determine the right EKS optimized AMI to use based on the instance types and gpu configuration.
+// Valid values are `RECOMMENDED`, `AL2`, `AL2023` and `Bottlerocket`.
+//
+// Defaults to the current recommended OS.
+func (o ClusterNodeGroupOptionsOutput) OperatingSystem() OperatingSystemPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *OperatingSystem { return v.OperatingSystem }).(OperatingSystemPtrOutput)
+}
+
+// Bidding price for spot instance. If set, only spot instances will be added as worker node.
+func (o ClusterNodeGroupOptionsOutput) SpotPrice() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.SpotPrice }).(pulumi.StringPtrOutput)
+}
+
+// Custom k8s node taints to be attached to each worker node. Adds the given taints to the `--register-with-taints` kubelet argument
+func (o ClusterNodeGroupOptionsOutput) Taints() TaintMapOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) map[string]Taint { return v.Taints }).(TaintMapOutput)
+}
+
+// Desired Kubernetes master / control plane version. If you do not specify a value, the latest available version is used.
+func (o ClusterNodeGroupOptionsOutput) Version() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.Version }).(pulumi.StringPtrOutput)
+}
+
+type ClusterNodeGroupOptionsPtrOutput struct{ *pulumi.OutputState }
+
+func (ClusterNodeGroupOptionsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ClusterNodeGroupOptions)(nil)).Elem()
+}
+
+func (o ClusterNodeGroupOptionsPtrOutput) ToClusterNodeGroupOptionsPtrOutput() ClusterNodeGroupOptionsPtrOutput {
+	return o
+}
+
+func (o ClusterNodeGroupOptionsPtrOutput) ToClusterNodeGroupOptionsPtrOutputWithContext(ctx context.Context) ClusterNodeGroupOptionsPtrOutput {
+	return o
+}
+
+func (o ClusterNodeGroupOptionsPtrOutput) Elem() ClusterNodeGroupOptionsOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) ClusterNodeGroupOptions {
+		if v != nil {
+			return *v
+		}
+		var ret ClusterNodeGroupOptions
+		return ret
+	}).(ClusterNodeGroupOptionsOutput)
+}
+
+// The AMI ID to use for the worker nodes.
+//
+// Defaults to the latest recommended EKS Optimized Linux AMI from the AWS Systems Manager Parameter Store.
+//
+// Note: `amiId` and `gpu` are mutually exclusive.
+//
+// See for more details:
+// - https://docs.aws.amazon.com/eks/latest/userguide/eks-optimized-ami.html.
+func (o ClusterNodeGroupOptionsPtrOutput) AmiId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.AmiId
+	}).(pulumi.StringPtrOutput)
+}
+
+// The AMI Type to use for the worker nodes.
+//
+// Only applicable when setting an AMI ID that is of type `arm64`.
+//
+// Note: `amiType` and `gpu` are mutually exclusive.
+func (o ClusterNodeGroupOptionsPtrOutput) AmiType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.AmiType
+	}).(pulumi.StringPtrOutput)
+}
+
+// The tags to apply to the NodeGroup's AutoScalingGroup in the CloudFormation Stack.
+//
+// Per AWS, all stack-level tags, including automatically created tags, and the `cloudFormationTags` option are propagated to resources that AWS CloudFormation supports, including the AutoScalingGroup. See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-resource-tags.html
+//
+// Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
+func (o ClusterNodeGroupOptionsPtrOutput) AutoScalingGroupTags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.AutoScalingGroupTags
+	}).(pulumi.StringMapOutput)
+}
+
+// Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+//
+// For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+func (o ClusterNodeGroupOptionsPtrOutput) AutoscalerDiscovery() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.AutoscalerDiscovery
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
+func (o ClusterNodeGroupOptionsPtrOutput) BootstrapExtraArgs() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.BootstrapExtraArgs
+	}).(pulumi.StringPtrOutput)
+}
+
+// Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+// The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+func (o ClusterNodeGroupOptionsPtrOutput) BottlerocketConfig() BottlerocketConfigPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *BottlerocketConfig {
+		if v == nil {
+			return nil
+		}
+		return v.BottlerocketConfig
+	}).(BottlerocketConfigPtrOutput)
+}
+
+// The configuration settings for Bottlerocket OS.
+// The settings will get merged with the base settings the provider uses to configure Bottlerocket.
+//
+// This includes:
+//   - settings.kubernetes.api-server
+//   - settings.kubernetes.cluster-certificate
+//   - settings.kubernetes.cluster-name
+//   - settings.kubernetes.cluster-dns-ip
+//
+// For an overview of the available settings, see https://bottlerocket.dev/en/os/1.20.x/api/settings/.
+func (o ClusterNodeGroupOptionsPtrOutput) BottlerocketSettings() pulumi.MapOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) map[string]interface{} {
+		if v == nil {
+			return nil
+		}
+		return v.BottlerocketSettings
+	}).(pulumi.MapOutput)
+}
+
+// The tags to apply to the CloudFormation Stack of the Worker NodeGroup.
+//
+// Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
+func (o ClusterNodeGroupOptionsPtrOutput) CloudFormationTags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.CloudFormationTags
+	}).(pulumi.StringMapOutput)
+}
+
+// The ingress rule that gives node group access.
+func (o ClusterNodeGroupOptionsPtrOutput) ClusterIngressRule() ec2.SecurityGroupRuleOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *ec2.SecurityGroupRule {
+		if v == nil {
+			return nil
+		}
+		return v.ClusterIngressRule
+	}).(ec2.SecurityGroupRuleOutput)
+}
+
+// The ID of the ingress rule that gives node group access.
+func (o ClusterNodeGroupOptionsPtrOutput) ClusterIngressRuleId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ClusterIngressRuleId
+	}).(pulumi.StringPtrOutput)
+}
+
+// The number of worker nodes that should be running in the cluster. Defaults to 2.
+func (o ClusterNodeGroupOptionsPtrOutput) DesiredCapacity() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *int {
+		if v == nil {
+			return nil
+		}
+		return v.DesiredCapacity
+	}).(pulumi.IntPtrOutput)
+}
+
+// Enables/disables detailed monitoring of the EC2 instances.
+//
+// With detailed monitoring, all metrics, including status check metrics, are available in 1-minute intervals.
+// When enabled, you can also get aggregated data across groups of similar instances.
+//
+// Note: You are charged per metric that is sent to CloudWatch. You are not charged for data storage.
+// For more information, see "Paid tier" and "Example 1 - EC2 Detailed Monitoring" here https://aws.amazon.com/cloudwatch/pricing/.
+func (o ClusterNodeGroupOptionsPtrOutput) EnableDetailedMonitoring() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.EnableDetailedMonitoring
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Encrypt the root block device of the nodes in the node group.
+func (o ClusterNodeGroupOptionsPtrOutput) EncryptRootBlockDevice() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.EncryptRootBlockDevice
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Extra security groups to attach on all nodes in this worker node group.
+//
+// This additional set of security groups captures any user application rules that will be needed for the nodes.
+func (o ClusterNodeGroupOptionsPtrOutput) ExtraNodeSecurityGroups() ec2.SecurityGroupArrayOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) []*ec2.SecurityGroup {
+		if v == nil {
+			return nil
+		}
+		return v.ExtraNodeSecurityGroups
+	}).(ec2.SecurityGroupArrayOutput)
+}
+
+// Use the latest recommended EKS Optimized Linux AMI with GPU support for the worker nodes from the AWS Systems Manager Parameter Store.
+//
+// Defaults to false.
+//
+// Note: `gpu` and `amiId` are mutually exclusive.
+//
+// See for more details:
+// - https://docs.aws.amazon.com/eks/latest/userguide/eks-optimized-ami.html
+// - https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
+func (o ClusterNodeGroupOptionsPtrOutput) Gpu() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Gpu
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether to ignore changes to the desired size of the Auto Scaling Group. This is useful when using Cluster Autoscaler.
+//
+// See [EKS best practices](https://aws.github.io/aws-eks-best-practices/cluster-autoscaling/) for more details.
+func (o ClusterNodeGroupOptionsPtrOutput) IgnoreScalingChanges() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.IgnoreScalingChanges
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
+func (o ClusterNodeGroupOptionsPtrOutput) InstanceProfile() iam.InstanceProfileOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *iam.InstanceProfile {
+		if v == nil {
+			return nil
+		}
+		return v.InstanceProfile
+	}).(iam.InstanceProfileOutput)
+}
+
+// The name of the IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
+func (o ClusterNodeGroupOptionsPtrOutput) InstanceProfileName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.InstanceProfileName
+	}).(pulumi.StringPtrOutput)
+}
+
+// The instance type to use for the cluster's nodes. Defaults to "t3.medium".
+func (o ClusterNodeGroupOptionsPtrOutput) InstanceType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.InstanceType
+	}).(pulumi.StringPtrOutput)
+}
+
+// Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+//
+// See for more details: https://github.com/aws/aws-node-termination-handler
+func (o ClusterNodeGroupOptionsPtrOutput) InterruptionHandling() InterruptionHandlingPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *InterruptionHandling {
+		if v == nil {
+			return nil
+		}
+		return v.InterruptionHandling
+	}).(InterruptionHandlingPtrOutput)
+}
+
+// Name of the key pair to use for SSH access to worker nodes.
+func (o ClusterNodeGroupOptionsPtrOutput) KeyName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.KeyName
+	}).(pulumi.StringPtrOutput)
+}
+
+// Extra args to pass to the Kubelet. Corresponds to the options passed in the `--kubeletExtraArgs` flag to `/etc/eks/bootstrap.sh`. For example, '--port=10251 --address=0.0.0.0'. Note that the `labels` and `taints` properties will be applied to this list (using `--node-labels` and `--register-with-taints` respectively) after to the explicit `kubeletExtraArgs`.
+func (o ClusterNodeGroupOptionsPtrOutput) KubeletExtraArgs() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.KubeletExtraArgs
+	}).(pulumi.StringPtrOutput)
+}
+
+// Custom k8s node labels to be attached to each worker node. Adds the given key/value pairs to the `--node-labels` kubelet argument.
+func (o ClusterNodeGroupOptionsPtrOutput) Labels() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.Labels
+	}).(pulumi.StringMapOutput)
+}
+
+// The tag specifications to apply to the launch template.
+func (o ClusterNodeGroupOptionsPtrOutput) LaunchTemplateTagSpecifications() ec2.LaunchTemplateTagSpecificationArrayOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) []ec2.LaunchTemplateTagSpecification {
+		if v == nil {
+			return nil
+		}
+		return v.LaunchTemplateTagSpecifications
+	}).(ec2.LaunchTemplateTagSpecificationArrayOutput)
+}
+
+// The maximum number of worker nodes running in the cluster. Defaults to 2.
+func (o ClusterNodeGroupOptionsPtrOutput) MaxSize() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *int {
+		if v == nil {
+			return nil
+		}
+		return v.MaxSize
+	}).(pulumi.IntPtrOutput)
+}
+
+// The minimum amount of instances that should remain available during an instance refresh, expressed as a percentage. Defaults to 50.
+func (o ClusterNodeGroupOptionsPtrOutput) MinRefreshPercentage() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *int {
+		if v == nil {
+			return nil
+		}
+		return v.MinRefreshPercentage
+	}).(pulumi.IntPtrOutput)
+}
+
+// The minimum number of worker nodes running in the cluster. Defaults to 1.
+func (o ClusterNodeGroupOptionsPtrOutput) MinSize() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *int {
+		if v == nil {
+			return nil
+		}
+		return v.MinSize
+	}).(pulumi.IntPtrOutput)
+}
+
+// Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
+func (o ClusterNodeGroupOptionsPtrOutput) NodeAssociatePublicIpAddress() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.NodeAssociatePublicIpAddress
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+//
+// Note: This is only applicable when using AL2023.
+// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+func (o ClusterNodeGroupOptionsPtrOutput) NodeConfig() NodeConfigPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *NodeConfig {
+		if v == nil {
+			return nil
+		}
+		return v.NodeConfig
+	}).(NodeConfigPtrOutput)
+}
+
+// Public key material for SSH access to worker nodes. See allowed formats at:
+// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
+// If not provided, no SSH access is enabled on VMs.
+func (o ClusterNodeGroupOptionsPtrOutput) NodePublicKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.NodePublicKey
+	}).(pulumi.StringPtrOutput)
+}
+
+// Whether the root block device should be deleted on termination of the instance. Defaults to true.
+func (o ClusterNodeGroupOptionsPtrOutput) NodeRootVolumeDeleteOnTermination() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.NodeRootVolumeDeleteOnTermination
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether to encrypt a cluster node's root volume. Defaults to false.
+func (o ClusterNodeGroupOptionsPtrOutput) NodeRootVolumeEncrypted() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.NodeRootVolumeEncrypted
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The amount of provisioned IOPS. This is only valid with a volumeType of 'io1'.
+func (o ClusterNodeGroupOptionsPtrOutput) NodeRootVolumeIops() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *int {
+		if v == nil {
+			return nil
+		}
+		return v.NodeRootVolumeIops
+	}).(pulumi.IntPtrOutput)
+}
+
+// The size in GiB of a cluster node's root volume. Defaults to 20.
+func (o ClusterNodeGroupOptionsPtrOutput) NodeRootVolumeSize() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *int {
+		if v == nil {
+			return nil
+		}
+		return v.NodeRootVolumeSize
+	}).(pulumi.IntPtrOutput)
+}
+
+// Provisioned throughput performance in integer MiB/s for a cluster node's root volume. This is only valid with a volumeType of 'gp3'.
+func (o ClusterNodeGroupOptionsPtrOutput) NodeRootVolumeThroughput() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *int {
+		if v == nil {
+			return nil
+		}
+		return v.NodeRootVolumeThroughput
+	}).(pulumi.IntPtrOutput)
+}
+
+// Configured EBS type for a cluster node's root volume. Default is 'gp2'. Supported values are 'standard', 'gp2', 'gp3', 'st1', 'sc1', 'io1'.
+func (o ClusterNodeGroupOptionsPtrOutput) NodeRootVolumeType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.NodeRootVolumeType
+	}).(pulumi.StringPtrOutput)
+}
+
+// The security group for the worker node group to communicate with the cluster.
+//
+// This security group requires specific inbound and outbound rules.
+//
+// See for more details:
+// https://docs.aws.amazon.com/eks/latest/userguide/sec-group-reqs.html
+//
+// Note: The `nodeSecurityGroup` option and the cluster option`nodeSecurityGroupTags` are mutually exclusive.
+func (o ClusterNodeGroupOptionsPtrOutput) NodeSecurityGroup() ec2.SecurityGroupOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *ec2.SecurityGroup {
+		if v == nil {
+			return nil
+		}
+		return v.NodeSecurityGroup
+	}).(ec2.SecurityGroupOutput)
+}
+
+// The ID of the security group for the worker node group to communicate with the cluster.
+//
+// This security group requires specific inbound and outbound rules.
+//
+// See for more details:
+// https://docs.aws.amazon.com/eks/latest/userguide/sec-group-reqs.html
+//
+// Note: The `nodeSecurityGroupId` option and the cluster option `nodeSecurityGroupTags` are mutually exclusive.
+func (o ClusterNodeGroupOptionsPtrOutput) NodeSecurityGroupId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.NodeSecurityGroupId
+	}).(pulumi.StringPtrOutput)
+}
+
+// The set of subnets to override and use for the worker node group.
+//
+// Setting this option overrides which subnets to use for the worker node group, regardless if the cluster's `subnetIds` is set, or if `publicSubnetIds` and/or `privateSubnetIds` were set.
+func (o ClusterNodeGroupOptionsPtrOutput) NodeSubnetIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) []string {
+		if v == nil {
+			return nil
+		}
+		return v.NodeSubnetIds
+	}).(pulumi.StringArrayOutput)
+}
+
+// Extra code to run on node startup. This code will run after the AWS EKS bootstrapping code and before the node signals its readiness to the managing CloudFormation stack. This code must be a typical user data script: critically it must begin with an interpreter directive (i.e. a `#!`).
+func (o ClusterNodeGroupOptionsPtrOutput) NodeUserData() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.NodeUserData
+	}).(pulumi.StringPtrOutput)
+}
+
+// User specified code to run on node startup. This code is expected to handle the full AWS EKS bootstrapping code and signal node readiness to the managing CloudFormation stack. This code must be a complete and executable user data script in bash (Linux) or powershell (Windows).
+//
+// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/worker.html
+func (o ClusterNodeGroupOptionsPtrOutput) NodeUserDataOverride() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.NodeUserDataOverride
+	}).(pulumi.StringPtrOutput)
+}
+
+// Extra nodeadm configuration sections to be added to the nodeadm user data. This can be shell scripts, nodeadm NodeConfig or any other user data compatible script. When configuring additional nodeadm NodeConfig sections, they'll be merged with the base settings the provider sets. You can overwrite base settings or provide additional settings this way.
+// The base settings the provider sets are:
+//   - cluster.name
+//   - cluster.apiServerEndpoint
+//   - cluster.certificateAuthority
+//   - cluster.cidr
+//
+// Note: This is only applicable when using AL2023.
+// See for more details:
+//   - https://awslabs.github.io/amazon-eks-ami/nodeadm/
+//   - https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+func (o ClusterNodeGroupOptionsPtrOutput) NodeadmExtraOptions() NodeadmOptionsArrayOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) []NodeadmOptions {
+		if v == nil {
+			return nil
+		}
+		return v.NodeadmExtraOptions
+	}).(NodeadmOptionsArrayOutput)
+}
+
+// The type of OS to use for the node group. Will be used to determine the right EKS optimized AMI to use based on the instance types and gpu configuration.
+// Valid values are `RECOMMENDED`, `AL2`, `AL2023` and `Bottlerocket`.
+//
+// Defaults to the current recommended OS.
+func (o ClusterNodeGroupOptionsPtrOutput) OperatingSystem() OperatingSystemPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *OperatingSystem {
+		if v == nil {
+			return nil
+		}
+		return v.OperatingSystem
+	}).(OperatingSystemPtrOutput)
+}
+
+// Bidding price for spot instance. If set, only spot instances will be added as worker node.
+func (o ClusterNodeGroupOptionsPtrOutput) SpotPrice() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SpotPrice
+	}).(pulumi.StringPtrOutput)
+}
+
+// Custom k8s node taints to be attached to each worker node. Adds the given taints to the `--register-with-taints` kubelet argument
+func (o ClusterNodeGroupOptionsPtrOutput) Taints() TaintMapOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) map[string]Taint {
+		if v == nil {
+			return nil
+		}
+		return v.Taints
+	}).(TaintMapOutput)
+}
+
+// Desired Kubernetes master / control plane version. If you do not specify a value, the latest available version is used.
+func (o ClusterNodeGroupOptionsPtrOutput) Version() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Version
+	}).(pulumi.StringPtrOutput)
+}
+
+// Defines the core set of data associated with an EKS cluster, including the network in which it runs.
+type CoreData struct {
+	// The access entries added to the cluster.
+	AccessEntries []AccessEntry `pulumi:"accessEntries"`
+	AwsProvider   *aws.Provider `pulumi:"awsProvider"`
+	Cluster       *eks.Cluster  `pulumi:"cluster"`
+	// The IAM Role attached to the EKS Cluster
+	ClusterIamRole *iam.Role `pulumi:"clusterIamRole"`
+	// The log group of the control plane logs, if it is managed by the cluster.
+	ClusterLogGroup      *cloudwatch.LogGroup `pulumi:"clusterLogGroup"`
+	ClusterSecurityGroup *ec2.SecurityGroup   `pulumi:"clusterSecurityGroup"`
+	// The scaling configuration of the control plane, including its provisioned tier.
+	ControlPlaneScalingConfig *eks.ClusterControlPlaneScalingConfig `pulumi:"controlPlaneScalingConfig"`
+	EksNodeAccess             *corev1.ConfigMap                     `pulumi:"eksNodeAccess"`
+	EncryptionConfig          *eks.ClusterEncryptionConfig          `pulumi:"encryptionConfig"`
+	// The EKS cluster's Kubernetes API server endpoint.
+	Endpoint string `pulumi:"endpoint"`
+	// The Fargate profile used to manage which pods run on Fargate.
+	FargateProfile *eks.FargateProfile `pulumi:"fargateProfile"`
+	// The IAM instance roles for the cluster's nodes.
+	InstanceRoles []*iam.Role `pulumi:"instanceRoles"`
+	// The kubeconfig file for the cluster.
+	Kubeconfig interface{} `pulumi:"kubeconfig"`
+	// The cluster's node group options.
+	NodeGroupOptions ClusterNodeGroupOptions `pulumi:"nodeGroupOptions"`
+	// Tags attached to the security groups associated with the cluster's worker nodes.
+	NodeSecurityGroupTags map[string]string          `pulumi:"nodeSecurityGroupTags"`
+	OidcProvider          *iam.OpenIdConnectProvider `pulumi:"oidcProvider"`
+	// List of subnet IDs for the private subnets.
+	PrivateSubnetIds []string             `pulumi:"privateSubnetIds"`
+	Provider         *kubernetes.Provider `pulumi:"provider"`
+	// List of subnet IDs for the public subnets.
+	PublicSubnetIds []string `pulumi:"publicSubnetIds"`
+	// The storage class used for persistent storage by the cluster.
+	StorageClasses map[string]*storagev1.StorageClass `pulumi:"storageClasses"`
+	// List of subnet IDs for the EKS cluster.
+	SubnetIds []string `pulumi:"subnetIds"`
+	// A map of tags assigned to the EKS cluster.
+	Tags map[string]string `pulumi:"tags"`
+	// The upgrade policy of the cluster, which determines whether it gets extended support.
+	UpgradePolicy *eks.ClusterUpgradePolicy `pulumi:"upgradePolicy"`
+	// The VPC CNI for the cluster.
+	VpcCni *VpcCniAddon `pulumi:"vpcCni"`
+	// The VPC CNI settings that affect the maximum number of pods of the nodes.
+	VpcCniNetworking *VpcCniNetworking `pulumi:"vpcCniNetworking"`
+	// ID of the cluster's VPC.
+	VpcId string `pulumi:"vpcId"`
+	// The zonal shift configuration of the cluster.
+	ZonalShiftConfig *eks.ClusterZonalShiftConfig `pulumi:"zonalShiftConfig"`
+}
+
+// CoreDataInput is an input type that accepts CoreDataArgs and CoreDataOutput values.
+// You can construct a concrete instance of `CoreDataInput` via:
+//
+//	CoreDataArgs{...}
+type CoreDataInput interface {
+	pulumi.Input
+
+	ToCoreDataOutput() CoreDataOutput
+	ToCoreDataOutputWithContext(context.Context) CoreDataOutput
+}
+
+// Defines the core set of data associated with an EKS cluster, including the network in which it runs.
+type CoreDataArgs struct {
+	// The access entries added to the cluster.
+	AccessEntries AccessEntryArrayInput `pulumi:"accessEntries"`
+	AwsProvider   aws.ProviderInput     `pulumi:"awsProvider"`
+	Cluster       eks.ClusterInput      `pulumi:"cluster"`
+	// The IAM Role attached to the EKS Cluster
+	ClusterIamRole iam.RoleInput `pulumi:"clusterIamRole"`
+	// The log group of the control plane logs, if it is managed by the cluster.
+	ClusterLogGroup      cloudwatch.LogGroupInput `pulumi:"clusterLogGroup"`
+	ClusterSecurityGroup ec2.SecurityGroupInput   `pulumi:"clusterSecurityGroup"`
+	// The scaling configuration of the control plane, including its provisioned tier.
+	ControlPlaneScalingConfig eks.ClusterControlPlaneScalingConfigPtrInput `pulumi:"controlPlaneScalingConfig"`
+	EksNodeAccess             corev1.ConfigMapInput                        `pulumi:"eksNodeAccess"`
+	EncryptionConfig          eks.ClusterEncryptionConfigPtrInput          `pulumi:"encryptionConfig"`
+	// The EKS cluster's Kubernetes API server endpoint.
+	Endpoint pulumi.StringInput `pulumi:"endpoint"`
+	// The Fargate profile used to manage which pods run on Fargate.
+	FargateProfile eks.FargateProfileInput `pulumi:"fargateProfile"`
+	// The IAM instance roles for the cluster's nodes.
+	InstanceRoles iam.RoleArrayInput `pulumi:"instanceRoles"`
+	// The kubeconfig file for the cluster.
+	Kubeconfig pulumi.Input `pulumi:"kubeconfig"`
+	// The cluster's node group options.
+	NodeGroupOptions ClusterNodeGroupOptionsInput `pulumi:"nodeGroupOptions"`
+	// Tags attached to the security groups associated with the cluster's worker nodes.
+	NodeSecurityGroupTags pulumi.StringMapInput          `pulumi:"nodeSecurityGroupTags"`
+	OidcProvider          iam.OpenIdConnectProviderInput `pulumi:"oidcProvider"`
+	// List of subnet IDs for the private subnets.
+	PrivateSubnetIds pulumi.StringArrayInput  `pulumi:"privateSubnetIds"`
+	Provider         kubernetes.ProviderInput `pulumi:"provider"`
+	// List of subnet IDs for the public subnets.
+	PublicSubnetIds pulumi.StringArrayInput `pulumi:"publicSubnetIds"`
+	// The storage class used for persistent storage by the cluster.
+	StorageClasses storagev1.StorageClassMapInput `pulumi:"storageClasses"`
+	// List of subnet IDs for the EKS cluster.
+	SubnetIds pulumi.StringArrayInput `pulumi:"subnetIds"`
+	// A map of tags assigned to the EKS cluster.
+	Tags pulumi.StringMapInput `pulumi:"tags"`
+	// The upgrade policy of the cluster, which determines whether it gets extended support.
+	UpgradePolicy eks.ClusterUpgradePolicyPtrInput `pulumi:"upgradePolicy"`
+	// The VPC CNI for the cluster.
+	VpcCni VpcCniAddonInput `pulumi:"vpcCni"`
+	// The VPC CNI settings that affect the maximum number of pods of the nodes.
+	VpcCniNetworking VpcCniNetworkingPtrInput `pulumi:"vpcCniNetworking"`
+	// ID of the cluster's VPC.
+	VpcId pulumi.StringInput `pulumi:"vpcId"`
+	// The zonal shift configuration of the cluster.
+	ZonalShiftConfig eks.ClusterZonalShiftConfigPtrInput `pulumi:"zonalShiftConfig"`
+}
+
+func (CoreDataArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CoreData)(nil)).Elem()
+}
+
+func (i CoreDataArgs) ToCoreDataOutput() CoreDataOutput {
+	return i.ToCoreDataOutputWithContext(context.Background())
+}
+
+func (i CoreDataArgs) ToCoreDataOutputWithContext(ctx context.Context) CoreDataOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CoreDataOutput)
+}
+
+// Defines the core set of data associated with an EKS cluster, including the network in which it runs.
+type CoreDataOutput struct{ *pulumi.OutputState }
+
+func (CoreDataOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CoreData)(nil)).Elem()
+}
+
+func (o CoreDataOutput) ToCoreDataOutput() CoreDataOutput {
+	return o
+}
+
+func (o CoreDataOutput) ToCoreDataOutputWithContext(ctx context.Context) CoreDataOutput {
+	return o
+}
+
+// The access entries added to the cluster.
+func (o CoreDataOutput) AccessEntries() AccessEntryArrayOutput {
+	return o.ApplyT(func(v CoreData) []AccessEntry { return v.AccessEntries }).(AccessEntryArrayOutput)
+}
+
+func (o CoreDataOutput) AwsProvider() aws.ProviderOutput {
+	return o.ApplyT(func(v CoreData) *aws.Provider { return v.AwsProvider }).(aws.ProviderOutput)
+}
+
+func (o CoreDataOutput) Cluster() eks.ClusterOutput {
+	return o.ApplyT(func(v CoreData) *eks.Cluster { return v.Cluster }).(eks.ClusterOutput)
+}
+
+// The IAM Role attached to the EKS Cluster
+func (o CoreDataOutput) ClusterIamRole() iam.RoleOutput {
+	return o.ApplyT(func(v CoreData) *iam.Role { return v.ClusterIamRole }).(iam.RoleOutput)
+}
+
+// The log group of the control plane logs, if it is managed by the cluster.
+func (o CoreDataOutput) ClusterLogGroup() cloudwatch.LogGroupOutput {
+	return o.ApplyT(func(v CoreData) *cloudwatch.LogGroup { return v.ClusterLogGroup }).(cloudwatch.LogGroupOutput)
+}
+
+func (o CoreDataOutput) ClusterSecurityGroup() ec2.SecurityGroupOutput {
+	return o.ApplyT(func(v CoreData) *ec2.SecurityGroup { return v.ClusterSecurityGroup }).(ec2.SecurityGroupOutput)
+}
+
+// The scaling configuration of the control plane, including its provisioned tier.
+func (o CoreDataOutput) ControlPlaneScalingConfig() eks.ClusterControlPlaneScalingConfigPtrOutput {
+	return o.ApplyT(func(v CoreData) *eks.ClusterControlPlaneScalingConfig { return v.ControlPlaneScalingConfig }).(eks.ClusterControlPlaneScalingConfigPtrOutput)
+}
+
+func (o CoreDataOutput) EksNodeAccess() corev1.ConfigMapOutput {
+	return o.ApplyT(func(v CoreData) *corev1.ConfigMap { return v.EksNodeAccess }).(corev1.ConfigMapOutput)
+}
+
+func (o CoreDataOutput) EncryptionConfig() eks.ClusterEncryptionConfigPtrOutput {
+	return o.ApplyT(func(v CoreData) *eks.ClusterEncryptionConfig { return v.EncryptionConfig }).(eks.ClusterEncryptionConfigPtrOutput)
+}
+
+// The EKS cluster's Kubernetes API server endpoint.
+func (o CoreDataOutput) Endpoint() pulumi.StringOutput {
+	return o.ApplyT(func(v CoreData) string { return v.Endpoint }).(pulumi.StringOutput)
+}
+
+// The Fargate profile used to manage which pods run on Fargate.
+func (o CoreDataOutput) FargateProfile() eks.FargateProfileOutput {
+	return o.ApplyT(func(v CoreData) *eks.FargateProfile { return v.FargateProfile }).(eks.FargateProfileOutput)
+}
+
+// The IAM instance roles for the cluster's nodes.
+func (o CoreDataOutput) InstanceRoles() iam.RoleArrayOutput {
+	return o.ApplyT(func(v CoreData) []*iam.Role { return v.InstanceRoles }).(iam.RoleArrayOutput)
+}
+
+// The kubeconfig file for the cluster.
+func (o CoreDataOutput) Kubeconfig() pulumi.AnyOutput {
+	return o.ApplyT(func(v CoreData) interface{} { return v.Kubeconfig }).(pulumi.AnyOutput)
+}
+
+// The cluster's node group options.
+func (o CoreDataOutput) NodeGroupOptions() ClusterNodeGroupOptionsOutput {
+	return o.ApplyT(func(v CoreData) ClusterNodeGroupOptions { return v.NodeGroupOptions }).(ClusterNodeGroupOptionsOutput)
+}
+
+// Tags attached to the security groups associated with the cluster's worker nodes.
+func (o CoreDataOutput) NodeSecurityGroupTags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v CoreData) map[string]string { return v.NodeSecurityGroupTags }).(pulumi.StringMapOutput)
+}
+
+func (o CoreDataOutput) OidcProvider() iam.OpenIdConnectProviderOutput {
+	return o.ApplyT(func(v CoreData) *iam.OpenIdConnectProvider { return v.OidcProvider }).(iam.OpenIdConnectProviderOutput)
+}
+
+// List of subnet IDs for the private subnets.
+func (o CoreDataOutput) PrivateSubnetIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v CoreData) []string { return v.PrivateSubnetIds }).(pulumi.StringArrayOutput)
+}
+
+func (o CoreDataOutput) Provider() kubernetes.ProviderOutput {
+	return o.ApplyT(func(v CoreData) *kubernetes.Provider { return v.Provider }).(kubernetes.ProviderOutput)
+}
+
+// List of subnet IDs for the public subnets.
+func (o CoreDataOutput) PublicSubnetIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v CoreData) []string { return v.PublicSubnetIds }).(pulumi.StringArrayOutput)
+}
+
+// The storage class used for persistent storage by the cluster.
+func (o CoreDataOutput) StorageClasses() storagev1.StorageClassMapOutput {
+	return o.ApplyT(func(v CoreData) map[string]*storagev1.StorageClass { return v.StorageClasses }).(storagev1.StorageClassMapOutput)
+}
+
+// List of subnet IDs for the EKS cluster.
+func (o CoreDataOutput) SubnetIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v CoreData) []string { return v.SubnetIds }).(pulumi.StringArrayOutput)
+}
+
+// A map of tags assigned to the EKS cluster.
+func (o CoreDataOutput) Tags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v CoreData) map[string]string { return v.Tags }).(pulumi.StringMapOutput)
+}
+
+// The upgrade policy of the cluster, which determines whether it gets extended support.
+func (o CoreDataOutput) UpgradePolicy() eks.ClusterUpgradePolicyPtrOutput {
+	return o.ApplyT(func(v CoreData) *eks.ClusterUpgradePolicy { return v.UpgradePolicy }).(eks.ClusterUpgradePolicyPtrOutput)
+}
+
+// The VPC CNI for the cluster.
+func (o CoreDataOutput) VpcCni() VpcCniAddonOutput {
+	return o.ApplyT(func(v CoreData) *VpcCniAddon { return v.VpcCni }).(VpcCniAddonOutput)
+}
+
+// The VPC CNI settings that affect the maximum number of pods of the nodes.
+func (o CoreDataOutput) VpcCniNetworking() VpcCniNetworkingPtrOutput {
+	return o.ApplyT(func(v CoreData) *VpcCniNetworking { return v.VpcCniNetworking }).(VpcCniNetworkingPtrOutput)
+}
+
+// ID of the cluster's VPC.
+func (o CoreDataOutput) VpcId() pulumi.StringOutput {
+	return o.ApplyT(func(v CoreData) string { return v.VpcId }).(pulumi.StringOutput)
+}
+
+// The zonal shift configuration of the cluster.
+func (o CoreDataOutput) ZonalShiftConfig() eks.ClusterZonalShiftConfigPtrOutput {
+	return o.ApplyT(func(v CoreData) *eks.ClusterZonalShiftConfig { return v.ZonalShiftConfig }).(eks.ClusterZonalShiftConfigPtrOutput)
+}
+
+type CoreDnsAddonOptions struct {
+	// Custom configuration values for the coredns addon. This object must match the schema derived from [describe-addon-configuration](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-configuration.html).
+	ConfigurationValues map[string]interface{} `pulumi:"configurationValues"`
+	// Whether or not to create the `coredns` Addon in the cluster
+	//
+	// The managed addon can only be enabled if the cluster is a Fargate cluster or if the cluster
+	// uses the default node group, otherwise the self-managed addon is used.
+	Enabled *bool `pulumi:"enabled"`
+	// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
+	ResolveConflictsOnCreate *ResolveConflictsOnCreate `pulumi:"resolveConflictsOnCreate"`
+	// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value. Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
+	ResolveConflictsOnUpdate *ResolveConflictsOnUpdate `pulumi:"resolveConflictsOnUpdate"`
+	// The version of the EKS add-on. The version must match one of the versions returned by [describe-addon-versions](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-versions.html).
+	Version *string `pulumi:"version"`
+}
+
+// Defaults sets the appropriate defaults for CoreDnsAddonOptions
+func (val *CoreDnsAddonOptions) Defaults() *CoreDnsAddonOptions {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Enabled == nil {
+		enabled_ := true
+		tmp.Enabled = &enabled_
+	}
+	if tmp.ResolveConflictsOnCreate == nil {
+		resolveConflictsOnCreate_ := ResolveConflictsOnCreate("OVERWRITE")
+		tmp.ResolveConflictsOnCreate = &resolveConflictsOnCreate_
+	}
+	if tmp.ResolveConflictsOnUpdate == nil {
+		resolveConflictsOnUpdate_ := ResolveConflictsOnUpdate("OVERWRITE")
+		tmp.ResolveConflictsOnUpdate = &resolveConflictsOnUpdate_
+	}
+	return &tmp
+}
+
+// CoreDnsAddonOptionsInput is an input type that accepts CoreDnsAddonOptionsArgs and CoreDnsAddonOptionsOutput values.
+// You can construct a concrete instance of `CoreDnsAddonOptionsInput` via:
+//
+//	CoreDnsAddonOptionsArgs{...}
+type CoreDnsAddonOptionsInput interface {
+	pulumi.Input
+
+	ToCoreDnsAddonOptionsOutput() CoreDnsAddonOptionsOutput
+	ToCoreDnsAddonOptionsOutputWithContext(context.Context) CoreDnsAddonOptionsOutput
+}
+
+type CoreDnsAddonOptionsArgs struct {
+	// Custom configuration values for the coredns addon. This object must match the schema derived from [describe-addon-configuration](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-configuration.html).
+	ConfigurationValues pulumi.MapInput `pulumi:"configurationValues"`
+	// Whether or not to create the `coredns` Addon in the cluster
 	//
-	// Defaults to the current recommended OS.
-	OperatingSystem OperatingSystemPtrInput `pulumi:"operatingSystem"`
-	// Bidding price for spot instance. If set, only spot instances will be added as worker node.
-	SpotPrice pulumi.StringPtrInput `pulumi:"spotPrice"`
-	// Custom k8s node taints to be attached to each worker node. Adds the given taints to the `--register-with-taints` kubelet argument
-	Taints TaintMapInput `pulumi:"taints"`
-	// Desired Kubernetes master / control plane version. If you do not specify a value, the latest available version is used.
+	// The managed addon can only be enabled if the cluster is a Fargate cluster or if the cluster
+	// uses the default node group, otherwise the self-managed addon is used.
+	Enabled *bool `pulumi:"enabled"`
+	// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
+	ResolveConflictsOnCreate *ResolveConflictsOnCreate `pulumi:"resolveConflictsOnCreate"`
+	// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value. Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
+	ResolveConflictsOnUpdate *ResolveConflictsOnUpdate `pulumi:"resolveConflictsOnUpdate"`
+	// The version of the EKS add-on. The version must match one of the versions returned by [describe-addon-versions](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-versions.html).
 	Version pulumi.StringPtrInput `pulumi:"version"`
 }
 
-func (ClusterNodeGroupOptionsArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*ClusterNodeGroupOptions)(nil)).Elem()
+// Defaults sets the appropriate defaults for CoreDnsAddonOptionsArgs
+func (val *CoreDnsAddonOptionsArgs) Defaults() *CoreDnsAddonOptionsArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Enabled == nil {
+		enabled_ := true
+		tmp.Enabled = &enabled_
+	}
+	if tmp.ResolveConflictsOnCreate == nil {
+		resolveConflictsOnCreate_ := ResolveConflictsOnCreate("OVERWRITE")
+		tmp.ResolveConflictsOnCreate = &resolveConflictsOnCreate_
+	}
+	if tmp.ResolveConflictsOnUpdate == nil {
+		resolveConflictsOnUpdate_ := ResolveConflictsOnUpdate("OVERWRITE")
+		tmp.ResolveConflictsOnUpdate = &resolveConflictsOnUpdate_
+	}
+	return &tmp
+}
+func (CoreDnsAddonOptionsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CoreDnsAddonOptions)(nil)).Elem()
+}
+
+func (i CoreDnsAddonOptionsArgs) ToCoreDnsAddonOptionsOutput() CoreDnsAddonOptionsOutput {
+	return i.ToCoreDnsAddonOptionsOutputWithContext(context.Background())
+}
+
+func (i CoreDnsAddonOptionsArgs) ToCoreDnsAddonOptionsOutputWithContext(ctx context.Context) CoreDnsAddonOptionsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CoreDnsAddonOptionsOutput)
+}
+
+func (i CoreDnsAddonOptionsArgs) ToCoreDnsAddonOptionsPtrOutput() CoreDnsAddonOptionsPtrOutput {
+	return i.ToCoreDnsAddonOptionsPtrOutputWithContext(context.Background())
+}
+
+func (i CoreDnsAddonOptionsArgs) ToCoreDnsAddonOptionsPtrOutputWithContext(ctx context.Context) CoreDnsAddonOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CoreDnsAddonOptionsOutput).ToCoreDnsAddonOptionsPtrOutputWithContext(ctx)
+}
+
+// CoreDnsAddonOptionsPtrInput is an input type that accepts CoreDnsAddonOptionsArgs, CoreDnsAddonOptionsPtr and CoreDnsAddonOptionsPtrOutput values.
+// You can construct a concrete instance of `CoreDnsAddonOptionsPtrInput` via:
+//
+//	        CoreDnsAddonOptionsArgs{...}
+//
+//	or:
+//
+//	        nil
+type CoreDnsAddonOptionsPtrInput interface {
+	pulumi.Input
+
+	ToCoreDnsAddonOptionsPtrOutput() CoreDnsAddonOptionsPtrOutput
+	ToCoreDnsAddonOptionsPtrOutputWithContext(context.Context) CoreDnsAddonOptionsPtrOutput
+}
+
+type coreDnsAddonOptionsPtrType CoreDnsAddonOptionsArgs
+
+func CoreDnsAddonOptionsPtr(v *CoreDnsAddonOptionsArgs) CoreDnsAddonOptionsPtrInput {
+	return (*coreDnsAddonOptionsPtrType)(v)
+}
+
+func (*coreDnsAddonOptionsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**CoreDnsAddonOptions)(nil)).Elem()
+}
+
+func (i *coreDnsAddonOptionsPtrType) ToCoreDnsAddonOptionsPtrOutput() CoreDnsAddonOptionsPtrOutput {
+	return i.ToCoreDnsAddonOptionsPtrOutputWithContext(context.Background())
+}
+
+func (i *coreDnsAddonOptionsPtrType) ToCoreDnsAddonOptionsPtrOutputWithContext(ctx context.Context) CoreDnsAddonOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CoreDnsAddonOptionsPtrOutput)
+}
+
+type CoreDnsAddonOptionsOutput struct{ *pulumi.OutputState }
+
+func (CoreDnsAddonOptionsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CoreDnsAddonOptions)(nil)).Elem()
+}
+
+func (o CoreDnsAddonOptionsOutput) ToCoreDnsAddonOptionsOutput() CoreDnsAddonOptionsOutput {
+	return o
+}
+
+func (o CoreDnsAddonOptionsOutput) ToCoreDnsAddonOptionsOutputWithContext(ctx context.Context) CoreDnsAddonOptionsOutput {
+	return o
+}
+
+func (o CoreDnsAddonOptionsOutput) ToCoreDnsAddonOptionsPtrOutput() CoreDnsAddonOptionsPtrOutput {
+	return o.ToCoreDnsAddonOptionsPtrOutputWithContext(context.Background())
+}
+
+func (o CoreDnsAddonOptionsOutput) ToCoreDnsAddonOptionsPtrOutputWithContext(ctx context.Context) CoreDnsAddonOptionsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v CoreDnsAddonOptions) *CoreDnsAddonOptions {
+		return &v
+	}).(CoreDnsAddonOptionsPtrOutput)
+}
+
+// Custom configuration values for the coredns addon. This object must match the schema derived from [describe-addon-configuration](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-configuration.html).
+func (o CoreDnsAddonOptionsOutput) ConfigurationValues() pulumi.MapOutput {
+	return o.ApplyT(func(v CoreDnsAddonOptions) map[string]interface{} { return v.ConfigurationValues }).(pulumi.MapOutput)
+}
+
+// Whether or not to create the `coredns` Addon in the cluster
+//
+// The managed addon can only be enabled if the cluster is a Fargate cluster or if the cluster
+// uses the default node group, otherwise the self-managed addon is used.
+func (o CoreDnsAddonOptionsOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v CoreDnsAddonOptions) *bool { return v.Enabled }).(pulumi.BoolPtrOutput)
+}
+
+// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
+func (o CoreDnsAddonOptionsOutput) ResolveConflictsOnCreate() ResolveConflictsOnCreatePtrOutput {
+	return o.ApplyT(func(v CoreDnsAddonOptions) *ResolveConflictsOnCreate { return v.ResolveConflictsOnCreate }).(ResolveConflictsOnCreatePtrOutput)
+}
+
+// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value. Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
+func (o CoreDnsAddonOptionsOutput) ResolveConflictsOnUpdate() ResolveConflictsOnUpdatePtrOutput {
+	return o.ApplyT(func(v CoreDnsAddonOptions) *ResolveConflictsOnUpdate { return v.ResolveConflictsOnUpdate }).(ResolveConflictsOnUpdatePtrOutput)
+}
+
+// The version of the EKS add-on. The version must match one of the versions returned by [describe-addon-versions](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-versions.html).
+func (o CoreDnsAddonOptionsOutput) Version() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CoreDnsAddonOptions) *string { return v.Version }).(pulumi.StringPtrOutput)
+}
+
+type CoreDnsAddonOptionsPtrOutput struct{ *pulumi.OutputState }
+
+func (CoreDnsAddonOptionsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**CoreDnsAddonOptions)(nil)).Elem()
+}
+
+func (o CoreDnsAddonOptionsPtrOutput) ToCoreDnsAddonOptionsPtrOutput() CoreDnsAddonOptionsPtrOutput {
+	return o
+}
+
+func (o CoreDnsAddonOptionsPtrOutput) ToCoreDnsAddonOptionsPtrOutputWithContext(ctx context.Context) CoreDnsAddonOptionsPtrOutput {
+	return o
+}
+
+func (o CoreDnsAddonOptionsPtrOutput) Elem() CoreDnsAddonOptionsOutput {
+	return o.ApplyT(func(v *CoreDnsAddonOptions) CoreDnsAddonOptions {
+		if v != nil {
+			return *v
+		}
+		var ret CoreDnsAddonOptions
+		return ret
+	}).(CoreDnsAddonOptionsOutput)
+}
+
+// Custom configuration values for the coredns addon. This object must match the schema derived from [describe-addon-configuration](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-configuration.html).
+func (o CoreDnsAddonOptionsPtrOutput) ConfigurationValues() pulumi.MapOutput {
+	return o.ApplyT(func(v *CoreDnsAddonOptions) map[string]interface{} {
+		if v == nil {
+			return nil
+		}
+		return v.ConfigurationValues
+	}).(pulumi.MapOutput)
+}
+
+// Whether or not to create the `coredns` Addon in the cluster
+//
+// The managed addon can only be enabled if the cluster is a Fargate cluster or if the cluster
+// uses the default node group, otherwise the self-managed addon is used.
+func (o CoreDnsAddonOptionsPtrOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *CoreDnsAddonOptions) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Enabled
+	}).(pulumi.BoolPtrOutput)
+}
+
+// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
+func (o CoreDnsAddonOptionsPtrOutput) ResolveConflictsOnCreate() ResolveConflictsOnCreatePtrOutput {
+	return o.ApplyT(func(v *CoreDnsAddonOptions) *ResolveConflictsOnCreate {
+		if v == nil {
+			return nil
+		}
+		return v.ResolveConflictsOnCreate
+	}).(ResolveConflictsOnCreatePtrOutput)
+}
+
+// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value. Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
+func (o CoreDnsAddonOptionsPtrOutput) ResolveConflictsOnUpdate() ResolveConflictsOnUpdatePtrOutput {
+	return o.ApplyT(func(v *CoreDnsAddonOptions) *ResolveConflictsOnUpdate {
+		if v == nil {
+			return nil
+		}
+		return v.ResolveConflictsOnUpdate
+	}).(ResolveConflictsOnUpdatePtrOutput)
 }
 
-func (i ClusterNodeGroupOptionsArgs) ToClusterNodeGroupOptionsOutput() ClusterNodeGroupOptionsOutput {
-	return i.ToClusterNodeGroupOptionsOutputWithContext(context.Background())
+// The version of the EKS add-on. The version must match one of the versions returned by [describe-addon-versions](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-versions.html).
+func (o CoreDnsAddonOptionsPtrOutput) Version() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CoreDnsAddonOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Version
+	}).(pulumi.StringPtrOutput)
 }
 
-func (i ClusterNodeGroupOptionsArgs) ToClusterNodeGroupOptionsOutputWithContext(ctx context.Context) ClusterNodeGroupOptionsOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ClusterNodeGroupOptionsOutput)
+// Contains the AWS Role and Provider necessary to override the `[system:master]` entity ARN. This is an optional argument used when creating `Cluster`. Read more: https://docs.aws.amazon.com/eks/latest/userguide/add-user-role.html
+//
+// Note: This option is only supported with Pulumi nodejs programs. Please use `ProviderCredentialOpts` as an alternative instead.
+type CreationRoleProvider struct {
+	Provider *aws.Provider `pulumi:"provider"`
+	Role     *iam.Role     `pulumi:"role"`
 }
 
-func (i ClusterNodeGroupOptionsArgs) ToClusterNodeGroupOptionsPtrOutput() ClusterNodeGroupOptionsPtrOutput {
-	return i.ToClusterNodeGroupOptionsPtrOutputWithContext(context.Background())
+// CreationRoleProviderInput is an input type that accepts CreationRoleProviderArgs and CreationRoleProviderOutput values.
+// You can construct a concrete instance of `CreationRoleProviderInput` via:
+//
+//	CreationRoleProviderArgs{...}
+type CreationRoleProviderInput interface {
+	pulumi.Input
+
+	ToCreationRoleProviderOutput() CreationRoleProviderOutput
+	ToCreationRoleProviderOutputWithContext(context.Context) CreationRoleProviderOutput
 }
 
-func (i ClusterNodeGroupOptionsArgs) ToClusterNodeGroupOptionsPtrOutputWithContext(ctx context.Context) ClusterNodeGroupOptionsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ClusterNodeGroupOptionsOutput).ToClusterNodeGroupOptionsPtrOutputWithContext(ctx)
+// Contains the AWS Role and Provider necessary to override the `[system:master]` entity ARN. This is an optional argument used when creating `Cluster`. Read more: https://docs.aws.amazon.com/eks/latest/userguide/add-user-role.html
+//
+// Note: This option is only supported with Pulumi nodejs programs. Please use `ProviderCredentialOpts` as an alternative instead.
+type CreationRoleProviderArgs struct {
+	Provider *aws.Provider `pulumi:"provider"`
+	Role     *iam.Role     `pulumi:"role"`
 }
 
-// ClusterNodeGroupOptionsPtrInput is an input type that accepts ClusterNodeGroupOptionsArgs, ClusterNodeGroupOptionsPtr and ClusterNodeGroupOptionsPtrOutput values.
-// You can construct a concrete instance of `ClusterNodeGroupOptionsPtrInput` via:
+func (CreationRoleProviderArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CreationRoleProvider)(nil)).Elem()
+}
+
+func (i CreationRoleProviderArgs) ToCreationRoleProviderOutput() CreationRoleProviderOutput {
+	return i.ToCreationRoleProviderOutputWithContext(context.Background())
+}
+
+func (i CreationRoleProviderArgs) ToCreationRoleProviderOutputWithContext(ctx context.Context) CreationRoleProviderOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CreationRoleProviderOutput)
+}
+
+func (i CreationRoleProviderArgs) ToCreationRoleProviderPtrOutput() CreationRoleProviderPtrOutput {
+	return i.ToCreationRoleProviderPtrOutputWithContext(context.Background())
+}
+
+func (i CreationRoleProviderArgs) ToCreationRoleProviderPtrOutputWithContext(ctx context.Context) CreationRoleProviderPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CreationRoleProviderOutput).ToCreationRoleProviderPtrOutputWithContext(ctx)
+}
+
+// CreationRoleProviderPtrInput is an input type that accepts CreationRoleProviderArgs, CreationRoleProviderPtr and CreationRoleProviderPtrOutput values.
+// You can construct a concrete instance of `CreationRoleProviderPtrInput` via:
 //
-//	        ClusterNodeGroupOptionsArgs{...}
+//	        CreationRoleProviderArgs{...}
 //
 //	or:
 //
 //	        nil
-type ClusterNodeGroupOptionsPtrInput interface {
+type CreationRoleProviderPtrInput interface {
 	pulumi.Input
 
-	ToClusterNodeGroupOptionsPtrOutput() ClusterNodeGroupOptionsPtrOutput
-	ToClusterNodeGroupOptionsPtrOutputWithContext(context.Context) ClusterNodeGroupOptionsPtrOutput
+	ToCreationRoleProviderPtrOutput() CreationRoleProviderPtrOutput
+	ToCreationRoleProviderPtrOutputWithContext(context.Context) CreationRoleProviderPtrOutput
 }
 
-type clusterNodeGroupOptionsPtrType ClusterNodeGroupOptionsArgs
+type creationRoleProviderPtrType CreationRoleProviderArgs
 
-func ClusterNodeGroupOptionsPtr(v *ClusterNodeGroupOptionsArgs) ClusterNodeGroupOptionsPtrInput {
-	return (*clusterNodeGroupOptionsPtrType)(v)
+func CreationRoleProviderPtr(v *CreationRoleProviderArgs) CreationRoleProviderPtrInput {
+	return (*creationRoleProviderPtrType)(v)
 }
 
-func (*clusterNodeGroupOptionsPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**ClusterNodeGroupOptions)(nil)).Elem()
+func (*creationRoleProviderPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**CreationRoleProvider)(nil)).Elem()
 }
 
-func (i *clusterNodeGroupOptionsPtrType) ToClusterNodeGroupOptionsPtrOutput() ClusterNodeGroupOptionsPtrOutput {
-	return i.ToClusterNodeGroupOptionsPtrOutputWithContext(context.Background())
+func (i *creationRoleProviderPtrType) ToCreationRoleProviderPtrOutput() CreationRoleProviderPtrOutput {
+	return i.ToCreationRoleProviderPtrOutputWithContext(context.Background())
 }
 
-func (i *clusterNodeGroupOptionsPtrType) ToClusterNodeGroupOptionsPtrOutputWithContext(ctx context.Context) ClusterNodeGroupOptionsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ClusterNodeGroupOptionsPtrOutput)
+func (i *creationRoleProviderPtrType) ToCreationRoleProviderPtrOutputWithContext(ctx context.Context) CreationRoleProviderPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CreationRoleProviderPtrOutput)
 }
 
-// Describes the configuration options accepted by a cluster to create its own node groups.
-type ClusterNodeGroupOptionsOutput struct{ *pulumi.OutputState }
+// Contains the AWS Role and Provider necessary to override the `[system:master]` entity ARN. This is an optional argument used when creating `Cluster`. Read more: https://docs.aws.amazon.com/eks/latest/userguide/add-user-role.html
+//
+// Note: This option is only supported with Pulumi nodejs programs. Please use `ProviderCredentialOpts` as an alternative instead.
+type CreationRoleProviderOutput struct{ *pulumi.OutputState }
 
-func (ClusterNodeGroupOptionsOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*ClusterNodeGroupOptions)(nil)).Elem()
+func (CreationRoleProviderOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CreationRoleProvider)(nil)).Elem()
 }
 
-func (o ClusterNodeGroupOptionsOutput) ToClusterNodeGroupOptionsOutput() ClusterNodeGroupOptionsOutput {
+func (o CreationRoleProviderOutput) ToCreationRoleProviderOutput() CreationRoleProviderOutput {
 	return o
 }
 
-func (o ClusterNodeGroupOptionsOutput) ToClusterNodeGroupOptionsOutputWithContext(ctx context.Context) ClusterNodeGroupOptionsOutput {
+func (o CreationRoleProviderOutput) ToCreationRoleProviderOutputWithContext(ctx context.Context) CreationRoleProviderOutput {
 	return o
 }
 
-func (o ClusterNodeGroupOptionsOutput) ToClusterNodeGroupOptionsPtrOutput() ClusterNodeGroupOptionsPtrOutput {
-	return o.ToClusterNodeGroupOptionsPtrOutputWithContext(context.Background())
+func (o CreationRoleProviderOutput) ToCreationRoleProviderPtrOutput() CreationRoleProviderPtrOutput {
+	return o.ToCreationRoleProviderPtrOutputWithContext(context.Background())
 }
 
-func (o ClusterNodeGroupOptionsOutput) ToClusterNodeGroupOptionsPtrOutputWithContext(ctx context.Context) ClusterNodeGroupOptionsPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v ClusterNodeGroupOptions) *ClusterNodeGroupOptions {
+func (o CreationRoleProviderOutput) ToCreationRoleProviderPtrOutputWithContext(ctx context.Context) CreationRoleProviderPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v CreationRoleProvider) *CreationRoleProvider {
 		return &v
-	}).(ClusterNodeGroupOptionsPtrOutput)
+	}).(CreationRoleProviderPtrOutput)
 }
 
-// The AMI ID to use for the worker nodes.
-//
-// Defaults to the latest recommended EKS Optimized Linux AMI from the AWS Systems Manager Parameter Store.
-//
-// Note: `amiId` and `gpu` are mutually exclusive.
-//
-// See for more details:
-// - https://docs.aws.amazon.com/eks/latest/userguide/eks-optimized-ami.html.
-func (o ClusterNodeGroupOptionsOutput) AmiId() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.AmiId }).(pulumi.StringPtrOutput)
+func (o CreationRoleProviderOutput) Provider() aws.ProviderOutput {
+	return o.ApplyT(func(v CreationRoleProvider) *aws.Provider { return v.Provider }).(aws.ProviderOutput)
 }
 
-// The AMI Type to use for the worker nodes.
-//
-// Only applicable when setting an AMI ID that is of type `arm64`.
-//
-// Note: `amiType` and `gpu` are mutually exclusive.
-func (o ClusterNodeGroupOptionsOutput) AmiType() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.AmiType }).(pulumi.StringPtrOutput)
+func (o CreationRoleProviderOutput) Role() iam.RoleOutput {
+	return o.ApplyT(func(v CreationRoleProvider) *iam.Role { return v.Role }).(iam.RoleOutput)
 }
 
-// The tags to apply to the NodeGroup's AutoScalingGroup in the CloudFormation Stack.
-//
-// Per AWS, all stack-level tags, including automatically created tags, and the `cloudFormationTags` option are propagated to resources that AWS CloudFormation supports, including the AutoScalingGroup. See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-resource-tags.html
+type CreationRoleProviderPtrOutput struct{ *pulumi.OutputState }
+
+func (CreationRoleProviderPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**CreationRoleProvider)(nil)).Elem()
+}
+
+func (o CreationRoleProviderPtrOutput) ToCreationRoleProviderPtrOutput() CreationRoleProviderPtrOutput {
+	return o
+}
+
+func (o CreationRoleProviderPtrOutput) ToCreationRoleProviderPtrOutputWithContext(ctx context.Context) CreationRoleProviderPtrOutput {
+	return o
+}
+
+func (o CreationRoleProviderPtrOutput) Elem() CreationRoleProviderOutput {
+	return o.ApplyT(func(v *CreationRoleProvider) CreationRoleProvider {
+		if v != nil {
+			return *v
+		}
+		var ret CreationRoleProvider
+		return ret
+	}).(CreationRoleProviderOutput)
+}
+
+func (o CreationRoleProviderPtrOutput) Provider() aws.ProviderOutput {
+	return o.ApplyT(func(v *CreationRoleProvider) *aws.Provider {
+		if v == nil {
+			return nil
+		}
+		return v.Provider
+	}).(aws.ProviderOutput)
+}
+
+func (o CreationRoleProviderPtrOutput) Role() iam.RoleOutput {
+	return o.ApplyT(func(v *CreationRoleProvider) *iam.Role {
+		if v == nil {
+			return nil
+		}
+		return v.Role
+	}).(iam.RoleOutput)
+}
+
+// Configures the KMS key the cluster creates for envelope encryption of Kubernetes secrets.
+type EncryptionConfigOptions struct {
+	// The name of the alias of the key. Must start with `alias/`. Defaults to `alias/eks/<clusterName>`.
+	AliasName *string `pulumi:"aliasName"`
+	// The waiting period in days before the key is deleted after the cluster is destroyed. Must be between 7 and 30. Defaults to 30 days.
+	DeletionWindowInDays *int `pulumi:"deletionWindowInDays"`
+	// Whether to enable automatic rotation of the key material. Defaults to `true`.
+	EnableKeyRotation *bool `pulumi:"enableKeyRotation"`
+	// The ARNs of IAM principals that are allowed to administer the key, e.g. to change its policy or schedule its deletion. The AWS account always retains full access to the key so that IAM policies can grant access to it as well.
+	KeyAdministratorArns []string `pulumi:"keyAdministratorArns"`
+	// The period in days between automatic rotations of the key material. Must be between 90 and 2560. Defaults to 365 days.
+	RotationPeriodInDays *int `pulumi:"rotationPeriodInDays"`
+}
+
+// EncryptionConfigOptionsInput is an input type that accepts EncryptionConfigOptionsArgs and EncryptionConfigOptionsOutput values.
+// You can construct a concrete instance of `EncryptionConfigOptionsInput` via:
 //
-// Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
-func (o ClusterNodeGroupOptionsOutput) AutoScalingGroupTags() pulumi.StringMapOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) map[string]string { return v.AutoScalingGroupTags }).(pulumi.StringMapOutput)
+//	EncryptionConfigOptionsArgs{...}
+type EncryptionConfigOptionsInput interface {
+	pulumi.Input
+
+	ToEncryptionConfigOptionsOutput() EncryptionConfigOptionsOutput
+	ToEncryptionConfigOptionsOutputWithContext(context.Context) EncryptionConfigOptionsOutput
 }
 
-// Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
-func (o ClusterNodeGroupOptionsOutput) BootstrapExtraArgs() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.BootstrapExtraArgs }).(pulumi.StringPtrOutput)
+// Configures the KMS key the cluster creates for envelope encryption of Kubernetes secrets.
+type EncryptionConfigOptionsArgs struct {
+	// The name of the alias of the key. Must start with `alias/`. Defaults to `alias/eks/<clusterName>`.
+	AliasName pulumi.StringPtrInput `pulumi:"aliasName"`
+	// The waiting period in days before the key is deleted after the cluster is destroyed. Must be between 7 and 30. Defaults to 30 days.
+	DeletionWindowInDays pulumi.IntPtrInput `pulumi:"deletionWindowInDays"`
+	// Whether to enable automatic rotation of the key material. Defaults to `true`.
+	EnableKeyRotation pulumi.BoolPtrInput `pulumi:"enableKeyRotation"`
+	// The ARNs of IAM principals that are allowed to administer the key, e.g. to change its policy or schedule its deletion. The AWS account always retains full access to the key so that IAM policies can grant access to it as well.
+	KeyAdministratorArns pulumi.StringArrayInput `pulumi:"keyAdministratorArns"`
+	// The period in days between automatic rotations of the key material. Must be between 90 and 2560. Defaults to 365 days.
+	RotationPeriodInDays pulumi.IntPtrInput `pulumi:"rotationPeriodInDays"`
 }
 
-// The configuration settings for Bottlerocket OS.
-// The settings will get merged with the base settings the provider uses to configure Bottlerocket.
+func (EncryptionConfigOptionsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EncryptionConfigOptions)(nil)).Elem()
+}
+
+func (i EncryptionConfigOptionsArgs) ToEncryptionConfigOptionsOutput() EncryptionConfigOptionsOutput {
+	return i.ToEncryptionConfigOptionsOutputWithContext(context.Background())
+}
+
+func (i EncryptionConfigOptionsArgs) ToEncryptionConfigOptionsOutputWithContext(ctx context.Context) EncryptionConfigOptionsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EncryptionConfigOptionsOutput)
+}
+
+func (i EncryptionConfigOptionsArgs) ToEncryptionConfigOptionsPtrOutput() EncryptionConfigOptionsPtrOutput {
+	return i.ToEncryptionConfigOptionsPtrOutputWithContext(context.Background())
+}
+
+func (i EncryptionConfigOptionsArgs) ToEncryptionConfigOptionsPtrOutputWithContext(ctx context.Context) EncryptionConfigOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EncryptionConfigOptionsOutput).ToEncryptionConfigOptionsPtrOutputWithContext(ctx)
+}
+
+// EncryptionConfigOptionsPtrInput is an input type that accepts EncryptionConfigOptionsArgs, EncryptionConfigOptionsPtr and EncryptionConfigOptionsPtrOutput values.
+// You can construct a concrete instance of `EncryptionConfigOptionsPtrInput` via:
 //
-// This includes:
-//   - settings.kubernetes.api-server
-//   - settings.kubernetes.cluster-certificate
-//   - settings.kubernetes.cluster-name
-//   - settings.kubernetes.cluster-dns-ip
+//	        EncryptionConfigOptionsArgs{...}
 //
-// For an overview of the available settings, see https://bottlerocket.dev/en/os/1.20.x/api/settings/.
-func (o ClusterNodeGroupOptionsOutput) BottlerocketSettings() pulumi.MapOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) map[string]interface{} { return v.BottlerocketSettings }).(pulumi.MapOutput)
+//	or:
+//
+//	        nil
+type EncryptionConfigOptionsPtrInput interface {
+	pulumi.Input
+
+	ToEncryptionConfigOptionsPtrOutput() EncryptionConfigOptionsPtrOutput
+	ToEncryptionConfigOptionsPtrOutputWithContext(context.Context) EncryptionConfigOptionsPtrOutput
 }
 
-// The tags to apply to the CloudFormation Stack of the Worker NodeGroup.
-//
-// Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
-func (o ClusterNodeGroupOptionsOutput) CloudFormationTags() pulumi.StringMapOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) map[string]string { return v.CloudFormationTags }).(pulumi.StringMapOutput)
+type encryptionConfigOptionsPtrType EncryptionConfigOptionsArgs
+
+func EncryptionConfigOptionsPtr(v *EncryptionConfigOptionsArgs) EncryptionConfigOptionsPtrInput {
+	return (*encryptionConfigOptionsPtrType)(v)
 }
 
-// The ingress rule that gives node group access.
-func (o ClusterNodeGroupOptionsOutput) ClusterIngressRule() ec2.SecurityGroupRuleOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *ec2.SecurityGroupRule { return v.ClusterIngressRule }).(ec2.SecurityGroupRuleOutput)
+func (*encryptionConfigOptionsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EncryptionConfigOptions)(nil)).Elem()
 }
 
-// The ID of the ingress rule that gives node group access.
-func (o ClusterNodeGroupOptionsOutput) ClusterIngressRuleId() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.ClusterIngressRuleId }).(pulumi.StringPtrOutput)
+func (i *encryptionConfigOptionsPtrType) ToEncryptionConfigOptionsPtrOutput() EncryptionConfigOptionsPtrOutput {
+	return i.ToEncryptionConfigOptionsPtrOutputWithContext(context.Background())
 }
 
-// The number of worker nodes that should be running in the cluster. Defaults to 2.
-func (o ClusterNodeGroupOptionsOutput) DesiredCapacity() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *int { return v.DesiredCapacity }).(pulumi.IntPtrOutput)
+func (i *encryptionConfigOptionsPtrType) ToEncryptionConfigOptionsPtrOutputWithContext(ctx context.Context) EncryptionConfigOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EncryptionConfigOptionsPtrOutput)
+}
+
+// Configures the KMS key the cluster creates for envelope encryption of Kubernetes secrets.
+type EncryptionConfigOptionsOutput struct{ *pulumi.OutputState }
+
+func (EncryptionConfigOptionsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EncryptionConfigOptions)(nil)).Elem()
+}
+
+func (o EncryptionConfigOptionsOutput) ToEncryptionConfigOptionsOutput() EncryptionConfigOptionsOutput {
+	return o
+}
+
+func (o EncryptionConfigOptionsOutput) ToEncryptionConfigOptionsOutputWithContext(ctx context.Context) EncryptionConfigOptionsOutput {
+	return o
+}
+
+func (o EncryptionConfigOptionsOutput) ToEncryptionConfigOptionsPtrOutput() EncryptionConfigOptionsPtrOutput {
+	return o.ToEncryptionConfigOptionsPtrOutputWithContext(context.Background())
 }
 
-// Enables/disables detailed monitoring of the EC2 instances.
-//
-// With detailed monitoring, all metrics, including status check metrics, are available in 1-minute intervals.
-// When enabled, you can also get aggregated data across groups of similar instances.
-//
-// Note: You are charged per metric that is sent to CloudWatch. You are not charged for data storage.
-// For more information, see "Paid tier" and "Example 1 - EC2 Detailed Monitoring" here https://aws.amazon.com/cloudwatch/pricing/.
-func (o ClusterNodeGroupOptionsOutput) EnableDetailedMonitoring() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *bool { return v.EnableDetailedMonitoring }).(pulumi.BoolPtrOutput)
+func (o EncryptionConfigOptionsOutput) ToEncryptionConfigOptionsPtrOutputWithContext(ctx context.Context) EncryptionConfigOptionsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EncryptionConfigOptions) *EncryptionConfigOptions {
+		return &v
+	}).(EncryptionConfigOptionsPtrOutput)
 }
 
-// Encrypt the root block device of the nodes in the node group.
-func (o ClusterNodeGroupOptionsOutput) EncryptRootBlockDevice() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *bool { return v.EncryptRootBlockDevice }).(pulumi.BoolPtrOutput)
+// The name of the alias of the key. Must start with `alias/`. Defaults to `alias/eks/<clusterName>`.
+func (o EncryptionConfigOptionsOutput) AliasName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v EncryptionConfigOptions) *string { return v.AliasName }).(pulumi.StringPtrOutput)
 }
 
-// Extra security groups to attach on all nodes in this worker node group.
-//
-// This additional set of security groups captures any user application rules that will be needed for the nodes.
-func (o ClusterNodeGroupOptionsOutput) ExtraNodeSecurityGroups() ec2.SecurityGroupArrayOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) []*ec2.SecurityGroup { return v.ExtraNodeSecurityGroups }).(ec2.SecurityGroupArrayOutput)
+// The waiting period in days before the key is deleted after the cluster is destroyed. Must be between 7 and 30. Defaults to 30 days.
+func (o EncryptionConfigOptionsOutput) DeletionWindowInDays() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v EncryptionConfigOptions) *int { return v.DeletionWindowInDays }).(pulumi.IntPtrOutput)
 }
 
-// Use the latest recommended EKS Optimized Linux AMI with GPU support for the worker nodes from the AWS Systems Manager Parameter Store.
-//
-// Defaults to false.
-//
-// Note: `gpu` and `amiId` are mutually exclusive.
-//
-// See for more details:
-// - https://docs.aws.amazon.com/eks/latest/userguide/eks-optimized-ami.html
-// - https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
-func (o ClusterNodeGroupOptionsOutput) Gpu() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *bool { return v.Gpu }).(pulumi.BoolPtrOutput)
+// Whether to enable automatic rotation of the key material. Defaults to `true`.
+func (o EncryptionConfigOptionsOutput) EnableKeyRotation() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EncryptionConfigOptions) *bool { return v.EnableKeyRotation }).(pulumi.BoolPtrOutput)
 }
 
-// Whether to ignore changes to the desired size of the Auto Scaling Group. This is useful when using Cluster Autoscaler.
-//
-// See [EKS best practices](https://aws.github.io/aws-eks-best-practices/cluster-autoscaling/) for more details.
-func (o ClusterNodeGroupOptionsOutput) IgnoreScalingChanges() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *bool { return v.IgnoreScalingChanges }).(pulumi.BoolPtrOutput)
+// The ARNs of IAM principals that are allowed to administer the key, e.g. to change its policy or schedule its deletion. The AWS account always retains full access to the key so that IAM policies can grant access to it as well.
+func (o EncryptionConfigOptionsOutput) KeyAdministratorArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EncryptionConfigOptions) []string { return v.KeyAdministratorArns }).(pulumi.StringArrayOutput)
 }
 
-// The IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
-func (o ClusterNodeGroupOptionsOutput) InstanceProfile() iam.InstanceProfileOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *iam.InstanceProfile { return v.InstanceProfile }).(iam.InstanceProfileOutput)
+// The period in days between automatic rotations of the key material. Must be between 90 and 2560. Defaults to 365 days.
+func (o EncryptionConfigOptionsOutput) RotationPeriodInDays() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v EncryptionConfigOptions) *int { return v.RotationPeriodInDays }).(pulumi.IntPtrOutput)
 }
 
-// The name of the IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
-func (o ClusterNodeGroupOptionsOutput) InstanceProfileName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.InstanceProfileName }).(pulumi.StringPtrOutput)
+type EncryptionConfigOptionsPtrOutput struct{ *pulumi.OutputState }
+
+func (EncryptionConfigOptionsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EncryptionConfigOptions)(nil)).Elem()
 }
 
-// The instance type to use for the cluster's nodes. Defaults to "t3.medium".
-func (o ClusterNodeGroupOptionsOutput) InstanceType() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.InstanceType }).(pulumi.StringPtrOutput)
+func (o EncryptionConfigOptionsPtrOutput) ToEncryptionConfigOptionsPtrOutput() EncryptionConfigOptionsPtrOutput {
+	return o
 }
 
-// Name of the key pair to use for SSH access to worker nodes.
-func (o ClusterNodeGroupOptionsOutput) KeyName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.KeyName }).(pulumi.StringPtrOutput)
+func (o EncryptionConfigOptionsPtrOutput) ToEncryptionConfigOptionsPtrOutputWithContext(ctx context.Context) EncryptionConfigOptionsPtrOutput {
+	return o
 }
 
-// Extra args to pass to the Kubelet. Corresponds to the options passed in the `--kubeletExtraArgs` flag to `/etc/eks/bootstrap.sh`. For example, '--port=10251 --address=0.0.0.0'. Note that the `labels` and `taints` properties will be applied to this list (using `--node-labels` and `--register-with-taints` respectively) after to the explicit `kubeletExtraArgs`.
-func (o ClusterNodeGroupOptionsOutput) KubeletExtraArgs() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.KubeletExtraArgs }).(pulumi.StringPtrOutput)
+func (o EncryptionConfigOptionsPtrOutput) Elem() EncryptionConfigOptionsOutput {
+	return o.ApplyT(func(v *EncryptionConfigOptions) EncryptionConfigOptions {
+		if v != nil {
+			return *v
+		}
+		var ret EncryptionConfigOptions
+		return ret
+	}).(EncryptionConfigOptionsOutput)
 }
 
-// Custom k8s node labels to be attached to each worker node. Adds the given key/value pairs to the `--node-labels` kubelet argument.
-func (o ClusterNodeGroupOptionsOutput) Labels() pulumi.StringMapOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) map[string]string { return v.Labels }).(pulumi.StringMapOutput)
+// The name of the alias of the key. Must start with `alias/`. Defaults to `alias/eks/<clusterName>`.
+func (o EncryptionConfigOptionsPtrOutput) AliasName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *EncryptionConfigOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.AliasName
+	}).(pulumi.StringPtrOutput)
 }
 
-// The tag specifications to apply to the launch template.
-func (o ClusterNodeGroupOptionsOutput) LaunchTemplateTagSpecifications() ec2.LaunchTemplateTagSpecificationArrayOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) []ec2.LaunchTemplateTagSpecification {
-		return v.LaunchTemplateTagSpecifications
-	}).(ec2.LaunchTemplateTagSpecificationArrayOutput)
+// The waiting period in days before the key is deleted after the cluster is destroyed. Must be between 7 and 30. Defaults to 30 days.
+func (o EncryptionConfigOptionsPtrOutput) DeletionWindowInDays() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *EncryptionConfigOptions) *int {
+		if v == nil {
+			return nil
+		}
+		return v.DeletionWindowInDays
+	}).(pulumi.IntPtrOutput)
 }
 
-// The maximum number of worker nodes running in the cluster. Defaults to 2.
-func (o ClusterNodeGroupOptionsOutput) MaxSize() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *int { return v.MaxSize }).(pulumi.IntPtrOutput)
+// Whether to enable automatic rotation of the key material. Defaults to `true`.
+func (o EncryptionConfigOptionsPtrOutput) EnableKeyRotation() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EncryptionConfigOptions) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.EnableKeyRotation
+	}).(pulumi.BoolPtrOutput)
 }
 
-// The minimum amount of instances that should remain available during an instance refresh, expressed as a percentage. Defaults to 50.
-func (o ClusterNodeGroupOptionsOutput) MinRefreshPercentage() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *int { return v.MinRefreshPercentage }).(pulumi.IntPtrOutput)
+// The ARNs of IAM principals that are allowed to administer the key, e.g. to change its policy or schedule its deletion. The AWS account always retains full access to the key so that IAM policies can grant access to it as well.
+func (o EncryptionConfigOptionsPtrOutput) KeyAdministratorArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EncryptionConfigOptions) []string {
+		if v == nil {
+			return nil
+		}
+		return v.KeyAdministratorArns
+	}).(pulumi.StringArrayOutput)
 }
 
-// The minimum number of worker nodes running in the cluster. Defaults to 1.
-func (o ClusterNodeGroupOptionsOutput) MinSize() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *int { return v.MinSize }).(pulumi.IntPtrOutput)
+// The period in days between automatic rotations of the key material. Must be between 90 and 2560. Defaults to 365 days.
+func (o EncryptionConfigOptionsPtrOutput) RotationPeriodInDays() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *EncryptionConfigOptions) *int {
+		if v == nil {
+			return nil
+		}
+		return v.RotationPeriodInDays
+	}).(pulumi.IntPtrOutput)
 }
 
-// Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
-func (o ClusterNodeGroupOptionsOutput) NodeAssociatePublicIpAddress() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *bool { return v.NodeAssociatePublicIpAddress }).(pulumi.BoolPtrOutput)
+// Defines how Kubernetes pods are executed in Fargate. See aws.eks.FargateProfileArgs for reference.
+type FargateProfile struct {
+	// Specify a custom role to use for executing pods in Fargate. Defaults to creating a new role with the `arn:aws:iam::aws:policy/AmazonEKSFargatePodExecutionRolePolicy` policy attached.
+	PodExecutionRoleArn *string `pulumi:"podExecutionRoleArn"`
+	// Specify the namespace and label selectors to use for launching pods into Fargate.
+	Selectors []eks.FargateProfileSelector `pulumi:"selectors"`
+	// Specify the subnets in which to execute Fargate tasks for pods. Defaults to the private subnets associated with the cluster.
+	SubnetIds []string `pulumi:"subnetIds"`
 }
 
-// Public key material for SSH access to worker nodes. See allowed formats at:
-// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
-// If not provided, no SSH access is enabled on VMs.
-func (o ClusterNodeGroupOptionsOutput) NodePublicKey() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.NodePublicKey }).(pulumi.StringPtrOutput)
+// FargateProfileInput is an input type that accepts FargateProfileArgs and FargateProfileOutput values.
+// You can construct a concrete instance of `FargateProfileInput` via:
+//
+//	FargateProfileArgs{...}
+type FargateProfileInput interface {
+	pulumi.Input
+
+	ToFargateProfileOutput() FargateProfileOutput
+	ToFargateProfileOutputWithContext(context.Context) FargateProfileOutput
 }
 
-// Whether the root block device should be deleted on termination of the instance. Defaults to true.
-func (o ClusterNodeGroupOptionsOutput) NodeRootVolumeDeleteOnTermination() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *bool { return v.NodeRootVolumeDeleteOnTermination }).(pulumi.BoolPtrOutput)
+// Defines how Kubernetes pods are executed in Fargate. See aws.eks.FargateProfileArgs for reference.
+type FargateProfileArgs struct {
+	// Specify a custom role to use for executing pods in Fargate. Defaults to creating a new role with the `arn:aws:iam::aws:policy/AmazonEKSFargatePodExecutionRolePolicy` policy attached.
+	PodExecutionRoleArn pulumi.StringPtrInput `pulumi:"podExecutionRoleArn"`
+	// Specify the namespace and label selectors to use for launching pods into Fargate.
+	Selectors eks.FargateProfileSelectorArrayInput `pulumi:"selectors"`
+	// Specify the subnets in which to execute Fargate tasks for pods. Defaults to the private subnets associated with the cluster.
+	SubnetIds pulumi.StringArrayInput `pulumi:"subnetIds"`
 }
 
-// Whether to encrypt a cluster node's root volume. Defaults to false.
-func (o ClusterNodeGroupOptionsOutput) NodeRootVolumeEncrypted() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *bool { return v.NodeRootVolumeEncrypted }).(pulumi.BoolPtrOutput)
+func (FargateProfileArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*FargateProfile)(nil)).Elem()
 }
 
-// The amount of provisioned IOPS. This is only valid with a volumeType of 'io1'.
-func (o ClusterNodeGroupOptionsOutput) NodeRootVolumeIops() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *int { return v.NodeRootVolumeIops }).(pulumi.IntPtrOutput)
+func (i FargateProfileArgs) ToFargateProfileOutput() FargateProfileOutput {
+	return i.ToFargateProfileOutputWithContext(context.Background())
 }
 
-// The size in GiB of a cluster node's root volume. Defaults to 20.
-func (o ClusterNodeGroupOptionsOutput) NodeRootVolumeSize() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *int { return v.NodeRootVolumeSize }).(pulumi.IntPtrOutput)
+func (i FargateProfileArgs) ToFargateProfileOutputWithContext(ctx context.Context) FargateProfileOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FargateProfileOutput)
 }
 
-// Provisioned throughput performance in integer MiB/s for a cluster node's root volume. This is only valid with a volumeType of 'gp3'.
-func (o ClusterNodeGroupOptionsOutput) NodeRootVolumeThroughput() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *int { return v.NodeRootVolumeThroughput }).(pulumi.IntPtrOutput)
+func (i FargateProfileArgs) ToFargateProfilePtrOutput() FargateProfilePtrOutput {
+	return i.ToFargateProfilePtrOutputWithContext(context.Background())
 }
 
-// Configured EBS type for a cluster node's root volume. Default is 'gp2'. Supported values are 'standard', 'gp2', 'gp3', 'st1', 'sc1', 'io1'.
-func (o ClusterNodeGroupOptionsOutput) NodeRootVolumeType() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.NodeRootVolumeType }).(pulumi.StringPtrOutput)
+func (i FargateProfileArgs) ToFargateProfilePtrOutputWithContext(ctx context.Context) FargateProfilePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FargateProfileOutput).ToFargateProfilePtrOutputWithContext(ctx)
 }
 
-// The security group for the worker node group to communicate with the cluster.
+// FargateProfilePtrInput is an input type that accepts FargateProfileArgs, FargateProfilePtr and FargateProfilePtrOutput values.
+// You can construct a concrete instance of `FargateProfilePtrInput` via:
 //
-// This security group requires specific inbound and outbound rules.
+//	        FargateProfileArgs{...}
 //
-// See for more details:
-// https://docs.aws.amazon.com/eks/latest/userguide/sec-group-reqs.html
+//	or:
 //
-// Note: The `nodeSecurityGroup` option and the cluster option`nodeSecurityGroupTags` are mutually exclusive.
-func (o ClusterNodeGroupOptionsOutput) NodeSecurityGroup() ec2.SecurityGroupOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *ec2.SecurityGroup { return v.NodeSecurityGroup }).(ec2.SecurityGroupOutput)
+//	        nil
+type FargateProfilePtrInput interface {
+	pulumi.Input
+
+	ToFargateProfilePtrOutput() FargateProfilePtrOutput
+	ToFargateProfilePtrOutputWithContext(context.Context) FargateProfilePtrOutput
 }
 
-// The ID of the security group for the worker node group to communicate with the cluster.
-//
-// This security group requires specific inbound and outbound rules.
-//
-// See for more details:
-// https://docs.aws.amazon.com/eks/latest/userguide/sec-group-reqs.html
-//
-// Note: The `nodeSecurityGroupId` option and the cluster option `nodeSecurityGroupTags` are mutually exclusive.
-func (o ClusterNodeGroupOptionsOutput) NodeSecurityGroupId() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.NodeSecurityGroupId }).(pulumi.StringPtrOutput)
+type fargateProfilePtrType FargateProfileArgs
+
+func FargateProfilePtr(v *FargateProfileArgs) FargateProfilePtrInput {
+	return (*fargateProfilePtrType)(v)
 }
 
-// The set of subnets to override and use for the worker node group.
-//
-// Setting this option overrides which subnets to use for the worker node group, regardless if the cluster's `subnetIds` is set, or if `publicSubnetIds` and/or `privateSubnetIds` were set.
-func (o ClusterNodeGroupOptionsOutput) NodeSubnetIds() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) []string { return v.NodeSubnetIds }).(pulumi.StringArrayOutput)
+func (*fargateProfilePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**FargateProfile)(nil)).Elem()
 }
 
-// Extra code to run on node startup. This code will run after the AWS EKS bootstrapping code and before the node signals its readiness to the managing CloudFormation stack. This code must be a typical user data script: critically it must begin with an interpreter directive (i.e. a `#!`).
-func (o ClusterNodeGroupOptionsOutput) NodeUserData() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.NodeUserData }).(pulumi.StringPtrOutput)
+func (i *fargateProfilePtrType) ToFargateProfilePtrOutput() FargateProfilePtrOutput {
+	return i.ToFargateProfilePtrOutputWithContext(context.Background())
 }
 
-// User specified code to run on node startup. This code is expected to handle the full AWS EKS bootstrapping code and signal node readiness to the managing CloudFormation stack. This code must be a complete and executable user data script in bash (Linux) or powershell (Windows).
-//
-// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/worker.html
-func (o ClusterNodeGroupOptionsOutput) NodeUserDataOverride() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.NodeUserDataOverride }).(pulumi.StringPtrOutput)
+func (i *fargateProfilePtrType) ToFargateProfilePtrOutputWithContext(ctx context.Context) FargateProfilePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FargateProfilePtrOutput)
 }
 
-// Extra nodeadm configuration sections to be added to the nodeadm user data. This can be shell scripts, nodeadm NodeConfig or any other user data compatible script. When configuring additional nodeadm NodeConfig sections, they'll be merged with the base settings the provider sets. You can overwrite base settings or provide additional settings this way.
-// The base settings the provider sets are:
-//   - cluster.name
-//   - cluster.apiServerEndpoint
-//   - cluster.certificateAuthority
-//   - cluster.cidr
-//
-// Note: This is only applicable when using AL2023.
-// See for more details:
-//   - https://awslabs.github.io/amazon-eks-ami/nodeadm/
-//   - https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
-func (o ClusterNodeGroupOptionsOutput) NodeadmExtraOptions() NodeadmOptionsArrayOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) []NodeadmOptions { return v.NodeadmExtraOptions }).(NodeadmOptionsArrayOutput)
+// Defines how Kubernetes pods are executed in Fargate. See aws.eks.FargateProfileArgs for reference.
+type FargateProfileOutput struct{ *pulumi.OutputState }
+
+func (FargateProfileOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FargateProfile)(nil)).Elem()
 }
 
-// The type of OS to use for the node group. Will be used to determine the right EKS optimized AMI to use based on the instance types and gpu configuration.
-// Valid values are `RECOMMENDED`, `AL2`, `AL2023` and `Bottlerocket`.
-//
-// Defaults to the current recommended OS.
-func (o ClusterNodeGroupOptionsOutput) OperatingSystem() OperatingSystemPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *OperatingSystem { return v.OperatingSystem }).(OperatingSystemPtrOutput)
+func (o FargateProfileOutput) ToFargateProfileOutput() FargateProfileOutput {
+	return o
 }
 
-// Bidding price for spot instance. If set, only spot instances will be added as worker node.
-func (o ClusterNodeGroupOptionsOutput) SpotPrice() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.SpotPrice }).(pulumi.StringPtrOutput)
+func (o FargateProfileOutput) ToFargateProfileOutputWithContext(ctx context.Context) FargateProfileOutput {
+	return o
 }
 
-// Custom k8s node taints to be attached to each worker node. Adds the given taints to the `--register-with-taints` kubelet argument
-func (o ClusterNodeGroupOptionsOutput) Taints() TaintMapOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) map[string]Taint { return v.Taints }).(TaintMapOutput)
+func (o FargateProfileOutput) ToFargateProfilePtrOutput() FargateProfilePtrOutput {
+	return o.ToFargateProfilePtrOutputWithContext(context.Background())
+}
+
+func (o FargateProfileOutput) ToFargateProfilePtrOutputWithContext(ctx context.Context) FargateProfilePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v FargateProfile) *FargateProfile {
+		return &v
+	}).(FargateProfilePtrOutput)
+}
+
+// Specify a custom role to use for executing pods in Fargate. Defaults to creating a new role with the `arn:aws:iam::aws:policy/AmazonEKSFargatePodExecutionRolePolicy` policy attached.
+func (o FargateProfileOutput) PodExecutionRoleArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FargateProfile) *string { return v.PodExecutionRoleArn }).(pulumi.StringPtrOutput)
+}
+
+// Specify the namespace and label selectors to use for launching pods into Fargate.
+func (o FargateProfileOutput) Selectors() eks.FargateProfileSelectorArrayOutput {
+	return o.ApplyT(func(v FargateProfile) []eks.FargateProfileSelector { return v.Selectors }).(eks.FargateProfileSelectorArrayOutput)
 }
 
-// Desired Kubernetes master / control plane version. If you do not specify a value, the latest available version is used.
-func (o ClusterNodeGroupOptionsOutput) Version() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ClusterNodeGroupOptions) *string { return v.Version }).(pulumi.StringPtrOutput)
+// Specify the subnets in which to execute Fargate tasks for pods. Defaults to the private subnets associated with the cluster.
+func (o FargateProfileOutput) SubnetIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v FargateProfile) []string { return v.SubnetIds }).(pulumi.StringArrayOutput)
 }
 
-type ClusterNodeGroupOptionsPtrOutput struct{ *pulumi.OutputState }
+type FargateProfilePtrOutput struct{ *pulumi.OutputState }
 
-func (ClusterNodeGroupOptionsPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**ClusterNodeGroupOptions)(nil)).Elem()
+func (FargateProfilePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**FargateProfile)(nil)).Elem()
 }
 
-func (o ClusterNodeGroupOptionsPtrOutput) ToClusterNodeGroupOptionsPtrOutput() ClusterNodeGroupOptionsPtrOutput {
+func (o FargateProfilePtrOutput) ToFargateProfilePtrOutput() FargateProfilePtrOutput {
 	return o
 }
 
-func (o ClusterNodeGroupOptionsPtrOutput) ToClusterNodeGroupOptionsPtrOutputWithContext(ctx context.Context) ClusterNodeGroupOptionsPtrOutput {
+func (o FargateProfilePtrOutput) ToFargateProfilePtrOutputWithContext(ctx context.Context) FargateProfilePtrOutput {
 	return o
 }
 
-func (o ClusterNodeGroupOptionsPtrOutput) Elem() ClusterNodeGroupOptionsOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) ClusterNodeGroupOptions {
+func (o FargateProfilePtrOutput) Elem() FargateProfileOutput {
+	return o.ApplyT(func(v *FargateProfile) FargateProfile {
 		if v != nil {
 			return *v
 		}
-		var ret ClusterNodeGroupOptions
+		var ret FargateProfile
 		return ret
-	}).(ClusterNodeGroupOptionsOutput)
+	}).(FargateProfileOutput)
 }
 
-// The AMI ID to use for the worker nodes.
-//
-// Defaults to the latest recommended EKS Optimized Linux AMI from the AWS Systems Manager Parameter Store.
-//
-// Note: `amiId` and `gpu` are mutually exclusive.
-//
-// See for more details:
-// - https://docs.aws.amazon.com/eks/latest/userguide/eks-optimized-ami.html.
-func (o ClusterNodeGroupOptionsPtrOutput) AmiId() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+// Specify a custom role to use for executing pods in Fargate. Defaults to creating a new role with the `arn:aws:iam::aws:policy/AmazonEKSFargatePodExecutionRolePolicy` policy attached.
+func (o FargateProfilePtrOutput) PodExecutionRoleArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FargateProfile) *string {
 		if v == nil {
 			return nil
 		}
-		return v.AmiId
+		return v.PodExecutionRoleArn
 	}).(pulumi.StringPtrOutput)
 }
 
-// The AMI Type to use for the worker nodes.
-//
-// Only applicable when setting an AMI ID that is of type `arm64`.
-//
-// Note: `amiType` and `gpu` are mutually exclusive.
-func (o ClusterNodeGroupOptionsPtrOutput) AmiType() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+// Specify the namespace and label selectors to use for launching pods into Fargate.
+func (o FargateProfilePtrOutput) Selectors() eks.FargateProfileSelectorArrayOutput {
+	return o.ApplyT(func(v *FargateProfile) []eks.FargateProfileSelector {
 		if v == nil {
 			return nil
 		}
-		return v.AmiType
-	}).(pulumi.StringPtrOutput)
+		return v.Selectors
+	}).(eks.FargateProfileSelectorArrayOutput)
 }
 
-// The tags to apply to the NodeGroup's AutoScalingGroup in the CloudFormation Stack.
-//
-// Per AWS, all stack-level tags, including automatically created tags, and the `cloudFormationTags` option are propagated to resources that AWS CloudFormation supports, including the AutoScalingGroup. See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-resource-tags.html
-//
-// Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
-func (o ClusterNodeGroupOptionsPtrOutput) AutoScalingGroupTags() pulumi.StringMapOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) map[string]string {
+// Specify the subnets in which to execute Fargate tasks for pods. Defaults to the private subnets associated with the cluster.
+func (o FargateProfilePtrOutput) SubnetIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *FargateProfile) []string {
 		if v == nil {
 			return nil
 		}
-		return v.AutoScalingGroupTags
-	}).(pulumi.StringMapOutput)
+		return v.SubnetIds
+	}).(pulumi.StringArrayOutput)
 }
 
-// Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
-func (o ClusterNodeGroupOptionsPtrOutput) BootstrapExtraArgs() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
-		if v == nil {
-			return nil
-		}
-		return v.BootstrapExtraArgs
-	}).(pulumi.StringPtrOutput)
+// InterruptionHandling configures how a node group handles Spot interruptions and Auto Scaling Group termination events. The events are delivered to an SQS queue, which aws-node-termination-handler processes in queue mode to cordon and drain the affected nodes before their instances are terminated.
+// See for more details: https://github.com/aws/aws-node-termination-handler#queue-processor
+type InterruptionHandling struct {
+	// The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+	ChartVersion *string `pulumi:"chartVersion"`
+	// The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+	HeartbeatTimeout *int `pulumi:"heartbeatTimeout"`
+	// The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+	Namespace *string `pulumi:"namespace"`
+	// The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+	//
+	// If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+	OidcProviderArn *string `pulumi:"oidcProviderArn"`
+	// The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `<name>-node-termination-handler`, where `<name>` is the name of the node group.
+	ServiceAccountName *string `pulumi:"serviceAccountName"`
+	// Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+	Values map[string]interface{} `pulumi:"values"`
 }
 
-// The configuration settings for Bottlerocket OS.
-// The settings will get merged with the base settings the provider uses to configure Bottlerocket.
-//
-// This includes:
-//   - settings.kubernetes.api-server
-//   - settings.kubernetes.cluster-certificate
-//   - settings.kubernetes.cluster-name
-//   - settings.kubernetes.cluster-dns-ip
+// InterruptionHandlingInput is an input type that accepts InterruptionHandlingArgs and InterruptionHandlingOutput values.
+// You can construct a concrete instance of `InterruptionHandlingInput` via:
 //
-// For an overview of the available settings, see https://bottlerocket.dev/en/os/1.20.x/api/settings/.
-func (o ClusterNodeGroupOptionsPtrOutput) BottlerocketSettings() pulumi.MapOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) map[string]interface{} {
-		if v == nil {
-			return nil
-		}
-		return v.BottlerocketSettings
-	}).(pulumi.MapOutput)
-}
+//	InterruptionHandlingArgs{...}
+type InterruptionHandlingInput interface {
+	pulumi.Input
 
-// The tags to apply to the CloudFormation Stack of the Worker NodeGroup.
-//
-// Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
-func (o ClusterNodeGroupOptionsPtrOutput) CloudFormationTags() pulumi.StringMapOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) map[string]string {
-		if v == nil {
-			return nil
-		}
-		return v.CloudFormationTags
-	}).(pulumi.StringMapOutput)
+	ToInterruptionHandlingOutput() InterruptionHandlingOutput
+	ToInterruptionHandlingOutputWithContext(context.Context) InterruptionHandlingOutput
 }
 
-// The ingress rule that gives node group access.
-func (o ClusterNodeGroupOptionsPtrOutput) ClusterIngressRule() ec2.SecurityGroupRuleOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *ec2.SecurityGroupRule {
-		if v == nil {
-			return nil
-		}
-		return v.ClusterIngressRule
-	}).(ec2.SecurityGroupRuleOutput)
+// InterruptionHandling configures how a node group handles Spot interruptions and Auto Scaling Group termination events. The events are delivered to an SQS queue, which aws-node-termination-handler processes in queue mode to cordon and drain the affected nodes before their instances are terminated.
+// See for more details: https://github.com/aws/aws-node-termination-handler#queue-processor
+type InterruptionHandlingArgs struct {
+	// The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+	ChartVersion pulumi.StringPtrInput `pulumi:"chartVersion"`
+	// The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+	HeartbeatTimeout pulumi.IntPtrInput `pulumi:"heartbeatTimeout"`
+	// The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+	Namespace pulumi.StringPtrInput `pulumi:"namespace"`
+	// The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+	//
+	// If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+	OidcProviderArn pulumi.StringPtrInput `pulumi:"oidcProviderArn"`
+	// The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `<name>-node-termination-handler`, where `<name>` is the name of the node group.
+	ServiceAccountName pulumi.StringPtrInput `pulumi:"serviceAccountName"`
+	// Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+	Values pulumi.MapInput `pulumi:"values"`
 }
 
-// The ID of the ingress rule that gives node group access.
-func (o ClusterNodeGroupOptionsPtrOutput) ClusterIngressRuleId() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
-		if v == nil {
-			return nil
-		}
-		return v.ClusterIngressRuleId
-	}).(pulumi.StringPtrOutput)
+func (InterruptionHandlingArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*InterruptionHandling)(nil)).Elem()
 }
 
-// The number of worker nodes that should be running in the cluster. Defaults to 2.
-func (o ClusterNodeGroupOptionsPtrOutput) DesiredCapacity() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *int {
-		if v == nil {
-			return nil
-		}
-		return v.DesiredCapacity
-	}).(pulumi.IntPtrOutput)
+func (i InterruptionHandlingArgs) ToInterruptionHandlingOutput() InterruptionHandlingOutput {
+	return i.ToInterruptionHandlingOutputWithContext(context.Background())
 }
 
-// Enables/disables detailed monitoring of the EC2 instances.
-//
-// With detailed monitoring, all metrics, including status check metrics, are available in 1-minute intervals.
-// When enabled, you can also get aggregated data across groups of similar instances.
-//
-// Note: You are charged per metric that is sent to CloudWatch. You are not charged for data storage.
-// For more information, see "Paid tier" and "Example 1 - EC2 Detailed Monitoring" here https://aws.amazon.com/cloudwatch/pricing/.
-func (o ClusterNodeGroupOptionsPtrOutput) EnableDetailedMonitoring() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *bool {
-		if v == nil {
-			return nil
-		}
-		return v.EnableDetailedMonitoring
-	}).(pulumi.BoolPtrOutput)
+func (i InterruptionHandlingArgs) ToInterruptionHandlingOutputWithContext(ctx context.Context) InterruptionHandlingOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(InterruptionHandlingOutput)
 }
 
-// Encrypt the root block device of the nodes in the node group.
-func (o ClusterNodeGroupOptionsPtrOutput) EncryptRootBlockDevice() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *bool {
-		if v == nil {
-			return nil
-		}
-		return v.EncryptRootBlockDevice
-	}).(pulumi.BoolPtrOutput)
+func (i InterruptionHandlingArgs) ToInterruptionHandlingPtrOutput() InterruptionHandlingPtrOutput {
+	return i.ToInterruptionHandlingPtrOutputWithContext(context.Background())
 }
 
-// Extra security groups to attach on all nodes in this worker node group.
-//
-// This additional set of security groups captures any user application rules that will be needed for the nodes.
-func (o ClusterNodeGroupOptionsPtrOutput) ExtraNodeSecurityGroups() ec2.SecurityGroupArrayOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) []*ec2.SecurityGroup {
-		if v == nil {
-			return nil
-		}
-		return v.ExtraNodeSecurityGroups
-	}).(ec2.SecurityGroupArrayOutput)
+func (i InterruptionHandlingArgs) ToInterruptionHandlingPtrOutputWithContext(ctx context.Context) InterruptionHandlingPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(InterruptionHandlingOutput).ToInterruptionHandlingPtrOutputWithContext(ctx)
 }
 
-// Use the latest recommended EKS Optimized Linux AMI with GPU support for the worker nodes from the AWS Systems Manager Parameter Store.
+// InterruptionHandlingPtrInput is an input type that accepts InterruptionHandlingArgs, InterruptionHandlingPtr and InterruptionHandlingPtrOutput values.
+// You can construct a concrete instance of `InterruptionHandlingPtrInput` via:
 //
-// Defaults to false.
+//	        InterruptionHandlingArgs{...}
 //
-// Note: `gpu` and `amiId` are mutually exclusive.
+//	or:
 //
-// See for more details:
-// - https://docs.aws.amazon.com/eks/latest/userguide/eks-optimized-ami.html
-// - https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
-func (o ClusterNodeGroupOptionsPtrOutput) Gpu() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *bool {
-		if v == nil {
-			return nil
-		}
-		return v.Gpu
-	}).(pulumi.BoolPtrOutput)
+//	        nil
+type InterruptionHandlingPtrInput interface {
+	pulumi.Input
+
+	ToInterruptionHandlingPtrOutput() InterruptionHandlingPtrOutput
+	ToInterruptionHandlingPtrOutputWithContext(context.Context) InterruptionHandlingPtrOutput
 }
 
-// Whether to ignore changes to the desired size of the Auto Scaling Group. This is useful when using Cluster Autoscaler.
-//
-// See [EKS best practices](https://aws.github.io/aws-eks-best-practices/cluster-autoscaling/) for more details.
-func (o ClusterNodeGroupOptionsPtrOutput) IgnoreScalingChanges() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *bool {
-		if v == nil {
-			return nil
-		}
-		return v.IgnoreScalingChanges
-	}).(pulumi.BoolPtrOutput)
+type interruptionHandlingPtrType InterruptionHandlingArgs
+
+func InterruptionHandlingPtr(v *InterruptionHandlingArgs) InterruptionHandlingPtrInput {
+	return (*interruptionHandlingPtrType)(v)
 }
 
-// The IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
-func (o ClusterNodeGroupOptionsPtrOutput) InstanceProfile() iam.InstanceProfileOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *iam.InstanceProfile {
-		if v == nil {
-			return nil
-		}
-		return v.InstanceProfile
-	}).(iam.InstanceProfileOutput)
+func (*interruptionHandlingPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**InterruptionHandling)(nil)).Elem()
 }
 
-// The name of the IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
-func (o ClusterNodeGroupOptionsPtrOutput) InstanceProfileName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
-		if v == nil {
-			return nil
-		}
-		return v.InstanceProfileName
-	}).(pulumi.StringPtrOutput)
+func (i *interruptionHandlingPtrType) ToInterruptionHandlingPtrOutput() InterruptionHandlingPtrOutput {
+	return i.ToInterruptionHandlingPtrOutputWithContext(context.Background())
 }
 
-// The instance type to use for the cluster's nodes. Defaults to "t3.medium".
-func (o ClusterNodeGroupOptionsPtrOutput) InstanceType() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
-		if v == nil {
-			return nil
-		}
-		return v.InstanceType
-	}).(pulumi.StringPtrOutput)
+func (i *interruptionHandlingPtrType) ToInterruptionHandlingPtrOutputWithContext(ctx context.Context) InterruptionHandlingPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(InterruptionHandlingPtrOutput)
 }
 
-// Name of the key pair to use for SSH access to worker nodes.
-func (o ClusterNodeGroupOptionsPtrOutput) KeyName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
-		if v == nil {
-			return nil
-		}
-		return v.KeyName
-	}).(pulumi.StringPtrOutput)
+// InterruptionHandling configures how a node group handles Spot interruptions and Auto Scaling Group termination events. The events are delivered to an SQS queue, which aws-node-termination-handler processes in queue mode to cordon and drain the affected nodes before their instances are terminated.
+// See for more details: https://github.com/aws/aws-node-termination-handler#queue-processor
+type InterruptionHandlingOutput struct{ *pulumi.OutputState }
+
+func (InterruptionHandlingOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*InterruptionHandling)(nil)).Elem()
 }
 
-// Extra args to pass to the Kubelet. Corresponds to the options passed in the `--kubeletExtraArgs` flag to `/etc/eks/bootstrap.sh`. For example, '--port=10251 --address=0.0.0.0'. Note that the `labels` and `taints` properties will be applied to this list (using `--node-labels` and `--register-with-taints` respectively) after to the explicit `kubeletExtraArgs`.
-func (o ClusterNodeGroupOptionsPtrOutput) KubeletExtraArgs() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
-		if v == nil {
-			return nil
-		}
-		return v.KubeletExtraArgs
-	}).(pulumi.StringPtrOutput)
+func (o InterruptionHandlingOutput) ToInterruptionHandlingOutput() InterruptionHandlingOutput {
+	return o
+}
+
+func (o InterruptionHandlingOutput) ToInterruptionHandlingOutputWithContext(ctx context.Context) InterruptionHandlingOutput {
+	return o
+}
+
+func (o InterruptionHandlingOutput) ToInterruptionHandlingPtrOutput() InterruptionHandlingPtrOutput {
+	return o.ToInterruptionHandlingPtrOutputWithContext(context.Background())
+}
+
+func (o InterruptionHandlingOutput) ToInterruptionHandlingPtrOutputWithContext(ctx context.Context) InterruptionHandlingPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v InterruptionHandling) *InterruptionHandling {
+		return &v
+	}).(InterruptionHandlingPtrOutput)
+}
+
+// The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+func (o InterruptionHandlingOutput) ChartVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v InterruptionHandling) *string { return v.ChartVersion }).(pulumi.StringPtrOutput)
+}
+
+// The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+func (o InterruptionHandlingOutput) HeartbeatTimeout() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v InterruptionHandling) *int { return v.HeartbeatTimeout }).(pulumi.IntPtrOutput)
+}
+
+// The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+func (o InterruptionHandlingOutput) Namespace() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v InterruptionHandling) *string { return v.Namespace }).(pulumi.StringPtrOutput)
+}
+
+// The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+//
+// If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+func (o InterruptionHandlingOutput) OidcProviderArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v InterruptionHandling) *string { return v.OidcProviderArn }).(pulumi.StringPtrOutput)
+}
+
+// The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `<name>-node-termination-handler`, where `<name>` is the name of the node group.
+func (o InterruptionHandlingOutput) ServiceAccountName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v InterruptionHandling) *string { return v.ServiceAccountName }).(pulumi.StringPtrOutput)
+}
+
+// Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+func (o InterruptionHandlingOutput) Values() pulumi.MapOutput {
+	return o.ApplyT(func(v InterruptionHandling) map[string]interface{} { return v.Values }).(pulumi.MapOutput)
+}
+
+type InterruptionHandlingPtrOutput struct{ *pulumi.OutputState }
+
+func (InterruptionHandlingPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**InterruptionHandling)(nil)).Elem()
+}
+
+func (o InterruptionHandlingPtrOutput) ToInterruptionHandlingPtrOutput() InterruptionHandlingPtrOutput {
+	return o
 }
 
-// Custom k8s node labels to be attached to each worker node. Adds the given key/value pairs to the `--node-labels` kubelet argument.
-func (o ClusterNodeGroupOptionsPtrOutput) Labels() pulumi.StringMapOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) map[string]string {
-		if v == nil {
-			return nil
-		}
-		return v.Labels
-	}).(pulumi.StringMapOutput)
+func (o InterruptionHandlingPtrOutput) ToInterruptionHandlingPtrOutputWithContext(ctx context.Context) InterruptionHandlingPtrOutput {
+	return o
 }
 
-// The tag specifications to apply to the launch template.
-func (o ClusterNodeGroupOptionsPtrOutput) LaunchTemplateTagSpecifications() ec2.LaunchTemplateTagSpecificationArrayOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) []ec2.LaunchTemplateTagSpecification {
-		if v == nil {
-			return nil
+func (o InterruptionHandlingPtrOutput) Elem() InterruptionHandlingOutput {
+	return o.ApplyT(func(v *InterruptionHandling) InterruptionHandling {
+		if v != nil {
+			return *v
 		}
-		return v.LaunchTemplateTagSpecifications
-	}).(ec2.LaunchTemplateTagSpecificationArrayOutput)
+		var ret InterruptionHandling
+		return ret
+	}).(InterruptionHandlingOutput)
 }
 
-// The maximum number of worker nodes running in the cluster. Defaults to 2.
-func (o ClusterNodeGroupOptionsPtrOutput) MaxSize() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *int {
+// The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+func (o InterruptionHandlingPtrOutput) ChartVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *InterruptionHandling) *string {
 		if v == nil {
 			return nil
 		}
-		return v.MaxSize
-	}).(pulumi.IntPtrOutput)
+		return v.ChartVersion
+	}).(pulumi.StringPtrOutput)
 }
 
-// The minimum amount of instances that should remain available during an instance refresh, expressed as a percentage. Defaults to 50.
-func (o ClusterNodeGroupOptionsPtrOutput) MinRefreshPercentage() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *int {
+// The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+func (o InterruptionHandlingPtrOutput) HeartbeatTimeout() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *InterruptionHandling) *int {
 		if v == nil {
 			return nil
 		}
-		return v.MinRefreshPercentage
+		return v.HeartbeatTimeout
 	}).(pulumi.IntPtrOutput)
 }
 
-// The minimum number of worker nodes running in the cluster. Defaults to 1.
-func (o ClusterNodeGroupOptionsPtrOutput) MinSize() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *int {
+// The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+func (o InterruptionHandlingPtrOutput) Namespace() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *InterruptionHandling) *string {
 		if v == nil {
 			return nil
 		}
-		return v.MinSize
-	}).(pulumi.IntPtrOutput)
+		return v.Namespace
+	}).(pulumi.StringPtrOutput)
 }
 
-// Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
-func (o ClusterNodeGroupOptionsPtrOutput) NodeAssociatePublicIpAddress() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *bool {
+// The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+//
+// If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+func (o InterruptionHandlingPtrOutput) OidcProviderArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *InterruptionHandling) *string {
 		if v == nil {
 			return nil
 		}
-		return v.NodeAssociatePublicIpAddress
-	}).(pulumi.BoolPtrOutput)
+		return v.OidcProviderArn
+	}).(pulumi.StringPtrOutput)
 }
 
-// Public key material for SSH access to worker nodes. See allowed formats at:
-// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
-// If not provided, no SSH access is enabled on VMs.
-func (o ClusterNodeGroupOptionsPtrOutput) NodePublicKey() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+// The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `<name>-node-termination-handler`, where `<name>` is the name of the node group.
+func (o InterruptionHandlingPtrOutput) ServiceAccountName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *InterruptionHandling) *string {
 		if v == nil {
 			return nil
 		}
-		return v.NodePublicKey
+		return v.ServiceAccountName
 	}).(pulumi.StringPtrOutput)
 }
 
-// Whether the root block device should be deleted on termination of the instance. Defaults to true.
-func (o ClusterNodeGroupOptionsPtrOutput) NodeRootVolumeDeleteOnTermination() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *bool {
+// Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+func (o InterruptionHandlingPtrOutput) Values() pulumi.MapOutput {
+	return o.ApplyT(func(v *InterruptionHandling) map[string]interface{} {
 		if v == nil {
 			return nil
 		}
-		return v.NodeRootVolumeDeleteOnTermination
-	}).(pulumi.BoolPtrOutput)
+		return v.Values
+	}).(pulumi.MapOutput)
 }
 
-// Whether to encrypt a cluster node's root volume. Defaults to false.
-func (o ClusterNodeGroupOptionsPtrOutput) NodeRootVolumeEncrypted() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *bool {
-		if v == nil {
-			return nil
-		}
-		return v.NodeRootVolumeEncrypted
-	}).(pulumi.BoolPtrOutput)
+type KubeProxyAddonOptions struct {
+	// Custom configuration values for the kube-proxy addon. This object must match the schema derived from [describe-addon-configuration](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-configuration.html).
+	ConfigurationValues map[string]interface{} `pulumi:"configurationValues"`
+	// Whether or not to create the `kube-proxy` Addon in the cluster. Defaults to true, unless `autoMode` is enabled.
+	Enabled *bool `pulumi:"enabled"`
+	// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
+	ResolveConflictsOnCreate *ResolveConflictsOnCreate `pulumi:"resolveConflictsOnCreate"`
+	// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value. Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
+	ResolveConflictsOnUpdate *ResolveConflictsOnUpdate `pulumi:"resolveConflictsOnUpdate"`
+	// The version of the EKS add-on. The version must match one of the versions returned by [describe-addon-versions](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-versions.html).
+	Version *string `pulumi:"version"`
 }
 
-// The amount of provisioned IOPS. This is only valid with a volumeType of 'io1'.
-func (o ClusterNodeGroupOptionsPtrOutput) NodeRootVolumeIops() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *int {
-		if v == nil {
-			return nil
-		}
-		return v.NodeRootVolumeIops
-	}).(pulumi.IntPtrOutput)
+// Defaults sets the appropriate defaults for KubeProxyAddonOptions
+func (val *KubeProxyAddonOptions) Defaults() *KubeProxyAddonOptions {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ResolveConflictsOnCreate == nil {
+		resolveConflictsOnCreate_ := ResolveConflictsOnCreate("OVERWRITE")
+		tmp.ResolveConflictsOnCreate = &resolveConflictsOnCreate_
+	}
+	if tmp.ResolveConflictsOnUpdate == nil {
+		resolveConflictsOnUpdate_ := ResolveConflictsOnUpdate("OVERWRITE")
+		tmp.ResolveConflictsOnUpdate = &resolveConflictsOnUpdate_
+	}
+	return &tmp
 }
 
-// The size in GiB of a cluster node's root volume. Defaults to 20.
-func (o ClusterNodeGroupOptionsPtrOutput) NodeRootVolumeSize() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *int {
-		if v == nil {
-			return nil
-		}
-		return v.NodeRootVolumeSize
-	}).(pulumi.IntPtrOutput)
+// KubeProxyAddonOptionsInput is an input type that accepts KubeProxyAddonOptionsArgs and KubeProxyAddonOptionsOutput values.
+// You can construct a concrete instance of `KubeProxyAddonOptionsInput` via:
+//
+//	KubeProxyAddonOptionsArgs{...}
+type KubeProxyAddonOptionsInput interface {
+	pulumi.Input
+
+	ToKubeProxyAddonOptionsOutput() KubeProxyAddonOptionsOutput
+	ToKubeProxyAddonOptionsOutputWithContext(context.Context) KubeProxyAddonOptionsOutput
 }
 
-// Provisioned throughput performance in integer MiB/s for a cluster node's root volume. This is only valid with a volumeType of 'gp3'.
-func (o ClusterNodeGroupOptionsPtrOutput) NodeRootVolumeThroughput() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *int {
-		if v == nil {
-			return nil
-		}
-		return v.NodeRootVolumeThroughput
-	}).(pulumi.IntPtrOutput)
+type KubeProxyAddonOptionsArgs struct {
+	// Custom configuration values for the kube-proxy addon. This object must match the schema derived from [describe-addon-configuration](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-configuration.html).
+	ConfigurationValues pulumi.MapInput `pulumi:"configurationValues"`
+	// Whether or not to create the `kube-proxy` Addon in the cluster. Defaults to true, unless `autoMode` is enabled.
+	Enabled *bool `pulumi:"enabled"`
+	// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
+	ResolveConflictsOnCreate *ResolveConflictsOnCreate `pulumi:"resolveConflictsOnCreate"`
+	// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value. Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
+	ResolveConflictsOnUpdate *ResolveConflictsOnUpdate `pulumi:"resolveConflictsOnUpdate"`
+	// The version of the EKS add-on. The version must match one of the versions returned by [describe-addon-versions](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-versions.html).
+	Version pulumi.StringPtrInput `pulumi:"version"`
 }
 
-// Configured EBS type for a cluster node's root volume. Default is 'gp2'. Supported values are 'standard', 'gp2', 'gp3', 'st1', 'sc1', 'io1'.
-func (o ClusterNodeGroupOptionsPtrOutput) NodeRootVolumeType() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
-		if v == nil {
-			return nil
-		}
-		return v.NodeRootVolumeType
-	}).(pulumi.StringPtrOutput)
+// Defaults sets the appropriate defaults for KubeProxyAddonOptionsArgs
+func (val *KubeProxyAddonOptionsArgs) Defaults() *KubeProxyAddonOptionsArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ResolveConflictsOnCreate == nil {
+		resolveConflictsOnCreate_ := ResolveConflictsOnCreate("OVERWRITE")
+		tmp.ResolveConflictsOnCreate = &resolveConflictsOnCreate_
+	}
+	if tmp.ResolveConflictsOnUpdate == nil {
+		resolveConflictsOnUpdate_ := ResolveConflictsOnUpdate("OVERWRITE")
+		tmp.ResolveConflictsOnUpdate = &resolveConflictsOnUpdate_
+	}
+	return &tmp
+}
+func (KubeProxyAddonOptionsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*KubeProxyAddonOptions)(nil)).Elem()
+}
+
+func (i KubeProxyAddonOptionsArgs) ToKubeProxyAddonOptionsOutput() KubeProxyAddonOptionsOutput {
+	return i.ToKubeProxyAddonOptionsOutputWithContext(context.Background())
+}
+
+func (i KubeProxyAddonOptionsArgs) ToKubeProxyAddonOptionsOutputWithContext(ctx context.Context) KubeProxyAddonOptionsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(KubeProxyAddonOptionsOutput)
+}
+
+func (i KubeProxyAddonOptionsArgs) ToKubeProxyAddonOptionsPtrOutput() KubeProxyAddonOptionsPtrOutput {
+	return i.ToKubeProxyAddonOptionsPtrOutputWithContext(context.Background())
+}
+
+func (i KubeProxyAddonOptionsArgs) ToKubeProxyAddonOptionsPtrOutputWithContext(ctx context.Context) KubeProxyAddonOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(KubeProxyAddonOptionsOutput).ToKubeProxyAddonOptionsPtrOutputWithContext(ctx)
+}
+
+// KubeProxyAddonOptionsPtrInput is an input type that accepts KubeProxyAddonOptionsArgs, KubeProxyAddonOptionsPtr and KubeProxyAddonOptionsPtrOutput values.
+// You can construct a concrete instance of `KubeProxyAddonOptionsPtrInput` via:
+//
+//	        KubeProxyAddonOptionsArgs{...}
+//
+//	or:
+//
+//	        nil
+type KubeProxyAddonOptionsPtrInput interface {
+	pulumi.Input
+
+	ToKubeProxyAddonOptionsPtrOutput() KubeProxyAddonOptionsPtrOutput
+	ToKubeProxyAddonOptionsPtrOutputWithContext(context.Context) KubeProxyAddonOptionsPtrOutput
+}
+
+type kubeProxyAddonOptionsPtrType KubeProxyAddonOptionsArgs
+
+func KubeProxyAddonOptionsPtr(v *KubeProxyAddonOptionsArgs) KubeProxyAddonOptionsPtrInput {
+	return (*kubeProxyAddonOptionsPtrType)(v)
+}
+
+func (*kubeProxyAddonOptionsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**KubeProxyAddonOptions)(nil)).Elem()
+}
+
+func (i *kubeProxyAddonOptionsPtrType) ToKubeProxyAddonOptionsPtrOutput() KubeProxyAddonOptionsPtrOutput {
+	return i.ToKubeProxyAddonOptionsPtrOutputWithContext(context.Background())
+}
+
+func (i *kubeProxyAddonOptionsPtrType) ToKubeProxyAddonOptionsPtrOutputWithContext(ctx context.Context) KubeProxyAddonOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(KubeProxyAddonOptionsPtrOutput)
+}
+
+type KubeProxyAddonOptionsOutput struct{ *pulumi.OutputState }
+
+func (KubeProxyAddonOptionsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*KubeProxyAddonOptions)(nil)).Elem()
+}
+
+func (o KubeProxyAddonOptionsOutput) ToKubeProxyAddonOptionsOutput() KubeProxyAddonOptionsOutput {
+	return o
+}
+
+func (o KubeProxyAddonOptionsOutput) ToKubeProxyAddonOptionsOutputWithContext(ctx context.Context) KubeProxyAddonOptionsOutput {
+	return o
+}
+
+func (o KubeProxyAddonOptionsOutput) ToKubeProxyAddonOptionsPtrOutput() KubeProxyAddonOptionsPtrOutput {
+	return o.ToKubeProxyAddonOptionsPtrOutputWithContext(context.Background())
+}
+
+func (o KubeProxyAddonOptionsOutput) ToKubeProxyAddonOptionsPtrOutputWithContext(ctx context.Context) KubeProxyAddonOptionsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v KubeProxyAddonOptions) *KubeProxyAddonOptions {
+		return &v
+	}).(KubeProxyAddonOptionsPtrOutput)
+}
+
+// Custom configuration values for the kube-proxy addon. This object must match the schema derived from [describe-addon-configuration](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-configuration.html).
+func (o KubeProxyAddonOptionsOutput) ConfigurationValues() pulumi.MapOutput {
+	return o.ApplyT(func(v KubeProxyAddonOptions) map[string]interface{} { return v.ConfigurationValues }).(pulumi.MapOutput)
+}
+
+// Whether or not to create the `kube-proxy` Addon in the cluster. Defaults to true, unless `autoMode` is enabled.
+func (o KubeProxyAddonOptionsOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v KubeProxyAddonOptions) *bool { return v.Enabled }).(pulumi.BoolPtrOutput)
+}
+
+// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
+func (o KubeProxyAddonOptionsOutput) ResolveConflictsOnCreate() ResolveConflictsOnCreatePtrOutput {
+	return o.ApplyT(func(v KubeProxyAddonOptions) *ResolveConflictsOnCreate { return v.ResolveConflictsOnCreate }).(ResolveConflictsOnCreatePtrOutput)
+}
+
+// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value. Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
+func (o KubeProxyAddonOptionsOutput) ResolveConflictsOnUpdate() ResolveConflictsOnUpdatePtrOutput {
+	return o.ApplyT(func(v KubeProxyAddonOptions) *ResolveConflictsOnUpdate { return v.ResolveConflictsOnUpdate }).(ResolveConflictsOnUpdatePtrOutput)
+}
+
+// The version of the EKS add-on. The version must match one of the versions returned by [describe-addon-versions](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-versions.html).
+func (o KubeProxyAddonOptionsOutput) Version() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v KubeProxyAddonOptions) *string { return v.Version }).(pulumi.StringPtrOutput)
 }
 
-// The security group for the worker node group to communicate with the cluster.
-//
-// This security group requires specific inbound and outbound rules.
-//
-// See for more details:
-// https://docs.aws.amazon.com/eks/latest/userguide/sec-group-reqs.html
-//
-// Note: The `nodeSecurityGroup` option and the cluster option`nodeSecurityGroupTags` are mutually exclusive.
-func (o ClusterNodeGroupOptionsPtrOutput) NodeSecurityGroup() ec2.SecurityGroupOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *ec2.SecurityGroup {
-		if v == nil {
-			return nil
-		}
-		return v.NodeSecurityGroup
-	}).(ec2.SecurityGroupOutput)
-}
+type KubeProxyAddonOptionsPtrOutput struct{ *pulumi.OutputState }
 
-// The ID of the security group for the worker node group to communicate with the cluster.
-//
-// This security group requires specific inbound and outbound rules.
-//
-// See for more details:
-// https://docs.aws.amazon.com/eks/latest/userguide/sec-group-reqs.html
-//
-// Note: The `nodeSecurityGroupId` option and the cluster option `nodeSecurityGroupTags` are mutually exclusive.
-func (o ClusterNodeGroupOptionsPtrOutput) NodeSecurityGroupId() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
-		if v == nil {
-			return nil
-		}
-		return v.NodeSecurityGroupId
-	}).(pulumi.StringPtrOutput)
+func (KubeProxyAddonOptionsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**KubeProxyAddonOptions)(nil)).Elem()
 }
 
-// The set of subnets to override and use for the worker node group.
-//
-// Setting this option overrides which subnets to use for the worker node group, regardless if the cluster's `subnetIds` is set, or if `publicSubnetIds` and/or `privateSubnetIds` were set.
-func (o ClusterNodeGroupOptionsPtrOutput) NodeSubnetIds() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) []string {
-		if v == nil {
-			return nil
-		}
-		return v.NodeSubnetIds
-	}).(pulumi.StringArrayOutput)
+func (o KubeProxyAddonOptionsPtrOutput) ToKubeProxyAddonOptionsPtrOutput() KubeProxyAddonOptionsPtrOutput {
+	return o
 }
 
-// Extra code to run on node startup. This code will run after the AWS EKS bootstrapping code and before the node signals its readiness to the managing CloudFormation stack. This code must be a typical user data script: critically it must begin with an interpreter directive (i.e. a `#!`).
-func (o ClusterNodeGroupOptionsPtrOutput) NodeUserData() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
-		if v == nil {
-			return nil
-		}
-		return v.NodeUserData
-	}).(pulumi.StringPtrOutput)
+func (o KubeProxyAddonOptionsPtrOutput) ToKubeProxyAddonOptionsPtrOutputWithContext(ctx context.Context) KubeProxyAddonOptionsPtrOutput {
+	return o
 }
 
-// User specified code to run on node startup. This code is expected to handle the full AWS EKS bootstrapping code and signal node readiness to the managing CloudFormation stack. This code must be a complete and executable user data script in bash (Linux) or powershell (Windows).
-//
-// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/worker.html
-func (o ClusterNodeGroupOptionsPtrOutput) NodeUserDataOverride() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
-		if v == nil {
-			return nil
+func (o KubeProxyAddonOptionsPtrOutput) Elem() KubeProxyAddonOptionsOutput {
+	return o.ApplyT(func(v *KubeProxyAddonOptions) KubeProxyAddonOptions {
+		if v != nil {
+			return *v
 		}
-		return v.NodeUserDataOverride
-	}).(pulumi.StringPtrOutput)
+		var ret KubeProxyAddonOptions
+		return ret
+	}).(KubeProxyAddonOptionsOutput)
 }
 
-// Extra nodeadm configuration sections to be added to the nodeadm user data. This can be shell scripts, nodeadm NodeConfig or any other user data compatible script. When configuring additional nodeadm NodeConfig sections, they'll be merged with the base settings the provider sets. You can overwrite base settings or provide additional settings this way.
-// The base settings the provider sets are:
-//   - cluster.name
-//   - cluster.apiServerEndpoint
-//   - cluster.certificateAuthority
-//   - cluster.cidr
-//
-// Note: This is only applicable when using AL2023.
-// See for more details:
-//   - https://awslabs.github.io/amazon-eks-ami/nodeadm/
-//   - https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
-func (o ClusterNodeGroupOptionsPtrOutput) NodeadmExtraOptions() NodeadmOptionsArrayOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) []NodeadmOptions {
+// Custom configuration values for the kube-proxy addon. This object must match the schema derived from [describe-addon-configuration](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-configuration.html).
+func (o KubeProxyAddonOptionsPtrOutput) ConfigurationValues() pulumi.MapOutput {
+	return o.ApplyT(func(v *KubeProxyAddonOptions) map[string]interface{} {
 		if v == nil {
 			return nil
 		}
-		return v.NodeadmExtraOptions
-	}).(NodeadmOptionsArrayOutput)
+		return v.ConfigurationValues
+	}).(pulumi.MapOutput)
 }
 
-// The type of OS to use for the node group. Will be used to determine the right EKS optimized AMI to use based on the instance types and gpu configuration.
-// Valid values are `RECOMMENDED`, `AL2`, `AL2023` and `Bottlerocket`.
-//
-// Defaults to the current recommended OS.
-func (o ClusterNodeGroupOptionsPtrOutput) OperatingSystem() OperatingSystemPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *OperatingSystem {
+// Whether or not to create the `kube-proxy` Addon in the cluster. Defaults to true, unless `autoMode` is enabled.
+func (o KubeProxyAddonOptionsPtrOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *KubeProxyAddonOptions) *bool {
 		if v == nil {
 			return nil
 		}
-		return v.OperatingSystem
-	}).(OperatingSystemPtrOutput)
+		return v.Enabled
+	}).(pulumi.BoolPtrOutput)
 }
 
-// Bidding price for spot instance. If set, only spot instances will be added as worker node.
-func (o ClusterNodeGroupOptionsPtrOutput) SpotPrice() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
+func (o KubeProxyAddonOptionsPtrOutput) ResolveConflictsOnCreate() ResolveConflictsOnCreatePtrOutput {
+	return o.ApplyT(func(v *KubeProxyAddonOptions) *ResolveConflictsOnCreate {
 		if v == nil {
 			return nil
 		}
-		return v.SpotPrice
-	}).(pulumi.StringPtrOutput)
+		return v.ResolveConflictsOnCreate
+	}).(ResolveConflictsOnCreatePtrOutput)
 }
 
-// Custom k8s node taints to be attached to each worker node. Adds the given taints to the `--register-with-taints` kubelet argument
-func (o ClusterNodeGroupOptionsPtrOutput) Taints() TaintMapOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) map[string]Taint {
+// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value. Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
+func (o KubeProxyAddonOptionsPtrOutput) ResolveConflictsOnUpdate() ResolveConflictsOnUpdatePtrOutput {
+	return o.ApplyT(func(v *KubeProxyAddonOptions) *ResolveConflictsOnUpdate {
 		if v == nil {
 			return nil
 		}
-		return v.Taints
-	}).(TaintMapOutput)
+		return v.ResolveConflictsOnUpdate
+	}).(ResolveConflictsOnUpdatePtrOutput)
 }
 
-// Desired Kubernetes master / control plane version. If you do not specify a value, the latest available version is used.
-func (o ClusterNodeGroupOptionsPtrOutput) Version() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ClusterNodeGroupOptions) *string {
+// The version of the EKS add-on. The version must match one of the versions returned by [describe-addon-versions](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-versions.html).
+func (o KubeProxyAddonOptionsPtrOutput) Version() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *KubeProxyAddonOptions) *string {
 		if v == nil {
 			return nil
 		}
@@ -1906,1293 +5725,1162 @@ func (o ClusterNodeGroupOptionsPtrOutput) Version() pulumi.StringPtrOutput {
 	}).(pulumi.StringPtrOutput)
 }
 
-// Defines the core set of data associated with an EKS cluster, including the network in which it runs.
-type CoreData struct {
-	// The access entries added to the cluster.
-	AccessEntries []AccessEntry `pulumi:"accessEntries"`
-	AwsProvider   *aws.Provider `pulumi:"awsProvider"`
-	Cluster       *eks.Cluster  `pulumi:"cluster"`
-	// The IAM Role attached to the EKS Cluster
-	ClusterIamRole       *iam.Role                    `pulumi:"clusterIamRole"`
-	ClusterSecurityGroup *ec2.SecurityGroup           `pulumi:"clusterSecurityGroup"`
-	EksNodeAccess        *corev1.ConfigMap            `pulumi:"eksNodeAccess"`
-	EncryptionConfig     *eks.ClusterEncryptionConfig `pulumi:"encryptionConfig"`
-	// The EKS cluster's Kubernetes API server endpoint.
-	Endpoint string `pulumi:"endpoint"`
-	// The Fargate profile used to manage which pods run on Fargate.
-	FargateProfile *eks.FargateProfile `pulumi:"fargateProfile"`
-	// The IAM instance roles for the cluster's nodes.
-	InstanceRoles []*iam.Role `pulumi:"instanceRoles"`
-	// The kubeconfig file for the cluster.
-	Kubeconfig interface{} `pulumi:"kubeconfig"`
-	// The cluster's node group options.
-	NodeGroupOptions ClusterNodeGroupOptions `pulumi:"nodeGroupOptions"`
-	// Tags attached to the security groups associated with the cluster's worker nodes.
-	NodeSecurityGroupTags map[string]string          `pulumi:"nodeSecurityGroupTags"`
-	OidcProvider          *iam.OpenIdConnectProvider `pulumi:"oidcProvider"`
-	// List of subnet IDs for the private subnets.
-	PrivateSubnetIds []string             `pulumi:"privateSubnetIds"`
-	Provider         *kubernetes.Provider `pulumi:"provider"`
-	// List of subnet IDs for the public subnets.
-	PublicSubnetIds []string `pulumi:"publicSubnetIds"`
-	// The storage class used for persistent storage by the cluster.
-	StorageClasses map[string]*storagev1.StorageClass `pulumi:"storageClasses"`
-	// List of subnet IDs for the EKS cluster.
-	SubnetIds []string `pulumi:"subnetIds"`
-	// A map of tags assigned to the EKS cluster.
-	Tags map[string]string `pulumi:"tags"`
-	// The VPC CNI for the cluster.
-	VpcCni *VpcCniAddon `pulumi:"vpcCni"`
-	// ID of the cluster's VPC.
-	VpcId string `pulumi:"vpcId"`
+// Represents the AWS credentials to scope a given kubeconfig when using a non-default credential chain.
+//
+// The options can be used independently, or additively.
+//
+// A scoped kubeconfig is necessary for certain auth scenarios. For example:
+//  1. Assume a role on the default account caller,
+//  2. Use an AWS creds profile instead of the default account caller,
+//  3. Use an AWS creds creds profile instead of the default account caller,
+//     and then assume a given role on the profile. This scenario is also
+//     possible by only using a profile, iff the profile includes a role to
+//     assume in its settings.
+//
+// See for more details:
+// - https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
+// - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-role.html
+// - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-profiles.html
+type KubeconfigOptions struct {
+	// Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+	Env map[string]string `pulumi:"env"`
+	// External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+	//
+	// Only supported by the `aws-iam-authenticator` token tool.
+	ExternalId *string `pulumi:"externalId"`
+	// AWS credential profile name to always use instead of the default AWS credential provider chain.
+	//
+	// The profile is passed to kubeconfig as an authentication environment setting.
+	ProfileName *string `pulumi:"profileName"`
+	// AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+	Region *string `pulumi:"region"`
+	// Role ARN to assume instead of the default AWS credential provider chain.
+	//
+	// The role is passed to kubeconfig as an authentication exec argument.
+	RoleArn *string `pulumi:"roleArn"`
+	// Session name to use when assuming `roleArn`.
+	//
+	// Only supported by the `aws-iam-authenticator` token tool.
+	RoleSessionName *string `pulumi:"roleSessionName"`
+	// The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+	TokenTool *KubeconfigTokenTool `pulumi:"tokenTool"`
 }
 
-// CoreDataInput is an input type that accepts CoreDataArgs and CoreDataOutput values.
-// You can construct a concrete instance of `CoreDataInput` via:
+// KubeconfigOptionsInput is an input type that accepts KubeconfigOptionsArgs and KubeconfigOptionsOutput values.
+// You can construct a concrete instance of `KubeconfigOptionsInput` via:
 //
-//	CoreDataArgs{...}
-type CoreDataInput interface {
+//	KubeconfigOptionsArgs{...}
+type KubeconfigOptionsInput interface {
 	pulumi.Input
 
-	ToCoreDataOutput() CoreDataOutput
-	ToCoreDataOutputWithContext(context.Context) CoreDataOutput
+	ToKubeconfigOptionsOutput() KubeconfigOptionsOutput
+	ToKubeconfigOptionsOutputWithContext(context.Context) KubeconfigOptionsOutput
 }
 
-// Defines the core set of data associated with an EKS cluster, including the network in which it runs.
-type CoreDataArgs struct {
-	// The access entries added to the cluster.
-	AccessEntries AccessEntryArrayInput `pulumi:"accessEntries"`
-	AwsProvider   aws.ProviderInput     `pulumi:"awsProvider"`
-	Cluster       eks.ClusterInput      `pulumi:"cluster"`
-	// The IAM Role attached to the EKS Cluster
-	ClusterIamRole       iam.RoleInput                       `pulumi:"clusterIamRole"`
-	ClusterSecurityGroup ec2.SecurityGroupInput              `pulumi:"clusterSecurityGroup"`
-	EksNodeAccess        corev1.ConfigMapInput               `pulumi:"eksNodeAccess"`
-	EncryptionConfig     eks.ClusterEncryptionConfigPtrInput `pulumi:"encryptionConfig"`
-	// The EKS cluster's Kubernetes API server endpoint.
-	Endpoint pulumi.StringInput `pulumi:"endpoint"`
-	// The Fargate profile used to manage which pods run on Fargate.
-	FargateProfile eks.FargateProfileInput `pulumi:"fargateProfile"`
-	// The IAM instance roles for the cluster's nodes.
-	InstanceRoles iam.RoleArrayInput `pulumi:"instanceRoles"`
-	// The kubeconfig file for the cluster.
-	Kubeconfig pulumi.Input `pulumi:"kubeconfig"`
-	// The cluster's node group options.
-	NodeGroupOptions ClusterNodeGroupOptionsInput `pulumi:"nodeGroupOptions"`
-	// Tags attached to the security groups associated with the cluster's worker nodes.
-	NodeSecurityGroupTags pulumi.StringMapInput          `pulumi:"nodeSecurityGroupTags"`
-	OidcProvider          iam.OpenIdConnectProviderInput `pulumi:"oidcProvider"`
-	// List of subnet IDs for the private subnets.
-	PrivateSubnetIds pulumi.StringArrayInput  `pulumi:"privateSubnetIds"`
-	Provider         kubernetes.ProviderInput `pulumi:"provider"`
-	// List of subnet IDs for the public subnets.
-	PublicSubnetIds pulumi.StringArrayInput `pulumi:"publicSubnetIds"`
-	// The storage class used for persistent storage by the cluster.
-	StorageClasses storagev1.StorageClassMapInput `pulumi:"storageClasses"`
-	// List of subnet IDs for the EKS cluster.
-	SubnetIds pulumi.StringArrayInput `pulumi:"subnetIds"`
-	// A map of tags assigned to the EKS cluster.
-	Tags pulumi.StringMapInput `pulumi:"tags"`
-	// The VPC CNI for the cluster.
-	VpcCni VpcCniAddonInput `pulumi:"vpcCni"`
-	// ID of the cluster's VPC.
-	VpcId pulumi.StringInput `pulumi:"vpcId"`
+// Represents the AWS credentials to scope a given kubeconfig when using a non-default credential chain.
+//
+// The options can be used independently, or additively.
+//
+// A scoped kubeconfig is necessary for certain auth scenarios. For example:
+//  1. Assume a role on the default account caller,
+//  2. Use an AWS creds profile instead of the default account caller,
+//  3. Use an AWS creds creds profile instead of the default account caller,
+//     and then assume a given role on the profile. This scenario is also
+//     possible by only using a profile, iff the profile includes a role to
+//     assume in its settings.
+//
+// See for more details:
+// - https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
+// - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-role.html
+// - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-profiles.html
+type KubeconfigOptionsArgs struct {
+	// Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+	Env pulumi.StringMapInput `pulumi:"env"`
+	// External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+	//
+	// Only supported by the `aws-iam-authenticator` token tool.
+	ExternalId pulumi.StringPtrInput `pulumi:"externalId"`
+	// AWS credential profile name to always use instead of the default AWS credential provider chain.
+	//
+	// The profile is passed to kubeconfig as an authentication environment setting.
+	ProfileName pulumi.StringPtrInput `pulumi:"profileName"`
+	// AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+	Region pulumi.StringPtrInput `pulumi:"region"`
+	// Role ARN to assume instead of the default AWS credential provider chain.
+	//
+	// The role is passed to kubeconfig as an authentication exec argument.
+	RoleArn pulumi.StringPtrInput `pulumi:"roleArn"`
+	// Session name to use when assuming `roleArn`.
+	//
+	// Only supported by the `aws-iam-authenticator` token tool.
+	RoleSessionName pulumi.StringPtrInput `pulumi:"roleSessionName"`
+	// The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+	TokenTool KubeconfigTokenToolPtrInput `pulumi:"tokenTool"`
 }
 
-func (CoreDataArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*CoreData)(nil)).Elem()
+func (KubeconfigOptionsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*KubeconfigOptions)(nil)).Elem()
 }
 
-func (i CoreDataArgs) ToCoreDataOutput() CoreDataOutput {
-	return i.ToCoreDataOutputWithContext(context.Background())
+func (i KubeconfigOptionsArgs) ToKubeconfigOptionsOutput() KubeconfigOptionsOutput {
+	return i.ToKubeconfigOptionsOutputWithContext(context.Background())
 }
 
-func (i CoreDataArgs) ToCoreDataOutputWithContext(ctx context.Context) CoreDataOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(CoreDataOutput)
+func (i KubeconfigOptionsArgs) ToKubeconfigOptionsOutputWithContext(ctx context.Context) KubeconfigOptionsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(KubeconfigOptionsOutput)
 }
 
-// Defines the core set of data associated with an EKS cluster, including the network in which it runs.
-type CoreDataOutput struct{ *pulumi.OutputState }
+func (i KubeconfigOptionsArgs) ToKubeconfigOptionsPtrOutput() KubeconfigOptionsPtrOutput {
+	return i.ToKubeconfigOptionsPtrOutputWithContext(context.Background())
+}
 
-func (CoreDataOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*CoreData)(nil)).Elem()
+func (i KubeconfigOptionsArgs) ToKubeconfigOptionsPtrOutputWithContext(ctx context.Context) KubeconfigOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(KubeconfigOptionsOutput).ToKubeconfigOptionsPtrOutputWithContext(ctx)
 }
 
-func (o CoreDataOutput) ToCoreDataOutput() CoreDataOutput {
-	return o
+// KubeconfigOptionsPtrInput is an input type that accepts KubeconfigOptionsArgs, KubeconfigOptionsPtr and KubeconfigOptionsPtrOutput values.
+// You can construct a concrete instance of `KubeconfigOptionsPtrInput` via:
+//
+//	        KubeconfigOptionsArgs{...}
+//
+//	or:
+//
+//	        nil
+type KubeconfigOptionsPtrInput interface {
+	pulumi.Input
+
+	ToKubeconfigOptionsPtrOutput() KubeconfigOptionsPtrOutput
+	ToKubeconfigOptionsPtrOutputWithContext(context.Context) KubeconfigOptionsPtrOutput
 }
 
-func (o CoreDataOutput) ToCoreDataOutputWithContext(ctx context.Context) CoreDataOutput {
-	return o
+type kubeconfigOptionsPtrType KubeconfigOptionsArgs
+
+func KubeconfigOptionsPtr(v *KubeconfigOptionsArgs) KubeconfigOptionsPtrInput {
+	return (*kubeconfigOptionsPtrType)(v)
 }
 
-// The access entries added to the cluster.
-func (o CoreDataOutput) AccessEntries() AccessEntryArrayOutput {
-	return o.ApplyT(func(v CoreData) []AccessEntry { return v.AccessEntries }).(AccessEntryArrayOutput)
+func (*kubeconfigOptionsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**KubeconfigOptions)(nil)).Elem()
 }
 
-func (o CoreDataOutput) AwsProvider() aws.ProviderOutput {
-	return o.ApplyT(func(v CoreData) *aws.Provider { return v.AwsProvider }).(aws.ProviderOutput)
+func (i *kubeconfigOptionsPtrType) ToKubeconfigOptionsPtrOutput() KubeconfigOptionsPtrOutput {
+	return i.ToKubeconfigOptionsPtrOutputWithContext(context.Background())
 }
 
-func (o CoreDataOutput) Cluster() eks.ClusterOutput {
-	return o.ApplyT(func(v CoreData) *eks.Cluster { return v.Cluster }).(eks.ClusterOutput)
+func (i *kubeconfigOptionsPtrType) ToKubeconfigOptionsPtrOutputWithContext(ctx context.Context) KubeconfigOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(KubeconfigOptionsPtrOutput)
 }
 
-// The IAM Role attached to the EKS Cluster
-func (o CoreDataOutput) ClusterIamRole() iam.RoleOutput {
-	return o.ApplyT(func(v CoreData) *iam.Role { return v.ClusterIamRole }).(iam.RoleOutput)
+// Represents the AWS credentials to scope a given kubeconfig when using a non-default credential chain.
+//
+// The options can be used independently, or additively.
+//
+// A scoped kubeconfig is necessary for certain auth scenarios. For example:
+//  1. Assume a role on the default account caller,
+//  2. Use an AWS creds profile instead of the default account caller,
+//  3. Use an AWS creds creds profile instead of the default account caller,
+//     and then assume a given role on the profile. This scenario is also
+//     possible by only using a profile, iff the profile includes a role to
+//     assume in its settings.
+//
+// See for more details:
+// - https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
+// - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-role.html
+// - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-profiles.html
+type KubeconfigOptionsOutput struct{ *pulumi.OutputState }
+
+func (KubeconfigOptionsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*KubeconfigOptions)(nil)).Elem()
 }
 
-func (o CoreDataOutput) ClusterSecurityGroup() ec2.SecurityGroupOutput {
-	return o.ApplyT(func(v CoreData) *ec2.SecurityGroup { return v.ClusterSecurityGroup }).(ec2.SecurityGroupOutput)
+func (o KubeconfigOptionsOutput) ToKubeconfigOptionsOutput() KubeconfigOptionsOutput {
+	return o
 }
 
-func (o CoreDataOutput) EksNodeAccess() corev1.ConfigMapOutput {
-	return o.ApplyT(func(v CoreData) *corev1.ConfigMap { return v.EksNodeAccess }).(corev1.ConfigMapOutput)
+func (o KubeconfigOptionsOutput) ToKubeconfigOptionsOutputWithContext(ctx context.Context) KubeconfigOptionsOutput {
+	return o
 }
 
-func (o CoreDataOutput) EncryptionConfig() eks.ClusterEncryptionConfigPtrOutput {
-	return o.ApplyT(func(v CoreData) *eks.ClusterEncryptionConfig { return v.EncryptionConfig }).(eks.ClusterEncryptionConfigPtrOutput)
+func (o KubeconfigOptionsOutput) ToKubeconfigOptionsPtrOutput() KubeconfigOptionsPtrOutput {
+	return o.ToKubeconfigOptionsPtrOutputWithContext(context.Background())
 }
 
-// The EKS cluster's Kubernetes API server endpoint.
-func (o CoreDataOutput) Endpoint() pulumi.StringOutput {
-	return o.ApplyT(func(v CoreData) string { return v.Endpoint }).(pulumi.StringOutput)
+func (o KubeconfigOptionsOutput) ToKubeconfigOptionsPtrOutputWithContext(ctx context.Context) KubeconfigOptionsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v KubeconfigOptions) *KubeconfigOptions {
+		return &v
+	}).(KubeconfigOptionsPtrOutput)
 }
 
-// The Fargate profile used to manage which pods run on Fargate.
-func (o CoreDataOutput) FargateProfile() eks.FargateProfileOutput {
-	return o.ApplyT(func(v CoreData) *eks.FargateProfile { return v.FargateProfile }).(eks.FargateProfileOutput)
+// Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+func (o KubeconfigOptionsOutput) Env() pulumi.StringMapOutput {
+	return o.ApplyT(func(v KubeconfigOptions) map[string]string { return v.Env }).(pulumi.StringMapOutput)
 }
 
-// The IAM instance roles for the cluster's nodes.
-func (o CoreDataOutput) InstanceRoles() iam.RoleArrayOutput {
-	return o.ApplyT(func(v CoreData) []*iam.Role { return v.InstanceRoles }).(iam.RoleArrayOutput)
+// External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+//
+// Only supported by the `aws-iam-authenticator` token tool.
+func (o KubeconfigOptionsOutput) ExternalId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v KubeconfigOptions) *string { return v.ExternalId }).(pulumi.StringPtrOutput)
 }
 
-// The kubeconfig file for the cluster.
-func (o CoreDataOutput) Kubeconfig() pulumi.AnyOutput {
-	return o.ApplyT(func(v CoreData) interface{} { return v.Kubeconfig }).(pulumi.AnyOutput)
+// AWS credential profile name to always use instead of the default AWS credential provider chain.
+//
+// The profile is passed to kubeconfig as an authentication environment setting.
+func (o KubeconfigOptionsOutput) ProfileName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v KubeconfigOptions) *string { return v.ProfileName }).(pulumi.StringPtrOutput)
 }
 
-// The cluster's node group options.
-func (o CoreDataOutput) NodeGroupOptions() ClusterNodeGroupOptionsOutput {
-	return o.ApplyT(func(v CoreData) ClusterNodeGroupOptions { return v.NodeGroupOptions }).(ClusterNodeGroupOptionsOutput)
+// AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+func (o KubeconfigOptionsOutput) Region() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v KubeconfigOptions) *string { return v.Region }).(pulumi.StringPtrOutput)
 }
 
-// Tags attached to the security groups associated with the cluster's worker nodes.
-func (o CoreDataOutput) NodeSecurityGroupTags() pulumi.StringMapOutput {
-	return o.ApplyT(func(v CoreData) map[string]string { return v.NodeSecurityGroupTags }).(pulumi.StringMapOutput)
+// Role ARN to assume instead of the default AWS credential provider chain.
+//
+// The role is passed to kubeconfig as an authentication exec argument.
+func (o KubeconfigOptionsOutput) RoleArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v KubeconfigOptions) *string { return v.RoleArn }).(pulumi.StringPtrOutput)
 }
 
-func (o CoreDataOutput) OidcProvider() iam.OpenIdConnectProviderOutput {
-	return o.ApplyT(func(v CoreData) *iam.OpenIdConnectProvider { return v.OidcProvider }).(iam.OpenIdConnectProviderOutput)
+// Session name to use when assuming `roleArn`.
+//
+// Only supported by the `aws-iam-authenticator` token tool.
+func (o KubeconfigOptionsOutput) RoleSessionName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v KubeconfigOptions) *string { return v.RoleSessionName }).(pulumi.StringPtrOutput)
 }
 
-// List of subnet IDs for the private subnets.
-func (o CoreDataOutput) PrivateSubnetIds() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v CoreData) []string { return v.PrivateSubnetIds }).(pulumi.StringArrayOutput)
+// The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+func (o KubeconfigOptionsOutput) TokenTool() KubeconfigTokenToolPtrOutput {
+	return o.ApplyT(func(v KubeconfigOptions) *KubeconfigTokenTool { return v.TokenTool }).(KubeconfigTokenToolPtrOutput)
 }
 
-func (o CoreDataOutput) Provider() kubernetes.ProviderOutput {
-	return o.ApplyT(func(v CoreData) *kubernetes.Provider { return v.Provider }).(kubernetes.ProviderOutput)
+type KubeconfigOptionsPtrOutput struct{ *pulumi.OutputState }
+
+func (KubeconfigOptionsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**KubeconfigOptions)(nil)).Elem()
 }
 
-// List of subnet IDs for the public subnets.
-func (o CoreDataOutput) PublicSubnetIds() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v CoreData) []string { return v.PublicSubnetIds }).(pulumi.StringArrayOutput)
+func (o KubeconfigOptionsPtrOutput) ToKubeconfigOptionsPtrOutput() KubeconfigOptionsPtrOutput {
+	return o
 }
 
-// The storage class used for persistent storage by the cluster.
-func (o CoreDataOutput) StorageClasses() storagev1.StorageClassMapOutput {
-	return o.ApplyT(func(v CoreData) map[string]*storagev1.StorageClass { return v.StorageClasses }).(storagev1.StorageClassMapOutput)
+func (o KubeconfigOptionsPtrOutput) ToKubeconfigOptionsPtrOutputWithContext(ctx context.Context) KubeconfigOptionsPtrOutput {
+	return o
 }
 
-// List of subnet IDs for the EKS cluster.
-func (o CoreDataOutput) SubnetIds() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v CoreData) []string { return v.SubnetIds }).(pulumi.StringArrayOutput)
+func (o KubeconfigOptionsPtrOutput) Elem() KubeconfigOptionsOutput {
+	return o.ApplyT(func(v *KubeconfigOptions) KubeconfigOptions {
+		if v != nil {
+			return *v
+		}
+		var ret KubeconfigOptions
+		return ret
+	}).(KubeconfigOptionsOutput)
 }
 
-// A map of tags assigned to the EKS cluster.
-func (o CoreDataOutput) Tags() pulumi.StringMapOutput {
-	return o.ApplyT(func(v CoreData) map[string]string { return v.Tags }).(pulumi.StringMapOutput)
+// Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+func (o KubeconfigOptionsPtrOutput) Env() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *KubeconfigOptions) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.Env
+	}).(pulumi.StringMapOutput)
 }
 
-// The VPC CNI for the cluster.
-func (o CoreDataOutput) VpcCni() VpcCniAddonOutput {
-	return o.ApplyT(func(v CoreData) *VpcCniAddon { return v.VpcCni }).(VpcCniAddonOutput)
+// External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+//
+// Only supported by the `aws-iam-authenticator` token tool.
+func (o KubeconfigOptionsPtrOutput) ExternalId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *KubeconfigOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ExternalId
+	}).(pulumi.StringPtrOutput)
 }
 
-// ID of the cluster's VPC.
-func (o CoreDataOutput) VpcId() pulumi.StringOutput {
-	return o.ApplyT(func(v CoreData) string { return v.VpcId }).(pulumi.StringOutput)
+// AWS credential profile name to always use instead of the default AWS credential provider chain.
+//
+// The profile is passed to kubeconfig as an authentication environment setting.
+func (o KubeconfigOptionsPtrOutput) ProfileName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *KubeconfigOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ProfileName
+	}).(pulumi.StringPtrOutput)
 }
 
-type CoreDnsAddonOptions struct {
-	// Custom configuration values for the coredns addon. This object must match the schema derived from [describe-addon-configuration](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-configuration.html).
-	ConfigurationValues map[string]interface{} `pulumi:"configurationValues"`
-	// Whether or not to create the `coredns` Addon in the cluster
-	//
-	// The managed addon can only be enabled if the cluster is a Fargate cluster or if the cluster
-	// uses the default node group, otherwise the self-managed addon is used.
-	Enabled *bool `pulumi:"enabled"`
-	// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
-	ResolveConflictsOnCreate *ResolveConflictsOnCreate `pulumi:"resolveConflictsOnCreate"`
-	// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value. Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
-	ResolveConflictsOnUpdate *ResolveConflictsOnUpdate `pulumi:"resolveConflictsOnUpdate"`
-	// The version of the EKS add-on. The version must match one of the versions returned by [describe-addon-versions](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-versions.html).
-	Version *string `pulumi:"version"`
+// AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+func (o KubeconfigOptionsPtrOutput) Region() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *KubeconfigOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Region
+	}).(pulumi.StringPtrOutput)
 }
 
-// Defaults sets the appropriate defaults for CoreDnsAddonOptions
-func (val *CoreDnsAddonOptions) Defaults() *CoreDnsAddonOptions {
-	if val == nil {
-		return nil
-	}
-	tmp := *val
-	if tmp.Enabled == nil {
-		enabled_ := true
-		tmp.Enabled = &enabled_
-	}
-	if tmp.ResolveConflictsOnCreate == nil {
-		resolveConflictsOnCreate_ := ResolveConflictsOnCreate("OVERWRITE")
-		tmp.ResolveConflictsOnCreate = &resolveConflictsOnCreate_
-	}
-	if tmp.ResolveConflictsOnUpdate == nil {
-		resolveConflictsOnUpdate_ := ResolveConflictsOnUpdate("OVERWRITE")
-		tmp.ResolveConflictsOnUpdate = &resolveConflictsOnUpdate_
-	}
-	return &tmp
+// Role ARN to assume instead of the default AWS credential provider chain.
+//
+// The role is passed to kubeconfig as an authentication exec argument.
+func (o KubeconfigOptionsPtrOutput) RoleArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *KubeconfigOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.RoleArn
+	}).(pulumi.StringPtrOutput)
+}
+
+// Session name to use when assuming `roleArn`.
+//
+// Only supported by the `aws-iam-authenticator` token tool.
+func (o KubeconfigOptionsPtrOutput) RoleSessionName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *KubeconfigOptions) *string {
+		if v == nil {
+			return nil
+		}
+		return v.RoleSessionName
+	}).(pulumi.StringPtrOutput)
+}
+
+// The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+func (o KubeconfigOptionsPtrOutput) TokenTool() KubeconfigTokenToolPtrOutput {
+	return o.ApplyT(func(v *KubeconfigOptions) *KubeconfigTokenTool {
+		if v == nil {
+			return nil
+		}
+		return v.TokenTool
+	}).(KubeconfigTokenToolPtrOutput)
 }
 
-// CoreDnsAddonOptionsInput is an input type that accepts CoreDnsAddonOptionsArgs and CoreDnsAddonOptionsOutput values.
-// You can construct a concrete instance of `CoreDnsAddonOptionsInput` via:
+// Typed nodeadm `NodeConfig`. The cluster details are configured by the provider.
 //
-//	CoreDnsAddonOptionsArgs{...}
-type CoreDnsAddonOptionsInput interface {
+// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/.
+type NodeConfig struct {
+	// Configuration of containerd.
+	Containerd *NodeConfigContainerd `pulumi:"containerd"`
+	// Configuration of the EC2 instance.
+	Instance *NodeConfigInstance `pulumi:"instance"`
+	// Configuration of the kubelet.
+	Kubelet *NodeConfigKubelet `pulumi:"kubelet"`
+}
+
+// NodeConfigInput is an input type that accepts NodeConfigArgs and NodeConfigOutput values.
+// You can construct a concrete instance of `NodeConfigInput` via:
+//
+//	NodeConfigArgs{...}
+type NodeConfigInput interface {
 	pulumi.Input
 
-	ToCoreDnsAddonOptionsOutput() CoreDnsAddonOptionsOutput
-	ToCoreDnsAddonOptionsOutputWithContext(context.Context) CoreDnsAddonOptionsOutput
+	ToNodeConfigOutput() NodeConfigOutput
+	ToNodeConfigOutputWithContext(context.Context) NodeConfigOutput
 }
 
-type CoreDnsAddonOptionsArgs struct {
-	// Custom configuration values for the coredns addon. This object must match the schema derived from [describe-addon-configuration](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-configuration.html).
-	ConfigurationValues pulumi.MapInput `pulumi:"configurationValues"`
-	// Whether or not to create the `coredns` Addon in the cluster
-	//
-	// The managed addon can only be enabled if the cluster is a Fargate cluster or if the cluster
-	// uses the default node group, otherwise the self-managed addon is used.
-	Enabled *bool `pulumi:"enabled"`
-	// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
-	ResolveConflictsOnCreate *ResolveConflictsOnCreate `pulumi:"resolveConflictsOnCreate"`
-	// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value. Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
-	ResolveConflictsOnUpdate *ResolveConflictsOnUpdate `pulumi:"resolveConflictsOnUpdate"`
-	// The version of the EKS add-on. The version must match one of the versions returned by [describe-addon-versions](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-versions.html).
-	Version pulumi.StringPtrInput `pulumi:"version"`
+// Typed nodeadm `NodeConfig`. The cluster details are configured by the provider.
+//
+// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/.
+type NodeConfigArgs struct {
+	// Configuration of containerd.
+	Containerd NodeConfigContainerdPtrInput `pulumi:"containerd"`
+	// Configuration of the EC2 instance.
+	Instance NodeConfigInstancePtrInput `pulumi:"instance"`
+	// Configuration of the kubelet.
+	Kubelet NodeConfigKubeletPtrInput `pulumi:"kubelet"`
 }
 
-// Defaults sets the appropriate defaults for CoreDnsAddonOptionsArgs
-func (val *CoreDnsAddonOptionsArgs) Defaults() *CoreDnsAddonOptionsArgs {
-	if val == nil {
-		return nil
-	}
-	tmp := *val
-	if tmp.Enabled == nil {
-		enabled_ := true
-		tmp.Enabled = &enabled_
-	}
-	if tmp.ResolveConflictsOnCreate == nil {
-		resolveConflictsOnCreate_ := ResolveConflictsOnCreate("OVERWRITE")
-		tmp.ResolveConflictsOnCreate = &resolveConflictsOnCreate_
-	}
-	if tmp.ResolveConflictsOnUpdate == nil {
-		resolveConflictsOnUpdate_ := ResolveConflictsOnUpdate("OVERWRITE")
-		tmp.ResolveConflictsOnUpdate = &resolveConflictsOnUpdate_
-	}
-	return &tmp
-}
-func (CoreDnsAddonOptionsArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*CoreDnsAddonOptions)(nil)).Elem()
+func (NodeConfigArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*NodeConfig)(nil)).Elem()
 }
 
-func (i CoreDnsAddonOptionsArgs) ToCoreDnsAddonOptionsOutput() CoreDnsAddonOptionsOutput {
-	return i.ToCoreDnsAddonOptionsOutputWithContext(context.Background())
+func (i NodeConfigArgs) ToNodeConfigOutput() NodeConfigOutput {
+	return i.ToNodeConfigOutputWithContext(context.Background())
 }
 
-func (i CoreDnsAddonOptionsArgs) ToCoreDnsAddonOptionsOutputWithContext(ctx context.Context) CoreDnsAddonOptionsOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(CoreDnsAddonOptionsOutput)
+func (i NodeConfigArgs) ToNodeConfigOutputWithContext(ctx context.Context) NodeConfigOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NodeConfigOutput)
 }
 
-func (i CoreDnsAddonOptionsArgs) ToCoreDnsAddonOptionsPtrOutput() CoreDnsAddonOptionsPtrOutput {
-	return i.ToCoreDnsAddonOptionsPtrOutputWithContext(context.Background())
+func (i NodeConfigArgs) ToNodeConfigPtrOutput() NodeConfigPtrOutput {
+	return i.ToNodeConfigPtrOutputWithContext(context.Background())
 }
 
-func (i CoreDnsAddonOptionsArgs) ToCoreDnsAddonOptionsPtrOutputWithContext(ctx context.Context) CoreDnsAddonOptionsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(CoreDnsAddonOptionsOutput).ToCoreDnsAddonOptionsPtrOutputWithContext(ctx)
+func (i NodeConfigArgs) ToNodeConfigPtrOutputWithContext(ctx context.Context) NodeConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NodeConfigOutput).ToNodeConfigPtrOutputWithContext(ctx)
 }
 
-// CoreDnsAddonOptionsPtrInput is an input type that accepts CoreDnsAddonOptionsArgs, CoreDnsAddonOptionsPtr and CoreDnsAddonOptionsPtrOutput values.
-// You can construct a concrete instance of `CoreDnsAddonOptionsPtrInput` via:
+// NodeConfigPtrInput is an input type that accepts NodeConfigArgs, NodeConfigPtr and NodeConfigPtrOutput values.
+// You can construct a concrete instance of `NodeConfigPtrInput` via:
 //
-//	        CoreDnsAddonOptionsArgs{...}
+//	        NodeConfigArgs{...}
 //
 //	or:
 //
 //	        nil
-type CoreDnsAddonOptionsPtrInput interface {
+type NodeConfigPtrInput interface {
 	pulumi.Input
 
-	ToCoreDnsAddonOptionsPtrOutput() CoreDnsAddonOptionsPtrOutput
-	ToCoreDnsAddonOptionsPtrOutputWithContext(context.Context) CoreDnsAddonOptionsPtrOutput
+	ToNodeConfigPtrOutput() NodeConfigPtrOutput
+	ToNodeConfigPtrOutputWithContext(context.Context) NodeConfigPtrOutput
 }
 
-type coreDnsAddonOptionsPtrType CoreDnsAddonOptionsArgs
+type nodeConfigPtrType NodeConfigArgs
 
-func CoreDnsAddonOptionsPtr(v *CoreDnsAddonOptionsArgs) CoreDnsAddonOptionsPtrInput {
-	return (*coreDnsAddonOptionsPtrType)(v)
+func NodeConfigPtr(v *NodeConfigArgs) NodeConfigPtrInput {
+	return (*nodeConfigPtrType)(v)
 }
 
-func (*coreDnsAddonOptionsPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**CoreDnsAddonOptions)(nil)).Elem()
+func (*nodeConfigPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**NodeConfig)(nil)).Elem()
 }
 
-func (i *coreDnsAddonOptionsPtrType) ToCoreDnsAddonOptionsPtrOutput() CoreDnsAddonOptionsPtrOutput {
-	return i.ToCoreDnsAddonOptionsPtrOutputWithContext(context.Background())
+func (i *nodeConfigPtrType) ToNodeConfigPtrOutput() NodeConfigPtrOutput {
+	return i.ToNodeConfigPtrOutputWithContext(context.Background())
 }
 
-func (i *coreDnsAddonOptionsPtrType) ToCoreDnsAddonOptionsPtrOutputWithContext(ctx context.Context) CoreDnsAddonOptionsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(CoreDnsAddonOptionsPtrOutput)
+func (i *nodeConfigPtrType) ToNodeConfigPtrOutputWithContext(ctx context.Context) NodeConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NodeConfigPtrOutput)
 }
 
-type CoreDnsAddonOptionsOutput struct{ *pulumi.OutputState }
+// Typed nodeadm `NodeConfig`. The cluster details are configured by the provider.
+//
+// See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/.
+type NodeConfigOutput struct{ *pulumi.OutputState }
 
-func (CoreDnsAddonOptionsOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*CoreDnsAddonOptions)(nil)).Elem()
+func (NodeConfigOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*NodeConfig)(nil)).Elem()
 }
 
-func (o CoreDnsAddonOptionsOutput) ToCoreDnsAddonOptionsOutput() CoreDnsAddonOptionsOutput {
+func (o NodeConfigOutput) ToNodeConfigOutput() NodeConfigOutput {
 	return o
 }
 
-func (o CoreDnsAddonOptionsOutput) ToCoreDnsAddonOptionsOutputWithContext(ctx context.Context) CoreDnsAddonOptionsOutput {
+func (o NodeConfigOutput) ToNodeConfigOutputWithContext(ctx context.Context) NodeConfigOutput {
 	return o
 }
 
-func (o CoreDnsAddonOptionsOutput) ToCoreDnsAddonOptionsPtrOutput() CoreDnsAddonOptionsPtrOutput {
-	return o.ToCoreDnsAddonOptionsPtrOutputWithContext(context.Background())
+func (o NodeConfigOutput) ToNodeConfigPtrOutput() NodeConfigPtrOutput {
+	return o.ToNodeConfigPtrOutputWithContext(context.Background())
 }
 
-func (o CoreDnsAddonOptionsOutput) ToCoreDnsAddonOptionsPtrOutputWithContext(ctx context.Context) CoreDnsAddonOptionsPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v CoreDnsAddonOptions) *CoreDnsAddonOptions {
+func (o NodeConfigOutput) ToNodeConfigPtrOutputWithContext(ctx context.Context) NodeConfigPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v NodeConfig) *NodeConfig {
 		return &v
-	}).(CoreDnsAddonOptionsPtrOutput)
-}
-
-// Custom configuration values for the coredns addon. This object must match the schema derived from [describe-addon-configuration](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-configuration.html).
-func (o CoreDnsAddonOptionsOutput) ConfigurationValues() pulumi.MapOutput {
-	return o.ApplyT(func(v CoreDnsAddonOptions) map[string]interface{} { return v.ConfigurationValues }).(pulumi.MapOutput)
-}
-
-// Whether or not to create the `coredns` Addon in the cluster
-//
-// The managed addon can only be enabled if the cluster is a Fargate cluster or if the cluster
-// uses the default node group, otherwise the self-managed addon is used.
-func (o CoreDnsAddonOptionsOutput) Enabled() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v CoreDnsAddonOptions) *bool { return v.Enabled }).(pulumi.BoolPtrOutput)
+	}).(NodeConfigPtrOutput)
 }
 
-// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
-func (o CoreDnsAddonOptionsOutput) ResolveConflictsOnCreate() ResolveConflictsOnCreatePtrOutput {
-	return o.ApplyT(func(v CoreDnsAddonOptions) *ResolveConflictsOnCreate { return v.ResolveConflictsOnCreate }).(ResolveConflictsOnCreatePtrOutput)
+// Configuration of containerd.
+func (o NodeConfigOutput) Containerd() NodeConfigContainerdPtrOutput {
+	return o.ApplyT(func(v NodeConfig) *NodeConfigContainerd { return v.Containerd }).(NodeConfigContainerdPtrOutput)
 }
 
-// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value. Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
-func (o CoreDnsAddonOptionsOutput) ResolveConflictsOnUpdate() ResolveConflictsOnUpdatePtrOutput {
-	return o.ApplyT(func(v CoreDnsAddonOptions) *ResolveConflictsOnUpdate { return v.ResolveConflictsOnUpdate }).(ResolveConflictsOnUpdatePtrOutput)
+// Configuration of the EC2 instance.
+func (o NodeConfigOutput) Instance() NodeConfigInstancePtrOutput {
+	return o.ApplyT(func(v NodeConfig) *NodeConfigInstance { return v.Instance }).(NodeConfigInstancePtrOutput)
 }
 
-// The version of the EKS add-on. The version must match one of the versions returned by [describe-addon-versions](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-versions.html).
-func (o CoreDnsAddonOptionsOutput) Version() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v CoreDnsAddonOptions) *string { return v.Version }).(pulumi.StringPtrOutput)
+// Configuration of the kubelet.
+func (o NodeConfigOutput) Kubelet() NodeConfigKubeletPtrOutput {
+	return o.ApplyT(func(v NodeConfig) *NodeConfigKubelet { return v.Kubelet }).(NodeConfigKubeletPtrOutput)
 }
 
-type CoreDnsAddonOptionsPtrOutput struct{ *pulumi.OutputState }
+type NodeConfigPtrOutput struct{ *pulumi.OutputState }
 
-func (CoreDnsAddonOptionsPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**CoreDnsAddonOptions)(nil)).Elem()
+func (NodeConfigPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**NodeConfig)(nil)).Elem()
 }
 
-func (o CoreDnsAddonOptionsPtrOutput) ToCoreDnsAddonOptionsPtrOutput() CoreDnsAddonOptionsPtrOutput {
+func (o NodeConfigPtrOutput) ToNodeConfigPtrOutput() NodeConfigPtrOutput {
 	return o
 }
 
-func (o CoreDnsAddonOptionsPtrOutput) ToCoreDnsAddonOptionsPtrOutputWithContext(ctx context.Context) CoreDnsAddonOptionsPtrOutput {
+func (o NodeConfigPtrOutput) ToNodeConfigPtrOutputWithContext(ctx context.Context) NodeConfigPtrOutput {
 	return o
 }
 
-func (o CoreDnsAddonOptionsPtrOutput) Elem() CoreDnsAddonOptionsOutput {
-	return o.ApplyT(func(v *CoreDnsAddonOptions) CoreDnsAddonOptions {
+func (o NodeConfigPtrOutput) Elem() NodeConfigOutput {
+	return o.ApplyT(func(v *NodeConfig) NodeConfig {
 		if v != nil {
 			return *v
 		}
-		var ret CoreDnsAddonOptions
+		var ret NodeConfig
 		return ret
-	}).(CoreDnsAddonOptionsOutput)
-}
-
-// Custom configuration values for the coredns addon. This object must match the schema derived from [describe-addon-configuration](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-configuration.html).
-func (o CoreDnsAddonOptionsPtrOutput) ConfigurationValues() pulumi.MapOutput {
-	return o.ApplyT(func(v *CoreDnsAddonOptions) map[string]interface{} {
-		if v == nil {
-			return nil
-		}
-		return v.ConfigurationValues
-	}).(pulumi.MapOutput)
-}
-
-// Whether or not to create the `coredns` Addon in the cluster
-//
-// The managed addon can only be enabled if the cluster is a Fargate cluster or if the cluster
-// uses the default node group, otherwise the self-managed addon is used.
-func (o CoreDnsAddonOptionsPtrOutput) Enabled() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *CoreDnsAddonOptions) *bool {
-		if v == nil {
-			return nil
-		}
-		return v.Enabled
-	}).(pulumi.BoolPtrOutput)
+	}).(NodeConfigOutput)
 }
 
-// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
-func (o CoreDnsAddonOptionsPtrOutput) ResolveConflictsOnCreate() ResolveConflictsOnCreatePtrOutput {
-	return o.ApplyT(func(v *CoreDnsAddonOptions) *ResolveConflictsOnCreate {
+// Configuration of containerd.
+func (o NodeConfigPtrOutput) Containerd() NodeConfigContainerdPtrOutput {
+	return o.ApplyT(func(v *NodeConfig) *NodeConfigContainerd {
 		if v == nil {
 			return nil
 		}
-		return v.ResolveConflictsOnCreate
-	}).(ResolveConflictsOnCreatePtrOutput)
+		return v.Containerd
+	}).(NodeConfigContainerdPtrOutput)
 }
 
-// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value. Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
-func (o CoreDnsAddonOptionsPtrOutput) ResolveConflictsOnUpdate() ResolveConflictsOnUpdatePtrOutput {
-	return o.ApplyT(func(v *CoreDnsAddonOptions) *ResolveConflictsOnUpdate {
+// Configuration of the EC2 instance.
+func (o NodeConfigPtrOutput) Instance() NodeConfigInstancePtrOutput {
+	return o.ApplyT(func(v *NodeConfig) *NodeConfigInstance {
 		if v == nil {
 			return nil
 		}
-		return v.ResolveConflictsOnUpdate
-	}).(ResolveConflictsOnUpdatePtrOutput)
+		return v.Instance
+	}).(NodeConfigInstancePtrOutput)
 }
 
-// The version of the EKS add-on. The version must match one of the versions returned by [describe-addon-versions](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-versions.html).
-func (o CoreDnsAddonOptionsPtrOutput) Version() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *CoreDnsAddonOptions) *string {
+// Configuration of the kubelet.
+func (o NodeConfigPtrOutput) Kubelet() NodeConfigKubeletPtrOutput {
+	return o.ApplyT(func(v *NodeConfig) *NodeConfigKubelet {
 		if v == nil {
 			return nil
 		}
-		return v.Version
-	}).(pulumi.StringPtrOutput)
+		return v.Kubelet
+	}).(NodeConfigKubeletPtrOutput)
 }
 
-// Contains the AWS Role and Provider necessary to override the `[system:master]` entity ARN. This is an optional argument used when creating `Cluster`. Read more: https://docs.aws.amazon.com/eks/latest/userguide/add-user-role.html
-//
-// Note: This option is only supported with Pulumi nodejs programs. Please use `ProviderCredentialOpts` as an alternative instead.
-type CreationRoleProvider struct {
-	Provider *aws.Provider `pulumi:"provider"`
-	Role     *iam.Role     `pulumi:"role"`
+// Configuration of containerd in a nodeadm `NodeConfig`.
+type NodeConfigContainerd struct {
+	// The OCI runtime specification containers are created with.
+	BaseRuntimeSpec map[string]interface{} `pulumi:"baseRuntimeSpec"`
+	// Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+	Config *string `pulumi:"config"`
 }
 
-// CreationRoleProviderInput is an input type that accepts CreationRoleProviderArgs and CreationRoleProviderOutput values.
-// You can construct a concrete instance of `CreationRoleProviderInput` via:
+// NodeConfigContainerdInput is an input type that accepts NodeConfigContainerdArgs and NodeConfigContainerdOutput values.
+// You can construct a concrete instance of `NodeConfigContainerdInput` via:
 //
-//	CreationRoleProviderArgs{...}
-type CreationRoleProviderInput interface {
+//	NodeConfigContainerdArgs{...}
+type NodeConfigContainerdInput interface {
 	pulumi.Input
 
-	ToCreationRoleProviderOutput() CreationRoleProviderOutput
-	ToCreationRoleProviderOutputWithContext(context.Context) CreationRoleProviderOutput
+	ToNodeConfigContainerdOutput() NodeConfigContainerdOutput
+	ToNodeConfigContainerdOutputWithContext(context.Context) NodeConfigContainerdOutput
 }
 
-// Contains the AWS Role and Provider necessary to override the `[system:master]` entity ARN. This is an optional argument used when creating `Cluster`. Read more: https://docs.aws.amazon.com/eks/latest/userguide/add-user-role.html
-//
-// Note: This option is only supported with Pulumi nodejs programs. Please use `ProviderCredentialOpts` as an alternative instead.
-type CreationRoleProviderArgs struct {
-	Provider *aws.Provider `pulumi:"provider"`
-	Role     *iam.Role     `pulumi:"role"`
+// Configuration of containerd in a nodeadm `NodeConfig`.
+type NodeConfigContainerdArgs struct {
+	// The OCI runtime specification containers are created with.
+	BaseRuntimeSpec pulumi.MapInput `pulumi:"baseRuntimeSpec"`
+	// Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+	Config pulumi.StringPtrInput `pulumi:"config"`
 }
 
-func (CreationRoleProviderArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*CreationRoleProvider)(nil)).Elem()
+func (NodeConfigContainerdArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*NodeConfigContainerd)(nil)).Elem()
 }
 
-func (i CreationRoleProviderArgs) ToCreationRoleProviderOutput() CreationRoleProviderOutput {
-	return i.ToCreationRoleProviderOutputWithContext(context.Background())
+func (i NodeConfigContainerdArgs) ToNodeConfigContainerdOutput() NodeConfigContainerdOutput {
+	return i.ToNodeConfigContainerdOutputWithContext(context.Background())
 }
 
-func (i CreationRoleProviderArgs) ToCreationRoleProviderOutputWithContext(ctx context.Context) CreationRoleProviderOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(CreationRoleProviderOutput)
+func (i NodeConfigContainerdArgs) ToNodeConfigContainerdOutputWithContext(ctx context.Context) NodeConfigContainerdOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NodeConfigContainerdOutput)
 }
 
-func (i CreationRoleProviderArgs) ToCreationRoleProviderPtrOutput() CreationRoleProviderPtrOutput {
-	return i.ToCreationRoleProviderPtrOutputWithContext(context.Background())
+func (i NodeConfigContainerdArgs) ToNodeConfigContainerdPtrOutput() NodeConfigContainerdPtrOutput {
+	return i.ToNodeConfigContainerdPtrOutputWithContext(context.Background())
 }
 
-func (i CreationRoleProviderArgs) ToCreationRoleProviderPtrOutputWithContext(ctx context.Context) CreationRoleProviderPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(CreationRoleProviderOutput).ToCreationRoleProviderPtrOutputWithContext(ctx)
+func (i NodeConfigContainerdArgs) ToNodeConfigContainerdPtrOutputWithContext(ctx context.Context) NodeConfigContainerdPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NodeConfigContainerdOutput).ToNodeConfigContainerdPtrOutputWithContext(ctx)
 }
 
-// CreationRoleProviderPtrInput is an input type that accepts CreationRoleProviderArgs, CreationRoleProviderPtr and CreationRoleProviderPtrOutput values.
-// You can construct a concrete instance of `CreationRoleProviderPtrInput` via:
+// NodeConfigContainerdPtrInput is an input type that accepts NodeConfigContainerdArgs, NodeConfigContainerdPtr and NodeConfigContainerdPtrOutput values.
+// You can construct a concrete instance of `NodeConfigContainerdPtrInput` via:
 //
-//	        CreationRoleProviderArgs{...}
+//	        NodeConfigContainerdArgs{...}
 //
 //	or:
 //
 //	        nil
-type CreationRoleProviderPtrInput interface {
+type NodeConfigContainerdPtrInput interface {
 	pulumi.Input
 
-	ToCreationRoleProviderPtrOutput() CreationRoleProviderPtrOutput
-	ToCreationRoleProviderPtrOutputWithContext(context.Context) CreationRoleProviderPtrOutput
+	ToNodeConfigContainerdPtrOutput() NodeConfigContainerdPtrOutput
+	ToNodeConfigContainerdPtrOutputWithContext(context.Context) NodeConfigContainerdPtrOutput
 }
 
-type creationRoleProviderPtrType CreationRoleProviderArgs
+type nodeConfigContainerdPtrType NodeConfigContainerdArgs
 
-func CreationRoleProviderPtr(v *CreationRoleProviderArgs) CreationRoleProviderPtrInput {
-	return (*creationRoleProviderPtrType)(v)
+func NodeConfigContainerdPtr(v *NodeConfigContainerdArgs) NodeConfigContainerdPtrInput {
+	return (*nodeConfigContainerdPtrType)(v)
 }
 
-func (*creationRoleProviderPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**CreationRoleProvider)(nil)).Elem()
+func (*nodeConfigContainerdPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**NodeConfigContainerd)(nil)).Elem()
 }
 
-func (i *creationRoleProviderPtrType) ToCreationRoleProviderPtrOutput() CreationRoleProviderPtrOutput {
-	return i.ToCreationRoleProviderPtrOutputWithContext(context.Background())
+func (i *nodeConfigContainerdPtrType) ToNodeConfigContainerdPtrOutput() NodeConfigContainerdPtrOutput {
+	return i.ToNodeConfigContainerdPtrOutputWithContext(context.Background())
 }
 
-func (i *creationRoleProviderPtrType) ToCreationRoleProviderPtrOutputWithContext(ctx context.Context) CreationRoleProviderPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(CreationRoleProviderPtrOutput)
+func (i *nodeConfigContainerdPtrType) ToNodeConfigContainerdPtrOutputWithContext(ctx context.Context) NodeConfigContainerdPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NodeConfigContainerdPtrOutput)
 }
 
-// Contains the AWS Role and Provider necessary to override the `[system:master]` entity ARN. This is an optional argument used when creating `Cluster`. Read more: https://docs.aws.amazon.com/eks/latest/userguide/add-user-role.html
-//
-// Note: This option is only supported with Pulumi nodejs programs. Please use `ProviderCredentialOpts` as an alternative instead.
-type CreationRoleProviderOutput struct{ *pulumi.OutputState }
+// Configuration of containerd in a nodeadm `NodeConfig`.
+type NodeConfigContainerdOutput struct{ *pulumi.OutputState }
 
-func (CreationRoleProviderOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*CreationRoleProvider)(nil)).Elem()
+func (NodeConfigContainerdOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*NodeConfigContainerd)(nil)).Elem()
 }
 
-func (o CreationRoleProviderOutput) ToCreationRoleProviderOutput() CreationRoleProviderOutput {
+func (o NodeConfigContainerdOutput) ToNodeConfigContainerdOutput() NodeConfigContainerdOutput {
 	return o
 }
 
-func (o CreationRoleProviderOutput) ToCreationRoleProviderOutputWithContext(ctx context.Context) CreationRoleProviderOutput {
+func (o NodeConfigContainerdOutput) ToNodeConfigContainerdOutputWithContext(ctx context.Context) NodeConfigContainerdOutput {
 	return o
 }
 
-func (o CreationRoleProviderOutput) ToCreationRoleProviderPtrOutput() CreationRoleProviderPtrOutput {
-	return o.ToCreationRoleProviderPtrOutputWithContext(context.Background())
+func (o NodeConfigContainerdOutput) ToNodeConfigContainerdPtrOutput() NodeConfigContainerdPtrOutput {
+	return o.ToNodeConfigContainerdPtrOutputWithContext(context.Background())
 }
 
-func (o CreationRoleProviderOutput) ToCreationRoleProviderPtrOutputWithContext(ctx context.Context) CreationRoleProviderPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v CreationRoleProvider) *CreationRoleProvider {
+func (o NodeConfigContainerdOutput) ToNodeConfigContainerdPtrOutputWithContext(ctx context.Context) NodeConfigContainerdPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v NodeConfigContainerd) *NodeConfigContainerd {
 		return &v
-	}).(CreationRoleProviderPtrOutput)
+	}).(NodeConfigContainerdPtrOutput)
 }
 
-func (o CreationRoleProviderOutput) Provider() aws.ProviderOutput {
-	return o.ApplyT(func(v CreationRoleProvider) *aws.Provider { return v.Provider }).(aws.ProviderOutput)
+// The OCI runtime specification containers are created with.
+func (o NodeConfigContainerdOutput) BaseRuntimeSpec() pulumi.MapOutput {
+	return o.ApplyT(func(v NodeConfigContainerd) map[string]interface{} { return v.BaseRuntimeSpec }).(pulumi.MapOutput)
 }
 
-func (o CreationRoleProviderOutput) Role() iam.RoleOutput {
-	return o.ApplyT(func(v CreationRoleProvider) *iam.Role { return v.Role }).(iam.RoleOutput)
+// Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+func (o NodeConfigContainerdOutput) Config() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v NodeConfigContainerd) *string { return v.Config }).(pulumi.StringPtrOutput)
 }
 
-type CreationRoleProviderPtrOutput struct{ *pulumi.OutputState }
+type NodeConfigContainerdPtrOutput struct{ *pulumi.OutputState }
 
-func (CreationRoleProviderPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**CreationRoleProvider)(nil)).Elem()
+func (NodeConfigContainerdPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**NodeConfigContainerd)(nil)).Elem()
 }
 
-func (o CreationRoleProviderPtrOutput) ToCreationRoleProviderPtrOutput() CreationRoleProviderPtrOutput {
+func (o NodeConfigContainerdPtrOutput) ToNodeConfigContainerdPtrOutput() NodeConfigContainerdPtrOutput {
 	return o
 }
 
-func (o CreationRoleProviderPtrOutput) ToCreationRoleProviderPtrOutputWithContext(ctx context.Context) CreationRoleProviderPtrOutput {
+func (o NodeConfigContainerdPtrOutput) ToNodeConfigContainerdPtrOutputWithContext(ctx context.Context) NodeConfigContainerdPtrOutput {
 	return o
 }
 
-func (o CreationRoleProviderPtrOutput) Elem() CreationRoleProviderOutput {
-	return o.ApplyT(func(v *CreationRoleProvider) CreationRoleProvider {
+func (o NodeConfigContainerdPtrOutput) Elem() NodeConfigContainerdOutput {
+	return o.ApplyT(func(v *NodeConfigContainerd) NodeConfigContainerd {
 		if v != nil {
 			return *v
 		}
-		var ret CreationRoleProvider
+		var ret NodeConfigContainerd
 		return ret
-	}).(CreationRoleProviderOutput)
+	}).(NodeConfigContainerdOutput)
 }
 
-func (o CreationRoleProviderPtrOutput) Provider() aws.ProviderOutput {
-	return o.ApplyT(func(v *CreationRoleProvider) *aws.Provider {
+// The OCI runtime specification containers are created with.
+func (o NodeConfigContainerdPtrOutput) BaseRuntimeSpec() pulumi.MapOutput {
+	return o.ApplyT(func(v *NodeConfigContainerd) map[string]interface{} {
 		if v == nil {
 			return nil
 		}
-		return v.Provider
-	}).(aws.ProviderOutput)
+		return v.BaseRuntimeSpec
+	}).(pulumi.MapOutput)
 }
 
-func (o CreationRoleProviderPtrOutput) Role() iam.RoleOutput {
-	return o.ApplyT(func(v *CreationRoleProvider) *iam.Role {
+// Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+func (o NodeConfigContainerdPtrOutput) Config() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *NodeConfigContainerd) *string {
 		if v == nil {
 			return nil
 		}
-		return v.Role
-	}).(iam.RoleOutput)
+		return v.Config
+	}).(pulumi.StringPtrOutput)
 }
 
-// Defines how Kubernetes pods are executed in Fargate. See aws.eks.FargateProfileArgs for reference.
-type FargateProfile struct {
-	// Specify a custom role to use for executing pods in Fargate. Defaults to creating a new role with the `arn:aws:iam::aws:policy/AmazonEKSFargatePodExecutionRolePolicy` policy attached.
-	PodExecutionRoleArn *string `pulumi:"podExecutionRoleArn"`
-	// Specify the namespace and label selectors to use for launching pods into Fargate.
-	Selectors []eks.FargateProfileSelector `pulumi:"selectors"`
-	// Specify the subnets in which to execute Fargate tasks for pods. Defaults to the private subnets associated with the cluster.
-	SubnetIds []string `pulumi:"subnetIds"`
+// Configuration of the EC2 instance in a nodeadm `NodeConfig`.
+type NodeConfigInstance struct {
+	// Configuration of the local instance store volumes.
+	LocalStorage *NodeConfigLocalStorage `pulumi:"localStorage"`
 }
 
-// FargateProfileInput is an input type that accepts FargateProfileArgs and FargateProfileOutput values.
-// You can construct a concrete instance of `FargateProfileInput` via:
+// NodeConfigInstanceInput is an input type that accepts NodeConfigInstanceArgs and NodeConfigInstanceOutput values.
+// You can construct a concrete instance of `NodeConfigInstanceInput` via:
 //
-//	FargateProfileArgs{...}
-type FargateProfileInput interface {
+//	NodeConfigInstanceArgs{...}
+type NodeConfigInstanceInput interface {
 	pulumi.Input
-
-	ToFargateProfileOutput() FargateProfileOutput
-	ToFargateProfileOutputWithContext(context.Context) FargateProfileOutput
-}
-
-// Defines how Kubernetes pods are executed in Fargate. See aws.eks.FargateProfileArgs for reference.
-type FargateProfileArgs struct {
-	// Specify a custom role to use for executing pods in Fargate. Defaults to creating a new role with the `arn:aws:iam::aws:policy/AmazonEKSFargatePodExecutionRolePolicy` policy attached.
-	PodExecutionRoleArn pulumi.StringPtrInput `pulumi:"podExecutionRoleArn"`
-	// Specify the namespace and label selectors to use for launching pods into Fargate.
-	Selectors eks.FargateProfileSelectorArrayInput `pulumi:"selectors"`
-	// Specify the subnets in which to execute Fargate tasks for pods. Defaults to the private subnets associated with the cluster.
-	SubnetIds pulumi.StringArrayInput `pulumi:"subnetIds"`
+
+	ToNodeConfigInstanceOutput() NodeConfigInstanceOutput
+	ToNodeConfigInstanceOutputWithContext(context.Context) NodeConfigInstanceOutput
 }
 
-func (FargateProfileArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*FargateProfile)(nil)).Elem()
+// Configuration of the EC2 instance in a nodeadm `NodeConfig`.
+type NodeConfigInstanceArgs struct {
+	// Configuration of the local instance store volumes.
+	LocalStorage NodeConfigLocalStoragePtrInput `pulumi:"localStorage"`
 }
 
-func (i FargateProfileArgs) ToFargateProfileOutput() FargateProfileOutput {
-	return i.ToFargateProfileOutputWithContext(context.Background())
+func (NodeConfigInstanceArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*NodeConfigInstance)(nil)).Elem()
 }
 
-func (i FargateProfileArgs) ToFargateProfileOutputWithContext(ctx context.Context) FargateProfileOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(FargateProfileOutput)
+func (i NodeConfigInstanceArgs) ToNodeConfigInstanceOutput() NodeConfigInstanceOutput {
+	return i.ToNodeConfigInstanceOutputWithContext(context.Background())
 }
 
-func (i FargateProfileArgs) ToFargateProfilePtrOutput() FargateProfilePtrOutput {
-	return i.ToFargateProfilePtrOutputWithContext(context.Background())
+func (i NodeConfigInstanceArgs) ToNodeConfigInstanceOutputWithContext(ctx context.Context) NodeConfigInstanceOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NodeConfigInstanceOutput)
 }
 
-func (i FargateProfileArgs) ToFargateProfilePtrOutputWithContext(ctx context.Context) FargateProfilePtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(FargateProfileOutput).ToFargateProfilePtrOutputWithContext(ctx)
+func (i NodeConfigInstanceArgs) ToNodeConfigInstancePtrOutput() NodeConfigInstancePtrOutput {
+	return i.ToNodeConfigInstancePtrOutputWithContext(context.Background())
 }
 
-// FargateProfilePtrInput is an input type that accepts FargateProfileArgs, FargateProfilePtr and FargateProfilePtrOutput values.
-// You can construct a concrete instance of `FargateProfilePtrInput` via:
+func (i NodeConfigInstanceArgs) ToNodeConfigInstancePtrOutputWithContext(ctx context.Context) NodeConfigInstancePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NodeConfigInstanceOutput).ToNodeConfigInstancePtrOutputWithContext(ctx)
+}
+
+// NodeConfigInstancePtrInput is an input type that accepts NodeConfigInstanceArgs, NodeConfigInstancePtr and NodeConfigInstancePtrOutput values.
+// You can construct a concrete instance of `NodeConfigInstancePtrInput` via:
 //
-//	        FargateProfileArgs{...}
+//	        NodeConfigInstanceArgs{...}
 //
 //	or:
 //
 //	        nil
-type FargateProfilePtrInput interface {
+type NodeConfigInstancePtrInput interface {
 	pulumi.Input
 
-	ToFargateProfilePtrOutput() FargateProfilePtrOutput
-	ToFargateProfilePtrOutputWithContext(context.Context) FargateProfilePtrOutput
+	ToNodeConfigInstancePtrOutput() NodeConfigInstancePtrOutput
+	ToNodeConfigInstancePtrOutputWithContext(context.Context) NodeConfigInstancePtrOutput
 }
 
-type fargateProfilePtrType FargateProfileArgs
+type nodeConfigInstancePtrType NodeConfigInstanceArgs
 
-func FargateProfilePtr(v *FargateProfileArgs) FargateProfilePtrInput {
-	return (*fargateProfilePtrType)(v)
+func NodeConfigInstancePtr(v *NodeConfigInstanceArgs) NodeConfigInstancePtrInput {
+	return (*nodeConfigInstancePtrType)(v)
 }
 
-func (*fargateProfilePtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**FargateProfile)(nil)).Elem()
+func (*nodeConfigInstancePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**NodeConfigInstance)(nil)).Elem()
 }
 
-func (i *fargateProfilePtrType) ToFargateProfilePtrOutput() FargateProfilePtrOutput {
-	return i.ToFargateProfilePtrOutputWithContext(context.Background())
+func (i *nodeConfigInstancePtrType) ToNodeConfigInstancePtrOutput() NodeConfigInstancePtrOutput {
+	return i.ToNodeConfigInstancePtrOutputWithContext(context.Background())
 }
 
-func (i *fargateProfilePtrType) ToFargateProfilePtrOutputWithContext(ctx context.Context) FargateProfilePtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(FargateProfilePtrOutput)
+func (i *nodeConfigInstancePtrType) ToNodeConfigInstancePtrOutputWithContext(ctx context.Context) NodeConfigInstancePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NodeConfigInstancePtrOutput)
 }
 
-// Defines how Kubernetes pods are executed in Fargate. See aws.eks.FargateProfileArgs for reference.
-type FargateProfileOutput struct{ *pulumi.OutputState }
+// Configuration of the EC2 instance in a nodeadm `NodeConfig`.
+type NodeConfigInstanceOutput struct{ *pulumi.OutputState }
 
-func (FargateProfileOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*FargateProfile)(nil)).Elem()
+func (NodeConfigInstanceOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*NodeConfigInstance)(nil)).Elem()
 }
 
-func (o FargateProfileOutput) ToFargateProfileOutput() FargateProfileOutput {
+func (o NodeConfigInstanceOutput) ToNodeConfigInstanceOutput() NodeConfigInstanceOutput {
 	return o
 }
 
-func (o FargateProfileOutput) ToFargateProfileOutputWithContext(ctx context.Context) FargateProfileOutput {
+func (o NodeConfigInstanceOutput) ToNodeConfigInstanceOutputWithContext(ctx context.Context) NodeConfigInstanceOutput {
 	return o
 }
 
-func (o FargateProfileOutput) ToFargateProfilePtrOutput() FargateProfilePtrOutput {
-	return o.ToFargateProfilePtrOutputWithContext(context.Background())
+func (o NodeConfigInstanceOutput) ToNodeConfigInstancePtrOutput() NodeConfigInstancePtrOutput {
+	return o.ToNodeConfigInstancePtrOutputWithContext(context.Background())
 }
 
-func (o FargateProfileOutput) ToFargateProfilePtrOutputWithContext(ctx context.Context) FargateProfilePtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v FargateProfile) *FargateProfile {
+func (o NodeConfigInstanceOutput) ToNodeConfigInstancePtrOutputWithContext(ctx context.Context) NodeConfigInstancePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v NodeConfigInstance) *NodeConfigInstance {
 		return &v
-	}).(FargateProfilePtrOutput)
-}
-
-// Specify a custom role to use for executing pods in Fargate. Defaults to creating a new role with the `arn:aws:iam::aws:policy/AmazonEKSFargatePodExecutionRolePolicy` policy attached.
-func (o FargateProfileOutput) PodExecutionRoleArn() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v FargateProfile) *string { return v.PodExecutionRoleArn }).(pulumi.StringPtrOutput)
-}
-
-// Specify the namespace and label selectors to use for launching pods into Fargate.
-func (o FargateProfileOutput) Selectors() eks.FargateProfileSelectorArrayOutput {
-	return o.ApplyT(func(v FargateProfile) []eks.FargateProfileSelector { return v.Selectors }).(eks.FargateProfileSelectorArrayOutput)
+	}).(NodeConfigInstancePtrOutput)
 }
 
-// Specify the subnets in which to execute Fargate tasks for pods. Defaults to the private subnets associated with the cluster.
-func (o FargateProfileOutput) SubnetIds() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v FargateProfile) []string { return v.SubnetIds }).(pulumi.StringArrayOutput)
+// Configuration of the local instance store volumes.
+func (o NodeConfigInstanceOutput) LocalStorage() NodeConfigLocalStoragePtrOutput {
+	return o.ApplyT(func(v NodeConfigInstance) *NodeConfigLocalStorage { return v.LocalStorage }).(NodeConfigLocalStoragePtrOutput)
 }
 
-type FargateProfilePtrOutput struct{ *pulumi.OutputState }
+type NodeConfigInstancePtrOutput struct{ *pulumi.OutputState }
 
-func (FargateProfilePtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**FargateProfile)(nil)).Elem()
+func (NodeConfigInstancePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**NodeConfigInstance)(nil)).Elem()
 }
 
-func (o FargateProfilePtrOutput) ToFargateProfilePtrOutput() FargateProfilePtrOutput {
+func (o NodeConfigInstancePtrOutput) ToNodeConfigInstancePtrOutput() NodeConfigInstancePtrOutput {
 	return o
 }
 
-func (o FargateProfilePtrOutput) ToFargateProfilePtrOutputWithContext(ctx context.Context) FargateProfilePtrOutput {
+func (o NodeConfigInstancePtrOutput) ToNodeConfigInstancePtrOutputWithContext(ctx context.Context) NodeConfigInstancePtrOutput {
 	return o
 }
 
-func (o FargateProfilePtrOutput) Elem() FargateProfileOutput {
-	return o.ApplyT(func(v *FargateProfile) FargateProfile {
+func (o NodeConfigInstancePtrOutput) Elem() NodeConfigInstanceOutput {
+	return o.ApplyT(func(v *NodeConfigInstance) NodeConfigInstance {
 		if v != nil {
 			return *v
 		}
-		var ret FargateProfile
+		var ret NodeConfigInstance
 		return ret
-	}).(FargateProfileOutput)
-}
-
-// Specify a custom role to use for executing pods in Fargate. Defaults to creating a new role with the `arn:aws:iam::aws:policy/AmazonEKSFargatePodExecutionRolePolicy` policy attached.
-func (o FargateProfilePtrOutput) PodExecutionRoleArn() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *FargateProfile) *string {
-		if v == nil {
-			return nil
-		}
-		return v.PodExecutionRoleArn
-	}).(pulumi.StringPtrOutput)
-}
-
-// Specify the namespace and label selectors to use for launching pods into Fargate.
-func (o FargateProfilePtrOutput) Selectors() eks.FargateProfileSelectorArrayOutput {
-	return o.ApplyT(func(v *FargateProfile) []eks.FargateProfileSelector {
-		if v == nil {
-			return nil
-		}
-		return v.Selectors
-	}).(eks.FargateProfileSelectorArrayOutput)
+	}).(NodeConfigInstanceOutput)
 }
 
-// Specify the subnets in which to execute Fargate tasks for pods. Defaults to the private subnets associated with the cluster.
-func (o FargateProfilePtrOutput) SubnetIds() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *FargateProfile) []string {
+// Configuration of the local instance store volumes.
+func (o NodeConfigInstancePtrOutput) LocalStorage() NodeConfigLocalStoragePtrOutput {
+	return o.ApplyT(func(v *NodeConfigInstance) *NodeConfigLocalStorage {
 		if v == nil {
 			return nil
 		}
-		return v.SubnetIds
-	}).(pulumi.StringArrayOutput)
-}
-
-type KubeProxyAddonOptions struct {
-	// Custom configuration values for the kube-proxy addon. This object must match the schema derived from [describe-addon-configuration](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-configuration.html).
-	ConfigurationValues map[string]interface{} `pulumi:"configurationValues"`
-	// Whether or not to create the `kube-proxy` Addon in the cluster. Defaults to true, unless `autoMode` is enabled.
-	Enabled *bool `pulumi:"enabled"`
-	// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
-	ResolveConflictsOnCreate *ResolveConflictsOnCreate `pulumi:"resolveConflictsOnCreate"`
-	// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value. Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
-	ResolveConflictsOnUpdate *ResolveConflictsOnUpdate `pulumi:"resolveConflictsOnUpdate"`
-	// The version of the EKS add-on. The version must match one of the versions returned by [describe-addon-versions](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-versions.html).
-	Version *string `pulumi:"version"`
+		return v.LocalStorage
+	}).(NodeConfigLocalStoragePtrOutput)
 }
 
-// Defaults sets the appropriate defaults for KubeProxyAddonOptions
-func (val *KubeProxyAddonOptions) Defaults() *KubeProxyAddonOptions {
-	if val == nil {
-		return nil
-	}
-	tmp := *val
-	if tmp.ResolveConflictsOnCreate == nil {
-		resolveConflictsOnCreate_ := ResolveConflictsOnCreate("OVERWRITE")
-		tmp.ResolveConflictsOnCreate = &resolveConflictsOnCreate_
-	}
-	if tmp.ResolveConflictsOnUpdate == nil {
-		resolveConflictsOnUpdate_ := ResolveConflictsOnUpdate("OVERWRITE")
-		tmp.ResolveConflictsOnUpdate = &resolveConflictsOnUpdate_
-	}
-	return &tmp
+// Configuration of the kubelet in a nodeadm `NodeConfig`.
+type NodeConfigKubelet struct {
+	// Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+	//
+	// See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+	Config map[string]interface{} `pulumi:"config"`
+	// Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+	Flags []string `pulumi:"flags"`
 }
 
-// KubeProxyAddonOptionsInput is an input type that accepts KubeProxyAddonOptionsArgs and KubeProxyAddonOptionsOutput values.
-// You can construct a concrete instance of `KubeProxyAddonOptionsInput` via:
+// NodeConfigKubeletInput is an input type that accepts NodeConfigKubeletArgs and NodeConfigKubeletOutput values.
+// You can construct a concrete instance of `NodeConfigKubeletInput` via:
 //
-//	KubeProxyAddonOptionsArgs{...}
-type KubeProxyAddonOptionsInput interface {
+//	NodeConfigKubeletArgs{...}
+type NodeConfigKubeletInput interface {
 	pulumi.Input
 
-	ToKubeProxyAddonOptionsOutput() KubeProxyAddonOptionsOutput
-	ToKubeProxyAddonOptionsOutputWithContext(context.Context) KubeProxyAddonOptionsOutput
+	ToNodeConfigKubeletOutput() NodeConfigKubeletOutput
+	ToNodeConfigKubeletOutputWithContext(context.Context) NodeConfigKubeletOutput
 }
 
-type KubeProxyAddonOptionsArgs struct {
-	// Custom configuration values for the kube-proxy addon. This object must match the schema derived from [describe-addon-configuration](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-configuration.html).
-	ConfigurationValues pulumi.MapInput `pulumi:"configurationValues"`
-	// Whether or not to create the `kube-proxy` Addon in the cluster. Defaults to true, unless `autoMode` is enabled.
-	Enabled *bool `pulumi:"enabled"`
-	// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
-	ResolveConflictsOnCreate *ResolveConflictsOnCreate `pulumi:"resolveConflictsOnCreate"`
-	// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value. Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
-	ResolveConflictsOnUpdate *ResolveConflictsOnUpdate `pulumi:"resolveConflictsOnUpdate"`
-	// The version of the EKS add-on. The version must match one of the versions returned by [describe-addon-versions](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-versions.html).
-	Version pulumi.StringPtrInput `pulumi:"version"`
+// Configuration of the kubelet in a nodeadm `NodeConfig`.
+type NodeConfigKubeletArgs struct {
+	// Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+	//
+	// See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+	Config pulumi.MapInput `pulumi:"config"`
+	// Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+	Flags pulumi.StringArrayInput `pulumi:"flags"`
 }
 
-// Defaults sets the appropriate defaults for KubeProxyAddonOptionsArgs
-func (val *KubeProxyAddonOptionsArgs) Defaults() *KubeProxyAddonOptionsArgs {
-	if val == nil {
-		return nil
-	}
-	tmp := *val
-	if tmp.ResolveConflictsOnCreate == nil {
-		resolveConflictsOnCreate_ := ResolveConflictsOnCreate("OVERWRITE")
-		tmp.ResolveConflictsOnCreate = &resolveConflictsOnCreate_
-	}
-	if tmp.ResolveConflictsOnUpdate == nil {
-		resolveConflictsOnUpdate_ := ResolveConflictsOnUpdate("OVERWRITE")
-		tmp.ResolveConflictsOnUpdate = &resolveConflictsOnUpdate_
-	}
-	return &tmp
-}
-func (KubeProxyAddonOptionsArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*KubeProxyAddonOptions)(nil)).Elem()
+func (NodeConfigKubeletArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*NodeConfigKubelet)(nil)).Elem()
 }
 
-func (i KubeProxyAddonOptionsArgs) ToKubeProxyAddonOptionsOutput() KubeProxyAddonOptionsOutput {
-	return i.ToKubeProxyAddonOptionsOutputWithContext(context.Background())
+func (i NodeConfigKubeletArgs) ToNodeConfigKubeletOutput() NodeConfigKubeletOutput {
+	return i.ToNodeConfigKubeletOutputWithContext(context.Background())
 }
 
-func (i KubeProxyAddonOptionsArgs) ToKubeProxyAddonOptionsOutputWithContext(ctx context.Context) KubeProxyAddonOptionsOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(KubeProxyAddonOptionsOutput)
+func (i NodeConfigKubeletArgs) ToNodeConfigKubeletOutputWithContext(ctx context.Context) NodeConfigKubeletOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NodeConfigKubeletOutput)
 }
 
-func (i KubeProxyAddonOptionsArgs) ToKubeProxyAddonOptionsPtrOutput() KubeProxyAddonOptionsPtrOutput {
-	return i.ToKubeProxyAddonOptionsPtrOutputWithContext(context.Background())
+func (i NodeConfigKubeletArgs) ToNodeConfigKubeletPtrOutput() NodeConfigKubeletPtrOutput {
+	return i.ToNodeConfigKubeletPtrOutputWithContext(context.Background())
 }
 
-func (i KubeProxyAddonOptionsArgs) ToKubeProxyAddonOptionsPtrOutputWithContext(ctx context.Context) KubeProxyAddonOptionsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(KubeProxyAddonOptionsOutput).ToKubeProxyAddonOptionsPtrOutputWithContext(ctx)
+func (i NodeConfigKubeletArgs) ToNodeConfigKubeletPtrOutputWithContext(ctx context.Context) NodeConfigKubeletPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NodeConfigKubeletOutput).ToNodeConfigKubeletPtrOutputWithContext(ctx)
 }
 
-// KubeProxyAddonOptionsPtrInput is an input type that accepts KubeProxyAddonOptionsArgs, KubeProxyAddonOptionsPtr and KubeProxyAddonOptionsPtrOutput values.
-// You can construct a concrete instance of `KubeProxyAddonOptionsPtrInput` via:
+// NodeConfigKubeletPtrInput is an input type that accepts NodeConfigKubeletArgs, NodeConfigKubeletPtr and NodeConfigKubeletPtrOutput values.
+// You can construct a concrete instance of `NodeConfigKubeletPtrInput` via:
 //
-//	        KubeProxyAddonOptionsArgs{...}
+//	        NodeConfigKubeletArgs{...}
 //
 //	or:
 //
 //	        nil
-type KubeProxyAddonOptionsPtrInput interface {
+type NodeConfigKubeletPtrInput interface {
 	pulumi.Input
 
-	ToKubeProxyAddonOptionsPtrOutput() KubeProxyAddonOptionsPtrOutput
-	ToKubeProxyAddonOptionsPtrOutputWithContext(context.Context) KubeProxyAddonOptionsPtrOutput
+	ToNodeConfigKubeletPtrOutput() NodeConfigKubeletPtrOutput
+	ToNodeConfigKubeletPtrOutputWithContext(context.Context) NodeConfigKubeletPtrOutput
 }
 
-type kubeProxyAddonOptionsPtrType KubeProxyAddonOptionsArgs
+type nodeConfigKubeletPtrType NodeConfigKubeletArgs
 
-func KubeProxyAddonOptionsPtr(v *KubeProxyAddonOptionsArgs) KubeProxyAddonOptionsPtrInput {
-	return (*kubeProxyAddonOptionsPtrType)(v)
+func NodeConfigKubeletPtr(v *NodeConfigKubeletArgs) NodeConfigKubeletPtrInput {
+	return (*nodeConfigKubeletPtrType)(v)
 }
 
-func (*kubeProxyAddonOptionsPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**KubeProxyAddonOptions)(nil)).Elem()
+func (*nodeConfigKubeletPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**NodeConfigKubelet)(nil)).Elem()
 }
 
-func (i *kubeProxyAddonOptionsPtrType) ToKubeProxyAddonOptionsPtrOutput() KubeProxyAddonOptionsPtrOutput {
-	return i.ToKubeProxyAddonOptionsPtrOutputWithContext(context.Background())
+func (i *nodeConfigKubeletPtrType) ToNodeConfigKubeletPtrOutput() NodeConfigKubeletPtrOutput {
+	return i.ToNodeConfigKubeletPtrOutputWithContext(context.Background())
 }
 
-func (i *kubeProxyAddonOptionsPtrType) ToKubeProxyAddonOptionsPtrOutputWithContext(ctx context.Context) KubeProxyAddonOptionsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(KubeProxyAddonOptionsPtrOutput)
+func (i *nodeConfigKubeletPtrType) ToNodeConfigKubeletPtrOutputWithContext(ctx context.Context) NodeConfigKubeletPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NodeConfigKubeletPtrOutput)
 }
 
-type KubeProxyAddonOptionsOutput struct{ *pulumi.OutputState }
+// Configuration of the kubelet in a nodeadm `NodeConfig`.
+type NodeConfigKubeletOutput struct{ *pulumi.OutputState }
 
-func (KubeProxyAddonOptionsOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*KubeProxyAddonOptions)(nil)).Elem()
+func (NodeConfigKubeletOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*NodeConfigKubelet)(nil)).Elem()
 }
 
-func (o KubeProxyAddonOptionsOutput) ToKubeProxyAddonOptionsOutput() KubeProxyAddonOptionsOutput {
+func (o NodeConfigKubeletOutput) ToNodeConfigKubeletOutput() NodeConfigKubeletOutput {
 	return o
 }
 
-func (o KubeProxyAddonOptionsOutput) ToKubeProxyAddonOptionsOutputWithContext(ctx context.Context) KubeProxyAddonOptionsOutput {
+func (o NodeConfigKubeletOutput) ToNodeConfigKubeletOutputWithContext(ctx context.Context) NodeConfigKubeletOutput {
 	return o
 }
 
-func (o KubeProxyAddonOptionsOutput) ToKubeProxyAddonOptionsPtrOutput() KubeProxyAddonOptionsPtrOutput {
-	return o.ToKubeProxyAddonOptionsPtrOutputWithContext(context.Background())
+func (o NodeConfigKubeletOutput) ToNodeConfigKubeletPtrOutput() NodeConfigKubeletPtrOutput {
+	return o.ToNodeConfigKubeletPtrOutputWithContext(context.Background())
 }
 
-func (o KubeProxyAddonOptionsOutput) ToKubeProxyAddonOptionsPtrOutputWithContext(ctx context.Context) KubeProxyAddonOptionsPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v KubeProxyAddonOptions) *KubeProxyAddonOptions {
+func (o NodeConfigKubeletOutput) ToNodeConfigKubeletPtrOutputWithContext(ctx context.Context) NodeConfigKubeletPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v NodeConfigKubelet) *NodeConfigKubelet {
 		return &v
-	}).(KubeProxyAddonOptionsPtrOutput)
-}
-
-// Custom configuration values for the kube-proxy addon. This object must match the schema derived from [describe-addon-configuration](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-configuration.html).
-func (o KubeProxyAddonOptionsOutput) ConfigurationValues() pulumi.MapOutput {
-	return o.ApplyT(func(v KubeProxyAddonOptions) map[string]interface{} { return v.ConfigurationValues }).(pulumi.MapOutput)
-}
-
-// Whether or not to create the `kube-proxy` Addon in the cluster. Defaults to true, unless `autoMode` is enabled.
-func (o KubeProxyAddonOptionsOutput) Enabled() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v KubeProxyAddonOptions) *bool { return v.Enabled }).(pulumi.BoolPtrOutput)
-}
-
-// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
-func (o KubeProxyAddonOptionsOutput) ResolveConflictsOnCreate() ResolveConflictsOnCreatePtrOutput {
-	return o.ApplyT(func(v KubeProxyAddonOptions) *ResolveConflictsOnCreate { return v.ResolveConflictsOnCreate }).(ResolveConflictsOnCreatePtrOutput)
+	}).(NodeConfigKubeletPtrOutput)
 }
 
-// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value. Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
-func (o KubeProxyAddonOptionsOutput) ResolveConflictsOnUpdate() ResolveConflictsOnUpdatePtrOutput {
-	return o.ApplyT(func(v KubeProxyAddonOptions) *ResolveConflictsOnUpdate { return v.ResolveConflictsOnUpdate }).(ResolveConflictsOnUpdatePtrOutput)
+// Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+//
+// See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+func (o NodeConfigKubeletOutput) Config() pulumi.MapOutput {
+	return o.ApplyT(func(v NodeConfigKubelet) map[string]interface{} { return v.Config }).(pulumi.MapOutput)
 }
 
-// The version of the EKS add-on. The version must match one of the versions returned by [describe-addon-versions](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-versions.html).
-func (o KubeProxyAddonOptionsOutput) Version() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v KubeProxyAddonOptions) *string { return v.Version }).(pulumi.StringPtrOutput)
+// Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+func (o NodeConfigKubeletOutput) Flags() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v NodeConfigKubelet) []string { return v.Flags }).(pulumi.StringArrayOutput)
 }
 
-type KubeProxyAddonOptionsPtrOutput struct{ *pulumi.OutputState }
+type NodeConfigKubeletPtrOutput struct{ *pulumi.OutputState }
 
-func (KubeProxyAddonOptionsPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**KubeProxyAddonOptions)(nil)).Elem()
+func (NodeConfigKubeletPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**NodeConfigKubelet)(nil)).Elem()
 }
 
-func (o KubeProxyAddonOptionsPtrOutput) ToKubeProxyAddonOptionsPtrOutput() KubeProxyAddonOptionsPtrOutput {
+func (o NodeConfigKubeletPtrOutput) ToNodeConfigKubeletPtrOutput() NodeConfigKubeletPtrOutput {
 	return o
 }
 
-func (o KubeProxyAddonOptionsPtrOutput) ToKubeProxyAddonOptionsPtrOutputWithContext(ctx context.Context) KubeProxyAddonOptionsPtrOutput {
+func (o NodeConfigKubeletPtrOutput) ToNodeConfigKubeletPtrOutputWithContext(ctx context.Context) NodeConfigKubeletPtrOutput {
 	return o
 }
 
-func (o KubeProxyAddonOptionsPtrOutput) Elem() KubeProxyAddonOptionsOutput {
-	return o.ApplyT(func(v *KubeProxyAddonOptions) KubeProxyAddonOptions {
+func (o NodeConfigKubeletPtrOutput) Elem() NodeConfigKubeletOutput {
+	return o.ApplyT(func(v *NodeConfigKubelet) NodeConfigKubelet {
 		if v != nil {
 			return *v
 		}
-		var ret KubeProxyAddonOptions
+		var ret NodeConfigKubelet
 		return ret
-	}).(KubeProxyAddonOptionsOutput)
+	}).(NodeConfigKubeletOutput)
 }
 
-// Custom configuration values for the kube-proxy addon. This object must match the schema derived from [describe-addon-configuration](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-configuration.html).
-func (o KubeProxyAddonOptionsPtrOutput) ConfigurationValues() pulumi.MapOutput {
-	return o.ApplyT(func(v *KubeProxyAddonOptions) map[string]interface{} {
+// Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+//
+// See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+func (o NodeConfigKubeletPtrOutput) Config() pulumi.MapOutput {
+	return o.ApplyT(func(v *NodeConfigKubelet) map[string]interface{} {
 		if v == nil {
 			return nil
 		}
-		return v.ConfigurationValues
+		return v.Config
 	}).(pulumi.MapOutput)
 }
 
-// Whether or not to create the `kube-proxy` Addon in the cluster. Defaults to true, unless `autoMode` is enabled.
-func (o KubeProxyAddonOptionsPtrOutput) Enabled() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *KubeProxyAddonOptions) *bool {
-		if v == nil {
-			return nil
-		}
-		return v.Enabled
-	}).(pulumi.BoolPtrOutput)
-}
-
-// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
-func (o KubeProxyAddonOptionsPtrOutput) ResolveConflictsOnCreate() ResolveConflictsOnCreatePtrOutput {
-	return o.ApplyT(func(v *KubeProxyAddonOptions) *ResolveConflictsOnCreate {
-		if v == nil {
-			return nil
-		}
-		return v.ResolveConflictsOnCreate
-	}).(ResolveConflictsOnCreatePtrOutput)
-}
-
-// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value. Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
-func (o KubeProxyAddonOptionsPtrOutput) ResolveConflictsOnUpdate() ResolveConflictsOnUpdatePtrOutput {
-	return o.ApplyT(func(v *KubeProxyAddonOptions) *ResolveConflictsOnUpdate {
-		if v == nil {
-			return nil
-		}
-		return v.ResolveConflictsOnUpdate
-	}).(ResolveConflictsOnUpdatePtrOutput)
-}
-
-// The version of the EKS add-on. The version must match one of the versions returned by [describe-addon-versions](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-versions.html).
-func (o KubeProxyAddonOptionsPtrOutput) Version() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *KubeProxyAddonOptions) *string {
+// Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+func (o NodeConfigKubeletPtrOutput) Flags() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *NodeConfigKubelet) []string {
 		if v == nil {
 			return nil
 		}
-		return v.Version
-	}).(pulumi.StringPtrOutput)
+		return v.Flags
+	}).(pulumi.StringArrayOutput)
 }
 
-// Represents the AWS credentials to scope a given kubeconfig when using a non-default credential chain.
-//
-// The options can be used independently, or additively.
-//
-// A scoped kubeconfig is necessary for certain auth scenarios. For example:
-//  1. Assume a role on the default account caller,
-//  2. Use an AWS creds profile instead of the default account caller,
-//  3. Use an AWS creds creds profile instead of the default account caller,
-//     and then assume a given role on the profile. This scenario is also
-//     possible by only using a profile, iff the profile includes a role to
-//     assume in its settings.
-//
-// See for more details:
-// - https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
-// - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-role.html
-// - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-profiles.html
-type KubeconfigOptions struct {
-	// AWS credential profile name to always use instead of the default AWS credential provider chain.
-	//
-	// The profile is passed to kubeconfig as an authentication environment setting.
-	ProfileName *string `pulumi:"profileName"`
-	// Role ARN to assume instead of the default AWS credential provider chain.
-	//
-	// The role is passed to kubeconfig as an authentication exec argument.
-	RoleArn *string `pulumi:"roleArn"`
+// Configuration of the local instance store volumes in a nodeadm `NodeConfig`.
+type NodeConfigLocalStorage struct {
+	// Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+	DisabledMounts []string `pulumi:"disabledMounts"`
+	// The path the instance store volumes are mounted at.
+	MountPath *string `pulumi:"mountPath"`
+	// How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+	Strategy string `pulumi:"strategy"`
 }
 
-// KubeconfigOptionsInput is an input type that accepts KubeconfigOptionsArgs and KubeconfigOptionsOutput values.
-// You can construct a concrete instance of `KubeconfigOptionsInput` via:
+// NodeConfigLocalStorageInput is an input type that accepts NodeConfigLocalStorageArgs and NodeConfigLocalStorageOutput values.
+// You can construct a concrete instance of `NodeConfigLocalStorageInput` via:
 //
-//	KubeconfigOptionsArgs{...}
-type KubeconfigOptionsInput interface {
+//	NodeConfigLocalStorageArgs{...}
+type NodeConfigLocalStorageInput interface {
 	pulumi.Input
 
-	ToKubeconfigOptionsOutput() KubeconfigOptionsOutput
-	ToKubeconfigOptionsOutputWithContext(context.Context) KubeconfigOptionsOutput
+	ToNodeConfigLocalStorageOutput() NodeConfigLocalStorageOutput
+	ToNodeConfigLocalStorageOutputWithContext(context.Context) NodeConfigLocalStorageOutput
 }
 
-// Represents the AWS credentials to scope a given kubeconfig when using a non-default credential chain.
-//
-// The options can be used independently, or additively.
-//
-// A scoped kubeconfig is necessary for certain auth scenarios. For example:
-//  1. Assume a role on the default account caller,
-//  2. Use an AWS creds profile instead of the default account caller,
-//  3. Use an AWS creds creds profile instead of the default account caller,
-//     and then assume a given role on the profile. This scenario is also
-//     possible by only using a profile, iff the profile includes a role to
-//     assume in its settings.
-//
-// See for more details:
-// - https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
-// - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-role.html
-// - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-profiles.html
-type KubeconfigOptionsArgs struct {
-	// AWS credential profile name to always use instead of the default AWS credential provider chain.
-	//
-	// The profile is passed to kubeconfig as an authentication environment setting.
-	ProfileName pulumi.StringPtrInput `pulumi:"profileName"`
-	// Role ARN to assume instead of the default AWS credential provider chain.
-	//
-	// The role is passed to kubeconfig as an authentication exec argument.
-	RoleArn pulumi.StringPtrInput `pulumi:"roleArn"`
+// Configuration of the local instance store volumes in a nodeadm `NodeConfig`.
+type NodeConfigLocalStorageArgs struct {
+	// Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+	DisabledMounts pulumi.StringArrayInput `pulumi:"disabledMounts"`
+	// The path the instance store volumes are mounted at.
+	MountPath pulumi.StringPtrInput `pulumi:"mountPath"`
+	// How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+	Strategy pulumi.StringInput `pulumi:"strategy"`
 }
 
-func (KubeconfigOptionsArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*KubeconfigOptions)(nil)).Elem()
+func (NodeConfigLocalStorageArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*NodeConfigLocalStorage)(nil)).Elem()
 }
 
-func (i KubeconfigOptionsArgs) ToKubeconfigOptionsOutput() KubeconfigOptionsOutput {
-	return i.ToKubeconfigOptionsOutputWithContext(context.Background())
+func (i NodeConfigLocalStorageArgs) ToNodeConfigLocalStorageOutput() NodeConfigLocalStorageOutput {
+	return i.ToNodeConfigLocalStorageOutputWithContext(context.Background())
 }
 
-func (i KubeconfigOptionsArgs) ToKubeconfigOptionsOutputWithContext(ctx context.Context) KubeconfigOptionsOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(KubeconfigOptionsOutput)
+func (i NodeConfigLocalStorageArgs) ToNodeConfigLocalStorageOutputWithContext(ctx context.Context) NodeConfigLocalStorageOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NodeConfigLocalStorageOutput)
 }
 
-func (i KubeconfigOptionsArgs) ToKubeconfigOptionsPtrOutput() KubeconfigOptionsPtrOutput {
-	return i.ToKubeconfigOptionsPtrOutputWithContext(context.Background())
+func (i NodeConfigLocalStorageArgs) ToNodeConfigLocalStoragePtrOutput() NodeConfigLocalStoragePtrOutput {
+	return i.ToNodeConfigLocalStoragePtrOutputWithContext(context.Background())
 }
 
-func (i KubeconfigOptionsArgs) ToKubeconfigOptionsPtrOutputWithContext(ctx context.Context) KubeconfigOptionsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(KubeconfigOptionsOutput).ToKubeconfigOptionsPtrOutputWithContext(ctx)
+func (i NodeConfigLocalStorageArgs) ToNodeConfigLocalStoragePtrOutputWithContext(ctx context.Context) NodeConfigLocalStoragePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NodeConfigLocalStorageOutput).ToNodeConfigLocalStoragePtrOutputWithContext(ctx)
 }
 
-// KubeconfigOptionsPtrInput is an input type that accepts KubeconfigOptionsArgs, KubeconfigOptionsPtr and KubeconfigOptionsPtrOutput values.
-// You can construct a concrete instance of `KubeconfigOptionsPtrInput` via:
+// NodeConfigLocalStoragePtrInput is an input type that accepts NodeConfigLocalStorageArgs, NodeConfigLocalStoragePtr and NodeConfigLocalStoragePtrOutput values.
+// You can construct a concrete instance of `NodeConfigLocalStoragePtrInput` via:
 //
-//	        KubeconfigOptionsArgs{...}
+//	        NodeConfigLocalStorageArgs{...}
 //
 //	or:
 //
 //	        nil
-type KubeconfigOptionsPtrInput interface {
+type NodeConfigLocalStoragePtrInput interface {
 	pulumi.Input
 
-	ToKubeconfigOptionsPtrOutput() KubeconfigOptionsPtrOutput
-	ToKubeconfigOptionsPtrOutputWithContext(context.Context) KubeconfigOptionsPtrOutput
+	ToNodeConfigLocalStoragePtrOutput() NodeConfigLocalStoragePtrOutput
+	ToNodeConfigLocalStoragePtrOutputWithContext(context.Context) NodeConfigLocalStoragePtrOutput
 }
 
-type kubeconfigOptionsPtrType KubeconfigOptionsArgs
+type nodeConfigLocalStoragePtrType NodeConfigLocalStorageArgs
 
-func KubeconfigOptionsPtr(v *KubeconfigOptionsArgs) KubeconfigOptionsPtrInput {
-	return (*kubeconfigOptionsPtrType)(v)
+func NodeConfigLocalStoragePtr(v *NodeConfigLocalStorageArgs) NodeConfigLocalStoragePtrInput {
+	return (*nodeConfigLocalStoragePtrType)(v)
 }
 
-func (*kubeconfigOptionsPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**KubeconfigOptions)(nil)).Elem()
+func (*nodeConfigLocalStoragePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**NodeConfigLocalStorage)(nil)).Elem()
 }
 
-func (i *kubeconfigOptionsPtrType) ToKubeconfigOptionsPtrOutput() KubeconfigOptionsPtrOutput {
-	return i.ToKubeconfigOptionsPtrOutputWithContext(context.Background())
+func (i *nodeConfigLocalStoragePtrType) ToNodeConfigLocalStoragePtrOutput() NodeConfigLocalStoragePtrOutput {
+	return i.ToNodeConfigLocalStoragePtrOutputWithContext(context.Background())
 }
 
-func (i *kubeconfigOptionsPtrType) ToKubeconfigOptionsPtrOutputWithContext(ctx context.Context) KubeconfigOptionsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(KubeconfigOptionsPtrOutput)
+func (i *nodeConfigLocalStoragePtrType) ToNodeConfigLocalStoragePtrOutputWithContext(ctx context.Context) NodeConfigLocalStoragePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NodeConfigLocalStoragePtrOutput)
 }
 
-// Represents the AWS credentials to scope a given kubeconfig when using a non-default credential chain.
-//
-// The options can be used independently, or additively.
-//
-// A scoped kubeconfig is necessary for certain auth scenarios. For example:
-//  1. Assume a role on the default account caller,
-//  2. Use an AWS creds profile instead of the default account caller,
-//  3. Use an AWS creds creds profile instead of the default account caller,
-//     and then assume a given role on the profile. This scenario is also
-//     possible by only using a profile, iff the profile includes a role to
-//     assume in its settings.
-//
-// See for more details:
-// - https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
-// - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-role.html
-// - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-profiles.html
-type KubeconfigOptionsOutput struct{ *pulumi.OutputState }
+// Configuration of the local instance store volumes in a nodeadm `NodeConfig`.
+type NodeConfigLocalStorageOutput struct{ *pulumi.OutputState }
 
-func (KubeconfigOptionsOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*KubeconfigOptions)(nil)).Elem()
+func (NodeConfigLocalStorageOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*NodeConfigLocalStorage)(nil)).Elem()
 }
 
-func (o KubeconfigOptionsOutput) ToKubeconfigOptionsOutput() KubeconfigOptionsOutput {
+func (o NodeConfigLocalStorageOutput) ToNodeConfigLocalStorageOutput() NodeConfigLocalStorageOutput {
 	return o
 }
 
-func (o KubeconfigOptionsOutput) ToKubeconfigOptionsOutputWithContext(ctx context.Context) KubeconfigOptionsOutput {
+func (o NodeConfigLocalStorageOutput) ToNodeConfigLocalStorageOutputWithContext(ctx context.Context) NodeConfigLocalStorageOutput {
 	return o
 }
 
-func (o KubeconfigOptionsOutput) ToKubeconfigOptionsPtrOutput() KubeconfigOptionsPtrOutput {
-	return o.ToKubeconfigOptionsPtrOutputWithContext(context.Background())
+func (o NodeConfigLocalStorageOutput) ToNodeConfigLocalStoragePtrOutput() NodeConfigLocalStoragePtrOutput {
+	return o.ToNodeConfigLocalStoragePtrOutputWithContext(context.Background())
 }
 
-func (o KubeconfigOptionsOutput) ToKubeconfigOptionsPtrOutputWithContext(ctx context.Context) KubeconfigOptionsPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v KubeconfigOptions) *KubeconfigOptions {
+func (o NodeConfigLocalStorageOutput) ToNodeConfigLocalStoragePtrOutputWithContext(ctx context.Context) NodeConfigLocalStoragePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v NodeConfigLocalStorage) *NodeConfigLocalStorage {
 		return &v
-	}).(KubeconfigOptionsPtrOutput)
+	}).(NodeConfigLocalStoragePtrOutput)
 }
 
-// AWS credential profile name to always use instead of the default AWS credential provider chain.
-//
-// The profile is passed to kubeconfig as an authentication environment setting.
-func (o KubeconfigOptionsOutput) ProfileName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v KubeconfigOptions) *string { return v.ProfileName }).(pulumi.StringPtrOutput)
+// Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+func (o NodeConfigLocalStorageOutput) DisabledMounts() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v NodeConfigLocalStorage) []string { return v.DisabledMounts }).(pulumi.StringArrayOutput)
 }
 
-// Role ARN to assume instead of the default AWS credential provider chain.
-//
-// The role is passed to kubeconfig as an authentication exec argument.
-func (o KubeconfigOptionsOutput) RoleArn() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v KubeconfigOptions) *string { return v.RoleArn }).(pulumi.StringPtrOutput)
+// The path the instance store volumes are mounted at.
+func (o NodeConfigLocalStorageOutput) MountPath() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v NodeConfigLocalStorage) *string { return v.MountPath }).(pulumi.StringPtrOutput)
 }
 
-type KubeconfigOptionsPtrOutput struct{ *pulumi.OutputState }
+// How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+func (o NodeConfigLocalStorageOutput) Strategy() pulumi.StringOutput {
+	return o.ApplyT(func(v NodeConfigLocalStorage) string { return v.Strategy }).(pulumi.StringOutput)
+}
 
-func (KubeconfigOptionsPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**KubeconfigOptions)(nil)).Elem()
+type NodeConfigLocalStoragePtrOutput struct{ *pulumi.OutputState }
+
+func (NodeConfigLocalStoragePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**NodeConfigLocalStorage)(nil)).Elem()
 }
 
-func (o KubeconfigOptionsPtrOutput) ToKubeconfigOptionsPtrOutput() KubeconfigOptionsPtrOutput {
+func (o NodeConfigLocalStoragePtrOutput) ToNodeConfigLocalStoragePtrOutput() NodeConfigLocalStoragePtrOutput {
 	return o
 }
 
-func (o KubeconfigOptionsPtrOutput) ToKubeconfigOptionsPtrOutputWithContext(ctx context.Context) KubeconfigOptionsPtrOutput {
+func (o NodeConfigLocalStoragePtrOutput) ToNodeConfigLocalStoragePtrOutputWithContext(ctx context.Context) NodeConfigLocalStoragePtrOutput {
 	return o
 }
 
-func (o KubeconfigOptionsPtrOutput) Elem() KubeconfigOptionsOutput {
-	return o.ApplyT(func(v *KubeconfigOptions) KubeconfigOptions {
+func (o NodeConfigLocalStoragePtrOutput) Elem() NodeConfigLocalStorageOutput {
+	return o.ApplyT(func(v *NodeConfigLocalStorage) NodeConfigLocalStorage {
 		if v != nil {
 			return *v
 		}
-		var ret KubeconfigOptions
+		var ret NodeConfigLocalStorage
 		return ret
-	}).(KubeconfigOptionsOutput)
+	}).(NodeConfigLocalStorageOutput)
 }
 
-// AWS credential profile name to always use instead of the default AWS credential provider chain.
-//
-// The profile is passed to kubeconfig as an authentication environment setting.
-func (o KubeconfigOptionsPtrOutput) ProfileName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *KubeconfigOptions) *string {
+// Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+func (o NodeConfigLocalStoragePtrOutput) DisabledMounts() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *NodeConfigLocalStorage) []string {
 		if v == nil {
 			return nil
 		}
-		return v.ProfileName
+		return v.DisabledMounts
+	}).(pulumi.StringArrayOutput)
+}
+
+// The path the instance store volumes are mounted at.
+func (o NodeConfigLocalStoragePtrOutput) MountPath() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *NodeConfigLocalStorage) *string {
+		if v == nil {
+			return nil
+		}
+		return v.MountPath
 	}).(pulumi.StringPtrOutput)
 }
 
-// Role ARN to assume instead of the default AWS credential provider chain.
-//
-// The role is passed to kubeconfig as an authentication exec argument.
-func (o KubeconfigOptionsPtrOutput) RoleArn() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *KubeconfigOptions) *string {
+// How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+func (o NodeConfigLocalStoragePtrOutput) Strategy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *NodeConfigLocalStorage) *string {
 		if v == nil {
 			return nil
 		}
-		return v.RoleArn
+		return &v.Strategy
 	}).(pulumi.StringPtrOutput)
 }
 
@@ -3952,6 +7640,165 @@ func (o UserMappingArrayOutput) Index(i pulumi.IntInput) UserMappingOutput {
 	}).(UserMappingOutput)
 }
 
+// The VPC CNI settings that affect the maximum number of pods of the nodes.
+type VpcCniNetworking struct {
+	// Whether pods get their IP addresses from the subnets of ENIConfigs.
+	CustomNetworking bool `pulumi:"customNetworking"`
+	// Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+	PrefixDelegation bool `pulumi:"prefixDelegation"`
+}
+
+// VpcCniNetworkingInput is an input type that accepts VpcCniNetworkingArgs and VpcCniNetworkingOutput values.
+// You can construct a concrete instance of `VpcCniNetworkingInput` via:
+//
+//	VpcCniNetworkingArgs{...}
+type VpcCniNetworkingInput interface {
+	pulumi.Input
+
+	ToVpcCniNetworkingOutput() VpcCniNetworkingOutput
+	ToVpcCniNetworkingOutputWithContext(context.Context) VpcCniNetworkingOutput
+}
+
+// The VPC CNI settings that affect the maximum number of pods of the nodes.
+type VpcCniNetworkingArgs struct {
+	// Whether pods get their IP addresses from the subnets of ENIConfigs.
+	CustomNetworking pulumi.BoolInput `pulumi:"customNetworking"`
+	// Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+	PrefixDelegation pulumi.BoolInput `pulumi:"prefixDelegation"`
+}
+
+func (VpcCniNetworkingArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*VpcCniNetworking)(nil)).Elem()
+}
+
+func (i VpcCniNetworkingArgs) ToVpcCniNetworkingOutput() VpcCniNetworkingOutput {
+	return i.ToVpcCniNetworkingOutputWithContext(context.Background())
+}
+
+func (i VpcCniNetworkingArgs) ToVpcCniNetworkingOutputWithContext(ctx context.Context) VpcCniNetworkingOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(VpcCniNetworkingOutput)
+}
+
+func (i VpcCniNetworkingArgs) ToVpcCniNetworkingPtrOutput() VpcCniNetworkingPtrOutput {
+	return i.ToVpcCniNetworkingPtrOutputWithContext(context.Background())
+}
+
+func (i VpcCniNetworkingArgs) ToVpcCniNetworkingPtrOutputWithContext(ctx context.Context) VpcCniNetworkingPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(VpcCniNetworkingOutput).ToVpcCniNetworkingPtrOutputWithContext(ctx)
+}
+
+// VpcCniNetworkingPtrInput is an input type that accepts VpcCniNetworkingArgs, VpcCniNetworkingPtr and VpcCniNetworkingPtrOutput values.
+// You can construct a concrete instance of `VpcCniNetworkingPtrInput` via:
+//
+//	        VpcCniNetworkingArgs{...}
+//
+//	or:
+//
+//	        nil
+type VpcCniNetworkingPtrInput interface {
+	pulumi.Input
+
+	ToVpcCniNetworkingPtrOutput() VpcCniNetworkingPtrOutput
+	ToVpcCniNetworkingPtrOutputWithContext(context.Context) VpcCniNetworkingPtrOutput
+}
+
+type vpcCniNetworkingPtrType VpcCniNetworkingArgs
+
+func VpcCniNetworkingPtr(v *VpcCniNetworkingArgs) VpcCniNetworkingPtrInput {
+	return (*vpcCniNetworkingPtrType)(v)
+}
+
+func (*vpcCniNetworkingPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**VpcCniNetworking)(nil)).Elem()
+}
+
+func (i *vpcCniNetworkingPtrType) ToVpcCniNetworkingPtrOutput() VpcCniNetworkingPtrOutput {
+	return i.ToVpcCniNetworkingPtrOutputWithContext(context.Background())
+}
+
+func (i *vpcCniNetworkingPtrType) ToVpcCniNetworkingPtrOutputWithContext(ctx context.Context) VpcCniNetworkingPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(VpcCniNetworkingPtrOutput)
+}
+
+// The VPC CNI settings that affect the maximum number of pods of the nodes.
+type VpcCniNetworkingOutput struct{ *pulumi.OutputState }
+
+func (VpcCniNetworkingOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*VpcCniNetworking)(nil)).Elem()
+}
+
+func (o VpcCniNetworkingOutput) ToVpcCniNetworkingOutput() VpcCniNetworkingOutput {
+	return o
+}
+
+func (o VpcCniNetworkingOutput) ToVpcCniNetworkingOutputWithContext(ctx context.Context) VpcCniNetworkingOutput {
+	return o
+}
+
+func (o VpcCniNetworkingOutput) ToVpcCniNetworkingPtrOutput() VpcCniNetworkingPtrOutput {
+	return o.ToVpcCniNetworkingPtrOutputWithContext(context.Background())
+}
+
+func (o VpcCniNetworkingOutput) ToVpcCniNetworkingPtrOutputWithContext(ctx context.Context) VpcCniNetworkingPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v VpcCniNetworking) *VpcCniNetworking {
+		return &v
+	}).(VpcCniNetworkingPtrOutput)
+}
+
+// Whether pods get their IP addresses from the subnets of ENIConfigs.
+func (o VpcCniNetworkingOutput) CustomNetworking() pulumi.BoolOutput {
+	return o.ApplyT(func(v VpcCniNetworking) bool { return v.CustomNetworking }).(pulumi.BoolOutput)
+}
+
+// Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+func (o VpcCniNetworkingOutput) PrefixDelegation() pulumi.BoolOutput {
+	return o.ApplyT(func(v VpcCniNetworking) bool { return v.PrefixDelegation }).(pulumi.BoolOutput)
+}
+
+type VpcCniNetworkingPtrOutput struct{ *pulumi.OutputState }
+
+func (VpcCniNetworkingPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**VpcCniNetworking)(nil)).Elem()
+}
+
+func (o VpcCniNetworkingPtrOutput) ToVpcCniNetworkingPtrOutput() VpcCniNetworkingPtrOutput {
+	return o
+}
+
+func (o VpcCniNetworkingPtrOutput) ToVpcCniNetworkingPtrOutputWithContext(ctx context.Context) VpcCniNetworkingPtrOutput {
+	return o
+}
+
+func (o VpcCniNetworkingPtrOutput) Elem() VpcCniNetworkingOutput {
+	return o.ApplyT(func(v *VpcCniNetworking) VpcCniNetworking {
+		if v != nil {
+			return *v
+		}
+		var ret VpcCniNetworking
+		return ret
+	}).(VpcCniNetworkingOutput)
+}
+
+// Whether pods get their IP addresses from the subnets of ENIConfigs.
+func (o VpcCniNetworkingPtrOutput) CustomNetworking() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *VpcCniNetworking) *bool {
+		if v == nil {
+			return nil
+		}
+		return &v.CustomNetworking
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+func (o VpcCniNetworkingPtrOutput) PrefixDelegation() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *VpcCniNetworking) *bool {
+		if v == nil {
+			return nil
+		}
+		return &v.PrefixDelegation
+	}).(pulumi.BoolPtrOutput)
+}
+
 // Describes the configuration options available for the Amazon VPC CNI plugin for Kubernetes.
 type VpcCniOptions struct {
 	// The version of the addon to use. If not specified, the latest version of the addon for the cluster's Kubernetes version will be used.
@@ -4004,6 +7851,16 @@ type VpcCniOptions struct {
 	//
 	// Defaults to true.
 	NodePortSupport *bool `pulumi:"nodePortSupport"`
+	// The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+	//
+	// Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+	PodSecurityGroupIds []string `pulumi:"podSecurityGroupIds"`
+	// Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+	//
+	// Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+	//
+	// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
+	PodSubnets map[string]string `pulumi:"podSubnets"`
 	// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
 	ResolveConflictsOnCreate *ResolveConflictsOnCreate `pulumi:"resolveConflictsOnCreate"`
 	// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value.  Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
@@ -4112,6 +7969,16 @@ type VpcCniOptionsArgs struct {
 	//
 	// Defaults to true.
 	NodePortSupport pulumi.BoolPtrInput `pulumi:"nodePortSupport"`
+	// The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+	//
+	// Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+	PodSecurityGroupIds pulumi.StringArrayInput `pulumi:"podSecurityGroupIds"`
+	// Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+	//
+	// Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+	//
+	// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
+	PodSubnets map[string]pulumi.StringInput `pulumi:"podSubnets"`
 	// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
 	ResolveConflictsOnCreate *ResolveConflictsOnCreate `pulumi:"resolveConflictsOnCreate"`
 	// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value.  Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
@@ -4332,6 +8199,22 @@ func (o VpcCniOptionsOutput) NodePortSupport() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v VpcCniOptions) *bool { return v.NodePortSupport }).(pulumi.BoolPtrOutput)
 }
 
+// The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+//
+// Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+func (o VpcCniOptionsOutput) PodSecurityGroupIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v VpcCniOptions) []string { return v.PodSecurityGroupIds }).(pulumi.StringArrayOutput)
+}
+
+// Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+//
+// Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+//
+// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
+func (o VpcCniOptionsOutput) PodSubnets() pulumi.StringMapOutput {
+	return o.ApplyT(func(v VpcCniOptions) map[string]string { return v.PodSubnets }).(pulumi.StringMapOutput)
+}
+
 // How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
 func (o VpcCniOptionsOutput) ResolveConflictsOnCreate() ResolveConflictsOnCreatePtrOutput {
 	return o.ApplyT(func(v VpcCniOptions) *ResolveConflictsOnCreate { return v.ResolveConflictsOnCreate }).(ResolveConflictsOnCreatePtrOutput)
@@ -4584,6 +8467,32 @@ func (o VpcCniOptionsPtrOutput) NodePortSupport() pulumi.BoolPtrOutput {
 	}).(pulumi.BoolPtrOutput)
 }
 
+// The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+//
+// Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+func (o VpcCniOptionsPtrOutput) PodSecurityGroupIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *VpcCniOptions) []string {
+		if v == nil {
+			return nil
+		}
+		return v.PodSecurityGroupIds
+	}).(pulumi.StringArrayOutput)
+}
+
+// Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+//
+// Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+//
+// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
+func (o VpcCniOptionsPtrOutput) PodSubnets() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *VpcCniOptions) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.PodSubnets
+	}).(pulumi.StringMapOutput)
+}
+
 // How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
 func (o VpcCniOptionsPtrOutput) ResolveConflictsOnCreate() ResolveConflictsOnCreatePtrOutput {
 	return o.ApplyT(func(v *VpcCniOptions) *ResolveConflictsOnCreate {
@@ -4679,8 +8588,34 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*AccessEntryArrayInput)(nil)).Elem(), AccessEntryArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*AccessPolicyAssociationInput)(nil)).Elem(), AccessPolicyAssociationArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*AccessPolicyAssociationMapInput)(nil)).Elem(), AccessPolicyAssociationMap{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AutoModeDisruptionInput)(nil)).Elem(), AutoModeDisruptionArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AutoModeDisruptionPtrInput)(nil)).Elem(), AutoModeDisruptionArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AutoModeDisruptionBudgetInput)(nil)).Elem(), AutoModeDisruptionBudgetArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AutoModeDisruptionBudgetArrayInput)(nil)).Elem(), AutoModeDisruptionBudgetArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AutoModeEphemeralStorageInput)(nil)).Elem(), AutoModeEphemeralStorageArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AutoModeEphemeralStoragePtrInput)(nil)).Elem(), AutoModeEphemeralStorageArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AutoModeNodeRequirementInput)(nil)).Elem(), AutoModeNodeRequirementArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AutoModeNodeRequirementArrayInput)(nil)).Elem(), AutoModeNodeRequirementArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*AutoModeOptionsInput)(nil)).Elem(), AutoModeOptionsArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*AutoModeOptionsPtrInput)(nil)).Elem(), AutoModeOptionsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AutoModeSelectorTermInput)(nil)).Elem(), AutoModeSelectorTermArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AutoModeSelectorTermArrayInput)(nil)).Elem(), AutoModeSelectorTermArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BottlerocketBootstrapContainerInput)(nil)).Elem(), BottlerocketBootstrapContainerArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BottlerocketBootstrapContainerMapInput)(nil)).Elem(), BottlerocketBootstrapContainerMap{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BottlerocketConfigInput)(nil)).Elem(), BottlerocketConfigArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BottlerocketConfigPtrInput)(nil)).Elem(), BottlerocketConfigArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BottlerocketContainerRegistryInput)(nil)).Elem(), BottlerocketContainerRegistryArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BottlerocketContainerRegistryPtrInput)(nil)).Elem(), BottlerocketContainerRegistryArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BottlerocketHostContainerInput)(nil)).Elem(), BottlerocketHostContainerArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BottlerocketHostContainerPtrInput)(nil)).Elem(), BottlerocketHostContainerArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BottlerocketHostContainersInput)(nil)).Elem(), BottlerocketHostContainersArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BottlerocketHostContainersPtrInput)(nil)).Elem(), BottlerocketHostContainersArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BottlerocketKernelSettingsInput)(nil)).Elem(), BottlerocketKernelSettingsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BottlerocketKernelSettingsPtrInput)(nil)).Elem(), BottlerocketKernelSettingsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BottlerocketKubernetesSettingsInput)(nil)).Elem(), BottlerocketKubernetesSettingsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BottlerocketKubernetesSettingsPtrInput)(nil)).Elem(), BottlerocketKubernetesSettingsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BottlerocketRegistryMirrorInput)(nil)).Elem(), BottlerocketRegistryMirrorArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BottlerocketRegistryMirrorArrayInput)(nil)).Elem(), BottlerocketRegistryMirrorArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ClusterComputeConfigInput)(nil)).Elem(), ClusterComputeConfigArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ClusterComputeConfigPtrInput)(nil)).Elem(), ClusterComputeConfigArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ClusterNodeGroupOptionsInput)(nil)).Elem(), ClusterNodeGroupOptionsArgs{})
@@ -4690,12 +8625,26 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*CoreDnsAddonOptionsPtrInput)(nil)).Elem(), CoreDnsAddonOptionsArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*CreationRoleProviderInput)(nil)).Elem(), CreationRoleProviderArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*CreationRoleProviderPtrInput)(nil)).Elem(), CreationRoleProviderArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EncryptionConfigOptionsInput)(nil)).Elem(), EncryptionConfigOptionsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EncryptionConfigOptionsPtrInput)(nil)).Elem(), EncryptionConfigOptionsArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*FargateProfileInput)(nil)).Elem(), FargateProfileArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*FargateProfilePtrInput)(nil)).Elem(), FargateProfileArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*InterruptionHandlingInput)(nil)).Elem(), InterruptionHandlingArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*InterruptionHandlingPtrInput)(nil)).Elem(), InterruptionHandlingArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*KubeProxyAddonOptionsInput)(nil)).Elem(), KubeProxyAddonOptionsArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*KubeProxyAddonOptionsPtrInput)(nil)).Elem(), KubeProxyAddonOptionsArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*KubeconfigOptionsInput)(nil)).Elem(), KubeconfigOptionsArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*KubeconfigOptionsPtrInput)(nil)).Elem(), KubeconfigOptionsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NodeConfigInput)(nil)).Elem(), NodeConfigArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NodeConfigPtrInput)(nil)).Elem(), NodeConfigArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NodeConfigContainerdInput)(nil)).Elem(), NodeConfigContainerdArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NodeConfigContainerdPtrInput)(nil)).Elem(), NodeConfigContainerdArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NodeConfigInstanceInput)(nil)).Elem(), NodeConfigInstanceArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NodeConfigInstancePtrInput)(nil)).Elem(), NodeConfigInstanceArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NodeConfigKubeletInput)(nil)).Elem(), NodeConfigKubeletArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NodeConfigKubeletPtrInput)(nil)).Elem(), NodeConfigKubeletArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NodeConfigLocalStorageInput)(nil)).Elem(), NodeConfigLocalStorageArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NodeConfigLocalStoragePtrInput)(nil)).Elem(), NodeConfigLocalStorageArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*NodeadmOptionsInput)(nil)).Elem(), NodeadmOptionsArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*NodeadmOptionsArrayInput)(nil)).Elem(), NodeadmOptionsArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*RoleMappingInput)(nil)).Elem(), RoleMappingArgs{})
@@ -4706,14 +8655,42 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*TaintMapInput)(nil)).Elem(), TaintMap{})
 	pulumi.RegisterInputType(reflect.TypeOf((*UserMappingInput)(nil)).Elem(), UserMappingArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*UserMappingArrayInput)(nil)).Elem(), UserMappingArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*VpcCniNetworkingInput)(nil)).Elem(), VpcCniNetworkingArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*VpcCniNetworkingPtrInput)(nil)).Elem(), VpcCniNetworkingArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*VpcCniOptionsInput)(nil)).Elem(), VpcCniOptionsArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*VpcCniOptionsPtrInput)(nil)).Elem(), VpcCniOptionsArgs{})
 	pulumi.RegisterOutputType(AccessEntryOutput{})
 	pulumi.RegisterOutputType(AccessEntryArrayOutput{})
 	pulumi.RegisterOutputType(AccessPolicyAssociationOutput{})
 	pulumi.RegisterOutputType(AccessPolicyAssociationMapOutput{})
+	pulumi.RegisterOutputType(AutoModeDisruptionOutput{})
+	pulumi.RegisterOutputType(AutoModeDisruptionPtrOutput{})
+	pulumi.RegisterOutputType(AutoModeDisruptionBudgetOutput{})
+	pulumi.RegisterOutputType(AutoModeDisruptionBudgetArrayOutput{})
+	pulumi.RegisterOutputType(AutoModeEphemeralStorageOutput{})
+	pulumi.RegisterOutputType(AutoModeEphemeralStoragePtrOutput{})
+	pulumi.RegisterOutputType(AutoModeNodeRequirementOutput{})
+	pulumi.RegisterOutputType(AutoModeNodeRequirementArrayOutput{})
 	pulumi.RegisterOutputType(AutoModeOptionsOutput{})
 	pulumi.RegisterOutputType(AutoModeOptionsPtrOutput{})
+	pulumi.RegisterOutputType(AutoModeSelectorTermOutput{})
+	pulumi.RegisterOutputType(AutoModeSelectorTermArrayOutput{})
+	pulumi.RegisterOutputType(BottlerocketBootstrapContainerOutput{})
+	pulumi.RegisterOutputType(BottlerocketBootstrapContainerMapOutput{})
+	pulumi.RegisterOutputType(BottlerocketConfigOutput{})
+	pulumi.RegisterOutputType(BottlerocketConfigPtrOutput{})
+	pulumi.RegisterOutputType(BottlerocketContainerRegistryOutput{})
+	pulumi.RegisterOutputType(BottlerocketContainerRegistryPtrOutput{})
+	pulumi.RegisterOutputType(BottlerocketHostContainerOutput{})
+	pulumi.RegisterOutputType(BottlerocketHostContainerPtrOutput{})
+	pulumi.RegisterOutputType(BottlerocketHostContainersOutput{})
+	pulumi.RegisterOutputType(BottlerocketHostContainersPtrOutput{})
+	pulumi.RegisterOutputType(BottlerocketKernelSettingsOutput{})
+	pulumi.RegisterOutputType(BottlerocketKernelSettingsPtrOutput{})
+	pulumi.RegisterOutputType(BottlerocketKubernetesSettingsOutput{})
+	pulumi.RegisterOutputType(BottlerocketKubernetesSettingsPtrOutput{})
+	pulumi.RegisterOutputType(BottlerocketRegistryMirrorOutput{})
+	pulumi.RegisterOutputType(BottlerocketRegistryMirrorArrayOutput{})
 	pulumi.RegisterOutputType(ClusterComputeConfigOutput{})
 	pulumi.RegisterOutputType(ClusterComputeConfigPtrOutput{})
 	pulumi.RegisterOutputType(ClusterNodeGroupOptionsOutput{})
@@ -4723,12 +8700,26 @@ func init() {
 	pulumi.RegisterOutputType(CoreDnsAddonOptionsPtrOutput{})
 	pulumi.RegisterOutputType(CreationRoleProviderOutput{})
 	pulumi.RegisterOutputType(CreationRoleProviderPtrOutput{})
+	pulumi.RegisterOutputType(EncryptionConfigOptionsOutput{})
+	pulumi.RegisterOutputType(EncryptionConfigOptionsPtrOutput{})
 	pulumi.RegisterOutputType(FargateProfileOutput{})
 	pulumi.RegisterOutputType(FargateProfilePtrOutput{})
+	pulumi.RegisterOutputType(InterruptionHandlingOutput{})
+	pulumi.RegisterOutputType(InterruptionHandlingPtrOutput{})
 	pulumi.RegisterOutputType(KubeProxyAddonOptionsOutput{})
 	pulumi.RegisterOutputType(KubeProxyAddonOptionsPtrOutput{})
 	pulumi.RegisterOutputType(KubeconfigOptionsOutput{})
 	pulumi.RegisterOutputType(KubeconfigOptionsPtrOutput{})
+	pulumi.RegisterOutputType(NodeConfigOutput{})
+	pulumi.RegisterOutputType(NodeConfigPtrOutput{})
+	pulumi.RegisterOutputType(NodeConfigContainerdOutput{})
+	pulumi.RegisterOutputType(NodeConfigContainerdPtrOutput{})
+	pulumi.RegisterOutputType(NodeConfigInstanceOutput{})
+	pulumi.RegisterOutputType(NodeConfigInstancePtrOutput{})
+	pulumi.RegisterOutputType(NodeConfigKubeletOutput{})
+	pulumi.RegisterOutputType(NodeConfigKubeletPtrOutput{})
+	pulumi.RegisterOutputType(NodeConfigLocalStorageOutput{})
+	pulumi.RegisterOutputType(NodeConfigLocalStoragePtrOutput{})
 	pulumi.RegisterOutputType(NodeGroupDataOutput{})
 	pulumi.RegisterOutputType(NodeGroupDataPtrOutput{})
 	pulumi.RegisterOutputType(NodeadmOptionsOutput{})
@@ -4741,6 +8732,8 @@ func init() {
 	pulumi.RegisterOutputType(TaintMapOutput{})
 	pulumi.RegisterOutputType(UserMappingOutput{})
 	pulumi.RegisterOutputType(UserMappingArrayOutput{})
+	pulumi.RegisterOutputType(VpcCniNetworkingOutput{})
+	pulumi.RegisterOutputType(VpcCniNetworkingPtrOutput{})
 	pulumi.RegisterOutputType(VpcCniOptionsOutput{})
 	pulumi.RegisterOutputType(VpcCniOptionsPtrOutput{})
 }
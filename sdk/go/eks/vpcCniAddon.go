@@ -106,6 +106,16 @@ type vpcCniAddonArgs struct {
 	//
 	// Defaults to true.
 	NodePortSupport *bool `pulumi:"nodePortSupport"`
+	// The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+	//
+	// Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+	PodSecurityGroupIds []string `pulumi:"podSecurityGroupIds"`
+	// Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+	//
+	// Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+	//
+	// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
+	PodSubnets map[string]string `pulumi:"podSubnets"`
 	// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
 	ResolveConflictsOnCreate *ResolveConflictsOnCreate `pulumi:"resolveConflictsOnCreate"`
 	// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value.  Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
@@ -192,6 +202,16 @@ type VpcCniAddonArgs struct {
 	//
 	// Defaults to true.
 	NodePortSupport pulumi.BoolPtrInput
+	// The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+	//
+	// Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+	PodSecurityGroupIds pulumi.StringArrayInput
+	// Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+	//
+	// Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+	//
+	// See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
+	PodSubnets map[string]pulumi.StringInput
 	// How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
 	ResolveConflictsOnCreate *ResolveConflictsOnCreate
 	// How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value.  Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
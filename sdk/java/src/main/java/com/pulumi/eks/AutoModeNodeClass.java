@@ -0,0 +1,107 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks;
+
+import com.pulumi.aws.eks.AccessEntry;
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Export;
+import com.pulumi.core.annotations.ResourceType;
+import com.pulumi.core.internal.Codegen;
+import com.pulumi.eks.AutoModeNodeClassArgs;
+import com.pulumi.eks.Utilities;
+import com.pulumi.kubernetes.apiextensions.CustomResource;
+import java.lang.String;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+/**
+ * AutoModeNodeClass creates an EKS Auto Mode NodeClass, which configures the networking, storage and IAM role of the nodes of the node pools that reference it.
+ * For more information see: https://docs.aws.amazon.com/eks/latest/userguide/create-node-class.html
+ * 
+ */
+@ResourceType(type="eks:index:AutoModeNodeClass")
+public class AutoModeNodeClass extends com.pulumi.resources.ComponentResource {
+    /**
+     * The access entry of the custom node role, if `nodeRoleArn` is set.
+     * 
+     */
+    @Export(name="accessEntry", refs={AccessEntry.class}, tree="[0]")
+    private Output</* @Nullable */ AccessEntry> accessEntry;
+
+    /**
+     * @return The access entry of the custom node role, if `nodeRoleArn` is set.
+     * 
+     */
+    public Output<Optional<AccessEntry>> accessEntry() {
+        return Codegen.optional(this.accessEntry);
+    }
+    /**
+     * The NodeClass custom resource.
+     * 
+     */
+    @Export(name="nodeClass", refs={CustomResource.class}, tree="[0]")
+    private Output<CustomResource> nodeClass;
+
+    /**
+     * @return The NodeClass custom resource.
+     * 
+     */
+    public Output<CustomResource> nodeClass() {
+        return this.nodeClass;
+    }
+    /**
+     * The name of the NodeClass, which node pools reference with `nodeClassName`.
+     * 
+     */
+    @Export(name="nodeClassName", refs={String.class}, tree="[0]")
+    private Output<String> nodeClassName;
+
+    /**
+     * @return The name of the NodeClass, which node pools reference with `nodeClassName`.
+     * 
+     */
+    public Output<String> nodeClassName() {
+        return this.nodeClassName;
+    }
+
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     */
+    public AutoModeNodeClass(java.lang.String name) {
+        this(name, AutoModeNodeClassArgs.Empty);
+    }
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param args The arguments to use to populate this resource's properties.
+     */
+    public AutoModeNodeClass(java.lang.String name, AutoModeNodeClassArgs args) {
+        this(name, args, null);
+    }
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param options A bag of options that control this resource's behavior.
+     */
+    public AutoModeNodeClass(java.lang.String name, AutoModeNodeClassArgs args, @Nullable com.pulumi.resources.ComponentResourceOptions options) {
+        super("eks:index:AutoModeNodeClass", name, makeArgs(args, options), makeResourceOptions(options, Codegen.empty()), true);
+    }
+
+    private static AutoModeNodeClassArgs makeArgs(AutoModeNodeClassArgs args, @Nullable com.pulumi.resources.ComponentResourceOptions options) {
+        if (options != null && options.getUrn().isPresent()) {
+            return null;
+        }
+        return args == null ? AutoModeNodeClassArgs.Empty : args;
+    }
+
+    private static com.pulumi.resources.ComponentResourceOptions makeResourceOptions(@Nullable com.pulumi.resources.ComponentResourceOptions options, @Nullable Output<java.lang.String> id) {
+        var defaultOptions = com.pulumi.resources.ComponentResourceOptions.builder()
+            .version(Utilities.getVersion())
+            .build();
+        return com.pulumi.resources.ComponentResourceOptions.merge(defaultOptions, options, id);
+    }
+
+}
@@ -0,0 +1,416 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.eks.Cluster;
+import com.pulumi.eks.inputs.AutoModeEphemeralStorageArgs;
+import com.pulumi.eks.inputs.AutoModeSelectorTermArgs;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.String;
+import java.util.List;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class AutoModeNodeClassArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final AutoModeNodeClassArgs Empty = new AutoModeNodeClassArgs();
+
+    /**
+     * The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+     * 
+     */
+    @Import(name="cluster", required=true)
+    private Output<Cluster> cluster;
+
+    /**
+     * @return The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+     * 
+     */
+    public Output<Cluster> cluster() {
+        return this.cluster;
+    }
+
+    /**
+     * The ephemeral storage of the nodes.
+     * 
+     */
+    @Import(name="ephemeralStorage")
+    private @Nullable Output<AutoModeEphemeralStorageArgs> ephemeralStorage;
+
+    /**
+     * @return The ephemeral storage of the nodes.
+     * 
+     */
+    public Optional<Output<AutoModeEphemeralStorageArgs>> ephemeralStorage() {
+        return Optional.ofNullable(this.ephemeralStorage);
+    }
+
+    /**
+     * The default network policy of the pods, `DefaultAllow` or `DefaultDeny`. Defaults to `DefaultAllow`.
+     * 
+     */
+    @Import(name="networkPolicy")
+    private @Nullable Output<String> networkPolicy;
+
+    /**
+     * @return The default network policy of the pods, `DefaultAllow` or `DefaultDeny`. Defaults to `DefaultAllow`.
+     * 
+     */
+    public Optional<Output<String>> networkPolicy() {
+        return Optional.ofNullable(this.networkPolicy);
+    }
+
+    /**
+     * Whether to log the network policy decisions, `Enabled` or `Disabled`. Defaults to `Disabled`.
+     * 
+     */
+    @Import(name="networkPolicyEventLogs")
+    private @Nullable Output<String> networkPolicyEventLogs;
+
+    /**
+     * @return Whether to log the network policy decisions, `Enabled` or `Disabled`. Defaults to `Disabled`.
+     * 
+     */
+    public Optional<Output<String>> networkPolicyEventLogs() {
+        return Optional.ofNullable(this.networkPolicyEventLogs);
+    }
+
+    /**
+     * The ARN of the IAM role of the nodes. Defaults to the node role of EKS Auto Mode created by the cluster.
+     * 
+     * An access entry of type `EC2` with the `AmazonEKSAutoNodePolicy` access policy is created for custom node roles, which allows their nodes to join the cluster.
+     * 
+     */
+    @Import(name="nodeRoleArn")
+    private @Nullable Output<String> nodeRoleArn;
+
+    /**
+     * @return The ARN of the IAM role of the nodes. Defaults to the node role of EKS Auto Mode created by the cluster.
+     * 
+     * An access entry of type `EC2` with the `AmazonEKSAutoNodePolicy` access policy is created for custom node roles, which allows their nodes to join the cluster.
+     * 
+     */
+    public Optional<Output<String>> nodeRoleArn() {
+        return Optional.ofNullable(this.nodeRoleArn);
+    }
+
+    /**
+     * The security groups of the nodes. Defaults to the cluster security group.
+     * 
+     */
+    @Import(name="securityGroupSelectorTerms")
+    private @Nullable Output<List<AutoModeSelectorTermArgs>> securityGroupSelectorTerms;
+
+    /**
+     * @return The security groups of the nodes. Defaults to the cluster security group.
+     * 
+     */
+    public Optional<Output<List<AutoModeSelectorTermArgs>>> securityGroupSelectorTerms() {
+        return Optional.ofNullable(this.securityGroupSelectorTerms);
+    }
+
+    /**
+     * The source NAT policy of the pod traffic leaving the VPC, `Random` or `Disabled`. Defaults to `Random`.
+     * 
+     */
+    @Import(name="snatPolicy")
+    private @Nullable Output<String> snatPolicy;
+
+    /**
+     * @return The source NAT policy of the pod traffic leaving the VPC, `Random` or `Disabled`. Defaults to `Random`.
+     * 
+     */
+    public Optional<Output<String>> snatPolicy() {
+        return Optional.ofNullable(this.snatPolicy);
+    }
+
+    /**
+     * The subnets to launch nodes into. Defaults to the subnets of the cluster.
+     * 
+     */
+    @Import(name="subnetSelectorTerms")
+    private @Nullable Output<List<AutoModeSelectorTermArgs>> subnetSelectorTerms;
+
+    /**
+     * @return The subnets to launch nodes into. Defaults to the subnets of the cluster.
+     * 
+     */
+    public Optional<Output<List<AutoModeSelectorTermArgs>>> subnetSelectorTerms() {
+        return Optional.ofNullable(this.subnetSelectorTerms);
+    }
+
+    /**
+     * The tags to apply to the EC2 resources created for the nodes.
+     * 
+     */
+    @Import(name="tags")
+    private @Nullable Output<Map<String,String>> tags;
+
+    /**
+     * @return The tags to apply to the EC2 resources created for the nodes.
+     * 
+     */
+    public Optional<Output<Map<String,String>>> tags() {
+        return Optional.ofNullable(this.tags);
+    }
+
+    private AutoModeNodeClassArgs() {}
+
+    private AutoModeNodeClassArgs(AutoModeNodeClassArgs $) {
+        this.cluster = $.cluster;
+        this.ephemeralStorage = $.ephemeralStorage;
+        this.networkPolicy = $.networkPolicy;
+        this.networkPolicyEventLogs = $.networkPolicyEventLogs;
+        this.nodeRoleArn = $.nodeRoleArn;
+        this.securityGroupSelectorTerms = $.securityGroupSelectorTerms;
+        this.snatPolicy = $.snatPolicy;
+        this.subnetSelectorTerms = $.subnetSelectorTerms;
+        this.tags = $.tags;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(AutoModeNodeClassArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private AutoModeNodeClassArgs $;
+
+        public Builder() {
+            $ = new AutoModeNodeClassArgs();
+        }
+
+        public Builder(AutoModeNodeClassArgs defaults) {
+            $ = new AutoModeNodeClassArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param cluster The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder cluster(Output<Cluster> cluster) {
+            $.cluster = cluster;
+            return this;
+        }
+
+        /**
+         * @param cluster The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder cluster(Cluster cluster) {
+            return cluster(Output.of(cluster));
+        }
+
+        /**
+         * @param ephemeralStorage The ephemeral storage of the nodes.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder ephemeralStorage(@Nullable Output<AutoModeEphemeralStorageArgs> ephemeralStorage) {
+            $.ephemeralStorage = ephemeralStorage;
+            return this;
+        }
+
+        /**
+         * @param ephemeralStorage The ephemeral storage of the nodes.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder ephemeralStorage(AutoModeEphemeralStorageArgs ephemeralStorage) {
+            return ephemeralStorage(Output.of(ephemeralStorage));
+        }
+
+        /**
+         * @param networkPolicy The default network policy of the pods, `DefaultAllow` or `DefaultDeny`. Defaults to `DefaultAllow`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder networkPolicy(@Nullable Output<String> networkPolicy) {
+            $.networkPolicy = networkPolicy;
+            return this;
+        }
+
+        /**
+         * @param networkPolicy The default network policy of the pods, `DefaultAllow` or `DefaultDeny`. Defaults to `DefaultAllow`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder networkPolicy(String networkPolicy) {
+            return networkPolicy(Output.of(networkPolicy));
+        }
+
+        /**
+         * @param networkPolicyEventLogs Whether to log the network policy decisions, `Enabled` or `Disabled`. Defaults to `Disabled`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder networkPolicyEventLogs(@Nullable Output<String> networkPolicyEventLogs) {
+            $.networkPolicyEventLogs = networkPolicyEventLogs;
+            return this;
+        }
+
+        /**
+         * @param networkPolicyEventLogs Whether to log the network policy decisions, `Enabled` or `Disabled`. Defaults to `Disabled`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder networkPolicyEventLogs(String networkPolicyEventLogs) {
+            return networkPolicyEventLogs(Output.of(networkPolicyEventLogs));
+        }
+
+        /**
+         * @param nodeRoleArn The ARN of the IAM role of the nodes. Defaults to the node role of EKS Auto Mode created by the cluster.
+         * 
+         * An access entry of type `EC2` with the `AmazonEKSAutoNodePolicy` access policy is created for custom node roles, which allows their nodes to join the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder nodeRoleArn(@Nullable Output<String> nodeRoleArn) {
+            $.nodeRoleArn = nodeRoleArn;
+            return this;
+        }
+
+        /**
+         * @param nodeRoleArn The ARN of the IAM role of the nodes. Defaults to the node role of EKS Auto Mode created by the cluster.
+         * 
+         * An access entry of type `EC2` with the `AmazonEKSAutoNodePolicy` access policy is created for custom node roles, which allows their nodes to join the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder nodeRoleArn(String nodeRoleArn) {
+            return nodeRoleArn(Output.of(nodeRoleArn));
+        }
+
+        /**
+         * @param securityGroupSelectorTerms The security groups of the nodes. Defaults to the cluster security group.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder securityGroupSelectorTerms(@Nullable Output<List<AutoModeSelectorTermArgs>> securityGroupSelectorTerms) {
+            $.securityGroupSelectorTerms = securityGroupSelectorTerms;
+            return this;
+        }
+
+        /**
+         * @param securityGroupSelectorTerms The security groups of the nodes. Defaults to the cluster security group.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder securityGroupSelectorTerms(List<AutoModeSelectorTermArgs> securityGroupSelectorTerms) {
+            return securityGroupSelectorTerms(Output.of(securityGroupSelectorTerms));
+        }
+
+        /**
+         * @param securityGroupSelectorTerms The security groups of the nodes. Defaults to the cluster security group.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder securityGroupSelectorTerms(AutoModeSelectorTermArgs... securityGroupSelectorTerms) {
+            return securityGroupSelectorTerms(List.of(securityGroupSelectorTerms));
+        }
+
+        /**
+         * @param snatPolicy The source NAT policy of the pod traffic leaving the VPC, `Random` or `Disabled`. Defaults to `Random`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder snatPolicy(@Nullable Output<String> snatPolicy) {
+            $.snatPolicy = snatPolicy;
+            return this;
+        }
+
+        /**
+         * @param snatPolicy The source NAT policy of the pod traffic leaving the VPC, `Random` or `Disabled`. Defaults to `Random`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder snatPolicy(String snatPolicy) {
+            return snatPolicy(Output.of(snatPolicy));
+        }
+
+        /**
+         * @param subnetSelectorTerms The subnets to launch nodes into. Defaults to the subnets of the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder subnetSelectorTerms(@Nullable Output<List<AutoModeSelectorTermArgs>> subnetSelectorTerms) {
+            $.subnetSelectorTerms = subnetSelectorTerms;
+            return this;
+        }
+
+        /**
+         * @param subnetSelectorTerms The subnets to launch nodes into. Defaults to the subnets of the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder subnetSelectorTerms(List<AutoModeSelectorTermArgs> subnetSelectorTerms) {
+            return subnetSelectorTerms(Output.of(subnetSelectorTerms));
+        }
+
+        /**
+         * @param subnetSelectorTerms The subnets to launch nodes into. Defaults to the subnets of the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder subnetSelectorTerms(AutoModeSelectorTermArgs... subnetSelectorTerms) {
+            return subnetSelectorTerms(List.of(subnetSelectorTerms));
+        }
+
+        /**
+         * @param tags The tags to apply to the EC2 resources created for the nodes.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder tags(@Nullable Output<Map<String,String>> tags) {
+            $.tags = tags;
+            return this;
+        }
+
+        /**
+         * @param tags The tags to apply to the EC2 resources created for the nodes.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder tags(Map<String,String> tags) {
+            return tags(Output.of(tags));
+        }
+
+        public AutoModeNodeClassArgs build() {
+            if ($.cluster == null) {
+                throw new MissingRequiredPropertyException("AutoModeNodeClassArgs", "cluster");
+            }
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,91 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Export;
+import com.pulumi.core.annotations.ResourceType;
+import com.pulumi.core.internal.Codegen;
+import com.pulumi.eks.AutoModeNodePoolArgs;
+import com.pulumi.eks.Utilities;
+import com.pulumi.kubernetes.apiextensions.CustomResource;
+import java.lang.String;
+import javax.annotation.Nullable;
+
+/**
+ * AutoModeNodePool creates an EKS Auto Mode NodePool, which defines which nodes EKS Auto Mode launches for pending pods and when it disrupts them.
+ * For more information see: https://docs.aws.amazon.com/eks/latest/userguide/create-node-pool.html
+ * 
+ */
+@ResourceType(type="eks:index:AutoModeNodePool")
+public class AutoModeNodePool extends com.pulumi.resources.ComponentResource {
+    /**
+     * The NodePool custom resource.
+     * 
+     */
+    @Export(name="nodePool", refs={CustomResource.class}, tree="[0]")
+    private Output<CustomResource> nodePool;
+
+    /**
+     * @return The NodePool custom resource.
+     * 
+     */
+    public Output<CustomResource> nodePool() {
+        return this.nodePool;
+    }
+    /**
+     * The name of the NodePool.
+     * 
+     */
+    @Export(name="nodePoolName", refs={String.class}, tree="[0]")
+    private Output<String> nodePoolName;
+
+    /**
+     * @return The name of the NodePool.
+     * 
+     */
+    public Output<String> nodePoolName() {
+        return this.nodePoolName;
+    }
+
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     */
+    public AutoModeNodePool(java.lang.String name) {
+        this(name, AutoModeNodePoolArgs.Empty);
+    }
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param args The arguments to use to populate this resource's properties.
+     */
+    public AutoModeNodePool(java.lang.String name, AutoModeNodePoolArgs args) {
+        this(name, args, null);
+    }
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param options A bag of options that control this resource's behavior.
+     */
+    public AutoModeNodePool(java.lang.String name, AutoModeNodePoolArgs args, @Nullable com.pulumi.resources.ComponentResourceOptions options) {
+        super("eks:index:AutoModeNodePool", name, makeArgs(args, options), makeResourceOptions(options, Codegen.empty()), true);
+    }
+
+    private static AutoModeNodePoolArgs makeArgs(AutoModeNodePoolArgs args, @Nullable com.pulumi.resources.ComponentResourceOptions options) {
+        if (options != null && options.getUrn().isPresent()) {
+            return null;
+        }
+        return args == null ? AutoModeNodePoolArgs.Empty : args;
+    }
+
+    private static com.pulumi.resources.ComponentResourceOptions makeResourceOptions(@Nullable com.pulumi.resources.ComponentResourceOptions options, @Nullable Output<java.lang.String> id) {
+        var defaultOptions = com.pulumi.resources.ComponentResourceOptions.builder()
+            .version(Utilities.getVersion())
+            .build();
+        return com.pulumi.resources.ComponentResourceOptions.merge(defaultOptions, options, id);
+    }
+
+}
@@ -0,0 +1,474 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.eks.Cluster;
+import com.pulumi.eks.inputs.AutoModeDisruptionArgs;
+import com.pulumi.eks.inputs.AutoModeNodeRequirementArgs;
+import com.pulumi.eks.inputs.TaintArgs;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.List;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class AutoModeNodePoolArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final AutoModeNodePoolArgs Empty = new AutoModeNodePoolArgs();
+
+    /**
+     * The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+     * 
+     */
+    @Import(name="cluster", required=true)
+    private Output<Cluster> cluster;
+
+    /**
+     * @return The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+     * 
+     */
+    public Output<Cluster> cluster() {
+        return this.cluster;
+    }
+
+    /**
+     * The disruption settings of the node pool.
+     * 
+     */
+    @Import(name="disruption")
+    private @Nullable Output<AutoModeDisruptionArgs> disruption;
+
+    /**
+     * @return The disruption settings of the node pool.
+     * 
+     */
+    public Optional<Output<AutoModeDisruptionArgs>> disruption() {
+        return Optional.ofNullable(this.disruption);
+    }
+
+    /**
+     * How long a node lives before it is replaced, e.g. `336h`, or `Never`. Defaults to `336h`.
+     * 
+     */
+    @Import(name="expireAfter")
+    private @Nullable Output<String> expireAfter;
+
+    /**
+     * @return How long a node lives before it is replaced, e.g. `336h`, or `Never`. Defaults to `336h`.
+     * 
+     */
+    public Optional<Output<String>> expireAfter() {
+        return Optional.ofNullable(this.expireAfter);
+    }
+
+    /**
+     * The labels of the nodes.
+     * 
+     */
+    @Import(name="labels")
+    private @Nullable Output<Map<String,String>> labels;
+
+    /**
+     * @return The labels of the nodes.
+     * 
+     */
+    public Optional<Output<Map<String,String>>> labels() {
+        return Optional.ofNullable(this.labels);
+    }
+
+    /**
+     * The maximum resources of all nodes of the node pool, e.g. `{ cpu: &#34;1000&#34;, memory: &#34;1000Gi&#34; }`.
+     * 
+     */
+    @Import(name="limits")
+    private @Nullable Output<Map<String,String>> limits;
+
+    /**
+     * @return The maximum resources of all nodes of the node pool, e.g. `{ cpu: &#34;1000&#34;, memory: &#34;1000Gi&#34; }`.
+     * 
+     */
+    public Optional<Output<Map<String,String>>> limits() {
+        return Optional.ofNullable(this.limits);
+    }
+
+    /**
+     * The name of the NodeClass of the nodes, e.g. `nodeClass.nodeClassName`. Defaults to `default`, the NodeClass of the built-in node pools.
+     * 
+     */
+    @Import(name="nodeClassName")
+    private @Nullable Output<String> nodeClassName;
+
+    /**
+     * @return The name of the NodeClass of the nodes, e.g. `nodeClass.nodeClassName`. Defaults to `default`, the NodeClass of the built-in node pools.
+     * 
+     */
+    public Optional<Output<String>> nodeClassName() {
+        return Optional.ofNullable(this.nodeClassName);
+    }
+
+    /**
+     * The requirements the instances of the node pool have to fulfill.
+     * 
+     */
+    @Import(name="requirements")
+    private @Nullable Output<List<AutoModeNodeRequirementArgs>> requirements;
+
+    /**
+     * @return The requirements the instances of the node pool have to fulfill.
+     * 
+     */
+    public Optional<Output<List<AutoModeNodeRequirementArgs>>> requirements() {
+        return Optional.ofNullable(this.requirements);
+    }
+
+    /**
+     * The taints of the nodes that are removed by a daemon once the node is ready, keyed by the taint key.
+     * 
+     */
+    @Import(name="startupTaints")
+    private @Nullable Output<Map<String,TaintArgs>> startupTaints;
+
+    /**
+     * @return The taints of the nodes that are removed by a daemon once the node is ready, keyed by the taint key.
+     * 
+     */
+    public Optional<Output<Map<String,TaintArgs>>> startupTaints() {
+        return Optional.ofNullable(this.startupTaints);
+    }
+
+    /**
+     * The taints of the nodes, keyed by the taint key.
+     * 
+     */
+    @Import(name="taints")
+    private @Nullable Output<Map<String,TaintArgs>> taints;
+
+    /**
+     * @return The taints of the nodes, keyed by the taint key.
+     * 
+     */
+    public Optional<Output<Map<String,TaintArgs>>> taints() {
+        return Optional.ofNullable(this.taints);
+    }
+
+    /**
+     * How long a node may take to drain before it is terminated forcefully, e.g. `24h`.
+     * 
+     */
+    @Import(name="terminationGracePeriod")
+    private @Nullable Output<String> terminationGracePeriod;
+
+    /**
+     * @return How long a node may take to drain before it is terminated forcefully, e.g. `24h`.
+     * 
+     */
+    public Optional<Output<String>> terminationGracePeriod() {
+        return Optional.ofNullable(this.terminationGracePeriod);
+    }
+
+    /**
+     * The priority of the node pool. Node pools with a higher weight are preferred.
+     * 
+     */
+    @Import(name="weight")
+    private @Nullable Output<Integer> weight;
+
+    /**
+     * @return The priority of the node pool. Node pools with a higher weight are preferred.
+     * 
+     */
+    public Optional<Output<Integer>> weight() {
+        return Optional.ofNullable(this.weight);
+    }
+
+    private AutoModeNodePoolArgs() {}
+
+    private AutoModeNodePoolArgs(AutoModeNodePoolArgs $) {
+        this.cluster = $.cluster;
+        this.disruption = $.disruption;
+        this.expireAfter = $.expireAfter;
+        this.labels = $.labels;
+        this.limits = $.limits;
+        this.nodeClassName = $.nodeClassName;
+        this.requirements = $.requirements;
+        this.startupTaints = $.startupTaints;
+        this.taints = $.taints;
+        this.terminationGracePeriod = $.terminationGracePeriod;
+        this.weight = $.weight;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(AutoModeNodePoolArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private AutoModeNodePoolArgs $;
+
+        public Builder() {
+            $ = new AutoModeNodePoolArgs();
+        }
+
+        public Builder(AutoModeNodePoolArgs defaults) {
+            $ = new AutoModeNodePoolArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param cluster The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder cluster(Output<Cluster> cluster) {
+            $.cluster = cluster;
+            return this;
+        }
+
+        /**
+         * @param cluster The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder cluster(Cluster cluster) {
+            return cluster(Output.of(cluster));
+        }
+
+        /**
+         * @param disruption The disruption settings of the node pool.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder disruption(@Nullable Output<AutoModeDisruptionArgs> disruption) {
+            $.disruption = disruption;
+            return this;
+        }
+
+        /**
+         * @param disruption The disruption settings of the node pool.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder disruption(AutoModeDisruptionArgs disruption) {
+            return disruption(Output.of(disruption));
+        }
+
+        /**
+         * @param expireAfter How long a node lives before it is replaced, e.g. `336h`, or `Never`. Defaults to `336h`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder expireAfter(@Nullable Output<String> expireAfter) {
+            $.expireAfter = expireAfter;
+            return this;
+        }
+
+        /**
+         * @param expireAfter How long a node lives before it is replaced, e.g. `336h`, or `Never`. Defaults to `336h`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder expireAfter(String expireAfter) {
+            return expireAfter(Output.of(expireAfter));
+        }
+
+        /**
+         * @param labels The labels of the nodes.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder labels(@Nullable Output<Map<String,String>> labels) {
+            $.labels = labels;
+            return this;
+        }
+
+        /**
+         * @param labels The labels of the nodes.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder labels(Map<String,String> labels) {
+            return labels(Output.of(labels));
+        }
+
+        /**
+         * @param limits The maximum resources of all nodes of the node pool, e.g. `{ cpu: &#34;1000&#34;, memory: &#34;1000Gi&#34; }`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder limits(@Nullable Output<Map<String,String>> limits) {
+            $.limits = limits;
+            return this;
+        }
+
+        /**
+         * @param limits The maximum resources of all nodes of the node pool, e.g. `{ cpu: &#34;1000&#34;, memory: &#34;1000Gi&#34; }`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder limits(Map<String,String> limits) {
+            return limits(Output.of(limits));
+        }
+
+        /**
+         * @param nodeClassName The name of the NodeClass of the nodes, e.g. `nodeClass.nodeClassName`. Defaults to `default`, the NodeClass of the built-in node pools.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder nodeClassName(@Nullable Output<String> nodeClassName) {
+            $.nodeClassName = nodeClassName;
+            return this;
+        }
+
+        /**
+         * @param nodeClassName The name of the NodeClass of the nodes, e.g. `nodeClass.nodeClassName`. Defaults to `default`, the NodeClass of the built-in node pools.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder nodeClassName(String nodeClassName) {
+            return nodeClassName(Output.of(nodeClassName));
+        }
+
+        /**
+         * @param requirements The requirements the instances of the node pool have to fulfill.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder requirements(@Nullable Output<List<AutoModeNodeRequirementArgs>> requirements) {
+            $.requirements = requirements;
+            return this;
+        }
+
+        /**
+         * @param requirements The requirements the instances of the node pool have to fulfill.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder requirements(List<AutoModeNodeRequirementArgs> requirements) {
+            return requirements(Output.of(requirements));
+        }
+
+        /**
+         * @param requirements The requirements the instances of the node pool have to fulfill.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder requirements(AutoModeNodeRequirementArgs... requirements) {
+            return requirements(List.of(requirements));
+        }
+
+        /**
+         * @param startupTaints The taints of the nodes that are removed by a daemon once the node is ready, keyed by the taint key.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder startupTaints(@Nullable Output<Map<String,TaintArgs>> startupTaints) {
+            $.startupTaints = startupTaints;
+            return this;
+        }
+
+        /**
+         * @param startupTaints The taints of the nodes that are removed by a daemon once the node is ready, keyed by the taint key.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder startupTaints(Map<String,TaintArgs> startupTaints) {
+            return startupTaints(Output.of(startupTaints));
+        }
+
+        /**
+         * @param taints The taints of the nodes, keyed by the taint key.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder taints(@Nullable Output<Map<String,TaintArgs>> taints) {
+            $.taints = taints;
+            return this;
+        }
+
+        /**
+         * @param taints The taints of the nodes, keyed by the taint key.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder taints(Map<String,TaintArgs> taints) {
+            return taints(Output.of(taints));
+        }
+
+        /**
+         * @param terminationGracePeriod How long a node may take to drain before it is terminated forcefully, e.g. `24h`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder terminationGracePeriod(@Nullable Output<String> terminationGracePeriod) {
+            $.terminationGracePeriod = terminationGracePeriod;
+            return this;
+        }
+
+        /**
+         * @param terminationGracePeriod How long a node may take to drain before it is terminated forcefully, e.g. `24h`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder terminationGracePeriod(String terminationGracePeriod) {
+            return terminationGracePeriod(Output.of(terminationGracePeriod));
+        }
+
+        /**
+         * @param weight The priority of the node pool. Node pools with a higher weight are preferred.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder weight(@Nullable Output<Integer> weight) {
+            $.weight = weight;
+            return this;
+        }
+
+        /**
+         * @param weight The priority of the node pool. Node pools with a higher weight are preferred.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder weight(Integer weight) {
+            return weight(Output.of(weight));
+        }
+
+        public AutoModeNodePoolArgs build() {
+            if ($.cluster == null) {
+                throw new MissingRequiredPropertyException("AutoModeNodePoolArgs", "cluster");
+            }
+            return $;
+        }
+    }
+
+}
@@ -4,7 +4,9 @@
 package com.pulumi.eks;
 
 import com.pulumi.aws.ec2.SecurityGroup;
+import com.pulumi.aws.eks.inputs.ClusterControlPlaneScalingConfigArgs;
 import com.pulumi.aws.eks.inputs.ClusterUpgradePolicyArgs;
+import com.pulumi.aws.eks.inputs.ClusterZonalShiftConfigArgs;
 import com.pulumi.aws.iam.Role;
 import com.pulumi.core.Either;
 import com.pulumi.core.Output;
@@ -15,6 +17,7 @@ import com.pulumi.eks.inputs.AutoModeOptionsArgs;
 import com.pulumi.eks.inputs.ClusterNodeGroupOptionsArgs;
 import com.pulumi.eks.inputs.CoreDnsAddonOptionsArgs;
 import com.pulumi.eks.inputs.CreationRoleProviderArgs;
+import com.pulumi.eks.inputs.EncryptionConfigOptionsArgs;
 import com.pulumi.eks.inputs.FargateProfileArgs;
 import com.pulumi.eks.inputs.KubeProxyAddonOptionsArgs;
 import com.pulumi.eks.inputs.KubeconfigOptionsArgs;
@@ -112,6 +115,50 @@ public final class ClusterArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.bootstrapSelfManagedAddons);
     }
 
+    /**
+     * The ARN of the KMS key to encrypt the control plane logs in the `/aws/eks/&lt;name&gt;/cluster` log group with. The key policy must allow the CloudWatch Logs service principal of the region to use the key.
+     * 
+     * When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set.
+     * 
+     * See for more details:
+     * - https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/encrypt-log-data-kms.html
+     * 
+     */
+    @Import(name="clusterLogKmsKeyArn")
+    private @Nullable Output<String> clusterLogKmsKeyArn;
+
+    /**
+     * @return The ARN of the KMS key to encrypt the control plane logs in the `/aws/eks/&lt;name&gt;/cluster` log group with. The key policy must allow the CloudWatch Logs service principal of the region to use the key.
+     * 
+     * When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set.
+     * 
+     * See for more details:
+     * - https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/encrypt-log-data-kms.html
+     * 
+     */
+    public Optional<Output<String>> clusterLogKmsKeyArn() {
+        return Optional.ofNullable(this.clusterLogKmsKeyArn);
+    }
+
+    /**
+     * The number of days to retain the control plane logs in the `/aws/eks/&lt;name&gt;/cluster` log group. Possible values are: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653, and 0. If set to 0, the logs never expire.
+     * 
+     * When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set. Set `name` to the name of the existing cluster when enabling this for an existing cluster, and import the log group if EKS already created it.
+     * 
+     */
+    @Import(name="clusterLogRetentionDays")
+    private @Nullable Output<Integer> clusterLogRetentionDays;
+
+    /**
+     * @return The number of days to retain the control plane logs in the `/aws/eks/&lt;name&gt;/cluster` log group. Possible values are: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653, and 0. If set to 0, the logs never expire.
+     * 
+     * When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set. Set `name` to the name of the existing cluster when enabling this for an existing cluster, and import the log group if EKS already created it.
+     * 
+     */
+    public Optional<Output<Integer>> clusterLogRetentionDays() {
+        return Optional.ofNullable(this.clusterLogRetentionDays);
+    }
+
     /**
      * The security group to use for the cluster API endpoint. If not provided, a new security group will be created with full internet egress and ingress from node groups.
      * 
@@ -161,6 +208,25 @@ public final class ClusterArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.clusterTags);
     }
 
+    /**
+     * The scaling configuration of the control plane. Setting a provisioned `tier` reserves control plane capacity for the cluster instead of scaling it on demand.
+     * 
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/eks-provisioned-control-plane.html
+     * 
+     */
+    @Import(name="controlPlaneScalingConfig")
+    private @Nullable Output<ClusterControlPlaneScalingConfigArgs> controlPlaneScalingConfig;
+
+    /**
+     * @return The scaling configuration of the control plane. Setting a provisioned `tier` reserves control plane capacity for the cluster instead of scaling it on demand.
+     * 
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/eks-provisioned-control-plane.html
+     * 
+     */
+    public Optional<Output<ClusterControlPlaneScalingConfigArgs>> controlPlaneScalingConfig() {
+        return Optional.ofNullable(this.controlPlaneScalingConfig);
+    }
+
     /**
      * Options for managing the `coredns` addon.
      * 
@@ -311,6 +377,29 @@ public final class ClusterArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.enabledClusterLogTypes);
     }
 
+    /**
+     * Creates a KMS key and alias for the encryption configuration of the cluster. The key policy grants the cluster role the permissions it needs to use the key for envelope encryption of Kubernetes secrets.
+     * 
+     * Mutually exclusive with `encryptionConfigKeyArn`.
+     * See for more details:
+     * - https://docs.aws.amazon.com/eks/latest/userguide/envelope-encryption.html
+     * 
+     */
+    @Import(name="encryptionConfig")
+    private @Nullable EncryptionConfigOptionsArgs encryptionConfig;
+
+    /**
+     * @return Creates a KMS key and alias for the encryption configuration of the cluster. The key policy grants the cluster role the permissions it needs to use the key for envelope encryption of Kubernetes secrets.
+     * 
+     * Mutually exclusive with `encryptionConfigKeyArn`.
+     * See for more details:
+     * - https://docs.aws.amazon.com/eks/latest/userguide/envelope-encryption.html
+     * 
+     */
+    public Optional<EncryptionConfigOptionsArgs> encryptionConfig() {
+        return Optional.ofNullable(this.encryptionConfig);
+    }
+
     /**
      * KMS Key ARN to use with the encryption configuration for the cluster.
      * 
@@ -1153,6 +1242,25 @@ public final class ClusterArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.vpcId);
     }
 
+    /**
+     * The zonal shift configuration of the cluster. Enabling zonal shift lets Amazon Application Recovery Controller (ARC) move traffic away from an impaired Availability Zone.
+     * 
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/zone-shift.html
+     * 
+     */
+    @Import(name="zonalShiftConfig")
+    private @Nullable Output<ClusterZonalShiftConfigArgs> zonalShiftConfig;
+
+    /**
+     * @return The zonal shift configuration of the cluster. Enabling zonal shift lets Amazon Application Recovery Controller (ARC) move traffic away from an impaired Availability Zone.
+     * 
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/zone-shift.html
+     * 
+     */
+    public Optional<Output<ClusterZonalShiftConfigArgs>> zonalShiftConfig() {
+        return Optional.ofNullable(this.zonalShiftConfig);
+    }
+
     private ClusterArgs() {}
 
     private ClusterArgs(ClusterArgs $) {
@@ -1160,9 +1268,12 @@ public final class ClusterArgs extends com.pulumi.resources.ResourceArgs {
         this.authenticationMode = $.authenticationMode;
         this.autoMode = $.autoMode;
         this.bootstrapSelfManagedAddons = $.bootstrapSelfManagedAddons;
+        this.clusterLogKmsKeyArn = $.clusterLogKmsKeyArn;
+        this.clusterLogRetentionDays = $.clusterLogRetentionDays;
         this.clusterSecurityGroup = $.clusterSecurityGroup;
         this.clusterSecurityGroupTags = $.clusterSecurityGroupTags;
         this.clusterTags = $.clusterTags;
+        this.controlPlaneScalingConfig = $.controlPlaneScalingConfig;
         this.corednsAddonOptions = $.corednsAddonOptions;
         this.createInstanceRole = $.createInstanceRole;
         this.createOidcProvider = $.createOidcProvider;
@@ -1171,6 +1282,7 @@ public final class ClusterArgs extends com.pulumi.resources.ResourceArgs {
         this.desiredCapacity = $.desiredCapacity;
         this.enableConfigMapMutable = $.enableConfigMapMutable;
         this.enabledClusterLogTypes = $.enabledClusterLogTypes;
+        this.encryptionConfig = $.encryptionConfig;
         this.encryptionConfigKeyArn = $.encryptionConfigKeyArn;
         this.endpointPrivateAccess = $.endpointPrivateAccess;
         this.endpointPublicAccess = $.endpointPublicAccess;
@@ -1213,6 +1325,7 @@ public final class ClusterArgs extends com.pulumi.resources.ResourceArgs {
         this.version = $.version;
         this.vpcCniOptions = $.vpcCniOptions;
         this.vpcId = $.vpcId;
+        this.zonalShiftConfig = $.zonalShiftConfig;
     }
 
     public static Builder builder() {
@@ -1295,6 +1408,62 @@ public final class ClusterArgs extends com.pulumi.resources.ResourceArgs {
             return bootstrapSelfManagedAddons(Output.of(bootstrapSelfManagedAddons));
         }
 
+        /**
+         * @param clusterLogKmsKeyArn The ARN of the KMS key to encrypt the control plane logs in the `/aws/eks/&lt;name&gt;/cluster` log group with. The key policy must allow the CloudWatch Logs service principal of the region to use the key.
+         * 
+         * When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set.
+         * 
+         * See for more details:
+         * - https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/encrypt-log-data-kms.html
+         * 
+         * @return builder
+         * 
+         */
+        public Builder clusterLogKmsKeyArn(@Nullable Output<String> clusterLogKmsKeyArn) {
+            $.clusterLogKmsKeyArn = clusterLogKmsKeyArn;
+            return this;
+        }
+
+        /**
+         * @param clusterLogKmsKeyArn The ARN of the KMS key to encrypt the control plane logs in the `/aws/eks/&lt;name&gt;/cluster` log group with. The key policy must allow the CloudWatch Logs service principal of the region to use the key.
+         * 
+         * When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set.
+         * 
+         * See for more details:
+         * - https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/encrypt-log-data-kms.html
+         * 
+         * @return builder
+         * 
+         */
+        public Builder clusterLogKmsKeyArn(String clusterLogKmsKeyArn) {
+            return clusterLogKmsKeyArn(Output.of(clusterLogKmsKeyArn));
+        }
+
+        /**
+         * @param clusterLogRetentionDays The number of days to retain the control plane logs in the `/aws/eks/&lt;name&gt;/cluster` log group. Possible values are: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653, and 0. If set to 0, the logs never expire.
+         * 
+         * When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set. Set `name` to the name of the existing cluster when enabling this for an existing cluster, and import the log group if EKS already created it.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder clusterLogRetentionDays(@Nullable Output<Integer> clusterLogRetentionDays) {
+            $.clusterLogRetentionDays = clusterLogRetentionDays;
+            return this;
+        }
+
+        /**
+         * @param clusterLogRetentionDays The number of days to retain the control plane logs in the `/aws/eks/&lt;name&gt;/cluster` log group. Possible values are: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653, and 0. If set to 0, the logs never expire.
+         * 
+         * When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set. Set `name` to the name of the existing cluster when enabling this for an existing cluster, and import the log group if EKS already created it.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder clusterLogRetentionDays(Integer clusterLogRetentionDays) {
+            return clusterLogRetentionDays(Output.of(clusterLogRetentionDays));
+        }
+
         /**
          * @param clusterSecurityGroup The security group to use for the cluster API endpoint. If not provided, a new security group will be created with full internet egress and ingress from node groups.
          * 
@@ -1362,6 +1531,31 @@ public final class ClusterArgs extends com.pulumi.resources.ResourceArgs {
             return clusterTags(Output.of(clusterTags));
         }
 
+        /**
+         * @param controlPlaneScalingConfig The scaling configuration of the control plane. Setting a provisioned `tier` reserves control plane capacity for the cluster instead of scaling it on demand.
+         * 
+         * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/eks-provisioned-control-plane.html
+         * 
+         * @return builder
+         * 
+         */
+        public Builder controlPlaneScalingConfig(@Nullable Output<ClusterControlPlaneScalingConfigArgs> controlPlaneScalingConfig) {
+            $.controlPlaneScalingConfig = controlPlaneScalingConfig;
+            return this;
+        }
+
+        /**
+         * @param controlPlaneScalingConfig The scaling configuration of the control plane. Setting a provisioned `tier` reserves control plane capacity for the cluster instead of scaling it on demand.
+         * 
+         * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/eks-provisioned-control-plane.html
+         * 
+         * @return builder
+         * 
+         */
+        public Builder controlPlaneScalingConfig(ClusterControlPlaneScalingConfigArgs controlPlaneScalingConfig) {
+            return controlPlaneScalingConfig(Output.of(controlPlaneScalingConfig));
+        }
+
         /**
          * @param corednsAddonOptions Options for managing the `coredns` addon.
          * 
@@ -1536,6 +1730,21 @@ public final class ClusterArgs extends com.pulumi.resources.ResourceArgs {
             return enabledClusterLogTypes(List.of(enabledClusterLogTypes));
         }
 
+        /**
+         * @param encryptionConfig Creates a KMS key and alias for the encryption configuration of the cluster. The key policy grants the cluster role the permissions it needs to use the key for envelope encryption of Kubernetes secrets.
+         * 
+         * Mutually exclusive with `encryptionConfigKeyArn`.
+         * See for more details:
+         * - https://docs.aws.amazon.com/eks/latest/userguide/envelope-encryption.html
+         * 
+         * @return builder
+         * 
+         */
+        public Builder encryptionConfig(@Nullable EncryptionConfigOptionsArgs encryptionConfig) {
+            $.encryptionConfig = encryptionConfig;
+            return this;
+        }
+
         /**
          * @param encryptionConfigKeyArn KMS Key ARN to use with the encryption configuration for the cluster.
          * 
@@ -2681,6 +2890,31 @@ public final class ClusterArgs extends com.pulumi.resources.ResourceArgs {
             return vpcId(Output.of(vpcId));
         }
 
+        /**
+         * @param zonalShiftConfig The zonal shift configuration of the cluster. Enabling zonal shift lets Amazon Application Recovery Controller (ARC) move traffic away from an impaired Availability Zone.
+         * 
+         * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/zone-shift.html
+         * 
+         * @return builder
+         * 
+         */
+        public Builder zonalShiftConfig(@Nullable Output<ClusterZonalShiftConfigArgs> zonalShiftConfig) {
+            $.zonalShiftConfig = zonalShiftConfig;
+            return this;
+        }
+
+        /**
+         * @param zonalShiftConfig The zonal shift configuration of the cluster. Enabling zonal shift lets Amazon Application Recovery Controller (ARC) move traffic away from an impaired Availability Zone.
+         * 
+         * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/zone-shift.html
+         * 
+         * @return builder
+         * 
+         */
+        public Builder zonalShiftConfig(ClusterZonalShiftConfigArgs zonalShiftConfig) {
+            return zonalShiftConfig(Output.of(zonalShiftConfig));
+        }
+
         public ClusterArgs build() {
             return $;
         }
@@ -0,0 +1,106 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks;
+
+import com.pulumi.aws.iam.Policy;
+import com.pulumi.aws.iam.Role;
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Export;
+import com.pulumi.core.annotations.ResourceType;
+import com.pulumi.core.internal.Codegen;
+import com.pulumi.eks.ClusterAutoscalerArgs;
+import com.pulumi.eks.Utilities;
+import com.pulumi.kubernetes.helm.v3.Release;
+import javax.annotation.Nullable;
+
+/**
+ * ClusterAutoscaler installs the Kubernetes Cluster Autoscaler into an EKS cluster. It creates the IAM role of the autoscaler and installs the cluster-autoscaler Helm chart, which discovers the Auto Scaling Groups tagged for the cluster. Node groups are tagged for discovery with their `autoscalerDiscovery` option.
+ * For more information see: https://github.com/kubernetes/autoscaler/blob/master/cluster-autoscaler/cloudprovider/aws/README.md
+ * 
+ */
+@ResourceType(type="eks:index:ClusterAutoscaler")
+public class ClusterAutoscaler extends com.pulumi.resources.ComponentResource {
+    /**
+     * The IAM policy with the permissions of the autoscaler.
+     * 
+     */
+    @Export(name="policy", refs={Policy.class}, tree="[0]")
+    private Output<Policy> policy;
+
+    /**
+     * @return The IAM policy with the permissions of the autoscaler.
+     * 
+     */
+    public Output<Policy> policy() {
+        return this.policy;
+    }
+    /**
+     * The Helm release of the autoscaler.
+     * 
+     */
+    @Export(name="release", refs={Release.class}, tree="[0]")
+    private Output<Release> release;
+
+    /**
+     * @return The Helm release of the autoscaler.
+     * 
+     */
+    public Output<Release> release() {
+        return this.release;
+    }
+    /**
+     * The IAM role of the autoscaler.
+     * 
+     */
+    @Export(name="role", refs={Role.class}, tree="[0]")
+    private Output<Role> role;
+
+    /**
+     * @return The IAM role of the autoscaler.
+     * 
+     */
+    public Output<Role> role() {
+        return this.role;
+    }
+
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     */
+    public ClusterAutoscaler(java.lang.String name) {
+        this(name, ClusterAutoscalerArgs.Empty);
+    }
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param args The arguments to use to populate this resource's properties.
+     */
+    public ClusterAutoscaler(java.lang.String name, ClusterAutoscalerArgs args) {
+        this(name, args, null);
+    }
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param options A bag of options that control this resource's behavior.
+     */
+    public ClusterAutoscaler(java.lang.String name, ClusterAutoscalerArgs args, @Nullable com.pulumi.resources.ComponentResourceOptions options) {
+        super("eks:index:ClusterAutoscaler", name, makeArgs(args, options), makeResourceOptions(options, Codegen.empty()), true);
+    }
+
+    private static ClusterAutoscalerArgs makeArgs(ClusterAutoscalerArgs args, @Nullable com.pulumi.resources.ComponentResourceOptions options) {
+        if (options != null && options.getUrn().isPresent()) {
+            return null;
+        }
+        return args == null ? ClusterAutoscalerArgs.Empty : args;
+    }
+
+    private static com.pulumi.resources.ComponentResourceOptions makeResourceOptions(@Nullable com.pulumi.resources.ComponentResourceOptions options, @Nullable Output<java.lang.String> id) {
+        var defaultOptions = com.pulumi.resources.ComponentResourceOptions.builder()
+            .version(Utilities.getVersion())
+            .build();
+        return com.pulumi.resources.ComponentResourceOptions.merge(defaultOptions, options, id);
+    }
+
+}
@@ -0,0 +1,283 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.eks.Cluster;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Object;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class ClusterAutoscalerArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final ClusterAutoscalerArgs Empty = new ClusterAutoscalerArgs();
+
+    /**
+     * The version of the cluster-autoscaler Helm chart. Defaults to `9.46.6`.
+     * 
+     */
+    @Import(name="chartVersion")
+    private @Nullable Output<String> chartVersion;
+
+    /**
+     * @return The version of the cluster-autoscaler Helm chart. Defaults to `9.46.6`.
+     * 
+     */
+    public Optional<Output<String>> chartVersion() {
+        return Optional.ofNullable(this.chartVersion);
+    }
+
+    /**
+     * The target EKS cluster.
+     * 
+     */
+    @Import(name="cluster", required=true)
+    private Output<Cluster> cluster;
+
+    /**
+     * @return The target EKS cluster.
+     * 
+     */
+    public Output<Cluster> cluster() {
+        return this.cluster;
+    }
+
+    /**
+     * The namespace to install the autoscaler into. Defaults to `kube-system`.
+     * 
+     */
+    @Import(name="namespace")
+    private @Nullable Output<String> namespace;
+
+    /**
+     * @return The namespace to install the autoscaler into. Defaults to `kube-system`.
+     * 
+     */
+    public Optional<Output<String>> namespace() {
+        return Optional.ofNullable(this.namespace);
+    }
+
+    /**
+     * The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+     * 
+     * If set, the autoscaler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+     * 
+     */
+    @Import(name="oidcProviderArn")
+    private @Nullable Output<String> oidcProviderArn;
+
+    /**
+     * @return The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+     * 
+     * If set, the autoscaler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+     * 
+     */
+    public Optional<Output<String>> oidcProviderArn() {
+        return Optional.ofNullable(this.oidcProviderArn);
+    }
+
+    /**
+     * The name of the Kubernetes service account of the autoscaler. Defaults to `cluster-autoscaler`.
+     * 
+     */
+    @Import(name="serviceAccountName")
+    private @Nullable Output<String> serviceAccountName;
+
+    /**
+     * @return The name of the Kubernetes service account of the autoscaler. Defaults to `cluster-autoscaler`.
+     * 
+     */
+    public Optional<Output<String>> serviceAccountName() {
+        return Optional.ofNullable(this.serviceAccountName);
+    }
+
+    /**
+     * Custom values for the Helm chart. The values are merged with the values set by the component, which are `autoDiscovery`, `awsRegion` and `rbac.serviceAccount`.
+     * 
+     */
+    @Import(name="values")
+    private @Nullable Output<Map<String,Object>> values;
+
+    /**
+     * @return Custom values for the Helm chart. The values are merged with the values set by the component, which are `autoDiscovery`, `awsRegion` and `rbac.serviceAccount`.
+     * 
+     */
+    public Optional<Output<Map<String,Object>>> values() {
+        return Optional.ofNullable(this.values);
+    }
+
+    private ClusterAutoscalerArgs() {}
+
+    private ClusterAutoscalerArgs(ClusterAutoscalerArgs $) {
+        this.chartVersion = $.chartVersion;
+        this.cluster = $.cluster;
+        this.namespace = $.namespace;
+        this.oidcProviderArn = $.oidcProviderArn;
+        this.serviceAccountName = $.serviceAccountName;
+        this.values = $.values;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(ClusterAutoscalerArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private ClusterAutoscalerArgs $;
+
+        public Builder() {
+            $ = new ClusterAutoscalerArgs();
+        }
+
+        public Builder(ClusterAutoscalerArgs defaults) {
+            $ = new ClusterAutoscalerArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param chartVersion The version of the cluster-autoscaler Helm chart. Defaults to `9.46.6`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder chartVersion(@Nullable Output<String> chartVersion) {
+            $.chartVersion = chartVersion;
+            return this;
+        }
+
+        /**
+         * @param chartVersion The version of the cluster-autoscaler Helm chart. Defaults to `9.46.6`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder chartVersion(String chartVersion) {
+            return chartVersion(Output.of(chartVersion));
+        }
+
+        /**
+         * @param cluster The target EKS cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder cluster(Output<Cluster> cluster) {
+            $.cluster = cluster;
+            return this;
+        }
+
+        /**
+         * @param cluster The target EKS cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder cluster(Cluster cluster) {
+            return cluster(Output.of(cluster));
+        }
+
+        /**
+         * @param namespace The namespace to install the autoscaler into. Defaults to `kube-system`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder namespace(@Nullable Output<String> namespace) {
+            $.namespace = namespace;
+            return this;
+        }
+
+        /**
+         * @param namespace The namespace to install the autoscaler into. Defaults to `kube-system`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder namespace(String namespace) {
+            return namespace(Output.of(namespace));
+        }
+
+        /**
+         * @param oidcProviderArn The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+         * 
+         * If set, the autoscaler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder oidcProviderArn(@Nullable Output<String> oidcProviderArn) {
+            $.oidcProviderArn = oidcProviderArn;
+            return this;
+        }
+
+        /**
+         * @param oidcProviderArn The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+         * 
+         * If set, the autoscaler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder oidcProviderArn(String oidcProviderArn) {
+            return oidcProviderArn(Output.of(oidcProviderArn));
+        }
+
+        /**
+         * @param serviceAccountName The name of the Kubernetes service account of the autoscaler. Defaults to `cluster-autoscaler`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder serviceAccountName(@Nullable Output<String> serviceAccountName) {
+            $.serviceAccountName = serviceAccountName;
+            return this;
+        }
+
+        /**
+         * @param serviceAccountName The name of the Kubernetes service account of the autoscaler. Defaults to `cluster-autoscaler`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder serviceAccountName(String serviceAccountName) {
+            return serviceAccountName(Output.of(serviceAccountName));
+        }
+
+        /**
+         * @param values Custom values for the Helm chart. The values are merged with the values set by the component, which are `autoDiscovery`, `awsRegion` and `rbac.serviceAccount`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder values(@Nullable Output<Map<String,Object>> values) {
+            $.values = values;
+            return this;
+        }
+
+        /**
+         * @param values Custom values for the Helm chart. The values are merged with the values set by the component, which are `autoDiscovery`, `awsRegion` and `rbac.serviceAccount`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder values(Map<String,Object> values) {
+            return values(Output.of(values));
+        }
+
+        public ClusterAutoscalerArgs build() {
+            if ($.cluster == null) {
+                throw new MissingRequiredPropertyException("ClusterAutoscalerArgs", "cluster");
+            }
+            return $;
+        }
+    }
+
+}
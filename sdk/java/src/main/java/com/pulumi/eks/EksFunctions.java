@@ -3,7 +3,81 @@
 
 package com.pulumi.eks;
 
-
+import com.pulumi.core.Output;
+import com.pulumi.core.TypeShape;
+import com.pulumi.deployment.Deployment;
+import com.pulumi.deployment.InvokeOptions;
+import com.pulumi.deployment.InvokeOutputOptions;
+import com.pulumi.eks.Utilities;
+import com.pulumi.eks.inputs.GetOptimizedAmiArgs;
+import com.pulumi.eks.inputs.GetOptimizedAmiPlainArgs;
+import com.pulumi.eks.outputs.GetOptimizedAmiResult;
+import java.util.concurrent.CompletableFuture;
 
 public final class EksFunctions {
+    /**
+     * Resolves the EKS optimized AMI for a Kubernetes version and AMI type. The AMI is looked up via the public SSM parameters published by AWS, the same way node groups resolve their AMI when no `amiId` is provided.
+     * 
+     * If no `releaseVersion` is specified, the release version of the currently recommended AMI is used. The resolved release version is returned so that it can be pinned, e.g. to roll out AMI updates in a controlled fashion by feeding the `amiId` into `NodeGroupV2.amiId`.
+     * 
+     * The SSM parameters are read using the AWS CLI, which needs to be installed and configured with credentials for the account of the stack. The region is always passed explicitly.
+     * 
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
+     * 
+     */
+    public static Output<GetOptimizedAmiResult> getOptimizedAmi(GetOptimizedAmiArgs args) {
+        return getOptimizedAmi(args, InvokeOptions.Empty);
+    }
+    /**
+     * Resolves the EKS optimized AMI for a Kubernetes version and AMI type. The AMI is looked up via the public SSM parameters published by AWS, the same way node groups resolve their AMI when no `amiId` is provided.
+     * 
+     * If no `releaseVersion` is specified, the release version of the currently recommended AMI is used. The resolved release version is returned so that it can be pinned, e.g. to roll out AMI updates in a controlled fashion by feeding the `amiId` into `NodeGroupV2.amiId`.
+     * 
+     * The SSM parameters are read using the AWS CLI, which needs to be installed and configured with credentials for the account of the stack. The region is always passed explicitly.
+     * 
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
+     * 
+     */
+    public static CompletableFuture<GetOptimizedAmiResult> getOptimizedAmiPlain(GetOptimizedAmiPlainArgs args) {
+        return getOptimizedAmiPlain(args, InvokeOptions.Empty);
+    }
+    /**
+     * Resolves the EKS optimized AMI for a Kubernetes version and AMI type. The AMI is looked up via the public SSM parameters published by AWS, the same way node groups resolve their AMI when no `amiId` is provided.
+     * 
+     * If no `releaseVersion` is specified, the release version of the currently recommended AMI is used. The resolved release version is returned so that it can be pinned, e.g. to roll out AMI updates in a controlled fashion by feeding the `amiId` into `NodeGroupV2.amiId`.
+     * 
+     * The SSM parameters are read using the AWS CLI, which needs to be installed and configured with credentials for the account of the stack. The region is always passed explicitly.
+     * 
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
+     * 
+     */
+    public static Output<GetOptimizedAmiResult> getOptimizedAmi(GetOptimizedAmiArgs args, InvokeOptions options) {
+        return Deployment.getInstance().invoke("eks:index:getOptimizedAmi", TypeShape.of(GetOptimizedAmiResult.class), args, Utilities.withVersion(options));
+    }
+    /**
+     * Resolves the EKS optimized AMI for a Kubernetes version and AMI type. The AMI is looked up via the public SSM parameters published by AWS, the same way node groups resolve their AMI when no `amiId` is provided.
+     * 
+     * If no `releaseVersion` is specified, the release version of the currently recommended AMI is used. The resolved release version is returned so that it can be pinned, e.g. to roll out AMI updates in a controlled fashion by feeding the `amiId` into `NodeGroupV2.amiId`.
+     * 
+     * The SSM parameters are read using the AWS CLI, which needs to be installed and configured with credentials for the account of the stack. The region is always passed explicitly.
+     * 
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
+     * 
+     */
+    public static Output<GetOptimizedAmiResult> getOptimizedAmi(GetOptimizedAmiArgs args, InvokeOutputOptions options) {
+        return Deployment.getInstance().invoke("eks:index:getOptimizedAmi", TypeShape.of(GetOptimizedAmiResult.class), args, Utilities.withVersion(options));
+    }
+    /**
+     * Resolves the EKS optimized AMI for a Kubernetes version and AMI type. The AMI is looked up via the public SSM parameters published by AWS, the same way node groups resolve their AMI when no `amiId` is provided.
+     * 
+     * If no `releaseVersion` is specified, the release version of the currently recommended AMI is used. The resolved release version is returned so that it can be pinned, e.g. to roll out AMI updates in a controlled fashion by feeding the `amiId` into `NodeGroupV2.amiId`.
+     * 
+     * The SSM parameters are read using the AWS CLI, which needs to be installed and configured with credentials for the account of the stack. The region is always passed explicitly.
+     * 
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
+     * 
+     */
+    public static CompletableFuture<GetOptimizedAmiResult> getOptimizedAmiPlain(GetOptimizedAmiPlainArgs args, InvokeOptions options) {
+        return Deployment.getInstance().invokeAsync("eks:index:getOptimizedAmi", TypeShape.of(GetOptimizedAmiResult.class), args, Utilities.withVersion(options));
+    }
 }
@@ -0,0 +1,106 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks;
+
+import com.pulumi.aws.iam.Policy;
+import com.pulumi.aws.iam.Role;
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Export;
+import com.pulumi.core.annotations.ResourceType;
+import com.pulumi.core.internal.Codegen;
+import com.pulumi.eks.LoadBalancerControllerArgs;
+import com.pulumi.eks.Utilities;
+import com.pulumi.kubernetes.helm.v3.Release;
+import javax.annotation.Nullable;
+
+/**
+ * LoadBalancerController installs the AWS Load Balancer Controller into an EKS cluster. It creates the IAM role of the controller, installs the aws-load-balancer-controller Helm chart and tags the given subnets, so the controller can discover them.
+ * For more information see: https://docs.aws.amazon.com/eks/latest/userguide/aws-load-balancer-controller.html
+ * 
+ */
+@ResourceType(type="eks:index:LoadBalancerController")
+public class LoadBalancerController extends com.pulumi.resources.ComponentResource {
+    /**
+     * The IAM policy with the permissions of the controller.
+     * 
+     */
+    @Export(name="policy", refs={Policy.class}, tree="[0]")
+    private Output<Policy> policy;
+
+    /**
+     * @return The IAM policy with the permissions of the controller.
+     * 
+     */
+    public Output<Policy> policy() {
+        return this.policy;
+    }
+    /**
+     * The Helm release of the controller.
+     * 
+     */
+    @Export(name="release", refs={Release.class}, tree="[0]")
+    private Output<Release> release;
+
+    /**
+     * @return The Helm release of the controller.
+     * 
+     */
+    public Output<Release> release() {
+        return this.release;
+    }
+    /**
+     * The IAM role of the controller.
+     * 
+     */
+    @Export(name="role", refs={Role.class}, tree="[0]")
+    private Output<Role> role;
+
+    /**
+     * @return The IAM role of the controller.
+     * 
+     */
+    public Output<Role> role() {
+        return this.role;
+    }
+
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     */
+    public LoadBalancerController(java.lang.String name) {
+        this(name, LoadBalancerControllerArgs.Empty);
+    }
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param args The arguments to use to populate this resource's properties.
+     */
+    public LoadBalancerController(java.lang.String name, LoadBalancerControllerArgs args) {
+        this(name, args, null);
+    }
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param options A bag of options that control this resource's behavior.
+     */
+    public LoadBalancerController(java.lang.String name, LoadBalancerControllerArgs args, @Nullable com.pulumi.resources.ComponentResourceOptions options) {
+        super("eks:index:LoadBalancerController", name, makeArgs(args, options), makeResourceOptions(options, Codegen.empty()), true);
+    }
+
+    private static LoadBalancerControllerArgs makeArgs(LoadBalancerControllerArgs args, @Nullable com.pulumi.resources.ComponentResourceOptions options) {
+        if (options != null && options.getUrn().isPresent()) {
+            return null;
+        }
+        return args == null ? LoadBalancerControllerArgs.Empty : args;
+    }
+
+    private static com.pulumi.resources.ComponentResourceOptions makeResourceOptions(@Nullable com.pulumi.resources.ComponentResourceOptions options, @Nullable Output<java.lang.String> id) {
+        var defaultOptions = com.pulumi.resources.ComponentResourceOptions.builder()
+            .version(Utilities.getVersion())
+            .build();
+        return com.pulumi.resources.ComponentResourceOptions.merge(defaultOptions, options, id);
+    }
+
+}
@@ -0,0 +1,415 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.eks.Cluster;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Object;
+import java.lang.String;
+import java.util.List;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class LoadBalancerControllerArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final LoadBalancerControllerArgs Empty = new LoadBalancerControllerArgs();
+
+    /**
+     * The version of the aws-load-balancer-controller Helm chart. Defaults to `1.13.0`.
+     * 
+     */
+    @Import(name="chartVersion")
+    private @Nullable Output<String> chartVersion;
+
+    /**
+     * @return The version of the aws-load-balancer-controller Helm chart. Defaults to `1.13.0`.
+     * 
+     */
+    public Optional<Output<String>> chartVersion() {
+        return Optional.ofNullable(this.chartVersion);
+    }
+
+    /**
+     * The target EKS cluster.
+     * 
+     */
+    @Import(name="cluster", required=true)
+    private Output<Cluster> cluster;
+
+    /**
+     * @return The target EKS cluster.
+     * 
+     */
+    public Output<Cluster> cluster() {
+        return this.cluster;
+    }
+
+    /**
+     * The namespace to install the controller into. Defaults to `kube-system`.
+     * 
+     */
+    @Import(name="namespace")
+    private @Nullable Output<String> namespace;
+
+    /**
+     * @return The namespace to install the controller into. Defaults to `kube-system`.
+     * 
+     */
+    public Optional<Output<String>> namespace() {
+        return Optional.ofNullable(this.namespace);
+    }
+
+    /**
+     * The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+     * 
+     * If set, the controller assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+     * 
+     */
+    @Import(name="oidcProviderArn")
+    private @Nullable Output<String> oidcProviderArn;
+
+    /**
+     * @return The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+     * 
+     * If set, the controller assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+     * 
+     */
+    public Optional<Output<String>> oidcProviderArn() {
+        return Optional.ofNullable(this.oidcProviderArn);
+    }
+
+    /**
+     * The private subnets to tag with `kubernetes.io/role/internal-elb`, which makes the controller place internal load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+     * 
+     */
+    @Import(name="privateSubnetIds")
+    private @Nullable Output<List<String>> privateSubnetIds;
+
+    /**
+     * @return The private subnets to tag with `kubernetes.io/role/internal-elb`, which makes the controller place internal load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+     * 
+     */
+    public Optional<Output<List<String>>> privateSubnetIds() {
+        return Optional.ofNullable(this.privateSubnetIds);
+    }
+
+    /**
+     * The public subnets to tag with `kubernetes.io/role/elb`, which makes the controller place internet-facing load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+     * 
+     */
+    @Import(name="publicSubnetIds")
+    private @Nullable Output<List<String>> publicSubnetIds;
+
+    /**
+     * @return The public subnets to tag with `kubernetes.io/role/elb`, which makes the controller place internet-facing load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+     * 
+     */
+    public Optional<Output<List<String>>> publicSubnetIds() {
+        return Optional.ofNullable(this.publicSubnetIds);
+    }
+
+    /**
+     * The name of the Helm release. Defaults to `aws-load-balancer-controller`.
+     * 
+     */
+    @Import(name="releaseName")
+    private @Nullable Output<String> releaseName;
+
+    /**
+     * @return The name of the Helm release. Defaults to `aws-load-balancer-controller`.
+     * 
+     */
+    public Optional<Output<String>> releaseName() {
+        return Optional.ofNullable(this.releaseName);
+    }
+
+    /**
+     * The name of the Kubernetes service account of the controller. Defaults to `aws-load-balancer-controller`.
+     * 
+     */
+    @Import(name="serviceAccountName")
+    private @Nullable Output<String> serviceAccountName;
+
+    /**
+     * @return The name of the Kubernetes service account of the controller. Defaults to `aws-load-balancer-controller`.
+     * 
+     */
+    public Optional<Output<String>> serviceAccountName() {
+        return Optional.ofNullable(this.serviceAccountName);
+    }
+
+    /**
+     * Custom values for the Helm chart. The values are merged with the values set by the component, which are `clusterName`, `vpcId`, `region` and `serviceAccount`.
+     * 
+     */
+    @Import(name="values")
+    private @Nullable Output<Map<String,Object>> values;
+
+    /**
+     * @return Custom values for the Helm chart. The values are merged with the values set by the component, which are `clusterName`, `vpcId`, `region` and `serviceAccount`.
+     * 
+     */
+    public Optional<Output<Map<String,Object>>> values() {
+        return Optional.ofNullable(this.values);
+    }
+
+    private LoadBalancerControllerArgs() {}
+
+    private LoadBalancerControllerArgs(LoadBalancerControllerArgs $) {
+        this.chartVersion = $.chartVersion;
+        this.cluster = $.cluster;
+        this.namespace = $.namespace;
+        this.oidcProviderArn = $.oidcProviderArn;
+        this.privateSubnetIds = $.privateSubnetIds;
+        this.publicSubnetIds = $.publicSubnetIds;
+        this.releaseName = $.releaseName;
+        this.serviceAccountName = $.serviceAccountName;
+        this.values = $.values;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(LoadBalancerControllerArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private LoadBalancerControllerArgs $;
+
+        public Builder() {
+            $ = new LoadBalancerControllerArgs();
+        }
+
+        public Builder(LoadBalancerControllerArgs defaults) {
+            $ = new LoadBalancerControllerArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param chartVersion The version of the aws-load-balancer-controller Helm chart. Defaults to `1.13.0`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder chartVersion(@Nullable Output<String> chartVersion) {
+            $.chartVersion = chartVersion;
+            return this;
+        }
+
+        /**
+         * @param chartVersion The version of the aws-load-balancer-controller Helm chart. Defaults to `1.13.0`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder chartVersion(String chartVersion) {
+            return chartVersion(Output.of(chartVersion));
+        }
+
+        /**
+         * @param cluster The target EKS cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder cluster(Output<Cluster> cluster) {
+            $.cluster = cluster;
+            return this;
+        }
+
+        /**
+         * @param cluster The target EKS cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder cluster(Cluster cluster) {
+            return cluster(Output.of(cluster));
+        }
+
+        /**
+         * @param namespace The namespace to install the controller into. Defaults to `kube-system`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder namespace(@Nullable Output<String> namespace) {
+            $.namespace = namespace;
+            return this;
+        }
+
+        /**
+         * @param namespace The namespace to install the controller into. Defaults to `kube-system`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder namespace(String namespace) {
+            return namespace(Output.of(namespace));
+        }
+
+        /**
+         * @param oidcProviderArn The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+         * 
+         * If set, the controller assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder oidcProviderArn(@Nullable Output<String> oidcProviderArn) {
+            $.oidcProviderArn = oidcProviderArn;
+            return this;
+        }
+
+        /**
+         * @param oidcProviderArn The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+         * 
+         * If set, the controller assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder oidcProviderArn(String oidcProviderArn) {
+            return oidcProviderArn(Output.of(oidcProviderArn));
+        }
+
+        /**
+         * @param privateSubnetIds The private subnets to tag with `kubernetes.io/role/internal-elb`, which makes the controller place internal load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder privateSubnetIds(@Nullable Output<List<String>> privateSubnetIds) {
+            $.privateSubnetIds = privateSubnetIds;
+            return this;
+        }
+
+        /**
+         * @param privateSubnetIds The private subnets to tag with `kubernetes.io/role/internal-elb`, which makes the controller place internal load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder privateSubnetIds(List<String> privateSubnetIds) {
+            return privateSubnetIds(Output.of(privateSubnetIds));
+        }
+
+        /**
+         * @param privateSubnetIds The private subnets to tag with `kubernetes.io/role/internal-elb`, which makes the controller place internal load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder privateSubnetIds(String... privateSubnetIds) {
+            return privateSubnetIds(List.of(privateSubnetIds));
+        }
+
+        /**
+         * @param publicSubnetIds The public subnets to tag with `kubernetes.io/role/elb`, which makes the controller place internet-facing load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder publicSubnetIds(@Nullable Output<List<String>> publicSubnetIds) {
+            $.publicSubnetIds = publicSubnetIds;
+            return this;
+        }
+
+        /**
+         * @param publicSubnetIds The public subnets to tag with `kubernetes.io/role/elb`, which makes the controller place internet-facing load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder publicSubnetIds(List<String> publicSubnetIds) {
+            return publicSubnetIds(Output.of(publicSubnetIds));
+        }
+
+        /**
+         * @param publicSubnetIds The public subnets to tag with `kubernetes.io/role/elb`, which makes the controller place internet-facing load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder publicSubnetIds(String... publicSubnetIds) {
+            return publicSubnetIds(List.of(publicSubnetIds));
+        }
+
+        /**
+         * @param releaseName The name of the Helm release. Defaults to `aws-load-balancer-controller`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder releaseName(@Nullable Output<String> releaseName) {
+            $.releaseName = releaseName;
+            return this;
+        }
+
+        /**
+         * @param releaseName The name of the Helm release. Defaults to `aws-load-balancer-controller`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder releaseName(String releaseName) {
+            return releaseName(Output.of(releaseName));
+        }
+
+        /**
+         * @param serviceAccountName The name of the Kubernetes service account of the controller. Defaults to `aws-load-balancer-controller`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder serviceAccountName(@Nullable Output<String> serviceAccountName) {
+            $.serviceAccountName = serviceAccountName;
+            return this;
+        }
+
+        /**
+         * @param serviceAccountName The name of the Kubernetes service account of the controller. Defaults to `aws-load-balancer-controller`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder serviceAccountName(String serviceAccountName) {
+            return serviceAccountName(Output.of(serviceAccountName));
+        }
+
+        /**
+         * @param values Custom values for the Helm chart. The values are merged with the values set by the component, which are `clusterName`, `vpcId`, `region` and `serviceAccount`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder values(@Nullable Output<Map<String,Object>> values) {
+            $.values = values;
+            return this;
+        }
+
+        /**
+         * @param values Custom values for the Helm chart. The values are merged with the values set by the component, which are `clusterName`, `vpcId`, `region` and `serviceAccount`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder values(Map<String,Object> values) {
+            return values(Output.of(values));
+        }
+
+        public LoadBalancerControllerArgs build() {
+            if ($.cluster == null) {
+                throw new MissingRequiredPropertyException("LoadBalancerControllerArgs", "cluster");
+            }
+            return $;
+        }
+    }
+
+}
@@ -13,7 +13,9 @@ import com.pulumi.core.Output;
 import com.pulumi.core.annotations.Import;
 import com.pulumi.eks.Cluster;
 import com.pulumi.eks.enums.OperatingSystem;
+import com.pulumi.eks.inputs.BottlerocketConfigArgs;
 import com.pulumi.eks.inputs.CoreDataArgs;
+import com.pulumi.eks.inputs.NodeConfigArgs;
 import com.pulumi.eks.inputs.NodeadmOptionsArgs;
 import com.pulumi.exceptions.MissingRequiredPropertyException;
 import java.lang.Boolean;
@@ -77,6 +79,25 @@ public final class ManagedNodeGroupArgs extends com.pulumi.resources.ResourceArg
         return Optional.ofNullable(this.amiType);
     }
 
+    /**
+     * Tags the Auto Scaling Group of the node group with the `labels` and `taints` as node template tags of the Cluster Autoscaler, so it can scale the node group up from zero. EKS tags the Auto Scaling Groups of managed node groups for the auto-discovery of the Cluster Autoscaler itself.
+     * 
+     * This implies `ignoreScalingChanges` unless it is explicitly disabled.
+     * 
+     */
+    @Import(name="autoscalerDiscovery")
+    private @Nullable Boolean autoscalerDiscovery;
+
+    /**
+     * @return Tags the Auto Scaling Group of the node group with the `labels` and `taints` as node template tags of the Cluster Autoscaler, so it can scale the node group up from zero. EKS tags the Auto Scaling Groups of managed node groups for the auto-discovery of the Cluster Autoscaler itself.
+     * 
+     * This implies `ignoreScalingChanges` unless it is explicitly disabled.
+     * 
+     */
+    public Optional<Boolean> autoscalerDiscovery() {
+        return Optional.ofNullable(this.autoscalerDiscovery);
+    }
+
     /**
      * Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
      * 
@@ -96,6 +117,23 @@ public final class ManagedNodeGroupArgs extends com.pulumi.resources.ResourceArg
         return Optional.ofNullable(this.bootstrapExtraArgs);
     }
 
+    /**
+     * Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+     * The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+     * 
+     */
+    @Import(name="bottlerocketConfig")
+    private @Nullable Output<BottlerocketConfigArgs> bottlerocketConfig;
+
+    /**
+     * @return Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+     * The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+     * 
+     */
+    public Optional<Output<BottlerocketConfigArgs>> bottlerocketConfig() {
+        return Optional.ofNullable(this.bottlerocketConfig);
+    }
+
     /**
      * The configuration settings for Bottlerocket OS.
      * The settings will get merged with the base settings the provider uses to configure Bottlerocket.
@@ -350,6 +388,27 @@ public final class ManagedNodeGroupArgs extends com.pulumi.resources.ResourceArg
         return Optional.ofNullable(this.launchTemplate);
     }
 
+    /**
+     * Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+     * 
+     * Note: This is only applicable when using AL2023.
+     * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+     * 
+     */
+    @Import(name="nodeConfig")
+    private @Nullable Output<NodeConfigArgs> nodeConfig;
+
+    /**
+     * @return Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+     * 
+     * Note: This is only applicable when using AL2023.
+     * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+     * 
+     */
+    public Optional<Output<NodeConfigArgs>> nodeConfig() {
+        return Optional.ofNullable(this.nodeConfig);
+    }
+
     /**
      * Name of the EKS Node Group. If omitted, this provider will assign a random, unique name. Conflicts with `nodeGroupNamePrefix`.
      * 
@@ -634,7 +693,9 @@ public final class ManagedNodeGroupArgs extends com.pulumi.resources.ResourceArg
     private ManagedNodeGroupArgs(ManagedNodeGroupArgs $) {
         this.amiId = $.amiId;
         this.amiType = $.amiType;
+        this.autoscalerDiscovery = $.autoscalerDiscovery;
         this.bootstrapExtraArgs = $.bootstrapExtraArgs;
+        this.bottlerocketConfig = $.bottlerocketConfig;
         this.bottlerocketSettings = $.bottlerocketSettings;
         this.capacityType = $.capacityType;
         this.cluster = $.cluster;
@@ -649,6 +710,7 @@ public final class ManagedNodeGroupArgs extends com.pulumi.resources.ResourceArg
         this.kubeletExtraArgs = $.kubeletExtraArgs;
         this.labels = $.labels;
         this.launchTemplate = $.launchTemplate;
+        this.nodeConfig = $.nodeConfig;
         this.nodeGroupName = $.nodeGroupName;
         this.nodeGroupNamePrefix = $.nodeGroupNamePrefix;
         this.nodeRole = $.nodeRole;
@@ -742,6 +804,19 @@ public final class ManagedNodeGroupArgs extends com.pulumi.resources.ResourceArg
             return amiType(Output.of(amiType));
         }
 
+        /**
+         * @param autoscalerDiscovery Tags the Auto Scaling Group of the node group with the `labels` and `taints` as node template tags of the Cluster Autoscaler, so it can scale the node group up from zero. EKS tags the Auto Scaling Groups of managed node groups for the auto-discovery of the Cluster Autoscaler itself.
+         * 
+         * This implies `ignoreScalingChanges` unless it is explicitly disabled.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder autoscalerDiscovery(@Nullable Boolean autoscalerDiscovery) {
+            $.autoscalerDiscovery = autoscalerDiscovery;
+            return this;
+        }
+
         /**
          * @param bootstrapExtraArgs Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
          * 
@@ -755,6 +830,29 @@ public final class ManagedNodeGroupArgs extends com.pulumi.resources.ResourceArg
             return this;
         }
 
+        /**
+         * @param bottlerocketConfig Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+         * The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder bottlerocketConfig(@Nullable Output<BottlerocketConfigArgs> bottlerocketConfig) {
+            $.bottlerocketConfig = bottlerocketConfig;
+            return this;
+        }
+
+        /**
+         * @param bottlerocketConfig Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+         * The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder bottlerocketConfig(BottlerocketConfigArgs bottlerocketConfig) {
+            return bottlerocketConfig(Output.of(bottlerocketConfig));
+        }
+
         /**
          * @param bottlerocketSettings The configuration settings for Bottlerocket OS.
          * The settings will get merged with the base settings the provider uses to configure Bottlerocket.
@@ -1077,6 +1175,33 @@ public final class ManagedNodeGroupArgs extends com.pulumi.resources.ResourceArg
             return launchTemplate(Output.of(launchTemplate));
         }
 
+        /**
+         * @param nodeConfig Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+         * 
+         * Note: This is only applicable when using AL2023.
+         * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+         * 
+         * @return builder
+         * 
+         */
+        public Builder nodeConfig(@Nullable Output<NodeConfigArgs> nodeConfig) {
+            $.nodeConfig = nodeConfig;
+            return this;
+        }
+
+        /**
+         * @param nodeConfig Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+         * 
+         * Note: This is only applicable when using AL2023.
+         * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+         * 
+         * @return builder
+         * 
+         */
+        public Builder nodeConfig(NodeConfigArgs nodeConfig) {
+            return nodeConfig(Output.of(nodeConfig));
+        }
+
         /**
          * @param nodeGroupName Name of the EKS Node Group. If omitted, this provider will assign a random, unique name. Conflicts with `nodeGroupNamePrefix`.
          * 
@@ -11,7 +11,9 @@ import com.pulumi.core.Output;
 import com.pulumi.core.annotations.Import;
 import com.pulumi.eks.Cluster;
 import com.pulumi.eks.enums.OperatingSystem;
+import com.pulumi.eks.inputs.BottlerocketConfigArgs;
 import com.pulumi.eks.inputs.CoreDataArgs;
+import com.pulumi.eks.inputs.NodeConfigArgs;
 import com.pulumi.eks.inputs.NodeadmOptionsArgs;
 import com.pulumi.eks.inputs.TaintArgs;
 import com.pulumi.exceptions.MissingRequiredPropertyException;
@@ -105,6 +107,25 @@ public final class NodeGroupArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.autoScalingGroupTags);
     }
 
+    /**
+     * Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/&lt;cluster name&gt;` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+     * 
+     * For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+     * 
+     */
+    @Import(name="autoscalerDiscovery")
+    private @Nullable Boolean autoscalerDiscovery;
+
+    /**
+     * @return Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/&lt;cluster name&gt;` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+     * 
+     * For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+     * 
+     */
+    public Optional<Boolean> autoscalerDiscovery() {
+        return Optional.ofNullable(this.autoscalerDiscovery);
+    }
+
     /**
      * Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
      * 
@@ -120,6 +141,23 @@ public final class NodeGroupArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.bootstrapExtraArgs);
     }
 
+    /**
+     * Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+     * The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+     * 
+     */
+    @Import(name="bottlerocketConfig")
+    private @Nullable Output<BottlerocketConfigArgs> bottlerocketConfig;
+
+    /**
+     * @return Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+     * The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+     * 
+     */
+    public Optional<Output<BottlerocketConfigArgs>> bottlerocketConfig() {
+        return Optional.ofNullable(this.bottlerocketConfig);
+    }
+
     /**
      * The configuration settings for Bottlerocket OS.
      * The settings will get merged with the base settings the provider uses to configure Bottlerocket.
@@ -459,6 +497,27 @@ public final class NodeGroupArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.nodeAssociatePublicIpAddress);
     }
 
+    /**
+     * Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+     * 
+     * Note: This is only applicable when using AL2023.
+     * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+     * 
+     */
+    @Import(name="nodeConfig")
+    private @Nullable Output<NodeConfigArgs> nodeConfig;
+
+    /**
+     * @return Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+     * 
+     * Note: This is only applicable when using AL2023.
+     * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+     * 
+     */
+    public Optional<Output<NodeConfigArgs>> nodeConfig() {
+        return Optional.ofNullable(this.nodeConfig);
+    }
+
     /**
      * Public key material for SSH access to worker nodes. See allowed formats at:
      * https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
@@ -786,7 +845,9 @@ public final class NodeGroupArgs extends com.pulumi.resources.ResourceArgs {
         this.amiId = $.amiId;
         this.amiType = $.amiType;
         this.autoScalingGroupTags = $.autoScalingGroupTags;
+        this.autoscalerDiscovery = $.autoscalerDiscovery;
         this.bootstrapExtraArgs = $.bootstrapExtraArgs;
+        this.bottlerocketConfig = $.bottlerocketConfig;
         this.bottlerocketSettings = $.bottlerocketSettings;
         this.cloudFormationTags = $.cloudFormationTags;
         this.cluster = $.cluster;
@@ -806,6 +867,7 @@ public final class NodeGroupArgs extends com.pulumi.resources.ResourceArgs {
         this.maxSize = $.maxSize;
         this.minSize = $.minSize;
         this.nodeAssociatePublicIpAddress = $.nodeAssociatePublicIpAddress;
+        this.nodeConfig = $.nodeConfig;
         this.nodePublicKey = $.nodePublicKey;
         this.nodeRootVolumeDeleteOnTermination = $.nodeRootVolumeDeleteOnTermination;
         this.nodeRootVolumeEncrypted = $.nodeRootVolumeEncrypted;
@@ -936,6 +998,19 @@ public final class NodeGroupArgs extends com.pulumi.resources.ResourceArgs {
             return autoScalingGroupTags(Output.of(autoScalingGroupTags));
         }
 
+        /**
+         * @param autoscalerDiscovery Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/&lt;cluster name&gt;` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+         * 
+         * For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder autoscalerDiscovery(@Nullable Boolean autoscalerDiscovery) {
+            $.autoscalerDiscovery = autoscalerDiscovery;
+            return this;
+        }
+
         /**
          * @param bootstrapExtraArgs Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
          * 
@@ -957,6 +1032,29 @@ public final class NodeGroupArgs extends com.pulumi.resources.ResourceArgs {
             return bootstrapExtraArgs(Output.of(bootstrapExtraArgs));
         }
 
+        /**
+         * @param bottlerocketConfig Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+         * The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder bottlerocketConfig(@Nullable Output<BottlerocketConfigArgs> bottlerocketConfig) {
+            $.bottlerocketConfig = bottlerocketConfig;
+            return this;
+        }
+
+        /**
+         * @param bottlerocketConfig Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+         * The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder bottlerocketConfig(BottlerocketConfigArgs bottlerocketConfig) {
+            return bottlerocketConfig(Output.of(bottlerocketConfig));
+        }
+
         /**
          * @param bottlerocketSettings The configuration settings for Bottlerocket OS.
          * The settings will get merged with the base settings the provider uses to configure Bottlerocket.
@@ -1432,6 +1530,33 @@ public final class NodeGroupArgs extends com.pulumi.resources.ResourceArgs {
             return nodeAssociatePublicIpAddress(Output.of(nodeAssociatePublicIpAddress));
         }
 
+        /**
+         * @param nodeConfig Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+         * 
+         * Note: This is only applicable when using AL2023.
+         * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+         * 
+         * @return builder
+         * 
+         */
+        public Builder nodeConfig(@Nullable Output<NodeConfigArgs> nodeConfig) {
+            $.nodeConfig = nodeConfig;
+            return this;
+        }
+
+        /**
+         * @param nodeConfig Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+         * 
+         * Note: This is only applicable when using AL2023.
+         * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+         * 
+         * @return builder
+         * 
+         */
+        public Builder nodeConfig(NodeConfigArgs nodeConfig) {
+            return nodeConfig(Output.of(nodeConfig));
+        }
+
         /**
          * @param nodePublicKey Public key material for SSH access to worker nodes. See allowed formats at:
          * https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
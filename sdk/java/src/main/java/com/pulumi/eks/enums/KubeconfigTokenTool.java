@@ -0,0 +1,45 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.enums;
+
+import com.pulumi.core.annotations.EnumType;
+import java.lang.String;
+import java.util.Objects;
+import java.util.StringJoiner;
+
+    /**
+     * The exec plugin used by a kubeconfig to retrieve a token for the cluster.
+     * 
+     */
+    @EnumType
+    public enum KubeconfigTokenTool {
+        /**
+         * Use `aws eks get-token` of the AWS CLI to retrieve the token.
+         * 
+         */
+        Aws("aws"),
+        /**
+         * Use `aws-iam-authenticator token` to retrieve the token.
+         * 
+         */
+        AwsIamAuthenticator("aws-iam-authenticator");
+
+        private final String value;
+
+        KubeconfigTokenTool(String value) {
+            this.value = Objects.requireNonNull(value);
+        }
+
+        @EnumType.Converter
+        public String getValue() {
+            return this.value;
+        }
+
+        @Override
+        public java.lang.String toString() {
+            return new StringJoiner(", ", "KubeconfigTokenTool[", "]")
+                .add("value='" + this.value + "'")
+                .toString();
+        }
+    }
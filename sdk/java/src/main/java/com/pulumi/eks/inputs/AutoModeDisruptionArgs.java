@@ -0,0 +1,173 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.eks.inputs.AutoModeDisruptionBudgetArgs;
+import java.lang.String;
+import java.util.List;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * AutoModeDisruption configures how nodes of a node pool are consolidated and replaced.
+ * 
+ */
+public final class AutoModeDisruptionArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final AutoModeDisruptionArgs Empty = new AutoModeDisruptionArgs();
+
+    /**
+     * The disruption budgets of the node pool.
+     * 
+     */
+    @Import(name="budgets")
+    private @Nullable Output<List<AutoModeDisruptionBudgetArgs>> budgets;
+
+    /**
+     * @return The disruption budgets of the node pool.
+     * 
+     */
+    public Optional<Output<List<AutoModeDisruptionBudgetArgs>>> budgets() {
+        return Optional.ofNullable(this.budgets);
+    }
+
+    /**
+     * How long to wait after a pod was added to or removed from a node before consolidating it, e.g. `30s`, or `Never`. Defaults to `0s`.
+     * 
+     */
+    @Import(name="consolidateAfter")
+    private @Nullable Output<String> consolidateAfter;
+
+    /**
+     * @return How long to wait after a pod was added to or removed from a node before consolidating it, e.g. `30s`, or `Never`. Defaults to `0s`.
+     * 
+     */
+    public Optional<Output<String>> consolidateAfter() {
+        return Optional.ofNullable(this.consolidateAfter);
+    }
+
+    /**
+     * Which nodes are consolidated, `WhenEmptyOrUnderutilized` or `WhenEmpty`. Defaults to `WhenEmptyOrUnderutilized`.
+     * 
+     */
+    @Import(name="consolidationPolicy")
+    private @Nullable Output<String> consolidationPolicy;
+
+    /**
+     * @return Which nodes are consolidated, `WhenEmptyOrUnderutilized` or `WhenEmpty`. Defaults to `WhenEmptyOrUnderutilized`.
+     * 
+     */
+    public Optional<Output<String>> consolidationPolicy() {
+        return Optional.ofNullable(this.consolidationPolicy);
+    }
+
+    private AutoModeDisruptionArgs() {}
+
+    private AutoModeDisruptionArgs(AutoModeDisruptionArgs $) {
+        this.budgets = $.budgets;
+        this.consolidateAfter = $.consolidateAfter;
+        this.consolidationPolicy = $.consolidationPolicy;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(AutoModeDisruptionArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private AutoModeDisruptionArgs $;
+
+        public Builder() {
+            $ = new AutoModeDisruptionArgs();
+        }
+
+        public Builder(AutoModeDisruptionArgs defaults) {
+            $ = new AutoModeDisruptionArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param budgets The disruption budgets of the node pool.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder budgets(@Nullable Output<List<AutoModeDisruptionBudgetArgs>> budgets) {
+            $.budgets = budgets;
+            return this;
+        }
+
+        /**
+         * @param budgets The disruption budgets of the node pool.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder budgets(List<AutoModeDisruptionBudgetArgs> budgets) {
+            return budgets(Output.of(budgets));
+        }
+
+        /**
+         * @param budgets The disruption budgets of the node pool.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder budgets(AutoModeDisruptionBudgetArgs... budgets) {
+            return budgets(List.of(budgets));
+        }
+
+        /**
+         * @param consolidateAfter How long to wait after a pod was added to or removed from a node before consolidating it, e.g. `30s`, or `Never`. Defaults to `0s`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder consolidateAfter(@Nullable Output<String> consolidateAfter) {
+            $.consolidateAfter = consolidateAfter;
+            return this;
+        }
+
+        /**
+         * @param consolidateAfter How long to wait after a pod was added to or removed from a node before consolidating it, e.g. `30s`, or `Never`. Defaults to `0s`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder consolidateAfter(String consolidateAfter) {
+            return consolidateAfter(Output.of(consolidateAfter));
+        }
+
+        /**
+         * @param consolidationPolicy Which nodes are consolidated, `WhenEmptyOrUnderutilized` or `WhenEmpty`. Defaults to `WhenEmptyOrUnderutilized`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder consolidationPolicy(@Nullable Output<String> consolidationPolicy) {
+            $.consolidationPolicy = consolidationPolicy;
+            return this;
+        }
+
+        /**
+         * @param consolidationPolicy Which nodes are consolidated, `WhenEmptyOrUnderutilized` or `WhenEmpty`. Defaults to `WhenEmptyOrUnderutilized`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder consolidationPolicy(String consolidationPolicy) {
+            return consolidationPolicy(Output.of(consolidationPolicy));
+        }
+
+        public AutoModeDisruptionArgs build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,213 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.String;
+import java.util.List;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * AutoModeDisruptionBudget limits the number of nodes that may be disrupted at the same time.
+ * 
+ */
+public final class AutoModeDisruptionBudgetArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final AutoModeDisruptionBudgetArgs Empty = new AutoModeDisruptionBudgetArgs();
+
+    /**
+     * How long the budget is active after each schedule, e.g. `8h`.
+     * 
+     */
+    @Import(name="duration")
+    private @Nullable Output<String> duration;
+
+    /**
+     * @return How long the budget is active after each schedule, e.g. `8h`.
+     * 
+     */
+    public Optional<Output<String>> duration() {
+        return Optional.ofNullable(this.duration);
+    }
+
+    /**
+     * The number or percentage of nodes that may be disrupted, e.g. `10%`. `0` blocks disruptions.
+     * 
+     */
+    @Import(name="nodes", required=true)
+    private Output<String> nodes;
+
+    /**
+     * @return The number or percentage of nodes that may be disrupted, e.g. `10%`. `0` blocks disruptions.
+     * 
+     */
+    public Output<String> nodes() {
+        return this.nodes;
+    }
+
+    /**
+     * The disruption reasons the budget applies to, `Underutilized`, `Empty` or `Drifted`. Defaults to all reasons.
+     * 
+     */
+    @Import(name="reasons")
+    private @Nullable Output<List<String>> reasons;
+
+    /**
+     * @return The disruption reasons the budget applies to, `Underutilized`, `Empty` or `Drifted`. Defaults to all reasons.
+     * 
+     */
+    public Optional<Output<List<String>>> reasons() {
+        return Optional.ofNullable(this.reasons);
+    }
+
+    /**
+     * The cron schedule at which the budget becomes active, e.g. `0 9 * * mon-fri`. Requires `duration`.
+     * 
+     */
+    @Import(name="schedule")
+    private @Nullable Output<String> schedule;
+
+    /**
+     * @return The cron schedule at which the budget becomes active, e.g. `0 9 * * mon-fri`. Requires `duration`.
+     * 
+     */
+    public Optional<Output<String>> schedule() {
+        return Optional.ofNullable(this.schedule);
+    }
+
+    private AutoModeDisruptionBudgetArgs() {}
+
+    private AutoModeDisruptionBudgetArgs(AutoModeDisruptionBudgetArgs $) {
+        this.duration = $.duration;
+        this.nodes = $.nodes;
+        this.reasons = $.reasons;
+        this.schedule = $.schedule;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(AutoModeDisruptionBudgetArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private AutoModeDisruptionBudgetArgs $;
+
+        public Builder() {
+            $ = new AutoModeDisruptionBudgetArgs();
+        }
+
+        public Builder(AutoModeDisruptionBudgetArgs defaults) {
+            $ = new AutoModeDisruptionBudgetArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param duration How long the budget is active after each schedule, e.g. `8h`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder duration(@Nullable Output<String> duration) {
+            $.duration = duration;
+            return this;
+        }
+
+        /**
+         * @param duration How long the budget is active after each schedule, e.g. `8h`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder duration(String duration) {
+            return duration(Output.of(duration));
+        }
+
+        /**
+         * @param nodes The number or percentage of nodes that may be disrupted, e.g. `10%`. `0` blocks disruptions.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder nodes(Output<String> nodes) {
+            $.nodes = nodes;
+            return this;
+        }
+
+        /**
+         * @param nodes The number or percentage of nodes that may be disrupted, e.g. `10%`. `0` blocks disruptions.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder nodes(String nodes) {
+            return nodes(Output.of(nodes));
+        }
+
+        /**
+         * @param reasons The disruption reasons the budget applies to, `Underutilized`, `Empty` or `Drifted`. Defaults to all reasons.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder reasons(@Nullable Output<List<String>> reasons) {
+            $.reasons = reasons;
+            return this;
+        }
+
+        /**
+         * @param reasons The disruption reasons the budget applies to, `Underutilized`, `Empty` or `Drifted`. Defaults to all reasons.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder reasons(List<String> reasons) {
+            return reasons(Output.of(reasons));
+        }
+
+        /**
+         * @param reasons The disruption reasons the budget applies to, `Underutilized`, `Empty` or `Drifted`. Defaults to all reasons.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder reasons(String... reasons) {
+            return reasons(List.of(reasons));
+        }
+
+        /**
+         * @param schedule The cron schedule at which the budget becomes active, e.g. `0 9 * * mon-fri`. Requires `duration`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder schedule(@Nullable Output<String> schedule) {
+            $.schedule = schedule;
+            return this;
+        }
+
+        /**
+         * @param schedule The cron schedule at which the budget becomes active, e.g. `0 9 * * mon-fri`. Requires `duration`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder schedule(String schedule) {
+            return schedule(Output.of(schedule));
+        }
+
+        public AutoModeDisruptionBudgetArgs build() {
+            if ($.nodes == null) {
+                throw new MissingRequiredPropertyException("AutoModeDisruptionBudgetArgs", "nodes");
+            }
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,199 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * AutoModeEphemeralStorage configures the volume that holds the ephemeral storage of the nodes.
+ * 
+ */
+public final class AutoModeEphemeralStorageArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final AutoModeEphemeralStorageArgs Empty = new AutoModeEphemeralStorageArgs();
+
+    /**
+     * The IOPS of the volume.
+     * 
+     */
+    @Import(name="iops")
+    private @Nullable Output<Integer> iops;
+
+    /**
+     * @return The IOPS of the volume.
+     * 
+     */
+    public Optional<Output<Integer>> iops() {
+        return Optional.ofNullable(this.iops);
+    }
+
+    /**
+     * The ARN of the KMS key that encrypts the volume.
+     * 
+     */
+    @Import(name="kmsKeyId")
+    private @Nullable Output<String> kmsKeyId;
+
+    /**
+     * @return The ARN of the KMS key that encrypts the volume.
+     * 
+     */
+    public Optional<Output<String>> kmsKeyId() {
+        return Optional.ofNullable(this.kmsKeyId);
+    }
+
+    /**
+     * The size of the volume, e.g. `80Gi`.
+     * 
+     */
+    @Import(name="size")
+    private @Nullable Output<String> size;
+
+    /**
+     * @return The size of the volume, e.g. `80Gi`.
+     * 
+     */
+    public Optional<Output<String>> size() {
+        return Optional.ofNullable(this.size);
+    }
+
+    /**
+     * The throughput of the volume in MiB/s.
+     * 
+     */
+    @Import(name="throughput")
+    private @Nullable Output<Integer> throughput;
+
+    /**
+     * @return The throughput of the volume in MiB/s.
+     * 
+     */
+    public Optional<Output<Integer>> throughput() {
+        return Optional.ofNullable(this.throughput);
+    }
+
+    private AutoModeEphemeralStorageArgs() {}
+
+    private AutoModeEphemeralStorageArgs(AutoModeEphemeralStorageArgs $) {
+        this.iops = $.iops;
+        this.kmsKeyId = $.kmsKeyId;
+        this.size = $.size;
+        this.throughput = $.throughput;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(AutoModeEphemeralStorageArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private AutoModeEphemeralStorageArgs $;
+
+        public Builder() {
+            $ = new AutoModeEphemeralStorageArgs();
+        }
+
+        public Builder(AutoModeEphemeralStorageArgs defaults) {
+            $ = new AutoModeEphemeralStorageArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param iops The IOPS of the volume.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder iops(@Nullable Output<Integer> iops) {
+            $.iops = iops;
+            return this;
+        }
+
+        /**
+         * @param iops The IOPS of the volume.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder iops(Integer iops) {
+            return iops(Output.of(iops));
+        }
+
+        /**
+         * @param kmsKeyId The ARN of the KMS key that encrypts the volume.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder kmsKeyId(@Nullable Output<String> kmsKeyId) {
+            $.kmsKeyId = kmsKeyId;
+            return this;
+        }
+
+        /**
+         * @param kmsKeyId The ARN of the KMS key that encrypts the volume.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder kmsKeyId(String kmsKeyId) {
+            return kmsKeyId(Output.of(kmsKeyId));
+        }
+
+        /**
+         * @param size The size of the volume, e.g. `80Gi`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder size(@Nullable Output<String> size) {
+            $.size = size;
+            return this;
+        }
+
+        /**
+         * @param size The size of the volume, e.g. `80Gi`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder size(String size) {
+            return size(Output.of(size));
+        }
+
+        /**
+         * @param throughput The throughput of the volume in MiB/s.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder throughput(@Nullable Output<Integer> throughput) {
+            $.throughput = throughput;
+            return this;
+        }
+
+        /**
+         * @param throughput The throughput of the volume in MiB/s.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder throughput(Integer throughput) {
+            return throughput(Output.of(throughput));
+        }
+
+        public AutoModeEphemeralStorageArgs build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,217 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.List;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * AutoModeNodeRequirement restricts the instances of a node pool by a well-known label, e.g. `eks.amazonaws.com/instance-category` or `karpenter.sh/capacity-type`.
+ * 
+ */
+public final class AutoModeNodeRequirementArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final AutoModeNodeRequirementArgs Empty = new AutoModeNodeRequirementArgs();
+
+    /**
+     * The label key.
+     * 
+     */
+    @Import(name="key", required=true)
+    private Output<String> key;
+
+    /**
+     * @return The label key.
+     * 
+     */
+    public Output<String> key() {
+        return this.key;
+    }
+
+    /**
+     * The minimum number of distinct values the node pool has to be able to choose from.
+     * 
+     */
+    @Import(name="minValues")
+    private @Nullable Output<Integer> minValues;
+
+    /**
+     * @return The minimum number of distinct values the node pool has to be able to choose from.
+     * 
+     */
+    public Optional<Output<Integer>> minValues() {
+        return Optional.ofNullable(this.minValues);
+    }
+
+    /**
+     * The operator, one of `In`, `NotIn`, `Exists`, `DoesNotExist`, `Gt` and `Lt`.
+     * 
+     */
+    @Import(name="operator", required=true)
+    private Output<String> operator;
+
+    /**
+     * @return The operator, one of `In`, `NotIn`, `Exists`, `DoesNotExist`, `Gt` and `Lt`.
+     * 
+     */
+    public Output<String> operator() {
+        return this.operator;
+    }
+
+    /**
+     * The label values.
+     * 
+     */
+    @Import(name="values")
+    private @Nullable Output<List<String>> values;
+
+    /**
+     * @return The label values.
+     * 
+     */
+    public Optional<Output<List<String>>> values() {
+        return Optional.ofNullable(this.values);
+    }
+
+    private AutoModeNodeRequirementArgs() {}
+
+    private AutoModeNodeRequirementArgs(AutoModeNodeRequirementArgs $) {
+        this.key = $.key;
+        this.minValues = $.minValues;
+        this.operator = $.operator;
+        this.values = $.values;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(AutoModeNodeRequirementArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private AutoModeNodeRequirementArgs $;
+
+        public Builder() {
+            $ = new AutoModeNodeRequirementArgs();
+        }
+
+        public Builder(AutoModeNodeRequirementArgs defaults) {
+            $ = new AutoModeNodeRequirementArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param key The label key.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder key(Output<String> key) {
+            $.key = key;
+            return this;
+        }
+
+        /**
+         * @param key The label key.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder key(String key) {
+            return key(Output.of(key));
+        }
+
+        /**
+         * @param minValues The minimum number of distinct values the node pool has to be able to choose from.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder minValues(@Nullable Output<Integer> minValues) {
+            $.minValues = minValues;
+            return this;
+        }
+
+        /**
+         * @param minValues The minimum number of distinct values the node pool has to be able to choose from.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder minValues(Integer minValues) {
+            return minValues(Output.of(minValues));
+        }
+
+        /**
+         * @param operator The operator, one of `In`, `NotIn`, `Exists`, `DoesNotExist`, `Gt` and `Lt`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder operator(Output<String> operator) {
+            $.operator = operator;
+            return this;
+        }
+
+        /**
+         * @param operator The operator, one of `In`, `NotIn`, `Exists`, `DoesNotExist`, `Gt` and `Lt`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder operator(String operator) {
+            return operator(Output.of(operator));
+        }
+
+        /**
+         * @param values The label values.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder values(@Nullable Output<List<String>> values) {
+            $.values = values;
+            return this;
+        }
+
+        /**
+         * @param values The label values.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder values(List<String> values) {
+            return values(Output.of(values));
+        }
+
+        /**
+         * @param values The label values.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder values(String... values) {
+            return values(List.of(values));
+        }
+
+        public AutoModeNodeRequirementArgs build() {
+            if ($.key == null) {
+                throw new MissingRequiredPropertyException("AutoModeNodeRequirementArgs", "key");
+            }
+            if ($.operator == null) {
+                throw new MissingRequiredPropertyException("AutoModeNodeRequirementArgs", "operator");
+            }
+            return $;
+        }
+    }
+
+}
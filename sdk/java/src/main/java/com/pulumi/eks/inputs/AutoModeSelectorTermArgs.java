@@ -0,0 +1,162 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * AutoModeSelectorTerm selects subnets or security groups by their ID, name or tags. The conditions of a term are ANDed, multiple terms are ORed.
+ * 
+ */
+public final class AutoModeSelectorTermArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final AutoModeSelectorTermArgs Empty = new AutoModeSelectorTermArgs();
+
+    /**
+     * The ID of the subnet or security group.
+     * 
+     */
+    @Import(name="id")
+    private @Nullable Output<String> id;
+
+    /**
+     * @return The ID of the subnet or security group.
+     * 
+     */
+    public Optional<Output<String>> id() {
+        return Optional.ofNullable(this.id);
+    }
+
+    /**
+     * The name of the security group. Not supported for subnets.
+     * 
+     */
+    @Import(name="name")
+    private @Nullable Output<String> name;
+
+    /**
+     * @return The name of the security group. Not supported for subnets.
+     * 
+     */
+    public Optional<Output<String>> name() {
+        return Optional.ofNullable(this.name);
+    }
+
+    /**
+     * The tags the subnets or security groups must have. A value of `*` matches any value.
+     * 
+     */
+    @Import(name="tags")
+    private @Nullable Output<Map<String,String>> tags;
+
+    /**
+     * @return The tags the subnets or security groups must have. A value of `*` matches any value.
+     * 
+     */
+    public Optional<Output<Map<String,String>>> tags() {
+        return Optional.ofNullable(this.tags);
+    }
+
+    private AutoModeSelectorTermArgs() {}
+
+    private AutoModeSelectorTermArgs(AutoModeSelectorTermArgs $) {
+        this.id = $.id;
+        this.name = $.name;
+        this.tags = $.tags;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(AutoModeSelectorTermArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private AutoModeSelectorTermArgs $;
+
+        public Builder() {
+            $ = new AutoModeSelectorTermArgs();
+        }
+
+        public Builder(AutoModeSelectorTermArgs defaults) {
+            $ = new AutoModeSelectorTermArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param id The ID of the subnet or security group.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder id(@Nullable Output<String> id) {
+            $.id = id;
+            return this;
+        }
+
+        /**
+         * @param id The ID of the subnet or security group.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder id(String id) {
+            return id(Output.of(id));
+        }
+
+        /**
+         * @param name The name of the security group. Not supported for subnets.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder name(@Nullable Output<String> name) {
+            $.name = name;
+            return this;
+        }
+
+        /**
+         * @param name The name of the security group. Not supported for subnets.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder name(String name) {
+            return name(Output.of(name));
+        }
+
+        /**
+         * @param tags The tags the subnets or security groups must have. A value of `*` matches any value.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder tags(@Nullable Output<Map<String,String>> tags) {
+            $.tags = tags;
+            return this;
+        }
+
+        /**
+         * @param tags The tags the subnets or security groups must have. A value of `*` matches any value.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder tags(Map<String,String> tags) {
+            return tags(Output.of(tags));
+        }
+
+        public AutoModeSelectorTermArgs build() {
+            return $;
+        }
+    }
+
+}
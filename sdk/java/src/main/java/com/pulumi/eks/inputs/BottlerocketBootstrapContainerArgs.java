@@ -0,0 +1,203 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Boolean;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * A Bottlerocket bootstrap container, which runs before the kubelet starts.
+ * 
+ */
+public final class BottlerocketBootstrapContainerArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final BottlerocketBootstrapContainerArgs Empty = new BottlerocketBootstrapContainerArgs();
+
+    /**
+     * Whether the node fails to boot if the bootstrap container fails.
+     * 
+     */
+    @Import(name="essential")
+    private @Nullable Output<Boolean> essential;
+
+    /**
+     * @return Whether the node fails to boot if the bootstrap container fails.
+     * 
+     */
+    public Optional<Output<Boolean>> essential() {
+        return Optional.ofNullable(this.essential);
+    }
+
+    /**
+     * When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+     * 
+     */
+    @Import(name="mode")
+    private @Nullable Output<String> mode;
+
+    /**
+     * @return When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+     * 
+     */
+    public Optional<Output<String>> mode() {
+        return Optional.ofNullable(this.mode);
+    }
+
+    /**
+     * The image of the bootstrap container.
+     * 
+     */
+    @Import(name="source", required=true)
+    private Output<String> source;
+
+    /**
+     * @return The image of the bootstrap container.
+     * 
+     */
+    public Output<String> source() {
+        return this.source;
+    }
+
+    /**
+     * Base64 encoded user data passed to the bootstrap container.
+     * 
+     */
+    @Import(name="userData")
+    private @Nullable Output<String> userData;
+
+    /**
+     * @return Base64 encoded user data passed to the bootstrap container.
+     * 
+     */
+    public Optional<Output<String>> userData() {
+        return Optional.ofNullable(this.userData);
+    }
+
+    private BottlerocketBootstrapContainerArgs() {}
+
+    private BottlerocketBootstrapContainerArgs(BottlerocketBootstrapContainerArgs $) {
+        this.essential = $.essential;
+        this.mode = $.mode;
+        this.source = $.source;
+        this.userData = $.userData;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(BottlerocketBootstrapContainerArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private BottlerocketBootstrapContainerArgs $;
+
+        public Builder() {
+            $ = new BottlerocketBootstrapContainerArgs();
+        }
+
+        public Builder(BottlerocketBootstrapContainerArgs defaults) {
+            $ = new BottlerocketBootstrapContainerArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param essential Whether the node fails to boot if the bootstrap container fails.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder essential(@Nullable Output<Boolean> essential) {
+            $.essential = essential;
+            return this;
+        }
+
+        /**
+         * @param essential Whether the node fails to boot if the bootstrap container fails.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder essential(Boolean essential) {
+            return essential(Output.of(essential));
+        }
+
+        /**
+         * @param mode When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder mode(@Nullable Output<String> mode) {
+            $.mode = mode;
+            return this;
+        }
+
+        /**
+         * @param mode When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder mode(String mode) {
+            return mode(Output.of(mode));
+        }
+
+        /**
+         * @param source The image of the bootstrap container.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder source(Output<String> source) {
+            $.source = source;
+            return this;
+        }
+
+        /**
+         * @param source The image of the bootstrap container.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder source(String source) {
+            return source(Output.of(source));
+        }
+
+        /**
+         * @param userData Base64 encoded user data passed to the bootstrap container.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder userData(@Nullable Output<String> userData) {
+            $.userData = userData;
+            return this;
+        }
+
+        /**
+         * @param userData Base64 encoded user data passed to the bootstrap container.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder userData(String userData) {
+            return userData(Output.of(userData));
+        }
+
+        public BottlerocketBootstrapContainerArgs build() {
+            if ($.source == null) {
+                throw new MissingRequiredPropertyException("BottlerocketBootstrapContainerArgs", "source");
+            }
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,243 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.eks.inputs.BottlerocketBootstrapContainerArgs;
+import com.pulumi.eks.inputs.BottlerocketContainerRegistryArgs;
+import com.pulumi.eks.inputs.BottlerocketHostContainersArgs;
+import com.pulumi.eks.inputs.BottlerocketKernelSettingsArgs;
+import com.pulumi.eks.inputs.BottlerocketKubernetesSettingsArgs;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Typed configuration of the most common Bottlerocket settings.
+ * 
+ * See for more details: https://bottlerocket.dev/en/os/1.20.x/api/settings/.
+ * 
+ */
+public final class BottlerocketConfigArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final BottlerocketConfigArgs Empty = new BottlerocketConfigArgs();
+
+    /**
+     * Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+     * 
+     */
+    @Import(name="bootstrapContainers")
+    private @Nullable Output<Map<String,BottlerocketBootstrapContainerArgs>> bootstrapContainers;
+
+    /**
+     * @return Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+     * 
+     */
+    public Optional<Output<Map<String,BottlerocketBootstrapContainerArgs>>> bootstrapContainers() {
+        return Optional.ofNullable(this.bootstrapContainers);
+    }
+
+    /**
+     * Settings of the container registries, i.e. `settings.container-registry`.
+     * 
+     */
+    @Import(name="containerRegistry")
+    private @Nullable Output<BottlerocketContainerRegistryArgs> containerRegistry;
+
+    /**
+     * @return Settings of the container registries, i.e. `settings.container-registry`.
+     * 
+     */
+    public Optional<Output<BottlerocketContainerRegistryArgs>> containerRegistry() {
+        return Optional.ofNullable(this.containerRegistry);
+    }
+
+    /**
+     * Settings of the admin and control host containers, i.e. `settings.host-containers`.
+     * 
+     */
+    @Import(name="hostContainers")
+    private @Nullable Output<BottlerocketHostContainersArgs> hostContainers;
+
+    /**
+     * @return Settings of the admin and control host containers, i.e. `settings.host-containers`.
+     * 
+     */
+    public Optional<Output<BottlerocketHostContainersArgs>> hostContainers() {
+        return Optional.ofNullable(this.hostContainers);
+    }
+
+    /**
+     * Settings of the kernel, i.e. `settings.kernel`.
+     * 
+     */
+    @Import(name="kernel")
+    private @Nullable Output<BottlerocketKernelSettingsArgs> kernel;
+
+    /**
+     * @return Settings of the kernel, i.e. `settings.kernel`.
+     * 
+     */
+    public Optional<Output<BottlerocketKernelSettingsArgs>> kernel() {
+        return Optional.ofNullable(this.kernel);
+    }
+
+    /**
+     * Settings of the kubelet, i.e. `settings.kubernetes`.
+     * 
+     */
+    @Import(name="kubernetes")
+    private @Nullable Output<BottlerocketKubernetesSettingsArgs> kubernetes;
+
+    /**
+     * @return Settings of the kubelet, i.e. `settings.kubernetes`.
+     * 
+     */
+    public Optional<Output<BottlerocketKubernetesSettingsArgs>> kubernetes() {
+        return Optional.ofNullable(this.kubernetes);
+    }
+
+    private BottlerocketConfigArgs() {}
+
+    private BottlerocketConfigArgs(BottlerocketConfigArgs $) {
+        this.bootstrapContainers = $.bootstrapContainers;
+        this.containerRegistry = $.containerRegistry;
+        this.hostContainers = $.hostContainers;
+        this.kernel = $.kernel;
+        this.kubernetes = $.kubernetes;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(BottlerocketConfigArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private BottlerocketConfigArgs $;
+
+        public Builder() {
+            $ = new BottlerocketConfigArgs();
+        }
+
+        public Builder(BottlerocketConfigArgs defaults) {
+            $ = new BottlerocketConfigArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param bootstrapContainers Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder bootstrapContainers(@Nullable Output<Map<String,BottlerocketBootstrapContainerArgs>> bootstrapContainers) {
+            $.bootstrapContainers = bootstrapContainers;
+            return this;
+        }
+
+        /**
+         * @param bootstrapContainers Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder bootstrapContainers(Map<String,BottlerocketBootstrapContainerArgs> bootstrapContainers) {
+            return bootstrapContainers(Output.of(bootstrapContainers));
+        }
+
+        /**
+         * @param containerRegistry Settings of the container registries, i.e. `settings.container-registry`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder containerRegistry(@Nullable Output<BottlerocketContainerRegistryArgs> containerRegistry) {
+            $.containerRegistry = containerRegistry;
+            return this;
+        }
+
+        /**
+         * @param containerRegistry Settings of the container registries, i.e. `settings.container-registry`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder containerRegistry(BottlerocketContainerRegistryArgs containerRegistry) {
+            return containerRegistry(Output.of(containerRegistry));
+        }
+
+        /**
+         * @param hostContainers Settings of the admin and control host containers, i.e. `settings.host-containers`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder hostContainers(@Nullable Output<BottlerocketHostContainersArgs> hostContainers) {
+            $.hostContainers = hostContainers;
+            return this;
+        }
+
+        /**
+         * @param hostContainers Settings of the admin and control host containers, i.e. `settings.host-containers`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder hostContainers(BottlerocketHostContainersArgs hostContainers) {
+            return hostContainers(Output.of(hostContainers));
+        }
+
+        /**
+         * @param kernel Settings of the kernel, i.e. `settings.kernel`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder kernel(@Nullable Output<BottlerocketKernelSettingsArgs> kernel) {
+            $.kernel = kernel;
+            return this;
+        }
+
+        /**
+         * @param kernel Settings of the kernel, i.e. `settings.kernel`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder kernel(BottlerocketKernelSettingsArgs kernel) {
+            return kernel(Output.of(kernel));
+        }
+
+        /**
+         * @param kubernetes Settings of the kubelet, i.e. `settings.kubernetes`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder kubernetes(@Nullable Output<BottlerocketKubernetesSettingsArgs> kubernetes) {
+            $.kubernetes = kubernetes;
+            return this;
+        }
+
+        /**
+         * @param kubernetes Settings of the kubelet, i.e. `settings.kubernetes`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder kubernetes(BottlerocketKubernetesSettingsArgs kubernetes) {
+            return kubernetes(Output.of(kubernetes));
+        }
+
+        public BottlerocketConfigArgs build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,98 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.eks.inputs.BottlerocketRegistryMirrorArgs;
+import java.util.List;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Settings of the container registries of Bottlerocket nodes.
+ * 
+ */
+public final class BottlerocketContainerRegistryArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final BottlerocketContainerRegistryArgs Empty = new BottlerocketContainerRegistryArgs();
+
+    /**
+     * Mirrors to pull images from instead of the original registries.
+     * 
+     */
+    @Import(name="mirrors")
+    private @Nullable Output<List<BottlerocketRegistryMirrorArgs>> mirrors;
+
+    /**
+     * @return Mirrors to pull images from instead of the original registries.
+     * 
+     */
+    public Optional<Output<List<BottlerocketRegistryMirrorArgs>>> mirrors() {
+        return Optional.ofNullable(this.mirrors);
+    }
+
+    private BottlerocketContainerRegistryArgs() {}
+
+    private BottlerocketContainerRegistryArgs(BottlerocketContainerRegistryArgs $) {
+        this.mirrors = $.mirrors;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(BottlerocketContainerRegistryArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private BottlerocketContainerRegistryArgs $;
+
+        public Builder() {
+            $ = new BottlerocketContainerRegistryArgs();
+        }
+
+        public Builder(BottlerocketContainerRegistryArgs defaults) {
+            $ = new BottlerocketContainerRegistryArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param mirrors Mirrors to pull images from instead of the original registries.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder mirrors(@Nullable Output<List<BottlerocketRegistryMirrorArgs>> mirrors) {
+            $.mirrors = mirrors;
+            return this;
+        }
+
+        /**
+         * @param mirrors Mirrors to pull images from instead of the original registries.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder mirrors(List<BottlerocketRegistryMirrorArgs> mirrors) {
+            return mirrors(Output.of(mirrors));
+        }
+
+        /**
+         * @param mirrors Mirrors to pull images from instead of the original registries.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder mirrors(BottlerocketRegistryMirrorArgs... mirrors) {
+            return mirrors(List.of(mirrors));
+        }
+
+        public BottlerocketContainerRegistryArgs build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,199 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.Boolean;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Settings of a Bottlerocket host container.
+ * 
+ */
+public final class BottlerocketHostContainerArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final BottlerocketHostContainerArgs Empty = new BottlerocketHostContainerArgs();
+
+    /**
+     * Whether the host container runs.
+     * 
+     */
+    @Import(name="enabled")
+    private @Nullable Output<Boolean> enabled;
+
+    /**
+     * @return Whether the host container runs.
+     * 
+     */
+    public Optional<Output<Boolean>> enabled() {
+        return Optional.ofNullable(this.enabled);
+    }
+
+    /**
+     * The image of the host container.
+     * 
+     */
+    @Import(name="source")
+    private @Nullable Output<String> source;
+
+    /**
+     * @return The image of the host container.
+     * 
+     */
+    public Optional<Output<String>> source() {
+        return Optional.ofNullable(this.source);
+    }
+
+    /**
+     * Whether the host container has elevated privileges.
+     * 
+     */
+    @Import(name="superpowered")
+    private @Nullable Output<Boolean> superpowered;
+
+    /**
+     * @return Whether the host container has elevated privileges.
+     * 
+     */
+    public Optional<Output<Boolean>> superpowered() {
+        return Optional.ofNullable(this.superpowered);
+    }
+
+    /**
+     * Base64 encoded user data passed to the host container.
+     * 
+     */
+    @Import(name="userData")
+    private @Nullable Output<String> userData;
+
+    /**
+     * @return Base64 encoded user data passed to the host container.
+     * 
+     */
+    public Optional<Output<String>> userData() {
+        return Optional.ofNullable(this.userData);
+    }
+
+    private BottlerocketHostContainerArgs() {}
+
+    private BottlerocketHostContainerArgs(BottlerocketHostContainerArgs $) {
+        this.enabled = $.enabled;
+        this.source = $.source;
+        this.superpowered = $.superpowered;
+        this.userData = $.userData;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(BottlerocketHostContainerArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private BottlerocketHostContainerArgs $;
+
+        public Builder() {
+            $ = new BottlerocketHostContainerArgs();
+        }
+
+        public Builder(BottlerocketHostContainerArgs defaults) {
+            $ = new BottlerocketHostContainerArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param enabled Whether the host container runs.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder enabled(@Nullable Output<Boolean> enabled) {
+            $.enabled = enabled;
+            return this;
+        }
+
+        /**
+         * @param enabled Whether the host container runs.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder enabled(Boolean enabled) {
+            return enabled(Output.of(enabled));
+        }
+
+        /**
+         * @param source The image of the host container.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder source(@Nullable Output<String> source) {
+            $.source = source;
+            return this;
+        }
+
+        /**
+         * @param source The image of the host container.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder source(String source) {
+            return source(Output.of(source));
+        }
+
+        /**
+         * @param superpowered Whether the host container has elevated privileges.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder superpowered(@Nullable Output<Boolean> superpowered) {
+            $.superpowered = superpowered;
+            return this;
+        }
+
+        /**
+         * @param superpowered Whether the host container has elevated privileges.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder superpowered(Boolean superpowered) {
+            return superpowered(Output.of(superpowered));
+        }
+
+        /**
+         * @param userData Base64 encoded user data passed to the host container.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder userData(@Nullable Output<String> userData) {
+            $.userData = userData;
+            return this;
+        }
+
+        /**
+         * @param userData Base64 encoded user data passed to the host container.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder userData(String userData) {
+            return userData(Output.of(userData));
+        }
+
+        public BottlerocketHostContainerArgs build() {
+            return $;
+        }
+    }
+
+}
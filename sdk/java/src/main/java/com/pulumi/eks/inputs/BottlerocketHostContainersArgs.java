@@ -0,0 +1,124 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.eks.inputs.BottlerocketHostContainerArgs;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Settings of the Bottlerocket host containers.
+ * 
+ */
+public final class BottlerocketHostContainersArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final BottlerocketHostContainersArgs Empty = new BottlerocketHostContainersArgs();
+
+    /**
+     * The admin container, used for troubleshooting the node.
+     * 
+     */
+    @Import(name="admin")
+    private @Nullable Output<BottlerocketHostContainerArgs> admin;
+
+    /**
+     * @return The admin container, used for troubleshooting the node.
+     * 
+     */
+    public Optional<Output<BottlerocketHostContainerArgs>> admin() {
+        return Optional.ofNullable(this.admin);
+    }
+
+    /**
+     * The control container, used to access the Bottlerocket API through AWS SSM.
+     * 
+     */
+    @Import(name="control")
+    private @Nullable Output<BottlerocketHostContainerArgs> control;
+
+    /**
+     * @return The control container, used to access the Bottlerocket API through AWS SSM.
+     * 
+     */
+    public Optional<Output<BottlerocketHostContainerArgs>> control() {
+        return Optional.ofNullable(this.control);
+    }
+
+    private BottlerocketHostContainersArgs() {}
+
+    private BottlerocketHostContainersArgs(BottlerocketHostContainersArgs $) {
+        this.admin = $.admin;
+        this.control = $.control;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(BottlerocketHostContainersArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private BottlerocketHostContainersArgs $;
+
+        public Builder() {
+            $ = new BottlerocketHostContainersArgs();
+        }
+
+        public Builder(BottlerocketHostContainersArgs defaults) {
+            $ = new BottlerocketHostContainersArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param admin The admin container, used for troubleshooting the node.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder admin(@Nullable Output<BottlerocketHostContainerArgs> admin) {
+            $.admin = admin;
+            return this;
+        }
+
+        /**
+         * @param admin The admin container, used for troubleshooting the node.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder admin(BottlerocketHostContainerArgs admin) {
+            return admin(Output.of(admin));
+        }
+
+        /**
+         * @param control The control container, used to access the Bottlerocket API through AWS SSM.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder control(@Nullable Output<BottlerocketHostContainerArgs> control) {
+            $.control = control;
+            return this;
+        }
+
+        /**
+         * @param control The control container, used to access the Bottlerocket API through AWS SSM.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder control(BottlerocketHostContainerArgs control) {
+            return control(Output.of(control));
+        }
+
+        public BottlerocketHostContainersArgs build() {
+            return $;
+        }
+    }
+
+}
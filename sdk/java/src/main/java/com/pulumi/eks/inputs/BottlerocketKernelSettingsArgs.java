@@ -0,0 +1,125 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Settings of the Bottlerocket kernel.
+ * 
+ */
+public final class BottlerocketKernelSettingsArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final BottlerocketKernelSettingsArgs Empty = new BottlerocketKernelSettingsArgs();
+
+    /**
+     * The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+     * 
+     */
+    @Import(name="lockdown")
+    private @Nullable Output<String> lockdown;
+
+    /**
+     * @return The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+     * 
+     */
+    public Optional<Output<String>> lockdown() {
+        return Optional.ofNullable(this.lockdown);
+    }
+
+    /**
+     * Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: &#34;1&#34;`.
+     * 
+     */
+    @Import(name="sysctl")
+    private @Nullable Output<Map<String,String>> sysctl;
+
+    /**
+     * @return Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: &#34;1&#34;`.
+     * 
+     */
+    public Optional<Output<Map<String,String>>> sysctl() {
+        return Optional.ofNullable(this.sysctl);
+    }
+
+    private BottlerocketKernelSettingsArgs() {}
+
+    private BottlerocketKernelSettingsArgs(BottlerocketKernelSettingsArgs $) {
+        this.lockdown = $.lockdown;
+        this.sysctl = $.sysctl;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(BottlerocketKernelSettingsArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private BottlerocketKernelSettingsArgs $;
+
+        public Builder() {
+            $ = new BottlerocketKernelSettingsArgs();
+        }
+
+        public Builder(BottlerocketKernelSettingsArgs defaults) {
+            $ = new BottlerocketKernelSettingsArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param lockdown The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder lockdown(@Nullable Output<String> lockdown) {
+            $.lockdown = lockdown;
+            return this;
+        }
+
+        /**
+         * @param lockdown The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder lockdown(String lockdown) {
+            return lockdown(Output.of(lockdown));
+        }
+
+        /**
+         * @param sysctl Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: &#34;1&#34;`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder sysctl(@Nullable Output<Map<String,String>> sysctl) {
+            $.sysctl = sysctl;
+            return this;
+        }
+
+        /**
+         * @param sysctl Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: &#34;1&#34;`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder sysctl(Map<String,String> sysctl) {
+            return sysctl(Output.of(sysctl));
+        }
+
+        public BottlerocketKernelSettingsArgs build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,348 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Settings of the kubelet on Bottlerocket nodes.
+ * 
+ */
+public final class BottlerocketKubernetesSettingsArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final BottlerocketKubernetesSettingsArgs Empty = new BottlerocketKubernetesSettingsArgs();
+
+    /**
+     * Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+     * 
+     */
+    @Import(name="evictionHard")
+    private @Nullable Output<Map<String,String>> evictionHard;
+
+    /**
+     * @return Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+     * 
+     */
+    public Optional<Output<Map<String,String>>> evictionHard() {
+        return Optional.ofNullable(this.evictionHard);
+    }
+
+    /**
+     * Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+     * 
+     */
+    @Import(name="evictionSoft")
+    private @Nullable Output<Map<String,String>> evictionSoft;
+
+    /**
+     * @return Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+     * 
+     */
+    public Optional<Output<Map<String,String>>> evictionSoft() {
+        return Optional.ofNullable(this.evictionSoft);
+    }
+
+    /**
+     * Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+     * 
+     */
+    @Import(name="evictionSoftGracePeriod")
+    private @Nullable Output<Map<String,String>> evictionSoftGracePeriod;
+
+    /**
+     * @return Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+     * 
+     */
+    public Optional<Output<Map<String,String>>> evictionSoftGracePeriod() {
+        return Optional.ofNullable(this.evictionSoftGracePeriod);
+    }
+
+    /**
+     * The disk usage in percent after which image garbage collection always runs.
+     * 
+     */
+    @Import(name="imageGcHighThresholdPercent")
+    private @Nullable Output<Integer> imageGcHighThresholdPercent;
+
+    /**
+     * @return The disk usage in percent after which image garbage collection always runs.
+     * 
+     */
+    public Optional<Output<Integer>> imageGcHighThresholdPercent() {
+        return Optional.ofNullable(this.imageGcHighThresholdPercent);
+    }
+
+    /**
+     * The disk usage in percent before which image garbage collection never runs.
+     * 
+     */
+    @Import(name="imageGcLowThresholdPercent")
+    private @Nullable Output<Integer> imageGcLowThresholdPercent;
+
+    /**
+     * @return The disk usage in percent before which image garbage collection never runs.
+     * 
+     */
+    public Optional<Output<Integer>> imageGcLowThresholdPercent() {
+        return Optional.ofNullable(this.imageGcLowThresholdPercent);
+    }
+
+    /**
+     * The maximum number of pods the kubelet runs on the node.
+     * 
+     */
+    @Import(name="maxPods")
+    private @Nullable Output<Integer> maxPods;
+
+    /**
+     * @return The maximum number of pods the kubelet runs on the node.
+     * 
+     */
+    public Optional<Output<Integer>> maxPods() {
+        return Optional.ofNullable(this.maxPods);
+    }
+
+    /**
+     * Labels the node registers with.
+     * 
+     */
+    @Import(name="nodeLabels")
+    private @Nullable Output<Map<String,String>> nodeLabels;
+
+    /**
+     * @return Labels the node registers with.
+     * 
+     */
+    public Optional<Output<Map<String,String>>> nodeLabels() {
+        return Optional.ofNullable(this.nodeLabels);
+    }
+
+    /**
+     * Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+     * 
+     */
+    @Import(name="nodeTaints")
+    private @Nullable Output<Map<String,String>> nodeTaints;
+
+    /**
+     * @return Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+     * 
+     */
+    public Optional<Output<Map<String,String>>> nodeTaints() {
+        return Optional.ofNullable(this.nodeTaints);
+    }
+
+    private BottlerocketKubernetesSettingsArgs() {}
+
+    private BottlerocketKubernetesSettingsArgs(BottlerocketKubernetesSettingsArgs $) {
+        this.evictionHard = $.evictionHard;
+        this.evictionSoft = $.evictionSoft;
+        this.evictionSoftGracePeriod = $.evictionSoftGracePeriod;
+        this.imageGcHighThresholdPercent = $.imageGcHighThresholdPercent;
+        this.imageGcLowThresholdPercent = $.imageGcLowThresholdPercent;
+        this.maxPods = $.maxPods;
+        this.nodeLabels = $.nodeLabels;
+        this.nodeTaints = $.nodeTaints;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(BottlerocketKubernetesSettingsArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private BottlerocketKubernetesSettingsArgs $;
+
+        public Builder() {
+            $ = new BottlerocketKubernetesSettingsArgs();
+        }
+
+        public Builder(BottlerocketKubernetesSettingsArgs defaults) {
+            $ = new BottlerocketKubernetesSettingsArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param evictionHard Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder evictionHard(@Nullable Output<Map<String,String>> evictionHard) {
+            $.evictionHard = evictionHard;
+            return this;
+        }
+
+        /**
+         * @param evictionHard Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder evictionHard(Map<String,String> evictionHard) {
+            return evictionHard(Output.of(evictionHard));
+        }
+
+        /**
+         * @param evictionSoft Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder evictionSoft(@Nullable Output<Map<String,String>> evictionSoft) {
+            $.evictionSoft = evictionSoft;
+            return this;
+        }
+
+        /**
+         * @param evictionSoft Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder evictionSoft(Map<String,String> evictionSoft) {
+            return evictionSoft(Output.of(evictionSoft));
+        }
+
+        /**
+         * @param evictionSoftGracePeriod Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder evictionSoftGracePeriod(@Nullable Output<Map<String,String>> evictionSoftGracePeriod) {
+            $.evictionSoftGracePeriod = evictionSoftGracePeriod;
+            return this;
+        }
+
+        /**
+         * @param evictionSoftGracePeriod Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder evictionSoftGracePeriod(Map<String,String> evictionSoftGracePeriod) {
+            return evictionSoftGracePeriod(Output.of(evictionSoftGracePeriod));
+        }
+
+        /**
+         * @param imageGcHighThresholdPercent The disk usage in percent after which image garbage collection always runs.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder imageGcHighThresholdPercent(@Nullable Output<Integer> imageGcHighThresholdPercent) {
+            $.imageGcHighThresholdPercent = imageGcHighThresholdPercent;
+            return this;
+        }
+
+        /**
+         * @param imageGcHighThresholdPercent The disk usage in percent after which image garbage collection always runs.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder imageGcHighThresholdPercent(Integer imageGcHighThresholdPercent) {
+            return imageGcHighThresholdPercent(Output.of(imageGcHighThresholdPercent));
+        }
+
+        /**
+         * @param imageGcLowThresholdPercent The disk usage in percent before which image garbage collection never runs.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder imageGcLowThresholdPercent(@Nullable Output<Integer> imageGcLowThresholdPercent) {
+            $.imageGcLowThresholdPercent = imageGcLowThresholdPercent;
+            return this;
+        }
+
+        /**
+         * @param imageGcLowThresholdPercent The disk usage in percent before which image garbage collection never runs.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder imageGcLowThresholdPercent(Integer imageGcLowThresholdPercent) {
+            return imageGcLowThresholdPercent(Output.of(imageGcLowThresholdPercent));
+        }
+
+        /**
+         * @param maxPods The maximum number of pods the kubelet runs on the node.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder maxPods(@Nullable Output<Integer> maxPods) {
+            $.maxPods = maxPods;
+            return this;
+        }
+
+        /**
+         * @param maxPods The maximum number of pods the kubelet runs on the node.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder maxPods(Integer maxPods) {
+            return maxPods(Output.of(maxPods));
+        }
+
+        /**
+         * @param nodeLabels Labels the node registers with.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder nodeLabels(@Nullable Output<Map<String,String>> nodeLabels) {
+            $.nodeLabels = nodeLabels;
+            return this;
+        }
+
+        /**
+         * @param nodeLabels Labels the node registers with.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder nodeLabels(Map<String,String> nodeLabels) {
+            return nodeLabels(Output.of(nodeLabels));
+        }
+
+        /**
+         * @param nodeTaints Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder nodeTaints(@Nullable Output<Map<String,String>> nodeTaints) {
+            $.nodeTaints = nodeTaints;
+            return this;
+        }
+
+        /**
+         * @param nodeTaints Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder nodeTaints(Map<String,String> nodeTaints) {
+            return nodeTaints(Output.of(nodeTaints));
+        }
+
+        public BottlerocketKubernetesSettingsArgs build() {
+            return $;
+        }
+    }
+
+}
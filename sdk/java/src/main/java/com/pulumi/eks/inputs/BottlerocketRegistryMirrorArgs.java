@@ -0,0 +1,140 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.String;
+import java.util.List;
+import java.util.Objects;
+
+
+/**
+ * A mirror of a container registry.
+ * 
+ */
+public final class BottlerocketRegistryMirrorArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final BottlerocketRegistryMirrorArgs Empty = new BottlerocketRegistryMirrorArgs();
+
+    /**
+     * The endpoints of the mirror, in order of preference.
+     * 
+     */
+    @Import(name="endpoints", required=true)
+    private Output<List<String>> endpoints;
+
+    /**
+     * @return The endpoints of the mirror, in order of preference.
+     * 
+     */
+    public Output<List<String>> endpoints() {
+        return this.endpoints;
+    }
+
+    /**
+     * The registry to mirror, e.g. `docker.io`.
+     * 
+     */
+    @Import(name="registry", required=true)
+    private Output<String> registry;
+
+    /**
+     * @return The registry to mirror, e.g. `docker.io`.
+     * 
+     */
+    public Output<String> registry() {
+        return this.registry;
+    }
+
+    private BottlerocketRegistryMirrorArgs() {}
+
+    private BottlerocketRegistryMirrorArgs(BottlerocketRegistryMirrorArgs $) {
+        this.endpoints = $.endpoints;
+        this.registry = $.registry;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(BottlerocketRegistryMirrorArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private BottlerocketRegistryMirrorArgs $;
+
+        public Builder() {
+            $ = new BottlerocketRegistryMirrorArgs();
+        }
+
+        public Builder(BottlerocketRegistryMirrorArgs defaults) {
+            $ = new BottlerocketRegistryMirrorArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param endpoints The endpoints of the mirror, in order of preference.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder endpoints(Output<List<String>> endpoints) {
+            $.endpoints = endpoints;
+            return this;
+        }
+
+        /**
+         * @param endpoints The endpoints of the mirror, in order of preference.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder endpoints(List<String> endpoints) {
+            return endpoints(Output.of(endpoints));
+        }
+
+        /**
+         * @param endpoints The endpoints of the mirror, in order of preference.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder endpoints(String... endpoints) {
+            return endpoints(List.of(endpoints));
+        }
+
+        /**
+         * @param registry The registry to mirror, e.g. `docker.io`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder registry(Output<String> registry) {
+            $.registry = registry;
+            return this;
+        }
+
+        /**
+         * @param registry The registry to mirror, e.g. `docker.io`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder registry(String registry) {
+            return registry(Output.of(registry));
+        }
+
+        public BottlerocketRegistryMirrorArgs build() {
+            if ($.endpoints == null) {
+                throw new MissingRequiredPropertyException("BottlerocketRegistryMirrorArgs", "endpoints");
+            }
+            if ($.registry == null) {
+                throw new MissingRequiredPropertyException("BottlerocketRegistryMirrorArgs", "registry");
+            }
+            return $;
+        }
+    }
+
+}
@@ -4,10 +4,14 @@
 package com.pulumi.eks.inputs;
 
 import com.pulumi.aws.Provider;
+import com.pulumi.aws.cloudwatch.LogGroup;
 import com.pulumi.aws.ec2.SecurityGroup;
 import com.pulumi.aws.eks.Cluster;
 import com.pulumi.aws.eks.FargateProfile;
+import com.pulumi.aws.eks.inputs.ClusterControlPlaneScalingConfigArgs;
 import com.pulumi.aws.eks.inputs.ClusterEncryptionConfigArgs;
+import com.pulumi.aws.eks.inputs.ClusterUpgradePolicyArgs;
+import com.pulumi.aws.eks.inputs.ClusterZonalShiftConfigArgs;
 import com.pulumi.aws.iam.OpenIdConnectProvider;
 import com.pulumi.aws.iam.Role;
 import com.pulumi.core.Output;
@@ -15,6 +19,7 @@ import com.pulumi.core.annotations.Import;
 import com.pulumi.eks.VpcCniAddon;
 import com.pulumi.eks.inputs.AccessEntryArgs;
 import com.pulumi.eks.inputs.ClusterNodeGroupOptionsArgs;
+import com.pulumi.eks.inputs.VpcCniNetworkingArgs;
 import com.pulumi.exceptions.MissingRequiredPropertyException;
 import com.pulumi.kubernetes.core.v1.ConfigMap;
 import com.pulumi.kubernetes.storage.v1.StorageClass;
@@ -79,6 +84,21 @@ public final class CoreDataArgs extends com.pulumi.resources.ResourceArgs {
         return this.clusterIamRole;
     }
 
+    /**
+     * The log group of the control plane logs, if it is managed by the cluster.
+     * 
+     */
+    @Import(name="clusterLogGroup")
+    private @Nullable Output<LogGroup> clusterLogGroup;
+
+    /**
+     * @return The log group of the control plane logs, if it is managed by the cluster.
+     * 
+     */
+    public Optional<Output<LogGroup>> clusterLogGroup() {
+        return Optional.ofNullable(this.clusterLogGroup);
+    }
+
     @Import(name="clusterSecurityGroup")
     private @Nullable Output<SecurityGroup> clusterSecurityGroup;
 
@@ -86,6 +106,21 @@ public final class CoreDataArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.clusterSecurityGroup);
     }
 
+    /**
+     * The scaling configuration of the control plane, including its provisioned tier.
+     * 
+     */
+    @Import(name="controlPlaneScalingConfig")
+    private @Nullable Output<ClusterControlPlaneScalingConfigArgs> controlPlaneScalingConfig;
+
+    /**
+     * @return The scaling configuration of the control plane, including its provisioned tier.
+     * 
+     */
+    public Optional<Output<ClusterControlPlaneScalingConfigArgs>> controlPlaneScalingConfig() {
+        return Optional.ofNullable(this.controlPlaneScalingConfig);
+    }
+
     @Import(name="eksNodeAccess")
     private @Nullable Output<ConfigMap> eksNodeAccess;
 
@@ -279,6 +314,21 @@ public final class CoreDataArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.tags);
     }
 
+    /**
+     * The upgrade policy of the cluster, which determines whether it gets extended support.
+     * 
+     */
+    @Import(name="upgradePolicy")
+    private @Nullable Output<ClusterUpgradePolicyArgs> upgradePolicy;
+
+    /**
+     * @return The upgrade policy of the cluster, which determines whether it gets extended support.
+     * 
+     */
+    public Optional<Output<ClusterUpgradePolicyArgs>> upgradePolicy() {
+        return Optional.ofNullable(this.upgradePolicy);
+    }
+
     /**
      * The VPC CNI for the cluster.
      * 
@@ -294,6 +344,21 @@ public final class CoreDataArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.vpcCni);
     }
 
+    /**
+     * The VPC CNI settings that affect the maximum number of pods of the nodes.
+     * 
+     */
+    @Import(name="vpcCniNetworking")
+    private @Nullable Output<VpcCniNetworkingArgs> vpcCniNetworking;
+
+    /**
+     * @return The VPC CNI settings that affect the maximum number of pods of the nodes.
+     * 
+     */
+    public Optional<Output<VpcCniNetworkingArgs>> vpcCniNetworking() {
+        return Optional.ofNullable(this.vpcCniNetworking);
+    }
+
     /**
      * ID of the cluster&#39;s VPC.
      * 
@@ -309,6 +374,21 @@ public final class CoreDataArgs extends com.pulumi.resources.ResourceArgs {
         return this.vpcId;
     }
 
+    /**
+     * The zonal shift configuration of the cluster.
+     * 
+     */
+    @Import(name="zonalShiftConfig")
+    private @Nullable Output<ClusterZonalShiftConfigArgs> zonalShiftConfig;
+
+    /**
+     * @return The zonal shift configuration of the cluster.
+     * 
+     */
+    public Optional<Output<ClusterZonalShiftConfigArgs>> zonalShiftConfig() {
+        return Optional.ofNullable(this.zonalShiftConfig);
+    }
+
     private CoreDataArgs() {}
 
     private CoreDataArgs(CoreDataArgs $) {
@@ -316,7 +396,9 @@ public final class CoreDataArgs extends com.pulumi.resources.ResourceArgs {
         this.awsProvider = $.awsProvider;
         this.cluster = $.cluster;
         this.clusterIamRole = $.clusterIamRole;
+        this.clusterLogGroup = $.clusterLogGroup;
         this.clusterSecurityGroup = $.clusterSecurityGroup;
+        this.controlPlaneScalingConfig = $.controlPlaneScalingConfig;
         this.eksNodeAccess = $.eksNodeAccess;
         this.encryptionConfig = $.encryptionConfig;
         this.endpoint = $.endpoint;
@@ -332,8 +414,11 @@ public final class CoreDataArgs extends com.pulumi.resources.ResourceArgs {
         this.storageClasses = $.storageClasses;
         this.subnetIds = $.subnetIds;
         this.tags = $.tags;
+        this.upgradePolicy = $.upgradePolicy;
         this.vpcCni = $.vpcCni;
+        this.vpcCniNetworking = $.vpcCniNetworking;
         this.vpcId = $.vpcId;
+        this.zonalShiftConfig = $.zonalShiftConfig;
     }
 
     public static Builder builder() {
@@ -424,6 +509,27 @@ public final class CoreDataArgs extends com.pulumi.resources.ResourceArgs {
             return clusterIamRole(Output.of(clusterIamRole));
         }
 
+        /**
+         * @param clusterLogGroup The log group of the control plane logs, if it is managed by the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder clusterLogGroup(@Nullable Output<LogGroup> clusterLogGroup) {
+            $.clusterLogGroup = clusterLogGroup;
+            return this;
+        }
+
+        /**
+         * @param clusterLogGroup The log group of the control plane logs, if it is managed by the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder clusterLogGroup(LogGroup clusterLogGroup) {
+            return clusterLogGroup(Output.of(clusterLogGroup));
+        }
+
         public Builder clusterSecurityGroup(@Nullable Output<SecurityGroup> clusterSecurityGroup) {
             $.clusterSecurityGroup = clusterSecurityGroup;
             return this;
@@ -433,6 +539,27 @@ public final class CoreDataArgs extends com.pulumi.resources.ResourceArgs {
             return clusterSecurityGroup(Output.of(clusterSecurityGroup));
         }
 
+        /**
+         * @param controlPlaneScalingConfig The scaling configuration of the control plane, including its provisioned tier.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder controlPlaneScalingConfig(@Nullable Output<ClusterControlPlaneScalingConfigArgs> controlPlaneScalingConfig) {
+            $.controlPlaneScalingConfig = controlPlaneScalingConfig;
+            return this;
+        }
+
+        /**
+         * @param controlPlaneScalingConfig The scaling configuration of the control plane, including its provisioned tier.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder controlPlaneScalingConfig(ClusterControlPlaneScalingConfigArgs controlPlaneScalingConfig) {
+            return controlPlaneScalingConfig(Output.of(controlPlaneScalingConfig));
+        }
+
         public Builder eksNodeAccess(@Nullable Output<ConfigMap> eksNodeAccess) {
             $.eksNodeAccess = eksNodeAccess;
             return this;
@@ -740,6 +867,27 @@ public final class CoreDataArgs extends com.pulumi.resources.ResourceArgs {
             return tags(Output.of(tags));
         }
 
+        /**
+         * @param upgradePolicy The upgrade policy of the cluster, which determines whether it gets extended support.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder upgradePolicy(@Nullable Output<ClusterUpgradePolicyArgs> upgradePolicy) {
+            $.upgradePolicy = upgradePolicy;
+            return this;
+        }
+
+        /**
+         * @param upgradePolicy The upgrade policy of the cluster, which determines whether it gets extended support.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder upgradePolicy(ClusterUpgradePolicyArgs upgradePolicy) {
+            return upgradePolicy(Output.of(upgradePolicy));
+        }
+
         /**
          * @param vpcCni The VPC CNI for the cluster.
          * 
@@ -761,6 +909,27 @@ public final class CoreDataArgs extends com.pulumi.resources.ResourceArgs {
             return vpcCni(Output.of(vpcCni));
         }
 
+        /**
+         * @param vpcCniNetworking The VPC CNI settings that affect the maximum number of pods of the nodes.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder vpcCniNetworking(@Nullable Output<VpcCniNetworkingArgs> vpcCniNetworking) {
+            $.vpcCniNetworking = vpcCniNetworking;
+            return this;
+        }
+
+        /**
+         * @param vpcCniNetworking The VPC CNI settings that affect the maximum number of pods of the nodes.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder vpcCniNetworking(VpcCniNetworkingArgs vpcCniNetworking) {
+            return vpcCniNetworking(Output.of(vpcCniNetworking));
+        }
+
         /**
          * @param vpcId ID of the cluster&#39;s VPC.
          * 
@@ -782,6 +951,27 @@ public final class CoreDataArgs extends com.pulumi.resources.ResourceArgs {
             return vpcId(Output.of(vpcId));
         }
 
+        /**
+         * @param zonalShiftConfig The zonal shift configuration of the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder zonalShiftConfig(@Nullable Output<ClusterZonalShiftConfigArgs> zonalShiftConfig) {
+            $.zonalShiftConfig = zonalShiftConfig;
+            return this;
+        }
+
+        /**
+         * @param zonalShiftConfig The zonal shift configuration of the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder zonalShiftConfig(ClusterZonalShiftConfigArgs zonalShiftConfig) {
+            return zonalShiftConfig(Output.of(zonalShiftConfig));
+        }
+
         public CoreDataArgs build() {
             if ($.cluster == null) {
                 throw new MissingRequiredPropertyException("CoreDataArgs", "cluster");
@@ -0,0 +1,248 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.Boolean;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.List;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Configures the KMS key the cluster creates for envelope encryption of Kubernetes secrets.
+ * 
+ */
+public final class EncryptionConfigOptionsArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final EncryptionConfigOptionsArgs Empty = new EncryptionConfigOptionsArgs();
+
+    /**
+     * The name of the alias of the key. Must start with `alias/`. Defaults to `alias/eks/&lt;clusterName&gt;`.
+     * 
+     */
+    @Import(name="aliasName")
+    private @Nullable Output<String> aliasName;
+
+    /**
+     * @return The name of the alias of the key. Must start with `alias/`. Defaults to `alias/eks/&lt;clusterName&gt;`.
+     * 
+     */
+    public Optional<Output<String>> aliasName() {
+        return Optional.ofNullable(this.aliasName);
+    }
+
+    /**
+     * The waiting period in days before the key is deleted after the cluster is destroyed. Must be between 7 and 30. Defaults to 30 days.
+     * 
+     */
+    @Import(name="deletionWindowInDays")
+    private @Nullable Output<Integer> deletionWindowInDays;
+
+    /**
+     * @return The waiting period in days before the key is deleted after the cluster is destroyed. Must be between 7 and 30. Defaults to 30 days.
+     * 
+     */
+    public Optional<Output<Integer>> deletionWindowInDays() {
+        return Optional.ofNullable(this.deletionWindowInDays);
+    }
+
+    /**
+     * Whether to enable automatic rotation of the key material. Defaults to `true`.
+     * 
+     */
+    @Import(name="enableKeyRotation")
+    private @Nullable Output<Boolean> enableKeyRotation;
+
+    /**
+     * @return Whether to enable automatic rotation of the key material. Defaults to `true`.
+     * 
+     */
+    public Optional<Output<Boolean>> enableKeyRotation() {
+        return Optional.ofNullable(this.enableKeyRotation);
+    }
+
+    /**
+     * The ARNs of IAM principals that are allowed to administer the key, e.g. to change its policy or schedule its deletion. The AWS account always retains full access to the key so that IAM policies can grant access to it as well.
+     * 
+     */
+    @Import(name="keyAdministratorArns")
+    private @Nullable Output<List<String>> keyAdministratorArns;
+
+    /**
+     * @return The ARNs of IAM principals that are allowed to administer the key, e.g. to change its policy or schedule its deletion. The AWS account always retains full access to the key so that IAM policies can grant access to it as well.
+     * 
+     */
+    public Optional<Output<List<String>>> keyAdministratorArns() {
+        return Optional.ofNullable(this.keyAdministratorArns);
+    }
+
+    /**
+     * The period in days between automatic rotations of the key material. Must be between 90 and 2560. Defaults to 365 days.
+     * 
+     */
+    @Import(name="rotationPeriodInDays")
+    private @Nullable Output<Integer> rotationPeriodInDays;
+
+    /**
+     * @return The period in days between automatic rotations of the key material. Must be between 90 and 2560. Defaults to 365 days.
+     * 
+     */
+    public Optional<Output<Integer>> rotationPeriodInDays() {
+        return Optional.ofNullable(this.rotationPeriodInDays);
+    }
+
+    private EncryptionConfigOptionsArgs() {}
+
+    private EncryptionConfigOptionsArgs(EncryptionConfigOptionsArgs $) {
+        this.aliasName = $.aliasName;
+        this.deletionWindowInDays = $.deletionWindowInDays;
+        this.enableKeyRotation = $.enableKeyRotation;
+        this.keyAdministratorArns = $.keyAdministratorArns;
+        this.rotationPeriodInDays = $.rotationPeriodInDays;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(EncryptionConfigOptionsArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private EncryptionConfigOptionsArgs $;
+
+        public Builder() {
+            $ = new EncryptionConfigOptionsArgs();
+        }
+
+        public Builder(EncryptionConfigOptionsArgs defaults) {
+            $ = new EncryptionConfigOptionsArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param aliasName The name of the alias of the key. Must start with `alias/`. Defaults to `alias/eks/&lt;clusterName&gt;`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder aliasName(@Nullable Output<String> aliasName) {
+            $.aliasName = aliasName;
+            return this;
+        }
+
+        /**
+         * @param aliasName The name of the alias of the key. Must start with `alias/`. Defaults to `alias/eks/&lt;clusterName&gt;`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder aliasName(String aliasName) {
+            return aliasName(Output.of(aliasName));
+        }
+
+        /**
+         * @param deletionWindowInDays The waiting period in days before the key is deleted after the cluster is destroyed. Must be between 7 and 30. Defaults to 30 days.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder deletionWindowInDays(@Nullable Output<Integer> deletionWindowInDays) {
+            $.deletionWindowInDays = deletionWindowInDays;
+            return this;
+        }
+
+        /**
+         * @param deletionWindowInDays The waiting period in days before the key is deleted after the cluster is destroyed. Must be between 7 and 30. Defaults to 30 days.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder deletionWindowInDays(Integer deletionWindowInDays) {
+            return deletionWindowInDays(Output.of(deletionWindowInDays));
+        }
+
+        /**
+         * @param enableKeyRotation Whether to enable automatic rotation of the key material. Defaults to `true`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder enableKeyRotation(@Nullable Output<Boolean> enableKeyRotation) {
+            $.enableKeyRotation = enableKeyRotation;
+            return this;
+        }
+
+        /**
+         * @param enableKeyRotation Whether to enable automatic rotation of the key material. Defaults to `true`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder enableKeyRotation(Boolean enableKeyRotation) {
+            return enableKeyRotation(Output.of(enableKeyRotation));
+        }
+
+        /**
+         * @param keyAdministratorArns The ARNs of IAM principals that are allowed to administer the key, e.g. to change its policy or schedule its deletion. The AWS account always retains full access to the key so that IAM policies can grant access to it as well.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder keyAdministratorArns(@Nullable Output<List<String>> keyAdministratorArns) {
+            $.keyAdministratorArns = keyAdministratorArns;
+            return this;
+        }
+
+        /**
+         * @param keyAdministratorArns The ARNs of IAM principals that are allowed to administer the key, e.g. to change its policy or schedule its deletion. The AWS account always retains full access to the key so that IAM policies can grant access to it as well.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder keyAdministratorArns(List<String> keyAdministratorArns) {
+            return keyAdministratorArns(Output.of(keyAdministratorArns));
+        }
+
+        /**
+         * @param keyAdministratorArns The ARNs of IAM principals that are allowed to administer the key, e.g. to change its policy or schedule its deletion. The AWS account always retains full access to the key so that IAM policies can grant access to it as well.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder keyAdministratorArns(String... keyAdministratorArns) {
+            return keyAdministratorArns(List.of(keyAdministratorArns));
+        }
+
+        /**
+         * @param rotationPeriodInDays The period in days between automatic rotations of the key material. Must be between 90 and 2560. Defaults to 365 days.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder rotationPeriodInDays(@Nullable Output<Integer> rotationPeriodInDays) {
+            $.rotationPeriodInDays = rotationPeriodInDays;
+            return this;
+        }
+
+        /**
+         * @param rotationPeriodInDays The period in days between automatic rotations of the key material. Must be between 90 and 2560. Defaults to 365 days.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder rotationPeriodInDays(Integer rotationPeriodInDays) {
+            return rotationPeriodInDays(Output.of(rotationPeriodInDays));
+        }
+
+        public EncryptionConfigOptionsArgs build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,213 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.eks.enums.AmiType;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class GetOptimizedAmiArgs extends com.pulumi.resources.InvokeArgs {
+
+    public static final GetOptimizedAmiArgs Empty = new GetOptimizedAmiArgs();
+
+    /**
+     * The AMI type to resolve the AMI for.
+     * 
+     */
+    @Import(name="amiType", required=true)
+    private Output<AmiType> amiType;
+
+    /**
+     * @return The AMI type to resolve the AMI for.
+     * 
+     */
+    public Output<AmiType> amiType() {
+        return this.amiType;
+    }
+
+    /**
+     * The Kubernetes version of the AMI, e.g. `1.31`.
+     * 
+     */
+    @Import(name="kubernetesVersion", required=true)
+    private Output<String> kubernetesVersion;
+
+    /**
+     * @return The Kubernetes version of the AMI, e.g. `1.31`.
+     * 
+     */
+    public Output<String> kubernetesVersion() {
+        return this.kubernetesVersion;
+    }
+
+    /**
+     * The AWS region to resolve the AMI in. This should be the region of the stack, e.g. the `aws:region` config value.
+     * 
+     */
+    @Import(name="region", required=true)
+    private Output<String> region;
+
+    /**
+     * @return The AWS region to resolve the AMI in. This should be the region of the stack, e.g. the `aws:region` config value.
+     * 
+     */
+    public Output<String> region() {
+        return this.region;
+    }
+
+    /**
+     * The release version of the AMI to resolve. For Amazon Linux AMIs this is the version reported by EKS, e.g. `1.31.0-20240917`. For Bottlerocket AMIs this is the Bottlerocket version, e.g. `1.22.0`.
+     * 
+     * Defaults to the release version of the currently recommended AMI.
+     * 
+     */
+    @Import(name="releaseVersion")
+    private @Nullable Output<String> releaseVersion;
+
+    /**
+     * @return The release version of the AMI to resolve. For Amazon Linux AMIs this is the version reported by EKS, e.g. `1.31.0-20240917`. For Bottlerocket AMIs this is the Bottlerocket version, e.g. `1.22.0`.
+     * 
+     * Defaults to the release version of the currently recommended AMI.
+     * 
+     */
+    public Optional<Output<String>> releaseVersion() {
+        return Optional.ofNullable(this.releaseVersion);
+    }
+
+    private GetOptimizedAmiArgs() {}
+
+    private GetOptimizedAmiArgs(GetOptimizedAmiArgs $) {
+        this.amiType = $.amiType;
+        this.kubernetesVersion = $.kubernetesVersion;
+        this.region = $.region;
+        this.releaseVersion = $.releaseVersion;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(GetOptimizedAmiArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private GetOptimizedAmiArgs $;
+
+        public Builder() {
+            $ = new GetOptimizedAmiArgs();
+        }
+
+        public Builder(GetOptimizedAmiArgs defaults) {
+            $ = new GetOptimizedAmiArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param amiType The AMI type to resolve the AMI for.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder amiType(Output<AmiType> amiType) {
+            $.amiType = amiType;
+            return this;
+        }
+
+        /**
+         * @param amiType The AMI type to resolve the AMI for.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder amiType(AmiType amiType) {
+            return amiType(Output.of(amiType));
+        }
+
+        /**
+         * @param kubernetesVersion The Kubernetes version of the AMI, e.g. `1.31`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder kubernetesVersion(Output<String> kubernetesVersion) {
+            $.kubernetesVersion = kubernetesVersion;
+            return this;
+        }
+
+        /**
+         * @param kubernetesVersion The Kubernetes version of the AMI, e.g. `1.31`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder kubernetesVersion(String kubernetesVersion) {
+            return kubernetesVersion(Output.of(kubernetesVersion));
+        }
+
+        /**
+         * @param region The AWS region to resolve the AMI in. This should be the region of the stack, e.g. the `aws:region` config value.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder region(Output<String> region) {
+            $.region = region;
+            return this;
+        }
+
+        /**
+         * @param region The AWS region to resolve the AMI in. This should be the region of the stack, e.g. the `aws:region` config value.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder region(String region) {
+            return region(Output.of(region));
+        }
+
+        /**
+         * @param releaseVersion The release version of the AMI to resolve. For Amazon Linux AMIs this is the version reported by EKS, e.g. `1.31.0-20240917`. For Bottlerocket AMIs this is the Bottlerocket version, e.g. `1.22.0`.
+         * 
+         * Defaults to the release version of the currently recommended AMI.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder releaseVersion(@Nullable Output<String> releaseVersion) {
+            $.releaseVersion = releaseVersion;
+            return this;
+        }
+
+        /**
+         * @param releaseVersion The release version of the AMI to resolve. For Amazon Linux AMIs this is the version reported by EKS, e.g. `1.31.0-20240917`. For Bottlerocket AMIs this is the Bottlerocket version, e.g. `1.22.0`.
+         * 
+         * Defaults to the release version of the currently recommended AMI.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder releaseVersion(String releaseVersion) {
+            return releaseVersion(Output.of(releaseVersion));
+        }
+
+        public GetOptimizedAmiArgs build() {
+            if ($.amiType == null) {
+                throw new MissingRequiredPropertyException("GetOptimizedAmiArgs", "amiType");
+            }
+            if ($.kubernetesVersion == null) {
+                throw new MissingRequiredPropertyException("GetOptimizedAmiArgs", "kubernetesVersion");
+            }
+            if ($.region == null) {
+                throw new MissingRequiredPropertyException("GetOptimizedAmiArgs", "region");
+            }
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,170 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.annotations.Import;
+import com.pulumi.eks.enums.AmiType;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class GetOptimizedAmiPlainArgs extends com.pulumi.resources.InvokeArgs {
+
+    public static final GetOptimizedAmiPlainArgs Empty = new GetOptimizedAmiPlainArgs();
+
+    /**
+     * The AMI type to resolve the AMI for.
+     * 
+     */
+    @Import(name="amiType", required=true)
+    private AmiType amiType;
+
+    /**
+     * @return The AMI type to resolve the AMI for.
+     * 
+     */
+    public AmiType amiType() {
+        return this.amiType;
+    }
+
+    /**
+     * The Kubernetes version of the AMI, e.g. `1.31`.
+     * 
+     */
+    @Import(name="kubernetesVersion", required=true)
+    private String kubernetesVersion;
+
+    /**
+     * @return The Kubernetes version of the AMI, e.g. `1.31`.
+     * 
+     */
+    public String kubernetesVersion() {
+        return this.kubernetesVersion;
+    }
+
+    /**
+     * The AWS region to resolve the AMI in. This should be the region of the stack, e.g. the `aws:region` config value.
+     * 
+     */
+    @Import(name="region", required=true)
+    private String region;
+
+    /**
+     * @return The AWS region to resolve the AMI in. This should be the region of the stack, e.g. the `aws:region` config value.
+     * 
+     */
+    public String region() {
+        return this.region;
+    }
+
+    /**
+     * The release version of the AMI to resolve. For Amazon Linux AMIs this is the version reported by EKS, e.g. `1.31.0-20240917`. For Bottlerocket AMIs this is the Bottlerocket version, e.g. `1.22.0`.
+     * 
+     * Defaults to the release version of the currently recommended AMI.
+     * 
+     */
+    @Import(name="releaseVersion")
+    private @Nullable String releaseVersion;
+
+    /**
+     * @return The release version of the AMI to resolve. For Amazon Linux AMIs this is the version reported by EKS, e.g. `1.31.0-20240917`. For Bottlerocket AMIs this is the Bottlerocket version, e.g. `1.22.0`.
+     * 
+     * Defaults to the release version of the currently recommended AMI.
+     * 
+     */
+    public Optional<String> releaseVersion() {
+        return Optional.ofNullable(this.releaseVersion);
+    }
+
+    private GetOptimizedAmiPlainArgs() {}
+
+    private GetOptimizedAmiPlainArgs(GetOptimizedAmiPlainArgs $) {
+        this.amiType = $.amiType;
+        this.kubernetesVersion = $.kubernetesVersion;
+        this.region = $.region;
+        this.releaseVersion = $.releaseVersion;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(GetOptimizedAmiPlainArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private GetOptimizedAmiPlainArgs $;
+
+        public Builder() {
+            $ = new GetOptimizedAmiPlainArgs();
+        }
+
+        public Builder(GetOptimizedAmiPlainArgs defaults) {
+            $ = new GetOptimizedAmiPlainArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param amiType The AMI type to resolve the AMI for.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder amiType(AmiType amiType) {
+            $.amiType = amiType;
+            return this;
+        }
+
+        /**
+         * @param kubernetesVersion The Kubernetes version of the AMI, e.g. `1.31`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder kubernetesVersion(String kubernetesVersion) {
+            $.kubernetesVersion = kubernetesVersion;
+            return this;
+        }
+
+        /**
+         * @param region The AWS region to resolve the AMI in. This should be the region of the stack, e.g. the `aws:region` config value.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder region(String region) {
+            $.region = region;
+            return this;
+        }
+
+        /**
+         * @param releaseVersion The release version of the AMI to resolve. For Amazon Linux AMIs this is the version reported by EKS, e.g. `1.31.0-20240917`. For Bottlerocket AMIs this is the Bottlerocket version, e.g. `1.22.0`.
+         * 
+         * Defaults to the release version of the currently recommended AMI.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder releaseVersion(@Nullable String releaseVersion) {
+            $.releaseVersion = releaseVersion;
+            return this;
+        }
+
+        public GetOptimizedAmiPlainArgs build() {
+            if ($.amiType == null) {
+                throw new MissingRequiredPropertyException("GetOptimizedAmiPlainArgs", "amiType");
+            }
+            if ($.kubernetesVersion == null) {
+                throw new MissingRequiredPropertyException("GetOptimizedAmiPlainArgs", "kubernetesVersion");
+            }
+            if ($.region == null) {
+                throw new MissingRequiredPropertyException("GetOptimizedAmiPlainArgs", "region");
+            }
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,284 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.Integer;
+import java.lang.Object;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * InterruptionHandling configures how a node group handles Spot interruptions and Auto Scaling Group termination events. The events are delivered to an SQS queue, which aws-node-termination-handler processes in queue mode to cordon and drain the affected nodes before their instances are terminated.
+ * See for more details: https://github.com/aws/aws-node-termination-handler#queue-processor
+ * 
+ */
+public final class InterruptionHandlingArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final InterruptionHandlingArgs Empty = new InterruptionHandlingArgs();
+
+    /**
+     * The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+     * 
+     */
+    @Import(name="chartVersion")
+    private @Nullable Output<String> chartVersion;
+
+    /**
+     * @return The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+     * 
+     */
+    public Optional<Output<String>> chartVersion() {
+        return Optional.ofNullable(this.chartVersion);
+    }
+
+    /**
+     * The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+     * 
+     */
+    @Import(name="heartbeatTimeout")
+    private @Nullable Output<Integer> heartbeatTimeout;
+
+    /**
+     * @return The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+     * 
+     */
+    public Optional<Output<Integer>> heartbeatTimeout() {
+        return Optional.ofNullable(this.heartbeatTimeout);
+    }
+
+    /**
+     * The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+     * 
+     */
+    @Import(name="namespace")
+    private @Nullable Output<String> namespace;
+
+    /**
+     * @return The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+     * 
+     */
+    public Optional<Output<String>> namespace() {
+        return Optional.ofNullable(this.namespace);
+    }
+
+    /**
+     * The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+     * 
+     * If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+     * 
+     */
+    @Import(name="oidcProviderArn")
+    private @Nullable Output<String> oidcProviderArn;
+
+    /**
+     * @return The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+     * 
+     * If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+     * 
+     */
+    public Optional<Output<String>> oidcProviderArn() {
+        return Optional.ofNullable(this.oidcProviderArn);
+    }
+
+    /**
+     * The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `&lt;name&gt;-node-termination-handler`, where `&lt;name&gt;` is the name of the node group.
+     * 
+     */
+    @Import(name="serviceAccountName")
+    private @Nullable Output<String> serviceAccountName;
+
+    /**
+     * @return The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `&lt;name&gt;-node-termination-handler`, where `&lt;name&gt;` is the name of the node group.
+     * 
+     */
+    public Optional<Output<String>> serviceAccountName() {
+        return Optional.ofNullable(this.serviceAccountName);
+    }
+
+    /**
+     * Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+     * 
+     */
+    @Import(name="values")
+    private @Nullable Output<Map<String,Object>> values;
+
+    /**
+     * @return Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+     * 
+     */
+    public Optional<Output<Map<String,Object>>> values() {
+        return Optional.ofNullable(this.values);
+    }
+
+    private InterruptionHandlingArgs() {}
+
+    private InterruptionHandlingArgs(InterruptionHandlingArgs $) {
+        this.chartVersion = $.chartVersion;
+        this.heartbeatTimeout = $.heartbeatTimeout;
+        this.namespace = $.namespace;
+        this.oidcProviderArn = $.oidcProviderArn;
+        this.serviceAccountName = $.serviceAccountName;
+        this.values = $.values;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(InterruptionHandlingArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private InterruptionHandlingArgs $;
+
+        public Builder() {
+            $ = new InterruptionHandlingArgs();
+        }
+
+        public Builder(InterruptionHandlingArgs defaults) {
+            $ = new InterruptionHandlingArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param chartVersion The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder chartVersion(@Nullable Output<String> chartVersion) {
+            $.chartVersion = chartVersion;
+            return this;
+        }
+
+        /**
+         * @param chartVersion The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder chartVersion(String chartVersion) {
+            return chartVersion(Output.of(chartVersion));
+        }
+
+        /**
+         * @param heartbeatTimeout The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder heartbeatTimeout(@Nullable Output<Integer> heartbeatTimeout) {
+            $.heartbeatTimeout = heartbeatTimeout;
+            return this;
+        }
+
+        /**
+         * @param heartbeatTimeout The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder heartbeatTimeout(Integer heartbeatTimeout) {
+            return heartbeatTimeout(Output.of(heartbeatTimeout));
+        }
+
+        /**
+         * @param namespace The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder namespace(@Nullable Output<String> namespace) {
+            $.namespace = namespace;
+            return this;
+        }
+
+        /**
+         * @param namespace The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder namespace(String namespace) {
+            return namespace(Output.of(namespace));
+        }
+
+        /**
+         * @param oidcProviderArn The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+         * 
+         * If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder oidcProviderArn(@Nullable Output<String> oidcProviderArn) {
+            $.oidcProviderArn = oidcProviderArn;
+            return this;
+        }
+
+        /**
+         * @param oidcProviderArn The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+         * 
+         * If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder oidcProviderArn(String oidcProviderArn) {
+            return oidcProviderArn(Output.of(oidcProviderArn));
+        }
+
+        /**
+         * @param serviceAccountName The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `&lt;name&gt;-node-termination-handler`, where `&lt;name&gt;` is the name of the node group.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder serviceAccountName(@Nullable Output<String> serviceAccountName) {
+            $.serviceAccountName = serviceAccountName;
+            return this;
+        }
+
+        /**
+         * @param serviceAccountName The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `&lt;name&gt;-node-termination-handler`, where `&lt;name&gt;` is the name of the node group.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder serviceAccountName(String serviceAccountName) {
+            return serviceAccountName(Output.of(serviceAccountName));
+        }
+
+        /**
+         * @param values Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder values(@Nullable Output<Map<String,Object>> values) {
+            $.values = values;
+            return this;
+        }
+
+        /**
+         * @param values Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder values(Map<String,Object> values) {
+            return values(Output.of(values));
+        }
+
+        public InterruptionHandlingArgs build() {
+            return $;
+        }
+    }
+
+}
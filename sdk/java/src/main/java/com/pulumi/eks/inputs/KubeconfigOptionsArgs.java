@@ -5,7 +5,9 @@ package com.pulumi.eks.inputs;
 
 import com.pulumi.core.Output;
 import com.pulumi.core.annotations.Import;
+import com.pulumi.eks.enums.KubeconfigTokenTool;
 import java.lang.String;
+import java.util.Map;
 import java.util.Objects;
 import java.util.Optional;
 import javax.annotation.Nullable;
@@ -34,6 +36,40 @@ public final class KubeconfigOptionsArgs extends com.pulumi.resources.ResourceAr
 
     public static final KubeconfigOptionsArgs Empty = new KubeconfigOptionsArgs();
 
+    /**
+     * Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+     * 
+     */
+    @Import(name="env")
+    private @Nullable Output<Map<String,String>> env;
+
+    /**
+     * @return Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+     * 
+     */
+    public Optional<Output<Map<String,String>>> env() {
+        return Optional.ofNullable(this.env);
+    }
+
+    /**
+     * External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+     * 
+     * Only supported by the `aws-iam-authenticator` token tool.
+     * 
+     */
+    @Import(name="externalId")
+    private @Nullable Output<String> externalId;
+
+    /**
+     * @return External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+     * 
+     * Only supported by the `aws-iam-authenticator` token tool.
+     * 
+     */
+    public Optional<Output<String>> externalId() {
+        return Optional.ofNullable(this.externalId);
+    }
+
     /**
      * AWS credential profile name to always use instead of the default AWS credential provider chain.
      * 
@@ -53,6 +89,21 @@ public final class KubeconfigOptionsArgs extends com.pulumi.resources.ResourceAr
         return Optional.ofNullable(this.profileName);
     }
 
+    /**
+     * AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+     * 
+     */
+    @Import(name="region")
+    private @Nullable Output<String> region;
+
+    /**
+     * @return AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+     * 
+     */
+    public Optional<Output<String>> region() {
+        return Optional.ofNullable(this.region);
+    }
+
     /**
      * Role ARN to assume instead of the default AWS credential provider chain.
      * 
@@ -72,11 +123,50 @@ public final class KubeconfigOptionsArgs extends com.pulumi.resources.ResourceAr
         return Optional.ofNullable(this.roleArn);
     }
 
+    /**
+     * Session name to use when assuming `roleArn`.
+     * 
+     * Only supported by the `aws-iam-authenticator` token tool.
+     * 
+     */
+    @Import(name="roleSessionName")
+    private @Nullable Output<String> roleSessionName;
+
+    /**
+     * @return Session name to use when assuming `roleArn`.
+     * 
+     * Only supported by the `aws-iam-authenticator` token tool.
+     * 
+     */
+    public Optional<Output<String>> roleSessionName() {
+        return Optional.ofNullable(this.roleSessionName);
+    }
+
+    /**
+     * The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+     * 
+     */
+    @Import(name="tokenTool")
+    private @Nullable Output<KubeconfigTokenTool> tokenTool;
+
+    /**
+     * @return The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+     * 
+     */
+    public Optional<Output<KubeconfigTokenTool>> tokenTool() {
+        return Optional.ofNullable(this.tokenTool);
+    }
+
     private KubeconfigOptionsArgs() {}
 
     private KubeconfigOptionsArgs(KubeconfigOptionsArgs $) {
+        this.env = $.env;
+        this.externalId = $.externalId;
         this.profileName = $.profileName;
+        this.region = $.region;
         this.roleArn = $.roleArn;
+        this.roleSessionName = $.roleSessionName;
+        this.tokenTool = $.tokenTool;
     }
 
     public static Builder builder() {
@@ -97,6 +187,52 @@ public final class KubeconfigOptionsArgs extends com.pulumi.resources.ResourceAr
             $ = new KubeconfigOptionsArgs(Objects.requireNonNull(defaults));
         }
 
+        /**
+         * @param env Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder env(@Nullable Output<Map<String,String>> env) {
+            $.env = env;
+            return this;
+        }
+
+        /**
+         * @param env Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder env(Map<String,String> env) {
+            return env(Output.of(env));
+        }
+
+        /**
+         * @param externalId External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+         * 
+         * Only supported by the `aws-iam-authenticator` token tool.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder externalId(@Nullable Output<String> externalId) {
+            $.externalId = externalId;
+            return this;
+        }
+
+        /**
+         * @param externalId External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+         * 
+         * Only supported by the `aws-iam-authenticator` token tool.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder externalId(String externalId) {
+            return externalId(Output.of(externalId));
+        }
+
         /**
          * @param profileName AWS credential profile name to always use instead of the default AWS credential provider chain.
          * 
@@ -122,6 +258,27 @@ public final class KubeconfigOptionsArgs extends com.pulumi.resources.ResourceAr
             return profileName(Output.of(profileName));
         }
 
+        /**
+         * @param region AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder region(@Nullable Output<String> region) {
+            $.region = region;
+            return this;
+        }
+
+        /**
+         * @param region AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder region(String region) {
+            return region(Output.of(region));
+        }
+
         /**
          * @param roleArn Role ARN to assume instead of the default AWS credential provider chain.
          * 
@@ -147,6 +304,52 @@ public final class KubeconfigOptionsArgs extends com.pulumi.resources.ResourceAr
             return roleArn(Output.of(roleArn));
         }
 
+        /**
+         * @param roleSessionName Session name to use when assuming `roleArn`.
+         * 
+         * Only supported by the `aws-iam-authenticator` token tool.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder roleSessionName(@Nullable Output<String> roleSessionName) {
+            $.roleSessionName = roleSessionName;
+            return this;
+        }
+
+        /**
+         * @param roleSessionName Session name to use when assuming `roleArn`.
+         * 
+         * Only supported by the `aws-iam-authenticator` token tool.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder roleSessionName(String roleSessionName) {
+            return roleSessionName(Output.of(roleSessionName));
+        }
+
+        /**
+         * @param tokenTool The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder tokenTool(@Nullable Output<KubeconfigTokenTool> tokenTool) {
+            $.tokenTool = tokenTool;
+            return this;
+        }
+
+        /**
+         * @param tokenTool The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder tokenTool(KubeconfigTokenTool tokenTool) {
+            return tokenTool(Output.of(tokenTool));
+        }
+
         public KubeconfigOptionsArgs build() {
             return $;
         }
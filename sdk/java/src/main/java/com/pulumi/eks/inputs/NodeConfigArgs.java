@@ -0,0 +1,165 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.eks.inputs.NodeConfigContainerdArgs;
+import com.pulumi.eks.inputs.NodeConfigInstanceArgs;
+import com.pulumi.eks.inputs.NodeConfigKubeletArgs;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Typed nodeadm `NodeConfig`. The cluster details are configured by the provider.
+ * 
+ * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/.
+ * 
+ */
+public final class NodeConfigArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final NodeConfigArgs Empty = new NodeConfigArgs();
+
+    /**
+     * Configuration of containerd.
+     * 
+     */
+    @Import(name="containerd")
+    private @Nullable Output<NodeConfigContainerdArgs> containerd;
+
+    /**
+     * @return Configuration of containerd.
+     * 
+     */
+    public Optional<Output<NodeConfigContainerdArgs>> containerd() {
+        return Optional.ofNullable(this.containerd);
+    }
+
+    /**
+     * Configuration of the EC2 instance.
+     * 
+     */
+    @Import(name="instance")
+    private @Nullable Output<NodeConfigInstanceArgs> instance;
+
+    /**
+     * @return Configuration of the EC2 instance.
+     * 
+     */
+    public Optional<Output<NodeConfigInstanceArgs>> instance() {
+        return Optional.ofNullable(this.instance);
+    }
+
+    /**
+     * Configuration of the kubelet.
+     * 
+     */
+    @Import(name="kubelet")
+    private @Nullable Output<NodeConfigKubeletArgs> kubelet;
+
+    /**
+     * @return Configuration of the kubelet.
+     * 
+     */
+    public Optional<Output<NodeConfigKubeletArgs>> kubelet() {
+        return Optional.ofNullable(this.kubelet);
+    }
+
+    private NodeConfigArgs() {}
+
+    private NodeConfigArgs(NodeConfigArgs $) {
+        this.containerd = $.containerd;
+        this.instance = $.instance;
+        this.kubelet = $.kubelet;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(NodeConfigArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private NodeConfigArgs $;
+
+        public Builder() {
+            $ = new NodeConfigArgs();
+        }
+
+        public Builder(NodeConfigArgs defaults) {
+            $ = new NodeConfigArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param containerd Configuration of containerd.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder containerd(@Nullable Output<NodeConfigContainerdArgs> containerd) {
+            $.containerd = containerd;
+            return this;
+        }
+
+        /**
+         * @param containerd Configuration of containerd.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder containerd(NodeConfigContainerdArgs containerd) {
+            return containerd(Output.of(containerd));
+        }
+
+        /**
+         * @param instance Configuration of the EC2 instance.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder instance(@Nullable Output<NodeConfigInstanceArgs> instance) {
+            $.instance = instance;
+            return this;
+        }
+
+        /**
+         * @param instance Configuration of the EC2 instance.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder instance(NodeConfigInstanceArgs instance) {
+            return instance(Output.of(instance));
+        }
+
+        /**
+         * @param kubelet Configuration of the kubelet.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder kubelet(@Nullable Output<NodeConfigKubeletArgs> kubelet) {
+            $.kubelet = kubelet;
+            return this;
+        }
+
+        /**
+         * @param kubelet Configuration of the kubelet.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder kubelet(NodeConfigKubeletArgs kubelet) {
+            return kubelet(Output.of(kubelet));
+        }
+
+        public NodeConfigArgs build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,126 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.Object;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Configuration of containerd in a nodeadm `NodeConfig`.
+ * 
+ */
+public final class NodeConfigContainerdArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final NodeConfigContainerdArgs Empty = new NodeConfigContainerdArgs();
+
+    /**
+     * The OCI runtime specification containers are created with.
+     * 
+     */
+    @Import(name="baseRuntimeSpec")
+    private @Nullable Output<Map<String,Object>> baseRuntimeSpec;
+
+    /**
+     * @return The OCI runtime specification containers are created with.
+     * 
+     */
+    public Optional<Output<Map<String,Object>>> baseRuntimeSpec() {
+        return Optional.ofNullable(this.baseRuntimeSpec);
+    }
+
+    /**
+     * Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+     * 
+     */
+    @Import(name="config")
+    private @Nullable Output<String> config;
+
+    /**
+     * @return Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+     * 
+     */
+    public Optional<Output<String>> config() {
+        return Optional.ofNullable(this.config);
+    }
+
+    private NodeConfigContainerdArgs() {}
+
+    private NodeConfigContainerdArgs(NodeConfigContainerdArgs $) {
+        this.baseRuntimeSpec = $.baseRuntimeSpec;
+        this.config = $.config;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(NodeConfigContainerdArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private NodeConfigContainerdArgs $;
+
+        public Builder() {
+            $ = new NodeConfigContainerdArgs();
+        }
+
+        public Builder(NodeConfigContainerdArgs defaults) {
+            $ = new NodeConfigContainerdArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param baseRuntimeSpec The OCI runtime specification containers are created with.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder baseRuntimeSpec(@Nullable Output<Map<String,Object>> baseRuntimeSpec) {
+            $.baseRuntimeSpec = baseRuntimeSpec;
+            return this;
+        }
+
+        /**
+         * @param baseRuntimeSpec The OCI runtime specification containers are created with.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder baseRuntimeSpec(Map<String,Object> baseRuntimeSpec) {
+            return baseRuntimeSpec(Output.of(baseRuntimeSpec));
+        }
+
+        /**
+         * @param config Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder config(@Nullable Output<String> config) {
+            $.config = config;
+            return this;
+        }
+
+        /**
+         * @param config Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder config(String config) {
+            return config(Output.of(config));
+        }
+
+        public NodeConfigContainerdArgs build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,87 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.eks.inputs.NodeConfigLocalStorageArgs;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Configuration of the EC2 instance in a nodeadm `NodeConfig`.
+ * 
+ */
+public final class NodeConfigInstanceArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final NodeConfigInstanceArgs Empty = new NodeConfigInstanceArgs();
+
+    /**
+     * Configuration of the local instance store volumes.
+     * 
+     */
+    @Import(name="localStorage")
+    private @Nullable Output<NodeConfigLocalStorageArgs> localStorage;
+
+    /**
+     * @return Configuration of the local instance store volumes.
+     * 
+     */
+    public Optional<Output<NodeConfigLocalStorageArgs>> localStorage() {
+        return Optional.ofNullable(this.localStorage);
+    }
+
+    private NodeConfigInstanceArgs() {}
+
+    private NodeConfigInstanceArgs(NodeConfigInstanceArgs $) {
+        this.localStorage = $.localStorage;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(NodeConfigInstanceArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private NodeConfigInstanceArgs $;
+
+        public Builder() {
+            $ = new NodeConfigInstanceArgs();
+        }
+
+        public Builder(NodeConfigInstanceArgs defaults) {
+            $ = new NodeConfigInstanceArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param localStorage Configuration of the local instance store volumes.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder localStorage(@Nullable Output<NodeConfigLocalStorageArgs> localStorage) {
+            $.localStorage = localStorage;
+            return this;
+        }
+
+        /**
+         * @param localStorage Configuration of the local instance store volumes.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder localStorage(NodeConfigLocalStorageArgs localStorage) {
+            return localStorage(Output.of(localStorage));
+        }
+
+        public NodeConfigInstanceArgs build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,145 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.Object;
+import java.lang.String;
+import java.util.List;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Configuration of the kubelet in a nodeadm `NodeConfig`.
+ * 
+ */
+public final class NodeConfigKubeletArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final NodeConfigKubeletArgs Empty = new NodeConfigKubeletArgs();
+
+    /**
+     * Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+     * 
+     * See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+     * 
+     */
+    @Import(name="config")
+    private @Nullable Output<Map<String,Object>> config;
+
+    /**
+     * @return Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+     * 
+     * See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+     * 
+     */
+    public Optional<Output<Map<String,Object>>> config() {
+        return Optional.ofNullable(this.config);
+    }
+
+    /**
+     * Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+     * 
+     */
+    @Import(name="flags")
+    private @Nullable Output<List<String>> flags;
+
+    /**
+     * @return Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+     * 
+     */
+    public Optional<Output<List<String>>> flags() {
+        return Optional.ofNullable(this.flags);
+    }
+
+    private NodeConfigKubeletArgs() {}
+
+    private NodeConfigKubeletArgs(NodeConfigKubeletArgs $) {
+        this.config = $.config;
+        this.flags = $.flags;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(NodeConfigKubeletArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private NodeConfigKubeletArgs $;
+
+        public Builder() {
+            $ = new NodeConfigKubeletArgs();
+        }
+
+        public Builder(NodeConfigKubeletArgs defaults) {
+            $ = new NodeConfigKubeletArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param config Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+         * 
+         * See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder config(@Nullable Output<Map<String,Object>> config) {
+            $.config = config;
+            return this;
+        }
+
+        /**
+         * @param config Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+         * 
+         * See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder config(Map<String,Object> config) {
+            return config(Output.of(config));
+        }
+
+        /**
+         * @param flags Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder flags(@Nullable Output<List<String>> flags) {
+            $.flags = flags;
+            return this;
+        }
+
+        /**
+         * @param flags Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder flags(List<String> flags) {
+            return flags(Output.of(flags));
+        }
+
+        /**
+         * @param flags Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder flags(String... flags) {
+            return flags(List.of(flags));
+        }
+
+        public NodeConfigKubeletArgs build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,176 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.String;
+import java.util.List;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Configuration of the local instance store volumes in a nodeadm `NodeConfig`.
+ * 
+ */
+public final class NodeConfigLocalStorageArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final NodeConfigLocalStorageArgs Empty = new NodeConfigLocalStorageArgs();
+
+    /**
+     * Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+     * 
+     */
+    @Import(name="disabledMounts")
+    private @Nullable Output<List<String>> disabledMounts;
+
+    /**
+     * @return Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+     * 
+     */
+    public Optional<Output<List<String>>> disabledMounts() {
+        return Optional.ofNullable(this.disabledMounts);
+    }
+
+    /**
+     * The path the instance store volumes are mounted at.
+     * 
+     */
+    @Import(name="mountPath")
+    private @Nullable Output<String> mountPath;
+
+    /**
+     * @return The path the instance store volumes are mounted at.
+     * 
+     */
+    public Optional<Output<String>> mountPath() {
+        return Optional.ofNullable(this.mountPath);
+    }
+
+    /**
+     * How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+     * 
+     */
+    @Import(name="strategy", required=true)
+    private Output<String> strategy;
+
+    /**
+     * @return How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+     * 
+     */
+    public Output<String> strategy() {
+        return this.strategy;
+    }
+
+    private NodeConfigLocalStorageArgs() {}
+
+    private NodeConfigLocalStorageArgs(NodeConfigLocalStorageArgs $) {
+        this.disabledMounts = $.disabledMounts;
+        this.mountPath = $.mountPath;
+        this.strategy = $.strategy;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(NodeConfigLocalStorageArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private NodeConfigLocalStorageArgs $;
+
+        public Builder() {
+            $ = new NodeConfigLocalStorageArgs();
+        }
+
+        public Builder(NodeConfigLocalStorageArgs defaults) {
+            $ = new NodeConfigLocalStorageArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param disabledMounts Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder disabledMounts(@Nullable Output<List<String>> disabledMounts) {
+            $.disabledMounts = disabledMounts;
+            return this;
+        }
+
+        /**
+         * @param disabledMounts Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder disabledMounts(List<String> disabledMounts) {
+            return disabledMounts(Output.of(disabledMounts));
+        }
+
+        /**
+         * @param disabledMounts Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder disabledMounts(String... disabledMounts) {
+            return disabledMounts(List.of(disabledMounts));
+        }
+
+        /**
+         * @param mountPath The path the instance store volumes are mounted at.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder mountPath(@Nullable Output<String> mountPath) {
+            $.mountPath = mountPath;
+            return this;
+        }
+
+        /**
+         * @param mountPath The path the instance store volumes are mounted at.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder mountPath(String mountPath) {
+            return mountPath(Output.of(mountPath));
+        }
+
+        /**
+         * @param strategy How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder strategy(Output<String> strategy) {
+            $.strategy = strategy;
+            return this;
+        }
+
+        /**
+         * @param strategy How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder strategy(String strategy) {
+            return strategy(Output.of(strategy));
+        }
+
+        public NodeConfigLocalStorageArgs build() {
+            if ($.strategy == null) {
+                throw new MissingRequiredPropertyException("NodeConfigLocalStorageArgs", "strategy");
+            }
+            return $;
+        }
+    }
+
+}
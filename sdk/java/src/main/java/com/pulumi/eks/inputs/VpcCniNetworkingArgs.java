@@ -0,0 +1,129 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Boolean;
+import java.util.Objects;
+
+
+/**
+ * The VPC CNI settings that affect the maximum number of pods of the nodes.
+ * 
+ */
+public final class VpcCniNetworkingArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final VpcCniNetworkingArgs Empty = new VpcCniNetworkingArgs();
+
+    /**
+     * Whether pods get their IP addresses from the subnets of ENIConfigs.
+     * 
+     */
+    @Import(name="customNetworking", required=true)
+    private Output<Boolean> customNetworking;
+
+    /**
+     * @return Whether pods get their IP addresses from the subnets of ENIConfigs.
+     * 
+     */
+    public Output<Boolean> customNetworking() {
+        return this.customNetworking;
+    }
+
+    /**
+     * Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+     * 
+     */
+    @Import(name="prefixDelegation", required=true)
+    private Output<Boolean> prefixDelegation;
+
+    /**
+     * @return Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+     * 
+     */
+    public Output<Boolean> prefixDelegation() {
+        return this.prefixDelegation;
+    }
+
+    private VpcCniNetworkingArgs() {}
+
+    private VpcCniNetworkingArgs(VpcCniNetworkingArgs $) {
+        this.customNetworking = $.customNetworking;
+        this.prefixDelegation = $.prefixDelegation;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(VpcCniNetworkingArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private VpcCniNetworkingArgs $;
+
+        public Builder() {
+            $ = new VpcCniNetworkingArgs();
+        }
+
+        public Builder(VpcCniNetworkingArgs defaults) {
+            $ = new VpcCniNetworkingArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param customNetworking Whether pods get their IP addresses from the subnets of ENIConfigs.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder customNetworking(Output<Boolean> customNetworking) {
+            $.customNetworking = customNetworking;
+            return this;
+        }
+
+        /**
+         * @param customNetworking Whether pods get their IP addresses from the subnets of ENIConfigs.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder customNetworking(Boolean customNetworking) {
+            return customNetworking(Output.of(customNetworking));
+        }
+
+        /**
+         * @param prefixDelegation Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder prefixDelegation(Output<Boolean> prefixDelegation) {
+            $.prefixDelegation = prefixDelegation;
+            return this;
+        }
+
+        /**
+         * @param prefixDelegation Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder prefixDelegation(Boolean prefixDelegation) {
+            return prefixDelegation(Output.of(prefixDelegation));
+        }
+
+        public VpcCniNetworkingArgs build() {
+            if ($.customNetworking == null) {
+                throw new MissingRequiredPropertyException("VpcCniNetworkingArgs", "customNetworking");
+            }
+            if ($.prefixDelegation == null) {
+                throw new MissingRequiredPropertyException("VpcCniNetworkingArgs", "prefixDelegation");
+            }
+            return $;
+        }
+    }
+
+}
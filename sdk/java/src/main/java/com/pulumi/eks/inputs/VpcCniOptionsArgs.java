@@ -12,6 +12,7 @@ import java.lang.Boolean;
 import java.lang.Integer;
 import java.lang.Object;
 import java.lang.String;
+import java.util.List;
 import java.util.Map;
 import java.util.Objects;
 import java.util.Optional;
@@ -302,6 +303,48 @@ public final class VpcCniOptionsArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.nodePortSupport);
     }
 
+    /**
+     * The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+     * 
+     * Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+     * 
+     */
+    @Import(name="podSecurityGroupIds")
+    private @Nullable Output<List<String>> podSecurityGroupIds;
+
+    /**
+     * @return The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+     * 
+     * Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+     * 
+     */
+    public Optional<Output<List<String>>> podSecurityGroupIds() {
+        return Optional.ofNullable(this.podSecurityGroupIds);
+    }
+
+    /**
+     * Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+     * 
+     * Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+     * 
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
+     * 
+     */
+    @Import(name="podSubnets")
+    private @Nullable Map<String,String> podSubnets;
+
+    /**
+     * @return Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+     * 
+     * Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+     * 
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
+     * 
+     */
+    public Optional<Map<String,String>> podSubnets() {
+        return Optional.ofNullable(this.podSubnets);
+    }
+
     /**
      * How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
      * 
@@ -461,6 +504,8 @@ public final class VpcCniOptionsArgs extends com.pulumi.resources.ResourceArgs {
         this.logFile = $.logFile;
         this.logLevel = $.logLevel;
         this.nodePortSupport = $.nodePortSupport;
+        this.podSecurityGroupIds = $.podSecurityGroupIds;
+        this.podSubnets = $.podSubnets;
         this.resolveConflictsOnCreate = $.resolveConflictsOnCreate;
         this.resolveConflictsOnUpdate = $.resolveConflictsOnUpdate;
         this.securityContextPrivileged = $.securityContextPrivileged;
@@ -861,6 +906,58 @@ public final class VpcCniOptionsArgs extends com.pulumi.resources.ResourceArgs {
             return nodePortSupport(Output.of(nodePortSupport));
         }
 
+        /**
+         * @param podSecurityGroupIds The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+         * 
+         * Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder podSecurityGroupIds(@Nullable Output<List<String>> podSecurityGroupIds) {
+            $.podSecurityGroupIds = podSecurityGroupIds;
+            return this;
+        }
+
+        /**
+         * @param podSecurityGroupIds The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+         * 
+         * Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder podSecurityGroupIds(List<String> podSecurityGroupIds) {
+            return podSecurityGroupIds(Output.of(podSecurityGroupIds));
+        }
+
+        /**
+         * @param podSecurityGroupIds The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+         * 
+         * Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder podSecurityGroupIds(String... podSecurityGroupIds) {
+            return podSecurityGroupIds(List.of(podSecurityGroupIds));
+        }
+
+        /**
+         * @param podSubnets Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+         * 
+         * Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+         * 
+         * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
+         * 
+         * @return builder
+         * 
+         */
+        public Builder podSubnets(@Nullable Map<String,String> podSubnets) {
+            $.podSubnets = podSubnets;
+            return this;
+        }
+
         /**
          * @param resolveConflictsOnCreate How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
          * 
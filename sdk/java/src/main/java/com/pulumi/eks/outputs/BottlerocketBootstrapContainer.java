@@ -0,0 +1,124 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Boolean;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class BottlerocketBootstrapContainer {
+    /**
+     * @return Whether the node fails to boot if the bootstrap container fails.
+     * 
+     */
+    private @Nullable Boolean essential;
+    /**
+     * @return When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+     * 
+     */
+    private @Nullable String mode;
+    /**
+     * @return The image of the bootstrap container.
+     * 
+     */
+    private String source;
+    /**
+     * @return Base64 encoded user data passed to the bootstrap container.
+     * 
+     */
+    private @Nullable String userData;
+
+    private BottlerocketBootstrapContainer() {}
+    /**
+     * @return Whether the node fails to boot if the bootstrap container fails.
+     * 
+     */
+    public Optional<Boolean> essential() {
+        return Optional.ofNullable(this.essential);
+    }
+    /**
+     * @return When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+     * 
+     */
+    public Optional<String> mode() {
+        return Optional.ofNullable(this.mode);
+    }
+    /**
+     * @return The image of the bootstrap container.
+     * 
+     */
+    public String source() {
+        return this.source;
+    }
+    /**
+     * @return Base64 encoded user data passed to the bootstrap container.
+     * 
+     */
+    public Optional<String> userData() {
+        return Optional.ofNullable(this.userData);
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(BottlerocketBootstrapContainer defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable Boolean essential;
+        private @Nullable String mode;
+        private String source;
+        private @Nullable String userData;
+        public Builder() {}
+        public Builder(BottlerocketBootstrapContainer defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.essential = defaults.essential;
+    	      this.mode = defaults.mode;
+    	      this.source = defaults.source;
+    	      this.userData = defaults.userData;
+        }
+
+        @CustomType.Setter
+        public Builder essential(@Nullable Boolean essential) {
+
+            this.essential = essential;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder mode(@Nullable String mode) {
+
+            this.mode = mode;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder source(String source) {
+            if (source == null) {
+              throw new MissingRequiredPropertyException("BottlerocketBootstrapContainer", "source");
+            }
+            this.source = source;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder userData(@Nullable String userData) {
+
+            this.userData = userData;
+            return this;
+        }
+        public BottlerocketBootstrapContainer build() {
+            final var _resultValue = new BottlerocketBootstrapContainer();
+            _resultValue.essential = essential;
+            _resultValue.mode = mode;
+            _resultValue.source = source;
+            _resultValue.userData = userData;
+            return _resultValue;
+        }
+    }
+}
@@ -0,0 +1,147 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.eks.outputs.BottlerocketBootstrapContainer;
+import com.pulumi.eks.outputs.BottlerocketContainerRegistry;
+import com.pulumi.eks.outputs.BottlerocketHostContainers;
+import com.pulumi.eks.outputs.BottlerocketKernelSettings;
+import com.pulumi.eks.outputs.BottlerocketKubernetesSettings;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class BottlerocketConfig {
+    /**
+     * @return Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+     * 
+     */
+    private @Nullable Map<String,BottlerocketBootstrapContainer> bootstrapContainers;
+    /**
+     * @return Settings of the container registries, i.e. `settings.container-registry`.
+     * 
+     */
+    private @Nullable BottlerocketContainerRegistry containerRegistry;
+    /**
+     * @return Settings of the admin and control host containers, i.e. `settings.host-containers`.
+     * 
+     */
+    private @Nullable BottlerocketHostContainers hostContainers;
+    /**
+     * @return Settings of the kernel, i.e. `settings.kernel`.
+     * 
+     */
+    private @Nullable BottlerocketKernelSettings kernel;
+    /**
+     * @return Settings of the kubelet, i.e. `settings.kubernetes`.
+     * 
+     */
+    private @Nullable BottlerocketKubernetesSettings kubernetes;
+
+    private BottlerocketConfig() {}
+    /**
+     * @return Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+     * 
+     */
+    public Map<String,BottlerocketBootstrapContainer> bootstrapContainers() {
+        return this.bootstrapContainers == null ? Map.of() : this.bootstrapContainers;
+    }
+    /**
+     * @return Settings of the container registries, i.e. `settings.container-registry`.
+     * 
+     */
+    public Optional<BottlerocketContainerRegistry> containerRegistry() {
+        return Optional.ofNullable(this.containerRegistry);
+    }
+    /**
+     * @return Settings of the admin and control host containers, i.e. `settings.host-containers`.
+     * 
+     */
+    public Optional<BottlerocketHostContainers> hostContainers() {
+        return Optional.ofNullable(this.hostContainers);
+    }
+    /**
+     * @return Settings of the kernel, i.e. `settings.kernel`.
+     * 
+     */
+    public Optional<BottlerocketKernelSettings> kernel() {
+        return Optional.ofNullable(this.kernel);
+    }
+    /**
+     * @return Settings of the kubelet, i.e. `settings.kubernetes`.
+     * 
+     */
+    public Optional<BottlerocketKubernetesSettings> kubernetes() {
+        return Optional.ofNullable(this.kubernetes);
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(BottlerocketConfig defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable Map<String,BottlerocketBootstrapContainer> bootstrapContainers;
+        private @Nullable BottlerocketContainerRegistry containerRegistry;
+        private @Nullable BottlerocketHostContainers hostContainers;
+        private @Nullable BottlerocketKernelSettings kernel;
+        private @Nullable BottlerocketKubernetesSettings kubernetes;
+        public Builder() {}
+        public Builder(BottlerocketConfig defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.bootstrapContainers = defaults.bootstrapContainers;
+    	      this.containerRegistry = defaults.containerRegistry;
+    	      this.hostContainers = defaults.hostContainers;
+    	      this.kernel = defaults.kernel;
+    	      this.kubernetes = defaults.kubernetes;
+        }
+
+        @CustomType.Setter
+        public Builder bootstrapContainers(@Nullable Map<String,BottlerocketBootstrapContainer> bootstrapContainers) {
+
+            this.bootstrapContainers = bootstrapContainers;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder containerRegistry(@Nullable BottlerocketContainerRegistry containerRegistry) {
+
+            this.containerRegistry = containerRegistry;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder hostContainers(@Nullable BottlerocketHostContainers hostContainers) {
+
+            this.hostContainers = hostContainers;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder kernel(@Nullable BottlerocketKernelSettings kernel) {
+
+            this.kernel = kernel;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder kubernetes(@Nullable BottlerocketKubernetesSettings kubernetes) {
+
+            this.kubernetes = kubernetes;
+            return this;
+        }
+        public BottlerocketConfig build() {
+            final var _resultValue = new BottlerocketConfig();
+            _resultValue.bootstrapContainers = bootstrapContainers;
+            _resultValue.containerRegistry = containerRegistry;
+            _resultValue.hostContainers = hostContainers;
+            _resultValue.kernel = kernel;
+            _resultValue.kubernetes = kubernetes;
+            return _resultValue;
+        }
+    }
+}
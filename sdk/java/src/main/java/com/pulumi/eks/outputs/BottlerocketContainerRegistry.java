@@ -0,0 +1,60 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.eks.outputs.BottlerocketRegistryMirror;
+import java.util.List;
+import java.util.Objects;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class BottlerocketContainerRegistry {
+    /**
+     * @return Mirrors to pull images from instead of the original registries.
+     * 
+     */
+    private @Nullable List<BottlerocketRegistryMirror> mirrors;
+
+    private BottlerocketContainerRegistry() {}
+    /**
+     * @return Mirrors to pull images from instead of the original registries.
+     * 
+     */
+    public List<BottlerocketRegistryMirror> mirrors() {
+        return this.mirrors == null ? List.of() : this.mirrors;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(BottlerocketContainerRegistry defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable List<BottlerocketRegistryMirror> mirrors;
+        public Builder() {}
+        public Builder(BottlerocketContainerRegistry defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.mirrors = defaults.mirrors;
+        }
+
+        @CustomType.Setter
+        public Builder mirrors(@Nullable List<BottlerocketRegistryMirror> mirrors) {
+
+            this.mirrors = mirrors;
+            return this;
+        }
+        public Builder mirrors(BottlerocketRegistryMirror... mirrors) {
+            return mirrors(List.of(mirrors));
+        }
+        public BottlerocketContainerRegistry build() {
+            final var _resultValue = new BottlerocketContainerRegistry();
+            _resultValue.mirrors = mirrors;
+            return _resultValue;
+        }
+    }
+}
@@ -0,0 +1,121 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import java.lang.Boolean;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class BottlerocketHostContainer {
+    /**
+     * @return Whether the host container runs.
+     * 
+     */
+    private @Nullable Boolean enabled;
+    /**
+     * @return The image of the host container.
+     * 
+     */
+    private @Nullable String source;
+    /**
+     * @return Whether the host container has elevated privileges.
+     * 
+     */
+    private @Nullable Boolean superpowered;
+    /**
+     * @return Base64 encoded user data passed to the host container.
+     * 
+     */
+    private @Nullable String userData;
+
+    private BottlerocketHostContainer() {}
+    /**
+     * @return Whether the host container runs.
+     * 
+     */
+    public Optional<Boolean> enabled() {
+        return Optional.ofNullable(this.enabled);
+    }
+    /**
+     * @return The image of the host container.
+     * 
+     */
+    public Optional<String> source() {
+        return Optional.ofNullable(this.source);
+    }
+    /**
+     * @return Whether the host container has elevated privileges.
+     * 
+     */
+    public Optional<Boolean> superpowered() {
+        return Optional.ofNullable(this.superpowered);
+    }
+    /**
+     * @return Base64 encoded user data passed to the host container.
+     * 
+     */
+    public Optional<String> userData() {
+        return Optional.ofNullable(this.userData);
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(BottlerocketHostContainer defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable Boolean enabled;
+        private @Nullable String source;
+        private @Nullable Boolean superpowered;
+        private @Nullable String userData;
+        public Builder() {}
+        public Builder(BottlerocketHostContainer defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.enabled = defaults.enabled;
+    	      this.source = defaults.source;
+    	      this.superpowered = defaults.superpowered;
+    	      this.userData = defaults.userData;
+        }
+
+        @CustomType.Setter
+        public Builder enabled(@Nullable Boolean enabled) {
+
+            this.enabled = enabled;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder source(@Nullable String source) {
+
+            this.source = source;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder superpowered(@Nullable Boolean superpowered) {
+
+            this.superpowered = superpowered;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder userData(@Nullable String userData) {
+
+            this.userData = userData;
+            return this;
+        }
+        public BottlerocketHostContainer build() {
+            final var _resultValue = new BottlerocketHostContainer();
+            _resultValue.enabled = enabled;
+            _resultValue.source = source;
+            _resultValue.superpowered = superpowered;
+            _resultValue.userData = userData;
+            return _resultValue;
+        }
+    }
+}
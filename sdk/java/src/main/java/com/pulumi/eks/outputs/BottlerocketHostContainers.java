@@ -0,0 +1,78 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.eks.outputs.BottlerocketHostContainer;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class BottlerocketHostContainers {
+    /**
+     * @return The admin container, used for troubleshooting the node.
+     * 
+     */
+    private @Nullable BottlerocketHostContainer admin;
+    /**
+     * @return The control container, used to access the Bottlerocket API through AWS SSM.
+     * 
+     */
+    private @Nullable BottlerocketHostContainer control;
+
+    private BottlerocketHostContainers() {}
+    /**
+     * @return The admin container, used for troubleshooting the node.
+     * 
+     */
+    public Optional<BottlerocketHostContainer> admin() {
+        return Optional.ofNullable(this.admin);
+    }
+    /**
+     * @return The control container, used to access the Bottlerocket API through AWS SSM.
+     * 
+     */
+    public Optional<BottlerocketHostContainer> control() {
+        return Optional.ofNullable(this.control);
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(BottlerocketHostContainers defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable BottlerocketHostContainer admin;
+        private @Nullable BottlerocketHostContainer control;
+        public Builder() {}
+        public Builder(BottlerocketHostContainers defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.admin = defaults.admin;
+    	      this.control = defaults.control;
+        }
+
+        @CustomType.Setter
+        public Builder admin(@Nullable BottlerocketHostContainer admin) {
+
+            this.admin = admin;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder control(@Nullable BottlerocketHostContainer control) {
+
+            this.control = control;
+            return this;
+        }
+        public BottlerocketHostContainers build() {
+            final var _resultValue = new BottlerocketHostContainers();
+            _resultValue.admin = admin;
+            _resultValue.control = control;
+            return _resultValue;
+        }
+    }
+}
@@ -0,0 +1,79 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class BottlerocketKernelSettings {
+    /**
+     * @return The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+     * 
+     */
+    private @Nullable String lockdown;
+    /**
+     * @return Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: &#34;1&#34;`.
+     * 
+     */
+    private @Nullable Map<String,String> sysctl;
+
+    private BottlerocketKernelSettings() {}
+    /**
+     * @return The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+     * 
+     */
+    public Optional<String> lockdown() {
+        return Optional.ofNullable(this.lockdown);
+    }
+    /**
+     * @return Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: &#34;1&#34;`.
+     * 
+     */
+    public Map<String,String> sysctl() {
+        return this.sysctl == null ? Map.of() : this.sysctl;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(BottlerocketKernelSettings defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable String lockdown;
+        private @Nullable Map<String,String> sysctl;
+        public Builder() {}
+        public Builder(BottlerocketKernelSettings defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.lockdown = defaults.lockdown;
+    	      this.sysctl = defaults.sysctl;
+        }
+
+        @CustomType.Setter
+        public Builder lockdown(@Nullable String lockdown) {
+
+            this.lockdown = lockdown;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder sysctl(@Nullable Map<String,String> sysctl) {
+
+            this.sysctl = sysctl;
+            return this;
+        }
+        public BottlerocketKernelSettings build() {
+            final var _resultValue = new BottlerocketKernelSettings();
+            _resultValue.lockdown = lockdown;
+            _resultValue.sysctl = sysctl;
+            return _resultValue;
+        }
+    }
+}
@@ -0,0 +1,206 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class BottlerocketKubernetesSettings {
+    /**
+     * @return Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+     * 
+     */
+    private @Nullable Map<String,String> evictionHard;
+    /**
+     * @return Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+     * 
+     */
+    private @Nullable Map<String,String> evictionSoft;
+    /**
+     * @return Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+     * 
+     */
+    private @Nullable Map<String,String> evictionSoftGracePeriod;
+    /**
+     * @return The disk usage in percent after which image garbage collection always runs.
+     * 
+     */
+    private @Nullable Integer imageGcHighThresholdPercent;
+    /**
+     * @return The disk usage in percent before which image garbage collection never runs.
+     * 
+     */
+    private @Nullable Integer imageGcLowThresholdPercent;
+    /**
+     * @return The maximum number of pods the kubelet runs on the node.
+     * 
+     */
+    private @Nullable Integer maxPods;
+    /**
+     * @return Labels the node registers with.
+     * 
+     */
+    private @Nullable Map<String,String> nodeLabels;
+    /**
+     * @return Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+     * 
+     */
+    private @Nullable Map<String,String> nodeTaints;
+
+    private BottlerocketKubernetesSettings() {}
+    /**
+     * @return Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+     * 
+     */
+    public Map<String,String> evictionHard() {
+        return this.evictionHard == null ? Map.of() : this.evictionHard;
+    }
+    /**
+     * @return Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+     * 
+     */
+    public Map<String,String> evictionSoft() {
+        return this.evictionSoft == null ? Map.of() : this.evictionSoft;
+    }
+    /**
+     * @return Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+     * 
+     */
+    public Map<String,String> evictionSoftGracePeriod() {
+        return this.evictionSoftGracePeriod == null ? Map.of() : this.evictionSoftGracePeriod;
+    }
+    /**
+     * @return The disk usage in percent after which image garbage collection always runs.
+     * 
+     */
+    public Optional<Integer> imageGcHighThresholdPercent() {
+        return Optional.ofNullable(this.imageGcHighThresholdPercent);
+    }
+    /**
+     * @return The disk usage in percent before which image garbage collection never runs.
+     * 
+     */
+    public Optional<Integer> imageGcLowThresholdPercent() {
+        return Optional.ofNullable(this.imageGcLowThresholdPercent);
+    }
+    /**
+     * @return The maximum number of pods the kubelet runs on the node.
+     * 
+     */
+    public Optional<Integer> maxPods() {
+        return Optional.ofNullable(this.maxPods);
+    }
+    /**
+     * @return Labels the node registers with.
+     * 
+     */
+    public Map<String,String> nodeLabels() {
+        return this.nodeLabels == null ? Map.of() : this.nodeLabels;
+    }
+    /**
+     * @return Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+     * 
+     */
+    public Map<String,String> nodeTaints() {
+        return this.nodeTaints == null ? Map.of() : this.nodeTaints;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(BottlerocketKubernetesSettings defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable Map<String,String> evictionHard;
+        private @Nullable Map<String,String> evictionSoft;
+        private @Nullable Map<String,String> evictionSoftGracePeriod;
+        private @Nullable Integer imageGcHighThresholdPercent;
+        private @Nullable Integer imageGcLowThresholdPercent;
+        private @Nullable Integer maxPods;
+        private @Nullable Map<String,String> nodeLabels;
+        private @Nullable Map<String,String> nodeTaints;
+        public Builder() {}
+        public Builder(BottlerocketKubernetesSettings defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.evictionHard = defaults.evictionHard;
+    	      this.evictionSoft = defaults.evictionSoft;
+    	      this.evictionSoftGracePeriod = defaults.evictionSoftGracePeriod;
+    	      this.imageGcHighThresholdPercent = defaults.imageGcHighThresholdPercent;
+    	      this.imageGcLowThresholdPercent = defaults.imageGcLowThresholdPercent;
+    	      this.maxPods = defaults.maxPods;
+    	      this.nodeLabels = defaults.nodeLabels;
+    	      this.nodeTaints = defaults.nodeTaints;
+        }
+
+        @CustomType.Setter
+        public Builder evictionHard(@Nullable Map<String,String> evictionHard) {
+
+            this.evictionHard = evictionHard;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder evictionSoft(@Nullable Map<String,String> evictionSoft) {
+
+            this.evictionSoft = evictionSoft;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder evictionSoftGracePeriod(@Nullable Map<String,String> evictionSoftGracePeriod) {
+
+            this.evictionSoftGracePeriod = evictionSoftGracePeriod;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder imageGcHighThresholdPercent(@Nullable Integer imageGcHighThresholdPercent) {
+
+            this.imageGcHighThresholdPercent = imageGcHighThresholdPercent;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder imageGcLowThresholdPercent(@Nullable Integer imageGcLowThresholdPercent) {
+
+            this.imageGcLowThresholdPercent = imageGcLowThresholdPercent;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder maxPods(@Nullable Integer maxPods) {
+
+            this.maxPods = maxPods;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder nodeLabels(@Nullable Map<String,String> nodeLabels) {
+
+            this.nodeLabels = nodeLabels;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder nodeTaints(@Nullable Map<String,String> nodeTaints) {
+
+            this.nodeTaints = nodeTaints;
+            return this;
+        }
+        public BottlerocketKubernetesSettings build() {
+            final var _resultValue = new BottlerocketKubernetesSettings();
+            _resultValue.evictionHard = evictionHard;
+            _resultValue.evictionSoft = evictionSoft;
+            _resultValue.evictionSoftGracePeriod = evictionSoftGracePeriod;
+            _resultValue.imageGcHighThresholdPercent = imageGcHighThresholdPercent;
+            _resultValue.imageGcLowThresholdPercent = imageGcLowThresholdPercent;
+            _resultValue.maxPods = maxPods;
+            _resultValue.nodeLabels = nodeLabels;
+            _resultValue.nodeTaints = nodeTaints;
+            return _resultValue;
+        }
+    }
+}
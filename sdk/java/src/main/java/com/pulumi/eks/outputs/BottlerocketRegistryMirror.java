@@ -0,0 +1,85 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.String;
+import java.util.List;
+import java.util.Objects;
+
+@CustomType
+public final class BottlerocketRegistryMirror {
+    /**
+     * @return The endpoints of the mirror, in order of preference.
+     * 
+     */
+    private List<String> endpoints;
+    /**
+     * @return The registry to mirror, e.g. `docker.io`.
+     * 
+     */
+    private String registry;
+
+    private BottlerocketRegistryMirror() {}
+    /**
+     * @return The endpoints of the mirror, in order of preference.
+     * 
+     */
+    public List<String> endpoints() {
+        return this.endpoints;
+    }
+    /**
+     * @return The registry to mirror, e.g. `docker.io`.
+     * 
+     */
+    public String registry() {
+        return this.registry;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(BottlerocketRegistryMirror defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private List<String> endpoints;
+        private String registry;
+        public Builder() {}
+        public Builder(BottlerocketRegistryMirror defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.endpoints = defaults.endpoints;
+    	      this.registry = defaults.registry;
+        }
+
+        @CustomType.Setter
+        public Builder endpoints(List<String> endpoints) {
+            if (endpoints == null) {
+              throw new MissingRequiredPropertyException("BottlerocketRegistryMirror", "endpoints");
+            }
+            this.endpoints = endpoints;
+            return this;
+        }
+        public Builder endpoints(String... endpoints) {
+            return endpoints(List.of(endpoints));
+        }
+        @CustomType.Setter
+        public Builder registry(String registry) {
+            if (registry == null) {
+              throw new MissingRequiredPropertyException("BottlerocketRegistryMirror", "registry");
+            }
+            this.registry = registry;
+            return this;
+        }
+        public BottlerocketRegistryMirror build() {
+            final var _resultValue = new BottlerocketRegistryMirror();
+            _resultValue.endpoints = endpoints;
+            _resultValue.registry = registry;
+            return _resultValue;
+        }
+    }
+}
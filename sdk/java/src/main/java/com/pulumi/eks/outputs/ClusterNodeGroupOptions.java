@@ -9,6 +9,9 @@ import com.pulumi.aws.ec2.outputs.LaunchTemplateTagSpecification;
 import com.pulumi.aws.iam.InstanceProfile;
 import com.pulumi.core.annotations.CustomType;
 import com.pulumi.eks.enums.OperatingSystem;
+import com.pulumi.eks.outputs.BottlerocketConfig;
+import com.pulumi.eks.outputs.InterruptionHandling;
+import com.pulumi.eks.outputs.NodeConfig;
 import com.pulumi.eks.outputs.NodeadmOptions;
 import com.pulumi.eks.outputs.Taint;
 import java.lang.Boolean;
@@ -53,11 +56,24 @@ public final class ClusterNodeGroupOptions {
      * 
      */
     private @Nullable Map<String,String> autoScalingGroupTags;
+    /**
+     * @return Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/&lt;cluster name&gt;` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+     * 
+     * For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+     * 
+     */
+    private @Nullable Boolean autoscalerDiscovery;
     /**
      * @return Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
      * 
      */
     private @Nullable String bootstrapExtraArgs;
+    /**
+     * @return Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+     * The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+     * 
+     */
+    private @Nullable BottlerocketConfig bottlerocketConfig;
     /**
      * @return The configuration settings for Bottlerocket OS.
      * The settings will get merged with the base settings the provider uses to configure Bottlerocket.
@@ -152,6 +168,13 @@ public final class ClusterNodeGroupOptions {
      * 
      */
     private @Nullable String instanceType;
+    /**
+     * @return Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+     * 
+     * See for more details: https://github.com/aws/aws-node-termination-handler
+     * 
+     */
+    private @Nullable InterruptionHandling interruptionHandling;
     /**
      * @return Name of the key pair to use for SSH access to worker nodes.
      * 
@@ -192,6 +215,14 @@ public final class ClusterNodeGroupOptions {
      * 
      */
     private @Nullable Boolean nodeAssociatePublicIpAddress;
+    /**
+     * @return Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+     * 
+     * Note: This is only applicable when using AL2023.
+     * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+     * 
+     */
+    private @Nullable NodeConfig nodeConfig;
     /**
      * @return Public key material for SSH access to worker nodes. See allowed formats at:
      * https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
@@ -348,6 +379,15 @@ public final class ClusterNodeGroupOptions {
     public Map<String,String> autoScalingGroupTags() {
         return this.autoScalingGroupTags == null ? Map.of() : this.autoScalingGroupTags;
     }
+    /**
+     * @return Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/&lt;cluster name&gt;` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+     * 
+     * For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+     * 
+     */
+    public Optional<Boolean> autoscalerDiscovery() {
+        return Optional.ofNullable(this.autoscalerDiscovery);
+    }
     /**
      * @return Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
      * 
@@ -355,6 +395,14 @@ public final class ClusterNodeGroupOptions {
     public Optional<String> bootstrapExtraArgs() {
         return Optional.ofNullable(this.bootstrapExtraArgs);
     }
+    /**
+     * @return Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+     * The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+     * 
+     */
+    public Optional<BottlerocketConfig> bottlerocketConfig() {
+        return Optional.ofNullable(this.bottlerocketConfig);
+    }
     /**
      * @return The configuration settings for Bottlerocket OS.
      * The settings will get merged with the base settings the provider uses to configure Bottlerocket.
@@ -475,6 +523,15 @@ public final class ClusterNodeGroupOptions {
     public Optional<String> instanceType() {
         return Optional.ofNullable(this.instanceType);
     }
+    /**
+     * @return Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+     * 
+     * See for more details: https://github.com/aws/aws-node-termination-handler
+     * 
+     */
+    public Optional<InterruptionHandling> interruptionHandling() {
+        return Optional.ofNullable(this.interruptionHandling);
+    }
     /**
      * @return Name of the key pair to use for SSH access to worker nodes.
      * 
@@ -531,6 +588,16 @@ public final class ClusterNodeGroupOptions {
     public Optional<Boolean> nodeAssociatePublicIpAddress() {
         return Optional.ofNullable(this.nodeAssociatePublicIpAddress);
     }
+    /**
+     * @return Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+     * 
+     * Note: This is only applicable when using AL2023.
+     * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+     * 
+     */
+    public Optional<NodeConfig> nodeConfig() {
+        return Optional.ofNullable(this.nodeConfig);
+    }
     /**
      * @return Public key material for SSH access to worker nodes. See allowed formats at:
      * https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
@@ -696,7 +763,9 @@ public final class ClusterNodeGroupOptions {
         private @Nullable String amiId;
         private @Nullable String amiType;
         private @Nullable Map<String,String> autoScalingGroupTags;
+        private @Nullable Boolean autoscalerDiscovery;
         private @Nullable String bootstrapExtraArgs;
+        private @Nullable BottlerocketConfig bottlerocketConfig;
         private @Nullable Map<String,Object> bottlerocketSettings;
         private @Nullable Map<String,String> cloudFormationTags;
         private @Nullable SecurityGroupRule clusterIngressRule;
@@ -710,6 +779,7 @@ public final class ClusterNodeGroupOptions {
         private @Nullable InstanceProfile instanceProfile;
         private @Nullable String instanceProfileName;
         private @Nullable String instanceType;
+        private @Nullable InterruptionHandling interruptionHandling;
         private @Nullable String keyName;
         private @Nullable String kubeletExtraArgs;
         private @Nullable Map<String,String> labels;
@@ -718,6 +788,7 @@ public final class ClusterNodeGroupOptions {
         private @Nullable Integer minRefreshPercentage;
         private @Nullable Integer minSize;
         private @Nullable Boolean nodeAssociatePublicIpAddress;
+        private @Nullable NodeConfig nodeConfig;
         private @Nullable String nodePublicKey;
         private @Nullable Boolean nodeRootVolumeDeleteOnTermination;
         private @Nullable Boolean nodeRootVolumeEncrypted;
@@ -741,7 +812,9 @@ public final class ClusterNodeGroupOptions {
     	      this.amiId = defaults.amiId;
     	      this.amiType = defaults.amiType;
     	      this.autoScalingGroupTags = defaults.autoScalingGroupTags;
+    	      this.autoscalerDiscovery = defaults.autoscalerDiscovery;
     	      this.bootstrapExtraArgs = defaults.bootstrapExtraArgs;
+    	      this.bottlerocketConfig = defaults.bottlerocketConfig;
     	      this.bottlerocketSettings = defaults.bottlerocketSettings;
     	      this.cloudFormationTags = defaults.cloudFormationTags;
     	      this.clusterIngressRule = defaults.clusterIngressRule;
@@ -755,6 +828,7 @@ public final class ClusterNodeGroupOptions {
     	      this.instanceProfile = defaults.instanceProfile;
     	      this.instanceProfileName = defaults.instanceProfileName;
     	      this.instanceType = defaults.instanceType;
+    	      this.interruptionHandling = defaults.interruptionHandling;
     	      this.keyName = defaults.keyName;
     	      this.kubeletExtraArgs = defaults.kubeletExtraArgs;
     	      this.labels = defaults.labels;
@@ -763,6 +837,7 @@ public final class ClusterNodeGroupOptions {
     	      this.minRefreshPercentage = defaults.minRefreshPercentage;
     	      this.minSize = defaults.minSize;
     	      this.nodeAssociatePublicIpAddress = defaults.nodeAssociatePublicIpAddress;
+    	      this.nodeConfig = defaults.nodeConfig;
     	      this.nodePublicKey = defaults.nodePublicKey;
     	      this.nodeRootVolumeDeleteOnTermination = defaults.nodeRootVolumeDeleteOnTermination;
     	      this.nodeRootVolumeEncrypted = defaults.nodeRootVolumeEncrypted;
@@ -801,12 +876,24 @@ public final class ClusterNodeGroupOptions {
             return this;
         }
         @CustomType.Setter
+        public Builder autoscalerDiscovery(@Nullable Boolean autoscalerDiscovery) {
+
+            this.autoscalerDiscovery = autoscalerDiscovery;
+            return this;
+        }
+        @CustomType.Setter
         public Builder bootstrapExtraArgs(@Nullable String bootstrapExtraArgs) {
 
             this.bootstrapExtraArgs = bootstrapExtraArgs;
             return this;
         }
         @CustomType.Setter
+        public Builder bottlerocketConfig(@Nullable BottlerocketConfig bottlerocketConfig) {
+
+            this.bottlerocketConfig = bottlerocketConfig;
+            return this;
+        }
+        @CustomType.Setter
         public Builder bottlerocketSettings(@Nullable Map<String,Object> bottlerocketSettings) {
 
             this.bottlerocketSettings = bottlerocketSettings;
@@ -888,6 +975,12 @@ public final class ClusterNodeGroupOptions {
             return this;
         }
         @CustomType.Setter
+        public Builder interruptionHandling(@Nullable InterruptionHandling interruptionHandling) {
+
+            this.interruptionHandling = interruptionHandling;
+            return this;
+        }
+        @CustomType.Setter
         public Builder keyName(@Nullable String keyName) {
 
             this.keyName = keyName;
@@ -939,6 +1032,12 @@ public final class ClusterNodeGroupOptions {
             return this;
         }
         @CustomType.Setter
+        public Builder nodeConfig(@Nullable NodeConfig nodeConfig) {
+
+            this.nodeConfig = nodeConfig;
+            return this;
+        }
+        @CustomType.Setter
         public Builder nodePublicKey(@Nullable String nodePublicKey) {
 
             this.nodePublicKey = nodePublicKey;
@@ -1051,7 +1150,9 @@ public final class ClusterNodeGroupOptions {
             _resultValue.amiId = amiId;
             _resultValue.amiType = amiType;
             _resultValue.autoScalingGroupTags = autoScalingGroupTags;
+            _resultValue.autoscalerDiscovery = autoscalerDiscovery;
             _resultValue.bootstrapExtraArgs = bootstrapExtraArgs;
+            _resultValue.bottlerocketConfig = bottlerocketConfig;
             _resultValue.bottlerocketSettings = bottlerocketSettings;
             _resultValue.cloudFormationTags = cloudFormationTags;
             _resultValue.clusterIngressRule = clusterIngressRule;
@@ -1065,6 +1166,7 @@ public final class ClusterNodeGroupOptions {
             _resultValue.instanceProfile = instanceProfile;
             _resultValue.instanceProfileName = instanceProfileName;
             _resultValue.instanceType = instanceType;
+            _resultValue.interruptionHandling = interruptionHandling;
             _resultValue.keyName = keyName;
             _resultValue.kubeletExtraArgs = kubeletExtraArgs;
             _resultValue.labels = labels;
@@ -1073,6 +1175,7 @@ public final class ClusterNodeGroupOptions {
             _resultValue.minRefreshPercentage = minRefreshPercentage;
             _resultValue.minSize = minSize;
             _resultValue.nodeAssociatePublicIpAddress = nodeAssociatePublicIpAddress;
+            _resultValue.nodeConfig = nodeConfig;
             _resultValue.nodePublicKey = nodePublicKey;
             _resultValue.nodeRootVolumeDeleteOnTermination = nodeRootVolumeDeleteOnTermination;
             _resultValue.nodeRootVolumeEncrypted = nodeRootVolumeEncrypted;
@@ -4,16 +4,21 @@
 package com.pulumi.eks.outputs;
 
 import com.pulumi.aws.Provider;
+import com.pulumi.aws.cloudwatch.LogGroup;
 import com.pulumi.aws.ec2.SecurityGroup;
 import com.pulumi.aws.eks.Cluster;
 import com.pulumi.aws.eks.FargateProfile;
+import com.pulumi.aws.eks.outputs.ClusterControlPlaneScalingConfig;
 import com.pulumi.aws.eks.outputs.ClusterEncryptionConfig;
+import com.pulumi.aws.eks.outputs.ClusterUpgradePolicy;
+import com.pulumi.aws.eks.outputs.ClusterZonalShiftConfig;
 import com.pulumi.aws.iam.OpenIdConnectProvider;
 import com.pulumi.aws.iam.Role;
 import com.pulumi.core.annotations.CustomType;
 import com.pulumi.eks.VpcCniAddon;
 import com.pulumi.eks.outputs.AccessEntry;
 import com.pulumi.eks.outputs.ClusterNodeGroupOptions;
+import com.pulumi.eks.outputs.VpcCniNetworking;
 import com.pulumi.exceptions.MissingRequiredPropertyException;
 import com.pulumi.kubernetes.core.v1.ConfigMap;
 import com.pulumi.kubernetes.storage.v1.StorageClass;
@@ -39,7 +44,17 @@ public final class CoreData {
      * 
      */
     private Role clusterIamRole;
+    /**
+     * @return The log group of the control plane logs, if it is managed by the cluster.
+     * 
+     */
+    private @Nullable LogGroup clusterLogGroup;
     private @Nullable SecurityGroup clusterSecurityGroup;
+    /**
+     * @return The scaling configuration of the control plane, including its provisioned tier.
+     * 
+     */
+    private @Nullable ClusterControlPlaneScalingConfig controlPlaneScalingConfig;
     private @Nullable ConfigMap eksNodeAccess;
     private @Nullable ClusterEncryptionConfig encryptionConfig;
     /**
@@ -99,16 +114,31 @@ public final class CoreData {
      * 
      */
     private @Nullable Map<String,String> tags;
+    /**
+     * @return The upgrade policy of the cluster, which determines whether it gets extended support.
+     * 
+     */
+    private @Nullable ClusterUpgradePolicy upgradePolicy;
     /**
      * @return The VPC CNI for the cluster.
      * 
      */
     private @Nullable VpcCniAddon vpcCni;
+    /**
+     * @return The VPC CNI settings that affect the maximum number of pods of the nodes.
+     * 
+     */
+    private @Nullable VpcCniNetworking vpcCniNetworking;
     /**
      * @return ID of the cluster&#39;s VPC.
      * 
      */
     private String vpcId;
+    /**
+     * @return The zonal shift configuration of the cluster.
+     * 
+     */
+    private @Nullable ClusterZonalShiftConfig zonalShiftConfig;
 
     private CoreData() {}
     /**
@@ -131,9 +161,23 @@ public final class CoreData {
     public Role clusterIamRole() {
         return this.clusterIamRole;
     }
+    /**
+     * @return The log group of the control plane logs, if it is managed by the cluster.
+     * 
+     */
+    public Optional<LogGroup> clusterLogGroup() {
+        return Optional.ofNullable(this.clusterLogGroup);
+    }
     public Optional<SecurityGroup> clusterSecurityGroup() {
         return Optional.ofNullable(this.clusterSecurityGroup);
     }
+    /**
+     * @return The scaling configuration of the control plane, including its provisioned tier.
+     * 
+     */
+    public Optional<ClusterControlPlaneScalingConfig> controlPlaneScalingConfig() {
+        return Optional.ofNullable(this.controlPlaneScalingConfig);
+    }
     public Optional<ConfigMap> eksNodeAccess() {
         return Optional.ofNullable(this.eksNodeAccess);
     }
@@ -223,6 +267,13 @@ public final class CoreData {
     public Map<String,String> tags() {
         return this.tags == null ? Map.of() : this.tags;
     }
+    /**
+     * @return The upgrade policy of the cluster, which determines whether it gets extended support.
+     * 
+     */
+    public Optional<ClusterUpgradePolicy> upgradePolicy() {
+        return Optional.ofNullable(this.upgradePolicy);
+    }
     /**
      * @return The VPC CNI for the cluster.
      * 
@@ -230,6 +281,13 @@ public final class CoreData {
     public Optional<VpcCniAddon> vpcCni() {
         return Optional.ofNullable(this.vpcCni);
     }
+    /**
+     * @return The VPC CNI settings that affect the maximum number of pods of the nodes.
+     * 
+     */
+    public Optional<VpcCniNetworking> vpcCniNetworking() {
+        return Optional.ofNullable(this.vpcCniNetworking);
+    }
     /**
      * @return ID of the cluster&#39;s VPC.
      * 
@@ -237,6 +295,13 @@ public final class CoreData {
     public String vpcId() {
         return this.vpcId;
     }
+    /**
+     * @return The zonal shift configuration of the cluster.
+     * 
+     */
+    public Optional<ClusterZonalShiftConfig> zonalShiftConfig() {
+        return Optional.ofNullable(this.zonalShiftConfig);
+    }
 
     public static Builder builder() {
         return new Builder();
@@ -251,7 +316,9 @@ public final class CoreData {
         private @Nullable Provider awsProvider;
         private Cluster cluster;
         private Role clusterIamRole;
+        private @Nullable LogGroup clusterLogGroup;
         private @Nullable SecurityGroup clusterSecurityGroup;
+        private @Nullable ClusterControlPlaneScalingConfig controlPlaneScalingConfig;
         private @Nullable ConfigMap eksNodeAccess;
         private @Nullable ClusterEncryptionConfig encryptionConfig;
         private String endpoint;
@@ -267,8 +334,11 @@ public final class CoreData {
         private @Nullable Map<String,StorageClass> storageClasses;
         private List<String> subnetIds;
         private @Nullable Map<String,String> tags;
+        private @Nullable ClusterUpgradePolicy upgradePolicy;
         private @Nullable VpcCniAddon vpcCni;
+        private @Nullable VpcCniNetworking vpcCniNetworking;
         private String vpcId;
+        private @Nullable ClusterZonalShiftConfig zonalShiftConfig;
         public Builder() {}
         public Builder(CoreData defaults) {
     	      Objects.requireNonNull(defaults);
@@ -276,7 +346,9 @@ public final class CoreData {
     	      this.awsProvider = defaults.awsProvider;
     	      this.cluster = defaults.cluster;
     	      this.clusterIamRole = defaults.clusterIamRole;
+    	      this.clusterLogGroup = defaults.clusterLogGroup;
     	      this.clusterSecurityGroup = defaults.clusterSecurityGroup;
+    	      this.controlPlaneScalingConfig = defaults.controlPlaneScalingConfig;
     	      this.eksNodeAccess = defaults.eksNodeAccess;
     	      this.encryptionConfig = defaults.encryptionConfig;
     	      this.endpoint = defaults.endpoint;
@@ -292,8 +364,11 @@ public final class CoreData {
     	      this.storageClasses = defaults.storageClasses;
     	      this.subnetIds = defaults.subnetIds;
     	      this.tags = defaults.tags;
+    	      this.upgradePolicy = defaults.upgradePolicy;
     	      this.vpcCni = defaults.vpcCni;
+    	      this.vpcCniNetworking = defaults.vpcCniNetworking;
     	      this.vpcId = defaults.vpcId;
+    	      this.zonalShiftConfig = defaults.zonalShiftConfig;
         }
 
         @CustomType.Setter
@@ -328,12 +403,24 @@ public final class CoreData {
             return this;
         }
         @CustomType.Setter
+        public Builder clusterLogGroup(@Nullable LogGroup clusterLogGroup) {
+
+            this.clusterLogGroup = clusterLogGroup;
+            return this;
+        }
+        @CustomType.Setter
         public Builder clusterSecurityGroup(@Nullable SecurityGroup clusterSecurityGroup) {
 
             this.clusterSecurityGroup = clusterSecurityGroup;
             return this;
         }
         @CustomType.Setter
+        public Builder controlPlaneScalingConfig(@Nullable ClusterControlPlaneScalingConfig controlPlaneScalingConfig) {
+
+            this.controlPlaneScalingConfig = controlPlaneScalingConfig;
+            return this;
+        }
+        @CustomType.Setter
         public Builder eksNodeAccess(@Nullable ConfigMap eksNodeAccess) {
 
             this.eksNodeAccess = eksNodeAccess;
@@ -446,12 +533,24 @@ public final class CoreData {
             return this;
         }
         @CustomType.Setter
+        public Builder upgradePolicy(@Nullable ClusterUpgradePolicy upgradePolicy) {
+
+            this.upgradePolicy = upgradePolicy;
+            return this;
+        }
+        @CustomType.Setter
         public Builder vpcCni(@Nullable VpcCniAddon vpcCni) {
 
             this.vpcCni = vpcCni;
             return this;
         }
         @CustomType.Setter
+        public Builder vpcCniNetworking(@Nullable VpcCniNetworking vpcCniNetworking) {
+
+            this.vpcCniNetworking = vpcCniNetworking;
+            return this;
+        }
+        @CustomType.Setter
         public Builder vpcId(String vpcId) {
             if (vpcId == null) {
               throw new MissingRequiredPropertyException("CoreData", "vpcId");
@@ -459,13 +558,21 @@ public final class CoreData {
             this.vpcId = vpcId;
             return this;
         }
+        @CustomType.Setter
+        public Builder zonalShiftConfig(@Nullable ClusterZonalShiftConfig zonalShiftConfig) {
+
+            this.zonalShiftConfig = zonalShiftConfig;
+            return this;
+        }
         public CoreData build() {
             final var _resultValue = new CoreData();
             _resultValue.accessEntries = accessEntries;
             _resultValue.awsProvider = awsProvider;
             _resultValue.cluster = cluster;
             _resultValue.clusterIamRole = clusterIamRole;
+            _resultValue.clusterLogGroup = clusterLogGroup;
             _resultValue.clusterSecurityGroup = clusterSecurityGroup;
+            _resultValue.controlPlaneScalingConfig = controlPlaneScalingConfig;
             _resultValue.eksNodeAccess = eksNodeAccess;
             _resultValue.encryptionConfig = encryptionConfig;
             _resultValue.endpoint = endpoint;
@@ -481,8 +588,11 @@ public final class CoreData {
             _resultValue.storageClasses = storageClasses;
             _resultValue.subnetIds = subnetIds;
             _resultValue.tags = tags;
+            _resultValue.upgradePolicy = upgradePolicy;
             _resultValue.vpcCni = vpcCni;
+            _resultValue.vpcCniNetworking = vpcCniNetworking;
             _resultValue.vpcId = vpcId;
+            _resultValue.zonalShiftConfig = zonalShiftConfig;
             return _resultValue;
         }
     }
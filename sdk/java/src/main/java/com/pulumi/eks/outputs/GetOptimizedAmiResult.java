@@ -0,0 +1,104 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.String;
+import java.util.Objects;
+
+@CustomType
+public final class GetOptimizedAmiResult {
+    /**
+     * @return The ID of the AMI.
+     * 
+     */
+    private String amiId;
+    /**
+     * @return The release version of the AMI.
+     * 
+     */
+    private String releaseVersion;
+    /**
+     * @return The name of the SSM parameter the AMI ID was read from. The parameter is specific to the release version of the AMI and does not change when new AMIs are released.
+     * 
+     */
+    private String ssmParameterName;
+
+    private GetOptimizedAmiResult() {}
+    /**
+     * @return The ID of the AMI.
+     * 
+     */
+    public String amiId() {
+        return this.amiId;
+    }
+    /**
+     * @return The release version of the AMI.
+     * 
+     */
+    public String releaseVersion() {
+        return this.releaseVersion;
+    }
+    /**
+     * @return The name of the SSM parameter the AMI ID was read from. The parameter is specific to the release version of the AMI and does not change when new AMIs are released.
+     * 
+     */
+    public String ssmParameterName() {
+        return this.ssmParameterName;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(GetOptimizedAmiResult defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private String amiId;
+        private String releaseVersion;
+        private String ssmParameterName;
+        public Builder() {}
+        public Builder(GetOptimizedAmiResult defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.amiId = defaults.amiId;
+    	      this.releaseVersion = defaults.releaseVersion;
+    	      this.ssmParameterName = defaults.ssmParameterName;
+        }
+
+        @CustomType.Setter
+        public Builder amiId(String amiId) {
+            if (amiId == null) {
+              throw new MissingRequiredPropertyException("GetOptimizedAmiResult", "amiId");
+            }
+            this.amiId = amiId;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder releaseVersion(String releaseVersion) {
+            if (releaseVersion == null) {
+              throw new MissingRequiredPropertyException("GetOptimizedAmiResult", "releaseVersion");
+            }
+            this.releaseVersion = releaseVersion;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder ssmParameterName(String ssmParameterName) {
+            if (ssmParameterName == null) {
+              throw new MissingRequiredPropertyException("GetOptimizedAmiResult", "ssmParameterName");
+            }
+            this.ssmParameterName = ssmParameterName;
+            return this;
+        }
+        public GetOptimizedAmiResult build() {
+            final var _resultValue = new GetOptimizedAmiResult();
+            _resultValue.amiId = amiId;
+            _resultValue.releaseVersion = releaseVersion;
+            _resultValue.ssmParameterName = ssmParameterName;
+            return _resultValue;
+        }
+    }
+}
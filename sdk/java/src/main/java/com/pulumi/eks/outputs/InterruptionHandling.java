@@ -0,0 +1,169 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import java.lang.Integer;
+import java.lang.Object;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class InterruptionHandling {
+    /**
+     * @return The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+     * 
+     */
+    private @Nullable String chartVersion;
+    /**
+     * @return The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+     * 
+     */
+    private @Nullable Integer heartbeatTimeout;
+    /**
+     * @return The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+     * 
+     */
+    private @Nullable String namespace;
+    /**
+     * @return The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+     * 
+     * If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+     * 
+     */
+    private @Nullable String oidcProviderArn;
+    /**
+     * @return The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `&lt;name&gt;-node-termination-handler`, where `&lt;name&gt;` is the name of the node group.
+     * 
+     */
+    private @Nullable String serviceAccountName;
+    /**
+     * @return Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+     * 
+     */
+    private @Nullable Map<String,Object> values;
+
+    private InterruptionHandling() {}
+    /**
+     * @return The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+     * 
+     */
+    public Optional<String> chartVersion() {
+        return Optional.ofNullable(this.chartVersion);
+    }
+    /**
+     * @return The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+     * 
+     */
+    public Optional<Integer> heartbeatTimeout() {
+        return Optional.ofNullable(this.heartbeatTimeout);
+    }
+    /**
+     * @return The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+     * 
+     */
+    public Optional<String> namespace() {
+        return Optional.ofNullable(this.namespace);
+    }
+    /**
+     * @return The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+     * 
+     * If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+     * 
+     */
+    public Optional<String> oidcProviderArn() {
+        return Optional.ofNullable(this.oidcProviderArn);
+    }
+    /**
+     * @return The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `&lt;name&gt;-node-termination-handler`, where `&lt;name&gt;` is the name of the node group.
+     * 
+     */
+    public Optional<String> serviceAccountName() {
+        return Optional.ofNullable(this.serviceAccountName);
+    }
+    /**
+     * @return Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+     * 
+     */
+    public Map<String,Object> values() {
+        return this.values == null ? Map.of() : this.values;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(InterruptionHandling defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable String chartVersion;
+        private @Nullable Integer heartbeatTimeout;
+        private @Nullable String namespace;
+        private @Nullable String oidcProviderArn;
+        private @Nullable String serviceAccountName;
+        private @Nullable Map<String,Object> values;
+        public Builder() {}
+        public Builder(InterruptionHandling defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.chartVersion = defaults.chartVersion;
+    	      this.heartbeatTimeout = defaults.heartbeatTimeout;
+    	      this.namespace = defaults.namespace;
+    	      this.oidcProviderArn = defaults.oidcProviderArn;
+    	      this.serviceAccountName = defaults.serviceAccountName;
+    	      this.values = defaults.values;
+        }
+
+        @CustomType.Setter
+        public Builder chartVersion(@Nullable String chartVersion) {
+
+            this.chartVersion = chartVersion;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder heartbeatTimeout(@Nullable Integer heartbeatTimeout) {
+
+            this.heartbeatTimeout = heartbeatTimeout;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder namespace(@Nullable String namespace) {
+
+            this.namespace = namespace;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder oidcProviderArn(@Nullable String oidcProviderArn) {
+
+            this.oidcProviderArn = oidcProviderArn;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder serviceAccountName(@Nullable String serviceAccountName) {
+
+            this.serviceAccountName = serviceAccountName;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder values(@Nullable Map<String,Object> values) {
+
+            this.values = values;
+            return this;
+        }
+        public InterruptionHandling build() {
+            final var _resultValue = new InterruptionHandling();
+            _resultValue.chartVersion = chartVersion;
+            _resultValue.heartbeatTimeout = heartbeatTimeout;
+            _resultValue.namespace = namespace;
+            _resultValue.oidcProviderArn = oidcProviderArn;
+            _resultValue.serviceAccountName = serviceAccountName;
+            _resultValue.values = values;
+            return _resultValue;
+        }
+    }
+}
@@ -0,0 +1,101 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.eks.outputs.NodeConfigContainerd;
+import com.pulumi.eks.outputs.NodeConfigInstance;
+import com.pulumi.eks.outputs.NodeConfigKubelet;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class NodeConfig {
+    /**
+     * @return Configuration of containerd.
+     * 
+     */
+    private @Nullable NodeConfigContainerd containerd;
+    /**
+     * @return Configuration of the EC2 instance.
+     * 
+     */
+    private @Nullable NodeConfigInstance instance;
+    /**
+     * @return Configuration of the kubelet.
+     * 
+     */
+    private @Nullable NodeConfigKubelet kubelet;
+
+    private NodeConfig() {}
+    /**
+     * @return Configuration of containerd.
+     * 
+     */
+    public Optional<NodeConfigContainerd> containerd() {
+        return Optional.ofNullable(this.containerd);
+    }
+    /**
+     * @return Configuration of the EC2 instance.
+     * 
+     */
+    public Optional<NodeConfigInstance> instance() {
+        return Optional.ofNullable(this.instance);
+    }
+    /**
+     * @return Configuration of the kubelet.
+     * 
+     */
+    public Optional<NodeConfigKubelet> kubelet() {
+        return Optional.ofNullable(this.kubelet);
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(NodeConfig defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable NodeConfigContainerd containerd;
+        private @Nullable NodeConfigInstance instance;
+        private @Nullable NodeConfigKubelet kubelet;
+        public Builder() {}
+        public Builder(NodeConfig defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.containerd = defaults.containerd;
+    	      this.instance = defaults.instance;
+    	      this.kubelet = defaults.kubelet;
+        }
+
+        @CustomType.Setter
+        public Builder containerd(@Nullable NodeConfigContainerd containerd) {
+
+            this.containerd = containerd;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder instance(@Nullable NodeConfigInstance instance) {
+
+            this.instance = instance;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder kubelet(@Nullable NodeConfigKubelet kubelet) {
+
+            this.kubelet = kubelet;
+            return this;
+        }
+        public NodeConfig build() {
+            final var _resultValue = new NodeConfig();
+            _resultValue.containerd = containerd;
+            _resultValue.instance = instance;
+            _resultValue.kubelet = kubelet;
+            return _resultValue;
+        }
+    }
+}
@@ -0,0 +1,80 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import java.lang.Object;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class NodeConfigContainerd {
+    /**
+     * @return The OCI runtime specification containers are created with.
+     * 
+     */
+    private @Nullable Map<String,Object> baseRuntimeSpec;
+    /**
+     * @return Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+     * 
+     */
+    private @Nullable String config;
+
+    private NodeConfigContainerd() {}
+    /**
+     * @return The OCI runtime specification containers are created with.
+     * 
+     */
+    public Map<String,Object> baseRuntimeSpec() {
+        return this.baseRuntimeSpec == null ? Map.of() : this.baseRuntimeSpec;
+    }
+    /**
+     * @return Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+     * 
+     */
+    public Optional<String> config() {
+        return Optional.ofNullable(this.config);
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(NodeConfigContainerd defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable Map<String,Object> baseRuntimeSpec;
+        private @Nullable String config;
+        public Builder() {}
+        public Builder(NodeConfigContainerd defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.baseRuntimeSpec = defaults.baseRuntimeSpec;
+    	      this.config = defaults.config;
+        }
+
+        @CustomType.Setter
+        public Builder baseRuntimeSpec(@Nullable Map<String,Object> baseRuntimeSpec) {
+
+            this.baseRuntimeSpec = baseRuntimeSpec;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder config(@Nullable String config) {
+
+            this.config = config;
+            return this;
+        }
+        public NodeConfigContainerd build() {
+            final var _resultValue = new NodeConfigContainerd();
+            _resultValue.baseRuntimeSpec = baseRuntimeSpec;
+            _resultValue.config = config;
+            return _resultValue;
+        }
+    }
+}
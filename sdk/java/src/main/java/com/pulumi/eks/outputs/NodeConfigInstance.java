@@ -0,0 +1,57 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.eks.outputs.NodeConfigLocalStorage;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class NodeConfigInstance {
+    /**
+     * @return Configuration of the local instance store volumes.
+     * 
+     */
+    private @Nullable NodeConfigLocalStorage localStorage;
+
+    private NodeConfigInstance() {}
+    /**
+     * @return Configuration of the local instance store volumes.
+     * 
+     */
+    public Optional<NodeConfigLocalStorage> localStorage() {
+        return Optional.ofNullable(this.localStorage);
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(NodeConfigInstance defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable NodeConfigLocalStorage localStorage;
+        public Builder() {}
+        public Builder(NodeConfigInstance defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.localStorage = defaults.localStorage;
+        }
+
+        @CustomType.Setter
+        public Builder localStorage(@Nullable NodeConfigLocalStorage localStorage) {
+
+            this.localStorage = localStorage;
+            return this;
+        }
+        public NodeConfigInstance build() {
+            final var _resultValue = new NodeConfigInstance();
+            _resultValue.localStorage = localStorage;
+            return _resultValue;
+        }
+    }
+}
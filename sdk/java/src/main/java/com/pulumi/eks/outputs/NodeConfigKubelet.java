@@ -0,0 +1,87 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import java.lang.Object;
+import java.lang.String;
+import java.util.List;
+import java.util.Map;
+import java.util.Objects;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class NodeConfigKubelet {
+    /**
+     * @return Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+     * 
+     * See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+     * 
+     */
+    private @Nullable Map<String,Object> config;
+    /**
+     * @return Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+     * 
+     */
+    private @Nullable List<String> flags;
+
+    private NodeConfigKubelet() {}
+    /**
+     * @return Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+     * 
+     * See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+     * 
+     */
+    public Map<String,Object> config() {
+        return this.config == null ? Map.of() : this.config;
+    }
+    /**
+     * @return Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+     * 
+     */
+    public List<String> flags() {
+        return this.flags == null ? List.of() : this.flags;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(NodeConfigKubelet defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable Map<String,Object> config;
+        private @Nullable List<String> flags;
+        public Builder() {}
+        public Builder(NodeConfigKubelet defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.config = defaults.config;
+    	      this.flags = defaults.flags;
+        }
+
+        @CustomType.Setter
+        public Builder config(@Nullable Map<String,Object> config) {
+
+            this.config = config;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder flags(@Nullable List<String> flags) {
+
+            this.flags = flags;
+            return this;
+        }
+        public Builder flags(String... flags) {
+            return flags(List.of(flags));
+        }
+        public NodeConfigKubelet build() {
+            final var _resultValue = new NodeConfigKubelet();
+            _resultValue.config = config;
+            _resultValue.flags = flags;
+            return _resultValue;
+        }
+    }
+}
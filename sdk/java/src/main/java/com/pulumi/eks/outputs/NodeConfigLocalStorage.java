@@ -0,0 +1,106 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.String;
+import java.util.List;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class NodeConfigLocalStorage {
+    /**
+     * @return Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+     * 
+     */
+    private @Nullable List<String> disabledMounts;
+    /**
+     * @return The path the instance store volumes are mounted at.
+     * 
+     */
+    private @Nullable String mountPath;
+    /**
+     * @return How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+     * 
+     */
+    private String strategy;
+
+    private NodeConfigLocalStorage() {}
+    /**
+     * @return Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+     * 
+     */
+    public List<String> disabledMounts() {
+        return this.disabledMounts == null ? List.of() : this.disabledMounts;
+    }
+    /**
+     * @return The path the instance store volumes are mounted at.
+     * 
+     */
+    public Optional<String> mountPath() {
+        return Optional.ofNullable(this.mountPath);
+    }
+    /**
+     * @return How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+     * 
+     */
+    public String strategy() {
+        return this.strategy;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(NodeConfigLocalStorage defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable List<String> disabledMounts;
+        private @Nullable String mountPath;
+        private String strategy;
+        public Builder() {}
+        public Builder(NodeConfigLocalStorage defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.disabledMounts = defaults.disabledMounts;
+    	      this.mountPath = defaults.mountPath;
+    	      this.strategy = defaults.strategy;
+        }
+
+        @CustomType.Setter
+        public Builder disabledMounts(@Nullable List<String> disabledMounts) {
+
+            this.disabledMounts = disabledMounts;
+            return this;
+        }
+        public Builder disabledMounts(String... disabledMounts) {
+            return disabledMounts(List.of(disabledMounts));
+        }
+        @CustomType.Setter
+        public Builder mountPath(@Nullable String mountPath) {
+
+            this.mountPath = mountPath;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder strategy(String strategy) {
+            if (strategy == null) {
+              throw new MissingRequiredPropertyException("NodeConfigLocalStorage", "strategy");
+            }
+            this.strategy = strategy;
+            return this;
+        }
+        public NodeConfigLocalStorage build() {
+            final var _resultValue = new NodeConfigLocalStorage();
+            _resultValue.disabledMounts = disabledMounts;
+            _resultValue.mountPath = mountPath;
+            _resultValue.strategy = strategy;
+            return _resultValue;
+        }
+    }
+}
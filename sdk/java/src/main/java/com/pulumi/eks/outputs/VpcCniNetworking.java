@@ -0,0 +1,81 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.eks.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Boolean;
+import java.util.Objects;
+
+@CustomType
+public final class VpcCniNetworking {
+    /**
+     * @return Whether pods get their IP addresses from the subnets of ENIConfigs.
+     * 
+     */
+    private Boolean customNetworking;
+    /**
+     * @return Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+     * 
+     */
+    private Boolean prefixDelegation;
+
+    private VpcCniNetworking() {}
+    /**
+     * @return Whether pods get their IP addresses from the subnets of ENIConfigs.
+     * 
+     */
+    public Boolean customNetworking() {
+        return this.customNetworking;
+    }
+    /**
+     * @return Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+     * 
+     */
+    public Boolean prefixDelegation() {
+        return this.prefixDelegation;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(VpcCniNetworking defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private Boolean customNetworking;
+        private Boolean prefixDelegation;
+        public Builder() {}
+        public Builder(VpcCniNetworking defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.customNetworking = defaults.customNetworking;
+    	      this.prefixDelegation = defaults.prefixDelegation;
+        }
+
+        @CustomType.Setter
+        public Builder customNetworking(Boolean customNetworking) {
+            if (customNetworking == null) {
+              throw new MissingRequiredPropertyException("VpcCniNetworking", "customNetworking");
+            }
+            this.customNetworking = customNetworking;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder prefixDelegation(Boolean prefixDelegation) {
+            if (prefixDelegation == null) {
+              throw new MissingRequiredPropertyException("VpcCniNetworking", "prefixDelegation");
+            }
+            this.prefixDelegation = prefixDelegation;
+            return this;
+        }
+        public VpcCniNetworking build() {
+            final var _resultValue = new VpcCniNetworking();
+            _resultValue.customNetworking = customNetworking;
+            _resultValue.prefixDelegation = prefixDelegation;
+            return _resultValue;
+        }
+    }
+}
@@ -0,0 +1,125 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as enums from "./types/enums";
+import * as utilities from "./utilities";
+
+import * as pulumiAws from "@pulumi/aws";
+import * as pulumiKubernetes from "@pulumi/kubernetes";
+
+import {Cluster} from "./index";
+
+/**
+ * AutoModeNodeClass creates an EKS Auto Mode NodeClass, which configures the networking, storage and IAM role of the nodes of the node pools that reference it.
+ * For more information see: https://docs.aws.amazon.com/eks/latest/userguide/create-node-class.html
+ */
+export class AutoModeNodeClass extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'eks:index:AutoModeNodeClass';
+
+    /**
+     * Returns true if the given object is an instance of AutoModeNodeClass.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is AutoModeNodeClass {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === AutoModeNodeClass.__pulumiType;
+    }
+
+    /**
+     * The access entry of the custom node role, if `nodeRoleArn` is set.
+     */
+    declare public /*out*/ readonly accessEntry: pulumi.Output<pulumiAws.eks.AccessEntry | undefined>;
+    /**
+     * The NodeClass custom resource.
+     */
+    declare public /*out*/ readonly nodeClass: pulumi.Output<pulumiKubernetes.apiextensions.CustomResource>;
+    /**
+     * The name of the NodeClass, which node pools reference with `nodeClassName`.
+     */
+    declare public /*out*/ readonly nodeClassName: pulumi.Output<string>;
+
+    /**
+     * Create a AutoModeNodeClass resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: AutoModeNodeClassArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.cluster === undefined && !opts.urn) {
+                throw new Error("Missing required property 'cluster'");
+            }
+            resourceInputs["cluster"] = args?.cluster;
+            resourceInputs["ephemeralStorage"] = args?.ephemeralStorage;
+            resourceInputs["networkPolicy"] = args?.networkPolicy;
+            resourceInputs["networkPolicyEventLogs"] = args?.networkPolicyEventLogs;
+            resourceInputs["nodeRoleArn"] = args?.nodeRoleArn;
+            resourceInputs["securityGroupSelectorTerms"] = args?.securityGroupSelectorTerms;
+            resourceInputs["snatPolicy"] = args?.snatPolicy;
+            resourceInputs["subnetSelectorTerms"] = args?.subnetSelectorTerms;
+            resourceInputs["tags"] = args?.tags;
+            resourceInputs["accessEntry"] = undefined /*out*/;
+            resourceInputs["nodeClass"] = undefined /*out*/;
+            resourceInputs["nodeClassName"] = undefined /*out*/;
+        } else {
+            resourceInputs["accessEntry"] = undefined /*out*/;
+            resourceInputs["nodeClass"] = undefined /*out*/;
+            resourceInputs["nodeClassName"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(AutoModeNodeClass.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a AutoModeNodeClass resource.
+ */
+export interface AutoModeNodeClassArgs {
+    /**
+     * The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+     */
+    cluster: pulumi.Input<Cluster>;
+    /**
+     * The ephemeral storage of the nodes.
+     */
+    ephemeralStorage?: pulumi.Input<inputs.AutoModeEphemeralStorageArgs>;
+    /**
+     * The default network policy of the pods, `DefaultAllow` or `DefaultDeny`. Defaults to `DefaultAllow`.
+     */
+    networkPolicy?: pulumi.Input<string>;
+    /**
+     * Whether to log the network policy decisions, `Enabled` or `Disabled`. Defaults to `Disabled`.
+     */
+    networkPolicyEventLogs?: pulumi.Input<string>;
+    /**
+     * The ARN of the IAM role of the nodes. Defaults to the node role of EKS Auto Mode created by the cluster.
+     *
+     * An access entry of type `EC2` with the `AmazonEKSAutoNodePolicy` access policy is created for custom node roles, which allows their nodes to join the cluster.
+     */
+    nodeRoleArn?: pulumi.Input<string>;
+    /**
+     * The security groups of the nodes. Defaults to the cluster security group.
+     */
+    securityGroupSelectorTerms?: pulumi.Input<pulumi.Input<inputs.AutoModeSelectorTermArgs>[]>;
+    /**
+     * The source NAT policy of the pod traffic leaving the VPC, `Random` or `Disabled`. Defaults to `Random`.
+     */
+    snatPolicy?: pulumi.Input<string>;
+    /**
+     * The subnets to launch nodes into. Defaults to the subnets of the cluster.
+     */
+    subnetSelectorTerms?: pulumi.Input<pulumi.Input<inputs.AutoModeSelectorTermArgs>[]>;
+    /**
+     * The tags to apply to the EC2 resources created for the nodes.
+     */
+    tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
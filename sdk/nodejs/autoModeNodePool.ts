@@ -0,0 +1,126 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as enums from "./types/enums";
+import * as utilities from "./utilities";
+
+import * as pulumiKubernetes from "@pulumi/kubernetes";
+
+import {Cluster} from "./index";
+
+/**
+ * AutoModeNodePool creates an EKS Auto Mode NodePool, which defines which nodes EKS Auto Mode launches for pending pods and when it disrupts them.
+ * For more information see: https://docs.aws.amazon.com/eks/latest/userguide/create-node-pool.html
+ */
+export class AutoModeNodePool extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'eks:index:AutoModeNodePool';
+
+    /**
+     * Returns true if the given object is an instance of AutoModeNodePool.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is AutoModeNodePool {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === AutoModeNodePool.__pulumiType;
+    }
+
+    /**
+     * The NodePool custom resource.
+     */
+    declare public /*out*/ readonly nodePool: pulumi.Output<pulumiKubernetes.apiextensions.CustomResource>;
+    /**
+     * The name of the NodePool.
+     */
+    declare public /*out*/ readonly nodePoolName: pulumi.Output<string>;
+
+    /**
+     * Create a AutoModeNodePool resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: AutoModeNodePoolArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.cluster === undefined && !opts.urn) {
+                throw new Error("Missing required property 'cluster'");
+            }
+            resourceInputs["cluster"] = args?.cluster;
+            resourceInputs["disruption"] = args?.disruption;
+            resourceInputs["expireAfter"] = args?.expireAfter;
+            resourceInputs["labels"] = args?.labels;
+            resourceInputs["limits"] = args?.limits;
+            resourceInputs["nodeClassName"] = args?.nodeClassName;
+            resourceInputs["requirements"] = args?.requirements;
+            resourceInputs["startupTaints"] = args?.startupTaints;
+            resourceInputs["taints"] = args?.taints;
+            resourceInputs["terminationGracePeriod"] = args?.terminationGracePeriod;
+            resourceInputs["weight"] = args?.weight;
+            resourceInputs["nodePool"] = undefined /*out*/;
+            resourceInputs["nodePoolName"] = undefined /*out*/;
+        } else {
+            resourceInputs["nodePool"] = undefined /*out*/;
+            resourceInputs["nodePoolName"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(AutoModeNodePool.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a AutoModeNodePool resource.
+ */
+export interface AutoModeNodePoolArgs {
+    /**
+     * The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+     */
+    cluster: pulumi.Input<Cluster>;
+    /**
+     * The disruption settings of the node pool.
+     */
+    disruption?: pulumi.Input<inputs.AutoModeDisruptionArgs>;
+    /**
+     * How long a node lives before it is replaced, e.g. `336h`, or `Never`. Defaults to `336h`.
+     */
+    expireAfter?: pulumi.Input<string>;
+    /**
+     * The labels of the nodes.
+     */
+    labels?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * The maximum resources of all nodes of the node pool, e.g. `{ cpu: "1000", memory: "1000Gi" }`.
+     */
+    limits?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * The name of the NodeClass of the nodes, e.g. `nodeClass.nodeClassName`. Defaults to `default`, the NodeClass of the built-in node pools.
+     */
+    nodeClassName?: pulumi.Input<string>;
+    /**
+     * The requirements the instances of the node pool have to fulfill.
+     */
+    requirements?: pulumi.Input<pulumi.Input<inputs.AutoModeNodeRequirementArgs>[]>;
+    /**
+     * The taints of the nodes that are removed by a daemon once the node is ready, keyed by the taint key.
+     */
+    startupTaints?: pulumi.Input<{[key: string]: pulumi.Input<inputs.TaintArgs>}>;
+    /**
+     * The taints of the nodes, keyed by the taint key.
+     */
+    taints?: pulumi.Input<{[key: string]: pulumi.Input<inputs.TaintArgs>}>;
+    /**
+     * How long a node may take to drain before it is terminated forcefully, e.g. `24h`.
+     */
+    terminationGracePeriod?: pulumi.Input<string>;
+    /**
+     * The priority of the node pool. Node pools with a higher weight are preferred.
+     */
+    weight?: pulumi.Input<number>;
+}
@@ -145,9 +145,12 @@ export class Cluster extends pulumi.ComponentResource {
             resourceInputs["authenticationMode"] = args?.authenticationMode;
             resourceInputs["autoMode"] = args ? (args.autoMode ? inputs.autoModeOptionsArgsProvideDefaults(args.autoMode) : undefined) : undefined;
             resourceInputs["bootstrapSelfManagedAddons"] = args?.bootstrapSelfManagedAddons;
+            resourceInputs["clusterLogKmsKeyArn"] = args?.clusterLogKmsKeyArn;
+            resourceInputs["clusterLogRetentionDays"] = args?.clusterLogRetentionDays;
             resourceInputs["clusterSecurityGroup"] = args?.clusterSecurityGroup;
             resourceInputs["clusterSecurityGroupTags"] = args?.clusterSecurityGroupTags;
             resourceInputs["clusterTags"] = args?.clusterTags;
+            resourceInputs["controlPlaneScalingConfig"] = args?.controlPlaneScalingConfig;
             resourceInputs["corednsAddonOptions"] = args ? (args.corednsAddonOptions ? inputs.coreDnsAddonOptionsArgsProvideDefaults(args.corednsAddonOptions) : undefined) : undefined;
             resourceInputs["createInstanceRole"] = args?.createInstanceRole;
             resourceInputs["createOidcProvider"] = args?.createOidcProvider;
@@ -156,6 +159,7 @@ export class Cluster extends pulumi.ComponentResource {
             resourceInputs["desiredCapacity"] = args?.desiredCapacity;
             resourceInputs["enableConfigMapMutable"] = args?.enableConfigMapMutable;
             resourceInputs["enabledClusterLogTypes"] = args?.enabledClusterLogTypes;
+            resourceInputs["encryptionConfig"] = args?.encryptionConfig;
             resourceInputs["encryptionConfigKeyArn"] = args?.encryptionConfigKeyArn;
             resourceInputs["endpointPrivateAccess"] = args?.endpointPrivateAccess;
             resourceInputs["endpointPublicAccess"] = args?.endpointPublicAccess;
@@ -198,6 +202,7 @@ export class Cluster extends pulumi.ComponentResource {
             resourceInputs["version"] = args?.version;
             resourceInputs["vpcCniOptions"] = args ? (args.vpcCniOptions ? inputs.vpcCniOptionsArgsProvideDefaults(args.vpcCniOptions) : undefined) : undefined;
             resourceInputs["vpcId"] = args?.vpcId;
+            resourceInputs["zonalShiftConfig"] = args?.zonalShiftConfig;
             resourceInputs["autoModeNodeRoleName"] = undefined /*out*/;
             resourceInputs["awsProvider"] = undefined /*out*/;
             resourceInputs["clusterIngressRuleId"] = undefined /*out*/;
@@ -256,8 +261,36 @@ export class Cluster extends pulumi.ComponentResource {
         args = args || {};
         return pulumi.runtime.call("eks:index:Cluster/getKubeconfig", {
             "__self__": this,
+            "env": args.env,
+            "externalId": args.externalId,
             "profileName": args.profileName,
+            "region": args.region,
             "roleArn": args.roleArn,
+            "roleSessionName": args.roleSessionName,
+            "tokenTool": args.tokenTool,
+        }, this);
+    }
+
+    /**
+     * Generate a kubeconfig that authenticates with a static bearer token instead of an exec plugin. This is useful for consumers that cannot run the AWS CLI, e.g. Argo CD cluster secrets or services using a Kubernetes client library.
+     *
+     * The token is a pre-signed STS `GetCallerIdentity` request retrieved with the configured `tokenTool` when the program runs, so the tool needs to be installed where Pulumi runs. Tokens are short-lived (15 minutes) and a new token is generated on every update, so consumers need to refresh the kubeconfig before `expirationTimestamp`.
+     *
+     * See for more details:
+     * - https://docs.aws.amazon.com/eks/latest/userguide/cluster-auth.html
+     * - https://github.com/kubernetes-sigs/aws-iam-authenticator#api-authorization-from-outside-a-cluster
+     */
+    getStaticKubeconfig(args?: Cluster.GetStaticKubeconfigArgs): pulumi.Output<Cluster.GetStaticKubeconfigResult> {
+        args = args || {};
+        return pulumi.runtime.call("eks:index:Cluster/getStaticKubeconfig", {
+            "__self__": this,
+            "env": args.env,
+            "externalId": args.externalId,
+            "profileName": args.profileName,
+            "region": args.region,
+            "roleArn": args.roleArn,
+            "roleSessionName": args.roleSessionName,
+            "tokenTool": args.tokenTool,
         }, this);
     }
 }
@@ -290,6 +323,21 @@ export interface ClusterArgs {
      * Install default unmanaged add-ons, such as `aws-cni`, `kube-proxy`, and CoreDNS during cluster creation. If `false`, you must manually install desired add-ons. Changing this value will force a new cluster to be created. Defaults to `true`
      */
     bootstrapSelfManagedAddons?: pulumi.Input<boolean>;
+    /**
+     * The ARN of the KMS key to encrypt the control plane logs in the `/aws/eks/<name>/cluster` log group with. The key policy must allow the CloudWatch Logs service principal of the region to use the key.
+     *
+     * When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set.
+     *
+     * See for more details:
+     * - https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/encrypt-log-data-kms.html
+     */
+    clusterLogKmsKeyArn?: pulumi.Input<string>;
+    /**
+     * The number of days to retain the control plane logs in the `/aws/eks/<name>/cluster` log group. Possible values are: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653, and 0. If set to 0, the logs never expire.
+     *
+     * When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set. Set `name` to the name of the existing cluster when enabling this for an existing cluster, and import the log group if EKS already created it.
+     */
+    clusterLogRetentionDays?: pulumi.Input<number>;
     /**
      * The security group to use for the cluster API endpoint. If not provided, a new security group will be created with full internet egress and ingress from node groups.
      *
@@ -304,6 +352,12 @@ export interface ClusterArgs {
      * The tags to apply to the EKS cluster.
      */
     clusterTags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * The scaling configuration of the control plane. Setting a provisioned `tier` reserves control plane capacity for the cluster instead of scaling it on demand.
+     *
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/eks-provisioned-control-plane.html
+     */
+    controlPlaneScalingConfig?: pulumi.Input<pulumiAws.types.input.eks.ClusterControlPlaneScalingConfig>;
     /**
      * Options for managing the `coredns` addon.
      */
@@ -351,6 +405,14 @@ export interface ClusterArgs {
      * Enable EKS control plane logging. This sends logs to cloudwatch. Possible list of values are: ["api", "audit", "authenticator", "controllerManager", "scheduler"]. By default it is off.
      */
     enabledClusterLogTypes?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Creates a KMS key and alias for the encryption configuration of the cluster. The key policy grants the cluster role the permissions it needs to use the key for envelope encryption of Kubernetes secrets.
+     *
+     * Mutually exclusive with `encryptionConfigKeyArn`.
+     * See for more details:
+     * - https://docs.aws.amazon.com/eks/latest/userguide/envelope-encryption.html
+     */
+    encryptionConfig?: inputs.EncryptionConfigOptionsArgs;
     /**
      * KMS Key ARN to use with the encryption configuration for the cluster.
      *
@@ -625,6 +687,12 @@ export interface ClusterArgs {
      * The VPC in which to create the cluster and its worker nodes. If unset, the cluster will be created in the default VPC.
      */
     vpcId?: pulumi.Input<string>;
+    /**
+     * The zonal shift configuration of the cluster. Enabling zonal shift lets Amazon Application Recovery Controller (ARC) move traffic away from an impaired Availability Zone.
+     *
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/zone-shift.html
+     */
+    zonalShiftConfig?: pulumi.Input<pulumiAws.types.input.eks.ClusterZonalShiftConfig>;
 }
 
 export namespace Cluster {
@@ -632,18 +700,42 @@ export namespace Cluster {
      * The set of arguments for the Cluster.getKubeconfig method.
      */
     export interface GetKubeconfigArgs {
+        /**
+         * Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+         */
+        env?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+        /**
+         * External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+         *
+         * Only supported by the `aws-iam-authenticator` token tool.
+         */
+        externalId?: pulumi.Input<string>;
         /**
          * AWS credential profile name to always use instead of the default AWS credential provider chain.
          *
          * The profile is passed to kubeconfig as an authentication environment setting.
          */
         profileName?: pulumi.Input<string>;
+        /**
+         * AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+         */
+        region?: pulumi.Input<string>;
         /**
          * Role ARN to assume instead of the default AWS credential provider chain.
          *
          * The role is passed to kubeconfig as an authentication exec argument.
          */
         roleArn?: pulumi.Input<string>;
+        /**
+         * Session name to use when assuming `roleArn`.
+         *
+         * Only supported by the `aws-iam-authenticator` token tool.
+         */
+        roleSessionName?: pulumi.Input<string>;
+        /**
+         * The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+         */
+        tokenTool?: pulumi.Input<enums.KubeconfigTokenTool>;
     }
 
     /**
@@ -656,4 +748,60 @@ export namespace Cluster {
         readonly result: string;
     }
 
+    /**
+     * The set of arguments for the Cluster.getStaticKubeconfig method.
+     */
+    export interface GetStaticKubeconfigArgs {
+        /**
+         * Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+         */
+        env?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+        /**
+         * External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+         *
+         * Only supported by the `aws-iam-authenticator` token tool.
+         */
+        externalId?: pulumi.Input<string>;
+        /**
+         * AWS credential profile name to always use instead of the default AWS credential provider chain.
+         *
+         * The profile is passed to kubeconfig as an authentication environment setting.
+         */
+        profileName?: pulumi.Input<string>;
+        /**
+         * AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+         */
+        region?: pulumi.Input<string>;
+        /**
+         * Role ARN to assume instead of the default AWS credential provider chain.
+         *
+         * The role is passed to kubeconfig as an authentication exec argument.
+         */
+        roleArn?: pulumi.Input<string>;
+        /**
+         * Session name to use when assuming `roleArn`.
+         *
+         * Only supported by the `aws-iam-authenticator` token tool.
+         */
+        roleSessionName?: pulumi.Input<string>;
+        /**
+         * The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+         */
+        tokenTool?: pulumi.Input<enums.KubeconfigTokenTool>;
+    }
+
+    /**
+     * The results of the Cluster.getStaticKubeconfig method.
+     */
+    export interface GetStaticKubeconfigResult {
+        /**
+         * The time at which the token expires, in RFC 3339 format.
+         */
+        readonly expirationTimestamp: string;
+        /**
+         * The stringified kubeconfig containing the bearer token for the cluster.
+         */
+        readonly kubeconfig: string;
+    }
+
 }
@@ -0,0 +1,107 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+import * as pulumiAws from "@pulumi/aws";
+import * as pulumiKubernetes from "@pulumi/kubernetes";
+
+import {Cluster} from "./index";
+
+/**
+ * ClusterAutoscaler installs the Kubernetes Cluster Autoscaler into an EKS cluster. It creates the IAM role of the autoscaler and installs the cluster-autoscaler Helm chart, which discovers the Auto Scaling Groups tagged for the cluster. Node groups are tagged for discovery with their `autoscalerDiscovery` option.
+ * For more information see: https://github.com/kubernetes/autoscaler/blob/master/cluster-autoscaler/cloudprovider/aws/README.md
+ */
+export class ClusterAutoscaler extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'eks:index:ClusterAutoscaler';
+
+    /**
+     * Returns true if the given object is an instance of ClusterAutoscaler.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ClusterAutoscaler {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ClusterAutoscaler.__pulumiType;
+    }
+
+    /**
+     * The IAM policy with the permissions of the autoscaler.
+     */
+    declare public /*out*/ readonly policy: pulumi.Output<pulumiAws.iam.Policy>;
+    /**
+     * The Helm release of the autoscaler.
+     */
+    declare public /*out*/ readonly release: pulumi.Output<pulumiKubernetes.helm.v3.Release>;
+    /**
+     * The IAM role of the autoscaler.
+     */
+    declare public /*out*/ readonly role: pulumi.Output<pulumiAws.iam.Role>;
+
+    /**
+     * Create a ClusterAutoscaler resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ClusterAutoscalerArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.cluster === undefined && !opts.urn) {
+                throw new Error("Missing required property 'cluster'");
+            }
+            resourceInputs["chartVersion"] = args?.chartVersion;
+            resourceInputs["cluster"] = args?.cluster;
+            resourceInputs["namespace"] = args?.namespace;
+            resourceInputs["oidcProviderArn"] = args?.oidcProviderArn;
+            resourceInputs["serviceAccountName"] = args?.serviceAccountName;
+            resourceInputs["values"] = args?.values;
+            resourceInputs["policy"] = undefined /*out*/;
+            resourceInputs["release"] = undefined /*out*/;
+            resourceInputs["role"] = undefined /*out*/;
+        } else {
+            resourceInputs["policy"] = undefined /*out*/;
+            resourceInputs["release"] = undefined /*out*/;
+            resourceInputs["role"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(ClusterAutoscaler.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ClusterAutoscaler resource.
+ */
+export interface ClusterAutoscalerArgs {
+    /**
+     * The version of the cluster-autoscaler Helm chart. Defaults to `9.46.6`.
+     */
+    chartVersion?: pulumi.Input<string>;
+    /**
+     * The target EKS cluster.
+     */
+    cluster: pulumi.Input<Cluster>;
+    /**
+     * The namespace to install the autoscaler into. Defaults to `kube-system`.
+     */
+    namespace?: pulumi.Input<string>;
+    /**
+     * The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+     *
+     * If set, the autoscaler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+     */
+    oidcProviderArn?: pulumi.Input<string>;
+    /**
+     * The name of the Kubernetes service account of the autoscaler. Defaults to `cluster-autoscaler`.
+     */
+    serviceAccountName?: pulumi.Input<string>;
+    /**
+     * Custom values for the Helm chart. The values are merged with the values set by the component, which are `autoDiscovery`, `awsRegion` and `rbac.serviceAccount`.
+     */
+    values?: pulumi.Input<{[key: string]: any}>;
+}
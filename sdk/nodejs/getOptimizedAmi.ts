@@ -0,0 +1,102 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as enums from "./types/enums";
+import * as utilities from "./utilities";
+
+/**
+ * Resolves the EKS optimized AMI for a Kubernetes version and AMI type. The AMI is looked up via the public SSM parameters published by AWS, the same way node groups resolve their AMI when no `amiId` is provided.
+ *
+ * If no `releaseVersion` is specified, the release version of the currently recommended AMI is used. The resolved release version is returned so that it can be pinned, e.g. to roll out AMI updates in a controlled fashion by feeding the `amiId` into `NodeGroupV2.amiId`.
+ *
+ * The SSM parameters are read using the AWS CLI, which needs to be installed and configured with credentials for the account of the stack. The region is always passed explicitly.
+ *
+ * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
+ */
+export function getOptimizedAmi(args: GetOptimizedAmiArgs, opts?: pulumi.InvokeOptions): Promise<GetOptimizedAmiResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("eks:index:getOptimizedAmi", {
+        "amiType": args.amiType,
+        "kubernetesVersion": args.kubernetesVersion,
+        "region": args.region,
+        "releaseVersion": args.releaseVersion,
+    }, opts);
+}
+
+export interface GetOptimizedAmiArgs {
+    /**
+     * The AMI type to resolve the AMI for.
+     */
+    amiType: enums.AmiType;
+    /**
+     * The Kubernetes version of the AMI, e.g. `1.31`.
+     */
+    kubernetesVersion: string;
+    /**
+     * The AWS region to resolve the AMI in. This should be the region of the stack, e.g. the `aws:region` config value.
+     */
+    region: string;
+    /**
+     * The release version of the AMI to resolve. For Amazon Linux AMIs this is the version reported by EKS, e.g. `1.31.0-20240917`. For Bottlerocket AMIs this is the Bottlerocket version, e.g. `1.22.0`.
+     *
+     * Defaults to the release version of the currently recommended AMI.
+     */
+    releaseVersion?: string;
+}
+
+export interface GetOptimizedAmiResult {
+    /**
+     * The ID of the AMI.
+     */
+    readonly amiId: string;
+    /**
+     * The release version of the AMI.
+     */
+    readonly releaseVersion: string;
+    /**
+     * The name of the SSM parameter the AMI ID was read from. The parameter is specific to the release version of the AMI and does not change when new AMIs are released.
+     */
+    readonly ssmParameterName: string;
+}
+/**
+ * Resolves the EKS optimized AMI for a Kubernetes version and AMI type. The AMI is looked up via the public SSM parameters published by AWS, the same way node groups resolve their AMI when no `amiId` is provided.
+ *
+ * If no `releaseVersion` is specified, the release version of the currently recommended AMI is used. The resolved release version is returned so that it can be pinned, e.g. to roll out AMI updates in a controlled fashion by feeding the `amiId` into `NodeGroupV2.amiId`.
+ *
+ * The SSM parameters are read using the AWS CLI, which needs to be installed and configured with credentials for the account of the stack. The region is always passed explicitly.
+ *
+ * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
+ */
+export function getOptimizedAmiOutput(args: GetOptimizedAmiOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetOptimizedAmiResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("eks:index:getOptimizedAmi", {
+        "amiType": args.amiType,
+        "kubernetesVersion": args.kubernetesVersion,
+        "region": args.region,
+        "releaseVersion": args.releaseVersion,
+    }, opts);
+}
+
+export interface GetOptimizedAmiOutputArgs {
+    /**
+     * The AMI type to resolve the AMI for.
+     */
+    amiType: pulumi.Input<enums.AmiType>;
+    /**
+     * The Kubernetes version of the AMI, e.g. `1.31`.
+     */
+    kubernetesVersion: pulumi.Input<string>;
+    /**
+     * The AWS region to resolve the AMI in. This should be the region of the stack, e.g. the `aws:region` config value.
+     */
+    region: pulumi.Input<string>;
+    /**
+     * The release version of the AMI to resolve. For Amazon Linux AMIs this is the version reported by EKS, e.g. `1.31.0-20240917`. For Bottlerocket AMIs this is the Bottlerocket version, e.g. `1.22.0`.
+     *
+     * Defaults to the release version of the currently recommended AMI.
+     */
+    releaseVersion?: pulumi.Input<string>;
+}
@@ -10,15 +10,40 @@ export type Addon = import("./addon").Addon;
 export const Addon: typeof import("./addon").Addon = null as any;
 utilities.lazyLoad(exports, ["Addon"], () => require("./addon"));
 
+export { AutoModeNodeClassArgs } from "./autoModeNodeClass";
+export type AutoModeNodeClass = import("./autoModeNodeClass").AutoModeNodeClass;
+export const AutoModeNodeClass: typeof import("./autoModeNodeClass").AutoModeNodeClass = null as any;
+utilities.lazyLoad(exports, ["AutoModeNodeClass"], () => require("./autoModeNodeClass"));
+
+export { AutoModeNodePoolArgs } from "./autoModeNodePool";
+export type AutoModeNodePool = import("./autoModeNodePool").AutoModeNodePool;
+export const AutoModeNodePool: typeof import("./autoModeNodePool").AutoModeNodePool = null as any;
+utilities.lazyLoad(exports, ["AutoModeNodePool"], () => require("./autoModeNodePool"));
+
 export * from "./cluster";
 import { Cluster } from "./cluster";
 
+export { ClusterAutoscalerArgs } from "./clusterAutoscaler";
+export type ClusterAutoscaler = import("./clusterAutoscaler").ClusterAutoscaler;
+export const ClusterAutoscaler: typeof import("./clusterAutoscaler").ClusterAutoscaler = null as any;
+utilities.lazyLoad(exports, ["ClusterAutoscaler"], () => require("./clusterAutoscaler"));
+
 export { ClusterCreationRoleProviderArgs } from "./clusterCreationRoleProvider";
 export type ClusterCreationRoleProvider = import("./clusterCreationRoleProvider").ClusterCreationRoleProvider;
 export const ClusterCreationRoleProvider: typeof import("./clusterCreationRoleProvider").ClusterCreationRoleProvider = null as any;
 utilities.lazyLoad(exports, ["ClusterCreationRoleProvider"], () => require("./clusterCreationRoleProvider"));
 
 export * from "./clusterMixins";
+export { GetOptimizedAmiArgs, GetOptimizedAmiResult, GetOptimizedAmiOutputArgs } from "./getOptimizedAmi";
+export const getOptimizedAmi: typeof import("./getOptimizedAmi").getOptimizedAmi = null as any;
+export const getOptimizedAmiOutput: typeof import("./getOptimizedAmi").getOptimizedAmiOutput = null as any;
+utilities.lazyLoad(exports, ["getOptimizedAmi","getOptimizedAmiOutput"], () => require("./getOptimizedAmi"));
+
+export { LoadBalancerControllerArgs } from "./loadBalancerController";
+export type LoadBalancerController = import("./loadBalancerController").LoadBalancerController;
+export const LoadBalancerController: typeof import("./loadBalancerController").LoadBalancerController = null as any;
+utilities.lazyLoad(exports, ["LoadBalancerController"], () => require("./loadBalancerController"));
+
 export { ManagedNodeGroupArgs } from "./managedNodeGroup";
 export type ManagedNodeGroup = import("./managedNodeGroup").ManagedNodeGroup;
 export const ManagedNodeGroup: typeof import("./managedNodeGroup").ManagedNodeGroup = null as any;
@@ -68,10 +93,18 @@ const _module = {
         switch (type) {
             case "eks:index:Addon":
                 return new Addon(name, <any>undefined, { urn })
+            case "eks:index:AutoModeNodeClass":
+                return new AutoModeNodeClass(name, <any>undefined, { urn })
+            case "eks:index:AutoModeNodePool":
+                return new AutoModeNodePool(name, <any>undefined, { urn })
             case "eks:index:Cluster":
                 return new Cluster(name, <any>undefined, { urn })
+            case "eks:index:ClusterAutoscaler":
+                return new ClusterAutoscaler(name, <any>undefined, { urn })
             case "eks:index:ClusterCreationRoleProvider":
                 return new ClusterCreationRoleProvider(name, <any>undefined, { urn })
+            case "eks:index:LoadBalancerController":
+                return new LoadBalancerController(name, <any>undefined, { urn })
             case "eks:index:ManagedNodeGroup":
                 return new ManagedNodeGroup(name, <any>undefined, { urn })
             case "eks:index:NodeGroup":
@@ -0,0 +1,122 @@
+// *** WARNING: this file was generated by pulumi-gen-eks. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+import * as pulumiAws from "@pulumi/aws";
+import * as pulumiKubernetes from "@pulumi/kubernetes";
+
+import {Cluster} from "./index";
+
+/**
+ * LoadBalancerController installs the AWS Load Balancer Controller into an EKS cluster. It creates the IAM role of the controller, installs the aws-load-balancer-controller Helm chart and tags the given subnets, so the controller can discover them.
+ * For more information see: https://docs.aws.amazon.com/eks/latest/userguide/aws-load-balancer-controller.html
+ */
+export class LoadBalancerController extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'eks:index:LoadBalancerController';
+
+    /**
+     * Returns true if the given object is an instance of LoadBalancerController.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is LoadBalancerController {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === LoadBalancerController.__pulumiType;
+    }
+
+    /**
+     * The IAM policy with the permissions of the controller.
+     */
+    declare public /*out*/ readonly policy: pulumi.Output<pulumiAws.iam.Policy>;
+    /**
+     * The Helm release of the controller.
+     */
+    declare public /*out*/ readonly release: pulumi.Output<pulumiKubernetes.helm.v3.Release>;
+    /**
+     * The IAM role of the controller.
+     */
+    declare public /*out*/ readonly role: pulumi.Output<pulumiAws.iam.Role>;
+
+    /**
+     * Create a LoadBalancerController resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: LoadBalancerControllerArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.cluster === undefined && !opts.urn) {
+                throw new Error("Missing required property 'cluster'");
+            }
+            resourceInputs["chartVersion"] = args?.chartVersion;
+            resourceInputs["cluster"] = args?.cluster;
+            resourceInputs["namespace"] = args?.namespace;
+            resourceInputs["oidcProviderArn"] = args?.oidcProviderArn;
+            resourceInputs["privateSubnetIds"] = args?.privateSubnetIds;
+            resourceInputs["publicSubnetIds"] = args?.publicSubnetIds;
+            resourceInputs["releaseName"] = args?.releaseName;
+            resourceInputs["serviceAccountName"] = args?.serviceAccountName;
+            resourceInputs["values"] = args?.values;
+            resourceInputs["policy"] = undefined /*out*/;
+            resourceInputs["release"] = undefined /*out*/;
+            resourceInputs["role"] = undefined /*out*/;
+        } else {
+            resourceInputs["policy"] = undefined /*out*/;
+            resourceInputs["release"] = undefined /*out*/;
+            resourceInputs["role"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(LoadBalancerController.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a LoadBalancerController resource.
+ */
+export interface LoadBalancerControllerArgs {
+    /**
+     * The version of the aws-load-balancer-controller Helm chart. Defaults to `1.13.0`.
+     */
+    chartVersion?: pulumi.Input<string>;
+    /**
+     * The target EKS cluster.
+     */
+    cluster: pulumi.Input<Cluster>;
+    /**
+     * The namespace to install the controller into. Defaults to `kube-system`.
+     */
+    namespace?: pulumi.Input<string>;
+    /**
+     * The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+     *
+     * If set, the controller assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+     */
+    oidcProviderArn?: pulumi.Input<string>;
+    /**
+     * The private subnets to tag with `kubernetes.io/role/internal-elb`, which makes the controller place internal load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+     */
+    privateSubnetIds?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The public subnets to tag with `kubernetes.io/role/elb`, which makes the controller place internet-facing load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+     */
+    publicSubnetIds?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The name of the Helm release. Defaults to `aws-load-balancer-controller`.
+     */
+    releaseName?: pulumi.Input<string>;
+    /**
+     * The name of the Kubernetes service account of the controller. Defaults to `aws-load-balancer-controller`.
+     */
+    serviceAccountName?: pulumi.Input<string>;
+    /**
+     * Custom values for the Helm chart. The values are merged with the values set by the component, which are `clusterName`, `vpcId`, `region` and `serviceAccount`.
+     */
+    values?: pulumi.Input<{[key: string]: any}>;
+}
@@ -223,7 +223,9 @@ export class ManagedNodeGroup extends pulumi.ComponentResource {
             }
             resourceInputs["amiId"] = args?.amiId;
             resourceInputs["amiType"] = args?.amiType;
+            resourceInputs["autoscalerDiscovery"] = args?.autoscalerDiscovery;
             resourceInputs["bootstrapExtraArgs"] = args?.bootstrapExtraArgs;
+            resourceInputs["bottlerocketConfig"] = args?.bottlerocketConfig;
             resourceInputs["bottlerocketSettings"] = args?.bottlerocketSettings;
             resourceInputs["capacityType"] = args?.capacityType;
             resourceInputs["cluster"] = args?.cluster;
@@ -238,6 +240,7 @@ export class ManagedNodeGroup extends pulumi.ComponentResource {
             resourceInputs["kubeletExtraArgs"] = args?.kubeletExtraArgs;
             resourceInputs["labels"] = args?.labels;
             resourceInputs["launchTemplate"] = args?.launchTemplate;
+            resourceInputs["nodeConfig"] = args?.nodeConfig;
             resourceInputs["nodeGroupName"] = args?.nodeGroupName;
             resourceInputs["nodeGroupNamePrefix"] = args?.nodeGroupNamePrefix;
             resourceInputs["nodeRole"] = args?.nodeRole;
@@ -284,12 +287,23 @@ export interface ManagedNodeGroupArgs {
      * See the AWS documentation (https://docs.aws.amazon.com/eks/latest/APIReference/API_Nodegroup.html#AmazonEKS-Type-Nodegroup-amiType) for valid AMI Types. This provider will only perform drift detection if a configuration value is provided.
      */
     amiType?: pulumi.Input<string>;
+    /**
+     * Tags the Auto Scaling Group of the node group with the `labels` and `taints` as node template tags of the Cluster Autoscaler, so it can scale the node group up from zero. EKS tags the Auto Scaling Groups of managed node groups for the auto-discovery of the Cluster Autoscaler itself.
+     *
+     * This implies `ignoreScalingChanges` unless it is explicitly disabled.
+     */
+    autoscalerDiscovery?: boolean;
     /**
      * Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
      *
      * Note that this field conflicts with `launchTemplate`.
      */
     bootstrapExtraArgs?: string;
+    /**
+     * Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+     * The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+     */
+    bottlerocketConfig?: pulumi.Input<inputs.BottlerocketConfigArgs>;
     /**
      * The configuration settings for Bottlerocket OS.
      * The settings will get merged with the base settings the provider uses to configure Bottlerocket.
@@ -368,6 +382,13 @@ export interface ManagedNodeGroupArgs {
      * Note: This field is mutually exclusive with `kubeletExtraArgs` and `bootstrapExtraArgs`.
      */
     launchTemplate?: pulumi.Input<pulumiAws.types.input.eks.NodeGroupLaunchTemplate>;
+    /**
+     * Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+     *
+     * Note: This is only applicable when using AL2023.
+     * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+     */
+    nodeConfig?: pulumi.Input<inputs.NodeConfigArgs>;
     /**
      * Name of the EKS Node Group. If omitted, this provider will assign a random, unique name. Conflicts with `nodeGroupNamePrefix`.
      */
@@ -72,7 +72,9 @@ export class NodeGroup extends pulumi.ComponentResource {
             resourceInputs["amiId"] = args?.amiId;
             resourceInputs["amiType"] = args?.amiType;
             resourceInputs["autoScalingGroupTags"] = args?.autoScalingGroupTags;
+            resourceInputs["autoscalerDiscovery"] = args?.autoscalerDiscovery;
             resourceInputs["bootstrapExtraArgs"] = args?.bootstrapExtraArgs;
+            resourceInputs["bottlerocketConfig"] = args?.bottlerocketConfig;
             resourceInputs["bottlerocketSettings"] = args?.bottlerocketSettings;
             resourceInputs["cloudFormationTags"] = args?.cloudFormationTags;
             resourceInputs["cluster"] = args?.cluster;
@@ -92,6 +94,7 @@ export class NodeGroup extends pulumi.ComponentResource {
             resourceInputs["maxSize"] = args?.maxSize;
             resourceInputs["minSize"] = args?.minSize;
             resourceInputs["nodeAssociatePublicIpAddress"] = args?.nodeAssociatePublicIpAddress;
+            resourceInputs["nodeConfig"] = args?.nodeConfig;
             resourceInputs["nodePublicKey"] = args?.nodePublicKey;
             resourceInputs["nodeRootVolumeDeleteOnTermination"] = args?.nodeRootVolumeDeleteOnTermination;
             resourceInputs["nodeRootVolumeEncrypted"] = args?.nodeRootVolumeEncrypted;
@@ -154,10 +157,21 @@ export interface NodeGroupArgs {
      * Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
      */
     autoScalingGroupTags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+     *
+     * For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+     */
+    autoscalerDiscovery?: boolean;
     /**
      * Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
      */
     bootstrapExtraArgs?: pulumi.Input<string>;
+    /**
+     * Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+     * The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+     */
+    bottlerocketConfig?: pulumi.Input<inputs.BottlerocketConfigArgs>;
     /**
      * The configuration settings for Bottlerocket OS.
      * The settings will get merged with the base settings the provider uses to configure Bottlerocket.
@@ -261,6 +275,13 @@ export interface NodeGroupArgs {
      * Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
      */
     nodeAssociatePublicIpAddress?: pulumi.Input<boolean>;
+    /**
+     * Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+     *
+     * Note: This is only applicable when using AL2023.
+     * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+     */
+    nodeConfig?: pulumi.Input<inputs.NodeConfigArgs>;
     /**
      * Public key material for SSH access to worker nodes. See allowed formats at:
      * https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
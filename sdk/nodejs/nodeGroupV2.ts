@@ -64,7 +64,9 @@ export class NodeGroupV2 extends pulumi.ComponentResource {
             resourceInputs["amiId"] = args?.amiId;
             resourceInputs["amiType"] = args?.amiType;
             resourceInputs["autoScalingGroupTags"] = args?.autoScalingGroupTags;
+            resourceInputs["autoscalerDiscovery"] = args?.autoscalerDiscovery;
             resourceInputs["bootstrapExtraArgs"] = args?.bootstrapExtraArgs;
+            resourceInputs["bottlerocketConfig"] = args?.bottlerocketConfig;
             resourceInputs["bottlerocketSettings"] = args?.bottlerocketSettings;
             resourceInputs["cloudFormationTags"] = args?.cloudFormationTags;
             resourceInputs["cluster"] = args?.cluster;
@@ -79,6 +81,7 @@ export class NodeGroupV2 extends pulumi.ComponentResource {
             resourceInputs["instanceProfile"] = args?.instanceProfile;
             resourceInputs["instanceProfileName"] = args?.instanceProfileName;
             resourceInputs["instanceType"] = args?.instanceType;
+            resourceInputs["interruptionHandling"] = args?.interruptionHandling;
             resourceInputs["keyName"] = args?.keyName;
             resourceInputs["kubeletExtraArgs"] = args?.kubeletExtraArgs;
             resourceInputs["labels"] = args?.labels;
@@ -87,6 +90,7 @@ export class NodeGroupV2 extends pulumi.ComponentResource {
             resourceInputs["minRefreshPercentage"] = args?.minRefreshPercentage;
             resourceInputs["minSize"] = args?.minSize;
             resourceInputs["nodeAssociatePublicIpAddress"] = args?.nodeAssociatePublicIpAddress;
+            resourceInputs["nodeConfig"] = args?.nodeConfig;
             resourceInputs["nodePublicKey"] = args?.nodePublicKey;
             resourceInputs["nodeRootVolumeDeleteOnTermination"] = args?.nodeRootVolumeDeleteOnTermination;
             resourceInputs["nodeRootVolumeEncrypted"] = args?.nodeRootVolumeEncrypted;
@@ -147,10 +151,21 @@ export interface NodeGroupV2Args {
      * Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
      */
     autoScalingGroupTags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+     *
+     * For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+     */
+    autoscalerDiscovery?: boolean;
     /**
      * Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
      */
     bootstrapExtraArgs?: pulumi.Input<string>;
+    /**
+     * Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+     * The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+     */
+    bottlerocketConfig?: pulumi.Input<inputs.BottlerocketConfigArgs>;
     /**
      * The configuration settings for Bottlerocket OS.
      * The settings will get merged with the base settings the provider uses to configure Bottlerocket.
@@ -236,6 +251,12 @@ export interface NodeGroupV2Args {
      * The instance type to use for the cluster's nodes. Defaults to "t3.medium".
      */
     instanceType?: pulumi.Input<string>;
+    /**
+     * Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+     *
+     * See for more details: https://github.com/aws/aws-node-termination-handler
+     */
+    interruptionHandling?: inputs.InterruptionHandlingArgs;
     /**
      * Name of the key pair to use for SSH access to worker nodes.
      */
@@ -268,6 +289,13 @@ export interface NodeGroupV2Args {
      * Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
      */
     nodeAssociatePublicIpAddress?: pulumi.Input<boolean>;
+    /**
+     * Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+     *
+     * Note: This is only applicable when using AL2023.
+     * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+     */
+    nodeConfig?: pulumi.Input<inputs.NodeConfigArgs>;
     /**
      * Public key material for SSH access to worker nodes. See allowed formats at:
      * https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
@@ -134,6 +134,22 @@ export const ClusterNodePools = {
  */
 export type ClusterNodePools = (typeof ClusterNodePools)[keyof typeof ClusterNodePools];
 
+export const KubeconfigTokenTool = {
+    /**
+     * Use `aws eks get-token` of the AWS CLI to retrieve the token.
+     */
+    Aws: "aws",
+    /**
+     * Use `aws-iam-authenticator token` to retrieve the token.
+     */
+    AwsIamAuthenticator: "aws-iam-authenticator",
+} as const;
+
+/**
+ * The exec plugin used by a kubeconfig to retrieve a token for the cluster.
+ */
+export type KubeconfigTokenTool = (typeof KubeconfigTokenTool)[keyof typeof KubeconfigTokenTool];
+
 export const OperatingSystem = {
     /**
      * EKS optimized OS based on Amazon Linux 2 (AL2).
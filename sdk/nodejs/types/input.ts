@@ -63,6 +63,90 @@ export interface AccessPolicyAssociationArgs {
     policyArn: pulumi.Input<string>;
 }
 
+/**
+ * AutoModeDisruption configures how nodes of a node pool are consolidated and replaced.
+ */
+export interface AutoModeDisruptionArgs {
+    /**
+     * The disruption budgets of the node pool.
+     */
+    budgets?: pulumi.Input<pulumi.Input<inputs.AutoModeDisruptionBudgetArgs>[]>;
+    /**
+     * How long to wait after a pod was added to or removed from a node before consolidating it, e.g. `30s`, or `Never`. Defaults to `0s`.
+     */
+    consolidateAfter?: pulumi.Input<string>;
+    /**
+     * Which nodes are consolidated, `WhenEmptyOrUnderutilized` or `WhenEmpty`. Defaults to `WhenEmptyOrUnderutilized`.
+     */
+    consolidationPolicy?: pulumi.Input<string>;
+}
+
+/**
+ * AutoModeDisruptionBudget limits the number of nodes that may be disrupted at the same time.
+ */
+export interface AutoModeDisruptionBudgetArgs {
+    /**
+     * How long the budget is active after each schedule, e.g. `8h`.
+     */
+    duration?: pulumi.Input<string>;
+    /**
+     * The number or percentage of nodes that may be disrupted, e.g. `10%`. `0` blocks disruptions.
+     */
+    nodes: pulumi.Input<string>;
+    /**
+     * The disruption reasons the budget applies to, `Underutilized`, `Empty` or `Drifted`. Defaults to all reasons.
+     */
+    reasons?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The cron schedule at which the budget becomes active, e.g. `0 9 * * mon-fri`. Requires `duration`.
+     */
+    schedule?: pulumi.Input<string>;
+}
+
+/**
+ * AutoModeEphemeralStorage configures the volume that holds the ephemeral storage of the nodes.
+ */
+export interface AutoModeEphemeralStorageArgs {
+    /**
+     * The IOPS of the volume.
+     */
+    iops?: pulumi.Input<number>;
+    /**
+     * The ARN of the KMS key that encrypts the volume.
+     */
+    kmsKeyId?: pulumi.Input<string>;
+    /**
+     * The size of the volume, e.g. `80Gi`.
+     */
+    size?: pulumi.Input<string>;
+    /**
+     * The throughput of the volume in MiB/s.
+     */
+    throughput?: pulumi.Input<number>;
+}
+
+/**
+ * AutoModeNodeRequirement restricts the instances of a node pool by a well-known label, e.g. `eks.amazonaws.com/instance-category` or `karpenter.sh/capacity-type`.
+ */
+export interface AutoModeNodeRequirementArgs {
+    /**
+     * The label key.
+     */
+    key: pulumi.Input<string>;
+    /**
+     * The minimum number of distinct values the node pool has to be able to choose from.
+     */
+    minValues?: pulumi.Input<number>;
+    /**
+     * The operator, one of `In`, `NotIn`, `Exists`, `DoesNotExist`, `Gt` and `Lt`.
+     */
+    operator: pulumi.Input<string>;
+    /**
+     * The label values.
+     */
+    values?: pulumi.Input<pulumi.Input<string>[]>;
+}
+
 /**
  * Configuration Options for EKS Auto Mode. If EKS Auto Mode is enabled, AWS will manage cluster infrastructure on your behalf.
  *
@@ -93,6 +177,186 @@ export function autoModeOptionsArgsProvideDefaults(val: AutoModeOptionsArgs): Au
     };
 }
 
+/**
+ * AutoModeSelectorTerm selects subnets or security groups by their ID, name or tags. The conditions of a term are ANDed, multiple terms are ORed.
+ */
+export interface AutoModeSelectorTermArgs {
+    /**
+     * The ID of the subnet or security group.
+     */
+    id?: pulumi.Input<string>;
+    /**
+     * The name of the security group. Not supported for subnets.
+     */
+    name?: pulumi.Input<string>;
+    /**
+     * The tags the subnets or security groups must have. A value of `*` matches any value.
+     */
+    tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
+
+/**
+ * A Bottlerocket bootstrap container, which runs before the kubelet starts.
+ */
+export interface BottlerocketBootstrapContainerArgs {
+    /**
+     * Whether the node fails to boot if the bootstrap container fails.
+     */
+    essential?: pulumi.Input<boolean>;
+    /**
+     * When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+     */
+    mode?: pulumi.Input<string>;
+    /**
+     * The image of the bootstrap container.
+     */
+    source: pulumi.Input<string>;
+    /**
+     * Base64 encoded user data passed to the bootstrap container.
+     */
+    userData?: pulumi.Input<string>;
+}
+
+/**
+ * Typed configuration of the most common Bottlerocket settings.
+ *
+ * See for more details: https://bottlerocket.dev/en/os/1.20.x/api/settings/.
+ */
+export interface BottlerocketConfigArgs {
+    /**
+     * Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+     */
+    bootstrapContainers?: pulumi.Input<{[key: string]: pulumi.Input<inputs.BottlerocketBootstrapContainerArgs>}>;
+    /**
+     * Settings of the container registries, i.e. `settings.container-registry`.
+     */
+    containerRegistry?: pulumi.Input<inputs.BottlerocketContainerRegistryArgs>;
+    /**
+     * Settings of the admin and control host containers, i.e. `settings.host-containers`.
+     */
+    hostContainers?: pulumi.Input<inputs.BottlerocketHostContainersArgs>;
+    /**
+     * Settings of the kernel, i.e. `settings.kernel`.
+     */
+    kernel?: pulumi.Input<inputs.BottlerocketKernelSettingsArgs>;
+    /**
+     * Settings of the kubelet, i.e. `settings.kubernetes`.
+     */
+    kubernetes?: pulumi.Input<inputs.BottlerocketKubernetesSettingsArgs>;
+}
+
+/**
+ * Settings of the container registries of Bottlerocket nodes.
+ */
+export interface BottlerocketContainerRegistryArgs {
+    /**
+     * Mirrors to pull images from instead of the original registries.
+     */
+    mirrors?: pulumi.Input<pulumi.Input<inputs.BottlerocketRegistryMirrorArgs>[]>;
+}
+
+/**
+ * Settings of a Bottlerocket host container.
+ */
+export interface BottlerocketHostContainerArgs {
+    /**
+     * Whether the host container runs.
+     */
+    enabled?: pulumi.Input<boolean>;
+    /**
+     * The image of the host container.
+     */
+    source?: pulumi.Input<string>;
+    /**
+     * Whether the host container has elevated privileges.
+     */
+    superpowered?: pulumi.Input<boolean>;
+    /**
+     * Base64 encoded user data passed to the host container.
+     */
+    userData?: pulumi.Input<string>;
+}
+
+/**
+ * Settings of the Bottlerocket host containers.
+ */
+export interface BottlerocketHostContainersArgs {
+    /**
+     * The admin container, used for troubleshooting the node.
+     */
+    admin?: pulumi.Input<inputs.BottlerocketHostContainerArgs>;
+    /**
+     * The control container, used to access the Bottlerocket API through AWS SSM.
+     */
+    control?: pulumi.Input<inputs.BottlerocketHostContainerArgs>;
+}
+
+/**
+ * Settings of the Bottlerocket kernel.
+ */
+export interface BottlerocketKernelSettingsArgs {
+    /**
+     * The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+     */
+    lockdown?: pulumi.Input<string>;
+    /**
+     * Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: "1"`.
+     */
+    sysctl?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
+
+/**
+ * Settings of the kubelet on Bottlerocket nodes.
+ */
+export interface BottlerocketKubernetesSettingsArgs {
+    /**
+     * Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+     */
+    evictionHard?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+     */
+    evictionSoft?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+     */
+    evictionSoftGracePeriod?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * The disk usage in percent after which image garbage collection always runs.
+     */
+    imageGcHighThresholdPercent?: pulumi.Input<number>;
+    /**
+     * The disk usage in percent before which image garbage collection never runs.
+     */
+    imageGcLowThresholdPercent?: pulumi.Input<number>;
+    /**
+     * The maximum number of pods the kubelet runs on the node.
+     */
+    maxPods?: pulumi.Input<number>;
+    /**
+     * Labels the node registers with.
+     */
+    nodeLabels?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+     */
+    nodeTaints?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
+
+/**
+ * A mirror of a container registry.
+ */
+export interface BottlerocketRegistryMirrorArgs {
+    /**
+     * The endpoints of the mirror, in order of preference.
+     */
+    endpoints: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The registry to mirror, e.g. `docker.io`.
+     */
+    registry: pulumi.Input<string>;
+}
+
 /**
  * Configuration for the compute capability of your EKS Auto Mode cluster.
  */
@@ -140,10 +404,21 @@ export interface ClusterNodeGroupOptionsArgs {
      * Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
      */
     autoScalingGroupTags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+     *
+     * For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+     */
+    autoscalerDiscovery?: boolean;
     /**
      * Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
      */
     bootstrapExtraArgs?: pulumi.Input<string>;
+    /**
+     * Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+     * The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+     */
+    bottlerocketConfig?: pulumi.Input<inputs.BottlerocketConfigArgs>;
     /**
      * The configuration settings for Bottlerocket OS.
      * The settings will get merged with the base settings the provider uses to configure Bottlerocket.
@@ -225,6 +500,12 @@ export interface ClusterNodeGroupOptionsArgs {
      * The instance type to use for the cluster's nodes. Defaults to "t3.medium".
      */
     instanceType?: pulumi.Input<string>;
+    /**
+     * Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+     *
+     * See for more details: https://github.com/aws/aws-node-termination-handler
+     */
+    interruptionHandling?: inputs.InterruptionHandlingArgs;
     /**
      * Name of the key pair to use for SSH access to worker nodes.
      */
@@ -257,6 +538,13 @@ export interface ClusterNodeGroupOptionsArgs {
      * Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
      */
     nodeAssociatePublicIpAddress?: pulumi.Input<boolean>;
+    /**
+     * Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+     *
+     * Note: This is only applicable when using AL2023.
+     * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+     */
+    nodeConfig?: pulumi.Input<inputs.NodeConfigArgs>;
     /**
      * Public key material for SSH access to worker nodes. See allowed formats at:
      * https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
@@ -374,7 +662,15 @@ export interface CoreDataArgs {
      * The IAM Role attached to the EKS Cluster
      */
     clusterIamRole: pulumi.Input<pulumiAws.iam.Role>;
+    /**
+     * The log group of the control plane logs, if it is managed by the cluster.
+     */
+    clusterLogGroup?: pulumi.Input<pulumiAws.cloudwatch.LogGroup>;
     clusterSecurityGroup?: pulumi.Input<pulumiAws.ec2.SecurityGroup>;
+    /**
+     * The scaling configuration of the control plane, including its provisioned tier.
+     */
+    controlPlaneScalingConfig?: pulumi.Input<pulumiAws.types.input.eks.ClusterControlPlaneScalingConfig>;
     eksNodeAccess?: pulumi.Input<pulumiKubernetes.core.v1.ConfigMap>;
     encryptionConfig?: pulumi.Input<pulumiAws.types.input.eks.ClusterEncryptionConfig>;
     /**
@@ -423,14 +719,26 @@ export interface CoreDataArgs {
      * A map of tags assigned to the EKS cluster.
      */
     tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * The upgrade policy of the cluster, which determines whether it gets extended support.
+     */
+    upgradePolicy?: pulumi.Input<pulumiAws.types.input.eks.ClusterUpgradePolicy>;
     /**
      * The VPC CNI for the cluster.
      */
     vpcCni?: pulumi.Input<VpcCniAddon>;
+    /**
+     * The VPC CNI settings that affect the maximum number of pods of the nodes.
+     */
+    vpcCniNetworking?: pulumi.Input<inputs.VpcCniNetworkingArgs>;
     /**
      * ID of the cluster's VPC.
      */
     vpcId: pulumi.Input<string>;
+    /**
+     * The zonal shift configuration of the cluster.
+     */
+    zonalShiftConfig?: pulumi.Input<pulumiAws.types.input.eks.ClusterZonalShiftConfig>;
 }
 
 export interface CoreDnsAddonOptionsArgs {
@@ -480,6 +788,32 @@ export interface CreationRoleProviderArgs {
     role: pulumiAws.iam.Role;
 }
 
+/**
+ * Configures the KMS key the cluster creates for envelope encryption of Kubernetes secrets.
+ */
+export interface EncryptionConfigOptionsArgs {
+    /**
+     * The name of the alias of the key. Must start with `alias/`. Defaults to `alias/eks/<clusterName>`.
+     */
+    aliasName?: pulumi.Input<string>;
+    /**
+     * The waiting period in days before the key is deleted after the cluster is destroyed. Must be between 7 and 30. Defaults to 30 days.
+     */
+    deletionWindowInDays?: pulumi.Input<number>;
+    /**
+     * Whether to enable automatic rotation of the key material. Defaults to `true`.
+     */
+    enableKeyRotation?: pulumi.Input<boolean>;
+    /**
+     * The ARNs of IAM principals that are allowed to administer the key, e.g. to change its policy or schedule its deletion. The AWS account always retains full access to the key so that IAM policies can grant access to it as well.
+     */
+    keyAdministratorArns?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The period in days between automatic rotations of the key material. Must be between 90 and 2560. Defaults to 365 days.
+     */
+    rotationPeriodInDays?: pulumi.Input<number>;
+}
+
 /**
  * Defines how Kubernetes pods are executed in Fargate. See aws.eks.FargateProfileArgs for reference.
  */
@@ -498,6 +832,39 @@ export interface FargateProfileArgs {
     subnetIds?: pulumi.Input<pulumi.Input<string>[]>;
 }
 
+/**
+ * InterruptionHandling configures how a node group handles Spot interruptions and Auto Scaling Group termination events. The events are delivered to an SQS queue, which aws-node-termination-handler processes in queue mode to cordon and drain the affected nodes before their instances are terminated.
+ * See for more details: https://github.com/aws/aws-node-termination-handler#queue-processor
+ */
+export interface InterruptionHandlingArgs {
+    /**
+     * The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+     */
+    chartVersion?: pulumi.Input<string>;
+    /**
+     * The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+     */
+    heartbeatTimeout?: pulumi.Input<number>;
+    /**
+     * The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+     */
+    namespace?: pulumi.Input<string>;
+    /**
+     * The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+     *
+     * If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+     */
+    oidcProviderArn?: pulumi.Input<string>;
+    /**
+     * The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `<name>-node-termination-handler`, where `<name>` is the name of the node group.
+     */
+    serviceAccountName?: pulumi.Input<string>;
+    /**
+     * Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+     */
+    values?: pulumi.Input<{[key: string]: any}>;
+}
+
 export interface KubeProxyAddonOptionsArgs {
     /**
      * Custom configuration values for the kube-proxy addon. This object must match the schema derived from [describe-addon-configuration](https://docs.aws.amazon.com/cli/latest/reference/eks/describe-addon-configuration.html).
@@ -550,18 +917,120 @@ export function kubeProxyAddonOptionsArgsProvideDefaults(val: KubeProxyAddonOpti
  * - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-profiles.html
  */
 export interface KubeconfigOptionsArgs {
+    /**
+     * Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+     *
+     * Only supported by the `aws-iam-authenticator` token tool.
+     */
+    externalId?: pulumi.Input<string>;
     /**
      * AWS credential profile name to always use instead of the default AWS credential provider chain.
      *
      * The profile is passed to kubeconfig as an authentication environment setting.
      */
     profileName?: pulumi.Input<string>;
+    /**
+     * AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+     */
+    region?: pulumi.Input<string>;
     /**
      * Role ARN to assume instead of the default AWS credential provider chain.
      *
      * The role is passed to kubeconfig as an authentication exec argument.
      */
     roleArn?: pulumi.Input<string>;
+    /**
+     * Session name to use when assuming `roleArn`.
+     *
+     * Only supported by the `aws-iam-authenticator` token tool.
+     */
+    roleSessionName?: pulumi.Input<string>;
+    /**
+     * The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+     */
+    tokenTool?: pulumi.Input<enums.KubeconfigTokenTool>;
+}
+
+/**
+ * Typed nodeadm `NodeConfig`. The cluster details are configured by the provider.
+ *
+ * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/.
+ */
+export interface NodeConfigArgs {
+    /**
+     * Configuration of containerd.
+     */
+    containerd?: pulumi.Input<inputs.NodeConfigContainerdArgs>;
+    /**
+     * Configuration of the EC2 instance.
+     */
+    instance?: pulumi.Input<inputs.NodeConfigInstanceArgs>;
+    /**
+     * Configuration of the kubelet.
+     */
+    kubelet?: pulumi.Input<inputs.NodeConfigKubeletArgs>;
+}
+
+/**
+ * Configuration of containerd in a nodeadm `NodeConfig`.
+ */
+export interface NodeConfigContainerdArgs {
+    /**
+     * The OCI runtime specification containers are created with.
+     */
+    baseRuntimeSpec?: pulumi.Input<{[key: string]: any}>;
+    /**
+     * Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+     */
+    config?: pulumi.Input<string>;
+}
+
+/**
+ * Configuration of the EC2 instance in a nodeadm `NodeConfig`.
+ */
+export interface NodeConfigInstanceArgs {
+    /**
+     * Configuration of the local instance store volumes.
+     */
+    localStorage?: pulumi.Input<inputs.NodeConfigLocalStorageArgs>;
+}
+
+/**
+ * Configuration of the kubelet in a nodeadm `NodeConfig`.
+ */
+export interface NodeConfigKubeletArgs {
+    /**
+     * Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+     *
+     * See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+     */
+    config?: pulumi.Input<{[key: string]: any}>;
+    /**
+     * Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+     */
+    flags?: pulumi.Input<pulumi.Input<string>[]>;
+}
+
+/**
+ * Configuration of the local instance store volumes in a nodeadm `NodeConfig`.
+ */
+export interface NodeConfigLocalStorageArgs {
+    /**
+     * Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+     */
+    disabledMounts?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The path the instance store volumes are mounted at.
+     */
+    mountPath?: pulumi.Input<string>;
+    /**
+     * How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+     */
+    strategy: pulumi.Input<string>;
 }
 
 /**
@@ -684,6 +1153,20 @@ export interface UserMappingArgs {
     username: pulumi.Input<string>;
 }
 
+/**
+ * The VPC CNI settings that affect the maximum number of pods of the nodes.
+ */
+export interface VpcCniNetworkingArgs {
+    /**
+     * Whether pods get their IP addresses from the subnets of ENIConfigs.
+     */
+    customNetworking: pulumi.Input<boolean>;
+    /**
+     * Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+     */
+    prefixDelegation: pulumi.Input<boolean>;
+}
+
 /**
  * Describes the configuration options available for the Amazon VPC CNI plugin for Kubernetes.
  */
@@ -770,6 +1253,20 @@ export interface VpcCniOptionsArgs {
      * Defaults to true.
      */
     nodePortSupport?: pulumi.Input<boolean>;
+    /**
+     * The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+     *
+     * Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+     */
+    podSecurityGroupIds?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+     *
+     * Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+     *
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
+     */
+    podSubnets?: {[key: string]: pulumi.Input<string>};
     /**
      * How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
      */
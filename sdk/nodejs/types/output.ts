@@ -63,6 +63,168 @@ export interface AccessPolicyAssociation {
     policyArn: string;
 }
 
+/**
+ * A Bottlerocket bootstrap container, which runs before the kubelet starts.
+ */
+export interface BottlerocketBootstrapContainer {
+    /**
+     * Whether the node fails to boot if the bootstrap container fails.
+     */
+    essential?: boolean;
+    /**
+     * When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+     */
+    mode?: string;
+    /**
+     * The image of the bootstrap container.
+     */
+    source: string;
+    /**
+     * Base64 encoded user data passed to the bootstrap container.
+     */
+    userData?: string;
+}
+
+/**
+ * Typed configuration of the most common Bottlerocket settings.
+ *
+ * See for more details: https://bottlerocket.dev/en/os/1.20.x/api/settings/.
+ */
+export interface BottlerocketConfig {
+    /**
+     * Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+     */
+    bootstrapContainers?: {[key: string]: outputs.BottlerocketBootstrapContainer};
+    /**
+     * Settings of the container registries, i.e. `settings.container-registry`.
+     */
+    containerRegistry?: outputs.BottlerocketContainerRegistry;
+    /**
+     * Settings of the admin and control host containers, i.e. `settings.host-containers`.
+     */
+    hostContainers?: outputs.BottlerocketHostContainers;
+    /**
+     * Settings of the kernel, i.e. `settings.kernel`.
+     */
+    kernel?: outputs.BottlerocketKernelSettings;
+    /**
+     * Settings of the kubelet, i.e. `settings.kubernetes`.
+     */
+    kubernetes?: outputs.BottlerocketKubernetesSettings;
+}
+
+/**
+ * Settings of the container registries of Bottlerocket nodes.
+ */
+export interface BottlerocketContainerRegistry {
+    /**
+     * Mirrors to pull images from instead of the original registries.
+     */
+    mirrors?: outputs.BottlerocketRegistryMirror[];
+}
+
+/**
+ * Settings of a Bottlerocket host container.
+ */
+export interface BottlerocketHostContainer {
+    /**
+     * Whether the host container runs.
+     */
+    enabled?: boolean;
+    /**
+     * The image of the host container.
+     */
+    source?: string;
+    /**
+     * Whether the host container has elevated privileges.
+     */
+    superpowered?: boolean;
+    /**
+     * Base64 encoded user data passed to the host container.
+     */
+    userData?: string;
+}
+
+/**
+ * Settings of the Bottlerocket host containers.
+ */
+export interface BottlerocketHostContainers {
+    /**
+     * The admin container, used for troubleshooting the node.
+     */
+    admin?: outputs.BottlerocketHostContainer;
+    /**
+     * The control container, used to access the Bottlerocket API through AWS SSM.
+     */
+    control?: outputs.BottlerocketHostContainer;
+}
+
+/**
+ * Settings of the Bottlerocket kernel.
+ */
+export interface BottlerocketKernelSettings {
+    /**
+     * The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+     */
+    lockdown?: string;
+    /**
+     * Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: "1"`.
+     */
+    sysctl?: {[key: string]: string};
+}
+
+/**
+ * Settings of the kubelet on Bottlerocket nodes.
+ */
+export interface BottlerocketKubernetesSettings {
+    /**
+     * Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+     */
+    evictionHard?: {[key: string]: string};
+    /**
+     * Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+     */
+    evictionSoft?: {[key: string]: string};
+    /**
+     * Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+     */
+    evictionSoftGracePeriod?: {[key: string]: string};
+    /**
+     * The disk usage in percent after which image garbage collection always runs.
+     */
+    imageGcHighThresholdPercent?: number;
+    /**
+     * The disk usage in percent before which image garbage collection never runs.
+     */
+    imageGcLowThresholdPercent?: number;
+    /**
+     * The maximum number of pods the kubelet runs on the node.
+     */
+    maxPods?: number;
+    /**
+     * Labels the node registers with.
+     */
+    nodeLabels?: {[key: string]: string};
+    /**
+     * Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+     */
+    nodeTaints?: {[key: string]: string};
+}
+
+/**
+ * A mirror of a container registry.
+ */
+export interface BottlerocketRegistryMirror {
+    /**
+     * The endpoints of the mirror, in order of preference.
+     */
+    endpoints: string[];
+    /**
+     * The registry to mirror, e.g. `docker.io`.
+     */
+    registry: string;
+}
+
 /**
  * Describes the configuration options accepted by a cluster to create its own node groups.
  */
@@ -94,10 +256,21 @@ export interface ClusterNodeGroupOptions {
      * Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
      */
     autoScalingGroupTags?: {[key: string]: string};
+    /**
+     * Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+     *
+     * For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+     */
+    autoscalerDiscovery?: boolean;
     /**
      * Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
      */
     bootstrapExtraArgs?: string;
+    /**
+     * Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+     * The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+     */
+    bottlerocketConfig?: outputs.BottlerocketConfig;
     /**
      * The configuration settings for Bottlerocket OS.
      * The settings will get merged with the base settings the provider uses to configure Bottlerocket.
@@ -179,6 +352,12 @@ export interface ClusterNodeGroupOptions {
      * The instance type to use for the cluster's nodes. Defaults to "t3.medium".
      */
     instanceType?: string;
+    /**
+     * Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+     *
+     * See for more details: https://github.com/aws/aws-node-termination-handler
+     */
+    interruptionHandling?: outputs.InterruptionHandling;
     /**
      * Name of the key pair to use for SSH access to worker nodes.
      */
@@ -211,6 +390,13 @@ export interface ClusterNodeGroupOptions {
      * Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
      */
     nodeAssociatePublicIpAddress?: boolean;
+    /**
+     * Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+     *
+     * Note: This is only applicable when using AL2023.
+     * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+     */
+    nodeConfig?: outputs.NodeConfig;
     /**
      * Public key material for SSH access to worker nodes. See allowed formats at:
      * https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
@@ -328,7 +514,15 @@ export interface CoreData {
      * The IAM Role attached to the EKS Cluster
      */
     clusterIamRole: pulumiAws.iam.Role;
+    /**
+     * The log group of the control plane logs, if it is managed by the cluster.
+     */
+    clusterLogGroup?: pulumiAws.cloudwatch.LogGroup;
     clusterSecurityGroup?: pulumiAws.ec2.SecurityGroup;
+    /**
+     * The scaling configuration of the control plane, including its provisioned tier.
+     */
+    controlPlaneScalingConfig?: pulumiAws.types.output.eks.ClusterControlPlaneScalingConfig;
     eksNodeAccess?: pulumiKubernetes.core.v1.ConfigMap;
     encryptionConfig?: pulumiAws.types.output.eks.ClusterEncryptionConfig;
     /**
@@ -377,14 +571,137 @@ export interface CoreData {
      * A map of tags assigned to the EKS cluster.
      */
     tags?: {[key: string]: string};
+    /**
+     * The upgrade policy of the cluster, which determines whether it gets extended support.
+     */
+    upgradePolicy?: pulumiAws.types.output.eks.ClusterUpgradePolicy;
     /**
      * The VPC CNI for the cluster.
      */
     vpcCni?: VpcCniAddon;
+    /**
+     * The VPC CNI settings that affect the maximum number of pods of the nodes.
+     */
+    vpcCniNetworking?: outputs.VpcCniNetworking;
     /**
      * ID of the cluster's VPC.
      */
     vpcId: string;
+    /**
+     * The zonal shift configuration of the cluster.
+     */
+    zonalShiftConfig?: pulumiAws.types.output.eks.ClusterZonalShiftConfig;
+}
+
+/**
+ * InterruptionHandling configures how a node group handles Spot interruptions and Auto Scaling Group termination events. The events are delivered to an SQS queue, which aws-node-termination-handler processes in queue mode to cordon and drain the affected nodes before their instances are terminated.
+ * See for more details: https://github.com/aws/aws-node-termination-handler#queue-processor
+ */
+export interface InterruptionHandling {
+    /**
+     * The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+     */
+    chartVersion?: string;
+    /**
+     * The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+     */
+    heartbeatTimeout?: number;
+    /**
+     * The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+     */
+    namespace?: string;
+    /**
+     * The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+     *
+     * If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+     */
+    oidcProviderArn?: string;
+    /**
+     * The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `<name>-node-termination-handler`, where `<name>` is the name of the node group.
+     */
+    serviceAccountName?: string;
+    /**
+     * Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+     */
+    values?: {[key: string]: any};
+}
+
+/**
+ * Typed nodeadm `NodeConfig`. The cluster details are configured by the provider.
+ *
+ * See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/.
+ */
+export interface NodeConfig {
+    /**
+     * Configuration of containerd.
+     */
+    containerd?: outputs.NodeConfigContainerd;
+    /**
+     * Configuration of the EC2 instance.
+     */
+    instance?: outputs.NodeConfigInstance;
+    /**
+     * Configuration of the kubelet.
+     */
+    kubelet?: outputs.NodeConfigKubelet;
+}
+
+/**
+ * Configuration of containerd in a nodeadm `NodeConfig`.
+ */
+export interface NodeConfigContainerd {
+    /**
+     * The OCI runtime specification containers are created with.
+     */
+    baseRuntimeSpec?: {[key: string]: any};
+    /**
+     * Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+     */
+    config?: string;
+}
+
+/**
+ * Configuration of the EC2 instance in a nodeadm `NodeConfig`.
+ */
+export interface NodeConfigInstance {
+    /**
+     * Configuration of the local instance store volumes.
+     */
+    localStorage?: outputs.NodeConfigLocalStorage;
+}
+
+/**
+ * Configuration of the kubelet in a nodeadm `NodeConfig`.
+ */
+export interface NodeConfigKubelet {
+    /**
+     * Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+     *
+     * See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+     */
+    config?: {[key: string]: any};
+    /**
+     * Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+     */
+    flags?: string[];
+}
+
+/**
+ * Configuration of the local instance store volumes in a nodeadm `NodeConfig`.
+ */
+export interface NodeConfigLocalStorage {
+    /**
+     * Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+     */
+    disabledMounts?: string[];
+    /**
+     * The path the instance store volumes are mounted at.
+     */
+    mountPath?: string;
+    /**
+     * How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+     */
+    strategy: string;
 }
 
 /**
@@ -435,3 +752,17 @@ export interface Taint {
     value: string;
 }
 
+/**
+ * The VPC CNI settings that affect the maximum number of pods of the nodes.
+ */
+export interface VpcCniNetworking {
+    /**
+     * Whether pods get their IP addresses from the subnets of ENIConfigs.
+     */
+    customNetworking: boolean;
+    /**
+     * Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+     */
+    prefixDelegation: boolean;
+}
+
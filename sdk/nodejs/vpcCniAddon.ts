@@ -59,6 +59,8 @@ export class VpcCniAddon extends pulumi.ComponentResource {
             resourceInputs["logFile"] = args?.logFile;
             resourceInputs["logLevel"] = args?.logLevel;
             resourceInputs["nodePortSupport"] = args?.nodePortSupport;
+            resourceInputs["podSecurityGroupIds"] = args?.podSecurityGroupIds;
+            resourceInputs["podSubnets"] = args?.podSubnets;
             resourceInputs["resolveConflictsOnCreate"] = (args?.resolveConflictsOnCreate) ?? "OVERWRITE";
             resourceInputs["resolveConflictsOnUpdate"] = (args?.resolveConflictsOnUpdate) ?? "OVERWRITE";
             resourceInputs["securityContextPrivileged"] = args?.securityContextPrivileged;
@@ -171,6 +173,20 @@ export interface VpcCniAddonArgs {
      * Defaults to true.
      */
     nodePortSupport?: pulumi.Input<boolean>;
+    /**
+     * The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+     *
+     * Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+     */
+    podSecurityGroupIds?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+     *
+     * Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+     *
+     * See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
+     */
+    podSubnets?: {[key: string]: pulumi.Input<string>};
     /**
      * How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
      */
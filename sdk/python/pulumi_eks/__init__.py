@@ -8,8 +8,13 @@ import typing
 # Export this package's modules as members:
 from ._enums import *
 from .addon import *
+from .auto_mode_node_class import *
+from .auto_mode_node_pool import *
 from .cluster import *
+from .cluster_autoscaler import *
 from .cluster_creation_role_provider import *
+from .get_optimized_ami import *
+from .load_balancer_controller import *
 from .managed_node_group import *
 from .node_group import *
 from .node_group_security_group import *
@@ -27,8 +32,12 @@ _utilities.register(
   "fqn": "pulumi_eks",
   "classes": {
    "eks:index:Addon": "Addon",
+   "eks:index:AutoModeNodeClass": "AutoModeNodeClass",
+   "eks:index:AutoModeNodePool": "AutoModeNodePool",
    "eks:index:Cluster": "Cluster",
+   "eks:index:ClusterAutoscaler": "ClusterAutoscaler",
    "eks:index:ClusterCreationRoleProvider": "ClusterCreationRoleProvider",
+   "eks:index:LoadBalancerController": "LoadBalancerController",
    "eks:index:ManagedNodeGroup": "ManagedNodeGroup",
    "eks:index:NodeGroup": "NodeGroup",
    "eks:index:NodeGroupSecurityGroup": "NodeGroupSecurityGroup",
@@ -11,6 +11,7 @@ __all__ = [
     'AmiType',
     'AuthenticationMode',
     'ClusterNodePools',
+    'KubeconfigTokenTool',
     'OperatingSystem',
     'ResolveConflictsOnCreate',
     'ResolveConflictsOnUpdate',
@@ -99,6 +100,21 @@ class ClusterNodePools(_builtins.str, Enum):
     """
 
 
+@pulumi.type_token("eks:index:KubeconfigTokenTool")
+class KubeconfigTokenTool(_builtins.str, Enum):
+    """
+    The exec plugin used by a kubeconfig to retrieve a token for the cluster.
+    """
+    AWS = "aws"
+    """
+    Use `aws eks get-token` of the AWS CLI to retrieve the token.
+    """
+    AWS_IAM_AUTHENTICATOR = "aws-iam-authenticator"
+    """
+    Use `aws-iam-authenticator token` to retrieve the token.
+    """
+
+
 @pulumi.type_token("eks:index:OperatingSystem")
 class OperatingSystem(_builtins.str, Enum):
     """
@@ -23,8 +23,34 @@ __all__ = [
     'AccessEntryArgsDict',
     'AccessPolicyAssociationArgs',
     'AccessPolicyAssociationArgsDict',
+    'AutoModeDisruptionBudgetArgs',
+    'AutoModeDisruptionBudgetArgsDict',
+    'AutoModeDisruptionArgs',
+    'AutoModeDisruptionArgsDict',
+    'AutoModeEphemeralStorageArgs',
+    'AutoModeEphemeralStorageArgsDict',
+    'AutoModeNodeRequirementArgs',
+    'AutoModeNodeRequirementArgsDict',
     'AutoModeOptionsArgs',
     'AutoModeOptionsArgsDict',
+    'AutoModeSelectorTermArgs',
+    'AutoModeSelectorTermArgsDict',
+    'BottlerocketBootstrapContainerArgs',
+    'BottlerocketBootstrapContainerArgsDict',
+    'BottlerocketConfigArgs',
+    'BottlerocketConfigArgsDict',
+    'BottlerocketContainerRegistryArgs',
+    'BottlerocketContainerRegistryArgsDict',
+    'BottlerocketHostContainersArgs',
+    'BottlerocketHostContainersArgsDict',
+    'BottlerocketHostContainerArgs',
+    'BottlerocketHostContainerArgsDict',
+    'BottlerocketKernelSettingsArgs',
+    'BottlerocketKernelSettingsArgsDict',
+    'BottlerocketKubernetesSettingsArgs',
+    'BottlerocketKubernetesSettingsArgsDict',
+    'BottlerocketRegistryMirrorArgs',
+    'BottlerocketRegistryMirrorArgsDict',
     'ClusterComputeConfigArgs',
     'ClusterComputeConfigArgsDict',
     'ClusterNodeGroupOptionsArgs',
@@ -35,12 +61,26 @@ __all__ = [
     'CoreDnsAddonOptionsArgsDict',
     'CreationRoleProviderArgs',
     'CreationRoleProviderArgsDict',
+    'EncryptionConfigOptionsArgs',
+    'EncryptionConfigOptionsArgsDict',
     'FargateProfileArgs',
     'FargateProfileArgsDict',
+    'InterruptionHandlingArgs',
+    'InterruptionHandlingArgsDict',
     'KubeProxyAddonOptionsArgs',
     'KubeProxyAddonOptionsArgsDict',
     'KubeconfigOptionsArgs',
     'KubeconfigOptionsArgsDict',
+    'NodeConfigContainerdArgs',
+    'NodeConfigContainerdArgsDict',
+    'NodeConfigInstanceArgs',
+    'NodeConfigInstanceArgsDict',
+    'NodeConfigKubeletArgs',
+    'NodeConfigKubeletArgsDict',
+    'NodeConfigLocalStorageArgs',
+    'NodeConfigLocalStorageArgsDict',
+    'NodeConfigArgs',
+    'NodeConfigArgsDict',
     'NodeadmOptionsArgs',
     'NodeadmOptionsArgsDict',
     'RoleMappingArgs',
@@ -51,6 +91,8 @@ __all__ = [
     'TaintArgsDict',
     'UserMappingArgs',
     'UserMappingArgsDict',
+    'VpcCniNetworkingArgs',
+    'VpcCniNetworkingArgsDict',
     'VpcCniOptionsArgs',
     'VpcCniOptionsArgsDict',
 ]
@@ -253,85 +295,1172 @@ class AccessPolicyAssociationArgs:
         pulumi.set(self, "policy_arn", value)
 
 
+class AutoModeDisruptionBudgetArgsDict(TypedDict):
+    """
+    AutoModeDisruptionBudget limits the number of nodes that may be disrupted at the same time.
+    """
+    nodes: pulumi.Input[_builtins.str]
+    """
+    The number or percentage of nodes that may be disrupted, e.g. `10%`. `0` blocks disruptions.
+    """
+    duration: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    How long the budget is active after each schedule, e.g. `8h`.
+    """
+    reasons: NotRequired[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]
+    """
+    The disruption reasons the budget applies to, `Underutilized`, `Empty` or `Drifted`. Defaults to all reasons.
+    """
+    schedule: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    The cron schedule at which the budget becomes active, e.g. `0 9 * * mon-fri`. Requires `duration`.
+    """
+
+@pulumi.input_type
+class AutoModeDisruptionBudgetArgs:
+    def __init__(__self__, *,
+                 nodes: pulumi.Input[_builtins.str],
+                 duration: Optional[pulumi.Input[_builtins.str]] = None,
+                 reasons: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 schedule: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        AutoModeDisruptionBudget limits the number of nodes that may be disrupted at the same time.
+        :param pulumi.Input[_builtins.str] nodes: The number or percentage of nodes that may be disrupted, e.g. `10%`. `0` blocks disruptions.
+        :param pulumi.Input[_builtins.str] duration: How long the budget is active after each schedule, e.g. `8h`.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] reasons: The disruption reasons the budget applies to, `Underutilized`, `Empty` or `Drifted`. Defaults to all reasons.
+        :param pulumi.Input[_builtins.str] schedule: The cron schedule at which the budget becomes active, e.g. `0 9 * * mon-fri`. Requires `duration`.
+        """
+        pulumi.set(__self__, "nodes", nodes)
+        if duration is not None:
+            pulumi.set(__self__, "duration", duration)
+        if reasons is not None:
+            pulumi.set(__self__, "reasons", reasons)
+        if schedule is not None:
+            pulumi.set(__self__, "schedule", schedule)
+
+    @_builtins.property
+    @pulumi.getter
+    def nodes(self) -> pulumi.Input[_builtins.str]:
+        """
+        The number or percentage of nodes that may be disrupted, e.g. `10%`. `0` blocks disruptions.
+        """
+        return pulumi.get(self, "nodes")
+
+    @nodes.setter
+    def nodes(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "nodes", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def duration(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        How long the budget is active after each schedule, e.g. `8h`.
+        """
+        return pulumi.get(self, "duration")
+
+    @duration.setter
+    def duration(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "duration", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def reasons(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The disruption reasons the budget applies to, `Underutilized`, `Empty` or `Drifted`. Defaults to all reasons.
+        """
+        return pulumi.get(self, "reasons")
+
+    @reasons.setter
+    def reasons(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "reasons", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def schedule(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The cron schedule at which the budget becomes active, e.g. `0 9 * * mon-fri`. Requires `duration`.
+        """
+        return pulumi.get(self, "schedule")
+
+    @schedule.setter
+    def schedule(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "schedule", value)
+
+
+class AutoModeDisruptionArgsDict(TypedDict):
+    """
+    AutoModeDisruption configures how nodes of a node pool are consolidated and replaced.
+    """
+    budgets: NotRequired[pulumi.Input[Sequence[pulumi.Input['AutoModeDisruptionBudgetArgsDict']]]]
+    """
+    The disruption budgets of the node pool.
+    """
+    consolidate_after: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    How long to wait after a pod was added to or removed from a node before consolidating it, e.g. `30s`, or `Never`. Defaults to `0s`.
+    """
+    consolidation_policy: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    Which nodes are consolidated, `WhenEmptyOrUnderutilized` or `WhenEmpty`. Defaults to `WhenEmptyOrUnderutilized`.
+    """
+
+@pulumi.input_type
+class AutoModeDisruptionArgs:
+    def __init__(__self__, *,
+                 budgets: Optional[pulumi.Input[Sequence[pulumi.Input['AutoModeDisruptionBudgetArgs']]]] = None,
+                 consolidate_after: Optional[pulumi.Input[_builtins.str]] = None,
+                 consolidation_policy: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        AutoModeDisruption configures how nodes of a node pool are consolidated and replaced.
+        :param pulumi.Input[Sequence[pulumi.Input['AutoModeDisruptionBudgetArgs']]] budgets: The disruption budgets of the node pool.
+        :param pulumi.Input[_builtins.str] consolidate_after: How long to wait after a pod was added to or removed from a node before consolidating it, e.g. `30s`, or `Never`. Defaults to `0s`.
+        :param pulumi.Input[_builtins.str] consolidation_policy: Which nodes are consolidated, `WhenEmptyOrUnderutilized` or `WhenEmpty`. Defaults to `WhenEmptyOrUnderutilized`.
+        """
+        if budgets is not None:
+            pulumi.set(__self__, "budgets", budgets)
+        if consolidate_after is not None:
+            pulumi.set(__self__, "consolidate_after", consolidate_after)
+        if consolidation_policy is not None:
+            pulumi.set(__self__, "consolidation_policy", consolidation_policy)
+
+    @_builtins.property
+    @pulumi.getter
+    def budgets(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['AutoModeDisruptionBudgetArgs']]]]:
+        """
+        The disruption budgets of the node pool.
+        """
+        return pulumi.get(self, "budgets")
+
+    @budgets.setter
+    def budgets(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['AutoModeDisruptionBudgetArgs']]]]):
+        pulumi.set(self, "budgets", value)
+
+    @_builtins.property
+    @pulumi.getter(name="consolidateAfter")
+    def consolidate_after(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        How long to wait after a pod was added to or removed from a node before consolidating it, e.g. `30s`, or `Never`. Defaults to `0s`.
+        """
+        return pulumi.get(self, "consolidate_after")
+
+    @consolidate_after.setter
+    def consolidate_after(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "consolidate_after", value)
+
+    @_builtins.property
+    @pulumi.getter(name="consolidationPolicy")
+    def consolidation_policy(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        Which nodes are consolidated, `WhenEmptyOrUnderutilized` or `WhenEmpty`. Defaults to `WhenEmptyOrUnderutilized`.
+        """
+        return pulumi.get(self, "consolidation_policy")
+
+    @consolidation_policy.setter
+    def consolidation_policy(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "consolidation_policy", value)
+
+
+class AutoModeEphemeralStorageArgsDict(TypedDict):
+    """
+    AutoModeEphemeralStorage configures the volume that holds the ephemeral storage of the nodes.
+    """
+    iops: NotRequired[pulumi.Input[_builtins.int]]
+    """
+    The IOPS of the volume.
+    """
+    kms_key_id: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    The ARN of the KMS key that encrypts the volume.
+    """
+    size: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    The size of the volume, e.g. `80Gi`.
+    """
+    throughput: NotRequired[pulumi.Input[_builtins.int]]
+    """
+    The throughput of the volume in MiB/s.
+    """
+
+@pulumi.input_type
+class AutoModeEphemeralStorageArgs:
+    def __init__(__self__, *,
+                 iops: Optional[pulumi.Input[_builtins.int]] = None,
+                 kms_key_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 size: Optional[pulumi.Input[_builtins.str]] = None,
+                 throughput: Optional[pulumi.Input[_builtins.int]] = None):
+        """
+        AutoModeEphemeralStorage configures the volume that holds the ephemeral storage of the nodes.
+        :param pulumi.Input[_builtins.int] iops: The IOPS of the volume.
+        :param pulumi.Input[_builtins.str] kms_key_id: The ARN of the KMS key that encrypts the volume.
+        :param pulumi.Input[_builtins.str] size: The size of the volume, e.g. `80Gi`.
+        :param pulumi.Input[_builtins.int] throughput: The throughput of the volume in MiB/s.
+        """
+        if iops is not None:
+            pulumi.set(__self__, "iops", iops)
+        if kms_key_id is not None:
+            pulumi.set(__self__, "kms_key_id", kms_key_id)
+        if size is not None:
+            pulumi.set(__self__, "size", size)
+        if throughput is not None:
+            pulumi.set(__self__, "throughput", throughput)
+
+    @_builtins.property
+    @pulumi.getter
+    def iops(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The IOPS of the volume.
+        """
+        return pulumi.get(self, "iops")
+
+    @iops.setter
+    def iops(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "iops", value)
+
+    @_builtins.property
+    @pulumi.getter(name="kmsKeyId")
+    def kms_key_id(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The ARN of the KMS key that encrypts the volume.
+        """
+        return pulumi.get(self, "kms_key_id")
+
+    @kms_key_id.setter
+    def kms_key_id(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "kms_key_id", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def size(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The size of the volume, e.g. `80Gi`.
+        """
+        return pulumi.get(self, "size")
+
+    @size.setter
+    def size(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "size", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def throughput(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The throughput of the volume in MiB/s.
+        """
+        return pulumi.get(self, "throughput")
+
+    @throughput.setter
+    def throughput(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "throughput", value)
+
+
+class AutoModeNodeRequirementArgsDict(TypedDict):
+    """
+    AutoModeNodeRequirement restricts the instances of a node pool by a well-known label, e.g. `eks.amazonaws.com/instance-category` or `karpenter.sh/capacity-type`.
+    """
+    key: pulumi.Input[_builtins.str]
+    """
+    The label key.
+    """
+    operator: pulumi.Input[_builtins.str]
+    """
+    The operator, one of `In`, `NotIn`, `Exists`, `DoesNotExist`, `Gt` and `Lt`.
+    """
+    min_values: NotRequired[pulumi.Input[_builtins.int]]
+    """
+    The minimum number of distinct values the node pool has to be able to choose from.
+    """
+    values: NotRequired[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]
+    """
+    The label values.
+    """
+
+@pulumi.input_type
+class AutoModeNodeRequirementArgs:
+    def __init__(__self__, *,
+                 key: pulumi.Input[_builtins.str],
+                 operator: pulumi.Input[_builtins.str],
+                 min_values: Optional[pulumi.Input[_builtins.int]] = None,
+                 values: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None):
+        """
+        AutoModeNodeRequirement restricts the instances of a node pool by a well-known label, e.g. `eks.amazonaws.com/instance-category` or `karpenter.sh/capacity-type`.
+        :param pulumi.Input[_builtins.str] key: The label key.
+        :param pulumi.Input[_builtins.str] operator: The operator, one of `In`, `NotIn`, `Exists`, `DoesNotExist`, `Gt` and `Lt`.
+        :param pulumi.Input[_builtins.int] min_values: The minimum number of distinct values the node pool has to be able to choose from.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] values: The label values.
+        """
+        pulumi.set(__self__, "key", key)
+        pulumi.set(__self__, "operator", operator)
+        if min_values is not None:
+            pulumi.set(__self__, "min_values", min_values)
+        if values is not None:
+            pulumi.set(__self__, "values", values)
+
+    @_builtins.property
+    @pulumi.getter
+    def key(self) -> pulumi.Input[_builtins.str]:
+        """
+        The label key.
+        """
+        return pulumi.get(self, "key")
+
+    @key.setter
+    def key(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "key", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def operator(self) -> pulumi.Input[_builtins.str]:
+        """
+        The operator, one of `In`, `NotIn`, `Exists`, `DoesNotExist`, `Gt` and `Lt`.
+        """
+        return pulumi.get(self, "operator")
+
+    @operator.setter
+    def operator(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "operator", value)
+
+    @_builtins.property
+    @pulumi.getter(name="minValues")
+    def min_values(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The minimum number of distinct values the node pool has to be able to choose from.
+        """
+        return pulumi.get(self, "min_values")
+
+    @min_values.setter
+    def min_values(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "min_values", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def values(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The label values.
+        """
+        return pulumi.get(self, "values")
+
+    @values.setter
+    def values(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "values", value)
+
+
 class AutoModeOptionsArgsDict(TypedDict):
     """
     Configuration Options for EKS Auto Mode. If EKS Auto Mode is enabled, AWS will manage cluster infrastructure on your behalf.
 
-    For more information, see: https://docs.aws.amazon.com/eks/latest/userguide/automode.html
-    """
-    enabled: _builtins.bool
+    For more information, see: https://docs.aws.amazon.com/eks/latest/userguide/automode.html
+    """
+    enabled: _builtins.bool
+    """
+    Whether to enable EKS Auto Mode. If enabled, EKS will manage node pools, EBS volumes and Load Balancers for you.
+    When enabled, the vpc-cni and kube-proxy will not be enabled by default because EKS Auto Mode includes pod networking capabilities.
+    """
+    compute_config: NotRequired[pulumi.Input['ClusterComputeConfigArgsDict']]
+    """
+    Compute configuration for EKS Auto Mode.
+    """
+    create_node_role: NotRequired[_builtins.bool]
+    """
+    Whether to create an IAM role for the EKS Auto Mode node group if none is provided in `computeConfig`.
+    """
+
+@pulumi.input_type
+class AutoModeOptionsArgs:
+    def __init__(__self__, *,
+                 enabled: _builtins.bool,
+                 compute_config: Optional[pulumi.Input['ClusterComputeConfigArgs']] = None,
+                 create_node_role: Optional[_builtins.bool] = None):
+        """
+        Configuration Options for EKS Auto Mode. If EKS Auto Mode is enabled, AWS will manage cluster infrastructure on your behalf.
+
+        For more information, see: https://docs.aws.amazon.com/eks/latest/userguide/automode.html
+        :param _builtins.bool enabled: Whether to enable EKS Auto Mode. If enabled, EKS will manage node pools, EBS volumes and Load Balancers for you.
+               When enabled, the vpc-cni and kube-proxy will not be enabled by default because EKS Auto Mode includes pod networking capabilities.
+        :param pulumi.Input['ClusterComputeConfigArgs'] compute_config: Compute configuration for EKS Auto Mode.
+        :param _builtins.bool create_node_role: Whether to create an IAM role for the EKS Auto Mode node group if none is provided in `computeConfig`.
+        """
+        pulumi.set(__self__, "enabled", enabled)
+        if compute_config is not None:
+            pulumi.set(__self__, "compute_config", compute_config)
+        if create_node_role is None:
+            create_node_role = True
+        if create_node_role is not None:
+            pulumi.set(__self__, "create_node_role", create_node_role)
+
+    @_builtins.property
+    @pulumi.getter
+    def enabled(self) -> _builtins.bool:
+        """
+        Whether to enable EKS Auto Mode. If enabled, EKS will manage node pools, EBS volumes and Load Balancers for you.
+        When enabled, the vpc-cni and kube-proxy will not be enabled by default because EKS Auto Mode includes pod networking capabilities.
+        """
+        return pulumi.get(self, "enabled")
+
+    @enabled.setter
+    def enabled(self, value: _builtins.bool):
+        pulumi.set(self, "enabled", value)
+
+    @_builtins.property
+    @pulumi.getter(name="computeConfig")
+    def compute_config(self) -> Optional[pulumi.Input['ClusterComputeConfigArgs']]:
+        """
+        Compute configuration for EKS Auto Mode.
+        """
+        return pulumi.get(self, "compute_config")
+
+    @compute_config.setter
+    def compute_config(self, value: Optional[pulumi.Input['ClusterComputeConfigArgs']]):
+        pulumi.set(self, "compute_config", value)
+
+    @_builtins.property
+    @pulumi.getter(name="createNodeRole")
+    def create_node_role(self) -> Optional[_builtins.bool]:
+        """
+        Whether to create an IAM role for the EKS Auto Mode node group if none is provided in `computeConfig`.
+        """
+        return pulumi.get(self, "create_node_role")
+
+    @create_node_role.setter
+    def create_node_role(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "create_node_role", value)
+
+
+class AutoModeSelectorTermArgsDict(TypedDict):
+    """
+    AutoModeSelectorTerm selects subnets or security groups by their ID, name or tags. The conditions of a term are ANDed, multiple terms are ORed.
+    """
+    id: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    The ID of the subnet or security group.
+    """
+    name: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    The name of the security group. Not supported for subnets.
+    """
+    tags: NotRequired[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]
+    """
+    The tags the subnets or security groups must have. A value of `*` matches any value.
+    """
+
+@pulumi.input_type
+class AutoModeSelectorTermArgs:
+    def __init__(__self__, *,
+                 id: Optional[pulumi.Input[_builtins.str]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None):
+        """
+        AutoModeSelectorTerm selects subnets or security groups by their ID, name or tags. The conditions of a term are ANDed, multiple terms are ORed.
+        :param pulumi.Input[_builtins.str] id: The ID of the subnet or security group.
+        :param pulumi.Input[_builtins.str] name: The name of the security group. Not supported for subnets.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] tags: The tags the subnets or security groups must have. A value of `*` matches any value.
+        """
+        if id is not None:
+            pulumi.set(__self__, "id", id)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+
+    @_builtins.property
+    @pulumi.getter
+    def id(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The ID of the subnet or security group.
+        """
+        return pulumi.get(self, "id")
+
+    @id.setter
+    def id(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "id", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The name of the security group. Not supported for subnets.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        The tags the subnets or security groups must have. A value of `*` matches any value.
+        """
+        return pulumi.get(self, "tags")
+
+    @tags.setter
+    def tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "tags", value)
+
+
+class BottlerocketBootstrapContainerArgsDict(TypedDict):
+    """
+    A Bottlerocket bootstrap container, which runs before the kubelet starts.
+    """
+    source: pulumi.Input[_builtins.str]
+    """
+    The image of the bootstrap container.
+    """
+    essential: NotRequired[pulumi.Input[_builtins.bool]]
+    """
+    Whether the node fails to boot if the bootstrap container fails.
+    """
+    mode: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+    """
+    user_data: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    Base64 encoded user data passed to the bootstrap container.
+    """
+
+@pulumi.input_type
+class BottlerocketBootstrapContainerArgs:
+    def __init__(__self__, *,
+                 source: pulumi.Input[_builtins.str],
+                 essential: Optional[pulumi.Input[_builtins.bool]] = None,
+                 mode: Optional[pulumi.Input[_builtins.str]] = None,
+                 user_data: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        A Bottlerocket bootstrap container, which runs before the kubelet starts.
+        :param pulumi.Input[_builtins.str] source: The image of the bootstrap container.
+        :param pulumi.Input[_builtins.bool] essential: Whether the node fails to boot if the bootstrap container fails.
+        :param pulumi.Input[_builtins.str] mode: When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+        :param pulumi.Input[_builtins.str] user_data: Base64 encoded user data passed to the bootstrap container.
+        """
+        pulumi.set(__self__, "source", source)
+        if essential is not None:
+            pulumi.set(__self__, "essential", essential)
+        if mode is not None:
+            pulumi.set(__self__, "mode", mode)
+        if user_data is not None:
+            pulumi.set(__self__, "user_data", user_data)
+
+    @_builtins.property
+    @pulumi.getter
+    def source(self) -> pulumi.Input[_builtins.str]:
+        """
+        The image of the bootstrap container.
+        """
+        return pulumi.get(self, "source")
+
+    @source.setter
+    def source(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "source", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def essential(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        Whether the node fails to boot if the bootstrap container fails.
+        """
+        return pulumi.get(self, "essential")
+
+    @essential.setter
+    def essential(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "essential", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def mode(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+        """
+        return pulumi.get(self, "mode")
+
+    @mode.setter
+    def mode(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "mode", value)
+
+    @_builtins.property
+    @pulumi.getter(name="userData")
+    def user_data(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        Base64 encoded user data passed to the bootstrap container.
+        """
+        return pulumi.get(self, "user_data")
+
+    @user_data.setter
+    def user_data(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "user_data", value)
+
+
+class BottlerocketConfigArgsDict(TypedDict):
+    """
+    Typed configuration of the most common Bottlerocket settings.
+
+    See for more details: https://bottlerocket.dev/en/os/1.20.x/api/settings/.
+    """
+    bootstrap_containers: NotRequired[pulumi.Input[Mapping[str, pulumi.Input['BottlerocketBootstrapContainerArgsDict']]]]
+    """
+    Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+    """
+    container_registry: NotRequired[pulumi.Input['BottlerocketContainerRegistryArgsDict']]
+    """
+    Settings of the container registries, i.e. `settings.container-registry`.
+    """
+    host_containers: NotRequired[pulumi.Input['BottlerocketHostContainersArgsDict']]
+    """
+    Settings of the admin and control host containers, i.e. `settings.host-containers`.
+    """
+    kernel: NotRequired[pulumi.Input['BottlerocketKernelSettingsArgsDict']]
+    """
+    Settings of the kernel, i.e. `settings.kernel`.
+    """
+    kubernetes: NotRequired[pulumi.Input['BottlerocketKubernetesSettingsArgsDict']]
+    """
+    Settings of the kubelet, i.e. `settings.kubernetes`.
+    """
+
+@pulumi.input_type
+class BottlerocketConfigArgs:
+    def __init__(__self__, *,
+                 bootstrap_containers: Optional[pulumi.Input[Mapping[str, pulumi.Input['BottlerocketBootstrapContainerArgs']]]] = None,
+                 container_registry: Optional[pulumi.Input['BottlerocketContainerRegistryArgs']] = None,
+                 host_containers: Optional[pulumi.Input['BottlerocketHostContainersArgs']] = None,
+                 kernel: Optional[pulumi.Input['BottlerocketKernelSettingsArgs']] = None,
+                 kubernetes: Optional[pulumi.Input['BottlerocketKubernetesSettingsArgs']] = None):
+        """
+        Typed configuration of the most common Bottlerocket settings.
+
+        See for more details: https://bottlerocket.dev/en/os/1.20.x/api/settings/.
+        :param pulumi.Input[Mapping[str, pulumi.Input['BottlerocketBootstrapContainerArgs']]] bootstrap_containers: Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+        :param pulumi.Input['BottlerocketContainerRegistryArgs'] container_registry: Settings of the container registries, i.e. `settings.container-registry`.
+        :param pulumi.Input['BottlerocketHostContainersArgs'] host_containers: Settings of the admin and control host containers, i.e. `settings.host-containers`.
+        :param pulumi.Input['BottlerocketKernelSettingsArgs'] kernel: Settings of the kernel, i.e. `settings.kernel`.
+        :param pulumi.Input['BottlerocketKubernetesSettingsArgs'] kubernetes: Settings of the kubelet, i.e. `settings.kubernetes`.
+        """
+        if bootstrap_containers is not None:
+            pulumi.set(__self__, "bootstrap_containers", bootstrap_containers)
+        if container_registry is not None:
+            pulumi.set(__self__, "container_registry", container_registry)
+        if host_containers is not None:
+            pulumi.set(__self__, "host_containers", host_containers)
+        if kernel is not None:
+            pulumi.set(__self__, "kernel", kernel)
+        if kubernetes is not None:
+            pulumi.set(__self__, "kubernetes", kubernetes)
+
+    @_builtins.property
+    @pulumi.getter(name="bootstrapContainers")
+    def bootstrap_containers(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input['BottlerocketBootstrapContainerArgs']]]]:
+        """
+        Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+        """
+        return pulumi.get(self, "bootstrap_containers")
+
+    @bootstrap_containers.setter
+    def bootstrap_containers(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input['BottlerocketBootstrapContainerArgs']]]]):
+        pulumi.set(self, "bootstrap_containers", value)
+
+    @_builtins.property
+    @pulumi.getter(name="containerRegistry")
+    def container_registry(self) -> Optional[pulumi.Input['BottlerocketContainerRegistryArgs']]:
+        """
+        Settings of the container registries, i.e. `settings.container-registry`.
+        """
+        return pulumi.get(self, "container_registry")
+
+    @container_registry.setter
+    def container_registry(self, value: Optional[pulumi.Input['BottlerocketContainerRegistryArgs']]):
+        pulumi.set(self, "container_registry", value)
+
+    @_builtins.property
+    @pulumi.getter(name="hostContainers")
+    def host_containers(self) -> Optional[pulumi.Input['BottlerocketHostContainersArgs']]:
+        """
+        Settings of the admin and control host containers, i.e. `settings.host-containers`.
+        """
+        return pulumi.get(self, "host_containers")
+
+    @host_containers.setter
+    def host_containers(self, value: Optional[pulumi.Input['BottlerocketHostContainersArgs']]):
+        pulumi.set(self, "host_containers", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def kernel(self) -> Optional[pulumi.Input['BottlerocketKernelSettingsArgs']]:
+        """
+        Settings of the kernel, i.e. `settings.kernel`.
+        """
+        return pulumi.get(self, "kernel")
+
+    @kernel.setter
+    def kernel(self, value: Optional[pulumi.Input['BottlerocketKernelSettingsArgs']]):
+        pulumi.set(self, "kernel", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def kubernetes(self) -> Optional[pulumi.Input['BottlerocketKubernetesSettingsArgs']]:
+        """
+        Settings of the kubelet, i.e. `settings.kubernetes`.
+        """
+        return pulumi.get(self, "kubernetes")
+
+    @kubernetes.setter
+    def kubernetes(self, value: Optional[pulumi.Input['BottlerocketKubernetesSettingsArgs']]):
+        pulumi.set(self, "kubernetes", value)
+
+
+class BottlerocketContainerRegistryArgsDict(TypedDict):
+    """
+    Settings of the container registries of Bottlerocket nodes.
+    """
+    mirrors: NotRequired[pulumi.Input[Sequence[pulumi.Input['BottlerocketRegistryMirrorArgsDict']]]]
+    """
+    Mirrors to pull images from instead of the original registries.
+    """
+
+@pulumi.input_type
+class BottlerocketContainerRegistryArgs:
+    def __init__(__self__, *,
+                 mirrors: Optional[pulumi.Input[Sequence[pulumi.Input['BottlerocketRegistryMirrorArgs']]]] = None):
+        """
+        Settings of the container registries of Bottlerocket nodes.
+        :param pulumi.Input[Sequence[pulumi.Input['BottlerocketRegistryMirrorArgs']]] mirrors: Mirrors to pull images from instead of the original registries.
+        """
+        if mirrors is not None:
+            pulumi.set(__self__, "mirrors", mirrors)
+
+    @_builtins.property
+    @pulumi.getter
+    def mirrors(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['BottlerocketRegistryMirrorArgs']]]]:
+        """
+        Mirrors to pull images from instead of the original registries.
+        """
+        return pulumi.get(self, "mirrors")
+
+    @mirrors.setter
+    def mirrors(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['BottlerocketRegistryMirrorArgs']]]]):
+        pulumi.set(self, "mirrors", value)
+
+
+class BottlerocketHostContainersArgsDict(TypedDict):
+    """
+    Settings of the Bottlerocket host containers.
+    """
+    admin: NotRequired[pulumi.Input['BottlerocketHostContainerArgsDict']]
+    """
+    The admin container, used for troubleshooting the node.
+    """
+    control: NotRequired[pulumi.Input['BottlerocketHostContainerArgsDict']]
+    """
+    The control container, used to access the Bottlerocket API through AWS SSM.
+    """
+
+@pulumi.input_type
+class BottlerocketHostContainersArgs:
+    def __init__(__self__, *,
+                 admin: Optional[pulumi.Input['BottlerocketHostContainerArgs']] = None,
+                 control: Optional[pulumi.Input['BottlerocketHostContainerArgs']] = None):
+        """
+        Settings of the Bottlerocket host containers.
+        :param pulumi.Input['BottlerocketHostContainerArgs'] admin: The admin container, used for troubleshooting the node.
+        :param pulumi.Input['BottlerocketHostContainerArgs'] control: The control container, used to access the Bottlerocket API through AWS SSM.
+        """
+        if admin is not None:
+            pulumi.set(__self__, "admin", admin)
+        if control is not None:
+            pulumi.set(__self__, "control", control)
+
+    @_builtins.property
+    @pulumi.getter
+    def admin(self) -> Optional[pulumi.Input['BottlerocketHostContainerArgs']]:
+        """
+        The admin container, used for troubleshooting the node.
+        """
+        return pulumi.get(self, "admin")
+
+    @admin.setter
+    def admin(self, value: Optional[pulumi.Input['BottlerocketHostContainerArgs']]):
+        pulumi.set(self, "admin", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def control(self) -> Optional[pulumi.Input['BottlerocketHostContainerArgs']]:
+        """
+        The control container, used to access the Bottlerocket API through AWS SSM.
+        """
+        return pulumi.get(self, "control")
+
+    @control.setter
+    def control(self, value: Optional[pulumi.Input['BottlerocketHostContainerArgs']]):
+        pulumi.set(self, "control", value)
+
+
+class BottlerocketHostContainerArgsDict(TypedDict):
+    """
+    Settings of a Bottlerocket host container.
+    """
+    enabled: NotRequired[pulumi.Input[_builtins.bool]]
+    """
+    Whether the host container runs.
+    """
+    source: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    The image of the host container.
+    """
+    superpowered: NotRequired[pulumi.Input[_builtins.bool]]
+    """
+    Whether the host container has elevated privileges.
+    """
+    user_data: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    Base64 encoded user data passed to the host container.
+    """
+
+@pulumi.input_type
+class BottlerocketHostContainerArgs:
+    def __init__(__self__, *,
+                 enabled: Optional[pulumi.Input[_builtins.bool]] = None,
+                 source: Optional[pulumi.Input[_builtins.str]] = None,
+                 superpowered: Optional[pulumi.Input[_builtins.bool]] = None,
+                 user_data: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        Settings of a Bottlerocket host container.
+        :param pulumi.Input[_builtins.bool] enabled: Whether the host container runs.
+        :param pulumi.Input[_builtins.str] source: The image of the host container.
+        :param pulumi.Input[_builtins.bool] superpowered: Whether the host container has elevated privileges.
+        :param pulumi.Input[_builtins.str] user_data: Base64 encoded user data passed to the host container.
+        """
+        if enabled is not None:
+            pulumi.set(__self__, "enabled", enabled)
+        if source is not None:
+            pulumi.set(__self__, "source", source)
+        if superpowered is not None:
+            pulumi.set(__self__, "superpowered", superpowered)
+        if user_data is not None:
+            pulumi.set(__self__, "user_data", user_data)
+
+    @_builtins.property
+    @pulumi.getter
+    def enabled(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        Whether the host container runs.
+        """
+        return pulumi.get(self, "enabled")
+
+    @enabled.setter
+    def enabled(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "enabled", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def source(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The image of the host container.
+        """
+        return pulumi.get(self, "source")
+
+    @source.setter
+    def source(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "source", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def superpowered(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        Whether the host container has elevated privileges.
+        """
+        return pulumi.get(self, "superpowered")
+
+    @superpowered.setter
+    def superpowered(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "superpowered", value)
+
+    @_builtins.property
+    @pulumi.getter(name="userData")
+    def user_data(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        Base64 encoded user data passed to the host container.
+        """
+        return pulumi.get(self, "user_data")
+
+    @user_data.setter
+    def user_data(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "user_data", value)
+
+
+class BottlerocketKernelSettingsArgsDict(TypedDict):
+    """
+    Settings of the Bottlerocket kernel.
+    """
+    lockdown: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+    """
+    sysctl: NotRequired[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]
+    """
+    Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: "1"`.
+    """
+
+@pulumi.input_type
+class BottlerocketKernelSettingsArgs:
+    def __init__(__self__, *,
+                 lockdown: Optional[pulumi.Input[_builtins.str]] = None,
+                 sysctl: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None):
+        """
+        Settings of the Bottlerocket kernel.
+        :param pulumi.Input[_builtins.str] lockdown: The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] sysctl: Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: "1"`.
+        """
+        if lockdown is not None:
+            pulumi.set(__self__, "lockdown", lockdown)
+        if sysctl is not None:
+            pulumi.set(__self__, "sysctl", sysctl)
+
+    @_builtins.property
+    @pulumi.getter
+    def lockdown(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+        """
+        return pulumi.get(self, "lockdown")
+
+    @lockdown.setter
+    def lockdown(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "lockdown", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def sysctl(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: "1"`.
+        """
+        return pulumi.get(self, "sysctl")
+
+    @sysctl.setter
+    def sysctl(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "sysctl", value)
+
+
+class BottlerocketKubernetesSettingsArgsDict(TypedDict):
+    """
+    Settings of the kubelet on Bottlerocket nodes.
+    """
+    eviction_hard: NotRequired[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]
+    """
+    Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+    """
+    eviction_soft: NotRequired[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]
+    """
+    Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+    """
+    eviction_soft_grace_period: NotRequired[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]
+    """
+    Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+    """
+    image_gc_high_threshold_percent: NotRequired[pulumi.Input[_builtins.int]]
+    """
+    The disk usage in percent after which image garbage collection always runs.
+    """
+    image_gc_low_threshold_percent: NotRequired[pulumi.Input[_builtins.int]]
+    """
+    The disk usage in percent before which image garbage collection never runs.
+    """
+    max_pods: NotRequired[pulumi.Input[_builtins.int]]
+    """
+    The maximum number of pods the kubelet runs on the node.
+    """
+    node_labels: NotRequired[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]
+    """
+    Labels the node registers with.
+    """
+    node_taints: NotRequired[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]
+    """
+    Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+    """
+
+@pulumi.input_type
+class BottlerocketKubernetesSettingsArgs:
+    def __init__(__self__, *,
+                 eviction_hard: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 eviction_soft: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 eviction_soft_grace_period: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 image_gc_high_threshold_percent: Optional[pulumi.Input[_builtins.int]] = None,
+                 image_gc_low_threshold_percent: Optional[pulumi.Input[_builtins.int]] = None,
+                 max_pods: Optional[pulumi.Input[_builtins.int]] = None,
+                 node_labels: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 node_taints: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None):
+        """
+        Settings of the kubelet on Bottlerocket nodes.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] eviction_hard: Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] eviction_soft: Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] eviction_soft_grace_period: Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+        :param pulumi.Input[_builtins.int] image_gc_high_threshold_percent: The disk usage in percent after which image garbage collection always runs.
+        :param pulumi.Input[_builtins.int] image_gc_low_threshold_percent: The disk usage in percent before which image garbage collection never runs.
+        :param pulumi.Input[_builtins.int] max_pods: The maximum number of pods the kubelet runs on the node.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] node_labels: Labels the node registers with.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] node_taints: Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+        """
+        if eviction_hard is not None:
+            pulumi.set(__self__, "eviction_hard", eviction_hard)
+        if eviction_soft is not None:
+            pulumi.set(__self__, "eviction_soft", eviction_soft)
+        if eviction_soft_grace_period is not None:
+            pulumi.set(__self__, "eviction_soft_grace_period", eviction_soft_grace_period)
+        if image_gc_high_threshold_percent is not None:
+            pulumi.set(__self__, "image_gc_high_threshold_percent", image_gc_high_threshold_percent)
+        if image_gc_low_threshold_percent is not None:
+            pulumi.set(__self__, "image_gc_low_threshold_percent", image_gc_low_threshold_percent)
+        if max_pods is not None:
+            pulumi.set(__self__, "max_pods", max_pods)
+        if node_labels is not None:
+            pulumi.set(__self__, "node_labels", node_labels)
+        if node_taints is not None:
+            pulumi.set(__self__, "node_taints", node_taints)
+
+    @_builtins.property
+    @pulumi.getter(name="evictionHard")
+    def eviction_hard(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+        """
+        return pulumi.get(self, "eviction_hard")
+
+    @eviction_hard.setter
+    def eviction_hard(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "eviction_hard", value)
+
+    @_builtins.property
+    @pulumi.getter(name="evictionSoft")
+    def eviction_soft(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+        """
+        return pulumi.get(self, "eviction_soft")
+
+    @eviction_soft.setter
+    def eviction_soft(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "eviction_soft", value)
+
+    @_builtins.property
+    @pulumi.getter(name="evictionSoftGracePeriod")
+    def eviction_soft_grace_period(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+        """
+        return pulumi.get(self, "eviction_soft_grace_period")
+
+    @eviction_soft_grace_period.setter
+    def eviction_soft_grace_period(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "eviction_soft_grace_period", value)
+
+    @_builtins.property
+    @pulumi.getter(name="imageGcHighThresholdPercent")
+    def image_gc_high_threshold_percent(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The disk usage in percent after which image garbage collection always runs.
+        """
+        return pulumi.get(self, "image_gc_high_threshold_percent")
+
+    @image_gc_high_threshold_percent.setter
+    def image_gc_high_threshold_percent(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "image_gc_high_threshold_percent", value)
+
+    @_builtins.property
+    @pulumi.getter(name="imageGcLowThresholdPercent")
+    def image_gc_low_threshold_percent(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The disk usage in percent before which image garbage collection never runs.
+        """
+        return pulumi.get(self, "image_gc_low_threshold_percent")
+
+    @image_gc_low_threshold_percent.setter
+    def image_gc_low_threshold_percent(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "image_gc_low_threshold_percent", value)
+
+    @_builtins.property
+    @pulumi.getter(name="maxPods")
+    def max_pods(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The maximum number of pods the kubelet runs on the node.
+        """
+        return pulumi.get(self, "max_pods")
+
+    @max_pods.setter
+    def max_pods(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "max_pods", value)
+
+    @_builtins.property
+    @pulumi.getter(name="nodeLabels")
+    def node_labels(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        Labels the node registers with.
+        """
+        return pulumi.get(self, "node_labels")
+
+    @node_labels.setter
+    def node_labels(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "node_labels", value)
+
+    @_builtins.property
+    @pulumi.getter(name="nodeTaints")
+    def node_taints(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+        """
+        return pulumi.get(self, "node_taints")
+
+    @node_taints.setter
+    def node_taints(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "node_taints", value)
+
+
+class BottlerocketRegistryMirrorArgsDict(TypedDict):
     """
-    Whether to enable EKS Auto Mode. If enabled, EKS will manage node pools, EBS volumes and Load Balancers for you.
-    When enabled, the vpc-cni and kube-proxy will not be enabled by default because EKS Auto Mode includes pod networking capabilities.
+    A mirror of a container registry.
     """
-    compute_config: NotRequired[pulumi.Input['ClusterComputeConfigArgsDict']]
+    endpoints: pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]
     """
-    Compute configuration for EKS Auto Mode.
+    The endpoints of the mirror, in order of preference.
     """
-    create_node_role: NotRequired[_builtins.bool]
+    registry: pulumi.Input[_builtins.str]
     """
-    Whether to create an IAM role for the EKS Auto Mode node group if none is provided in `computeConfig`.
+    The registry to mirror, e.g. `docker.io`.
     """
 
 @pulumi.input_type
-class AutoModeOptionsArgs:
+class BottlerocketRegistryMirrorArgs:
     def __init__(__self__, *,
-                 enabled: _builtins.bool,
-                 compute_config: Optional[pulumi.Input['ClusterComputeConfigArgs']] = None,
-                 create_node_role: Optional[_builtins.bool] = None):
+                 endpoints: pulumi.Input[Sequence[pulumi.Input[_builtins.str]]],
+                 registry: pulumi.Input[_builtins.str]):
         """
-        Configuration Options for EKS Auto Mode. If EKS Auto Mode is enabled, AWS will manage cluster infrastructure on your behalf.
-
-        For more information, see: https://docs.aws.amazon.com/eks/latest/userguide/automode.html
-        :param _builtins.bool enabled: Whether to enable EKS Auto Mode. If enabled, EKS will manage node pools, EBS volumes and Load Balancers for you.
-               When enabled, the vpc-cni and kube-proxy will not be enabled by default because EKS Auto Mode includes pod networking capabilities.
-        :param pulumi.Input['ClusterComputeConfigArgs'] compute_config: Compute configuration for EKS Auto Mode.
-        :param _builtins.bool create_node_role: Whether to create an IAM role for the EKS Auto Mode node group if none is provided in `computeConfig`.
+        A mirror of a container registry.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] endpoints: The endpoints of the mirror, in order of preference.
+        :param pulumi.Input[_builtins.str] registry: The registry to mirror, e.g. `docker.io`.
         """
-        pulumi.set(__self__, "enabled", enabled)
-        if compute_config is not None:
-            pulumi.set(__self__, "compute_config", compute_config)
-        if create_node_role is None:
-            create_node_role = True
-        if create_node_role is not None:
-            pulumi.set(__self__, "create_node_role", create_node_role)
+        pulumi.set(__self__, "endpoints", endpoints)
+        pulumi.set(__self__, "registry", registry)
 
     @_builtins.property
     @pulumi.getter
-    def enabled(self) -> _builtins.bool:
-        """
-        Whether to enable EKS Auto Mode. If enabled, EKS will manage node pools, EBS volumes and Load Balancers for you.
-        When enabled, the vpc-cni and kube-proxy will not be enabled by default because EKS Auto Mode includes pod networking capabilities.
-        """
-        return pulumi.get(self, "enabled")
-
-    @enabled.setter
-    def enabled(self, value: _builtins.bool):
-        pulumi.set(self, "enabled", value)
-
-    @_builtins.property
-    @pulumi.getter(name="computeConfig")
-    def compute_config(self) -> Optional[pulumi.Input['ClusterComputeConfigArgs']]:
+    def endpoints(self) -> pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]:
         """
-        Compute configuration for EKS Auto Mode.
+        The endpoints of the mirror, in order of preference.
         """
-        return pulumi.get(self, "compute_config")
+        return pulumi.get(self, "endpoints")
 
-    @compute_config.setter
-    def compute_config(self, value: Optional[pulumi.Input['ClusterComputeConfigArgs']]):
-        pulumi.set(self, "compute_config", value)
+    @endpoints.setter
+    def endpoints(self, value: pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]):
+        pulumi.set(self, "endpoints", value)
 
     @_builtins.property
-    @pulumi.getter(name="createNodeRole")
-    def create_node_role(self) -> Optional[_builtins.bool]:
+    @pulumi.getter
+    def registry(self) -> pulumi.Input[_builtins.str]:
         """
-        Whether to create an IAM role for the EKS Auto Mode node group if none is provided in `computeConfig`.
+        The registry to mirror, e.g. `docker.io`.
         """
-        return pulumi.get(self, "create_node_role")
+        return pulumi.get(self, "registry")
 
-    @create_node_role.setter
-    def create_node_role(self, value: Optional[_builtins.bool]):
-        pulumi.set(self, "create_node_role", value)
+    @registry.setter
+    def registry(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "registry", value)
 
 
 class ClusterComputeConfigArgsDict(TypedDict):
@@ -424,10 +1553,21 @@ class ClusterNodeGroupOptionsArgsDict(TypedDict):
 
     Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
     """
+    autoscaler_discovery: NotRequired[_builtins.bool]
+    """
+    Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+
+    For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+    """
     bootstrap_extra_args: NotRequired[pulumi.Input[_builtins.str]]
     """
     Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
     """
+    bottlerocket_config: NotRequired[pulumi.Input['BottlerocketConfigArgsDict']]
+    """
+    Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+    The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+    """
     bottlerocket_settings: NotRequired[pulumi.Input[Mapping[str, Any]]]
     """
     The configuration settings for Bottlerocket OS.
@@ -509,6 +1649,12 @@ class ClusterNodeGroupOptionsArgsDict(TypedDict):
     """
     The instance type to use for the cluster's nodes. Defaults to "t3.medium".
     """
+    interruption_handling: NotRequired['InterruptionHandlingArgsDict']
+    """
+    Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+
+    See for more details: https://github.com/aws/aws-node-termination-handler
+    """
     key_name: NotRequired[pulumi.Input[_builtins.str]]
     """
     Name of the key pair to use for SSH access to worker nodes.
@@ -541,6 +1687,13 @@ class ClusterNodeGroupOptionsArgsDict(TypedDict):
     """
     Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
     """
+    node_config: NotRequired[pulumi.Input['NodeConfigArgsDict']]
+    """
+    Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+
+    Note: This is only applicable when using AL2023.
+    See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+    """
     node_public_key: NotRequired[pulumi.Input[_builtins.str]]
     """
     Public key material for SSH access to worker nodes. See allowed formats at:
@@ -649,7 +1802,9 @@ class ClusterNodeGroupOptionsArgs:
                  ami_id: Optional[pulumi.Input[_builtins.str]] = None,
                  ami_type: Optional[pulumi.Input[_builtins.str]] = None,
                  auto_scaling_group_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 autoscaler_discovery: Optional[_builtins.bool] = None,
                  bootstrap_extra_args: Optional[pulumi.Input[_builtins.str]] = None,
+                 bottlerocket_config: Optional[pulumi.Input['BottlerocketConfigArgs']] = None,
                  bottlerocket_settings: Optional[pulumi.Input[Mapping[str, Any]]] = None,
                  cloud_formation_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
                  cluster_ingress_rule: Optional[pulumi.Input['pulumi_aws.ec2.SecurityGroupRule']] = None,
@@ -663,6 +1818,7 @@ class ClusterNodeGroupOptionsArgs:
                  instance_profile: Optional['pulumi_aws.iam.InstanceProfile'] = None,
                  instance_profile_name: Optional[pulumi.Input[_builtins.str]] = None,
                  instance_type: Optional[pulumi.Input[_builtins.str]] = None,
+                 interruption_handling: Optional['InterruptionHandlingArgs'] = None,
                  key_name: Optional[pulumi.Input[_builtins.str]] = None,
                  kubelet_extra_args: Optional[pulumi.Input[_builtins.str]] = None,
                  labels: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
@@ -671,6 +1827,7 @@ class ClusterNodeGroupOptionsArgs:
                  min_refresh_percentage: Optional[pulumi.Input[_builtins.int]] = None,
                  min_size: Optional[pulumi.Input[_builtins.int]] = None,
                  node_associate_public_ip_address: Optional[pulumi.Input[_builtins.bool]] = None,
+                 node_config: Optional[pulumi.Input['NodeConfigArgs']] = None,
                  node_public_key: Optional[pulumi.Input[_builtins.str]] = None,
                  node_root_volume_delete_on_termination: Optional[pulumi.Input[_builtins.bool]] = None,
                  node_root_volume_encrypted: Optional[pulumi.Input[_builtins.bool]] = None,
@@ -708,7 +1865,12 @@ class ClusterNodeGroupOptionsArgs:
                Per AWS, all stack-level tags, including automatically created tags, and the `cloudFormationTags` option are propagated to resources that AWS CloudFormation supports, including the AutoScalingGroup. See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-resource-tags.html
                
                Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
+        :param _builtins.bool autoscaler_discovery: Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+               
+               For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
         :param pulumi.Input[_builtins.str] bootstrap_extra_args: Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
+        :param pulumi.Input['BottlerocketConfigArgs'] bottlerocket_config: Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+               The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
         :param pulumi.Input[Mapping[str, Any]] bottlerocket_settings: The configuration settings for Bottlerocket OS.
                The settings will get merged with the base settings the provider uses to configure Bottlerocket.
                
@@ -751,6 +1913,9 @@ class ClusterNodeGroupOptionsArgs:
         :param 'pulumi_aws.iam.InstanceProfile' instance_profile: The IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
         :param pulumi.Input[_builtins.str] instance_profile_name: The name of the IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
         :param pulumi.Input[_builtins.str] instance_type: The instance type to use for the cluster's nodes. Defaults to "t3.medium".
+        :param 'InterruptionHandlingArgs' interruption_handling: Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+               
+               See for more details: https://github.com/aws/aws-node-termination-handler
         :param pulumi.Input[_builtins.str] key_name: Name of the key pair to use for SSH access to worker nodes.
         :param pulumi.Input[_builtins.str] kubelet_extra_args: Extra args to pass to the Kubelet. Corresponds to the options passed in the `--kubeletExtraArgs` flag to `/etc/eks/bootstrap.sh`. For example, '--port=10251 --address=0.0.0.0'. Note that the `labels` and `taints` properties will be applied to this list (using `--node-labels` and `--register-with-taints` respectively) after to the explicit `kubeletExtraArgs`.
         :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] labels: Custom k8s node labels to be attached to each worker node. Adds the given key/value pairs to the `--node-labels` kubelet argument.
@@ -759,6 +1924,10 @@ class ClusterNodeGroupOptionsArgs:
         :param pulumi.Input[_builtins.int] min_refresh_percentage: The minimum amount of instances that should remain available during an instance refresh, expressed as a percentage. Defaults to 50.
         :param pulumi.Input[_builtins.int] min_size: The minimum number of worker nodes running in the cluster. Defaults to 1.
         :param pulumi.Input[_builtins.bool] node_associate_public_ip_address: Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
+        :param pulumi.Input['NodeConfigArgs'] node_config: Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+               
+               Note: This is only applicable when using AL2023.
+               See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
         :param pulumi.Input[_builtins.str] node_public_key: Public key material for SSH access to worker nodes. See allowed formats at:
                https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
                If not provided, no SSH access is enabled on VMs.
@@ -816,8 +1985,12 @@ class ClusterNodeGroupOptionsArgs:
             pulumi.set(__self__, "ami_type", ami_type)
         if auto_scaling_group_tags is not None:
             pulumi.set(__self__, "auto_scaling_group_tags", auto_scaling_group_tags)
+        if autoscaler_discovery is not None:
+            pulumi.set(__self__, "autoscaler_discovery", autoscaler_discovery)
         if bootstrap_extra_args is not None:
             pulumi.set(__self__, "bootstrap_extra_args", bootstrap_extra_args)
+        if bottlerocket_config is not None:
+            pulumi.set(__self__, "bottlerocket_config", bottlerocket_config)
         if bottlerocket_settings is not None:
             pulumi.set(__self__, "bottlerocket_settings", bottlerocket_settings)
         if cloud_formation_tags is not None:
@@ -844,6 +2017,8 @@ class ClusterNodeGroupOptionsArgs:
             pulumi.set(__self__, "instance_profile_name", instance_profile_name)
         if instance_type is not None:
             pulumi.set(__self__, "instance_type", instance_type)
+        if interruption_handling is not None:
+            pulumi.set(__self__, "interruption_handling", interruption_handling)
         if key_name is not None:
             pulumi.set(__self__, "key_name", key_name)
         if kubelet_extra_args is not None:
@@ -860,6 +2035,8 @@ class ClusterNodeGroupOptionsArgs:
             pulumi.set(__self__, "min_size", min_size)
         if node_associate_public_ip_address is not None:
             pulumi.set(__self__, "node_associate_public_ip_address", node_associate_public_ip_address)
+        if node_config is not None:
+            pulumi.set(__self__, "node_config", node_config)
         if node_public_key is not None:
             pulumi.set(__self__, "node_public_key", node_public_key)
         if node_root_volume_delete_on_termination is not None:
@@ -946,6 +2123,20 @@ class ClusterNodeGroupOptionsArgs:
     def auto_scaling_group_tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
         pulumi.set(self, "auto_scaling_group_tags", value)
 
+    @_builtins.property
+    @pulumi.getter(name="autoscalerDiscovery")
+    def autoscaler_discovery(self) -> Optional[_builtins.bool]:
+        """
+        Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+
+        For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+        """
+        return pulumi.get(self, "autoscaler_discovery")
+
+    @autoscaler_discovery.setter
+    def autoscaler_discovery(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "autoscaler_discovery", value)
+
     @_builtins.property
     @pulumi.getter(name="bootstrapExtraArgs")
     def bootstrap_extra_args(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -958,6 +2149,19 @@ class ClusterNodeGroupOptionsArgs:
     def bootstrap_extra_args(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "bootstrap_extra_args", value)
 
+    @_builtins.property
+    @pulumi.getter(name="bottlerocketConfig")
+    def bottlerocket_config(self) -> Optional[pulumi.Input['BottlerocketConfigArgs']]:
+        """
+        Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+        The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+        """
+        return pulumi.get(self, "bottlerocket_config")
+
+    @bottlerocket_config.setter
+    def bottlerocket_config(self, value: Optional[pulumi.Input['BottlerocketConfigArgs']]):
+        pulumi.set(self, "bottlerocket_config", value)
+
     @_builtins.property
     @pulumi.getter(name="bottlerocketSettings")
     def bottlerocket_settings(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
@@ -1143,6 +2347,20 @@ class ClusterNodeGroupOptionsArgs:
     def instance_type(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "instance_type", value)
 
+    @_builtins.property
+    @pulumi.getter(name="interruptionHandling")
+    def interruption_handling(self) -> Optional['InterruptionHandlingArgs']:
+        """
+        Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+
+        See for more details: https://github.com/aws/aws-node-termination-handler
+        """
+        return pulumi.get(self, "interruption_handling")
+
+    @interruption_handling.setter
+    def interruption_handling(self, value: Optional['InterruptionHandlingArgs']):
+        pulumi.set(self, "interruption_handling", value)
+
     @_builtins.property
     @pulumi.getter(name="keyName")
     def key_name(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -1239,6 +2457,21 @@ class ClusterNodeGroupOptionsArgs:
     def node_associate_public_ip_address(self, value: Optional[pulumi.Input[_builtins.bool]]):
         pulumi.set(self, "node_associate_public_ip_address", value)
 
+    @_builtins.property
+    @pulumi.getter(name="nodeConfig")
+    def node_config(self) -> Optional[pulumi.Input['NodeConfigArgs']]:
+        """
+        Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+
+        Note: This is only applicable when using AL2023.
+        See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+        """
+        return pulumi.get(self, "node_config")
+
+    @node_config.setter
+    def node_config(self, value: Optional[pulumi.Input['NodeConfigArgs']]):
+        pulumi.set(self, "node_config", value)
+
     @_builtins.property
     @pulumi.getter(name="nodePublicKey")
     def node_public_key(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -1512,7 +2745,15 @@ class CoreDataArgsDict(TypedDict):
     The access entries added to the cluster.
     """
     aws_provider: NotRequired[pulumi.Input['pulumi_aws.Provider']]
+    cluster_log_group: NotRequired[pulumi.Input['pulumi_aws.cloudwatch.LogGroup']]
+    """
+    The log group of the control plane logs, if it is managed by the cluster.
+    """
     cluster_security_group: NotRequired[pulumi.Input['pulumi_aws.ec2.SecurityGroup']]
+    control_plane_scaling_config: NotRequired[pulumi.Input['pulumi_aws.eks.ClusterControlPlaneScalingConfigArgsDict']]
+    """
+    The scaling configuration of the control plane, including its provisioned tier.
+    """
     eks_node_access: NotRequired[pulumi.Input['pulumi_kubernetes.core.v1.ConfigMap']]
     encryption_config: NotRequired[pulumi.Input['pulumi_aws.eks.ClusterEncryptionConfigArgsDict']]
     fargate_profile: NotRequired[pulumi.Input['pulumi_aws.eks.FargateProfile']]
@@ -1544,10 +2785,22 @@ class CoreDataArgsDict(TypedDict):
     """
     A map of tags assigned to the EKS cluster.
     """
+    upgrade_policy: NotRequired[pulumi.Input['pulumi_aws.eks.ClusterUpgradePolicyArgsDict']]
+    """
+    The upgrade policy of the cluster, which determines whether it gets extended support.
+    """
     vpc_cni: NotRequired[pulumi.Input['VpcCniAddon']]
     """
     The VPC CNI for the cluster.
     """
+    vpc_cni_networking: NotRequired[pulumi.Input['VpcCniNetworkingArgsDict']]
+    """
+    The VPC CNI settings that affect the maximum number of pods of the nodes.
+    """
+    zonal_shift_config: NotRequired[pulumi.Input['pulumi_aws.eks.ClusterZonalShiftConfigArgsDict']]
+    """
+    The zonal shift configuration of the cluster.
+    """
 
 @pulumi.input_type
 class CoreDataArgs:
@@ -1562,7 +2815,9 @@ class CoreDataArgs:
                  vpc_id: pulumi.Input[_builtins.str],
                  access_entries: Optional[pulumi.Input[Sequence[pulumi.Input['AccessEntryArgs']]]] = None,
                  aws_provider: Optional[pulumi.Input['pulumi_aws.Provider']] = None,
+                 cluster_log_group: Optional[pulumi.Input['pulumi_aws.cloudwatch.LogGroup']] = None,
                  cluster_security_group: Optional[pulumi.Input['pulumi_aws.ec2.SecurityGroup']] = None,
+                 control_plane_scaling_config: Optional[pulumi.Input['pulumi_aws.eks.ClusterControlPlaneScalingConfigArgs']] = None,
                  eks_node_access: Optional[pulumi.Input['pulumi_kubernetes.core.v1.ConfigMap']] = None,
                  encryption_config: Optional[pulumi.Input['pulumi_aws.eks.ClusterEncryptionConfigArgs']] = None,
                  fargate_profile: Optional[pulumi.Input['pulumi_aws.eks.FargateProfile']] = None,
@@ -1573,7 +2828,10 @@ class CoreDataArgs:
                  public_subnet_ids: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
                  storage_classes: Optional[pulumi.Input[Mapping[str, pulumi.Input['pulumi_kubernetes.storage.v1.StorageClass']]]] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
-                 vpc_cni: Optional[pulumi.Input['VpcCniAddon']] = None):
+                 upgrade_policy: Optional[pulumi.Input['pulumi_aws.eks.ClusterUpgradePolicyArgs']] = None,
+                 vpc_cni: Optional[pulumi.Input['VpcCniAddon']] = None,
+                 vpc_cni_networking: Optional[pulumi.Input['VpcCniNetworkingArgs']] = None,
+                 zonal_shift_config: Optional[pulumi.Input['pulumi_aws.eks.ClusterZonalShiftConfigArgs']] = None):
         """
         Defines the core set of data associated with an EKS cluster, including the network in which it runs.
         :param pulumi.Input['pulumi_aws.iam.Role'] cluster_iam_role: The IAM Role attached to the EKS Cluster
@@ -1583,6 +2841,8 @@ class CoreDataArgs:
         :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] subnet_ids: List of subnet IDs for the EKS cluster.
         :param pulumi.Input[_builtins.str] vpc_id: ID of the cluster's VPC.
         :param pulumi.Input[Sequence[pulumi.Input['AccessEntryArgs']]] access_entries: The access entries added to the cluster.
+        :param pulumi.Input['pulumi_aws.cloudwatch.LogGroup'] cluster_log_group: The log group of the control plane logs, if it is managed by the cluster.
+        :param pulumi.Input['pulumi_aws.eks.ClusterControlPlaneScalingConfigArgs'] control_plane_scaling_config: The scaling configuration of the control plane, including its provisioned tier.
         :param pulumi.Input['pulumi_aws.eks.FargateProfile'] fargate_profile: The Fargate profile used to manage which pods run on Fargate.
         :param Any kubeconfig: The kubeconfig file for the cluster.
         :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] node_security_group_tags: Tags attached to the security groups associated with the cluster's worker nodes.
@@ -1590,7 +2850,10 @@ class CoreDataArgs:
         :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] public_subnet_ids: List of subnet IDs for the public subnets.
         :param pulumi.Input[Mapping[str, pulumi.Input['pulumi_kubernetes.storage.v1.StorageClass']]] storage_classes: The storage class used for persistent storage by the cluster.
         :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] tags: A map of tags assigned to the EKS cluster.
+        :param pulumi.Input['pulumi_aws.eks.ClusterUpgradePolicyArgs'] upgrade_policy: The upgrade policy of the cluster, which determines whether it gets extended support.
         :param pulumi.Input['VpcCniAddon'] vpc_cni: The VPC CNI for the cluster.
+        :param pulumi.Input['VpcCniNetworkingArgs'] vpc_cni_networking: The VPC CNI settings that affect the maximum number of pods of the nodes.
+        :param pulumi.Input['pulumi_aws.eks.ClusterZonalShiftConfigArgs'] zonal_shift_config: The zonal shift configuration of the cluster.
         """
         pulumi.set(__self__, "cluster", cluster)
         pulumi.set(__self__, "cluster_iam_role", cluster_iam_role)
@@ -1604,8 +2867,12 @@ class CoreDataArgs:
             pulumi.set(__self__, "access_entries", access_entries)
         if aws_provider is not None:
             pulumi.set(__self__, "aws_provider", aws_provider)
+        if cluster_log_group is not None:
+            pulumi.set(__self__, "cluster_log_group", cluster_log_group)
         if cluster_security_group is not None:
             pulumi.set(__self__, "cluster_security_group", cluster_security_group)
+        if control_plane_scaling_config is not None:
+            pulumi.set(__self__, "control_plane_scaling_config", control_plane_scaling_config)
         if eks_node_access is not None:
             pulumi.set(__self__, "eks_node_access", eks_node_access)
         if encryption_config is not None:
@@ -1626,8 +2893,14 @@ class CoreDataArgs:
             pulumi.set(__self__, "storage_classes", storage_classes)
         if tags is not None:
             pulumi.set(__self__, "tags", tags)
+        if upgrade_policy is not None:
+            pulumi.set(__self__, "upgrade_policy", upgrade_policy)
         if vpc_cni is not None:
             pulumi.set(__self__, "vpc_cni", vpc_cni)
+        if vpc_cni_networking is not None:
+            pulumi.set(__self__, "vpc_cni_networking", vpc_cni_networking)
+        if zonal_shift_config is not None:
+            pulumi.set(__self__, "zonal_shift_config", zonal_shift_config)
 
     @_builtins.property
     @pulumi.getter
@@ -1740,6 +3013,18 @@ class CoreDataArgs:
     def aws_provider(self, value: Optional[pulumi.Input['pulumi_aws.Provider']]):
         pulumi.set(self, "aws_provider", value)
 
+    @_builtins.property
+    @pulumi.getter(name="clusterLogGroup")
+    def cluster_log_group(self) -> Optional[pulumi.Input['pulumi_aws.cloudwatch.LogGroup']]:
+        """
+        The log group of the control plane logs, if it is managed by the cluster.
+        """
+        return pulumi.get(self, "cluster_log_group")
+
+    @cluster_log_group.setter
+    def cluster_log_group(self, value: Optional[pulumi.Input['pulumi_aws.cloudwatch.LogGroup']]):
+        pulumi.set(self, "cluster_log_group", value)
+
     @_builtins.property
     @pulumi.getter(name="clusterSecurityGroup")
     def cluster_security_group(self) -> Optional[pulumi.Input['pulumi_aws.ec2.SecurityGroup']]:
@@ -1749,6 +3034,18 @@ class CoreDataArgs:
     def cluster_security_group(self, value: Optional[pulumi.Input['pulumi_aws.ec2.SecurityGroup']]):
         pulumi.set(self, "cluster_security_group", value)
 
+    @_builtins.property
+    @pulumi.getter(name="controlPlaneScalingConfig")
+    def control_plane_scaling_config(self) -> Optional[pulumi.Input['pulumi_aws.eks.ClusterControlPlaneScalingConfigArgs']]:
+        """
+        The scaling configuration of the control plane, including its provisioned tier.
+        """
+        return pulumi.get(self, "control_plane_scaling_config")
+
+    @control_plane_scaling_config.setter
+    def control_plane_scaling_config(self, value: Optional[pulumi.Input['pulumi_aws.eks.ClusterControlPlaneScalingConfigArgs']]):
+        pulumi.set(self, "control_plane_scaling_config", value)
+
     @_builtins.property
     @pulumi.getter(name="eksNodeAccess")
     def eks_node_access(self) -> Optional[pulumi.Input['pulumi_kubernetes.core.v1.ConfigMap']]:
@@ -1860,6 +3157,18 @@ class CoreDataArgs:
     def tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
         pulumi.set(self, "tags", value)
 
+    @_builtins.property
+    @pulumi.getter(name="upgradePolicy")
+    def upgrade_policy(self) -> Optional[pulumi.Input['pulumi_aws.eks.ClusterUpgradePolicyArgs']]:
+        """
+        The upgrade policy of the cluster, which determines whether it gets extended support.
+        """
+        return pulumi.get(self, "upgrade_policy")
+
+    @upgrade_policy.setter
+    def upgrade_policy(self, value: Optional[pulumi.Input['pulumi_aws.eks.ClusterUpgradePolicyArgs']]):
+        pulumi.set(self, "upgrade_policy", value)
+
     @_builtins.property
     @pulumi.getter(name="vpcCni")
     def vpc_cni(self) -> Optional[pulumi.Input['VpcCniAddon']]:
@@ -1872,6 +3181,30 @@ class CoreDataArgs:
     def vpc_cni(self, value: Optional[pulumi.Input['VpcCniAddon']]):
         pulumi.set(self, "vpc_cni", value)
 
+    @_builtins.property
+    @pulumi.getter(name="vpcCniNetworking")
+    def vpc_cni_networking(self) -> Optional[pulumi.Input['VpcCniNetworkingArgs']]:
+        """
+        The VPC CNI settings that affect the maximum number of pods of the nodes.
+        """
+        return pulumi.get(self, "vpc_cni_networking")
+
+    @vpc_cni_networking.setter
+    def vpc_cni_networking(self, value: Optional[pulumi.Input['VpcCniNetworkingArgs']]):
+        pulumi.set(self, "vpc_cni_networking", value)
+
+    @_builtins.property
+    @pulumi.getter(name="zonalShiftConfig")
+    def zonal_shift_config(self) -> Optional[pulumi.Input['pulumi_aws.eks.ClusterZonalShiftConfigArgs']]:
+        """
+        The zonal shift configuration of the cluster.
+        """
+        return pulumi.get(self, "zonal_shift_config")
+
+    @zonal_shift_config.setter
+    def zonal_shift_config(self, value: Optional[pulumi.Input['pulumi_aws.eks.ClusterZonalShiftConfigArgs']]):
+        pulumi.set(self, "zonal_shift_config", value)
+
 
 class CoreDnsAddonOptionsArgsDict(TypedDict):
     configuration_values: NotRequired[pulumi.Input[Mapping[str, Any]]]
@@ -2038,6 +3371,119 @@ class CreationRoleProviderArgs:
         pulumi.set(self, "role", value)
 
 
+class EncryptionConfigOptionsArgsDict(TypedDict):
+    """
+    Configures the KMS key the cluster creates for envelope encryption of Kubernetes secrets.
+    """
+    alias_name: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    The name of the alias of the key. Must start with `alias/`. Defaults to `alias/eks/<clusterName>`.
+    """
+    deletion_window_in_days: NotRequired[pulumi.Input[_builtins.int]]
+    """
+    The waiting period in days before the key is deleted after the cluster is destroyed. Must be between 7 and 30. Defaults to 30 days.
+    """
+    enable_key_rotation: NotRequired[pulumi.Input[_builtins.bool]]
+    """
+    Whether to enable automatic rotation of the key material. Defaults to `true`.
+    """
+    key_administrator_arns: NotRequired[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]
+    """
+    The ARNs of IAM principals that are allowed to administer the key, e.g. to change its policy or schedule its deletion. The AWS account always retains full access to the key so that IAM policies can grant access to it as well.
+    """
+    rotation_period_in_days: NotRequired[pulumi.Input[_builtins.int]]
+    """
+    The period in days between automatic rotations of the key material. Must be between 90 and 2560. Defaults to 365 days.
+    """
+
+@pulumi.input_type
+class EncryptionConfigOptionsArgs:
+    def __init__(__self__, *,
+                 alias_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 deletion_window_in_days: Optional[pulumi.Input[_builtins.int]] = None,
+                 enable_key_rotation: Optional[pulumi.Input[_builtins.bool]] = None,
+                 key_administrator_arns: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 rotation_period_in_days: Optional[pulumi.Input[_builtins.int]] = None):
+        """
+        Configures the KMS key the cluster creates for envelope encryption of Kubernetes secrets.
+        :param pulumi.Input[_builtins.str] alias_name: The name of the alias of the key. Must start with `alias/`. Defaults to `alias/eks/<clusterName>`.
+        :param pulumi.Input[_builtins.int] deletion_window_in_days: The waiting period in days before the key is deleted after the cluster is destroyed. Must be between 7 and 30. Defaults to 30 days.
+        :param pulumi.Input[_builtins.bool] enable_key_rotation: Whether to enable automatic rotation of the key material. Defaults to `true`.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] key_administrator_arns: The ARNs of IAM principals that are allowed to administer the key, e.g. to change its policy or schedule its deletion. The AWS account always retains full access to the key so that IAM policies can grant access to it as well.
+        :param pulumi.Input[_builtins.int] rotation_period_in_days: The period in days between automatic rotations of the key material. Must be between 90 and 2560. Defaults to 365 days.
+        """
+        if alias_name is not None:
+            pulumi.set(__self__, "alias_name", alias_name)
+        if deletion_window_in_days is not None:
+            pulumi.set(__self__, "deletion_window_in_days", deletion_window_in_days)
+        if enable_key_rotation is not None:
+            pulumi.set(__self__, "enable_key_rotation", enable_key_rotation)
+        if key_administrator_arns is not None:
+            pulumi.set(__self__, "key_administrator_arns", key_administrator_arns)
+        if rotation_period_in_days is not None:
+            pulumi.set(__self__, "rotation_period_in_days", rotation_period_in_days)
+
+    @_builtins.property
+    @pulumi.getter(name="aliasName")
+    def alias_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The name of the alias of the key. Must start with `alias/`. Defaults to `alias/eks/<clusterName>`.
+        """
+        return pulumi.get(self, "alias_name")
+
+    @alias_name.setter
+    def alias_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "alias_name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="deletionWindowInDays")
+    def deletion_window_in_days(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The waiting period in days before the key is deleted after the cluster is destroyed. Must be between 7 and 30. Defaults to 30 days.
+        """
+        return pulumi.get(self, "deletion_window_in_days")
+
+    @deletion_window_in_days.setter
+    def deletion_window_in_days(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "deletion_window_in_days", value)
+
+    @_builtins.property
+    @pulumi.getter(name="enableKeyRotation")
+    def enable_key_rotation(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        Whether to enable automatic rotation of the key material. Defaults to `true`.
+        """
+        return pulumi.get(self, "enable_key_rotation")
+
+    @enable_key_rotation.setter
+    def enable_key_rotation(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "enable_key_rotation", value)
+
+    @_builtins.property
+    @pulumi.getter(name="keyAdministratorArns")
+    def key_administrator_arns(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The ARNs of IAM principals that are allowed to administer the key, e.g. to change its policy or schedule its deletion. The AWS account always retains full access to the key so that IAM policies can grant access to it as well.
+        """
+        return pulumi.get(self, "key_administrator_arns")
+
+    @key_administrator_arns.setter
+    def key_administrator_arns(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "key_administrator_arns", value)
+
+    @_builtins.property
+    @pulumi.getter(name="rotationPeriodInDays")
+    def rotation_period_in_days(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The period in days between automatic rotations of the key material. Must be between 90 and 2560. Defaults to 365 days.
+        """
+        return pulumi.get(self, "rotation_period_in_days")
+
+    @rotation_period_in_days.setter
+    def rotation_period_in_days(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "rotation_period_in_days", value)
+
+
 class FargateProfileArgsDict(TypedDict):
     """
     Defines how Kubernetes pods are executed in Fargate. See aws.eks.FargateProfileArgs for reference.
@@ -2075,40 +3521,181 @@ class FargateProfileArgs:
             pulumi.set(__self__, "subnet_ids", subnet_ids)
 
     @_builtins.property
-    @pulumi.getter(name="podExecutionRoleArn")
-    def pod_execution_role_arn(self) -> Optional[pulumi.Input[_builtins.str]]:
+    @pulumi.getter(name="podExecutionRoleArn")
+    def pod_execution_role_arn(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        Specify a custom role to use for executing pods in Fargate. Defaults to creating a new role with the `arn:aws:iam::aws:policy/AmazonEKSFargatePodExecutionRolePolicy` policy attached.
+        """
+        return pulumi.get(self, "pod_execution_role_arn")
+
+    @pod_execution_role_arn.setter
+    def pod_execution_role_arn(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "pod_execution_role_arn", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def selectors(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['pulumi_aws.eks.FargateProfileSelectorArgs']]]]:
+        """
+        Specify the namespace and label selectors to use for launching pods into Fargate.
+        """
+        return pulumi.get(self, "selectors")
+
+    @selectors.setter
+    def selectors(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['pulumi_aws.eks.FargateProfileSelectorArgs']]]]):
+        pulumi.set(self, "selectors", value)
+
+    @_builtins.property
+    @pulumi.getter(name="subnetIds")
+    def subnet_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        Specify the subnets in which to execute Fargate tasks for pods. Defaults to the private subnets associated with the cluster.
+        """
+        return pulumi.get(self, "subnet_ids")
+
+    @subnet_ids.setter
+    def subnet_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "subnet_ids", value)
+
+
+class InterruptionHandlingArgsDict(TypedDict):
+    """
+    InterruptionHandling configures how a node group handles Spot interruptions and Auto Scaling Group termination events. The events are delivered to an SQS queue, which aws-node-termination-handler processes in queue mode to cordon and drain the affected nodes before their instances are terminated.
+    See for more details: https://github.com/aws/aws-node-termination-handler#queue-processor
+    """
+    chart_version: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+    """
+    heartbeat_timeout: NotRequired[pulumi.Input[_builtins.int]]
+    """
+    The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+    """
+    namespace: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+    """
+    oidc_provider_arn: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+
+    If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+    """
+    service_account_name: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `<name>-node-termination-handler`, where `<name>` is the name of the node group.
+    """
+    values: NotRequired[pulumi.Input[Mapping[str, Any]]]
+    """
+    Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+    """
+
+@pulumi.input_type
+class InterruptionHandlingArgs:
+    def __init__(__self__, *,
+                 chart_version: Optional[pulumi.Input[_builtins.str]] = None,
+                 heartbeat_timeout: Optional[pulumi.Input[_builtins.int]] = None,
+                 namespace: Optional[pulumi.Input[_builtins.str]] = None,
+                 oidc_provider_arn: Optional[pulumi.Input[_builtins.str]] = None,
+                 service_account_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 values: Optional[pulumi.Input[Mapping[str, Any]]] = None):
+        """
+        InterruptionHandling configures how a node group handles Spot interruptions and Auto Scaling Group termination events. The events are delivered to an SQS queue, which aws-node-termination-handler processes in queue mode to cordon and drain the affected nodes before their instances are terminated.
+        See for more details: https://github.com/aws/aws-node-termination-handler#queue-processor
+        :param pulumi.Input[_builtins.str] chart_version: The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+        :param pulumi.Input[_builtins.int] heartbeat_timeout: The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+        :param pulumi.Input[_builtins.str] namespace: The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+        :param pulumi.Input[_builtins.str] oidc_provider_arn: The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+               
+               If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+        :param pulumi.Input[_builtins.str] service_account_name: The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `<name>-node-termination-handler`, where `<name>` is the name of the node group.
+        :param pulumi.Input[Mapping[str, Any]] values: Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+        """
+        if chart_version is not None:
+            pulumi.set(__self__, "chart_version", chart_version)
+        if heartbeat_timeout is not None:
+            pulumi.set(__self__, "heartbeat_timeout", heartbeat_timeout)
+        if namespace is not None:
+            pulumi.set(__self__, "namespace", namespace)
+        if oidc_provider_arn is not None:
+            pulumi.set(__self__, "oidc_provider_arn", oidc_provider_arn)
+        if service_account_name is not None:
+            pulumi.set(__self__, "service_account_name", service_account_name)
+        if values is not None:
+            pulumi.set(__self__, "values", values)
+
+    @_builtins.property
+    @pulumi.getter(name="chartVersion")
+    def chart_version(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+        """
+        return pulumi.get(self, "chart_version")
+
+    @chart_version.setter
+    def chart_version(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "chart_version", value)
+
+    @_builtins.property
+    @pulumi.getter(name="heartbeatTimeout")
+    def heartbeat_timeout(self) -> Optional[pulumi.Input[_builtins.int]]:
         """
-        Specify a custom role to use for executing pods in Fargate. Defaults to creating a new role with the `arn:aws:iam::aws:policy/AmazonEKSFargatePodExecutionRolePolicy` policy attached.
+        The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
         """
-        return pulumi.get(self, "pod_execution_role_arn")
+        return pulumi.get(self, "heartbeat_timeout")
 
-    @pod_execution_role_arn.setter
-    def pod_execution_role_arn(self, value: Optional[pulumi.Input[_builtins.str]]):
-        pulumi.set(self, "pod_execution_role_arn", value)
+    @heartbeat_timeout.setter
+    def heartbeat_timeout(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "heartbeat_timeout", value)
 
     @_builtins.property
     @pulumi.getter
-    def selectors(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['pulumi_aws.eks.FargateProfileSelectorArgs']]]]:
+    def namespace(self) -> Optional[pulumi.Input[_builtins.str]]:
         """
-        Specify the namespace and label selectors to use for launching pods into Fargate.
+        The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
         """
-        return pulumi.get(self, "selectors")
+        return pulumi.get(self, "namespace")
 
-    @selectors.setter
-    def selectors(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['pulumi_aws.eks.FargateProfileSelectorArgs']]]]):
-        pulumi.set(self, "selectors", value)
+    @namespace.setter
+    def namespace(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "namespace", value)
 
     @_builtins.property
-    @pulumi.getter(name="subnetIds")
-    def subnet_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+    @pulumi.getter(name="oidcProviderArn")
+    def oidc_provider_arn(self) -> Optional[pulumi.Input[_builtins.str]]:
         """
-        Specify the subnets in which to execute Fargate tasks for pods. Defaults to the private subnets associated with the cluster.
+        The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+
+        If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
         """
-        return pulumi.get(self, "subnet_ids")
+        return pulumi.get(self, "oidc_provider_arn")
 
-    @subnet_ids.setter
-    def subnet_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
-        pulumi.set(self, "subnet_ids", value)
+    @oidc_provider_arn.setter
+    def oidc_provider_arn(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "oidc_provider_arn", value)
+
+    @_builtins.property
+    @pulumi.getter(name="serviceAccountName")
+    def service_account_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `<name>-node-termination-handler`, where `<name>` is the name of the node group.
+        """
+        return pulumi.get(self, "service_account_name")
+
+    @service_account_name.setter
+    def service_account_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "service_account_name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def values(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+        """
+        return pulumi.get(self, "values")
+
+    @values.setter
+    def values(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "values", value)
 
 
 class KubeProxyAddonOptionsArgsDict(TypedDict):
@@ -2243,24 +3830,53 @@ class KubeconfigOptionsArgsDict(TypedDict):
     - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-role.html
     - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-profiles.html
     """
+    env: NotRequired[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]
+    """
+    Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+    """
+    external_id: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+
+    Only supported by the `aws-iam-authenticator` token tool.
+    """
     profile_name: NotRequired[pulumi.Input[_builtins.str]]
     """
     AWS credential profile name to always use instead of the default AWS credential provider chain.
 
     The profile is passed to kubeconfig as an authentication environment setting.
     """
+    region: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+    """
     role_arn: NotRequired[pulumi.Input[_builtins.str]]
     """
     Role ARN to assume instead of the default AWS credential provider chain.
 
     The role is passed to kubeconfig as an authentication exec argument.
     """
+    role_session_name: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    Session name to use when assuming `roleArn`.
+
+    Only supported by the `aws-iam-authenticator` token tool.
+    """
+    token_tool: NotRequired[pulumi.Input['KubeconfigTokenTool']]
+    """
+    The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+    """
 
 @pulumi.input_type
 class KubeconfigOptionsArgs:
     def __init__(__self__, *,
+                 env: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 external_id: Optional[pulumi.Input[_builtins.str]] = None,
                  profile_name: Optional[pulumi.Input[_builtins.str]] = None,
-                 role_arn: Optional[pulumi.Input[_builtins.str]] = None):
+                 region: Optional[pulumi.Input[_builtins.str]] = None,
+                 role_arn: Optional[pulumi.Input[_builtins.str]] = None,
+                 role_session_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 token_tool: Optional[pulumi.Input['KubeconfigTokenTool']] = None):
         """
         Represents the AWS credentials to scope a given kubeconfig when using a non-default credential chain.
 
@@ -2278,17 +3894,62 @@ class KubeconfigOptionsArgs:
         - https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
         - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-role.html
         - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-profiles.html
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] env: Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+        :param pulumi.Input[_builtins.str] external_id: External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+               
+               Only supported by the `aws-iam-authenticator` token tool.
         :param pulumi.Input[_builtins.str] profile_name: AWS credential profile name to always use instead of the default AWS credential provider chain.
                
                The profile is passed to kubeconfig as an authentication environment setting.
+        :param pulumi.Input[_builtins.str] region: AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
         :param pulumi.Input[_builtins.str] role_arn: Role ARN to assume instead of the default AWS credential provider chain.
                
                The role is passed to kubeconfig as an authentication exec argument.
+        :param pulumi.Input[_builtins.str] role_session_name: Session name to use when assuming `roleArn`.
+               
+               Only supported by the `aws-iam-authenticator` token tool.
+        :param pulumi.Input['KubeconfigTokenTool'] token_tool: The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
         """
+        if env is not None:
+            pulumi.set(__self__, "env", env)
+        if external_id is not None:
+            pulumi.set(__self__, "external_id", external_id)
         if profile_name is not None:
             pulumi.set(__self__, "profile_name", profile_name)
+        if region is not None:
+            pulumi.set(__self__, "region", region)
         if role_arn is not None:
             pulumi.set(__self__, "role_arn", role_arn)
+        if role_session_name is not None:
+            pulumi.set(__self__, "role_session_name", role_session_name)
+        if token_tool is not None:
+            pulumi.set(__self__, "token_tool", token_tool)
+
+    @_builtins.property
+    @pulumi.getter
+    def env(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+        """
+        return pulumi.get(self, "env")
+
+    @env.setter
+    def env(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "env", value)
+
+    @_builtins.property
+    @pulumi.getter(name="externalId")
+    def external_id(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+
+        Only supported by the `aws-iam-authenticator` token tool.
+        """
+        return pulumi.get(self, "external_id")
+
+    @external_id.setter
+    def external_id(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "external_id", value)
 
     @_builtins.property
     @pulumi.getter(name="profileName")
@@ -2304,6 +3965,18 @@ class KubeconfigOptionsArgs:
     def profile_name(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "profile_name", value)
 
+    @_builtins.property
+    @pulumi.getter
+    def region(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+        """
+        return pulumi.get(self, "region")
+
+    @region.setter
+    def region(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "region", value)
+
     @_builtins.property
     @pulumi.getter(name="roleArn")
     def role_arn(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -2318,6 +3991,326 @@ class KubeconfigOptionsArgs:
     def role_arn(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "role_arn", value)
 
+    @_builtins.property
+    @pulumi.getter(name="roleSessionName")
+    def role_session_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        Session name to use when assuming `roleArn`.
+
+        Only supported by the `aws-iam-authenticator` token tool.
+        """
+        return pulumi.get(self, "role_session_name")
+
+    @role_session_name.setter
+    def role_session_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "role_session_name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="tokenTool")
+    def token_tool(self) -> Optional[pulumi.Input['KubeconfigTokenTool']]:
+        """
+        The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+        """
+        return pulumi.get(self, "token_tool")
+
+    @token_tool.setter
+    def token_tool(self, value: Optional[pulumi.Input['KubeconfigTokenTool']]):
+        pulumi.set(self, "token_tool", value)
+
+
+class NodeConfigContainerdArgsDict(TypedDict):
+    """
+    Configuration of containerd in a nodeadm `NodeConfig`.
+    """
+    base_runtime_spec: NotRequired[pulumi.Input[Mapping[str, Any]]]
+    """
+    The OCI runtime specification containers are created with.
+    """
+    config: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+    """
+
+@pulumi.input_type
+class NodeConfigContainerdArgs:
+    def __init__(__self__, *,
+                 base_runtime_spec: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 config: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        Configuration of containerd in a nodeadm `NodeConfig`.
+        :param pulumi.Input[Mapping[str, Any]] base_runtime_spec: The OCI runtime specification containers are created with.
+        :param pulumi.Input[_builtins.str] config: Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+        """
+        if base_runtime_spec is not None:
+            pulumi.set(__self__, "base_runtime_spec", base_runtime_spec)
+        if config is not None:
+            pulumi.set(__self__, "config", config)
+
+    @_builtins.property
+    @pulumi.getter(name="baseRuntimeSpec")
+    def base_runtime_spec(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        The OCI runtime specification containers are created with.
+        """
+        return pulumi.get(self, "base_runtime_spec")
+
+    @base_runtime_spec.setter
+    def base_runtime_spec(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "base_runtime_spec", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def config(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+        """
+        return pulumi.get(self, "config")
+
+    @config.setter
+    def config(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "config", value)
+
+
+class NodeConfigInstanceArgsDict(TypedDict):
+    """
+    Configuration of the EC2 instance in a nodeadm `NodeConfig`.
+    """
+    local_storage: NotRequired[pulumi.Input['NodeConfigLocalStorageArgsDict']]
+    """
+    Configuration of the local instance store volumes.
+    """
+
+@pulumi.input_type
+class NodeConfigInstanceArgs:
+    def __init__(__self__, *,
+                 local_storage: Optional[pulumi.Input['NodeConfigLocalStorageArgs']] = None):
+        """
+        Configuration of the EC2 instance in a nodeadm `NodeConfig`.
+        :param pulumi.Input['NodeConfigLocalStorageArgs'] local_storage: Configuration of the local instance store volumes.
+        """
+        if local_storage is not None:
+            pulumi.set(__self__, "local_storage", local_storage)
+
+    @_builtins.property
+    @pulumi.getter(name="localStorage")
+    def local_storage(self) -> Optional[pulumi.Input['NodeConfigLocalStorageArgs']]:
+        """
+        Configuration of the local instance store volumes.
+        """
+        return pulumi.get(self, "local_storage")
+
+    @local_storage.setter
+    def local_storage(self, value: Optional[pulumi.Input['NodeConfigLocalStorageArgs']]):
+        pulumi.set(self, "local_storage", value)
+
+
+class NodeConfigKubeletArgsDict(TypedDict):
+    """
+    Configuration of the kubelet in a nodeadm `NodeConfig`.
+    """
+    config: NotRequired[pulumi.Input[Mapping[str, Any]]]
+    """
+    Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+
+    See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+    """
+    flags: NotRequired[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]
+    """
+    Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+    """
+
+@pulumi.input_type
+class NodeConfigKubeletArgs:
+    def __init__(__self__, *,
+                 config: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 flags: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None):
+        """
+        Configuration of the kubelet in a nodeadm `NodeConfig`.
+        :param pulumi.Input[Mapping[str, Any]] config: Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+               
+               See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] flags: Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+        """
+        if config is not None:
+            pulumi.set(__self__, "config", config)
+        if flags is not None:
+            pulumi.set(__self__, "flags", flags)
+
+    @_builtins.property
+    @pulumi.getter
+    def config(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+
+        See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+        """
+        return pulumi.get(self, "config")
+
+    @config.setter
+    def config(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "config", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def flags(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+        """
+        return pulumi.get(self, "flags")
+
+    @flags.setter
+    def flags(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "flags", value)
+
+
+class NodeConfigLocalStorageArgsDict(TypedDict):
+    """
+    Configuration of the local instance store volumes in a nodeadm `NodeConfig`.
+    """
+    strategy: pulumi.Input[_builtins.str]
+    """
+    How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+    """
+    disabled_mounts: NotRequired[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]
+    """
+    Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+    """
+    mount_path: NotRequired[pulumi.Input[_builtins.str]]
+    """
+    The path the instance store volumes are mounted at.
+    """
+
+@pulumi.input_type
+class NodeConfigLocalStorageArgs:
+    def __init__(__self__, *,
+                 strategy: pulumi.Input[_builtins.str],
+                 disabled_mounts: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 mount_path: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        Configuration of the local instance store volumes in a nodeadm `NodeConfig`.
+        :param pulumi.Input[_builtins.str] strategy: How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] disabled_mounts: Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+        :param pulumi.Input[_builtins.str] mount_path: The path the instance store volumes are mounted at.
+        """
+        pulumi.set(__self__, "strategy", strategy)
+        if disabled_mounts is not None:
+            pulumi.set(__self__, "disabled_mounts", disabled_mounts)
+        if mount_path is not None:
+            pulumi.set(__self__, "mount_path", mount_path)
+
+    @_builtins.property
+    @pulumi.getter
+    def strategy(self) -> pulumi.Input[_builtins.str]:
+        """
+        How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+        """
+        return pulumi.get(self, "strategy")
+
+    @strategy.setter
+    def strategy(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "strategy", value)
+
+    @_builtins.property
+    @pulumi.getter(name="disabledMounts")
+    def disabled_mounts(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+        """
+        return pulumi.get(self, "disabled_mounts")
+
+    @disabled_mounts.setter
+    def disabled_mounts(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "disabled_mounts", value)
+
+    @_builtins.property
+    @pulumi.getter(name="mountPath")
+    def mount_path(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The path the instance store volumes are mounted at.
+        """
+        return pulumi.get(self, "mount_path")
+
+    @mount_path.setter
+    def mount_path(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "mount_path", value)
+
+
+class NodeConfigArgsDict(TypedDict):
+    """
+    Typed nodeadm `NodeConfig`. The cluster details are configured by the provider.
+
+    See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/.
+    """
+    containerd: NotRequired[pulumi.Input['NodeConfigContainerdArgsDict']]
+    """
+    Configuration of containerd.
+    """
+    instance: NotRequired[pulumi.Input['NodeConfigInstanceArgsDict']]
+    """
+    Configuration of the EC2 instance.
+    """
+    kubelet: NotRequired[pulumi.Input['NodeConfigKubeletArgsDict']]
+    """
+    Configuration of the kubelet.
+    """
+
+@pulumi.input_type
+class NodeConfigArgs:
+    def __init__(__self__, *,
+                 containerd: Optional[pulumi.Input['NodeConfigContainerdArgs']] = None,
+                 instance: Optional[pulumi.Input['NodeConfigInstanceArgs']] = None,
+                 kubelet: Optional[pulumi.Input['NodeConfigKubeletArgs']] = None):
+        """
+        Typed nodeadm `NodeConfig`. The cluster details are configured by the provider.
+
+        See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/.
+        :param pulumi.Input['NodeConfigContainerdArgs'] containerd: Configuration of containerd.
+        :param pulumi.Input['NodeConfigInstanceArgs'] instance: Configuration of the EC2 instance.
+        :param pulumi.Input['NodeConfigKubeletArgs'] kubelet: Configuration of the kubelet.
+        """
+        if containerd is not None:
+            pulumi.set(__self__, "containerd", containerd)
+        if instance is not None:
+            pulumi.set(__self__, "instance", instance)
+        if kubelet is not None:
+            pulumi.set(__self__, "kubelet", kubelet)
+
+    @_builtins.property
+    @pulumi.getter
+    def containerd(self) -> Optional[pulumi.Input['NodeConfigContainerdArgs']]:
+        """
+        Configuration of containerd.
+        """
+        return pulumi.get(self, "containerd")
+
+    @containerd.setter
+    def containerd(self, value: Optional[pulumi.Input['NodeConfigContainerdArgs']]):
+        pulumi.set(self, "containerd", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def instance(self) -> Optional[pulumi.Input['NodeConfigInstanceArgs']]:
+        """
+        Configuration of the EC2 instance.
+        """
+        return pulumi.get(self, "instance")
+
+    @instance.setter
+    def instance(self, value: Optional[pulumi.Input['NodeConfigInstanceArgs']]):
+        pulumi.set(self, "instance", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def kubelet(self) -> Optional[pulumi.Input['NodeConfigKubeletArgs']]:
+        """
+        Configuration of the kubelet.
+        """
+        return pulumi.get(self, "kubelet")
+
+    @kubelet.setter
+    def kubelet(self, value: Optional[pulumi.Input['NodeConfigKubeletArgs']]):
+        pulumi.set(self, "kubelet", value)
+
 
 class NodeadmOptionsArgsDict(TypedDict):
     """
@@ -2809,6 +4802,57 @@ class UserMappingArgs:
         pulumi.set(self, "username", value)
 
 
+class VpcCniNetworkingArgsDict(TypedDict):
+    """
+    The VPC CNI settings that affect the maximum number of pods of the nodes.
+    """
+    custom_networking: pulumi.Input[_builtins.bool]
+    """
+    Whether pods get their IP addresses from the subnets of ENIConfigs.
+    """
+    prefix_delegation: pulumi.Input[_builtins.bool]
+    """
+    Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+    """
+
+@pulumi.input_type
+class VpcCniNetworkingArgs:
+    def __init__(__self__, *,
+                 custom_networking: pulumi.Input[_builtins.bool],
+                 prefix_delegation: pulumi.Input[_builtins.bool]):
+        """
+        The VPC CNI settings that affect the maximum number of pods of the nodes.
+        :param pulumi.Input[_builtins.bool] custom_networking: Whether pods get their IP addresses from the subnets of ENIConfigs.
+        :param pulumi.Input[_builtins.bool] prefix_delegation: Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+        """
+        pulumi.set(__self__, "custom_networking", custom_networking)
+        pulumi.set(__self__, "prefix_delegation", prefix_delegation)
+
+    @_builtins.property
+    @pulumi.getter(name="customNetworking")
+    def custom_networking(self) -> pulumi.Input[_builtins.bool]:
+        """
+        Whether pods get their IP addresses from the subnets of ENIConfigs.
+        """
+        return pulumi.get(self, "custom_networking")
+
+    @custom_networking.setter
+    def custom_networking(self, value: pulumi.Input[_builtins.bool]):
+        pulumi.set(self, "custom_networking", value)
+
+    @_builtins.property
+    @pulumi.getter(name="prefixDelegation")
+    def prefix_delegation(self) -> pulumi.Input[_builtins.bool]:
+        """
+        Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+        """
+        return pulumi.get(self, "prefix_delegation")
+
+    @prefix_delegation.setter
+    def prefix_delegation(self, value: pulumi.Input[_builtins.bool]):
+        pulumi.set(self, "prefix_delegation", value)
+
+
 class VpcCniOptionsArgsDict(TypedDict):
     """
     Describes the configuration options available for the Amazon VPC CNI plugin for Kubernetes.
@@ -2895,6 +4939,20 @@ class VpcCniOptionsArgsDict(TypedDict):
 
     Defaults to true.
     """
+    pod_security_group_ids: NotRequired[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]
+    """
+    The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+
+    Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+    """
+    pod_subnets: NotRequired[Mapping[str, pulumi.Input[_builtins.str]]]
+    """
+    Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+
+    Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+
+    See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
+    """
     resolve_conflicts_on_create: NotRequired['ResolveConflictsOnCreate']
     """
     How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
@@ -2957,6 +5015,8 @@ class VpcCniOptionsArgs:
                  log_file: Optional[pulumi.Input[_builtins.str]] = None,
                  log_level: Optional[pulumi.Input[_builtins.str]] = None,
                  node_port_support: Optional[pulumi.Input[_builtins.bool]] = None,
+                 pod_security_group_ids: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 pod_subnets: Optional[Mapping[str, pulumi.Input[_builtins.str]]] = None,
                  resolve_conflicts_on_create: Optional['ResolveConflictsOnCreate'] = None,
                  resolve_conflicts_on_update: Optional['ResolveConflictsOnUpdate'] = None,
                  security_context_privileged: Optional[pulumi.Input[_builtins.bool]] = None,
@@ -3001,6 +5061,14 @@ class VpcCniOptionsArgs:
         :param pulumi.Input[_builtins.bool] node_port_support: Specifies whether NodePort services are enabled on a worker node's primary network interface. This requires additional iptables rules and that the kernel's reverse path filter on the primary interface is set to loose.
                
                Defaults to true.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] pod_security_group_ids: The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+               
+               Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+        :param Mapping[str, pulumi.Input[_builtins.str]] pod_subnets: Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+               
+               Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+               
+               See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
         :param 'ResolveConflictsOnCreate' resolve_conflicts_on_create: How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
         :param 'ResolveConflictsOnUpdate' resolve_conflicts_on_update: How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value.  Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
         :param pulumi.Input[_builtins.bool] security_context_privileged: Pass privilege to containers securityContext. This is required when SELinux is enabled. This value will not be passed to the CNI config by default
@@ -3052,6 +5120,10 @@ class VpcCniOptionsArgs:
             pulumi.set(__self__, "log_level", log_level)
         if node_port_support is not None:
             pulumi.set(__self__, "node_port_support", node_port_support)
+        if pod_security_group_ids is not None:
+            pulumi.set(__self__, "pod_security_group_ids", pod_security_group_ids)
+        if pod_subnets is not None:
+            pulumi.set(__self__, "pod_subnets", pod_subnets)
         if resolve_conflicts_on_create is None:
             resolve_conflicts_on_create = 'OVERWRITE'
         if resolve_conflicts_on_create is not None:
@@ -3283,6 +5355,36 @@ class VpcCniOptionsArgs:
     def node_port_support(self, value: Optional[pulumi.Input[_builtins.bool]]):
         pulumi.set(self, "node_port_support", value)
 
+    @_builtins.property
+    @pulumi.getter(name="podSecurityGroupIds")
+    def pod_security_group_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+
+        Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+        """
+        return pulumi.get(self, "pod_security_group_ids")
+
+    @pod_security_group_ids.setter
+    def pod_security_group_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "pod_security_group_ids", value)
+
+    @_builtins.property
+    @pulumi.getter(name="podSubnets")
+    def pod_subnets(self) -> Optional[Mapping[str, pulumi.Input[_builtins.str]]]:
+        """
+        Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+
+        Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+
+        See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
+        """
+        return pulumi.get(self, "pod_subnets")
+
+    @pod_subnets.setter
+    def pod_subnets(self, value: Optional[Mapping[str, pulumi.Input[_builtins.str]]]):
+        pulumi.set(self, "pod_subnets", value)
+
     @_builtins.property
     @pulumi.getter(name="resolveConflictsOnCreate")
     def resolve_conflicts_on_create(self) -> Optional['ResolveConflictsOnCreate']:
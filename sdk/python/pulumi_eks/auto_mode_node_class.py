@@ -0,0 +1,301 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-eks. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from ._inputs import *
+from .cluster import Cluster
+import pulumi_aws
+import pulumi_kubernetes
+
+__all__ = ['AutoModeNodeClassArgs', 'AutoModeNodeClass']
+
+@pulumi.input_type
+class AutoModeNodeClassArgs:
+    def __init__(__self__, *,
+                 cluster: pulumi.Input['Cluster'],
+                 ephemeral_storage: Optional[pulumi.Input['AutoModeEphemeralStorageArgs']] = None,
+                 network_policy: Optional[pulumi.Input[_builtins.str]] = None,
+                 network_policy_event_logs: Optional[pulumi.Input[_builtins.str]] = None,
+                 node_role_arn: Optional[pulumi.Input[_builtins.str]] = None,
+                 security_group_selector_terms: Optional[pulumi.Input[Sequence[pulumi.Input['AutoModeSelectorTermArgs']]]] = None,
+                 snat_policy: Optional[pulumi.Input[_builtins.str]] = None,
+                 subnet_selector_terms: Optional[pulumi.Input[Sequence[pulumi.Input['AutoModeSelectorTermArgs']]]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None):
+        """
+        The set of arguments for constructing a AutoModeNodeClass resource.
+        :param pulumi.Input['Cluster'] cluster: The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+        :param pulumi.Input['AutoModeEphemeralStorageArgs'] ephemeral_storage: The ephemeral storage of the nodes.
+        :param pulumi.Input[_builtins.str] network_policy: The default network policy of the pods, `DefaultAllow` or `DefaultDeny`. Defaults to `DefaultAllow`.
+        :param pulumi.Input[_builtins.str] network_policy_event_logs: Whether to log the network policy decisions, `Enabled` or `Disabled`. Defaults to `Disabled`.
+        :param pulumi.Input[_builtins.str] node_role_arn: The ARN of the IAM role of the nodes. Defaults to the node role of EKS Auto Mode created by the cluster.
+               
+               An access entry of type `EC2` with the `AmazonEKSAutoNodePolicy` access policy is created for custom node roles, which allows their nodes to join the cluster.
+        :param pulumi.Input[Sequence[pulumi.Input['AutoModeSelectorTermArgs']]] security_group_selector_terms: The security groups of the nodes. Defaults to the cluster security group.
+        :param pulumi.Input[_builtins.str] snat_policy: The source NAT policy of the pod traffic leaving the VPC, `Random` or `Disabled`. Defaults to `Random`.
+        :param pulumi.Input[Sequence[pulumi.Input['AutoModeSelectorTermArgs']]] subnet_selector_terms: The subnets to launch nodes into. Defaults to the subnets of the cluster.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] tags: The tags to apply to the EC2 resources created for the nodes.
+        """
+        pulumi.set(__self__, "cluster", cluster)
+        if ephemeral_storage is not None:
+            pulumi.set(__self__, "ephemeral_storage", ephemeral_storage)
+        if network_policy is not None:
+            pulumi.set(__self__, "network_policy", network_policy)
+        if network_policy_event_logs is not None:
+            pulumi.set(__self__, "network_policy_event_logs", network_policy_event_logs)
+        if node_role_arn is not None:
+            pulumi.set(__self__, "node_role_arn", node_role_arn)
+        if security_group_selector_terms is not None:
+            pulumi.set(__self__, "security_group_selector_terms", security_group_selector_terms)
+        if snat_policy is not None:
+            pulumi.set(__self__, "snat_policy", snat_policy)
+        if subnet_selector_terms is not None:
+            pulumi.set(__self__, "subnet_selector_terms", subnet_selector_terms)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+
+    @_builtins.property
+    @pulumi.getter
+    def cluster(self) -> pulumi.Input['Cluster']:
+        """
+        The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+        """
+        return pulumi.get(self, "cluster")
+
+    @cluster.setter
+    def cluster(self, value: pulumi.Input['Cluster']):
+        pulumi.set(self, "cluster", value)
+
+    @_builtins.property
+    @pulumi.getter(name="ephemeralStorage")
+    def ephemeral_storage(self) -> Optional[pulumi.Input['AutoModeEphemeralStorageArgs']]:
+        """
+        The ephemeral storage of the nodes.
+        """
+        return pulumi.get(self, "ephemeral_storage")
+
+    @ephemeral_storage.setter
+    def ephemeral_storage(self, value: Optional[pulumi.Input['AutoModeEphemeralStorageArgs']]):
+        pulumi.set(self, "ephemeral_storage", value)
+
+    @_builtins.property
+    @pulumi.getter(name="networkPolicy")
+    def network_policy(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The default network policy of the pods, `DefaultAllow` or `DefaultDeny`. Defaults to `DefaultAllow`.
+        """
+        return pulumi.get(self, "network_policy")
+
+    @network_policy.setter
+    def network_policy(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "network_policy", value)
+
+    @_builtins.property
+    @pulumi.getter(name="networkPolicyEventLogs")
+    def network_policy_event_logs(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        Whether to log the network policy decisions, `Enabled` or `Disabled`. Defaults to `Disabled`.
+        """
+        return pulumi.get(self, "network_policy_event_logs")
+
+    @network_policy_event_logs.setter
+    def network_policy_event_logs(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "network_policy_event_logs", value)
+
+    @_builtins.property
+    @pulumi.getter(name="nodeRoleArn")
+    def node_role_arn(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The ARN of the IAM role of the nodes. Defaults to the node role of EKS Auto Mode created by the cluster.
+
+        An access entry of type `EC2` with the `AmazonEKSAutoNodePolicy` access policy is created for custom node roles, which allows their nodes to join the cluster.
+        """
+        return pulumi.get(self, "node_role_arn")
+
+    @node_role_arn.setter
+    def node_role_arn(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "node_role_arn", value)
+
+    @_builtins.property
+    @pulumi.getter(name="securityGroupSelectorTerms")
+    def security_group_selector_terms(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['AutoModeSelectorTermArgs']]]]:
+        """
+        The security groups of the nodes. Defaults to the cluster security group.
+        """
+        return pulumi.get(self, "security_group_selector_terms")
+
+    @security_group_selector_terms.setter
+    def security_group_selector_terms(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['AutoModeSelectorTermArgs']]]]):
+        pulumi.set(self, "security_group_selector_terms", value)
+
+    @_builtins.property
+    @pulumi.getter(name="snatPolicy")
+    def snat_policy(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The source NAT policy of the pod traffic leaving the VPC, `Random` or `Disabled`. Defaults to `Random`.
+        """
+        return pulumi.get(self, "snat_policy")
+
+    @snat_policy.setter
+    def snat_policy(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "snat_policy", value)
+
+    @_builtins.property
+    @pulumi.getter(name="subnetSelectorTerms")
+    def subnet_selector_terms(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['AutoModeSelectorTermArgs']]]]:
+        """
+        The subnets to launch nodes into. Defaults to the subnets of the cluster.
+        """
+        return pulumi.get(self, "subnet_selector_terms")
+
+    @subnet_selector_terms.setter
+    def subnet_selector_terms(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['AutoModeSelectorTermArgs']]]]):
+        pulumi.set(self, "subnet_selector_terms", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        The tags to apply to the EC2 resources created for the nodes.
+        """
+        return pulumi.get(self, "tags")
+
+    @tags.setter
+    def tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "tags", value)
+
+
+@pulumi.type_token("eks:index:AutoModeNodeClass")
+class AutoModeNodeClass(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 cluster: Optional[pulumi.Input['Cluster']] = None,
+                 ephemeral_storage: Optional[pulumi.Input[Union['AutoModeEphemeralStorageArgs', 'AutoModeEphemeralStorageArgsDict']]] = None,
+                 network_policy: Optional[pulumi.Input[_builtins.str]] = None,
+                 network_policy_event_logs: Optional[pulumi.Input[_builtins.str]] = None,
+                 node_role_arn: Optional[pulumi.Input[_builtins.str]] = None,
+                 security_group_selector_terms: Optional[pulumi.Input[Sequence[pulumi.Input[Union['AutoModeSelectorTermArgs', 'AutoModeSelectorTermArgsDict']]]]] = None,
+                 snat_policy: Optional[pulumi.Input[_builtins.str]] = None,
+                 subnet_selector_terms: Optional[pulumi.Input[Sequence[pulumi.Input[Union['AutoModeSelectorTermArgs', 'AutoModeSelectorTermArgsDict']]]]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 __props__=None):
+        """
+        AutoModeNodeClass creates an EKS Auto Mode NodeClass, which configures the networking, storage and IAM role of the nodes of the node pools that reference it.
+        For more information see: https://docs.aws.amazon.com/eks/latest/userguide/create-node-class.html
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input['Cluster'] cluster: The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+        :param pulumi.Input[Union['AutoModeEphemeralStorageArgs', 'AutoModeEphemeralStorageArgsDict']] ephemeral_storage: The ephemeral storage of the nodes.
+        :param pulumi.Input[_builtins.str] network_policy: The default network policy of the pods, `DefaultAllow` or `DefaultDeny`. Defaults to `DefaultAllow`.
+        :param pulumi.Input[_builtins.str] network_policy_event_logs: Whether to log the network policy decisions, `Enabled` or `Disabled`. Defaults to `Disabled`.
+        :param pulumi.Input[_builtins.str] node_role_arn: The ARN of the IAM role of the nodes. Defaults to the node role of EKS Auto Mode created by the cluster.
+               
+               An access entry of type `EC2` with the `AmazonEKSAutoNodePolicy` access policy is created for custom node roles, which allows their nodes to join the cluster.
+        :param pulumi.Input[Sequence[pulumi.Input[Union['AutoModeSelectorTermArgs', 'AutoModeSelectorTermArgsDict']]]] security_group_selector_terms: The security groups of the nodes. Defaults to the cluster security group.
+        :param pulumi.Input[_builtins.str] snat_policy: The source NAT policy of the pod traffic leaving the VPC, `Random` or `Disabled`. Defaults to `Random`.
+        :param pulumi.Input[Sequence[pulumi.Input[Union['AutoModeSelectorTermArgs', 'AutoModeSelectorTermArgsDict']]]] subnet_selector_terms: The subnets to launch nodes into. Defaults to the subnets of the cluster.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] tags: The tags to apply to the EC2 resources created for the nodes.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: AutoModeNodeClassArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        AutoModeNodeClass creates an EKS Auto Mode NodeClass, which configures the networking, storage and IAM role of the nodes of the node pools that reference it.
+        For more information see: https://docs.aws.amazon.com/eks/latest/userguide/create-node-class.html
+
+        :param str resource_name: The name of the resource.
+        :param AutoModeNodeClassArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(AutoModeNodeClassArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 cluster: Optional[pulumi.Input['Cluster']] = None,
+                 ephemeral_storage: Optional[pulumi.Input[Union['AutoModeEphemeralStorageArgs', 'AutoModeEphemeralStorageArgsDict']]] = None,
+                 network_policy: Optional[pulumi.Input[_builtins.str]] = None,
+                 network_policy_event_logs: Optional[pulumi.Input[_builtins.str]] = None,
+                 node_role_arn: Optional[pulumi.Input[_builtins.str]] = None,
+                 security_group_selector_terms: Optional[pulumi.Input[Sequence[pulumi.Input[Union['AutoModeSelectorTermArgs', 'AutoModeSelectorTermArgsDict']]]]] = None,
+                 snat_policy: Optional[pulumi.Input[_builtins.str]] = None,
+                 subnet_selector_terms: Optional[pulumi.Input[Sequence[pulumi.Input[Union['AutoModeSelectorTermArgs', 'AutoModeSelectorTermArgsDict']]]]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = AutoModeNodeClassArgs.__new__(AutoModeNodeClassArgs)
+
+            if cluster is None and not opts.urn:
+                raise TypeError("Missing required property 'cluster'")
+            __props__.__dict__["cluster"] = cluster
+            __props__.__dict__["ephemeral_storage"] = ephemeral_storage
+            __props__.__dict__["network_policy"] = network_policy
+            __props__.__dict__["network_policy_event_logs"] = network_policy_event_logs
+            __props__.__dict__["node_role_arn"] = node_role_arn
+            __props__.__dict__["security_group_selector_terms"] = security_group_selector_terms
+            __props__.__dict__["snat_policy"] = snat_policy
+            __props__.__dict__["subnet_selector_terms"] = subnet_selector_terms
+            __props__.__dict__["tags"] = tags
+            __props__.__dict__["access_entry"] = None
+            __props__.__dict__["node_class"] = None
+            __props__.__dict__["node_class_name"] = None
+        super(AutoModeNodeClass, __self__).__init__(
+            'eks:index:AutoModeNodeClass',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @_builtins.property
+    @pulumi.getter(name="accessEntry")
+    def access_entry(self) -> pulumi.Output[Optional['pulumi_aws.eks.AccessEntry']]:
+        """
+        The access entry of the custom node role, if `nodeRoleArn` is set.
+        """
+        return pulumi.get(self, "access_entry")
+
+    @_builtins.property
+    @pulumi.getter(name="nodeClass")
+    def node_class(self) -> pulumi.Output['pulumi_kubernetes.apiextensions.CustomResource']:
+        """
+        The NodeClass custom resource.
+        """
+        return pulumi.get(self, "node_class")
+
+    @_builtins.property
+    @pulumi.getter(name="nodeClassName")
+    def node_class_name(self) -> pulumi.Output[_builtins.str]:
+        """
+        The name of the NodeClass, which node pools reference with `nodeClassName`.
+        """
+        return pulumi.get(self, "node_class_name")
+
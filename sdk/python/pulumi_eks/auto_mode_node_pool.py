@@ -0,0 +1,325 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-eks. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from ._inputs import *
+from .cluster import Cluster
+import pulumi_kubernetes
+
+__all__ = ['AutoModeNodePoolArgs', 'AutoModeNodePool']
+
+@pulumi.input_type
+class AutoModeNodePoolArgs:
+    def __init__(__self__, *,
+                 cluster: pulumi.Input['Cluster'],
+                 disruption: Optional[pulumi.Input['AutoModeDisruptionArgs']] = None,
+                 expire_after: Optional[pulumi.Input[_builtins.str]] = None,
+                 labels: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 limits: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 node_class_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 requirements: Optional[pulumi.Input[Sequence[pulumi.Input['AutoModeNodeRequirementArgs']]]] = None,
+                 startup_taints: Optional[pulumi.Input[Mapping[str, pulumi.Input['TaintArgs']]]] = None,
+                 taints: Optional[pulumi.Input[Mapping[str, pulumi.Input['TaintArgs']]]] = None,
+                 termination_grace_period: Optional[pulumi.Input[_builtins.str]] = None,
+                 weight: Optional[pulumi.Input[_builtins.int]] = None):
+        """
+        The set of arguments for constructing a AutoModeNodePool resource.
+        :param pulumi.Input['Cluster'] cluster: The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+        :param pulumi.Input['AutoModeDisruptionArgs'] disruption: The disruption settings of the node pool.
+        :param pulumi.Input[_builtins.str] expire_after: How long a node lives before it is replaced, e.g. `336h`, or `Never`. Defaults to `336h`.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] labels: The labels of the nodes.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] limits: The maximum resources of all nodes of the node pool, e.g. `{ cpu: "1000", memory: "1000Gi" }`.
+        :param pulumi.Input[_builtins.str] node_class_name: The name of the NodeClass of the nodes, e.g. `nodeClass.nodeClassName`. Defaults to `default`, the NodeClass of the built-in node pools.
+        :param pulumi.Input[Sequence[pulumi.Input['AutoModeNodeRequirementArgs']]] requirements: The requirements the instances of the node pool have to fulfill.
+        :param pulumi.Input[Mapping[str, pulumi.Input['TaintArgs']]] startup_taints: The taints of the nodes that are removed by a daemon once the node is ready, keyed by the taint key.
+        :param pulumi.Input[Mapping[str, pulumi.Input['TaintArgs']]] taints: The taints of the nodes, keyed by the taint key.
+        :param pulumi.Input[_builtins.str] termination_grace_period: How long a node may take to drain before it is terminated forcefully, e.g. `24h`.
+        :param pulumi.Input[_builtins.int] weight: The priority of the node pool. Node pools with a higher weight are preferred.
+        """
+        pulumi.set(__self__, "cluster", cluster)
+        if disruption is not None:
+            pulumi.set(__self__, "disruption", disruption)
+        if expire_after is not None:
+            pulumi.set(__self__, "expire_after", expire_after)
+        if labels is not None:
+            pulumi.set(__self__, "labels", labels)
+        if limits is not None:
+            pulumi.set(__self__, "limits", limits)
+        if node_class_name is not None:
+            pulumi.set(__self__, "node_class_name", node_class_name)
+        if requirements is not None:
+            pulumi.set(__self__, "requirements", requirements)
+        if startup_taints is not None:
+            pulumi.set(__self__, "startup_taints", startup_taints)
+        if taints is not None:
+            pulumi.set(__self__, "taints", taints)
+        if termination_grace_period is not None:
+            pulumi.set(__self__, "termination_grace_period", termination_grace_period)
+        if weight is not None:
+            pulumi.set(__self__, "weight", weight)
+
+    @_builtins.property
+    @pulumi.getter
+    def cluster(self) -> pulumi.Input['Cluster']:
+        """
+        The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+        """
+        return pulumi.get(self, "cluster")
+
+    @cluster.setter
+    def cluster(self, value: pulumi.Input['Cluster']):
+        pulumi.set(self, "cluster", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def disruption(self) -> Optional[pulumi.Input['AutoModeDisruptionArgs']]:
+        """
+        The disruption settings of the node pool.
+        """
+        return pulumi.get(self, "disruption")
+
+    @disruption.setter
+    def disruption(self, value: Optional[pulumi.Input['AutoModeDisruptionArgs']]):
+        pulumi.set(self, "disruption", value)
+
+    @_builtins.property
+    @pulumi.getter(name="expireAfter")
+    def expire_after(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        How long a node lives before it is replaced, e.g. `336h`, or `Never`. Defaults to `336h`.
+        """
+        return pulumi.get(self, "expire_after")
+
+    @expire_after.setter
+    def expire_after(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "expire_after", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def labels(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        The labels of the nodes.
+        """
+        return pulumi.get(self, "labels")
+
+    @labels.setter
+    def labels(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "labels", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def limits(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        The maximum resources of all nodes of the node pool, e.g. `{ cpu: "1000", memory: "1000Gi" }`.
+        """
+        return pulumi.get(self, "limits")
+
+    @limits.setter
+    def limits(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "limits", value)
+
+    @_builtins.property
+    @pulumi.getter(name="nodeClassName")
+    def node_class_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The name of the NodeClass of the nodes, e.g. `nodeClass.nodeClassName`. Defaults to `default`, the NodeClass of the built-in node pools.
+        """
+        return pulumi.get(self, "node_class_name")
+
+    @node_class_name.setter
+    def node_class_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "node_class_name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def requirements(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['AutoModeNodeRequirementArgs']]]]:
+        """
+        The requirements the instances of the node pool have to fulfill.
+        """
+        return pulumi.get(self, "requirements")
+
+    @requirements.setter
+    def requirements(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['AutoModeNodeRequirementArgs']]]]):
+        pulumi.set(self, "requirements", value)
+
+    @_builtins.property
+    @pulumi.getter(name="startupTaints")
+    def startup_taints(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input['TaintArgs']]]]:
+        """
+        The taints of the nodes that are removed by a daemon once the node is ready, keyed by the taint key.
+        """
+        return pulumi.get(self, "startup_taints")
+
+    @startup_taints.setter
+    def startup_taints(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input['TaintArgs']]]]):
+        pulumi.set(self, "startup_taints", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def taints(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input['TaintArgs']]]]:
+        """
+        The taints of the nodes, keyed by the taint key.
+        """
+        return pulumi.get(self, "taints")
+
+    @taints.setter
+    def taints(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input['TaintArgs']]]]):
+        pulumi.set(self, "taints", value)
+
+    @_builtins.property
+    @pulumi.getter(name="terminationGracePeriod")
+    def termination_grace_period(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        How long a node may take to drain before it is terminated forcefully, e.g. `24h`.
+        """
+        return pulumi.get(self, "termination_grace_period")
+
+    @termination_grace_period.setter
+    def termination_grace_period(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "termination_grace_period", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def weight(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The priority of the node pool. Node pools with a higher weight are preferred.
+        """
+        return pulumi.get(self, "weight")
+
+    @weight.setter
+    def weight(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "weight", value)
+
+
+@pulumi.type_token("eks:index:AutoModeNodePool")
+class AutoModeNodePool(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 cluster: Optional[pulumi.Input['Cluster']] = None,
+                 disruption: Optional[pulumi.Input[Union['AutoModeDisruptionArgs', 'AutoModeDisruptionArgsDict']]] = None,
+                 expire_after: Optional[pulumi.Input[_builtins.str]] = None,
+                 labels: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 limits: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 node_class_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 requirements: Optional[pulumi.Input[Sequence[pulumi.Input[Union['AutoModeNodeRequirementArgs', 'AutoModeNodeRequirementArgsDict']]]]] = None,
+                 startup_taints: Optional[pulumi.Input[Mapping[str, pulumi.Input[Union['TaintArgs', 'TaintArgsDict']]]]] = None,
+                 taints: Optional[pulumi.Input[Mapping[str, pulumi.Input[Union['TaintArgs', 'TaintArgsDict']]]]] = None,
+                 termination_grace_period: Optional[pulumi.Input[_builtins.str]] = None,
+                 weight: Optional[pulumi.Input[_builtins.int]] = None,
+                 __props__=None):
+        """
+        AutoModeNodePool creates an EKS Auto Mode NodePool, which defines which nodes EKS Auto Mode launches for pending pods and when it disrupts them.
+        For more information see: https://docs.aws.amazon.com/eks/latest/userguide/create-node-pool.html
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input['Cluster'] cluster: The target EKS cluster. EKS Auto Mode must be enabled on the cluster.
+        :param pulumi.Input[Union['AutoModeDisruptionArgs', 'AutoModeDisruptionArgsDict']] disruption: The disruption settings of the node pool.
+        :param pulumi.Input[_builtins.str] expire_after: How long a node lives before it is replaced, e.g. `336h`, or `Never`. Defaults to `336h`.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] labels: The labels of the nodes.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] limits: The maximum resources of all nodes of the node pool, e.g. `{ cpu: "1000", memory: "1000Gi" }`.
+        :param pulumi.Input[_builtins.str] node_class_name: The name of the NodeClass of the nodes, e.g. `nodeClass.nodeClassName`. Defaults to `default`, the NodeClass of the built-in node pools.
+        :param pulumi.Input[Sequence[pulumi.Input[Union['AutoModeNodeRequirementArgs', 'AutoModeNodeRequirementArgsDict']]]] requirements: The requirements the instances of the node pool have to fulfill.
+        :param pulumi.Input[Mapping[str, pulumi.Input[Union['TaintArgs', 'TaintArgsDict']]]] startup_taints: The taints of the nodes that are removed by a daemon once the node is ready, keyed by the taint key.
+        :param pulumi.Input[Mapping[str, pulumi.Input[Union['TaintArgs', 'TaintArgsDict']]]] taints: The taints of the nodes, keyed by the taint key.
+        :param pulumi.Input[_builtins.str] termination_grace_period: How long a node may take to drain before it is terminated forcefully, e.g. `24h`.
+        :param pulumi.Input[_builtins.int] weight: The priority of the node pool. Node pools with a higher weight are preferred.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: AutoModeNodePoolArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        AutoModeNodePool creates an EKS Auto Mode NodePool, which defines which nodes EKS Auto Mode launches for pending pods and when it disrupts them.
+        For more information see: https://docs.aws.amazon.com/eks/latest/userguide/create-node-pool.html
+
+        :param str resource_name: The name of the resource.
+        :param AutoModeNodePoolArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(AutoModeNodePoolArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 cluster: Optional[pulumi.Input['Cluster']] = None,
+                 disruption: Optional[pulumi.Input[Union['AutoModeDisruptionArgs', 'AutoModeDisruptionArgsDict']]] = None,
+                 expire_after: Optional[pulumi.Input[_builtins.str]] = None,
+                 labels: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 limits: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 node_class_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 requirements: Optional[pulumi.Input[Sequence[pulumi.Input[Union['AutoModeNodeRequirementArgs', 'AutoModeNodeRequirementArgsDict']]]]] = None,
+                 startup_taints: Optional[pulumi.Input[Mapping[str, pulumi.Input[Union['TaintArgs', 'TaintArgsDict']]]]] = None,
+                 taints: Optional[pulumi.Input[Mapping[str, pulumi.Input[Union['TaintArgs', 'TaintArgsDict']]]]] = None,
+                 termination_grace_period: Optional[pulumi.Input[_builtins.str]] = None,
+                 weight: Optional[pulumi.Input[_builtins.int]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = AutoModeNodePoolArgs.__new__(AutoModeNodePoolArgs)
+
+            if cluster is None and not opts.urn:
+                raise TypeError("Missing required property 'cluster'")
+            __props__.__dict__["cluster"] = cluster
+            __props__.__dict__["disruption"] = disruption
+            __props__.__dict__["expire_after"] = expire_after
+            __props__.__dict__["labels"] = labels
+            __props__.__dict__["limits"] = limits
+            __props__.__dict__["node_class_name"] = node_class_name
+            __props__.__dict__["requirements"] = requirements
+            __props__.__dict__["startup_taints"] = startup_taints
+            __props__.__dict__["taints"] = taints
+            __props__.__dict__["termination_grace_period"] = termination_grace_period
+            __props__.__dict__["weight"] = weight
+            __props__.__dict__["node_pool"] = None
+            __props__.__dict__["node_pool_name"] = None
+        super(AutoModeNodePool, __self__).__init__(
+            'eks:index:AutoModeNodePool',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @_builtins.property
+    @pulumi.getter(name="nodePool")
+    def node_pool(self) -> pulumi.Output['pulumi_kubernetes.apiextensions.CustomResource']:
+        """
+        The NodePool custom resource.
+        """
+        return pulumi.get(self, "node_pool")
+
+    @_builtins.property
+    @pulumi.getter(name="nodePoolName")
+    def node_pool_name(self) -> pulumi.Output[_builtins.str]:
+        """
+        The name of the NodePool.
+        """
+        return pulumi.get(self, "node_pool_name")
+
@@ -29,9 +29,12 @@ class ClusterArgs:
                  authentication_mode: Optional['AuthenticationMode'] = None,
                  auto_mode: Optional['AutoModeOptionsArgs'] = None,
                  bootstrap_self_managed_addons: Optional[pulumi.Input[_builtins.bool]] = None,
+                 cluster_log_kms_key_arn: Optional[pulumi.Input[_builtins.str]] = None,
+                 cluster_log_retention_days: Optional[pulumi.Input[_builtins.int]] = None,
                  cluster_security_group: Optional[pulumi.Input['pulumi_aws.ec2.SecurityGroup']] = None,
                  cluster_security_group_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
                  cluster_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 control_plane_scaling_config: Optional[pulumi.Input['pulumi_aws.eks.ClusterControlPlaneScalingConfigArgs']] = None,
                  coredns_addon_options: Optional['CoreDnsAddonOptionsArgs'] = None,
                  create_instance_role: Optional[_builtins.bool] = None,
                  create_oidc_provider: Optional[pulumi.Input[_builtins.bool]] = None,
@@ -40,6 +43,7 @@ class ClusterArgs:
                  desired_capacity: Optional[pulumi.Input[_builtins.int]] = None,
                  enable_config_map_mutable: Optional[pulumi.Input[_builtins.bool]] = None,
                  enabled_cluster_log_types: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 encryption_config: Optional['EncryptionConfigOptionsArgs'] = None,
                  encryption_config_key_arn: Optional[pulumi.Input[_builtins.str]] = None,
                  endpoint_private_access: Optional[pulumi.Input[_builtins.bool]] = None,
                  endpoint_public_access: Optional[pulumi.Input[_builtins.bool]] = None,
@@ -81,7 +85,8 @@ class ClusterArgs:
                  user_mappings: Optional[pulumi.Input[Sequence[pulumi.Input['UserMappingArgs']]]] = None,
                  version: Optional[pulumi.Input[_builtins.str]] = None,
                  vpc_cni_options: Optional['VpcCniOptionsArgs'] = None,
-                 vpc_id: Optional[pulumi.Input[_builtins.str]] = None):
+                 vpc_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 zonal_shift_config: Optional[pulumi.Input['pulumi_aws.eks.ClusterZonalShiftConfigArgs']] = None):
         """
         The set of arguments for constructing a Cluster resource.
         :param Mapping[str, 'AccessEntryArgs'] access_entries: Access entries to add to the EKS cluster. They can be used to allow IAM principals to access the cluster. Access entries are only supported with authentication mode `API` or `API_AND_CONFIG_MAP`.
@@ -96,11 +101,23 @@ class ClusterArgs:
                
                For more information, see: https://docs.aws.amazon.com/eks/latest/userguide/automode.html
         :param pulumi.Input[_builtins.bool] bootstrap_self_managed_addons: Install default unmanaged add-ons, such as `aws-cni`, `kube-proxy`, and CoreDNS during cluster creation. If `false`, you must manually install desired add-ons. Changing this value will force a new cluster to be created. Defaults to `true`
+        :param pulumi.Input[_builtins.str] cluster_log_kms_key_arn: The ARN of the KMS key to encrypt the control plane logs in the `/aws/eks/<name>/cluster` log group with. The key policy must allow the CloudWatch Logs service principal of the region to use the key.
+               
+               When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set.
+               
+               See for more details:
+               - https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/encrypt-log-data-kms.html
+        :param pulumi.Input[_builtins.int] cluster_log_retention_days: The number of days to retain the control plane logs in the `/aws/eks/<name>/cluster` log group. Possible values are: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653, and 0. If set to 0, the logs never expire.
+               
+               When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set. Set `name` to the name of the existing cluster when enabling this for an existing cluster, and import the log group if EKS already created it.
         :param pulumi.Input['pulumi_aws.ec2.SecurityGroup'] cluster_security_group: The security group to use for the cluster API endpoint. If not provided, a new security group will be created with full internet egress and ingress from node groups.
                
                Note: The security group resource should not contain any inline ingress or egress rules.
         :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] cluster_security_group_tags: The tags to apply to the cluster security group.
         :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] cluster_tags: The tags to apply to the EKS cluster.
+        :param pulumi.Input['pulumi_aws.eks.ClusterControlPlaneScalingConfigArgs'] control_plane_scaling_config: The scaling configuration of the control plane. Setting a provisioned `tier` reserves control plane capacity for the cluster instead of scaling it on demand.
+               
+               See for more details: https://docs.aws.amazon.com/eks/latest/userguide/eks-provisioned-control-plane.html
         :param 'CoreDnsAddonOptionsArgs' coredns_addon_options: Options for managing the `coredns` addon.
         :param _builtins.bool create_instance_role: Whether to create the instance role for the EKS cluster. Defaults to true when using the default node group, false otherwise.
                If set to false when using the default node group, an instance role or instance profile must be provided.n
@@ -124,6 +141,11 @@ class ClusterArgs:
                Applies updates to the aws-auth ConfigMap in place over a replace operation if set to true.
                https://www.pulumi.com/registry/packages/kubernetes/api-docs/provider/#enableconfigmapmutable_nodejs
         :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] enabled_cluster_log_types: Enable EKS control plane logging. This sends logs to cloudwatch. Possible list of values are: ["api", "audit", "authenticator", "controllerManager", "scheduler"]. By default it is off.
+        :param 'EncryptionConfigOptionsArgs' encryption_config: Creates a KMS key and alias for the encryption configuration of the cluster. The key policy grants the cluster role the permissions it needs to use the key for envelope encryption of Kubernetes secrets.
+               
+               Mutually exclusive with `encryptionConfigKeyArn`.
+               See for more details:
+               - https://docs.aws.amazon.com/eks/latest/userguide/envelope-encryption.html
         :param pulumi.Input[_builtins.str] encryption_config_key_arn: KMS Key ARN to use with the encryption configuration for the cluster.
                
                Only available on Kubernetes 1.13+ clusters created after March 6, 2020.
@@ -272,6 +294,9 @@ class ClusterArgs:
         :param pulumi.Input[_builtins.str] version: Desired Kubernetes master / control plane version. If you do not specify a value, the latest available version is used.
         :param 'VpcCniOptionsArgs' vpc_cni_options: The configuration of the Amazon VPC CNI plugin for this instance. Defaults are described in the documentation for the VpcCniOptions type.
         :param pulumi.Input[_builtins.str] vpc_id: The VPC in which to create the cluster and its worker nodes. If unset, the cluster will be created in the default VPC.
+        :param pulumi.Input['pulumi_aws.eks.ClusterZonalShiftConfigArgs'] zonal_shift_config: The zonal shift configuration of the cluster. Enabling zonal shift lets Amazon Application Recovery Controller (ARC) move traffic away from an impaired Availability Zone.
+               
+               See for more details: https://docs.aws.amazon.com/eks/latest/userguide/zone-shift.html
         """
         if access_entries is not None:
             pulumi.set(__self__, "access_entries", access_entries)
@@ -281,12 +306,18 @@ class ClusterArgs:
             pulumi.set(__self__, "auto_mode", auto_mode)
         if bootstrap_self_managed_addons is not None:
             pulumi.set(__self__, "bootstrap_self_managed_addons", bootstrap_self_managed_addons)
+        if cluster_log_kms_key_arn is not None:
+            pulumi.set(__self__, "cluster_log_kms_key_arn", cluster_log_kms_key_arn)
+        if cluster_log_retention_days is not None:
+            pulumi.set(__self__, "cluster_log_retention_days", cluster_log_retention_days)
         if cluster_security_group is not None:
             pulumi.set(__self__, "cluster_security_group", cluster_security_group)
         if cluster_security_group_tags is not None:
             pulumi.set(__self__, "cluster_security_group_tags", cluster_security_group_tags)
         if cluster_tags is not None:
             pulumi.set(__self__, "cluster_tags", cluster_tags)
+        if control_plane_scaling_config is not None:
+            pulumi.set(__self__, "control_plane_scaling_config", control_plane_scaling_config)
         if coredns_addon_options is not None:
             pulumi.set(__self__, "coredns_addon_options", coredns_addon_options)
         if create_instance_role is not None:
@@ -303,6 +334,8 @@ class ClusterArgs:
             pulumi.set(__self__, "enable_config_map_mutable", enable_config_map_mutable)
         if enabled_cluster_log_types is not None:
             pulumi.set(__self__, "enabled_cluster_log_types", enabled_cluster_log_types)
+        if encryption_config is not None:
+            pulumi.set(__self__, "encryption_config", encryption_config)
         if encryption_config_key_arn is not None:
             pulumi.set(__self__, "encryption_config_key_arn", encryption_config_key_arn)
         if endpoint_private_access is not None:
@@ -387,6 +420,8 @@ class ClusterArgs:
             pulumi.set(__self__, "vpc_cni_options", vpc_cni_options)
         if vpc_id is not None:
             pulumi.set(__self__, "vpc_id", vpc_id)
+        if zonal_shift_config is not None:
+            pulumi.set(__self__, "zonal_shift_config", zonal_shift_config)
 
     @_builtins.property
     @pulumi.getter(name="accessEntries")
@@ -444,6 +479,37 @@ class ClusterArgs:
     def bootstrap_self_managed_addons(self, value: Optional[pulumi.Input[_builtins.bool]]):
         pulumi.set(self, "bootstrap_self_managed_addons", value)
 
+    @_builtins.property
+    @pulumi.getter(name="clusterLogKmsKeyArn")
+    def cluster_log_kms_key_arn(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The ARN of the KMS key to encrypt the control plane logs in the `/aws/eks/<name>/cluster` log group with. The key policy must allow the CloudWatch Logs service principal of the region to use the key.
+
+        When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set.
+
+        See for more details:
+        - https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/encrypt-log-data-kms.html
+        """
+        return pulumi.get(self, "cluster_log_kms_key_arn")
+
+    @cluster_log_kms_key_arn.setter
+    def cluster_log_kms_key_arn(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "cluster_log_kms_key_arn", value)
+
+    @_builtins.property
+    @pulumi.getter(name="clusterLogRetentionDays")
+    def cluster_log_retention_days(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The number of days to retain the control plane logs in the `/aws/eks/<name>/cluster` log group. Possible values are: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653, and 0. If set to 0, the logs never expire.
+
+        When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set. Set `name` to the name of the existing cluster when enabling this for an existing cluster, and import the log group if EKS already created it.
+        """
+        return pulumi.get(self, "cluster_log_retention_days")
+
+    @cluster_log_retention_days.setter
+    def cluster_log_retention_days(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "cluster_log_retention_days", value)
+
     @_builtins.property
     @pulumi.getter(name="clusterSecurityGroup")
     def cluster_security_group(self) -> Optional[pulumi.Input['pulumi_aws.ec2.SecurityGroup']]:
@@ -482,6 +548,20 @@ class ClusterArgs:
     def cluster_tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
         pulumi.set(self, "cluster_tags", value)
 
+    @_builtins.property
+    @pulumi.getter(name="controlPlaneScalingConfig")
+    def control_plane_scaling_config(self) -> Optional[pulumi.Input['pulumi_aws.eks.ClusterControlPlaneScalingConfigArgs']]:
+        """
+        The scaling configuration of the control plane. Setting a provisioned `tier` reserves control plane capacity for the cluster instead of scaling it on demand.
+
+        See for more details: https://docs.aws.amazon.com/eks/latest/userguide/eks-provisioned-control-plane.html
+        """
+        return pulumi.get(self, "control_plane_scaling_config")
+
+    @control_plane_scaling_config.setter
+    def control_plane_scaling_config(self, value: Optional[pulumi.Input['pulumi_aws.eks.ClusterControlPlaneScalingConfigArgs']]):
+        pulumi.set(self, "control_plane_scaling_config", value)
+
     @_builtins.property
     @pulumi.getter(name="corednsAddonOptions")
     def coredns_addon_options(self) -> Optional['CoreDnsAddonOptionsArgs']:
@@ -593,6 +673,22 @@ class ClusterArgs:
     def enabled_cluster_log_types(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
         pulumi.set(self, "enabled_cluster_log_types", value)
 
+    @_builtins.property
+    @pulumi.getter(name="encryptionConfig")
+    def encryption_config(self) -> Optional['EncryptionConfigOptionsArgs']:
+        """
+        Creates a KMS key and alias for the encryption configuration of the cluster. The key policy grants the cluster role the permissions it needs to use the key for envelope encryption of Kubernetes secrets.
+
+        Mutually exclusive with `encryptionConfigKeyArn`.
+        See for more details:
+        - https://docs.aws.amazon.com/eks/latest/userguide/envelope-encryption.html
+        """
+        return pulumi.get(self, "encryption_config")
+
+    @encryption_config.setter
+    def encryption_config(self, value: Optional['EncryptionConfigOptionsArgs']):
+        pulumi.set(self, "encryption_config", value)
+
     @_builtins.property
     @pulumi.getter(name="encryptionConfigKeyArn")
     def encryption_config_key_arn(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -1203,6 +1299,20 @@ class ClusterArgs:
     def vpc_id(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "vpc_id", value)
 
+    @_builtins.property
+    @pulumi.getter(name="zonalShiftConfig")
+    def zonal_shift_config(self) -> Optional[pulumi.Input['pulumi_aws.eks.ClusterZonalShiftConfigArgs']]:
+        """
+        The zonal shift configuration of the cluster. Enabling zonal shift lets Amazon Application Recovery Controller (ARC) move traffic away from an impaired Availability Zone.
+
+        See for more details: https://docs.aws.amazon.com/eks/latest/userguide/zone-shift.html
+        """
+        return pulumi.get(self, "zonal_shift_config")
+
+    @zonal_shift_config.setter
+    def zonal_shift_config(self, value: Optional[pulumi.Input['pulumi_aws.eks.ClusterZonalShiftConfigArgs']]):
+        pulumi.set(self, "zonal_shift_config", value)
+
 
 @pulumi.type_token("eks:index:Cluster")
 class Cluster(pulumi.ComponentResource):
@@ -1214,9 +1324,12 @@ class Cluster(pulumi.ComponentResource):
                  authentication_mode: Optional['AuthenticationMode'] = None,
                  auto_mode: Optional[Union['AutoModeOptionsArgs', 'AutoModeOptionsArgsDict']] = None,
                  bootstrap_self_managed_addons: Optional[pulumi.Input[_builtins.bool]] = None,
+                 cluster_log_kms_key_arn: Optional[pulumi.Input[_builtins.str]] = None,
+                 cluster_log_retention_days: Optional[pulumi.Input[_builtins.int]] = None,
                  cluster_security_group: Optional[pulumi.Input['pulumi_aws.ec2.SecurityGroup']] = None,
                  cluster_security_group_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
                  cluster_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 control_plane_scaling_config: Optional[pulumi.Input[pulumi.InputType['pulumi_aws.eks.ClusterControlPlaneScalingConfigArgs']]] = None,
                  coredns_addon_options: Optional[Union['CoreDnsAddonOptionsArgs', 'CoreDnsAddonOptionsArgsDict']] = None,
                  create_instance_role: Optional[_builtins.bool] = None,
                  create_oidc_provider: Optional[pulumi.Input[_builtins.bool]] = None,
@@ -1225,6 +1338,7 @@ class Cluster(pulumi.ComponentResource):
                  desired_capacity: Optional[pulumi.Input[_builtins.int]] = None,
                  enable_config_map_mutable: Optional[pulumi.Input[_builtins.bool]] = None,
                  enabled_cluster_log_types: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 encryption_config: Optional[Union['EncryptionConfigOptionsArgs', 'EncryptionConfigOptionsArgsDict']] = None,
                  encryption_config_key_arn: Optional[pulumi.Input[_builtins.str]] = None,
                  endpoint_private_access: Optional[pulumi.Input[_builtins.bool]] = None,
                  endpoint_public_access: Optional[pulumi.Input[_builtins.bool]] = None,
@@ -1267,6 +1381,7 @@ class Cluster(pulumi.ComponentResource):
                  version: Optional[pulumi.Input[_builtins.str]] = None,
                  vpc_cni_options: Optional[Union['VpcCniOptionsArgs', 'VpcCniOptionsArgsDict']] = None,
                  vpc_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 zonal_shift_config: Optional[pulumi.Input[pulumi.InputType['pulumi_aws.eks.ClusterZonalShiftConfigArgs']]] = None,
                  __props__=None):
         """
         Cluster is a component that wraps the AWS and Kubernetes resources necessary to run an EKS cluster, its worker nodes, its optional StorageClasses, and an optional deployment of the Kubernetes Dashboard.
@@ -1303,11 +1418,23 @@ class Cluster(pulumi.ComponentResource):
                
                For more information, see: https://docs.aws.amazon.com/eks/latest/userguide/automode.html
         :param pulumi.Input[_builtins.bool] bootstrap_self_managed_addons: Install default unmanaged add-ons, such as `aws-cni`, `kube-proxy`, and CoreDNS during cluster creation. If `false`, you must manually install desired add-ons. Changing this value will force a new cluster to be created. Defaults to `true`
+        :param pulumi.Input[_builtins.str] cluster_log_kms_key_arn: The ARN of the KMS key to encrypt the control plane logs in the `/aws/eks/<name>/cluster` log group with. The key policy must allow the CloudWatch Logs service principal of the region to use the key.
+               
+               When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set.
+               
+               See for more details:
+               - https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/encrypt-log-data-kms.html
+        :param pulumi.Input[_builtins.int] cluster_log_retention_days: The number of days to retain the control plane logs in the `/aws/eks/<name>/cluster` log group. Possible values are: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653, and 0. If set to 0, the logs never expire.
+               
+               When set, the log group is created and managed by this component before the cluster is created. This requires `name` to be set. Set `name` to the name of the existing cluster when enabling this for an existing cluster, and import the log group if EKS already created it.
         :param pulumi.Input['pulumi_aws.ec2.SecurityGroup'] cluster_security_group: The security group to use for the cluster API endpoint. If not provided, a new security group will be created with full internet egress and ingress from node groups.
                
                Note: The security group resource should not contain any inline ingress or egress rules.
         :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] cluster_security_group_tags: The tags to apply to the cluster security group.
         :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] cluster_tags: The tags to apply to the EKS cluster.
+        :param pulumi.Input[pulumi.InputType['pulumi_aws.eks.ClusterControlPlaneScalingConfigArgs']] control_plane_scaling_config: The scaling configuration of the control plane. Setting a provisioned `tier` reserves control plane capacity for the cluster instead of scaling it on demand.
+               
+               See for more details: https://docs.aws.amazon.com/eks/latest/userguide/eks-provisioned-control-plane.html
         :param Union['CoreDnsAddonOptionsArgs', 'CoreDnsAddonOptionsArgsDict'] coredns_addon_options: Options for managing the `coredns` addon.
         :param _builtins.bool create_instance_role: Whether to create the instance role for the EKS cluster. Defaults to true when using the default node group, false otherwise.
                If set to false when using the default node group, an instance role or instance profile must be provided.n
@@ -1331,6 +1458,11 @@ class Cluster(pulumi.ComponentResource):
                Applies updates to the aws-auth ConfigMap in place over a replace operation if set to true.
                https://www.pulumi.com/registry/packages/kubernetes/api-docs/provider/#enableconfigmapmutable_nodejs
         :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] enabled_cluster_log_types: Enable EKS control plane logging. This sends logs to cloudwatch. Possible list of values are: ["api", "audit", "authenticator", "controllerManager", "scheduler"]. By default it is off.
+        :param Union['EncryptionConfigOptionsArgs', 'EncryptionConfigOptionsArgsDict'] encryption_config: Creates a KMS key and alias for the encryption configuration of the cluster. The key policy grants the cluster role the permissions it needs to use the key for envelope encryption of Kubernetes secrets.
+               
+               Mutually exclusive with `encryptionConfigKeyArn`.
+               See for more details:
+               - https://docs.aws.amazon.com/eks/latest/userguide/envelope-encryption.html
         :param pulumi.Input[_builtins.str] encryption_config_key_arn: KMS Key ARN to use with the encryption configuration for the cluster.
                
                Only available on Kubernetes 1.13+ clusters created after March 6, 2020.
@@ -1479,6 +1611,9 @@ class Cluster(pulumi.ComponentResource):
         :param pulumi.Input[_builtins.str] version: Desired Kubernetes master / control plane version. If you do not specify a value, the latest available version is used.
         :param Union['VpcCniOptionsArgs', 'VpcCniOptionsArgsDict'] vpc_cni_options: The configuration of the Amazon VPC CNI plugin for this instance. Defaults are described in the documentation for the VpcCniOptions type.
         :param pulumi.Input[_builtins.str] vpc_id: The VPC in which to create the cluster and its worker nodes. If unset, the cluster will be created in the default VPC.
+        :param pulumi.Input[pulumi.InputType['pulumi_aws.eks.ClusterZonalShiftConfigArgs']] zonal_shift_config: The zonal shift configuration of the cluster. Enabling zonal shift lets Amazon Application Recovery Controller (ARC) move traffic away from an impaired Availability Zone.
+               
+               See for more details: https://docs.aws.amazon.com/eks/latest/userguide/zone-shift.html
         """
         ...
     @overload
@@ -1526,9 +1661,12 @@ class Cluster(pulumi.ComponentResource):
                  authentication_mode: Optional['AuthenticationMode'] = None,
                  auto_mode: Optional[Union['AutoModeOptionsArgs', 'AutoModeOptionsArgsDict']] = None,
                  bootstrap_self_managed_addons: Optional[pulumi.Input[_builtins.bool]] = None,
+                 cluster_log_kms_key_arn: Optional[pulumi.Input[_builtins.str]] = None,
+                 cluster_log_retention_days: Optional[pulumi.Input[_builtins.int]] = None,
                  cluster_security_group: Optional[pulumi.Input['pulumi_aws.ec2.SecurityGroup']] = None,
                  cluster_security_group_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
                  cluster_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 control_plane_scaling_config: Optional[pulumi.Input[pulumi.InputType['pulumi_aws.eks.ClusterControlPlaneScalingConfigArgs']]] = None,
                  coredns_addon_options: Optional[Union['CoreDnsAddonOptionsArgs', 'CoreDnsAddonOptionsArgsDict']] = None,
                  create_instance_role: Optional[_builtins.bool] = None,
                  create_oidc_provider: Optional[pulumi.Input[_builtins.bool]] = None,
@@ -1537,6 +1675,7 @@ class Cluster(pulumi.ComponentResource):
                  desired_capacity: Optional[pulumi.Input[_builtins.int]] = None,
                  enable_config_map_mutable: Optional[pulumi.Input[_builtins.bool]] = None,
                  enabled_cluster_log_types: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 encryption_config: Optional[Union['EncryptionConfigOptionsArgs', 'EncryptionConfigOptionsArgsDict']] = None,
                  encryption_config_key_arn: Optional[pulumi.Input[_builtins.str]] = None,
                  endpoint_private_access: Optional[pulumi.Input[_builtins.bool]] = None,
                  endpoint_public_access: Optional[pulumi.Input[_builtins.bool]] = None,
@@ -1579,6 +1718,7 @@ class Cluster(pulumi.ComponentResource):
                  version: Optional[pulumi.Input[_builtins.str]] = None,
                  vpc_cni_options: Optional[Union['VpcCniOptionsArgs', 'VpcCniOptionsArgsDict']] = None,
                  vpc_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 zonal_shift_config: Optional[pulumi.Input[pulumi.InputType['pulumi_aws.eks.ClusterZonalShiftConfigArgs']]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
         if not isinstance(opts, pulumi.ResourceOptions):
@@ -1594,9 +1734,12 @@ class Cluster(pulumi.ComponentResource):
             __props__.__dict__["authentication_mode"] = authentication_mode
             __props__.__dict__["auto_mode"] = auto_mode
             __props__.__dict__["bootstrap_self_managed_addons"] = bootstrap_self_managed_addons
+            __props__.__dict__["cluster_log_kms_key_arn"] = cluster_log_kms_key_arn
+            __props__.__dict__["cluster_log_retention_days"] = cluster_log_retention_days
             __props__.__dict__["cluster_security_group"] = cluster_security_group
             __props__.__dict__["cluster_security_group_tags"] = cluster_security_group_tags
             __props__.__dict__["cluster_tags"] = cluster_tags
+            __props__.__dict__["control_plane_scaling_config"] = control_plane_scaling_config
             __props__.__dict__["coredns_addon_options"] = coredns_addon_options
             __props__.__dict__["create_instance_role"] = create_instance_role
             __props__.__dict__["create_oidc_provider"] = create_oidc_provider
@@ -1605,6 +1748,7 @@ class Cluster(pulumi.ComponentResource):
             __props__.__dict__["desired_capacity"] = desired_capacity
             __props__.__dict__["enable_config_map_mutable"] = enable_config_map_mutable
             __props__.__dict__["enabled_cluster_log_types"] = enabled_cluster_log_types
+            __props__.__dict__["encryption_config"] = encryption_config
             __props__.__dict__["encryption_config_key_arn"] = encryption_config_key_arn
             __props__.__dict__["endpoint_private_access"] = endpoint_private_access
             __props__.__dict__["endpoint_public_access"] = endpoint_public_access
@@ -1647,6 +1791,7 @@ class Cluster(pulumi.ComponentResource):
             __props__.__dict__["version"] = version
             __props__.__dict__["vpc_cni_options"] = vpc_cni_options
             __props__.__dict__["vpc_id"] = vpc_id
+            __props__.__dict__["zonal_shift_config"] = zonal_shift_config
             __props__.__dict__["auto_mode_node_role_name"] = None
             __props__.__dict__["aws_provider"] = None
             __props__.__dict__["cluster_ingress_rule_id"] = None
@@ -1850,8 +1995,13 @@ class Cluster(pulumi.ComponentResource):
             return pulumi.get(self, "result")
 
     def get_kubeconfig(__self__, *,
+                       env: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                       external_id: Optional[pulumi.Input[_builtins.str]] = None,
                        profile_name: Optional[pulumi.Input[_builtins.str]] = None,
-                       role_arn: Optional[pulumi.Input[_builtins.str]] = None) -> pulumi.Output['str']:
+                       region: Optional[pulumi.Input[_builtins.str]] = None,
+                       role_arn: Optional[pulumi.Input[_builtins.str]] = None,
+                       role_session_name: Optional[pulumi.Input[_builtins.str]] = None,
+                       token_tool: Optional[pulumi.Input['KubeconfigTokenTool']] = None) -> pulumi.Output['str']:
         """
         Generate a kubeconfig for cluster authentication that does not use the default AWS credential provider chain, and instead is scoped to the supported options in `KubeconfigOptions`.
 
@@ -1863,17 +2013,102 @@ class Cluster(pulumi.ComponentResource):
         - https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-profiles.html
 
 
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] env: Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+        :param pulumi.Input[_builtins.str] external_id: External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+               
+               Only supported by the `aws-iam-authenticator` token tool.
         :param pulumi.Input[_builtins.str] profile_name: AWS credential profile name to always use instead of the default AWS credential provider chain.
                
                The profile is passed to kubeconfig as an authentication environment setting.
+        :param pulumi.Input[_builtins.str] region: AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
         :param pulumi.Input[_builtins.str] role_arn: Role ARN to assume instead of the default AWS credential provider chain.
                
                The role is passed to kubeconfig as an authentication exec argument.
+        :param pulumi.Input[_builtins.str] role_session_name: Session name to use when assuming `roleArn`.
+               
+               Only supported by the `aws-iam-authenticator` token tool.
+        :param pulumi.Input['KubeconfigTokenTool'] token_tool: The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
         """
         __args__ = dict()
         __args__['__self__'] = __self__
+        __args__['env'] = env
+        __args__['externalId'] = external_id
         __args__['profileName'] = profile_name
+        __args__['region'] = region
         __args__['roleArn'] = role_arn
+        __args__['roleSessionName'] = role_session_name
+        __args__['tokenTool'] = token_tool
         __result__ = pulumi.runtime.call('eks:index:Cluster/getKubeconfig', __args__, res=__self__, typ=Cluster.GetKubeconfigResult)
         return __result__.result
 
+    @pulumi.output_type
+    class GetStaticKubeconfigResult:
+        def __init__(__self__, expiration_timestamp=None, kubeconfig=None):
+            if expiration_timestamp and not isinstance(expiration_timestamp, str):
+                raise TypeError("Expected argument 'expiration_timestamp' to be a str")
+            pulumi.set(__self__, "expiration_timestamp", expiration_timestamp)
+            if kubeconfig and not isinstance(kubeconfig, str):
+                raise TypeError("Expected argument 'kubeconfig' to be a str")
+            pulumi.set(__self__, "kubeconfig", kubeconfig)
+
+        @_builtins.property
+        @pulumi.getter(name="expirationTimestamp")
+        def expiration_timestamp(self) -> _builtins.str:
+            """
+            The time at which the token expires, in RFC 3339 format.
+            """
+            return pulumi.get(self, "expiration_timestamp")
+
+        @_builtins.property
+        @pulumi.getter
+        def kubeconfig(self) -> _builtins.str:
+            """
+            The stringified kubeconfig containing the bearer token for the cluster.
+            """
+            return pulumi.get(self, "kubeconfig")
+
+    def get_static_kubeconfig(__self__, *,
+                              env: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                              external_id: Optional[pulumi.Input[_builtins.str]] = None,
+                              profile_name: Optional[pulumi.Input[_builtins.str]] = None,
+                              region: Optional[pulumi.Input[_builtins.str]] = None,
+                              role_arn: Optional[pulumi.Input[_builtins.str]] = None,
+                              role_session_name: Optional[pulumi.Input[_builtins.str]] = None,
+                              token_tool: Optional[pulumi.Input['KubeconfigTokenTool']] = None) -> pulumi.Output['Cluster.GetStaticKubeconfigResult']:
+        """
+        Generate a kubeconfig that authenticates with a static bearer token instead of an exec plugin. This is useful for consumers that cannot run the AWS CLI, e.g. Argo CD cluster secrets or services using a Kubernetes client library.
+
+        The token is a pre-signed STS `GetCallerIdentity` request retrieved with the configured `tokenTool` when the program runs, so the tool needs to be installed where Pulumi runs. Tokens are short-lived (15 minutes) and a new token is generated on every update, so consumers need to refresh the kubeconfig before `expirationTimestamp`.
+
+        See for more details:
+        - https://docs.aws.amazon.com/eks/latest/userguide/cluster-auth.html
+        - https://github.com/kubernetes-sigs/aws-iam-authenticator#api-authorization-from-outside-a-cluster
+
+
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] env: Additional environment variables to set for the exec plugin that retrieves the token, e.g. to configure credential wrappers like granted or aws-vault.
+        :param pulumi.Input[_builtins.str] external_id: External ID to pass when assuming `roleArn`. Required by roles whose trust policy has an `sts:ExternalId` condition.
+               
+               Only supported by the `aws-iam-authenticator` token tool.
+        :param pulumi.Input[_builtins.str] profile_name: AWS credential profile name to always use instead of the default AWS credential provider chain.
+               
+               The profile is passed to kubeconfig as an authentication environment setting.
+        :param pulumi.Input[_builtins.str] region: AWS region of the STS endpoint used to generate the token. Defaults to the region of the cluster.
+        :param pulumi.Input[_builtins.str] role_arn: Role ARN to assume instead of the default AWS credential provider chain.
+               
+               The role is passed to kubeconfig as an authentication exec argument.
+        :param pulumi.Input[_builtins.str] role_session_name: Session name to use when assuming `roleArn`.
+               
+               Only supported by the `aws-iam-authenticator` token tool.
+        :param pulumi.Input['KubeconfigTokenTool'] token_tool: The tool the kubeconfig uses to retrieve a token for the cluster. Defaults to `aws`, i.e. `aws eks get-token`.
+        """
+        __args__ = dict()
+        __args__['__self__'] = __self__
+        __args__['env'] = env
+        __args__['externalId'] = external_id
+        __args__['profileName'] = profile_name
+        __args__['region'] = region
+        __args__['roleArn'] = role_arn
+        __args__['roleSessionName'] = role_session_name
+        __args__['tokenTool'] = token_tool
+        return pulumi.runtime.call('eks:index:Cluster/getStaticKubeconfig', __args__, res=__self__, typ=Cluster.GetStaticKubeconfigResult)
+
@@ -0,0 +1,240 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-eks. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from .cluster import Cluster
+import pulumi_aws
+import pulumi_kubernetes
+
+__all__ = ['ClusterAutoscalerArgs', 'ClusterAutoscaler']
+
+@pulumi.input_type
+class ClusterAutoscalerArgs:
+    def __init__(__self__, *,
+                 cluster: pulumi.Input['Cluster'],
+                 chart_version: Optional[pulumi.Input[_builtins.str]] = None,
+                 namespace: Optional[pulumi.Input[_builtins.str]] = None,
+                 oidc_provider_arn: Optional[pulumi.Input[_builtins.str]] = None,
+                 service_account_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 values: Optional[pulumi.Input[Mapping[str, Any]]] = None):
+        """
+        The set of arguments for constructing a ClusterAutoscaler resource.
+        :param pulumi.Input['Cluster'] cluster: The target EKS cluster.
+        :param pulumi.Input[_builtins.str] chart_version: The version of the cluster-autoscaler Helm chart. Defaults to `9.46.6`.
+        :param pulumi.Input[_builtins.str] namespace: The namespace to install the autoscaler into. Defaults to `kube-system`.
+        :param pulumi.Input[_builtins.str] oidc_provider_arn: The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+               
+               If set, the autoscaler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+        :param pulumi.Input[_builtins.str] service_account_name: The name of the Kubernetes service account of the autoscaler. Defaults to `cluster-autoscaler`.
+        :param pulumi.Input[Mapping[str, Any]] values: Custom values for the Helm chart. The values are merged with the values set by the component, which are `autoDiscovery`, `awsRegion` and `rbac.serviceAccount`.
+        """
+        pulumi.set(__self__, "cluster", cluster)
+        if chart_version is not None:
+            pulumi.set(__self__, "chart_version", chart_version)
+        if namespace is not None:
+            pulumi.set(__self__, "namespace", namespace)
+        if oidc_provider_arn is not None:
+            pulumi.set(__self__, "oidc_provider_arn", oidc_provider_arn)
+        if service_account_name is not None:
+            pulumi.set(__self__, "service_account_name", service_account_name)
+        if values is not None:
+            pulumi.set(__self__, "values", values)
+
+    @_builtins.property
+    @pulumi.getter
+    def cluster(self) -> pulumi.Input['Cluster']:
+        """
+        The target EKS cluster.
+        """
+        return pulumi.get(self, "cluster")
+
+    @cluster.setter
+    def cluster(self, value: pulumi.Input['Cluster']):
+        pulumi.set(self, "cluster", value)
+
+    @_builtins.property
+    @pulumi.getter(name="chartVersion")
+    def chart_version(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The version of the cluster-autoscaler Helm chart. Defaults to `9.46.6`.
+        """
+        return pulumi.get(self, "chart_version")
+
+    @chart_version.setter
+    def chart_version(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "chart_version", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def namespace(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The namespace to install the autoscaler into. Defaults to `kube-system`.
+        """
+        return pulumi.get(self, "namespace")
+
+    @namespace.setter
+    def namespace(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "namespace", value)
+
+    @_builtins.property
+    @pulumi.getter(name="oidcProviderArn")
+    def oidc_provider_arn(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+
+        If set, the autoscaler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+        """
+        return pulumi.get(self, "oidc_provider_arn")
+
+    @oidc_provider_arn.setter
+    def oidc_provider_arn(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "oidc_provider_arn", value)
+
+    @_builtins.property
+    @pulumi.getter(name="serviceAccountName")
+    def service_account_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The name of the Kubernetes service account of the autoscaler. Defaults to `cluster-autoscaler`.
+        """
+        return pulumi.get(self, "service_account_name")
+
+    @service_account_name.setter
+    def service_account_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "service_account_name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def values(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        Custom values for the Helm chart. The values are merged with the values set by the component, which are `autoDiscovery`, `awsRegion` and `rbac.serviceAccount`.
+        """
+        return pulumi.get(self, "values")
+
+    @values.setter
+    def values(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "values", value)
+
+
+@pulumi.type_token("eks:index:ClusterAutoscaler")
+class ClusterAutoscaler(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 chart_version: Optional[pulumi.Input[_builtins.str]] = None,
+                 cluster: Optional[pulumi.Input['Cluster']] = None,
+                 namespace: Optional[pulumi.Input[_builtins.str]] = None,
+                 oidc_provider_arn: Optional[pulumi.Input[_builtins.str]] = None,
+                 service_account_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 values: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 __props__=None):
+        """
+        ClusterAutoscaler installs the Kubernetes Cluster Autoscaler into an EKS cluster. It creates the IAM role of the autoscaler and installs the cluster-autoscaler Helm chart, which discovers the Auto Scaling Groups tagged for the cluster. Node groups are tagged for discovery with their `autoscalerDiscovery` option.
+        For more information see: https://github.com/kubernetes/autoscaler/blob/master/cluster-autoscaler/cloudprovider/aws/README.md
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[_builtins.str] chart_version: The version of the cluster-autoscaler Helm chart. Defaults to `9.46.6`.
+        :param pulumi.Input['Cluster'] cluster: The target EKS cluster.
+        :param pulumi.Input[_builtins.str] namespace: The namespace to install the autoscaler into. Defaults to `kube-system`.
+        :param pulumi.Input[_builtins.str] oidc_provider_arn: The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+               
+               If set, the autoscaler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+        :param pulumi.Input[_builtins.str] service_account_name: The name of the Kubernetes service account of the autoscaler. Defaults to `cluster-autoscaler`.
+        :param pulumi.Input[Mapping[str, Any]] values: Custom values for the Helm chart. The values are merged with the values set by the component, which are `autoDiscovery`, `awsRegion` and `rbac.serviceAccount`.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: ClusterAutoscalerArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        ClusterAutoscaler installs the Kubernetes Cluster Autoscaler into an EKS cluster. It creates the IAM role of the autoscaler and installs the cluster-autoscaler Helm chart, which discovers the Auto Scaling Groups tagged for the cluster. Node groups are tagged for discovery with their `autoscalerDiscovery` option.
+        For more information see: https://github.com/kubernetes/autoscaler/blob/master/cluster-autoscaler/cloudprovider/aws/README.md
+
+        :param str resource_name: The name of the resource.
+        :param ClusterAutoscalerArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(ClusterAutoscalerArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 chart_version: Optional[pulumi.Input[_builtins.str]] = None,
+                 cluster: Optional[pulumi.Input['Cluster']] = None,
+                 namespace: Optional[pulumi.Input[_builtins.str]] = None,
+                 oidc_provider_arn: Optional[pulumi.Input[_builtins.str]] = None,
+                 service_account_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 values: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = ClusterAutoscalerArgs.__new__(ClusterAutoscalerArgs)
+
+            __props__.__dict__["chart_version"] = chart_version
+            if cluster is None and not opts.urn:
+                raise TypeError("Missing required property 'cluster'")
+            __props__.__dict__["cluster"] = cluster
+            __props__.__dict__["namespace"] = namespace
+            __props__.__dict__["oidc_provider_arn"] = oidc_provider_arn
+            __props__.__dict__["service_account_name"] = service_account_name
+            __props__.__dict__["values"] = values
+            __props__.__dict__["policy"] = None
+            __props__.__dict__["release"] = None
+            __props__.__dict__["role"] = None
+        super(ClusterAutoscaler, __self__).__init__(
+            'eks:index:ClusterAutoscaler',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @_builtins.property
+    @pulumi.getter
+    def policy(self) -> pulumi.Output['pulumi_aws.iam.Policy']:
+        """
+        The IAM policy with the permissions of the autoscaler.
+        """
+        return pulumi.get(self, "policy")
+
+    @_builtins.property
+    @pulumi.getter
+    def release(self) -> pulumi.Output['pulumi_kubernetes.helm.v3.Release']:
+        """
+        The Helm release of the autoscaler.
+        """
+        return pulumi.get(self, "release")
+
+    @_builtins.property
+    @pulumi.getter
+    def role(self) -> pulumi.Output['pulumi_aws.iam.Role']:
+        """
+        The IAM role of the autoscaler.
+        """
+        return pulumi.get(self, "role")
+
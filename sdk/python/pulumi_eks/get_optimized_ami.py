@@ -0,0 +1,140 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-eks. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from ._enums import *
+
+__all__ = [
+    'GetOptimizedAmiResult',
+    'AwaitableGetOptimizedAmiResult',
+    'get_optimized_ami',
+    'get_optimized_ami_output',
+]
+
+@pulumi.output_type
+class GetOptimizedAmiResult:
+    def __init__(__self__, ami_id=None, release_version=None, ssm_parameter_name=None):
+        if ami_id and not isinstance(ami_id, str):
+            raise TypeError("Expected argument 'ami_id' to be a str")
+        pulumi.set(__self__, "ami_id", ami_id)
+        if release_version and not isinstance(release_version, str):
+            raise TypeError("Expected argument 'release_version' to be a str")
+        pulumi.set(__self__, "release_version", release_version)
+        if ssm_parameter_name and not isinstance(ssm_parameter_name, str):
+            raise TypeError("Expected argument 'ssm_parameter_name' to be a str")
+        pulumi.set(__self__, "ssm_parameter_name", ssm_parameter_name)
+
+    @_builtins.property
+    @pulumi.getter(name="amiId")
+    def ami_id(self) -> _builtins.str:
+        """
+        The ID of the AMI.
+        """
+        return pulumi.get(self, "ami_id")
+
+    @_builtins.property
+    @pulumi.getter(name="releaseVersion")
+    def release_version(self) -> _builtins.str:
+        """
+        The release version of the AMI.
+        """
+        return pulumi.get(self, "release_version")
+
+    @_builtins.property
+    @pulumi.getter(name="ssmParameterName")
+    def ssm_parameter_name(self) -> _builtins.str:
+        """
+        The name of the SSM parameter the AMI ID was read from. The parameter is specific to the release version of the AMI and does not change when new AMIs are released.
+        """
+        return pulumi.get(self, "ssm_parameter_name")
+
+
+class AwaitableGetOptimizedAmiResult(GetOptimizedAmiResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetOptimizedAmiResult(
+            ami_id=self.ami_id,
+            release_version=self.release_version,
+            ssm_parameter_name=self.ssm_parameter_name)
+
+
+def get_optimized_ami(ami_type: Optional['AmiType'] = None,
+                      kubernetes_version: Optional[_builtins.str] = None,
+                      region: Optional[_builtins.str] = None,
+                      release_version: Optional[_builtins.str] = None,
+                      opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetOptimizedAmiResult:
+    """
+    Resolves the EKS optimized AMI for a Kubernetes version and AMI type. The AMI is looked up via the public SSM parameters published by AWS, the same way node groups resolve their AMI when no `amiId` is provided.
+
+    If no `releaseVersion` is specified, the release version of the currently recommended AMI is used. The resolved release version is returned so that it can be pinned, e.g. to roll out AMI updates in a controlled fashion by feeding the `amiId` into `NodeGroupV2.amiId`.
+
+    The SSM parameters are read using the AWS CLI, which needs to be installed and configured with credentials for the account of the stack. The region is always passed explicitly.
+
+    See for more details: https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
+
+
+    :param 'AmiType' ami_type: The AMI type to resolve the AMI for.
+    :param _builtins.str kubernetes_version: The Kubernetes version of the AMI, e.g. `1.31`.
+    :param _builtins.str region: The AWS region to resolve the AMI in. This should be the region of the stack, e.g. the `aws:region` config value.
+    :param _builtins.str release_version: The release version of the AMI to resolve. For Amazon Linux AMIs this is the version reported by EKS, e.g. `1.31.0-20240917`. For Bottlerocket AMIs this is the Bottlerocket version, e.g. `1.22.0`.
+           
+           Defaults to the release version of the currently recommended AMI.
+    """
+    __args__ = dict()
+    __args__['amiType'] = ami_type
+    __args__['kubernetesVersion'] = kubernetes_version
+    __args__['region'] = region
+    __args__['releaseVersion'] = release_version
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('eks:index:getOptimizedAmi', __args__, opts=opts, typ=GetOptimizedAmiResult).value
+
+    return AwaitableGetOptimizedAmiResult(
+        ami_id=pulumi.get(__ret__, 'ami_id'),
+        release_version=pulumi.get(__ret__, 'release_version'),
+        ssm_parameter_name=pulumi.get(__ret__, 'ssm_parameter_name'))
+def get_optimized_ami_output(ami_type: Optional[pulumi.Input['AmiType']] = None,
+                             kubernetes_version: Optional[pulumi.Input[_builtins.str]] = None,
+                             region: Optional[pulumi.Input[_builtins.str]] = None,
+                             release_version: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                             opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetOptimizedAmiResult]:
+    """
+    Resolves the EKS optimized AMI for a Kubernetes version and AMI type. The AMI is looked up via the public SSM parameters published by AWS, the same way node groups resolve their AMI when no `amiId` is provided.
+
+    If no `releaseVersion` is specified, the release version of the currently recommended AMI is used. The resolved release version is returned so that it can be pinned, e.g. to roll out AMI updates in a controlled fashion by feeding the `amiId` into `NodeGroupV2.amiId`.
+
+    The SSM parameters are read using the AWS CLI, which needs to be installed and configured with credentials for the account of the stack. The region is always passed explicitly.
+
+    See for more details: https://docs.aws.amazon.com/eks/latest/userguide/retrieve-ami-id.html
+
+
+    :param 'AmiType' ami_type: The AMI type to resolve the AMI for.
+    :param _builtins.str kubernetes_version: The Kubernetes version of the AMI, e.g. `1.31`.
+    :param _builtins.str region: The AWS region to resolve the AMI in. This should be the region of the stack, e.g. the `aws:region` config value.
+    :param _builtins.str release_version: The release version of the AMI to resolve. For Amazon Linux AMIs this is the version reported by EKS, e.g. `1.31.0-20240917`. For Bottlerocket AMIs this is the Bottlerocket version, e.g. `1.22.0`.
+           
+           Defaults to the release version of the currently recommended AMI.
+    """
+    __args__ = dict()
+    __args__['amiType'] = ami_type
+    __args__['kubernetesVersion'] = kubernetes_version
+    __args__['region'] = region
+    __args__['releaseVersion'] = release_version
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('eks:index:getOptimizedAmi', __args__, opts=opts, typ=GetOptimizedAmiResult)
+    return __ret__.apply(lambda __response__: GetOptimizedAmiResult(
+        ami_id=pulumi.get(__response__, 'ami_id'),
+        release_version=pulumi.get(__response__, 'release_version'),
+        ssm_parameter_name=pulumi.get(__response__, 'ssm_parameter_name')))
@@ -0,0 +1,300 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-eks. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from .cluster import Cluster
+import pulumi_aws
+import pulumi_kubernetes
+
+__all__ = ['LoadBalancerControllerArgs', 'LoadBalancerController']
+
+@pulumi.input_type
+class LoadBalancerControllerArgs:
+    def __init__(__self__, *,
+                 cluster: pulumi.Input['Cluster'],
+                 chart_version: Optional[pulumi.Input[_builtins.str]] = None,
+                 namespace: Optional[pulumi.Input[_builtins.str]] = None,
+                 oidc_provider_arn: Optional[pulumi.Input[_builtins.str]] = None,
+                 private_subnet_ids: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 public_subnet_ids: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 release_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 service_account_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 values: Optional[pulumi.Input[Mapping[str, Any]]] = None):
+        """
+        The set of arguments for constructing a LoadBalancerController resource.
+        :param pulumi.Input['Cluster'] cluster: The target EKS cluster.
+        :param pulumi.Input[_builtins.str] chart_version: The version of the aws-load-balancer-controller Helm chart. Defaults to `1.13.0`.
+        :param pulumi.Input[_builtins.str] namespace: The namespace to install the controller into. Defaults to `kube-system`.
+        :param pulumi.Input[_builtins.str] oidc_provider_arn: The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+               
+               If set, the controller assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] private_subnet_ids: The private subnets to tag with `kubernetes.io/role/internal-elb`, which makes the controller place internal load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] public_subnet_ids: The public subnets to tag with `kubernetes.io/role/elb`, which makes the controller place internet-facing load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+        :param pulumi.Input[_builtins.str] release_name: The name of the Helm release. Defaults to `aws-load-balancer-controller`.
+        :param pulumi.Input[_builtins.str] service_account_name: The name of the Kubernetes service account of the controller. Defaults to `aws-load-balancer-controller`.
+        :param pulumi.Input[Mapping[str, Any]] values: Custom values for the Helm chart. The values are merged with the values set by the component, which are `clusterName`, `vpcId`, `region` and `serviceAccount`.
+        """
+        pulumi.set(__self__, "cluster", cluster)
+        if chart_version is not None:
+            pulumi.set(__self__, "chart_version", chart_version)
+        if namespace is not None:
+            pulumi.set(__self__, "namespace", namespace)
+        if oidc_provider_arn is not None:
+            pulumi.set(__self__, "oidc_provider_arn", oidc_provider_arn)
+        if private_subnet_ids is not None:
+            pulumi.set(__self__, "private_subnet_ids", private_subnet_ids)
+        if public_subnet_ids is not None:
+            pulumi.set(__self__, "public_subnet_ids", public_subnet_ids)
+        if release_name is not None:
+            pulumi.set(__self__, "release_name", release_name)
+        if service_account_name is not None:
+            pulumi.set(__self__, "service_account_name", service_account_name)
+        if values is not None:
+            pulumi.set(__self__, "values", values)
+
+    @_builtins.property
+    @pulumi.getter
+    def cluster(self) -> pulumi.Input['Cluster']:
+        """
+        The target EKS cluster.
+        """
+        return pulumi.get(self, "cluster")
+
+    @cluster.setter
+    def cluster(self, value: pulumi.Input['Cluster']):
+        pulumi.set(self, "cluster", value)
+
+    @_builtins.property
+    @pulumi.getter(name="chartVersion")
+    def chart_version(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The version of the aws-load-balancer-controller Helm chart. Defaults to `1.13.0`.
+        """
+        return pulumi.get(self, "chart_version")
+
+    @chart_version.setter
+    def chart_version(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "chart_version", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def namespace(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The namespace to install the controller into. Defaults to `kube-system`.
+        """
+        return pulumi.get(self, "namespace")
+
+    @namespace.setter
+    def namespace(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "namespace", value)
+
+    @_builtins.property
+    @pulumi.getter(name="oidcProviderArn")
+    def oidc_provider_arn(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+
+        If set, the controller assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+        """
+        return pulumi.get(self, "oidc_provider_arn")
+
+    @oidc_provider_arn.setter
+    def oidc_provider_arn(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "oidc_provider_arn", value)
+
+    @_builtins.property
+    @pulumi.getter(name="privateSubnetIds")
+    def private_subnet_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The private subnets to tag with `kubernetes.io/role/internal-elb`, which makes the controller place internal load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+        """
+        return pulumi.get(self, "private_subnet_ids")
+
+    @private_subnet_ids.setter
+    def private_subnet_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "private_subnet_ids", value)
+
+    @_builtins.property
+    @pulumi.getter(name="publicSubnetIds")
+    def public_subnet_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The public subnets to tag with `kubernetes.io/role/elb`, which makes the controller place internet-facing load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+        """
+        return pulumi.get(self, "public_subnet_ids")
+
+    @public_subnet_ids.setter
+    def public_subnet_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "public_subnet_ids", value)
+
+    @_builtins.property
+    @pulumi.getter(name="releaseName")
+    def release_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The name of the Helm release. Defaults to `aws-load-balancer-controller`.
+        """
+        return pulumi.get(self, "release_name")
+
+    @release_name.setter
+    def release_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "release_name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="serviceAccountName")
+    def service_account_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The name of the Kubernetes service account of the controller. Defaults to `aws-load-balancer-controller`.
+        """
+        return pulumi.get(self, "service_account_name")
+
+    @service_account_name.setter
+    def service_account_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "service_account_name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def values(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        Custom values for the Helm chart. The values are merged with the values set by the component, which are `clusterName`, `vpcId`, `region` and `serviceAccount`.
+        """
+        return pulumi.get(self, "values")
+
+    @values.setter
+    def values(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "values", value)
+
+
+@pulumi.type_token("eks:index:LoadBalancerController")
+class LoadBalancerController(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 chart_version: Optional[pulumi.Input[_builtins.str]] = None,
+                 cluster: Optional[pulumi.Input['Cluster']] = None,
+                 namespace: Optional[pulumi.Input[_builtins.str]] = None,
+                 oidc_provider_arn: Optional[pulumi.Input[_builtins.str]] = None,
+                 private_subnet_ids: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 public_subnet_ids: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 release_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 service_account_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 values: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 __props__=None):
+        """
+        LoadBalancerController installs the AWS Load Balancer Controller into an EKS cluster. It creates the IAM role of the controller, installs the aws-load-balancer-controller Helm chart and tags the given subnets, so the controller can discover them.
+        For more information see: https://docs.aws.amazon.com/eks/latest/userguide/aws-load-balancer-controller.html
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[_builtins.str] chart_version: The version of the aws-load-balancer-controller Helm chart. Defaults to `1.13.0`.
+        :param pulumi.Input['Cluster'] cluster: The target EKS cluster.
+        :param pulumi.Input[_builtins.str] namespace: The namespace to install the controller into. Defaults to `kube-system`.
+        :param pulumi.Input[_builtins.str] oidc_provider_arn: The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+               
+               If set, the controller assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] private_subnet_ids: The private subnets to tag with `kubernetes.io/role/internal-elb`, which makes the controller place internal load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] public_subnet_ids: The public subnets to tag with `kubernetes.io/role/elb`, which makes the controller place internet-facing load balancers into them. The subnet IDs must be known during preview, so subnets created in the same program have to be tagged where they are created instead. If unset, no subnets are tagged.
+        :param pulumi.Input[_builtins.str] release_name: The name of the Helm release. Defaults to `aws-load-balancer-controller`.
+        :param pulumi.Input[_builtins.str] service_account_name: The name of the Kubernetes service account of the controller. Defaults to `aws-load-balancer-controller`.
+        :param pulumi.Input[Mapping[str, Any]] values: Custom values for the Helm chart. The values are merged with the values set by the component, which are `clusterName`, `vpcId`, `region` and `serviceAccount`.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: LoadBalancerControllerArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        LoadBalancerController installs the AWS Load Balancer Controller into an EKS cluster. It creates the IAM role of the controller, installs the aws-load-balancer-controller Helm chart and tags the given subnets, so the controller can discover them.
+        For more information see: https://docs.aws.amazon.com/eks/latest/userguide/aws-load-balancer-controller.html
+
+        :param str resource_name: The name of the resource.
+        :param LoadBalancerControllerArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(LoadBalancerControllerArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 chart_version: Optional[pulumi.Input[_builtins.str]] = None,
+                 cluster: Optional[pulumi.Input['Cluster']] = None,
+                 namespace: Optional[pulumi.Input[_builtins.str]] = None,
+                 oidc_provider_arn: Optional[pulumi.Input[_builtins.str]] = None,
+                 private_subnet_ids: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 public_subnet_ids: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 release_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 service_account_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 values: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = LoadBalancerControllerArgs.__new__(LoadBalancerControllerArgs)
+
+            __props__.__dict__["chart_version"] = chart_version
+            if cluster is None and not opts.urn:
+                raise TypeError("Missing required property 'cluster'")
+            __props__.__dict__["cluster"] = cluster
+            __props__.__dict__["namespace"] = namespace
+            __props__.__dict__["oidc_provider_arn"] = oidc_provider_arn
+            __props__.__dict__["private_subnet_ids"] = private_subnet_ids
+            __props__.__dict__["public_subnet_ids"] = public_subnet_ids
+            __props__.__dict__["release_name"] = release_name
+            __props__.__dict__["service_account_name"] = service_account_name
+            __props__.__dict__["values"] = values
+            __props__.__dict__["policy"] = None
+            __props__.__dict__["release"] = None
+            __props__.__dict__["role"] = None
+        super(LoadBalancerController, __self__).__init__(
+            'eks:index:LoadBalancerController',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @_builtins.property
+    @pulumi.getter
+    def policy(self) -> pulumi.Output['pulumi_aws.iam.Policy']:
+        """
+        The IAM policy with the permissions of the controller.
+        """
+        return pulumi.get(self, "policy")
+
+    @_builtins.property
+    @pulumi.getter
+    def release(self) -> pulumi.Output['pulumi_kubernetes.helm.v3.Release']:
+        """
+        The Helm release of the controller.
+        """
+        return pulumi.get(self, "release")
+
+    @_builtins.property
+    @pulumi.getter
+    def role(self) -> pulumi.Output['pulumi_aws.iam.Role']:
+        """
+        The IAM role of the controller.
+        """
+        return pulumi.get(self, "role")
+
@@ -28,7 +28,9 @@ class ManagedNodeGroupArgs:
                  cluster: pulumi.Input[Union['Cluster', 'CoreDataArgs']],
                  ami_id: Optional[pulumi.Input[_builtins.str]] = None,
                  ami_type: Optional[pulumi.Input[_builtins.str]] = None,
+                 autoscaler_discovery: Optional[_builtins.bool] = None,
                  bootstrap_extra_args: Optional[_builtins.str] = None,
+                 bottlerocket_config: Optional[pulumi.Input['BottlerocketConfigArgs']] = None,
                  bottlerocket_settings: Optional[pulumi.Input[Mapping[str, Any]]] = None,
                  capacity_type: Optional[pulumi.Input[_builtins.str]] = None,
                  cluster_name: Optional[pulumi.Input[_builtins.str]] = None,
@@ -42,6 +44,7 @@ class ManagedNodeGroupArgs:
                  kubelet_extra_args: Optional[_builtins.str] = None,
                  labels: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
                  launch_template: Optional[pulumi.Input['pulumi_aws.eks.NodeGroupLaunchTemplateArgs']] = None,
+                 node_config: Optional[pulumi.Input['NodeConfigArgs']] = None,
                  node_group_name: Optional[pulumi.Input[_builtins.str]] = None,
                  node_group_name_prefix: Optional[pulumi.Input[_builtins.str]] = None,
                  node_role: Optional[pulumi.Input['pulumi_aws.iam.Role']] = None,
@@ -70,9 +73,14 @@ class ManagedNodeGroupArgs:
                Note: `amiType` and `amiId` are mutually exclusive.
                
                See the AWS documentation (https://docs.aws.amazon.com/eks/latest/APIReference/API_Nodegroup.html#AmazonEKS-Type-Nodegroup-amiType) for valid AMI Types. This provider will only perform drift detection if a configuration value is provided.
+        :param _builtins.bool autoscaler_discovery: Tags the Auto Scaling Group of the node group with the `labels` and `taints` as node template tags of the Cluster Autoscaler, so it can scale the node group up from zero. EKS tags the Auto Scaling Groups of managed node groups for the auto-discovery of the Cluster Autoscaler itself.
+               
+               This implies `ignoreScalingChanges` unless it is explicitly disabled.
         :param _builtins.str bootstrap_extra_args: Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
                
                Note that this field conflicts with `launchTemplate`.
+        :param pulumi.Input['BottlerocketConfigArgs'] bottlerocket_config: Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+               The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
         :param pulumi.Input[Mapping[str, Any]] bottlerocket_settings: The configuration settings for Bottlerocket OS.
                The settings will get merged with the base settings the provider uses to configure Bottlerocket.
                
@@ -108,6 +116,10 @@ class ManagedNodeGroupArgs:
         :param pulumi.Input['pulumi_aws.eks.NodeGroupLaunchTemplateArgs'] launch_template: Launch Template settings.
                
                Note: This field is mutually exclusive with `kubeletExtraArgs` and `bootstrapExtraArgs`.
+        :param pulumi.Input['NodeConfigArgs'] node_config: Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+               
+               Note: This is only applicable when using AL2023.
+               See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
         :param pulumi.Input[_builtins.str] node_group_name: Name of the EKS Node Group. If omitted, this provider will assign a random, unique name. Conflicts with `nodeGroupNamePrefix`.
         :param pulumi.Input[_builtins.str] node_group_name_prefix: Creates a unique name beginning with the specified prefix. Conflicts with `nodeGroupName`.
         :param pulumi.Input['pulumi_aws.iam.Role'] node_role: The IAM Role that provides permissions for the EKS Node Group.
@@ -159,8 +171,12 @@ class ManagedNodeGroupArgs:
             pulumi.set(__self__, "ami_id", ami_id)
         if ami_type is not None:
             pulumi.set(__self__, "ami_type", ami_type)
+        if autoscaler_discovery is not None:
+            pulumi.set(__self__, "autoscaler_discovery", autoscaler_discovery)
         if bootstrap_extra_args is not None:
             pulumi.set(__self__, "bootstrap_extra_args", bootstrap_extra_args)
+        if bottlerocket_config is not None:
+            pulumi.set(__self__, "bottlerocket_config", bottlerocket_config)
         if bottlerocket_settings is not None:
             pulumi.set(__self__, "bottlerocket_settings", bottlerocket_settings)
         if capacity_type is not None:
@@ -187,6 +203,8 @@ class ManagedNodeGroupArgs:
             pulumi.set(__self__, "labels", labels)
         if launch_template is not None:
             pulumi.set(__self__, "launch_template", launch_template)
+        if node_config is not None:
+            pulumi.set(__self__, "node_config", node_config)
         if node_group_name is not None:
             pulumi.set(__self__, "node_group_name", node_group_name)
         if node_group_name_prefix is not None:
@@ -262,6 +280,20 @@ class ManagedNodeGroupArgs:
     def ami_type(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "ami_type", value)
 
+    @_builtins.property
+    @pulumi.getter(name="autoscalerDiscovery")
+    def autoscaler_discovery(self) -> Optional[_builtins.bool]:
+        """
+        Tags the Auto Scaling Group of the node group with the `labels` and `taints` as node template tags of the Cluster Autoscaler, so it can scale the node group up from zero. EKS tags the Auto Scaling Groups of managed node groups for the auto-discovery of the Cluster Autoscaler itself.
+
+        This implies `ignoreScalingChanges` unless it is explicitly disabled.
+        """
+        return pulumi.get(self, "autoscaler_discovery")
+
+    @autoscaler_discovery.setter
+    def autoscaler_discovery(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "autoscaler_discovery", value)
+
     @_builtins.property
     @pulumi.getter(name="bootstrapExtraArgs")
     def bootstrap_extra_args(self) -> Optional[_builtins.str]:
@@ -276,6 +308,19 @@ class ManagedNodeGroupArgs:
     def bootstrap_extra_args(self, value: Optional[_builtins.str]):
         pulumi.set(self, "bootstrap_extra_args", value)
 
+    @_builtins.property
+    @pulumi.getter(name="bottlerocketConfig")
+    def bottlerocket_config(self) -> Optional[pulumi.Input['BottlerocketConfigArgs']]:
+        """
+        Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+        The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+        """
+        return pulumi.get(self, "bottlerocket_config")
+
+    @bottlerocket_config.setter
+    def bottlerocket_config(self, value: Optional[pulumi.Input['BottlerocketConfigArgs']]):
+        pulumi.set(self, "bottlerocket_config", value)
+
     @_builtins.property
     @pulumi.getter(name="bottlerocketSettings")
     def bottlerocket_settings(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
@@ -454,6 +499,21 @@ class ManagedNodeGroupArgs:
     def launch_template(self, value: Optional[pulumi.Input['pulumi_aws.eks.NodeGroupLaunchTemplateArgs']]):
         pulumi.set(self, "launch_template", value)
 
+    @_builtins.property
+    @pulumi.getter(name="nodeConfig")
+    def node_config(self) -> Optional[pulumi.Input['NodeConfigArgs']]:
+        """
+        Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+
+        Note: This is only applicable when using AL2023.
+        See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+        """
+        return pulumi.get(self, "node_config")
+
+    @node_config.setter
+    def node_config(self, value: Optional[pulumi.Input['NodeConfigArgs']]):
+        pulumi.set(self, "node_config", value)
+
     @_builtins.property
     @pulumi.getter(name="nodeGroupName")
     def node_group_name(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -671,7 +731,9 @@ class ManagedNodeGroup(pulumi.ComponentResource):
                  opts: Optional[pulumi.ResourceOptions] = None,
                  ami_id: Optional[pulumi.Input[_builtins.str]] = None,
                  ami_type: Optional[pulumi.Input[_builtins.str]] = None,
+                 autoscaler_discovery: Optional[_builtins.bool] = None,
                  bootstrap_extra_args: Optional[_builtins.str] = None,
+                 bottlerocket_config: Optional[pulumi.Input[Union['BottlerocketConfigArgs', 'BottlerocketConfigArgsDict']]] = None,
                  bottlerocket_settings: Optional[pulumi.Input[Mapping[str, Any]]] = None,
                  capacity_type: Optional[pulumi.Input[_builtins.str]] = None,
                  cluster: Optional[pulumi.Input[Union['Cluster', Union['CoreDataArgs', 'CoreDataArgsDict']]]] = None,
@@ -686,6 +748,7 @@ class ManagedNodeGroup(pulumi.ComponentResource):
                  kubelet_extra_args: Optional[_builtins.str] = None,
                  labels: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
                  launch_template: Optional[pulumi.Input[pulumi.InputType['pulumi_aws.eks.NodeGroupLaunchTemplateArgs']]] = None,
+                 node_config: Optional[pulumi.Input[Union['NodeConfigArgs', 'NodeConfigArgsDict']]] = None,
                  node_group_name: Optional[pulumi.Input[_builtins.str]] = None,
                  node_group_name_prefix: Optional[pulumi.Input[_builtins.str]] = None,
                  node_role: Optional[pulumi.Input['pulumi_aws.iam.Role']] = None,
@@ -872,9 +935,14 @@ class ManagedNodeGroup(pulumi.ComponentResource):
                Note: `amiType` and `amiId` are mutually exclusive.
                
                See the AWS documentation (https://docs.aws.amazon.com/eks/latest/APIReference/API_Nodegroup.html#AmazonEKS-Type-Nodegroup-amiType) for valid AMI Types. This provider will only perform drift detection if a configuration value is provided.
+        :param _builtins.bool autoscaler_discovery: Tags the Auto Scaling Group of the node group with the `labels` and `taints` as node template tags of the Cluster Autoscaler, so it can scale the node group up from zero. EKS tags the Auto Scaling Groups of managed node groups for the auto-discovery of the Cluster Autoscaler itself.
+               
+               This implies `ignoreScalingChanges` unless it is explicitly disabled.
         :param _builtins.str bootstrap_extra_args: Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
                
                Note that this field conflicts with `launchTemplate`.
+        :param pulumi.Input[Union['BottlerocketConfigArgs', 'BottlerocketConfigArgsDict']] bottlerocket_config: Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+               The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
         :param pulumi.Input[Mapping[str, Any]] bottlerocket_settings: The configuration settings for Bottlerocket OS.
                The settings will get merged with the base settings the provider uses to configure Bottlerocket.
                
@@ -911,6 +979,10 @@ class ManagedNodeGroup(pulumi.ComponentResource):
         :param pulumi.Input[pulumi.InputType['pulumi_aws.eks.NodeGroupLaunchTemplateArgs']] launch_template: Launch Template settings.
                
                Note: This field is mutually exclusive with `kubeletExtraArgs` and `bootstrapExtraArgs`.
+        :param pulumi.Input[Union['NodeConfigArgs', 'NodeConfigArgsDict']] node_config: Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+               
+               Note: This is only applicable when using AL2023.
+               See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
         :param pulumi.Input[_builtins.str] node_group_name: Name of the EKS Node Group. If omitted, this provider will assign a random, unique name. Conflicts with `nodeGroupNamePrefix`.
         :param pulumi.Input[_builtins.str] node_group_name_prefix: Creates a unique name beginning with the specified prefix. Conflicts with `nodeGroupName`.
         :param pulumi.Input['pulumi_aws.iam.Role'] node_role: The IAM Role that provides permissions for the EKS Node Group.
@@ -1138,7 +1210,9 @@ class ManagedNodeGroup(pulumi.ComponentResource):
                  opts: Optional[pulumi.ResourceOptions] = None,
                  ami_id: Optional[pulumi.Input[_builtins.str]] = None,
                  ami_type: Optional[pulumi.Input[_builtins.str]] = None,
+                 autoscaler_discovery: Optional[_builtins.bool] = None,
                  bootstrap_extra_args: Optional[_builtins.str] = None,
+                 bottlerocket_config: Optional[pulumi.Input[Union['BottlerocketConfigArgs', 'BottlerocketConfigArgsDict']]] = None,
                  bottlerocket_settings: Optional[pulumi.Input[Mapping[str, Any]]] = None,
                  capacity_type: Optional[pulumi.Input[_builtins.str]] = None,
                  cluster: Optional[pulumi.Input[Union['Cluster', Union['CoreDataArgs', 'CoreDataArgsDict']]]] = None,
@@ -1153,6 +1227,7 @@ class ManagedNodeGroup(pulumi.ComponentResource):
                  kubelet_extra_args: Optional[_builtins.str] = None,
                  labels: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
                  launch_template: Optional[pulumi.Input[pulumi.InputType['pulumi_aws.eks.NodeGroupLaunchTemplateArgs']]] = None,
+                 node_config: Optional[pulumi.Input[Union['NodeConfigArgs', 'NodeConfigArgsDict']]] = None,
                  node_group_name: Optional[pulumi.Input[_builtins.str]] = None,
                  node_group_name_prefix: Optional[pulumi.Input[_builtins.str]] = None,
                  node_role: Optional[pulumi.Input['pulumi_aws.iam.Role']] = None,
@@ -1181,7 +1256,9 @@ class ManagedNodeGroup(pulumi.ComponentResource):
 
             __props__.__dict__["ami_id"] = ami_id
             __props__.__dict__["ami_type"] = ami_type
+            __props__.__dict__["autoscaler_discovery"] = autoscaler_discovery
             __props__.__dict__["bootstrap_extra_args"] = bootstrap_extra_args
+            __props__.__dict__["bottlerocket_config"] = bottlerocket_config
             __props__.__dict__["bottlerocket_settings"] = bottlerocket_settings
             __props__.__dict__["capacity_type"] = capacity_type
             if cluster is None and not opts.urn:
@@ -1198,6 +1275,7 @@ class ManagedNodeGroup(pulumi.ComponentResource):
             __props__.__dict__["kubelet_extra_args"] = kubelet_extra_args
             __props__.__dict__["labels"] = labels
             __props__.__dict__["launch_template"] = launch_template
+            __props__.__dict__["node_config"] = node_config
             __props__.__dict__["node_group_name"] = node_group_name
             __props__.__dict__["node_group_name_prefix"] = node_group_name_prefix
             __props__.__dict__["node_role"] = node_role
@@ -29,7 +29,9 @@ class NodeGroupArgs:
                  ami_id: Optional[pulumi.Input[_builtins.str]] = None,
                  ami_type: Optional[pulumi.Input[_builtins.str]] = None,
                  auto_scaling_group_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 autoscaler_discovery: Optional[_builtins.bool] = None,
                  bootstrap_extra_args: Optional[pulumi.Input[_builtins.str]] = None,
+                 bottlerocket_config: Optional[pulumi.Input['BottlerocketConfigArgs']] = None,
                  bottlerocket_settings: Optional[pulumi.Input[Mapping[str, Any]]] = None,
                  cloud_formation_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
                  cluster_ingress_rule: Optional[pulumi.Input['pulumi_aws.ec2.SecurityGroupRule']] = None,
@@ -48,6 +50,7 @@ class NodeGroupArgs:
                  max_size: Optional[pulumi.Input[_builtins.int]] = None,
                  min_size: Optional[pulumi.Input[_builtins.int]] = None,
                  node_associate_public_ip_address: Optional[pulumi.Input[_builtins.bool]] = None,
+                 node_config: Optional[pulumi.Input['NodeConfigArgs']] = None,
                  node_public_key: Optional[pulumi.Input[_builtins.str]] = None,
                  node_root_volume_delete_on_termination: Optional[pulumi.Input[_builtins.bool]] = None,
                  node_root_volume_encrypted: Optional[pulumi.Input[_builtins.bool]] = None,
@@ -86,7 +89,12 @@ class NodeGroupArgs:
                Per AWS, all stack-level tags, including automatically created tags, and the `cloudFormationTags` option are propagated to resources that AWS CloudFormation supports, including the AutoScalingGroup. See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-resource-tags.html
                
                Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
+        :param _builtins.bool autoscaler_discovery: Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+               
+               For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
         :param pulumi.Input[_builtins.str] bootstrap_extra_args: Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
+        :param pulumi.Input['BottlerocketConfigArgs'] bottlerocket_config: Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+               The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
         :param pulumi.Input[Mapping[str, Any]] bottlerocket_settings: The configuration settings for Bottlerocket OS.
                The settings will get merged with the base settings the provider uses to configure Bottlerocket.
                
@@ -132,6 +140,10 @@ class NodeGroupArgs:
         :param pulumi.Input[_builtins.int] max_size: The maximum number of worker nodes running in the cluster. Defaults to 2.
         :param pulumi.Input[_builtins.int] min_size: The minimum number of worker nodes running in the cluster. Defaults to 1.
         :param pulumi.Input[_builtins.bool] node_associate_public_ip_address: Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
+        :param pulumi.Input['NodeConfigArgs'] node_config: Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+               
+               Note: This is only applicable when using AL2023.
+               See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
         :param pulumi.Input[_builtins.str] node_public_key: Public key material for SSH access to worker nodes. See allowed formats at:
                https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
                If not provided, no SSH access is enabled on VMs.
@@ -190,8 +202,12 @@ class NodeGroupArgs:
             pulumi.set(__self__, "ami_type", ami_type)
         if auto_scaling_group_tags is not None:
             pulumi.set(__self__, "auto_scaling_group_tags", auto_scaling_group_tags)
+        if autoscaler_discovery is not None:
+            pulumi.set(__self__, "autoscaler_discovery", autoscaler_discovery)
         if bootstrap_extra_args is not None:
             pulumi.set(__self__, "bootstrap_extra_args", bootstrap_extra_args)
+        if bottlerocket_config is not None:
+            pulumi.set(__self__, "bottlerocket_config", bottlerocket_config)
         if bottlerocket_settings is not None:
             pulumi.set(__self__, "bottlerocket_settings", bottlerocket_settings)
         if cloud_formation_tags is not None:
@@ -228,6 +244,8 @@ class NodeGroupArgs:
             pulumi.set(__self__, "min_size", min_size)
         if node_associate_public_ip_address is not None:
             pulumi.set(__self__, "node_associate_public_ip_address", node_associate_public_ip_address)
+        if node_config is not None:
+            pulumi.set(__self__, "node_config", node_config)
         if node_public_key is not None:
             pulumi.set(__self__, "node_public_key", node_public_key)
         if node_root_volume_delete_on_termination is not None:
@@ -326,6 +344,20 @@ class NodeGroupArgs:
     def auto_scaling_group_tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
         pulumi.set(self, "auto_scaling_group_tags", value)
 
+    @_builtins.property
+    @pulumi.getter(name="autoscalerDiscovery")
+    def autoscaler_discovery(self) -> Optional[_builtins.bool]:
+        """
+        Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+
+        For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+        """
+        return pulumi.get(self, "autoscaler_discovery")
+
+    @autoscaler_discovery.setter
+    def autoscaler_discovery(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "autoscaler_discovery", value)
+
     @_builtins.property
     @pulumi.getter(name="bootstrapExtraArgs")
     def bootstrap_extra_args(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -338,6 +370,19 @@ class NodeGroupArgs:
     def bootstrap_extra_args(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "bootstrap_extra_args", value)
 
+    @_builtins.property
+    @pulumi.getter(name="bottlerocketConfig")
+    def bottlerocket_config(self) -> Optional[pulumi.Input['BottlerocketConfigArgs']]:
+        """
+        Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+        The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+        """
+        return pulumi.get(self, "bottlerocket_config")
+
+    @bottlerocket_config.setter
+    def bottlerocket_config(self, value: Optional[pulumi.Input['BottlerocketConfigArgs']]):
+        pulumi.set(self, "bottlerocket_config", value)
+
     @_builtins.property
     @pulumi.getter(name="bottlerocketSettings")
     def bottlerocket_settings(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
@@ -581,6 +626,21 @@ class NodeGroupArgs:
     def node_associate_public_ip_address(self, value: Optional[pulumi.Input[_builtins.bool]]):
         pulumi.set(self, "node_associate_public_ip_address", value)
 
+    @_builtins.property
+    @pulumi.getter(name="nodeConfig")
+    def node_config(self) -> Optional[pulumi.Input['NodeConfigArgs']]:
+        """
+        Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+
+        Note: This is only applicable when using AL2023.
+        See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+        """
+        return pulumi.get(self, "node_config")
+
+    @node_config.setter
+    def node_config(self, value: Optional[pulumi.Input['NodeConfigArgs']]):
+        pulumi.set(self, "node_config", value)
+
     @_builtins.property
     @pulumi.getter(name="nodePublicKey")
     def node_public_key(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -833,7 +893,9 @@ class NodeGroup(pulumi.ComponentResource):
                  ami_id: Optional[pulumi.Input[_builtins.str]] = None,
                  ami_type: Optional[pulumi.Input[_builtins.str]] = None,
                  auto_scaling_group_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 autoscaler_discovery: Optional[_builtins.bool] = None,
                  bootstrap_extra_args: Optional[pulumi.Input[_builtins.str]] = None,
+                 bottlerocket_config: Optional[pulumi.Input[Union['BottlerocketConfigArgs', 'BottlerocketConfigArgsDict']]] = None,
                  bottlerocket_settings: Optional[pulumi.Input[Mapping[str, Any]]] = None,
                  cloud_formation_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
                  cluster: Optional[pulumi.Input[Union['Cluster', Union['CoreDataArgs', 'CoreDataArgsDict']]]] = None,
@@ -853,6 +915,7 @@ class NodeGroup(pulumi.ComponentResource):
                  max_size: Optional[pulumi.Input[_builtins.int]] = None,
                  min_size: Optional[pulumi.Input[_builtins.int]] = None,
                  node_associate_public_ip_address: Optional[pulumi.Input[_builtins.bool]] = None,
+                 node_config: Optional[pulumi.Input[Union['NodeConfigArgs', 'NodeConfigArgsDict']]] = None,
                  node_public_key: Optional[pulumi.Input[_builtins.str]] = None,
                  node_root_volume_delete_on_termination: Optional[pulumi.Input[_builtins.bool]] = None,
                  node_root_volume_encrypted: Optional[pulumi.Input[_builtins.bool]] = None,
@@ -894,7 +957,12 @@ class NodeGroup(pulumi.ComponentResource):
                Per AWS, all stack-level tags, including automatically created tags, and the `cloudFormationTags` option are propagated to resources that AWS CloudFormation supports, including the AutoScalingGroup. See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-resource-tags.html
                
                Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
+        :param _builtins.bool autoscaler_discovery: Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+               
+               For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
         :param pulumi.Input[_builtins.str] bootstrap_extra_args: Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
+        :param pulumi.Input[Union['BottlerocketConfigArgs', 'BottlerocketConfigArgsDict']] bottlerocket_config: Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+               The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
         :param pulumi.Input[Mapping[str, Any]] bottlerocket_settings: The configuration settings for Bottlerocket OS.
                The settings will get merged with the base settings the provider uses to configure Bottlerocket.
                
@@ -941,6 +1009,10 @@ class NodeGroup(pulumi.ComponentResource):
         :param pulumi.Input[_builtins.int] max_size: The maximum number of worker nodes running in the cluster. Defaults to 2.
         :param pulumi.Input[_builtins.int] min_size: The minimum number of worker nodes running in the cluster. Defaults to 1.
         :param pulumi.Input[_builtins.bool] node_associate_public_ip_address: Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
+        :param pulumi.Input[Union['NodeConfigArgs', 'NodeConfigArgsDict']] node_config: Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+               
+               Note: This is only applicable when using AL2023.
+               See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
         :param pulumi.Input[_builtins.str] node_public_key: Public key material for SSH access to worker nodes. See allowed formats at:
                https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
                If not provided, no SSH access is enabled on VMs.
@@ -1019,7 +1091,9 @@ class NodeGroup(pulumi.ComponentResource):
                  ami_id: Optional[pulumi.Input[_builtins.str]] = None,
                  ami_type: Optional[pulumi.Input[_builtins.str]] = None,
                  auto_scaling_group_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 autoscaler_discovery: Optional[_builtins.bool] = None,
                  bootstrap_extra_args: Optional[pulumi.Input[_builtins.str]] = None,
+                 bottlerocket_config: Optional[pulumi.Input[Union['BottlerocketConfigArgs', 'BottlerocketConfigArgsDict']]] = None,
                  bottlerocket_settings: Optional[pulumi.Input[Mapping[str, Any]]] = None,
                  cloud_formation_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
                  cluster: Optional[pulumi.Input[Union['Cluster', Union['CoreDataArgs', 'CoreDataArgsDict']]]] = None,
@@ -1039,6 +1113,7 @@ class NodeGroup(pulumi.ComponentResource):
                  max_size: Optional[pulumi.Input[_builtins.int]] = None,
                  min_size: Optional[pulumi.Input[_builtins.int]] = None,
                  node_associate_public_ip_address: Optional[pulumi.Input[_builtins.bool]] = None,
+                 node_config: Optional[pulumi.Input[Union['NodeConfigArgs', 'NodeConfigArgsDict']]] = None,
                  node_public_key: Optional[pulumi.Input[_builtins.str]] = None,
                  node_root_volume_delete_on_termination: Optional[pulumi.Input[_builtins.bool]] = None,
                  node_root_volume_encrypted: Optional[pulumi.Input[_builtins.bool]] = None,
@@ -1071,7 +1146,9 @@ class NodeGroup(pulumi.ComponentResource):
             __props__.__dict__["ami_id"] = ami_id
             __props__.__dict__["ami_type"] = ami_type
             __props__.__dict__["auto_scaling_group_tags"] = auto_scaling_group_tags
+            __props__.__dict__["autoscaler_discovery"] = autoscaler_discovery
             __props__.__dict__["bootstrap_extra_args"] = bootstrap_extra_args
+            __props__.__dict__["bottlerocket_config"] = bottlerocket_config
             __props__.__dict__["bottlerocket_settings"] = bottlerocket_settings
             __props__.__dict__["cloud_formation_tags"] = cloud_formation_tags
             if cluster is None and not opts.urn:
@@ -1093,6 +1170,7 @@ class NodeGroup(pulumi.ComponentResource):
             __props__.__dict__["max_size"] = max_size
             __props__.__dict__["min_size"] = min_size
             __props__.__dict__["node_associate_public_ip_address"] = node_associate_public_ip_address
+            __props__.__dict__["node_config"] = node_config
             __props__.__dict__["node_public_key"] = node_public_key
             __props__.__dict__["node_root_volume_delete_on_termination"] = node_root_volume_delete_on_termination
             __props__.__dict__["node_root_volume_encrypted"] = node_root_volume_encrypted
@@ -29,7 +29,9 @@ class NodeGroupV2Args:
                  ami_id: Optional[pulumi.Input[_builtins.str]] = None,
                  ami_type: Optional[pulumi.Input[_builtins.str]] = None,
                  auto_scaling_group_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 autoscaler_discovery: Optional[_builtins.bool] = None,
                  bootstrap_extra_args: Optional[pulumi.Input[_builtins.str]] = None,
+                 bottlerocket_config: Optional[pulumi.Input['BottlerocketConfigArgs']] = None,
                  bottlerocket_settings: Optional[pulumi.Input[Mapping[str, Any]]] = None,
                  cloud_formation_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
                  cluster_ingress_rule: Optional[pulumi.Input['pulumi_aws.ec2.SecurityGroupRule']] = None,
@@ -43,6 +45,7 @@ class NodeGroupV2Args:
                  instance_profile: Optional['pulumi_aws.iam.InstanceProfile'] = None,
                  instance_profile_name: Optional[pulumi.Input[_builtins.str]] = None,
                  instance_type: Optional[pulumi.Input[_builtins.str]] = None,
+                 interruption_handling: Optional['InterruptionHandlingArgs'] = None,
                  key_name: Optional[pulumi.Input[_builtins.str]] = None,
                  kubelet_extra_args: Optional[pulumi.Input[_builtins.str]] = None,
                  labels: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
@@ -51,6 +54,7 @@ class NodeGroupV2Args:
                  min_refresh_percentage: Optional[pulumi.Input[_builtins.int]] = None,
                  min_size: Optional[pulumi.Input[_builtins.int]] = None,
                  node_associate_public_ip_address: Optional[pulumi.Input[_builtins.bool]] = None,
+                 node_config: Optional[pulumi.Input['NodeConfigArgs']] = None,
                  node_public_key: Optional[pulumi.Input[_builtins.str]] = None,
                  node_root_volume_delete_on_termination: Optional[pulumi.Input[_builtins.bool]] = None,
                  node_root_volume_encrypted: Optional[pulumi.Input[_builtins.bool]] = None,
@@ -89,7 +93,12 @@ class NodeGroupV2Args:
                Per AWS, all stack-level tags, including automatically created tags, and the `cloudFormationTags` option are propagated to resources that AWS CloudFormation supports, including the AutoScalingGroup. See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-resource-tags.html
                
                Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
+        :param _builtins.bool autoscaler_discovery: Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+               
+               For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
         :param pulumi.Input[_builtins.str] bootstrap_extra_args: Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
+        :param pulumi.Input['BottlerocketConfigArgs'] bottlerocket_config: Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+               The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
         :param pulumi.Input[Mapping[str, Any]] bottlerocket_settings: The configuration settings for Bottlerocket OS.
                The settings will get merged with the base settings the provider uses to configure Bottlerocket.
                
@@ -132,6 +141,9 @@ class NodeGroupV2Args:
         :param 'pulumi_aws.iam.InstanceProfile' instance_profile: The IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
         :param pulumi.Input[_builtins.str] instance_profile_name: The name of the IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
         :param pulumi.Input[_builtins.str] instance_type: The instance type to use for the cluster's nodes. Defaults to "t3.medium".
+        :param 'InterruptionHandlingArgs' interruption_handling: Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+               
+               See for more details: https://github.com/aws/aws-node-termination-handler
         :param pulumi.Input[_builtins.str] key_name: Name of the key pair to use for SSH access to worker nodes.
         :param pulumi.Input[_builtins.str] kubelet_extra_args: Extra args to pass to the Kubelet. Corresponds to the options passed in the `--kubeletExtraArgs` flag to `/etc/eks/bootstrap.sh`. For example, '--port=10251 --address=0.0.0.0'. Note that the `labels` and `taints` properties will be applied to this list (using `--node-labels` and `--register-with-taints` respectively) after to the explicit `kubeletExtraArgs`.
         :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] labels: Custom k8s node labels to be attached to each worker node. Adds the given key/value pairs to the `--node-labels` kubelet argument.
@@ -140,6 +152,10 @@ class NodeGroupV2Args:
         :param pulumi.Input[_builtins.int] min_refresh_percentage: The minimum amount of instances that should remain available during an instance refresh, expressed as a percentage. Defaults to 50.
         :param pulumi.Input[_builtins.int] min_size: The minimum number of worker nodes running in the cluster. Defaults to 1.
         :param pulumi.Input[_builtins.bool] node_associate_public_ip_address: Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
+        :param pulumi.Input['NodeConfigArgs'] node_config: Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+               
+               Note: This is only applicable when using AL2023.
+               See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
         :param pulumi.Input[_builtins.str] node_public_key: Public key material for SSH access to worker nodes. See allowed formats at:
                https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
                If not provided, no SSH access is enabled on VMs.
@@ -198,8 +214,12 @@ class NodeGroupV2Args:
             pulumi.set(__self__, "ami_type", ami_type)
         if auto_scaling_group_tags is not None:
             pulumi.set(__self__, "auto_scaling_group_tags", auto_scaling_group_tags)
+        if autoscaler_discovery is not None:
+            pulumi.set(__self__, "autoscaler_discovery", autoscaler_discovery)
         if bootstrap_extra_args is not None:
             pulumi.set(__self__, "bootstrap_extra_args", bootstrap_extra_args)
+        if bottlerocket_config is not None:
+            pulumi.set(__self__, "bottlerocket_config", bottlerocket_config)
         if bottlerocket_settings is not None:
             pulumi.set(__self__, "bottlerocket_settings", bottlerocket_settings)
         if cloud_formation_tags is not None:
@@ -226,6 +246,8 @@ class NodeGroupV2Args:
             pulumi.set(__self__, "instance_profile_name", instance_profile_name)
         if instance_type is not None:
             pulumi.set(__self__, "instance_type", instance_type)
+        if interruption_handling is not None:
+            pulumi.set(__self__, "interruption_handling", interruption_handling)
         if key_name is not None:
             pulumi.set(__self__, "key_name", key_name)
         if kubelet_extra_args is not None:
@@ -242,6 +264,8 @@ class NodeGroupV2Args:
             pulumi.set(__self__, "min_size", min_size)
         if node_associate_public_ip_address is not None:
             pulumi.set(__self__, "node_associate_public_ip_address", node_associate_public_ip_address)
+        if node_config is not None:
+            pulumi.set(__self__, "node_config", node_config)
         if node_public_key is not None:
             pulumi.set(__self__, "node_public_key", node_public_key)
         if node_root_volume_delete_on_termination is not None:
@@ -340,6 +364,20 @@ class NodeGroupV2Args:
     def auto_scaling_group_tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
         pulumi.set(self, "auto_scaling_group_tags", value)
 
+    @_builtins.property
+    @pulumi.getter(name="autoscalerDiscovery")
+    def autoscaler_discovery(self) -> Optional[_builtins.bool]:
+        """
+        Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+
+        For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+        """
+        return pulumi.get(self, "autoscaler_discovery")
+
+    @autoscaler_discovery.setter
+    def autoscaler_discovery(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "autoscaler_discovery", value)
+
     @_builtins.property
     @pulumi.getter(name="bootstrapExtraArgs")
     def bootstrap_extra_args(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -352,6 +390,19 @@ class NodeGroupV2Args:
     def bootstrap_extra_args(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "bootstrap_extra_args", value)
 
+    @_builtins.property
+    @pulumi.getter(name="bottlerocketConfig")
+    def bottlerocket_config(self) -> Optional[pulumi.Input['BottlerocketConfigArgs']]:
+        """
+        Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+        The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+        """
+        return pulumi.get(self, "bottlerocket_config")
+
+    @bottlerocket_config.setter
+    def bottlerocket_config(self, value: Optional[pulumi.Input['BottlerocketConfigArgs']]):
+        pulumi.set(self, "bottlerocket_config", value)
+
     @_builtins.property
     @pulumi.getter(name="bottlerocketSettings")
     def bottlerocket_settings(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
@@ -537,6 +588,20 @@ class NodeGroupV2Args:
     def instance_type(self, value: Optional[pulumi.Input[_builtins.str]]):
         pulumi.set(self, "instance_type", value)
 
+    @_builtins.property
+    @pulumi.getter(name="interruptionHandling")
+    def interruption_handling(self) -> Optional['InterruptionHandlingArgs']:
+        """
+        Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+
+        See for more details: https://github.com/aws/aws-node-termination-handler
+        """
+        return pulumi.get(self, "interruption_handling")
+
+    @interruption_handling.setter
+    def interruption_handling(self, value: Optional['InterruptionHandlingArgs']):
+        pulumi.set(self, "interruption_handling", value)
+
     @_builtins.property
     @pulumi.getter(name="keyName")
     def key_name(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -633,6 +698,21 @@ class NodeGroupV2Args:
     def node_associate_public_ip_address(self, value: Optional[pulumi.Input[_builtins.bool]]):
         pulumi.set(self, "node_associate_public_ip_address", value)
 
+    @_builtins.property
+    @pulumi.getter(name="nodeConfig")
+    def node_config(self) -> Optional[pulumi.Input['NodeConfigArgs']]:
+        """
+        Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+
+        Note: This is only applicable when using AL2023.
+        See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+        """
+        return pulumi.get(self, "node_config")
+
+    @node_config.setter
+    def node_config(self, value: Optional[pulumi.Input['NodeConfigArgs']]):
+        pulumi.set(self, "node_config", value)
+
     @_builtins.property
     @pulumi.getter(name="nodePublicKey")
     def node_public_key(self) -> Optional[pulumi.Input[_builtins.str]]:
@@ -880,7 +960,9 @@ class NodeGroupV2(pulumi.ComponentResource):
                  ami_id: Optional[pulumi.Input[_builtins.str]] = None,
                  ami_type: Optional[pulumi.Input[_builtins.str]] = None,
                  auto_scaling_group_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 autoscaler_discovery: Optional[_builtins.bool] = None,
                  bootstrap_extra_args: Optional[pulumi.Input[_builtins.str]] = None,
+                 bottlerocket_config: Optional[pulumi.Input[Union['BottlerocketConfigArgs', 'BottlerocketConfigArgsDict']]] = None,
                  bottlerocket_settings: Optional[pulumi.Input[Mapping[str, Any]]] = None,
                  cloud_formation_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
                  cluster: Optional[pulumi.Input[Union['Cluster', Union['CoreDataArgs', 'CoreDataArgsDict']]]] = None,
@@ -895,6 +977,7 @@ class NodeGroupV2(pulumi.ComponentResource):
                  instance_profile: Optional['pulumi_aws.iam.InstanceProfile'] = None,
                  instance_profile_name: Optional[pulumi.Input[_builtins.str]] = None,
                  instance_type: Optional[pulumi.Input[_builtins.str]] = None,
+                 interruption_handling: Optional[Union['InterruptionHandlingArgs', 'InterruptionHandlingArgsDict']] = None,
                  key_name: Optional[pulumi.Input[_builtins.str]] = None,
                  kubelet_extra_args: Optional[pulumi.Input[_builtins.str]] = None,
                  labels: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
@@ -903,6 +986,7 @@ class NodeGroupV2(pulumi.ComponentResource):
                  min_refresh_percentage: Optional[pulumi.Input[_builtins.int]] = None,
                  min_size: Optional[pulumi.Input[_builtins.int]] = None,
                  node_associate_public_ip_address: Optional[pulumi.Input[_builtins.bool]] = None,
+                 node_config: Optional[pulumi.Input[Union['NodeConfigArgs', 'NodeConfigArgsDict']]] = None,
                  node_public_key: Optional[pulumi.Input[_builtins.str]] = None,
                  node_root_volume_delete_on_termination: Optional[pulumi.Input[_builtins.bool]] = None,
                  node_root_volume_encrypted: Optional[pulumi.Input[_builtins.bool]] = None,
@@ -944,7 +1028,12 @@ class NodeGroupV2(pulumi.ComponentResource):
                Per AWS, all stack-level tags, including automatically created tags, and the `cloudFormationTags` option are propagated to resources that AWS CloudFormation supports, including the AutoScalingGroup. See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-resource-tags.html
                
                Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
+        :param _builtins.bool autoscaler_discovery: Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+               
+               For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
         :param pulumi.Input[_builtins.str] bootstrap_extra_args: Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
+        :param pulumi.Input[Union['BottlerocketConfigArgs', 'BottlerocketConfigArgsDict']] bottlerocket_config: Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+               The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
         :param pulumi.Input[Mapping[str, Any]] bottlerocket_settings: The configuration settings for Bottlerocket OS.
                The settings will get merged with the base settings the provider uses to configure Bottlerocket.
                
@@ -988,6 +1077,9 @@ class NodeGroupV2(pulumi.ComponentResource):
         :param 'pulumi_aws.iam.InstanceProfile' instance_profile: The IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
         :param pulumi.Input[_builtins.str] instance_profile_name: The name of the IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
         :param pulumi.Input[_builtins.str] instance_type: The instance type to use for the cluster's nodes. Defaults to "t3.medium".
+        :param Union['InterruptionHandlingArgs', 'InterruptionHandlingArgsDict'] interruption_handling: Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+               
+               See for more details: https://github.com/aws/aws-node-termination-handler
         :param pulumi.Input[_builtins.str] key_name: Name of the key pair to use for SSH access to worker nodes.
         :param pulumi.Input[_builtins.str] kubelet_extra_args: Extra args to pass to the Kubelet. Corresponds to the options passed in the `--kubeletExtraArgs` flag to `/etc/eks/bootstrap.sh`. For example, '--port=10251 --address=0.0.0.0'. Note that the `labels` and `taints` properties will be applied to this list (using `--node-labels` and `--register-with-taints` respectively) after to the explicit `kubeletExtraArgs`.
         :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] labels: Custom k8s node labels to be attached to each worker node. Adds the given key/value pairs to the `--node-labels` kubelet argument.
@@ -996,6 +1088,10 @@ class NodeGroupV2(pulumi.ComponentResource):
         :param pulumi.Input[_builtins.int] min_refresh_percentage: The minimum amount of instances that should remain available during an instance refresh, expressed as a percentage. Defaults to 50.
         :param pulumi.Input[_builtins.int] min_size: The minimum number of worker nodes running in the cluster. Defaults to 1.
         :param pulumi.Input[_builtins.bool] node_associate_public_ip_address: Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
+        :param pulumi.Input[Union['NodeConfigArgs', 'NodeConfigArgsDict']] node_config: Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+               
+               Note: This is only applicable when using AL2023.
+               See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
         :param pulumi.Input[_builtins.str] node_public_key: Public key material for SSH access to worker nodes. See allowed formats at:
                https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
                If not provided, no SSH access is enabled on VMs.
@@ -1074,7 +1170,9 @@ class NodeGroupV2(pulumi.ComponentResource):
                  ami_id: Optional[pulumi.Input[_builtins.str]] = None,
                  ami_type: Optional[pulumi.Input[_builtins.str]] = None,
                  auto_scaling_group_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 autoscaler_discovery: Optional[_builtins.bool] = None,
                  bootstrap_extra_args: Optional[pulumi.Input[_builtins.str]] = None,
+                 bottlerocket_config: Optional[pulumi.Input[Union['BottlerocketConfigArgs', 'BottlerocketConfigArgsDict']]] = None,
                  bottlerocket_settings: Optional[pulumi.Input[Mapping[str, Any]]] = None,
                  cloud_formation_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
                  cluster: Optional[pulumi.Input[Union['Cluster', Union['CoreDataArgs', 'CoreDataArgsDict']]]] = None,
@@ -1089,6 +1187,7 @@ class NodeGroupV2(pulumi.ComponentResource):
                  instance_profile: Optional['pulumi_aws.iam.InstanceProfile'] = None,
                  instance_profile_name: Optional[pulumi.Input[_builtins.str]] = None,
                  instance_type: Optional[pulumi.Input[_builtins.str]] = None,
+                 interruption_handling: Optional[Union['InterruptionHandlingArgs', 'InterruptionHandlingArgsDict']] = None,
                  key_name: Optional[pulumi.Input[_builtins.str]] = None,
                  kubelet_extra_args: Optional[pulumi.Input[_builtins.str]] = None,
                  labels: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
@@ -1097,6 +1196,7 @@ class NodeGroupV2(pulumi.ComponentResource):
                  min_refresh_percentage: Optional[pulumi.Input[_builtins.int]] = None,
                  min_size: Optional[pulumi.Input[_builtins.int]] = None,
                  node_associate_public_ip_address: Optional[pulumi.Input[_builtins.bool]] = None,
+                 node_config: Optional[pulumi.Input[Union['NodeConfigArgs', 'NodeConfigArgsDict']]] = None,
                  node_public_key: Optional[pulumi.Input[_builtins.str]] = None,
                  node_root_volume_delete_on_termination: Optional[pulumi.Input[_builtins.bool]] = None,
                  node_root_volume_encrypted: Optional[pulumi.Input[_builtins.bool]] = None,
@@ -1128,7 +1228,9 @@ class NodeGroupV2(pulumi.ComponentResource):
             __props__.__dict__["ami_id"] = ami_id
             __props__.__dict__["ami_type"] = ami_type
             __props__.__dict__["auto_scaling_group_tags"] = auto_scaling_group_tags
+            __props__.__dict__["autoscaler_discovery"] = autoscaler_discovery
             __props__.__dict__["bootstrap_extra_args"] = bootstrap_extra_args
+            __props__.__dict__["bottlerocket_config"] = bottlerocket_config
             __props__.__dict__["bottlerocket_settings"] = bottlerocket_settings
             __props__.__dict__["cloud_formation_tags"] = cloud_formation_tags
             if cluster is None and not opts.urn:
@@ -1145,6 +1247,7 @@ class NodeGroupV2(pulumi.ComponentResource):
             __props__.__dict__["instance_profile"] = instance_profile
             __props__.__dict__["instance_profile_name"] = instance_profile_name
             __props__.__dict__["instance_type"] = instance_type
+            __props__.__dict__["interruption_handling"] = interruption_handling
             __props__.__dict__["key_name"] = key_name
             __props__.__dict__["kubelet_extra_args"] = kubelet_extra_args
             __props__.__dict__["labels"] = labels
@@ -1153,6 +1256,7 @@ class NodeGroupV2(pulumi.ComponentResource):
             __props__.__dict__["min_refresh_percentage"] = min_refresh_percentage
             __props__.__dict__["min_size"] = min_size
             __props__.__dict__["node_associate_public_ip_address"] = node_associate_public_ip_address
+            __props__.__dict__["node_config"] = node_config
             __props__.__dict__["node_public_key"] = node_public_key
             __props__.__dict__["node_root_volume_delete_on_termination"] = node_root_volume_delete_on_termination
             __props__.__dict__["node_root_volume_encrypted"] = node_root_volume_encrypted
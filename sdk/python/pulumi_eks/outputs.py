@@ -22,11 +22,26 @@ import pulumi_kubernetes
 __all__ = [
     'AccessEntry',
     'AccessPolicyAssociation',
+    'BottlerocketBootstrapContainer',
+    'BottlerocketConfig',
+    'BottlerocketContainerRegistry',
+    'BottlerocketHostContainer',
+    'BottlerocketHostContainers',
+    'BottlerocketKernelSettings',
+    'BottlerocketKubernetesSettings',
+    'BottlerocketRegistryMirror',
     'ClusterNodeGroupOptions',
     'CoreData',
+    'InterruptionHandling',
+    'NodeConfig',
+    'NodeConfigContainerd',
+    'NodeConfigInstance',
+    'NodeConfigKubelet',
+    'NodeConfigLocalStorage',
     'NodeGroupData',
     'NodeadmOptions',
     'Taint',
+    'VpcCniNetworking',
 ]
 
 @pulumi.output_type
@@ -198,6 +213,517 @@ class AccessPolicyAssociation(dict):
         return pulumi.get(self, "policy_arn")
 
 
+@pulumi.output_type
+class BottlerocketBootstrapContainer(dict):
+    """
+    A Bottlerocket bootstrap container, which runs before the kubelet starts.
+    """
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "userData":
+            suggest = "user_data"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in BottlerocketBootstrapContainer. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        BottlerocketBootstrapContainer.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        BottlerocketBootstrapContainer.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 source: _builtins.str,
+                 essential: Optional[_builtins.bool] = None,
+                 mode: Optional[_builtins.str] = None,
+                 user_data: Optional[_builtins.str] = None):
+        """
+        A Bottlerocket bootstrap container, which runs before the kubelet starts.
+        :param _builtins.str source: The image of the bootstrap container.
+        :param _builtins.bool essential: Whether the node fails to boot if the bootstrap container fails.
+        :param _builtins.str mode: When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+        :param _builtins.str user_data: Base64 encoded user data passed to the bootstrap container.
+        """
+        pulumi.set(__self__, "source", source)
+        if essential is not None:
+            pulumi.set(__self__, "essential", essential)
+        if mode is not None:
+            pulumi.set(__self__, "mode", mode)
+        if user_data is not None:
+            pulumi.set(__self__, "user_data", user_data)
+
+    @_builtins.property
+    @pulumi.getter
+    def source(self) -> _builtins.str:
+        """
+        The image of the bootstrap container.
+        """
+        return pulumi.get(self, "source")
+
+    @_builtins.property
+    @pulumi.getter
+    def essential(self) -> Optional[_builtins.bool]:
+        """
+        Whether the node fails to boot if the bootstrap container fails.
+        """
+        return pulumi.get(self, "essential")
+
+    @_builtins.property
+    @pulumi.getter
+    def mode(self) -> Optional[_builtins.str]:
+        """
+        When the bootstrap container runs. Valid values are `always`, `once` and `off`.
+        """
+        return pulumi.get(self, "mode")
+
+    @_builtins.property
+    @pulumi.getter(name="userData")
+    def user_data(self) -> Optional[_builtins.str]:
+        """
+        Base64 encoded user data passed to the bootstrap container.
+        """
+        return pulumi.get(self, "user_data")
+
+
+@pulumi.output_type
+class BottlerocketConfig(dict):
+    """
+    Typed configuration of the most common Bottlerocket settings.
+
+    See for more details: https://bottlerocket.dev/en/os/1.20.x/api/settings/.
+    """
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "bootstrapContainers":
+            suggest = "bootstrap_containers"
+        elif key == "containerRegistry":
+            suggest = "container_registry"
+        elif key == "hostContainers":
+            suggest = "host_containers"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in BottlerocketConfig. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        BottlerocketConfig.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        BottlerocketConfig.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 bootstrap_containers: Optional[Mapping[str, 'outputs.BottlerocketBootstrapContainer']] = None,
+                 container_registry: Optional['outputs.BottlerocketContainerRegistry'] = None,
+                 host_containers: Optional['outputs.BottlerocketHostContainers'] = None,
+                 kernel: Optional['outputs.BottlerocketKernelSettings'] = None,
+                 kubernetes: Optional['outputs.BottlerocketKubernetesSettings'] = None):
+        """
+        Typed configuration of the most common Bottlerocket settings.
+
+        See for more details: https://bottlerocket.dev/en/os/1.20.x/api/settings/.
+        :param Mapping[str, 'BottlerocketBootstrapContainer'] bootstrap_containers: Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+        :param 'BottlerocketContainerRegistry' container_registry: Settings of the container registries, i.e. `settings.container-registry`.
+        :param 'BottlerocketHostContainers' host_containers: Settings of the admin and control host containers, i.e. `settings.host-containers`.
+        :param 'BottlerocketKernelSettings' kernel: Settings of the kernel, i.e. `settings.kernel`.
+        :param 'BottlerocketKubernetesSettings' kubernetes: Settings of the kubelet, i.e. `settings.kubernetes`.
+        """
+        if bootstrap_containers is not None:
+            pulumi.set(__self__, "bootstrap_containers", bootstrap_containers)
+        if container_registry is not None:
+            pulumi.set(__self__, "container_registry", container_registry)
+        if host_containers is not None:
+            pulumi.set(__self__, "host_containers", host_containers)
+        if kernel is not None:
+            pulumi.set(__self__, "kernel", kernel)
+        if kubernetes is not None:
+            pulumi.set(__self__, "kubernetes", kubernetes)
+
+    @_builtins.property
+    @pulumi.getter(name="bootstrapContainers")
+    def bootstrap_containers(self) -> Optional[Mapping[str, 'outputs.BottlerocketBootstrapContainer']]:
+        """
+        Containers that run before the kubelet starts, keyed by their name, i.e. `settings.bootstrap-containers`.
+        """
+        return pulumi.get(self, "bootstrap_containers")
+
+    @_builtins.property
+    @pulumi.getter(name="containerRegistry")
+    def container_registry(self) -> Optional['outputs.BottlerocketContainerRegistry']:
+        """
+        Settings of the container registries, i.e. `settings.container-registry`.
+        """
+        return pulumi.get(self, "container_registry")
+
+    @_builtins.property
+    @pulumi.getter(name="hostContainers")
+    def host_containers(self) -> Optional['outputs.BottlerocketHostContainers']:
+        """
+        Settings of the admin and control host containers, i.e. `settings.host-containers`.
+        """
+        return pulumi.get(self, "host_containers")
+
+    @_builtins.property
+    @pulumi.getter
+    def kernel(self) -> Optional['outputs.BottlerocketKernelSettings']:
+        """
+        Settings of the kernel, i.e. `settings.kernel`.
+        """
+        return pulumi.get(self, "kernel")
+
+    @_builtins.property
+    @pulumi.getter
+    def kubernetes(self) -> Optional['outputs.BottlerocketKubernetesSettings']:
+        """
+        Settings of the kubelet, i.e. `settings.kubernetes`.
+        """
+        return pulumi.get(self, "kubernetes")
+
+
+@pulumi.output_type
+class BottlerocketContainerRegistry(dict):
+    """
+    Settings of the container registries of Bottlerocket nodes.
+    """
+    def __init__(__self__, *,
+                 mirrors: Optional[Sequence['outputs.BottlerocketRegistryMirror']] = None):
+        """
+        Settings of the container registries of Bottlerocket nodes.
+        :param Sequence['BottlerocketRegistryMirror'] mirrors: Mirrors to pull images from instead of the original registries.
+        """
+        if mirrors is not None:
+            pulumi.set(__self__, "mirrors", mirrors)
+
+    @_builtins.property
+    @pulumi.getter
+    def mirrors(self) -> Optional[Sequence['outputs.BottlerocketRegistryMirror']]:
+        """
+        Mirrors to pull images from instead of the original registries.
+        """
+        return pulumi.get(self, "mirrors")
+
+
+@pulumi.output_type
+class BottlerocketHostContainer(dict):
+    """
+    Settings of a Bottlerocket host container.
+    """
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "userData":
+            suggest = "user_data"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in BottlerocketHostContainer. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        BottlerocketHostContainer.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        BottlerocketHostContainer.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 enabled: Optional[_builtins.bool] = None,
+                 source: Optional[_builtins.str] = None,
+                 superpowered: Optional[_builtins.bool] = None,
+                 user_data: Optional[_builtins.str] = None):
+        """
+        Settings of a Bottlerocket host container.
+        :param _builtins.bool enabled: Whether the host container runs.
+        :param _builtins.str source: The image of the host container.
+        :param _builtins.bool superpowered: Whether the host container has elevated privileges.
+        :param _builtins.str user_data: Base64 encoded user data passed to the host container.
+        """
+        if enabled is not None:
+            pulumi.set(__self__, "enabled", enabled)
+        if source is not None:
+            pulumi.set(__self__, "source", source)
+        if superpowered is not None:
+            pulumi.set(__self__, "superpowered", superpowered)
+        if user_data is not None:
+            pulumi.set(__self__, "user_data", user_data)
+
+    @_builtins.property
+    @pulumi.getter
+    def enabled(self) -> Optional[_builtins.bool]:
+        """
+        Whether the host container runs.
+        """
+        return pulumi.get(self, "enabled")
+
+    @_builtins.property
+    @pulumi.getter
+    def source(self) -> Optional[_builtins.str]:
+        """
+        The image of the host container.
+        """
+        return pulumi.get(self, "source")
+
+    @_builtins.property
+    @pulumi.getter
+    def superpowered(self) -> Optional[_builtins.bool]:
+        """
+        Whether the host container has elevated privileges.
+        """
+        return pulumi.get(self, "superpowered")
+
+    @_builtins.property
+    @pulumi.getter(name="userData")
+    def user_data(self) -> Optional[_builtins.str]:
+        """
+        Base64 encoded user data passed to the host container.
+        """
+        return pulumi.get(self, "user_data")
+
+
+@pulumi.output_type
+class BottlerocketHostContainers(dict):
+    """
+    Settings of the Bottlerocket host containers.
+    """
+    def __init__(__self__, *,
+                 admin: Optional['outputs.BottlerocketHostContainer'] = None,
+                 control: Optional['outputs.BottlerocketHostContainer'] = None):
+        """
+        Settings of the Bottlerocket host containers.
+        :param 'BottlerocketHostContainer' admin: The admin container, used for troubleshooting the node.
+        :param 'BottlerocketHostContainer' control: The control container, used to access the Bottlerocket API through AWS SSM.
+        """
+        if admin is not None:
+            pulumi.set(__self__, "admin", admin)
+        if control is not None:
+            pulumi.set(__self__, "control", control)
+
+    @_builtins.property
+    @pulumi.getter
+    def admin(self) -> Optional['outputs.BottlerocketHostContainer']:
+        """
+        The admin container, used for troubleshooting the node.
+        """
+        return pulumi.get(self, "admin")
+
+    @_builtins.property
+    @pulumi.getter
+    def control(self) -> Optional['outputs.BottlerocketHostContainer']:
+        """
+        The control container, used to access the Bottlerocket API through AWS SSM.
+        """
+        return pulumi.get(self, "control")
+
+
+@pulumi.output_type
+class BottlerocketKernelSettings(dict):
+    """
+    Settings of the Bottlerocket kernel.
+    """
+    def __init__(__self__, *,
+                 lockdown: Optional[_builtins.str] = None,
+                 sysctl: Optional[Mapping[str, _builtins.str]] = None):
+        """
+        Settings of the Bottlerocket kernel.
+        :param _builtins.str lockdown: The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+        :param Mapping[str, _builtins.str] sysctl: Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: "1"`.
+        """
+        if lockdown is not None:
+            pulumi.set(__self__, "lockdown", lockdown)
+        if sysctl is not None:
+            pulumi.set(__self__, "sysctl", sysctl)
+
+    @_builtins.property
+    @pulumi.getter
+    def lockdown(self) -> Optional[_builtins.str]:
+        """
+        The kernel lockdown mode. Valid values are `none`, `integrity` and `confidentiality`.
+        """
+        return pulumi.get(self, "lockdown")
+
+    @_builtins.property
+    @pulumi.getter
+    def sysctl(self) -> Optional[Mapping[str, _builtins.str]]:
+        """
+        Kernel parameters keyed by their name, e.g. `net.ipv4.ip_forward: "1"`.
+        """
+        return pulumi.get(self, "sysctl")
+
+
+@pulumi.output_type
+class BottlerocketKubernetesSettings(dict):
+    """
+    Settings of the kubelet on Bottlerocket nodes.
+    """
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "evictionHard":
+            suggest = "eviction_hard"
+        elif key == "evictionSoft":
+            suggest = "eviction_soft"
+        elif key == "evictionSoftGracePeriod":
+            suggest = "eviction_soft_grace_period"
+        elif key == "imageGcHighThresholdPercent":
+            suggest = "image_gc_high_threshold_percent"
+        elif key == "imageGcLowThresholdPercent":
+            suggest = "image_gc_low_threshold_percent"
+        elif key == "maxPods":
+            suggest = "max_pods"
+        elif key == "nodeLabels":
+            suggest = "node_labels"
+        elif key == "nodeTaints":
+            suggest = "node_taints"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in BottlerocketKubernetesSettings. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        BottlerocketKubernetesSettings.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        BottlerocketKubernetesSettings.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 eviction_hard: Optional[Mapping[str, _builtins.str]] = None,
+                 eviction_soft: Optional[Mapping[str, _builtins.str]] = None,
+                 eviction_soft_grace_period: Optional[Mapping[str, _builtins.str]] = None,
+                 image_gc_high_threshold_percent: Optional[_builtins.int] = None,
+                 image_gc_low_threshold_percent: Optional[_builtins.int] = None,
+                 max_pods: Optional[_builtins.int] = None,
+                 node_labels: Optional[Mapping[str, _builtins.str]] = None,
+                 node_taints: Optional[Mapping[str, _builtins.str]] = None):
+        """
+        Settings of the kubelet on Bottlerocket nodes.
+        :param Mapping[str, _builtins.str] eviction_hard: Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+        :param Mapping[str, _builtins.str] eviction_soft: Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+        :param Mapping[str, _builtins.str] eviction_soft_grace_period: Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+        :param _builtins.int image_gc_high_threshold_percent: The disk usage in percent after which image garbage collection always runs.
+        :param _builtins.int image_gc_low_threshold_percent: The disk usage in percent before which image garbage collection never runs.
+        :param _builtins.int max_pods: The maximum number of pods the kubelet runs on the node.
+        :param Mapping[str, _builtins.str] node_labels: Labels the node registers with.
+        :param Mapping[str, _builtins.str] node_taints: Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+        """
+        if eviction_hard is not None:
+            pulumi.set(__self__, "eviction_hard", eviction_hard)
+        if eviction_soft is not None:
+            pulumi.set(__self__, "eviction_soft", eviction_soft)
+        if eviction_soft_grace_period is not None:
+            pulumi.set(__self__, "eviction_soft_grace_period", eviction_soft_grace_period)
+        if image_gc_high_threshold_percent is not None:
+            pulumi.set(__self__, "image_gc_high_threshold_percent", image_gc_high_threshold_percent)
+        if image_gc_low_threshold_percent is not None:
+            pulumi.set(__self__, "image_gc_low_threshold_percent", image_gc_low_threshold_percent)
+        if max_pods is not None:
+            pulumi.set(__self__, "max_pods", max_pods)
+        if node_labels is not None:
+            pulumi.set(__self__, "node_labels", node_labels)
+        if node_taints is not None:
+            pulumi.set(__self__, "node_taints", node_taints)
+
+    @_builtins.property
+    @pulumi.getter(name="evictionHard")
+    def eviction_hard(self) -> Optional[Mapping[str, _builtins.str]]:
+        """
+        Hard eviction thresholds keyed by their eviction signal, e.g. `memory.available: 100Mi`.
+        """
+        return pulumi.get(self, "eviction_hard")
+
+    @_builtins.property
+    @pulumi.getter(name="evictionSoft")
+    def eviction_soft(self) -> Optional[Mapping[str, _builtins.str]]:
+        """
+        Soft eviction thresholds keyed by their eviction signal. Every soft threshold needs a grace period in `evictionSoftGracePeriod`.
+        """
+        return pulumi.get(self, "eviction_soft")
+
+    @_builtins.property
+    @pulumi.getter(name="evictionSoftGracePeriod")
+    def eviction_soft_grace_period(self) -> Optional[Mapping[str, _builtins.str]]:
+        """
+        Grace periods of the soft eviction thresholds keyed by their eviction signal, e.g. `memory.available: 30s`.
+        """
+        return pulumi.get(self, "eviction_soft_grace_period")
+
+    @_builtins.property
+    @pulumi.getter(name="imageGcHighThresholdPercent")
+    def image_gc_high_threshold_percent(self) -> Optional[_builtins.int]:
+        """
+        The disk usage in percent after which image garbage collection always runs.
+        """
+        return pulumi.get(self, "image_gc_high_threshold_percent")
+
+    @_builtins.property
+    @pulumi.getter(name="imageGcLowThresholdPercent")
+    def image_gc_low_threshold_percent(self) -> Optional[_builtins.int]:
+        """
+        The disk usage in percent before which image garbage collection never runs.
+        """
+        return pulumi.get(self, "image_gc_low_threshold_percent")
+
+    @_builtins.property
+    @pulumi.getter(name="maxPods")
+    def max_pods(self) -> Optional[_builtins.int]:
+        """
+        The maximum number of pods the kubelet runs on the node.
+        """
+        return pulumi.get(self, "max_pods")
+
+    @_builtins.property
+    @pulumi.getter(name="nodeLabels")
+    def node_labels(self) -> Optional[Mapping[str, _builtins.str]]:
+        """
+        Labels the node registers with.
+        """
+        return pulumi.get(self, "node_labels")
+
+    @_builtins.property
+    @pulumi.getter(name="nodeTaints")
+    def node_taints(self) -> Optional[Mapping[str, _builtins.str]]:
+        """
+        Taints the node registers with, in the format `value:effect`. The value can be empty, e.g. `:NoSchedule`.
+        """
+        return pulumi.get(self, "node_taints")
+
+
+@pulumi.output_type
+class BottlerocketRegistryMirror(dict):
+    """
+    A mirror of a container registry.
+    """
+    def __init__(__self__, *,
+                 endpoints: Sequence[_builtins.str],
+                 registry: _builtins.str):
+        """
+        A mirror of a container registry.
+        :param Sequence[_builtins.str] endpoints: The endpoints of the mirror, in order of preference.
+        :param _builtins.str registry: The registry to mirror, e.g. `docker.io`.
+        """
+        pulumi.set(__self__, "endpoints", endpoints)
+        pulumi.set(__self__, "registry", registry)
+
+    @_builtins.property
+    @pulumi.getter
+    def endpoints(self) -> Sequence[_builtins.str]:
+        """
+        The endpoints of the mirror, in order of preference.
+        """
+        return pulumi.get(self, "endpoints")
+
+    @_builtins.property
+    @pulumi.getter
+    def registry(self) -> _builtins.str:
+        """
+        The registry to mirror, e.g. `docker.io`.
+        """
+        return pulumi.get(self, "registry")
+
+
 @pulumi.output_type
 class ClusterNodeGroupOptions(dict):
     """
@@ -212,8 +738,12 @@ class ClusterNodeGroupOptions(dict):
             suggest = "ami_type"
         elif key == "autoScalingGroupTags":
             suggest = "auto_scaling_group_tags"
+        elif key == "autoscalerDiscovery":
+            suggest = "autoscaler_discovery"
         elif key == "bootstrapExtraArgs":
             suggest = "bootstrap_extra_args"
+        elif key == "bottlerocketConfig":
+            suggest = "bottlerocket_config"
         elif key == "bottlerocketSettings":
             suggest = "bottlerocket_settings"
         elif key == "cloudFormationTags":
@@ -238,6 +768,8 @@ class ClusterNodeGroupOptions(dict):
             suggest = "instance_profile_name"
         elif key == "instanceType":
             suggest = "instance_type"
+        elif key == "interruptionHandling":
+            suggest = "interruption_handling"
         elif key == "keyName":
             suggest = "key_name"
         elif key == "kubeletExtraArgs":
@@ -252,6 +784,8 @@ class ClusterNodeGroupOptions(dict):
             suggest = "min_size"
         elif key == "nodeAssociatePublicIpAddress":
             suggest = "node_associate_public_ip_address"
+        elif key == "nodeConfig":
+            suggest = "node_config"
         elif key == "nodePublicKey":
             suggest = "node_public_key"
         elif key == "nodeRootVolumeDeleteOnTermination":
@@ -298,7 +832,9 @@ class ClusterNodeGroupOptions(dict):
                  ami_id: Optional[_builtins.str] = None,
                  ami_type: Optional[_builtins.str] = None,
                  auto_scaling_group_tags: Optional[Mapping[str, _builtins.str]] = None,
+                 autoscaler_discovery: Optional[_builtins.bool] = None,
                  bootstrap_extra_args: Optional[_builtins.str] = None,
+                 bottlerocket_config: Optional['outputs.BottlerocketConfig'] = None,
                  bottlerocket_settings: Optional[Mapping[str, Any]] = None,
                  cloud_formation_tags: Optional[Mapping[str, _builtins.str]] = None,
                  cluster_ingress_rule: Optional['pulumi_aws.ec2.SecurityGroupRule'] = None,
@@ -312,6 +848,7 @@ class ClusterNodeGroupOptions(dict):
                  instance_profile: Optional['pulumi_aws.iam.InstanceProfile'] = None,
                  instance_profile_name: Optional[_builtins.str] = None,
                  instance_type: Optional[_builtins.str] = None,
+                 interruption_handling: Optional['outputs.InterruptionHandling'] = None,
                  key_name: Optional[_builtins.str] = None,
                  kubelet_extra_args: Optional[_builtins.str] = None,
                  labels: Optional[Mapping[str, _builtins.str]] = None,
@@ -320,6 +857,7 @@ class ClusterNodeGroupOptions(dict):
                  min_refresh_percentage: Optional[_builtins.int] = None,
                  min_size: Optional[_builtins.int] = None,
                  node_associate_public_ip_address: Optional[_builtins.bool] = None,
+                 node_config: Optional['outputs.NodeConfig'] = None,
                  node_public_key: Optional[_builtins.str] = None,
                  node_root_volume_delete_on_termination: Optional[_builtins.bool] = None,
                  node_root_volume_encrypted: Optional[_builtins.bool] = None,
@@ -357,7 +895,12 @@ class ClusterNodeGroupOptions(dict):
                Per AWS, all stack-level tags, including automatically created tags, and the `cloudFormationTags` option are propagated to resources that AWS CloudFormation supports, including the AutoScalingGroup. See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-resource-tags.html
                
                Note: Given the inheritance of auto-generated CF tags and `cloudFormationTags`, you should either supply the tag in `autoScalingGroupTags` or `cloudFormationTags`, but not both.
+        :param _builtins.bool autoscaler_discovery: Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+               
+               For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
         :param _builtins.str bootstrap_extra_args: Additional args to pass directly to `/etc/eks/bootstrap.sh`. For details on available options, see: https://github.com/awslabs/amazon-eks-ami/blob/master/files/bootstrap.sh. Note that the `--apiserver-endpoint`, `--b64-cluster-ca` and `--kubelet-extra-args` flags are included automatically based on other configuration parameters.
+        :param 'BottlerocketConfig' bottlerocket_config: Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+               The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
         :param Mapping[str, Any] bottlerocket_settings: The configuration settings for Bottlerocket OS.
                The settings will get merged with the base settings the provider uses to configure Bottlerocket.
                
@@ -400,6 +943,9 @@ class ClusterNodeGroupOptions(dict):
         :param 'pulumi_aws.iam.InstanceProfile' instance_profile: The IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
         :param _builtins.str instance_profile_name: The name of the IAM InstanceProfile to use on the NodeGroup. Properties instanceProfile and instanceProfileName are mutually exclusive.
         :param _builtins.str instance_type: The instance type to use for the cluster's nodes. Defaults to "t3.medium".
+        :param 'InterruptionHandling' interruption_handling: Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+               
+               See for more details: https://github.com/aws/aws-node-termination-handler
         :param _builtins.str key_name: Name of the key pair to use for SSH access to worker nodes.
         :param _builtins.str kubelet_extra_args: Extra args to pass to the Kubelet. Corresponds to the options passed in the `--kubeletExtraArgs` flag to `/etc/eks/bootstrap.sh`. For example, '--port=10251 --address=0.0.0.0'. Note that the `labels` and `taints` properties will be applied to this list (using `--node-labels` and `--register-with-taints` respectively) after to the explicit `kubeletExtraArgs`.
         :param Mapping[str, _builtins.str] labels: Custom k8s node labels to be attached to each worker node. Adds the given key/value pairs to the `--node-labels` kubelet argument.
@@ -408,6 +954,10 @@ class ClusterNodeGroupOptions(dict):
         :param _builtins.int min_refresh_percentage: The minimum amount of instances that should remain available during an instance refresh, expressed as a percentage. Defaults to 50.
         :param _builtins.int min_size: The minimum number of worker nodes running in the cluster. Defaults to 1.
         :param _builtins.bool node_associate_public_ip_address: Whether or not to auto-assign public IP addresses on the EKS worker nodes. If this toggle is set to true, the EKS workers will be auto-assigned public IPs. If false, they will not be auto-assigned public IPs.
+        :param 'NodeConfig' node_config: Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+               
+               Note: This is only applicable when using AL2023.
+               See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
         :param _builtins.str node_public_key: Public key material for SSH access to worker nodes. See allowed formats at:
                https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html
                If not provided, no SSH access is enabled on VMs.
@@ -465,8 +1015,12 @@ class ClusterNodeGroupOptions(dict):
             pulumi.set(__self__, "ami_type", ami_type)
         if auto_scaling_group_tags is not None:
             pulumi.set(__self__, "auto_scaling_group_tags", auto_scaling_group_tags)
+        if autoscaler_discovery is not None:
+            pulumi.set(__self__, "autoscaler_discovery", autoscaler_discovery)
         if bootstrap_extra_args is not None:
             pulumi.set(__self__, "bootstrap_extra_args", bootstrap_extra_args)
+        if bottlerocket_config is not None:
+            pulumi.set(__self__, "bottlerocket_config", bottlerocket_config)
         if bottlerocket_settings is not None:
             pulumi.set(__self__, "bottlerocket_settings", bottlerocket_settings)
         if cloud_formation_tags is not None:
@@ -493,6 +1047,8 @@ class ClusterNodeGroupOptions(dict):
             pulumi.set(__self__, "instance_profile_name", instance_profile_name)
         if instance_type is not None:
             pulumi.set(__self__, "instance_type", instance_type)
+        if interruption_handling is not None:
+            pulumi.set(__self__, "interruption_handling", interruption_handling)
         if key_name is not None:
             pulumi.set(__self__, "key_name", key_name)
         if kubelet_extra_args is not None:
@@ -509,6 +1065,8 @@ class ClusterNodeGroupOptions(dict):
             pulumi.set(__self__, "min_size", min_size)
         if node_associate_public_ip_address is not None:
             pulumi.set(__self__, "node_associate_public_ip_address", node_associate_public_ip_address)
+        if node_config is not None:
+            pulumi.set(__self__, "node_config", node_config)
         if node_public_key is not None:
             pulumi.set(__self__, "node_public_key", node_public_key)
         if node_root_volume_delete_on_termination is not None:
@@ -583,6 +1141,16 @@ class ClusterNodeGroupOptions(dict):
         """
         return pulumi.get(self, "auto_scaling_group_tags")
 
+    @_builtins.property
+    @pulumi.getter(name="autoscalerDiscovery")
+    def autoscaler_discovery(self) -> Optional[_builtins.bool]:
+        """
+        Tags the Auto Scaling Group for the auto-discovery of the Cluster Autoscaler. Besides the `k8s.io/cluster-autoscaler/enabled` and `k8s.io/cluster-autoscaler/<cluster name>` tags, the `labels` and `taints` are added as node template tags, so the Cluster Autoscaler can scale the node group up from zero.
+
+        For `NodeGroupV2`, this implies `ignoreScalingChanges` unless it is explicitly disabled.
+        """
+        return pulumi.get(self, "autoscaler_discovery")
+
     @_builtins.property
     @pulumi.getter(name="bootstrapExtraArgs")
     def bootstrap_extra_args(self) -> Optional[_builtins.str]:
@@ -591,6 +1159,15 @@ class ClusterNodeGroupOptions(dict):
         """
         return pulumi.get(self, "bootstrap_extra_args")
 
+    @_builtins.property
+    @pulumi.getter(name="bottlerocketConfig")
+    def bottlerocket_config(self) -> Optional['outputs.BottlerocketConfig']:
+        """
+        Typed configuration of the most common Bottlerocket settings. Invalid settings are reported during preview.
+        The config gets converted to Bottlerocket settings and merged with the base settings the provider uses to configure Bottlerocket. Settings in `bottlerocketSettings` take precedence over it.
+        """
+        return pulumi.get(self, "bottlerocket_config")
+
     @_builtins.property
     @pulumi.getter(name="bottlerocketSettings")
     def bottlerocket_settings(self) -> Optional[Mapping[str, Any]]:
@@ -724,6 +1301,16 @@ class ClusterNodeGroupOptions(dict):
         """
         return pulumi.get(self, "instance_type")
 
+    @_builtins.property
+    @pulumi.getter(name="interruptionHandling")
+    def interruption_handling(self) -> Optional['outputs.InterruptionHandling']:
+        """
+        Drain nodes gracefully before their instances are interrupted or terminated. This creates an SQS queue that receives Spot interruption warnings, rebalance recommendations and the termination lifecycle actions of the Auto Scaling Group, adds a termination lifecycle hook to the group and installs aws-node-termination-handler in queue mode, which cordons and drains the affected nodes.
+
+        See for more details: https://github.com/aws/aws-node-termination-handler
+        """
+        return pulumi.get(self, "interruption_handling")
+
     @_builtins.property
     @pulumi.getter(name="keyName")
     def key_name(self) -> Optional[_builtins.str]:
@@ -788,6 +1375,17 @@ class ClusterNodeGroupOptions(dict):
         """
         return pulumi.get(self, "node_associate_public_ip_address")
 
+    @_builtins.property
+    @pulumi.getter(name="nodeConfig")
+    def node_config(self) -> Optional['outputs.NodeConfig']:
+        """
+        Typed nodeadm NodeConfig for the kubelet, containerd and the instance store volumes. It gets rendered into an `application/node.eks.aws` part of the user data and merged with the base settings the provider sets. Settings in `nodeadmExtraOptions` take precedence over it. Invalid settings are reported during preview.
+
+        Note: This is only applicable when using AL2023.
+        See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/
+        """
+        return pulumi.get(self, "node_config")
+
     @_builtins.property
     @pulumi.getter(name="nodePublicKey")
     def node_public_key(self) -> Optional[_builtins.str]:
@@ -980,8 +1578,12 @@ class CoreData(dict):
             suggest = "access_entries"
         elif key == "awsProvider":
             suggest = "aws_provider"
+        elif key == "clusterLogGroup":
+            suggest = "cluster_log_group"
         elif key == "clusterSecurityGroup":
             suggest = "cluster_security_group"
+        elif key == "controlPlaneScalingConfig":
+            suggest = "control_plane_scaling_config"
         elif key == "eksNodeAccess":
             suggest = "eks_node_access"
         elif key == "encryptionConfig":
@@ -998,8 +1600,14 @@ class CoreData(dict):
             suggest = "public_subnet_ids"
         elif key == "storageClasses":
             suggest = "storage_classes"
+        elif key == "upgradePolicy":
+            suggest = "upgrade_policy"
         elif key == "vpcCni":
             suggest = "vpc_cni"
+        elif key == "vpcCniNetworking":
+            suggest = "vpc_cni_networking"
+        elif key == "zonalShiftConfig":
+            suggest = "zonal_shift_config"
 
         if suggest:
             pulumi.log.warn(f"Key '{key}' not found in CoreData. Access the value via the '{suggest}' property getter instead.")
@@ -1023,7 +1631,9 @@ class CoreData(dict):
                  vpc_id: _builtins.str,
                  access_entries: Optional[Sequence['outputs.AccessEntry']] = None,
                  aws_provider: Optional['pulumi_aws.Provider'] = None,
+                 cluster_log_group: Optional['pulumi_aws.cloudwatch.LogGroup'] = None,
                  cluster_security_group: Optional['pulumi_aws.ec2.SecurityGroup'] = None,
+                 control_plane_scaling_config: Optional['pulumi_aws.eks.outputs.ClusterControlPlaneScalingConfig'] = None,
                  eks_node_access: Optional['pulumi_kubernetes.core.v1.ConfigMap'] = None,
                  encryption_config: Optional['pulumi_aws.eks.outputs.ClusterEncryptionConfig'] = None,
                  fargate_profile: Optional['pulumi_aws.eks.FargateProfile'] = None,
@@ -1034,7 +1644,10 @@ class CoreData(dict):
                  public_subnet_ids: Optional[Sequence[_builtins.str]] = None,
                  storage_classes: Optional[Mapping[str, 'pulumi_kubernetes.storage.v1.StorageClass']] = None,
                  tags: Optional[Mapping[str, _builtins.str]] = None,
-                 vpc_cni: Optional['VpcCniAddon'] = None):
+                 upgrade_policy: Optional['pulumi_aws.eks.outputs.ClusterUpgradePolicy'] = None,
+                 vpc_cni: Optional['VpcCniAddon'] = None,
+                 vpc_cni_networking: Optional['outputs.VpcCniNetworking'] = None,
+                 zonal_shift_config: Optional['pulumi_aws.eks.outputs.ClusterZonalShiftConfig'] = None):
         """
         Defines the core set of data associated with an EKS cluster, including the network in which it runs.
         :param 'pulumi_aws.iam.Role' cluster_iam_role: The IAM Role attached to the EKS Cluster
@@ -1044,6 +1657,8 @@ class CoreData(dict):
         :param Sequence[_builtins.str] subnet_ids: List of subnet IDs for the EKS cluster.
         :param _builtins.str vpc_id: ID of the cluster's VPC.
         :param Sequence['AccessEntry'] access_entries: The access entries added to the cluster.
+        :param 'pulumi_aws.cloudwatch.LogGroup' cluster_log_group: The log group of the control plane logs, if it is managed by the cluster.
+        :param 'pulumi_aws.eks.ClusterControlPlaneScalingConfigArgs' control_plane_scaling_config: The scaling configuration of the control plane, including its provisioned tier.
         :param 'pulumi_aws.eks.FargateProfile' fargate_profile: The Fargate profile used to manage which pods run on Fargate.
         :param Any kubeconfig: The kubeconfig file for the cluster.
         :param Mapping[str, _builtins.str] node_security_group_tags: Tags attached to the security groups associated with the cluster's worker nodes.
@@ -1051,7 +1666,10 @@ class CoreData(dict):
         :param Sequence[_builtins.str] public_subnet_ids: List of subnet IDs for the public subnets.
         :param Mapping[str, 'pulumi_kubernetes.storage.v1.StorageClass'] storage_classes: The storage class used for persistent storage by the cluster.
         :param Mapping[str, _builtins.str] tags: A map of tags assigned to the EKS cluster.
+        :param 'pulumi_aws.eks.ClusterUpgradePolicyArgs' upgrade_policy: The upgrade policy of the cluster, which determines whether it gets extended support.
         :param 'VpcCniAddon' vpc_cni: The VPC CNI for the cluster.
+        :param 'VpcCniNetworking' vpc_cni_networking: The VPC CNI settings that affect the maximum number of pods of the nodes.
+        :param 'pulumi_aws.eks.ClusterZonalShiftConfigArgs' zonal_shift_config: The zonal shift configuration of the cluster.
         """
         pulumi.set(__self__, "cluster", cluster)
         pulumi.set(__self__, "cluster_iam_role", cluster_iam_role)
@@ -1065,8 +1683,12 @@ class CoreData(dict):
             pulumi.set(__self__, "access_entries", access_entries)
         if aws_provider is not None:
             pulumi.set(__self__, "aws_provider", aws_provider)
+        if cluster_log_group is not None:
+            pulumi.set(__self__, "cluster_log_group", cluster_log_group)
         if cluster_security_group is not None:
             pulumi.set(__self__, "cluster_security_group", cluster_security_group)
+        if control_plane_scaling_config is not None:
+            pulumi.set(__self__, "control_plane_scaling_config", control_plane_scaling_config)
         if eks_node_access is not None:
             pulumi.set(__self__, "eks_node_access", eks_node_access)
         if encryption_config is not None:
@@ -1087,8 +1709,14 @@ class CoreData(dict):
             pulumi.set(__self__, "storage_classes", storage_classes)
         if tags is not None:
             pulumi.set(__self__, "tags", tags)
+        if upgrade_policy is not None:
+            pulumi.set(__self__, "upgrade_policy", upgrade_policy)
         if vpc_cni is not None:
             pulumi.set(__self__, "vpc_cni", vpc_cni)
+        if vpc_cni_networking is not None:
+            pulumi.set(__self__, "vpc_cni_networking", vpc_cni_networking)
+        if zonal_shift_config is not None:
+            pulumi.set(__self__, "zonal_shift_config", zonal_shift_config)
 
     @_builtins.property
     @pulumi.getter
@@ -1161,11 +1789,27 @@ class CoreData(dict):
     def aws_provider(self) -> Optional['pulumi_aws.Provider']:
         return pulumi.get(self, "aws_provider")
 
+    @_builtins.property
+    @pulumi.getter(name="clusterLogGroup")
+    def cluster_log_group(self) -> Optional['pulumi_aws.cloudwatch.LogGroup']:
+        """
+        The log group of the control plane logs, if it is managed by the cluster.
+        """
+        return pulumi.get(self, "cluster_log_group")
+
     @_builtins.property
     @pulumi.getter(name="clusterSecurityGroup")
     def cluster_security_group(self) -> Optional['pulumi_aws.ec2.SecurityGroup']:
         return pulumi.get(self, "cluster_security_group")
 
+    @_builtins.property
+    @pulumi.getter(name="controlPlaneScalingConfig")
+    def control_plane_scaling_config(self) -> Optional['pulumi_aws.eks.outputs.ClusterControlPlaneScalingConfig']:
+        """
+        The scaling configuration of the control plane, including its provisioned tier.
+        """
+        return pulumi.get(self, "control_plane_scaling_config")
+
     @_builtins.property
     @pulumi.getter(name="eksNodeAccess")
     def eks_node_access(self) -> Optional['pulumi_kubernetes.core.v1.ConfigMap']:
@@ -1237,6 +1881,14 @@ class CoreData(dict):
         """
         return pulumi.get(self, "tags")
 
+    @_builtins.property
+    @pulumi.getter(name="upgradePolicy")
+    def upgrade_policy(self) -> Optional['pulumi_aws.eks.outputs.ClusterUpgradePolicy']:
+        """
+        The upgrade policy of the cluster, which determines whether it gets extended support.
+        """
+        return pulumi.get(self, "upgrade_policy")
+
     @_builtins.property
     @pulumi.getter(name="vpcCni")
     def vpc_cni(self) -> Optional['VpcCniAddon']:
@@ -1245,6 +1897,381 @@ class CoreData(dict):
         """
         return pulumi.get(self, "vpc_cni")
 
+    @_builtins.property
+    @pulumi.getter(name="vpcCniNetworking")
+    def vpc_cni_networking(self) -> Optional['outputs.VpcCniNetworking']:
+        """
+        The VPC CNI settings that affect the maximum number of pods of the nodes.
+        """
+        return pulumi.get(self, "vpc_cni_networking")
+
+    @_builtins.property
+    @pulumi.getter(name="zonalShiftConfig")
+    def zonal_shift_config(self) -> Optional['pulumi_aws.eks.outputs.ClusterZonalShiftConfig']:
+        """
+        The zonal shift configuration of the cluster.
+        """
+        return pulumi.get(self, "zonal_shift_config")
+
+
+@pulumi.output_type
+class InterruptionHandling(dict):
+    """
+    InterruptionHandling configures how a node group handles Spot interruptions and Auto Scaling Group termination events. The events are delivered to an SQS queue, which aws-node-termination-handler processes in queue mode to cordon and drain the affected nodes before their instances are terminated.
+    See for more details: https://github.com/aws/aws-node-termination-handler#queue-processor
+    """
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "chartVersion":
+            suggest = "chart_version"
+        elif key == "heartbeatTimeout":
+            suggest = "heartbeat_timeout"
+        elif key == "oidcProviderArn":
+            suggest = "oidc_provider_arn"
+        elif key == "serviceAccountName":
+            suggest = "service_account_name"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in InterruptionHandling. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        InterruptionHandling.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        InterruptionHandling.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 chart_version: Optional[_builtins.str] = None,
+                 heartbeat_timeout: Optional[_builtins.int] = None,
+                 namespace: Optional[_builtins.str] = None,
+                 oidc_provider_arn: Optional[_builtins.str] = None,
+                 service_account_name: Optional[_builtins.str] = None,
+                 values: Optional[Mapping[str, Any]] = None):
+        """
+        InterruptionHandling configures how a node group handles Spot interruptions and Auto Scaling Group termination events. The events are delivered to an SQS queue, which aws-node-termination-handler processes in queue mode to cordon and drain the affected nodes before their instances are terminated.
+        See for more details: https://github.com/aws/aws-node-termination-handler#queue-processor
+        :param _builtins.str chart_version: The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+        :param _builtins.int heartbeat_timeout: The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+        :param _builtins.str namespace: The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+        :param _builtins.str oidc_provider_arn: The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+               
+               If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+        :param _builtins.str service_account_name: The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `<name>-node-termination-handler`, where `<name>` is the name of the node group.
+        :param Mapping[str, Any] values: Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+        """
+        if chart_version is not None:
+            pulumi.set(__self__, "chart_version", chart_version)
+        if heartbeat_timeout is not None:
+            pulumi.set(__self__, "heartbeat_timeout", heartbeat_timeout)
+        if namespace is not None:
+            pulumi.set(__self__, "namespace", namespace)
+        if oidc_provider_arn is not None:
+            pulumi.set(__self__, "oidc_provider_arn", oidc_provider_arn)
+        if service_account_name is not None:
+            pulumi.set(__self__, "service_account_name", service_account_name)
+        if values is not None:
+            pulumi.set(__self__, "values", values)
+
+    @_builtins.property
+    @pulumi.getter(name="chartVersion")
+    def chart_version(self) -> Optional[_builtins.str]:
+        """
+        The version of the aws-node-termination-handler Helm chart. Defaults to `0.27.0`.
+        """
+        return pulumi.get(self, "chart_version")
+
+    @_builtins.property
+    @pulumi.getter(name="heartbeatTimeout")
+    def heartbeat_timeout(self) -> Optional[_builtins.int]:
+        """
+        The time in seconds an instance waits in the `Terminating:Wait` state for its node to be drained, before it is terminated anyway. Defaults to 300.
+        """
+        return pulumi.get(self, "heartbeat_timeout")
+
+    @_builtins.property
+    @pulumi.getter
+    def namespace(self) -> Optional[_builtins.str]:
+        """
+        The namespace to install the aws-node-termination-handler into. Defaults to `kube-system`.
+        """
+        return pulumi.get(self, "namespace")
+
+    @_builtins.property
+    @pulumi.getter(name="oidcProviderArn")
+    def oidc_provider_arn(self) -> Optional[_builtins.str]:
+        """
+        The ARN of the IAM OpenID Connect provider of the cluster, e.g. `cluster.oidcProviderArn`.
+
+        If set, the aws-node-termination-handler assumes its IAM role with IAM roles for service accounts (IRSA). Otherwise the IAM role is associated with the service account with EKS Pod Identity, which requires the `eks-pod-identity-agent` add-on to be installed on the cluster.
+        """
+        return pulumi.get(self, "oidc_provider_arn")
+
+    @_builtins.property
+    @pulumi.getter(name="serviceAccountName")
+    def service_account_name(self) -> Optional[_builtins.str]:
+        """
+        The name of the Kubernetes service account of aws-node-termination-handler. Defaults to `<name>-node-termination-handler`, where `<name>` is the name of the node group.
+        """
+        return pulumi.get(self, "service_account_name")
+
+    @_builtins.property
+    @pulumi.getter
+    def values(self) -> Optional[Mapping[str, Any]]:
+        """
+        Custom values for the Helm chart. The values are merged with the values set by the component, which are `enableSqsTerminationDraining`, `queueURL`, `awsRegion` and `serviceAccount`.
+        """
+        return pulumi.get(self, "values")
+
+
+@pulumi.output_type
+class NodeConfig(dict):
+    """
+    Typed nodeadm `NodeConfig`. The cluster details are configured by the provider.
+
+    See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/.
+    """
+    def __init__(__self__, *,
+                 containerd: Optional['outputs.NodeConfigContainerd'] = None,
+                 instance: Optional['outputs.NodeConfigInstance'] = None,
+                 kubelet: Optional['outputs.NodeConfigKubelet'] = None):
+        """
+        Typed nodeadm `NodeConfig`. The cluster details are configured by the provider.
+
+        See for more details: https://awslabs.github.io/amazon-eks-ami/nodeadm/doc/api/.
+        :param 'NodeConfigContainerd' containerd: Configuration of containerd.
+        :param 'NodeConfigInstance' instance: Configuration of the EC2 instance.
+        :param 'NodeConfigKubelet' kubelet: Configuration of the kubelet.
+        """
+        if containerd is not None:
+            pulumi.set(__self__, "containerd", containerd)
+        if instance is not None:
+            pulumi.set(__self__, "instance", instance)
+        if kubelet is not None:
+            pulumi.set(__self__, "kubelet", kubelet)
+
+    @_builtins.property
+    @pulumi.getter
+    def containerd(self) -> Optional['outputs.NodeConfigContainerd']:
+        """
+        Configuration of containerd.
+        """
+        return pulumi.get(self, "containerd")
+
+    @_builtins.property
+    @pulumi.getter
+    def instance(self) -> Optional['outputs.NodeConfigInstance']:
+        """
+        Configuration of the EC2 instance.
+        """
+        return pulumi.get(self, "instance")
+
+    @_builtins.property
+    @pulumi.getter
+    def kubelet(self) -> Optional['outputs.NodeConfigKubelet']:
+        """
+        Configuration of the kubelet.
+        """
+        return pulumi.get(self, "kubelet")
+
+
+@pulumi.output_type
+class NodeConfigContainerd(dict):
+    """
+    Configuration of containerd in a nodeadm `NodeConfig`.
+    """
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "baseRuntimeSpec":
+            suggest = "base_runtime_spec"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in NodeConfigContainerd. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        NodeConfigContainerd.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        NodeConfigContainerd.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 base_runtime_spec: Optional[Mapping[str, Any]] = None,
+                 config: Optional[_builtins.str] = None):
+        """
+        Configuration of containerd in a nodeadm `NodeConfig`.
+        :param Mapping[str, Any] base_runtime_spec: The OCI runtime specification containers are created with.
+        :param _builtins.str config: Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+        """
+        if base_runtime_spec is not None:
+            pulumi.set(__self__, "base_runtime_spec", base_runtime_spec)
+        if config is not None:
+            pulumi.set(__self__, "config", config)
+
+    @_builtins.property
+    @pulumi.getter(name="baseRuntimeSpec")
+    def base_runtime_spec(self) -> Optional[Mapping[str, Any]]:
+        """
+        The OCI runtime specification containers are created with.
+        """
+        return pulumi.get(self, "base_runtime_spec")
+
+    @_builtins.property
+    @pulumi.getter
+    def config(self) -> Optional[_builtins.str]:
+        """
+        Inline containerd configuration in TOML format, merged with the defaults of nodeadm.
+        """
+        return pulumi.get(self, "config")
+
+
+@pulumi.output_type
+class NodeConfigInstance(dict):
+    """
+    Configuration of the EC2 instance in a nodeadm `NodeConfig`.
+    """
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "localStorage":
+            suggest = "local_storage"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in NodeConfigInstance. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        NodeConfigInstance.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        NodeConfigInstance.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 local_storage: Optional['outputs.NodeConfigLocalStorage'] = None):
+        """
+        Configuration of the EC2 instance in a nodeadm `NodeConfig`.
+        :param 'NodeConfigLocalStorage' local_storage: Configuration of the local instance store volumes.
+        """
+        if local_storage is not None:
+            pulumi.set(__self__, "local_storage", local_storage)
+
+    @_builtins.property
+    @pulumi.getter(name="localStorage")
+    def local_storage(self) -> Optional['outputs.NodeConfigLocalStorage']:
+        """
+        Configuration of the local instance store volumes.
+        """
+        return pulumi.get(self, "local_storage")
+
+
+@pulumi.output_type
+class NodeConfigKubelet(dict):
+    """
+    Configuration of the kubelet in a nodeadm `NodeConfig`.
+    """
+    def __init__(__self__, *,
+                 config: Optional[Mapping[str, Any]] = None,
+                 flags: Optional[Sequence[_builtins.str]] = None):
+        """
+        Configuration of the kubelet in a nodeadm `NodeConfig`.
+        :param Mapping[str, Any] config: Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+               
+               See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+        :param Sequence[_builtins.str] flags: Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+        """
+        if config is not None:
+            pulumi.set(__self__, "config", config)
+        if flags is not None:
+            pulumi.set(__self__, "flags", flags)
+
+    @_builtins.property
+    @pulumi.getter
+    def config(self) -> Optional[Mapping[str, Any]]:
+        """
+        Kubelet configuration merged with the defaults of nodeadm, e.g. `{ maxPods: 110 }`.
+
+        See for more details: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/.
+        """
+        return pulumi.get(self, "config")
+
+    @_builtins.property
+    @pulumi.getter
+    def flags(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        Command line flags of the kubelet, e.g. `--node-labels=key=value`.
+        """
+        return pulumi.get(self, "flags")
+
+
+@pulumi.output_type
+class NodeConfigLocalStorage(dict):
+    """
+    Configuration of the local instance store volumes in a nodeadm `NodeConfig`.
+    """
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "disabledMounts":
+            suggest = "disabled_mounts"
+        elif key == "mountPath":
+            suggest = "mount_path"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in NodeConfigLocalStorage. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        NodeConfigLocalStorage.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        NodeConfigLocalStorage.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 strategy: _builtins.str,
+                 disabled_mounts: Optional[Sequence[_builtins.str]] = None,
+                 mount_path: Optional[_builtins.str] = None):
+        """
+        Configuration of the local instance store volumes in a nodeadm `NodeConfig`.
+        :param _builtins.str strategy: How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+        :param Sequence[_builtins.str] disabled_mounts: Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+        :param _builtins.str mount_path: The path the instance store volumes are mounted at.
+        """
+        pulumi.set(__self__, "strategy", strategy)
+        if disabled_mounts is not None:
+            pulumi.set(__self__, "disabled_mounts", disabled_mounts)
+        if mount_path is not None:
+            pulumi.set(__self__, "mount_path", mount_path)
+
+    @_builtins.property
+    @pulumi.getter
+    def strategy(self) -> _builtins.str:
+        """
+        How the instance store volumes are set up. Valid values are `RAID0`, `RAID10` and `Mount`.
+        """
+        return pulumi.get(self, "strategy")
+
+    @_builtins.property
+    @pulumi.getter(name="disabledMounts")
+    def disabled_mounts(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        Directories that are not moved to the instance store volumes. Valid values are `Containerd` and `PodLogs`.
+        """
+        return pulumi.get(self, "disabled_mounts")
+
+    @_builtins.property
+    @pulumi.getter(name="mountPath")
+    def mount_path(self) -> Optional[_builtins.str]:
+        """
+        The path the instance store volumes are mounted at.
+        """
+        return pulumi.get(self, "mount_path")
+
 
 @pulumi.output_type
 class NodeGroupData(dict):
@@ -1398,3 +2425,55 @@ class Taint(dict):
         return pulumi.get(self, "value")
 
 
+@pulumi.output_type
+class VpcCniNetworking(dict):
+    """
+    The VPC CNI settings that affect the maximum number of pods of the nodes.
+    """
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "customNetworking":
+            suggest = "custom_networking"
+        elif key == "prefixDelegation":
+            suggest = "prefix_delegation"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in VpcCniNetworking. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        VpcCniNetworking.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        VpcCniNetworking.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 custom_networking: _builtins.bool,
+                 prefix_delegation: _builtins.bool):
+        """
+        The VPC CNI settings that affect the maximum number of pods of the nodes.
+        :param _builtins.bool custom_networking: Whether pods get their IP addresses from the subnets of ENIConfigs.
+        :param _builtins.bool prefix_delegation: Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+        """
+        pulumi.set(__self__, "custom_networking", custom_networking)
+        pulumi.set(__self__, "prefix_delegation", prefix_delegation)
+
+    @_builtins.property
+    @pulumi.getter(name="customNetworking")
+    def custom_networking(self) -> _builtins.bool:
+        """
+        Whether pods get their IP addresses from the subnets of ENIConfigs.
+        """
+        return pulumi.get(self, "custom_networking")
+
+    @_builtins.property
+    @pulumi.getter(name="prefixDelegation")
+    def prefix_delegation(self) -> _builtins.bool:
+        """
+        Whether the VPC CNI assigns prefixes instead of individual IP addresses to network interfaces.
+        """
+        return pulumi.get(self, "prefix_delegation")
+
+
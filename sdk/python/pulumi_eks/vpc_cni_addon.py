@@ -38,6 +38,8 @@ class VpcCniAddonArgs:
                  log_file: Optional[pulumi.Input[_builtins.str]] = None,
                  log_level: Optional[pulumi.Input[_builtins.str]] = None,
                  node_port_support: Optional[pulumi.Input[_builtins.bool]] = None,
+                 pod_security_group_ids: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 pod_subnets: Optional[Mapping[str, pulumi.Input[_builtins.str]]] = None,
                  resolve_conflicts_on_create: Optional['ResolveConflictsOnCreate'] = None,
                  resolve_conflicts_on_update: Optional['ResolveConflictsOnUpdate'] = None,
                  security_context_privileged: Optional[pulumi.Input[_builtins.bool]] = None,
@@ -85,6 +87,14 @@ class VpcCniAddonArgs:
         :param pulumi.Input[_builtins.bool] node_port_support: Specifies whether NodePort services are enabled on a worker node's primary network interface. This requires additional iptables rules and that the kernel's reverse path filter on the primary interface is set to loose.
                
                Defaults to true.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] pod_security_group_ids: The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+               
+               Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+        :param Mapping[str, pulumi.Input[_builtins.str]] pod_subnets: Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+               
+               Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+               
+               See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
         :param 'ResolveConflictsOnCreate' resolve_conflicts_on_create: How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
         :param 'ResolveConflictsOnUpdate' resolve_conflicts_on_update: How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value.  Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
         :param pulumi.Input[_builtins.bool] security_context_privileged: Pass privilege to containers securityContext. This is required when SELinux is enabled. This value will not be passed to the CNI config by default
@@ -140,6 +150,10 @@ class VpcCniAddonArgs:
             pulumi.set(__self__, "log_level", log_level)
         if node_port_support is not None:
             pulumi.set(__self__, "node_port_support", node_port_support)
+        if pod_security_group_ids is not None:
+            pulumi.set(__self__, "pod_security_group_ids", pod_security_group_ids)
+        if pod_subnets is not None:
+            pulumi.set(__self__, "pod_subnets", pod_subnets)
         if resolve_conflicts_on_create is None:
             resolve_conflicts_on_create = 'OVERWRITE'
         if resolve_conflicts_on_create is not None:
@@ -397,6 +411,36 @@ class VpcCniAddonArgs:
     def node_port_support(self, value: Optional[pulumi.Input[_builtins.bool]]):
         pulumi.set(self, "node_port_support", value)
 
+    @_builtins.property
+    @pulumi.getter(name="podSecurityGroupIds")
+    def pod_security_group_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+
+        Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+        """
+        return pulumi.get(self, "pod_security_group_ids")
+
+    @pod_security_group_ids.setter
+    def pod_security_group_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "pod_security_group_ids", value)
+
+    @_builtins.property
+    @pulumi.getter(name="podSubnets")
+    def pod_subnets(self) -> Optional[Mapping[str, pulumi.Input[_builtins.str]]]:
+        """
+        Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+
+        Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+
+        See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
+        """
+        return pulumi.get(self, "pod_subnets")
+
+    @pod_subnets.setter
+    def pod_subnets(self, value: Optional[Mapping[str, pulumi.Input[_builtins.str]]]):
+        pulumi.set(self, "pod_subnets", value)
+
     @_builtins.property
     @pulumi.getter(name="resolveConflictsOnCreate")
     def resolve_conflicts_on_create(self) -> Optional['ResolveConflictsOnCreate']:
@@ -540,6 +584,8 @@ class VpcCniAddon(pulumi.ComponentResource):
                  log_file: Optional[pulumi.Input[_builtins.str]] = None,
                  log_level: Optional[pulumi.Input[_builtins.str]] = None,
                  node_port_support: Optional[pulumi.Input[_builtins.bool]] = None,
+                 pod_security_group_ids: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 pod_subnets: Optional[Mapping[str, pulumi.Input[_builtins.str]]] = None,
                  resolve_conflicts_on_create: Optional['ResolveConflictsOnCreate'] = None,
                  resolve_conflicts_on_update: Optional['ResolveConflictsOnUpdate'] = None,
                  security_context_privileged: Optional[pulumi.Input[_builtins.bool]] = None,
@@ -592,6 +638,14 @@ class VpcCniAddon(pulumi.ComponentResource):
         :param pulumi.Input[_builtins.bool] node_port_support: Specifies whether NodePort services are enabled on a worker node's primary network interface. This requires additional iptables rules and that the kernel's reverse path filter on the primary interface is set to loose.
                
                Defaults to true.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] pod_security_group_ids: The IDs of the security groups attached to the network interfaces of pods in `podSubnets`.
+               
+               Defaults to the node security group of the cluster, or the cluster security group created by EKS if the cluster has no node security group.
+        :param Mapping[str, pulumi.Input[_builtins.str]] pod_subnets: Maps availability zones to the IDs of the subnets that pods in the availability zone get their IP addresses from, e.g. subnets in a secondary CIDR block of the VPC.
+               
+               Setting this enables custom networking: an `ENIConfig` named after each availability zone is created and `eniConfigLabelDef` defaults to `topology.kubernetes.io/zone`, so that nodes automatically use the `ENIConfig` of their availability zone. Only nodes launched after enabling custom networking use it.
+               
+               See for more details: https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html
         :param 'ResolveConflictsOnCreate' resolve_conflicts_on_create: How to resolve field value conflicts when migrating a self-managed add-on to an Amazon EKS add-on. Valid values are `NONE` and `OVERWRITE`. For more details see the [CreateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateAddon.html) API Docs.
         :param 'ResolveConflictsOnUpdate' resolve_conflicts_on_update: How to resolve field value conflicts for an Amazon EKS add-on if you've changed a value from the Amazon EKS default value.  Valid values are `NONE`, `OVERWRITE`, and `PRESERVE`. For more details see the [UpdateAddon](https://docs.aws.amazon.com/eks/latest/APIReference/API_UpdateAddon.html) API Docs.
         :param pulumi.Input[_builtins.bool] security_context_privileged: Pass privilege to containers securityContext. This is required when SELinux is enabled. This value will not be passed to the CNI config by default
@@ -655,6 +709,8 @@ class VpcCniAddon(pulumi.ComponentResource):
                  log_file: Optional[pulumi.Input[_builtins.str]] = None,
                  log_level: Optional[pulumi.Input[_builtins.str]] = None,
                  node_port_support: Optional[pulumi.Input[_builtins.bool]] = None,
+                 pod_security_group_ids: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 pod_subnets: Optional[Mapping[str, pulumi.Input[_builtins.str]]] = None,
                  resolve_conflicts_on_create: Optional['ResolveConflictsOnCreate'] = None,
                  resolve_conflicts_on_update: Optional['ResolveConflictsOnUpdate'] = None,
                  security_context_privileged: Optional[pulumi.Input[_builtins.bool]] = None,
@@ -695,6 +751,8 @@ class VpcCniAddon(pulumi.ComponentResource):
             __props__.__dict__["log_file"] = log_file
             __props__.__dict__["log_level"] = log_level
             __props__.__dict__["node_port_support"] = node_port_support
+            __props__.__dict__["pod_security_group_ids"] = pod_security_group_ids
+            __props__.__dict__["pod_subnets"] = pod_subnets
             if resolve_conflicts_on_create is None:
                 resolve_conflicts_on_create = 'OVERWRITE'
             __props__.__dict__["resolve_conflicts_on_create"] = resolve_conflicts_on_create
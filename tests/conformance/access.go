@@ -0,0 +1,455 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"gopkg.in/yaml.v2"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// AccessEntry is an access entry of a cluster as described by the stack state.
+type AccessEntry struct {
+	PrincipalArn string
+	// AccessPolicies are the access policies associated with the principal, sorted by their ARNs.
+	AccessPolicies []AccessPolicy
+}
+
+// AccessPolicy is an access policy that is associated with the principal of an access entry.
+type AccessPolicy struct {
+	PolicyArn string
+	// ScopeType is either "cluster" or "namespace".
+	ScopeType string
+	// Namespaces are the namespaces the policy is scoped to, sorted by name. Empty for the cluster scope.
+	Namespaces []string
+}
+
+func (p AccessPolicy) String() string {
+	if len(p.Namespaces) == 0 {
+		return fmt.Sprintf("%s (%s)", p.PolicyArn, p.ScopeType)
+	}
+	return fmt.Sprintf("%s (%s: %s)", p.PolicyArn, p.ScopeType, strings.Join(p.Namespaces, ", "))
+}
+
+// AwsAuthMapping maps an IAM role or user to a Kubernetes user and groups in the aws-auth ConfigMap.
+type AwsAuthMapping struct {
+	RoleArn  string   `yaml:"rolearn,omitempty"`
+	UserArn  string   `yaml:"userarn,omitempty"`
+	Username string   `yaml:"username"`
+	Groups   []string `yaml:"groups"`
+}
+
+// AwsAuth holds the mappings of the aws-auth ConfigMap, i.e. the `roleMappings`, `userMappings` and instance roles
+// of a cluster.
+type AwsAuth struct {
+	RoleMappings []AwsAuthMapping
+	UserMappings []AwsAuthMapping
+}
+
+// clusterAccessEntryMap implements a map of Kubernetes cluster names to their access entries.
+type clusterAccessEntryMap map[string][]AccessEntry
+
+// mapClusterToAccessEntries looks up the access entries and access policy associations of the stack, which include
+// the ones the clusters created for their `accessEntries`. They are matched to their clusters by the `clusterName`
+// output.
+func mapClusterToAccessEntries(resources []apitype.ResourceV3) (clusterAccessEntryMap, error) {
+	type principal struct{ clusterName, principalArn string }
+	policies := make(map[principal][]AccessPolicy)
+	for _, res := range resources {
+		if res.Type.String() != "aws:eks/accessPolicyAssociation:AccessPolicyAssociation" {
+			continue
+		}
+		clusterName, err := property[string](res, "outputs.clusterName")
+		if err != nil {
+			return nil, err
+		}
+		principalArn, err := property[string](res, "outputs.principalArn")
+		if err != nil {
+			return nil, err
+		}
+		policy, err := accessPolicyFromState(res)
+		if err != nil {
+			return nil, err
+		}
+		key := principal{clusterName, principalArn}
+		policies[key] = append(policies[key], policy)
+	}
+
+	clusterAccessEntries := make(clusterAccessEntryMap)
+	for _, res := range resources {
+		if res.Type.String() != "aws:eks/accessEntry:AccessEntry" {
+			continue
+		}
+		clusterName, err := property[string](res, "outputs.clusterName")
+		if err != nil {
+			return nil, err
+		}
+		principalArn, err := property[string](res, "outputs.principalArn")
+		if err != nil {
+			return nil, err
+		}
+
+		entry := AccessEntry{PrincipalArn: principalArn, AccessPolicies: policies[principal{clusterName, principalArn}]}
+		sortAccessPolicies(entry.AccessPolicies)
+		clusterAccessEntries[clusterName] = append(clusterAccessEntries[clusterName], entry)
+	}
+
+	for _, entries := range clusterAccessEntries {
+		sort.Slice(entries, func(i, j int) bool { return entries[i].PrincipalArn < entries[j].PrincipalArn })
+	}
+	return clusterAccessEntries, nil
+}
+
+func accessPolicyFromState(res apitype.ResourceV3) (AccessPolicy, error) {
+	var policy AccessPolicy
+	var err error
+	if policy.PolicyArn, err = property[string](res, "outputs.policyArn"); err != nil {
+		return AccessPolicy{}, err
+	}
+	if policy.ScopeType, err = property[string](res, "outputs.accessScope.type"); err != nil {
+		return AccessPolicy{}, err
+	}
+
+	namespaces, err := property[[]any](res, "outputs.accessScope.namespaces")
+	if err != nil && !errors.Is(err, ErrMissingProperty) {
+		return AccessPolicy{}, err
+	}
+	for i, namespace := range namespaces {
+		ns, err := lookup[string](res.URN, fmt.Sprintf("outputs.accessScope.namespaces[%d]", i), namespace)
+		if err != nil {
+			return AccessPolicy{}, err
+		}
+		policy.Namespaces = append(policy.Namespaces, ns)
+	}
+	sort.Strings(policy.Namespaces)
+	return policy, nil
+}
+
+func sortAccessPolicies(policies []AccessPolicy) {
+	sort.Slice(policies, func(i, j int) bool { return policies[i].String() < policies[j].String() })
+}
+
+// clusterAwsAuthMap implements a map of Kubernetes cluster names to the aws-auth mappings of their stack state.
+type clusterAwsAuthMap map[string]*AwsAuth
+
+// mapClusterToAwsAuth looks up the aws-auth ConfigMaps that eks:index:Cluster components created for their instance
+// roles, `roleMappings` and `userMappings`.
+func mapClusterToAwsAuth(resources []apitype.ResourceV3) (clusterAwsAuthMap, error) {
+	componentClusterNames, err := mapComponentToClusterName(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterAwsAuth := make(clusterAwsAuthMap)
+	for _, res := range resources {
+		if res.Type.String() != "kubernetes:core/v1:ConfigMap" {
+			continue
+		}
+		clusterName, ok := componentClusterNames[res.Parent]
+		if !ok {
+			continue
+		}
+		if name, err := property[string](res, "outputs.metadata.name"); err != nil || name != "aws-auth" {
+			continue
+		}
+
+		data, err := property[map[string]any](res, "outputs.data")
+		if err != nil {
+			return nil, err
+		}
+		awsAuth, err := awsAuthFromData(data)
+		if err != nil {
+			return nil, &StateError{URN: res.URN, Property: "outputs.data", Err: err}
+		}
+		clusterAwsAuth[clusterName] = awsAuth
+	}
+	return clusterAwsAuth, nil
+}
+
+// awsAuthFromData parses the mapRoles and mapUsers of the data of an aws-auth ConfigMap.
+func awsAuthFromData(data map[string]any) (*AwsAuth, error) {
+	awsAuth := &AwsAuth{}
+	for key, mappings := range map[string]*[]AwsAuthMapping{"mapRoles": &awsAuth.RoleMappings, "mapUsers": &awsAuth.UserMappings} {
+		value, ok := data[key].(string)
+		if !ok {
+			continue
+		}
+		if err := yaml.Unmarshal([]byte(value), mappings); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", key, err)
+		}
+	}
+	return awsAuth, nil
+}
+
+// clusterAccess holds the expected access configuration of a cluster and the principals whose permissions are
+// validated.
+type clusterAccess struct {
+	eksClient     EKSAPI
+	accessEntries []AccessEntry
+	// awsAuth is nil if the stack doesn't manage the aws-auth ConfigMap of the cluster.
+	awsAuth    *AwsAuth
+	principals []principalClient
+}
+
+// principalClient is a principal together with the clientset that authenticates as the principal.
+type principalClient struct {
+	PrincipalAccess
+	clientset kubernetes.Interface
+}
+
+// mapClusterToAccess looks up the access configuration of the validated clusters and maps the principals to the
+// clusters of their kubeconfigs.
+func mapClusterToAccess(resources []apitype.ResourceV3, clusterKubeAccess clusterKubeAccessMap, eksClient EKSAPI, principals []PrincipalAccess) (map[string]*clusterAccess, error) {
+	clusterAccessEntries, err := mapClusterToAccessEntries(resources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map resources to access entries: %w", err)
+	}
+	clusterAwsAuth, err := mapClusterToAwsAuth(resources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map resources to aws-auth mappings: %w", err)
+	}
+
+	access := make(map[string]*clusterAccess, len(clusterKubeAccess))
+	for clusterName := range clusterKubeAccess {
+		access[clusterName] = &clusterAccess{
+			eksClient:     eksClient,
+			accessEntries: clusterAccessEntries[clusterName],
+			awsAuth:       clusterAwsAuth[clusterName],
+		}
+	}
+
+	for _, principal := range principals {
+		kubeAccess, err := mapClusterToKubeAccess(principal.Kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid kubeconfig of principal %s: %w", principal.PrincipalArn, err)
+		}
+		for clusterName, principalKubeAccess := range kubeAccess {
+			cluster, ok := access[clusterName]
+			if !ok {
+				return nil, fmt.Errorf("the kubeconfig of principal %s is for cluster %s, which is not validated", principal.PrincipalArn, clusterName)
+			}
+			cluster.principals = append(cluster.principals, principalClient{PrincipalAccess: principal, clientset: principalKubeAccess.Clientset})
+		}
+	}
+	return access, nil
+}
+
+// ValidateAccessEntries validates that the access entries of a cluster and their associated access policies match
+// the expected ones. Access entries of other principals, e.g. the ones EKS creates for managed node groups, are
+// ignored.
+func ValidateAccessEntries(ctx context.Context, log Logger, eksClient EKSAPI, clusterName string, expected []AccessEntry) error {
+	var principalArns []string
+	pages := eks.NewListAccessEntriesPaginator(eksClient, &eks.ListAccessEntriesInput{ClusterName: aws.String(clusterName)})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list access entries of cluster %s: %v", clusterName, err)
+		}
+		principalArns = append(principalArns, page.AccessEntries...)
+	}
+
+	var errs []error
+	for _, entry := range expected {
+		if !slices.Contains(principalArns, entry.PrincipalArn) {
+			errs = append(errs, fmt.Errorf("cluster %s has no access entry for principal %s", clusterName, entry.PrincipalArn))
+			continue
+		}
+
+		var policies []AccessPolicy
+		pages := eks.NewListAssociatedAccessPoliciesPaginator(eksClient, &eks.ListAssociatedAccessPoliciesInput{
+			ClusterName:  aws.String(clusterName),
+			PrincipalArn: aws.String(entry.PrincipalArn),
+		})
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list access policies of principal %s in cluster %s: %v", entry.PrincipalArn, clusterName, err)
+			}
+			for _, associated := range page.AssociatedAccessPolicies {
+				policy := AccessPolicy{PolicyArn: aws.ToString(associated.PolicyArn)}
+				if associated.AccessScope != nil {
+					policy.ScopeType = string(associated.AccessScope.Type)
+					policy.Namespaces = slices.Sorted(slices.Values(associated.AccessScope.Namespaces))
+				}
+				policies = append(policies, policy)
+			}
+		}
+		sortAccessPolicies(policies)
+
+		if !slices.EqualFunc(policies, entry.AccessPolicies, func(a, b AccessPolicy) bool { return a.String() == b.String() }) {
+			errs = append(errs, fmt.Errorf("expected principal %s of cluster %s to have access policies %v, got %v", entry.PrincipalArn, clusterName, entry.AccessPolicies, policies))
+			continue
+		}
+		log.Logf("Verified access entry of principal %s in cluster %s with %d access policies", entry.PrincipalArn, clusterName, len(policies))
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateAwsAuthMappings validates that the aws-auth ConfigMap of a cluster maps the roles and users like expected.
+// Mappings of other roles and users, e.g. the ones EKS adds for managed node groups, are ignored.
+func ValidateAwsAuthMappings(ctx context.Context, log Logger, clientset kubernetes.Interface, clusterName string, expected AwsAuth) error {
+	configMap, err := clientset.CoreV1().ConfigMaps("kube-system").Get(ctx, "aws-auth", metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get aws-auth ConfigMap of cluster %s: %w", clusterName, err)
+	}
+	data := make(map[string]any, len(configMap.Data))
+	for key, value := range configMap.Data {
+		data[key] = value
+	}
+	actual, err := awsAuthFromData(data)
+	if err != nil {
+		return fmt.Errorf("invalid aws-auth ConfigMap of cluster %s: %w", clusterName, err)
+	}
+
+	errs := append(
+		compareAwsAuthMappings(clusterName, "role", expected.RoleMappings, actual.RoleMappings, func(m AwsAuthMapping) string { return m.RoleArn }),
+		compareAwsAuthMappings(clusterName, "user", expected.UserMappings, actual.UserMappings, func(m AwsAuthMapping) string { return m.UserArn })...,
+	)
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	log.Logf("Verified %d role mappings and %d user mappings in the aws-auth ConfigMap of cluster %s", len(expected.RoleMappings), len(expected.UserMappings), clusterName)
+	return nil
+}
+
+func compareAwsAuthMappings(clusterName, kind string, expected, actual []AwsAuthMapping, arn func(AwsAuthMapping) string) []error {
+	var errs []error
+	for _, want := range expected {
+		i := slices.IndexFunc(actual, func(m AwsAuthMapping) bool { return arn(m) == arn(want) })
+		if i < 0 {
+			errs = append(errs, fmt.Errorf("aws-auth ConfigMap of cluster %s does not map %s %s", clusterName, kind, arn(want)))
+			continue
+		}
+
+		got := actual[i]
+		if got.Username != want.Username || !slices.Equal(slices.Sorted(slices.Values(got.Groups)), slices.Sorted(slices.Values(want.Groups))) {
+			errs = append(errs, fmt.Errorf("expected aws-auth ConfigMap of cluster %s to map %s %s to user %q and groups %v, got user %q and groups %v",
+				clusterName, kind, arn(want), want.Username, want.Groups, got.Username, got.Groups))
+		}
+	}
+	return errs
+}
+
+// accessReviewVerbs are the verbs that are reviewed for every AccessRule. The verbs that aren't allowed by the rule
+// are expected to be denied.
+var accessReviewVerbs = []string{"get", "list", "watch", "create", "update", "patch", "delete", "deletecollection"}
+
+// AccessRule describes the verbs that a principal is expected to be allowed on a resource. All other verbs are
+// expected to be denied.
+type AccessRule struct {
+	// Namespace is the namespace of the resource. It is empty for cluster-scoped resources or access across all
+	// namespaces.
+	Namespace string
+	// Group is the API group of the resource, e.g. "apps". It is empty for the core group.
+	Group    string
+	Resource string
+	// Verbs are the allowed verbs. "*" allows all verbs. If empty, all verbs are expected to be denied.
+	Verbs []string
+}
+
+// PrincipalAccess describes the Kubernetes permissions of an IAM principal, as granted by its access entry or its
+// aws-auth mapping and the RBAC bindings of its groups.
+type PrincipalAccess struct {
+	PrincipalArn string
+	// Kubeconfig authenticates as the principal, e.g. the result of `Cluster.getKubeconfig({ roleArn })`. It can
+	// either be passed as a string or as an object.
+	Kubeconfig any
+	Rules      []AccessRule
+}
+
+// principalCheckName returns the name of the check of a principal, e.g. "rbac arn:aws:iam::123456789012:role/dev".
+func principalCheckName(principal PrincipalAccess) string {
+	return "rbac " + principal.PrincipalArn
+}
+
+// ValidatePrincipalAccess validates the permissions of a principal with SelfSubjectAccessReviews. The clientset has to
+// authenticate as the principal, see PrincipalAccess.Kubeconfig.
+func ValidatePrincipalAccess(ctx context.Context, log Logger, clientset kubernetes.Interface, clusterName string, principal PrincipalAccess) error {
+	var errs []error
+	for _, rule := range principal.Rules {
+		verbs := accessReviewVerbs
+		for _, verb := range rule.Verbs {
+			if verb != "*" && !slices.Contains(verbs, verb) {
+				verbs = append(slices.Clip(verbs), verb)
+			}
+		}
+
+		for _, verb := range verbs {
+			review, err := clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+				Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+					ResourceAttributes: &authorizationv1.ResourceAttributes{
+						Namespace: rule.Namespace,
+						Verb:      verb,
+						Group:     rule.Group,
+						Resource:  rule.Resource,
+					},
+				},
+			}, metav1.CreateOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to review access of principal %s in cluster %s: %v", principal.PrincipalArn, clusterName, err)
+			}
+
+			allowed := slices.Contains(rule.Verbs, "*") || slices.Contains(rule.Verbs, verb)
+			if review.Status.Allowed != allowed {
+				errs = append(errs, fmt.Errorf("expected principal %s to be %s to %s in cluster %s, but it was %s%s",
+					principal.PrincipalArn, describeAllowed(allowed), describeAccess(verb, rule), clusterName, describeAllowed(review.Status.Allowed), describeReason(review.Status)))
+			}
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	log.Logf("Verified the permissions of principal %s in cluster %s", principal.PrincipalArn, clusterName)
+	return nil
+}
+
+// describeAccess describes a verb on the resource of a rule, e.g. "list deployments.apps in namespace default".
+func describeAccess(verb string, rule AccessRule) string {
+	resource := rule.Resource
+	if rule.Group != "" {
+		resource += "." + rule.Group
+	}
+	if rule.Namespace == "" {
+		return verb + " " + resource
+	}
+	return fmt.Sprintf("%s %s in namespace %s", verb, resource, rule.Namespace)
+}
+
+func describeAllowed(allowed bool) string {
+	if allowed {
+		return "allowed"
+	}
+	return "denied"
+}
+
+func describeReason(status authorizationv1.SubjectAccessReviewStatus) string {
+	if status.Reason == "" {
+		return ""
+	}
+	return ": " + status.Reason
+}
@@ -0,0 +1,494 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	eksTypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const (
+	testDevRoleArn   = "arn:aws:iam::123456789012:role/dev"
+	testAdminUserArn = "arn:aws:iam::123456789012:user/admin"
+	testViewPolicy   = "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+	testAdminPolicy  = "arn:aws:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy"
+)
+
+func accessEntryResource(clusterName, principalArn string) apitype.ResourceV3 {
+	return apitype.ResourceV3{
+		URN:  resource.URN("urn:pulumi:test::access::eks:index:Cluster$aws:eks/accessEntry:AccessEntry::" + principalArn),
+		Type: "aws:eks/accessEntry:AccessEntry",
+		Outputs: map[string]any{
+			"clusterName":  clusterName,
+			"principalArn": principalArn,
+			"type":         "STANDARD",
+		},
+	}
+}
+
+func accessPolicyAssociationResource(clusterName, principalArn, policyArn string, accessScope map[string]any) apitype.ResourceV3 {
+	return apitype.ResourceV3{
+		URN:  resource.URN("urn:pulumi:test::access::eks:index:Cluster$aws:eks/accessEntry:AccessEntry$aws:eks/accessPolicyAssociation:AccessPolicyAssociation::" + policyArn),
+		Type: "aws:eks/accessPolicyAssociation:AccessPolicyAssociation",
+		Outputs: map[string]any{
+			"clusterName":  clusterName,
+			"principalArn": principalArn,
+			"policyArn":    policyArn,
+			"accessScope":  accessScope,
+		},
+	}
+}
+
+func TestMapClusterToAccessEntries(t *testing.T) {
+	t.Parallel()
+
+	resources := []apitype.ResourceV3{
+		accessEntryResource("cluster-1", testDevRoleArn),
+		accessPolicyAssociationResource("cluster-1", testDevRoleArn, testViewPolicy, map[string]any{
+			"type":       "namespace",
+			"namespaces": []any{"kube-system", "default"},
+		}),
+		accessPolicyAssociationResource("cluster-1", testDevRoleArn, testAdminPolicy, map[string]any{"type": "cluster"}),
+		accessEntryResource("cluster-1", "arn:aws:iam::123456789012:role/nodes"),
+		// The policies of the principal in another cluster aren't mixed up.
+		accessEntryResource("cluster-2", testDevRoleArn),
+	}
+
+	accessEntries, err := mapClusterToAccessEntries(resources)
+	require.NoError(t, err)
+	assert.Equal(t, clusterAccessEntryMap{
+		"cluster-1": {
+			{
+				PrincipalArn: testDevRoleArn,
+				AccessPolicies: []AccessPolicy{
+					{PolicyArn: testAdminPolicy, ScopeType: "cluster"},
+					{PolicyArn: testViewPolicy, ScopeType: "namespace", Namespaces: []string{"default", "kube-system"}},
+				},
+			},
+			{PrincipalArn: "arn:aws:iam::123456789012:role/nodes"},
+		},
+		"cluster-2": {
+			{PrincipalArn: testDevRoleArn},
+		},
+	}, accessEntries)
+}
+
+func TestMapClusterToAccessEntriesMalformedState(t *testing.T) {
+	t.Parallel()
+
+	resources := []apitype.ResourceV3{
+		accessEntryResource("cluster-1", testDevRoleArn),
+		accessPolicyAssociationResource("cluster-1", testDevRoleArn, testViewPolicy, map[string]any{
+			"type":       "namespace",
+			"namespaces": []any{"default", 42.0},
+		}),
+	}
+
+	_, err := mapClusterToAccessEntries(resources)
+	var stateErr *StateError
+	require.True(t, errors.As(err, &stateErr), "expected a StateError, got %v", err)
+	assert.Equal(t, "outputs.accessScope.namespaces[1]", stateErr.Property)
+	assert.ErrorIs(t, err, ErrUnexpectedType)
+}
+
+func TestMapClusterToAwsAuth(t *testing.T) {
+	t.Parallel()
+
+	componentURN := resource.URN("urn:pulumi:test::access::eks:index:Cluster::cluster")
+	configMap := func(name string, data map[string]any) apitype.ResourceV3 {
+		return apitype.ResourceV3{
+			URN:    resource.URN("urn:pulumi:test::access::eks:index:Cluster$kubernetes:core/v1:ConfigMap::" + name),
+			Type:   "kubernetes:core/v1:ConfigMap",
+			Parent: componentURN,
+			Outputs: map[string]any{
+				"metadata": map[string]any{"name": name, "namespace": "kube-system"},
+				"data":     data,
+			},
+		}
+	}
+	resources := []apitype.ResourceV3{
+		{URN: componentURN, Type: "eks:index:Cluster"},
+		{
+			URN:     "urn:pulumi:test::access::eks:index:Cluster$aws:eks/cluster:Cluster::cluster-eksCluster",
+			Type:    "aws:eks/cluster:Cluster",
+			Parent:  componentURN,
+			Outputs: map[string]any{"name": "cluster-eksCluster-1234567"},
+		},
+		configMap("aws-auth", map[string]any{
+			"mapRoles": "- rolearn: arn:aws:iam::123456789012:role/dev\n  username: dev\n  groups:\n    - devs\n" +
+				"- rolearn: arn:aws:iam::123456789012:role/nodes\n  username: system:node:{{EC2PrivateDNSName}}\n  groups:\n    - system:bootstrappers\n    - system:nodes\n",
+			"mapUsers": "- userarn: arn:aws:iam::123456789012:user/admin\n  username: admin\n  groups:\n    - system:masters\n",
+		}),
+		configMap("other", map[string]any{"mapRoles": "not yaml: ["}),
+	}
+
+	awsAuth, err := mapClusterToAwsAuth(resources)
+	require.NoError(t, err)
+	assert.Equal(t, clusterAwsAuthMap{
+		"cluster-eksCluster-1234567": {
+			RoleMappings: []AwsAuthMapping{
+				{RoleArn: testDevRoleArn, Username: "dev", Groups: []string{"devs"}},
+				{RoleArn: "arn:aws:iam::123456789012:role/nodes", Username: "system:node:{{EC2PrivateDNSName}}", Groups: []string{"system:bootstrappers", "system:nodes"}},
+			},
+			UserMappings: []AwsAuthMapping{
+				{UserArn: testAdminUserArn, Username: "admin", Groups: []string{"system:masters"}},
+			},
+		},
+	}, awsAuth)
+}
+
+func TestValidateAccessEntries(t *testing.T) {
+	t.Parallel()
+
+	viewDefault := eksTypes.AssociatedAccessPolicy{
+		PolicyArn:   aws.String(testViewPolicy),
+		AccessScope: &eksTypes.AccessScope{Type: eksTypes.AccessScopeTypeNamespace, Namespaces: []string{"kube-system", "default"}},
+	}
+	admin := eksTypes.AssociatedAccessPolicy{
+		PolicyArn:   aws.String(testAdminPolicy),
+		AccessScope: &eksTypes.AccessScope{Type: eksTypes.AccessScopeTypeCluster},
+	}
+	expected := []AccessEntry{
+		{
+			PrincipalArn: testDevRoleArn,
+			AccessPolicies: []AccessPolicy{
+				{PolicyArn: testAdminPolicy, ScopeType: "cluster"},
+				{PolicyArn: testViewPolicy, ScopeType: "namespace", Namespaces: []string{"default", "kube-system"}},
+			},
+		},
+		{PrincipalArn: "arn:aws:iam::123456789012:role/nodes"},
+	}
+
+	tests := []struct {
+		name          string
+		accessEntries map[string][]eksTypes.AssociatedAccessPolicy
+		wantErr       []string
+	}{
+		{
+			name: "matching access entries",
+			accessEntries: map[string][]eksTypes.AssociatedAccessPolicy{
+				testDevRoleArn:                         {viewDefault, admin},
+				"arn:aws:iam::123456789012:role/nodes": nil,
+				// Created by EKS for a managed node group.
+				"arn:aws:iam::123456789012:role/managed-nodes": nil,
+			},
+		},
+		{
+			name: "missing access entry",
+			accessEntries: map[string][]eksTypes.AssociatedAccessPolicy{
+				testDevRoleArn: {viewDefault, admin},
+			},
+			wantErr: []string{"cluster my-cluster has no access entry for principal arn:aws:iam::123456789012:role/nodes"},
+		},
+		{
+			name: "different access policies",
+			accessEntries: map[string][]eksTypes.AssociatedAccessPolicy{
+				testDevRoleArn: {{
+					PolicyArn:   aws.String(testViewPolicy),
+					AccessScope: &eksTypes.AccessScope{Type: eksTypes.AccessScopeTypeNamespace, Namespaces: []string{"default"}},
+				}},
+				"arn:aws:iam::123456789012:role/nodes": {admin},
+			},
+			wantErr: []string{
+				"expected principal " + testDevRoleArn + " of cluster my-cluster to have access policies [" +
+					testAdminPolicy + " (cluster) " + testViewPolicy + " (namespace: default, kube-system)], got [" + testViewPolicy + " (namespace: default)]",
+				"expected principal arn:aws:iam::123456789012:role/nodes of cluster my-cluster to have access policies [], got [" + testAdminPolicy + " (cluster)]",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			eksClient := newFakeEKS("my-cluster")
+			eksClient.accessEntries = tt.accessEntries
+
+			log := &recordingLogger{}
+			err := ValidateAccessEntries(context.Background(), log, eksClient, "my-cluster", expected)
+			if len(tt.wantErr) == 0 {
+				require.NoError(t, err)
+				assert.True(t, log.contains("Verified access entry of principal "+testDevRoleArn+" in cluster my-cluster with 2 access policies"))
+				return
+			}
+			require.Error(t, err)
+			for _, want := range tt.wantErr {
+				assert.Contains(t, err.Error(), want)
+			}
+		})
+	}
+}
+
+func TestValidateAwsAuthMappings(t *testing.T) {
+	t.Parallel()
+
+	expected := AwsAuth{
+		RoleMappings: []AwsAuthMapping{
+			{RoleArn: testDevRoleArn, Username: "dev", Groups: []string{"devs", "viewers"}},
+		},
+		UserMappings: []AwsAuthMapping{
+			{UserArn: testAdminUserArn, Username: "admin", Groups: []string{"system:masters"}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		data    map[string]string
+		wantErr []string
+	}{
+		{
+			name: "matching mappings",
+			data: map[string]string{
+				// EKS adds the roles of managed node groups.
+				"mapRoles": "- rolearn: arn:aws:iam::123456789012:role/dev\n  username: dev\n  groups: [viewers, devs]\n" +
+					"- rolearn: arn:aws:iam::123456789012:role/managed-nodes\n  username: system:node:{{EC2PrivateDNSName}}\n  groups: [system:bootstrappers, system:nodes]\n",
+				"mapUsers": "- userarn: arn:aws:iam::123456789012:user/admin\n  username: admin\n  groups: [system:masters]\n",
+			},
+		},
+		{
+			name: "different user and groups",
+			data: map[string]string{
+				"mapRoles": "- rolearn: arn:aws:iam::123456789012:role/dev\n  username: developer\n  groups: [devs]\n",
+				"mapUsers": "- userarn: arn:aws:iam::123456789012:user/admin\n  username: admin\n  groups: [system:masters]\n",
+			},
+			wantErr: []string{`expected aws-auth ConfigMap of cluster my-cluster to map role ` + testDevRoleArn + ` to user "dev" and groups [devs viewers], got user "developer" and groups [devs]`},
+		},
+		{
+			name: "missing mappings",
+			data: map[string]string{"mapRoles": "[]"},
+			wantErr: []string{
+				"aws-auth ConfigMap of cluster my-cluster does not map role " + testDevRoleArn,
+				"aws-auth ConfigMap of cluster my-cluster does not map user " + testAdminUserArn,
+			},
+		},
+		{
+			name:    "invalid mappings",
+			data:    map[string]string{"mapRoles": "rolearn: " + testDevRoleArn},
+			wantErr: []string{"invalid aws-auth ConfigMap of cluster my-cluster: failed to parse mapRoles"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			clientset, _ := newFakeClients(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "aws-auth"},
+				Data:       tt.data,
+			})
+
+			log := &recordingLogger{}
+			err := ValidateAwsAuthMappings(context.Background(), log, clientset, "my-cluster", expected)
+			if len(tt.wantErr) == 0 {
+				require.NoError(t, err)
+				assert.True(t, log.contains("Verified 1 role mappings and 1 user mappings in the aws-auth ConfigMap of cluster my-cluster"))
+				return
+			}
+			require.Error(t, err)
+			for _, want := range tt.wantErr {
+				assert.Contains(t, err.Error(), want)
+			}
+		})
+	}
+}
+
+// simulateAuthorizer makes the fake clientset answer SelfSubjectAccessReviews with the decision of allow.
+func simulateAuthorizer(clientset *fake.Clientset, allow func(attributes authorizationv1.ResourceAttributes) bool) {
+	clientset.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		review.Status.Allowed = allow(*review.Spec.ResourceAttributes)
+		if !review.Status.Allowed {
+			review.Status.Reason = "no RBAC policy matched"
+		}
+		return true, review, nil
+	})
+}
+
+// viewDefaultNamespace allows to read the resources in the default namespace, like the AmazonEKSViewPolicy scoped to
+// the namespace.
+func viewDefaultNamespace(attributes authorizationv1.ResourceAttributes) bool {
+	return attributes.Namespace == "default" && slices.Contains([]string{"get", "list", "watch"}, attributes.Verb)
+}
+
+func TestValidatePrincipalAccess(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		rules   []AccessRule
+		allow   func(authorizationv1.ResourceAttributes) bool
+		wantErr []string
+	}{
+		{
+			name: "allowed and denied verbs",
+			rules: []AccessRule{
+				{Namespace: "default", Resource: "pods", Verbs: []string{"get", "list", "watch"}},
+				{Namespace: "default", Group: "apps", Resource: "deployments", Verbs: []string{"get", "list", "watch"}},
+				{Namespace: "kube-system", Resource: "pods"},
+				{Resource: "nodes"},
+			},
+			allow: viewDefaultNamespace,
+		},
+		{
+			name: "all verbs",
+			rules: []AccessRule{
+				{Resource: "nodes", Verbs: []string{"*"}},
+			},
+			allow: func(authorizationv1.ResourceAttributes) bool { return true },
+		},
+		{
+			name: "unexpected permissions",
+			rules: []AccessRule{
+				{Namespace: "default", Group: "apps", Resource: "deployments", Verbs: []string{"get", "list", "watch", "escalate"}},
+			},
+			allow: func(attributes authorizationv1.ResourceAttributes) bool {
+				return attributes.Verb != "escalate"
+			},
+			wantErr: []string{
+				"expected principal " + testDevRoleArn + " to be denied to create deployments.apps in namespace default in cluster my-cluster, but it was allowed",
+				"expected principal " + testDevRoleArn + " to be denied to deletecollection deployments.apps in namespace default in cluster my-cluster, but it was allowed",
+				"expected principal " + testDevRoleArn + " to be allowed to escalate deployments.apps in namespace default in cluster my-cluster, but it was denied: no RBAC policy matched",
+			},
+		},
+		{
+			name: "missing permissions",
+			rules: []AccessRule{
+				{Resource: "nodes", Verbs: []string{"list"}},
+			},
+			allow:   viewDefaultNamespace,
+			wantErr: []string{"expected principal " + testDevRoleArn + " to be allowed to list nodes in cluster my-cluster, but it was denied: no RBAC policy matched"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			clientset, _ := newFakeClients()
+			simulateAuthorizer(clientset, tt.allow)
+
+			log := &recordingLogger{}
+			err := ValidatePrincipalAccess(context.Background(), log, clientset, "my-cluster", PrincipalAccess{
+				PrincipalArn: testDevRoleArn,
+				Rules:        tt.rules,
+			})
+			if len(tt.wantErr) == 0 {
+				require.NoError(t, err)
+				assert.True(t, log.contains("Verified the permissions of principal "+testDevRoleArn+" in cluster my-cluster"))
+				return
+			}
+			require.Error(t, err)
+			for _, want := range tt.wantErr {
+				assert.Contains(t, err.Error(), want)
+			}
+		})
+	}
+}
+
+func TestValidateClusterAccess(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		authenticationMode string
+		accessEntries      map[string][]eksTypes.AssociatedAccessPolicy
+		awsAuthData        map[string]string
+		failedChecks       []string
+	}{
+		{
+			name:               "API cluster",
+			authenticationMode: "API",
+			accessEntries:      map[string][]eksTypes.AssociatedAccessPolicy{testDevRoleArn: nil},
+		},
+		{
+			name:               "API cluster without access entry",
+			authenticationMode: "API",
+			failedChecks:       []string{CheckAccessConfig},
+		},
+		{
+			name:               "CONFIG_MAP cluster",
+			authenticationMode: "CONFIG_MAP",
+			awsAuthData:        map[string]string{"mapRoles": "- rolearn: " + testDevRoleArn + "\n  username: dev\n  groups: [devs]\n"},
+		},
+		{
+			name:               "CONFIG_MAP cluster without role mapping",
+			authenticationMode: "CONFIG_MAP",
+			awsAuthData:        map[string]string{"mapRoles": "[]"},
+			failedChecks:       []string{CheckAccessConfig},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+			defer cancel()
+
+			objects := healthyCluster()
+			access := &clusterAccess{eksClient: newFakeEKS("my-cluster")}
+			if tt.awsAuthData != nil {
+				objects = append(objects, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "aws-auth"},
+					Data:       tt.awsAuthData,
+				})
+				access.awsAuth = &AwsAuth{RoleMappings: []AwsAuthMapping{{RoleArn: testDevRoleArn, Username: "dev", Groups: []string{"devs"}}}}
+			} else {
+				access.accessEntries = []AccessEntry{{PrincipalArn: testDevRoleArn}}
+			}
+			access.eksClient.(*fakeEKS).accessEntries = tt.accessEntries
+
+			clientset, dynamicClient := newFakeClients(objects...)
+			principalClientset, _ := newFakeClients()
+			simulateAuthorizer(principalClientset, viewDefaultNamespace)
+			access.principals = []principalClient{{
+				PrincipalAccess: PrincipalAccess{
+					PrincipalArn: testDevRoleArn,
+					Rules:        []AccessRule{{Namespace: "default", Resource: "pods", Verbs: []string{"get", "list", "watch"}}},
+				},
+				clientset: principalClientset,
+			}}
+
+			aws := newFakeAWS()
+			result := validateCluster(ctx, "my-cluster", tt.authenticationMode, clientset, dynamicClient, aws, aws, Capacity{}, nil, access, *DefaultOptions())
+
+			var checks, failedChecks []string
+			for _, check := range result.Checks {
+				checks = append(checks, check.Name)
+				if !check.Passed() {
+					failedChecks = append(failedChecks, check.Name)
+				}
+			}
+			assert.Subset(t, checks, []string{CheckAccessConfig, "rbac " + testDevRoleArn})
+			assert.Equal(t, tt.failedChecks, failedChecks)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	eksTypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// VpcCniValidation describes the expected configuration of the vpc-cni addon of a cluster.
+type VpcCniValidation struct {
+	Kubeconfig                interface{}
+	ExpectedAddonVersion      string
+	ClusterName               string
+	ExpectedInitEnvVars       map[string]string
+	ExpectedEnvVars           map[string]string
+	SecurityContextPrivileged *bool
+	NetworkPoliciesEnabled    *bool
+}
+
+// ValidateVpcCni validates that the aws-node daemonset of the vpc-cni addon is ready and configured as expected, and
+// that the expected version of the addon is installed. All deviations are returned as a single joined error.
+func ValidateVpcCni(ctx context.Context, log Logger, eksClient EKSAPI, args VpcCniValidation) error {
+	clientset, err := ClientsetFromKubeconfig(args.Kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	ds, err := WaitForDaemonSet(ctx, log, clientset, "kube-system", "aws-node")
+	if err != nil {
+		return err
+	}
+
+	errs := validateVpcCniDaemonSet(ds, args)
+
+	addon, err := GetInstalledAddon(ctx, eksClient, args.ClusterName, "vpc-cni")
+	if err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+
+	if args.ExpectedAddonVersion != *addon.AddonVersion {
+		errs = append(errs, fmt.Errorf("expected vpc-cni version to be %s, got %s", args.ExpectedAddonVersion, *addon.AddonVersion))
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateVpcCniDaemonSet(ds *appsv1.DaemonSet, args VpcCniValidation) []error {
+	var errs []error
+
+	var initContainerFound bool
+	for _, ic := range ds.Spec.Template.Spec.InitContainers {
+		if ic.Name != "aws-vpc-cni-init" {
+			continue
+		}
+
+		initContainerFound = true
+
+		errs = append(errs, validateEnvVariables(ic, args.ExpectedInitEnvVars)...)
+	}
+	if !initContainerFound {
+		errs = append(errs, fmt.Errorf("expected init container aws-vpc-cni-init to exist"))
+	}
+
+	var awsNodeContainerFound bool
+	var awsNodeAgentContainerFound bool
+	for _, c := range ds.Spec.Template.Spec.Containers {
+		switch c.Name {
+		case "aws-node":
+			awsNodeContainerFound = true
+			errs = append(errs, validateEnvVariables(c, args.ExpectedEnvVars)...)
+			if args.SecurityContextPrivileged != nil {
+				if c.SecurityContext == nil || c.SecurityContext.Privileged == nil {
+					errs = append(errs, fmt.Errorf("expected SecurityContext.Privileged of aws-node container to be set"))
+				} else if *args.SecurityContextPrivileged != *c.SecurityContext.Privileged {
+					errs = append(errs, fmt.Errorf("expected privileged security context for aws-node container to be %t", *args.SecurityContextPrivileged))
+				}
+			}
+		case "aws-eks-nodeagent":
+			awsNodeAgentContainerFound = true
+			if args.NetworkPoliciesEnabled != nil {
+				arg := fmt.Sprintf("--enable-network-policy=%t", *args.NetworkPoliciesEnabled)
+				if !slices.Contains(c.Args, arg) {
+					errs = append(errs, fmt.Errorf("expected %s to be set for aws-eks-nodeagent container", arg))
+				}
+			}
+		}
+	}
+	if !awsNodeContainerFound {
+		errs = append(errs, fmt.Errorf("expected container aws-node to exist"))
+	}
+	if !awsNodeAgentContainerFound {
+		errs = append(errs, fmt.Errorf("expected container aws-eks-nodeagent to exist"))
+	}
+
+	return errs
+}
+
+func validateEnvVariables(container corev1.Container, expectedEnvVars map[string]string) []error {
+	if len(expectedEnvVars) == 0 {
+		return nil
+	}
+
+	var errs []error
+	setEnvVars := make(map[string]bool)
+	for name := range expectedEnvVars {
+		setEnvVars[name] = false
+	}
+
+	for _, env := range container.Env {
+		if _, ok := expectedEnvVars[env.Name]; ok {
+			setEnvVars[env.Name] = true
+			if expectedEnvVars[env.Name] != env.Value {
+				errs = append(errs, fmt.Errorf("expected env %s of container %s to be %s, got %s", env.Name, container.Name, expectedEnvVars[env.Name], env.Value))
+			}
+		}
+	}
+
+	for env, set := range setEnvVars {
+		if !set {
+			errs = append(errs, fmt.Errorf("expected env %s of container %s to be set", env, container.Name))
+		}
+	}
+
+	return errs
+}
+
+// GetInstalledAddon returns the addon with the given name that is installed in a cluster.
+func GetInstalledAddon(ctx context.Context, eksClient EKSAPI, clusterName, addonName string) (*eksTypes.Addon, error) {
+	resp, err := eksClient.DescribeAddon(ctx, &eks.DescribeAddonInput{
+		ClusterName: aws.String(clusterName),
+		AddonName:   aws.String(addonName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Addon, nil
+}
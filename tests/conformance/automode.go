@@ -0,0 +1,161 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	// computeTypeLabel is set by EKS on nodes that are not part of a node group. It is "auto" for EKS Auto Mode
+	// nodes and "fargate" for Fargate nodes.
+	computeTypeLabel = "eks.amazonaws.com/compute-type"
+	// fargateProfileLabel is set by EKS on Fargate nodes to the name of the Fargate profile they were scheduled by.
+	fargateProfileLabel = "eks.amazonaws.com/fargate-profile"
+	// nodePoolLabel is set by EKS Auto Mode on NodeClaims and nodes to the name of their node pool.
+	nodePoolLabel = "karpenter.sh/nodepool"
+)
+
+// nodeClaimsResource is the resource of the NodeClaims that EKS Auto Mode creates for every node it launches.
+var nodeClaimsResource = schema.GroupVersionResource{Group: "karpenter.sh", Version: "v1", Resource: "nodeclaims"}
+
+// nodeClaim holds the parts of a NodeClaim that are relevant for the health checks.
+type nodeClaim struct {
+	name       string
+	nodePool   string
+	nodeName   string
+	instanceID string
+	// ready is the Ready condition of the NodeClaim, nil if it hasn't been reported yet.
+	ready *metav1.Condition
+}
+
+func parseNodeClaim(obj unstructured.Unstructured) nodeClaim {
+	claim := nodeClaim{
+		name:     obj.GetName(),
+		nodePool: obj.GetLabels()[nodePoolLabel],
+	}
+	claim.nodeName, _, _ = unstructured.NestedString(obj.Object, "status", "nodeName")
+
+	// Provider ID format: aws:///az/i-1234567890abcdef0
+	providerID, _, _ := unstructured.NestedString(obj.Object, "status", "providerID")
+	claim.instanceID = providerID[strings.LastIndex(providerID, "/")+1:]
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != "Ready" {
+			continue
+		}
+		status, _ := condition["status"].(string)
+		reason, _ := condition["reason"].(string)
+		message, _ := condition["message"].(string)
+		claim.ready = &metav1.Condition{
+			Type:    "Ready",
+			Status:  metav1.ConditionStatus(status),
+			Reason:  reason,
+			Message: message,
+		}
+	}
+
+	return claim
+}
+
+// validateAutoModeNodes validates that every NodeClaim of EKS Auto Mode is ready and backed by a node that joined the
+// cluster, and that every Auto Mode node is claimed by a NodeClaim. It returns the IDs of the EC2 instances of the
+// NodeClaims, mapped to the names of their NodeClaims.
+func validateAutoModeNodes(ctx context.Context, log Logger, dynamicClient dynamic.Interface, clusterName string, nodes *corev1.NodeList) (map[string]string, error) {
+	list, err := dynamicClient.Resource(nodeClaimsResource).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list NodeClaims of cluster %s: %v", clusterName, err)
+	}
+
+	nodeNames := make(map[string]bool)
+	for _, node := range nodes.Items {
+		nodeNames[node.Name] = true
+	}
+
+	claimedNodes := make(map[string]bool)
+	instanceIDToClaim := make(map[string]string)
+	nodePoolCounts := make(map[string]int)
+	for _, obj := range list.Items {
+		claim := parseNodeClaim(obj)
+		if claim.ready == nil {
+			return nil, fmt.Errorf("NodeClaim %s of cluster %s has not reported a Ready condition", claim.name, clusterName)
+		}
+		if claim.ready.Status != metav1.ConditionTrue {
+			return nil, fmt.Errorf("NodeClaim %s of cluster %s is not ready: %s %s", claim.name, clusterName, claim.ready.Reason, claim.ready.Message)
+		}
+		if claim.nodeName == "" || !nodeNames[claim.nodeName] {
+			return nil, fmt.Errorf("node %q of NodeClaim %s has not joined cluster %s", claim.nodeName, claim.name, clusterName)
+		}
+
+		claimedNodes[claim.nodeName] = true
+		nodePoolCounts[claim.nodePool]++
+		if eC2InstanceIDPattern.MatchString(claim.instanceID) {
+			instanceIDToClaim[claim.instanceID] = claim.name
+		}
+	}
+
+	for _, node := range nodes.Items {
+		if node.Labels[computeTypeLabel] == "auto" && !claimedNodes[node.Name] {
+			return nil, fmt.Errorf("Auto Mode node %s of cluster %s is not claimed by any NodeClaim", node.Name, clusterName)
+		}
+	}
+
+	nodePools := make([]string, 0, len(nodePoolCounts))
+	for nodePool := range nodePoolCounts {
+		nodePools = append(nodePools, nodePool)
+	}
+	sort.Strings(nodePools)
+	for _, nodePool := range nodePools {
+		log.Logf("Node pool %s in cluster %s has %d ready NodeClaims", nodePool, clusterName, nodePoolCounts[nodePool])
+	}
+
+	return instanceIDToClaim, nil
+}
+
+// validateFargateNodes validates that all Fargate nodes of a cluster were scheduled by one of its Fargate profiles.
+func validateFargateNodes(log Logger, clusterName string, nodes *corev1.NodeList, fargateProfiles []string) error {
+	profileCounts := make(map[string]int, len(fargateProfiles))
+	for _, profile := range fargateProfiles {
+		profileCounts[profile] = 0
+	}
+
+	for _, node := range nodes.Items {
+		if node.Labels[computeTypeLabel] != "fargate" {
+			continue
+		}
+		profile := node.Labels[fargateProfileLabel]
+		if _, ok := profileCounts[profile]; !ok {
+			return fmt.Errorf("Fargate node %s of cluster %s was scheduled by unknown Fargate profile %q", node.Name, clusterName, profile)
+		}
+		profileCounts[profile]++
+	}
+
+	for _, profile := range fargateProfiles {
+		log.Logf("Fargate profile %s in cluster %s has %d nodes", profile, clusterName, profileCounts[profile])
+	}
+
+	return nil
+}
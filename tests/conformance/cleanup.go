@@ -0,0 +1,50 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"time"
+)
+
+// cleanups deletes the resources that a check created, in the reverse order of their creation.
+type cleanups struct {
+	// description describes what is cleaned up in log messages, e.g. "probe pods of cluster my-cluster".
+	description string
+	timeout     time.Duration
+	funcs       []func(ctx context.Context) error
+}
+
+func newCleanups(description string, timeout time.Duration) *cleanups {
+	return &cleanups{description: description, timeout: timeout}
+}
+
+// add registers a function that deletes a resource created by the check.
+func (c *cleanups) add(cleanup func(ctx context.Context) error) {
+	c.funcs = append(c.funcs, cleanup)
+}
+
+// run runs the registered functions and logs their errors. Checks defer it right after creating the cleanups. The
+// functions get a context that is detached from ctx, so the resources are cleaned up even if ctx is done, e.g.
+// because the checks timed out.
+func (c *cleanups) run(ctx context.Context, log Logger) {
+	cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.timeout)
+	defer cancel()
+	for i := len(c.funcs) - 1; i >= 0; i-- {
+		if err := c.funcs[i](cleanupCtx); err != nil {
+			log.Logf("Failed to clean up %s: %v", c.description, err)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCleanups(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	log := &recordingLogger{}
+	cleanups := newCleanups("validation of cluster my-cluster", time.Minute)
+
+	var order []string
+	cleanups.add(func(ctx context.Context) error {
+		order = append(order, "namespace")
+		return ctx.Err()
+	})
+	cleanups.add(func(ctx context.Context) error {
+		order = append(order, "pod")
+		return errors.New("pod not found")
+	})
+
+	// The checks timed out.
+	cancel()
+	cleanups.run(ctx, log)
+
+	assert.Equal(t, []string{"pod", "namespace"}, order)
+	assert.True(t, log.contains("Failed to clean up validation of cluster my-cluster: pod not found"))
+	assert.Len(t, log.messages, 1, "the cleanup context must not be done")
+}
@@ -0,0 +1,576 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conformance implements the health checks that the integration tests of pulumi-eks run against the EKS
+// clusters they deploy. The checks only depend on the state of a stack and the kubeconfigs of its clusters, so they
+// can also be run after a `pulumi up` outside of a Go test, e.g. as a post-deployment check of a pipeline.
+//
+// Progress is reported through a Logger instead of a *testing.T, and the outcome of the checks is returned as a
+// structured Result.
+//
+// The package is released as its own Go module together with the provider, tagged as tests/conformance/vX.Y.Z, so a
+// version matching the provider can be added with:
+//
+//	go get github.com/pulumi/pulumi-eks/tests/conformance/v4@v4.X.Y
+//
+// and imported as github.com/pulumi/pulumi-eks/tests/conformance/v4.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Logger receives the progress messages of the health checks. *testing.T implements Logger.
+type Logger interface {
+	Logf(format string, args ...any)
+}
+
+// nopLogger discards all messages.
+type nopLogger struct{}
+
+func (nopLogger) Logf(string, ...any) {}
+
+// The names of the health checks that are performed for every cluster. The checks of workloads are named after the
+// kind and the namespaced name of the workload, e.g. "deployment kube-system/coredns". The checks of StorageClasses
+// are named after their keys in the `storageClasses` of the cluster, e.g. "storageclass gp2", and the checks of the
+// permissions of principals after their ARNs, e.g. "rbac arn:aws:iam::123456789012:role/dev".
+const (
+	CheckAPIServer          = "api-server"
+	CheckNodeGroups         = "node-groups"
+	CheckAuthenticationMode = "authentication-mode"
+	// CheckConnectivity is only performed if the connectivity probe is enabled with ProbeConnectivity.
+	CheckConnectivity = "connectivity"
+	// CheckAccessConfig is only performed if the access validation is enabled with ValidateAccess.
+	CheckAccessConfig = "access-config"
+)
+
+// CheckResult is the outcome of a single health check of a cluster.
+type CheckResult struct {
+	// Name identifies the check, see CheckAPIServer, CheckNodeGroups, CheckAuthenticationMode, CheckConnectivity and
+	// CheckAccessConfig.
+	Name string
+	// Err is the reason the check failed. It is nil if the check passed.
+	Err error
+	// Duration is the time it took until the check passed or gave up, including retries.
+	Duration time.Duration
+}
+
+// Passed returns true if the check passed.
+func (c CheckResult) Passed() bool {
+	return c.Err == nil
+}
+
+// ClusterResult holds the outcome of the health checks of a cluster.
+type ClusterResult struct {
+	// ClusterName is the name of the EKS cluster.
+	ClusterName string
+	// AuthenticationMode is the authentication mode of the cluster, e.g. "API_AND_CONFIG_MAP".
+	AuthenticationMode string
+	// ServerVersion is the git version of the API server. It is empty if the API server wasn't reachable.
+	ServerVersion string
+	// NodeGroups reports how the instances of the node groups match the nodes of the cluster, sorted by the names
+	// of their Auto Scaling Groups. It is empty if the nodes or instances of the cluster are unhealthy.
+	NodeGroups []NodeGroupResult
+	// Workloads reports the readiness of the validated workloads.
+	Workloads []WorkloadResult
+	// Checks are the results of the checks in the order they were started.
+	Checks []CheckResult
+}
+
+// Healthy returns true if all checks of the cluster passed.
+func (c ClusterResult) Healthy() bool {
+	return c.Err() == nil
+}
+
+// Err returns the failures of the checks of the cluster joined into a single error, or nil if all checks passed.
+func (c ClusterResult) Err() error {
+	var errs []error
+	for _, check := range c.Checks {
+		if !check.Passed() {
+			errs = append(errs, check.Err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("cluster %s is unhealthy: %w", c.ClusterName, err)
+	}
+	return nil
+}
+
+// Result holds the outcome of the health checks of all validated clusters.
+type Result struct {
+	// Clusters are the results of the individual clusters, sorted by cluster name.
+	Clusters []ClusterResult
+}
+
+// Healthy returns true if all clusters are healthy.
+func (r *Result) Healthy() bool {
+	return r.Err() == nil
+}
+
+// Err returns the failures of all clusters joined into a single error, or nil if all clusters are healthy.
+func (r *Result) Err() error {
+	var errs []error
+	for _, cluster := range r.Clusters {
+		if err := cluster.Err(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// KubernetesResource identifies a namespaced Kubernetes resource.
+type KubernetesResource struct {
+	Namespace string
+	Name      string
+}
+
+func (r KubernetesResource) String() string {
+	return r.Namespace + "/" + r.Name
+}
+
+// Options configure ValidateClusters.
+type Options struct {
+	kubeConfigs []interface{}
+	workloads   []Workload
+	// probeConnectivity enables the connectivity probe. If probeTargets is empty, the nodes of the node groups are
+	// probed.
+	probeConnectivity bool
+	probeTargets      []ProbeTarget
+	probeImage        string
+	// validateStorageClasses enables the validation of the StorageClasses of the clusters. If storageClassKeys is not
+	// empty, only the StorageClasses with these keys are validated.
+	validateStorageClasses bool
+	storageClassKeys       []string
+	// validateAccess enables the validation of the access entries and aws-auth mappings of the clusters, and of the
+	// permissions of principals.
+	validateAccess bool
+	principals     []PrincipalAccess
+	timeout        time.Duration
+	logger         Logger
+	awsConfig      *aws.Config
+}
+
+type Option interface {
+	Apply(options *Options)
+}
+
+type optionFunc func(*Options)
+
+func (o optionFunc) Apply(opts *Options) {
+	o(opts)
+}
+
+// WithKubeConfigs adds the kubeconfigs of the clusters to validate. The kubeconfigs can either be passed as strings
+// or as the objects of the `kubeconfig` outputs of the clusters.
+func WithKubeConfigs(kubeConfigs ...interface{}) Option {
+	return optionFunc(func(o *Options) {
+		o.kubeConfigs = append(o.kubeConfigs, kubeConfigs...)
+	})
+}
+
+// ValidateWorkloads adds workloads whose readiness is validated in addition to the defaults: the coredns deployment
+// and the aws-node and kube-proxy daemonsets.
+func ValidateWorkloads(workloads ...Workload) Option {
+	return optionFunc(func(o *Options) {
+		o.workloads = append(o.workloads, workloads...)
+	})
+}
+
+// ReplaceWorkloads replaces the workloads whose readiness is validated, including the defaults. This is needed for
+// clusters that don't run the default workloads, e.g. EKS Auto Mode clusters don't run aws-node and kube-proxy.
+func ReplaceWorkloads(workloads ...Workload) Option {
+	return optionFunc(func(o *Options) {
+		o.workloads = append([]Workload(nil), workloads...)
+	})
+}
+
+// ValidateDeployments replaces the deployments whose readiness is validated, including the default coredns
+// deployment.
+func ValidateDeployments(deployments ...KubernetesResource) Option {
+	return optionFunc(func(o *Options) {
+		o.workloads = replaceWorkloadsOfKind(o.workloads, "deployment", deployments, func(r KubernetesResource) Workload {
+			return DeploymentWorkload(r.Namespace, r.Name)
+		})
+	})
+}
+
+// ValidateDaemonSets replaces the daemonsets whose readiness is validated, including the default aws-node and
+// kube-proxy daemonsets.
+func ValidateDaemonSets(daemonsets ...KubernetesResource) Option {
+	return optionFunc(func(o *Options) {
+		o.workloads = replaceWorkloadsOfKind(o.workloads, "daemonset", daemonsets, func(r KubernetesResource) Workload {
+			return DaemonSetWorkload(r.Namespace, r.Name)
+		})
+	})
+}
+
+func replaceWorkloadsOfKind(workloads []Workload, kind string, resources []KubernetesResource, newWorkload func(KubernetesResource) Workload) []Workload {
+	var replaced []Workload
+	for _, workload := range workloads {
+		if workload.Kind != kind {
+			replaced = append(replaced, workload)
+		}
+	}
+	for _, resource := range resources {
+		replaced = append(replaced, newWorkload(resource))
+	}
+	return replaced
+}
+
+// ProbeConnectivity enables the data plane connectivity probe, see ValidateConnectivity. The probe pods are scheduled
+// on the given targets, or on the nodes of the node groups if no targets are given. The probe runs after the other
+// checks, because it needs healthy nodes.
+func ProbeConnectivity(targets ...ProbeTarget) Option {
+	return optionFunc(func(o *Options) {
+		o.probeConnectivity = true
+		o.probeTargets = append(o.probeTargets, targets...)
+	})
+}
+
+// WithProbeImage sets the image of the pods of the connectivity probe. Defaults to DefaultProbeImage.
+func WithProbeImage(image string) Option {
+	return optionFunc(func(o *Options) {
+		o.probeImage = image
+	})
+}
+
+// ValidateStorageClasses enables the validation of the StorageClasses that the clusters created for their
+// `storageClasses`, see ValidateStorageClass. If keys are given, only the StorageClasses with these keys are
+// validated. The StorageClasses provision their volumes with the EBS CSI driver, which has to be installed.
+func ValidateStorageClasses(keys ...string) Option {
+	return optionFunc(func(o *Options) {
+		o.validateStorageClasses = true
+		o.storageClassKeys = append(o.storageClassKeys, keys...)
+	})
+}
+
+// ValidateAccess enables the validation of the access configuration of the clusters: the access entries and their
+// access policies are compared with the ones of the stack, see ValidateAccessEntries, and the role and user mappings
+// of the aws-auth ConfigMap with the ones of the stack, see ValidateAwsAuthMappings. The permissions of the given
+// principals are validated with their kubeconfigs, see ValidatePrincipalAccess.
+func ValidateAccess(principals ...PrincipalAccess) Option {
+	return optionFunc(func(o *Options) {
+		o.validateAccess = true
+		o.principals = append(o.principals, principals...)
+	})
+}
+
+// WithTimeout sets the time after which the health checks give up. Defaults to 5 minutes.
+func WithTimeout(timeout time.Duration) Option {
+	return optionFunc(func(o *Options) {
+		o.timeout = timeout
+	})
+}
+
+// WithLogger sets the Logger that receives the progress messages of the health checks. By default the messages are
+// discarded.
+func WithLogger(logger Logger) Option {
+	return optionFunc(func(o *Options) {
+		o.logger = logger
+	})
+}
+
+// WithAWSConfig sets the AWS configuration used to look up the instances of the node groups. Defaults to the
+// configuration returned by LoadAWSConfig.
+func WithAWSConfig(cfg aws.Config) Option {
+	return optionFunc(func(o *Options) {
+		o.awsConfig = &cfg
+	})
+}
+
+func DefaultOptions() *Options {
+	return &Options{
+		timeout: 5 * time.Minute,
+		workloads: []Workload{
+			DeploymentWorkload("kube-system", "coredns"),
+			DaemonSetWorkload("kube-system", "aws-node"),
+			DaemonSetWorkload("kube-system", "kube-proxy"),
+		},
+		logger: nopLogger{},
+	}
+}
+
+// ValidateClusters validates the health of the clusters specified in the options.
+// It performs a series of health checks on every EKS cluster to ensure it is functioning correctly.
+// It verifies API server connectivity, validates node group instances are healthy and properly joined,
+// checks authentication configuration, and validates that the specified workloads are ready.
+// If enabled with ProbeConnectivity, it also probes the connectivity of the data plane. ValidateStorageClasses and
+// ValidateAccess enable the validation of the StorageClasses and of the access configuration of the clusters.
+// Any failures are automatically retried with exponential backoff.
+//
+// The returned error is only set if the clusters couldn't be validated at all, e.g. because of an invalid
+// kubeconfig. The outcome of the health checks is reported in the Result.
+func ValidateClusters(ctx context.Context, resources []apitype.ResourceV3, options ...Option) (*Result, error) {
+	opts := DefaultOptions()
+	for _, option := range options {
+		option.Apply(opts)
+	}
+
+	if len(opts.kubeConfigs) == 0 {
+		return nil, fmt.Errorf("no kubeconfigs provided")
+	}
+
+	if opts.awsConfig == nil {
+		awsConfig, err := LoadAWSConfig(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load AWS config: %v", err)
+		}
+		opts.awsConfig = &awsConfig
+	}
+	// Create AWS clients
+	asgClient := autoscaling.NewFromConfig(*opts.awsConfig)
+	ec2Client := ec2.NewFromConfig(*opts.awsConfig)
+	eksClient := eks.NewFromConfig(*opts.awsConfig)
+
+	// set up the clientsets for each cluster
+	clusterKubeAccess, err := mapClusterToKubeAccess(opts.kubeConfigs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map kubeconfigs to clusters: %w", err)
+	}
+
+	// look up the configured node groups, Auto Mode and Fargate profiles for each cluster
+	clusterCapacity, err := mapClusterToCapacity(resources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map resources to node groups: %w", err)
+	}
+
+	// look up the authentication mode for each cluster
+	clusterAuthenticationMode, err := mapClusterToAuthenticationMode(resources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map resources to authentication mode: %w", err)
+	}
+
+	// look up the StorageClasses to validate for each cluster
+	var clusterStorageClasses clusterStorageClassMap
+	if opts.validateStorageClasses {
+		clusterStorageClasses, err = mapClusterToStorageClasses(resources)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map resources to storage classes: %w", err)
+		}
+		if clusterStorageClasses, err = filterStorageClasses(clusterStorageClasses, opts.storageClassKeys); err != nil {
+			return nil, err
+		}
+	}
+
+	// look up the access configuration and the principals to validate for each cluster
+	var clusterAccess map[string]*clusterAccess
+	if opts.validateAccess {
+		clusterAccess, err = mapClusterToAccess(resources, clusterKubeAccess, eksClient, opts.principals)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, opts.timeout)
+	defer cancel()
+
+	clusterNames := make([]string, 0, len(clusterKubeAccess))
+	for clusterName := range clusterKubeAccess {
+		clusterNames = append(clusterNames, clusterName)
+	}
+	sort.Strings(clusterNames)
+
+	var wg sync.WaitGroup
+	result := &Result{Clusters: make([]ClusterResult, len(clusterNames))}
+	for i, clusterName := range clusterNames {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			opts.logger.Logf("Validating Cluster: %s", clusterName)
+			authenticationMode := clusterAuthenticationMode[clusterName]
+			opts.logger.Logf("Detected authentication mode %q for Cluster %s", authenticationMode, clusterName)
+
+			var capacity Capacity
+			if c, ok := clusterCapacity[clusterName]; ok {
+				capacity = *c
+			}
+
+			kubeAccess := clusterKubeAccess[clusterName]
+			result.Clusters[i] = validateCluster(ctx, clusterName, authenticationMode, kubeAccess.Clientset, kubeAccess.DynamicClient, asgClient, ec2Client, capacity, clusterStorageClasses[clusterName], clusterAccess[clusterName], *opts)
+		}()
+	}
+	wg.Wait()
+
+	return result, nil
+}
+
+// validateCluster performs a series of health checks on an EKS cluster to ensure it is functioning correctly.
+// It verifies API server connectivity, validates node group instances are healthy and properly joined,
+// checks authentication configuration, and validates that the specified workloads are ready.
+// The validation is performed concurrently using goroutines and retries failed checks with exponential backoff.
+func validateCluster(ctx context.Context, clusterName string, authenticationMode string, clientset kubernetes.Interface, dynamicClient dynamic.Interface, asgClient AutoScalingAPI, ec2Client EC2API, expectedCapacity Capacity, storageClasses []StorageClass, access *clusterAccess, opts Options) ClusterResult {
+	log := opts.logger
+	result := ClusterResult{
+		ClusterName:        clusterName,
+		AuthenticationMode: authenticationMode,
+	}
+
+	// first, make sure the API server is reachable. None of the other checks
+	// will work if the API server is not reachable.
+	start := time.Now()
+	err := RetryWithExponentialBackoff(ctx, 10*time.Millisecond, func() error {
+		version, err := clientset.Discovery().ServerVersion()
+		if err != nil {
+			return fmt.Errorf("failed to connect to API Server: %v", err)
+		}
+		log.Logf("Successfully connected to API Server of cluster %s\n\tVersion: %s.%s\n\tGitVersion: %s\n", clusterName, version.Major, version.Minor, version.GitVersion)
+		result.ServerVersion = version.GitVersion
+		return nil
+	})
+	result.Checks = append(result.Checks, CheckResult{Name: CheckAPIServer, Err: err, Duration: time.Since(start)})
+	if err != nil {
+		return result
+	}
+
+	type check struct {
+		name string
+		run  func() error
+	}
+
+	checks := []check{
+		{
+			name: CheckNodeGroups,
+			run: func() error {
+				nodeGroups, err := validateNodeGroupInstances(ctx, log, clientset, dynamicClient, asgClient, ec2Client, clusterName, expectedCapacity)
+				result.NodeGroups = nodeGroups
+				return err
+			},
+		},
+		{
+			name: CheckAuthenticationMode,
+			run: func() error {
+				if authenticationMode == "CONFIG_MAP" || authenticationMode == "API_AND_CONFIG_MAP" {
+					return assertAwsAuthConfigMapExists(ctx, log, clientset, clusterName)
+				} else {
+					return assertAwsAuthConfigMapNotExists(ctx, log, clientset, clusterName)
+				}
+			},
+		},
+	}
+
+	result.Workloads = make([]WorkloadResult, len(opts.workloads))
+	for i, workload := range opts.workloads {
+		checks = append(checks, check{
+			name: workload.String(),
+			run: func() error {
+				workloadResult := WorkloadResult{
+					Kind:      workload.Kind,
+					Namespace: workload.Resource.Namespace,
+					Name:      workload.Resource.Name,
+				}
+				defer func() { result.Workloads[i] = workloadResult }()
+
+				labelSelector, err := workload.check(ctx, clientset)
+				workloadResult.Ready = err == nil
+				if err != nil {
+					log.Logf("Detected unhealthy %s %q in namespace %q of cluster %s: %v", workload.Kind, workload.Resource.Name, workload.Resource.Namespace, clusterName, err)
+					if labelSelector != "" {
+						pods, podsErr := logUnhealthyPodInfo(ctx, log, clientset, workload.Resource.Namespace, labelSelector, clusterName)
+						if podsErr != nil {
+							log.Logf("%v", podsErr)
+						}
+						workloadResult.UnhealthyPods = pods
+					}
+				} else {
+					log.Logf("Detected healthy %s %q in namespace %q of cluster %s", workload.Kind, workload.Resource.Name, workload.Resource.Namespace, clusterName)
+				}
+				return err
+			},
+		})
+	}
+
+	for _, storageClass := range storageClasses {
+		checks = append(checks, check{
+			name: storageCheckName(storageClass),
+			run: func() error {
+				return ValidateStorageClass(ctx, log, clientset, ec2Client, clusterName, storageClass)
+			},
+		})
+	}
+
+	if access != nil {
+		checks = append(checks, check{
+			name: CheckAccessConfig,
+			run: func() error {
+				var errs []error
+				if authenticationMode == "API" || authenticationMode == "API_AND_CONFIG_MAP" {
+					errs = append(errs, ValidateAccessEntries(ctx, log, access.eksClient, clusterName, access.accessEntries))
+				}
+				if access.awsAuth != nil {
+					errs = append(errs, ValidateAwsAuthMappings(ctx, log, clientset, clusterName, *access.awsAuth))
+				}
+				return errors.Join(errs...)
+			},
+		})
+		for _, principal := range access.principals {
+			checks = append(checks, check{
+				name: principalCheckName(principal.PrincipalAccess),
+				run: func() error {
+					return ValidatePrincipalAccess(ctx, log, principal.clientset, clusterName, principal.PrincipalAccess)
+				},
+			})
+		}
+	}
+
+	var wg sync.WaitGroup
+	checkResults := make([]CheckResult, len(checks))
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			start := time.Now()
+			err := RetryWithExponentialBackoff(ctx, 2*time.Second, check.run)
+			checkResults[i] = CheckResult{
+				Name:     check.name,
+				Err:      err,
+				Duration: time.Since(start),
+			}
+		}()
+	}
+	wg.Wait()
+
+	result.Checks = append(result.Checks, checkResults...)
+
+	if opts.probeConnectivity {
+		targets := opts.probeTargets
+		if len(targets) == 0 {
+			targets = probeTargetsFromNodeGroups(result.NodeGroups)
+		}
+
+		start := time.Now()
+		err := RetryWithExponentialBackoff(ctx, 2*time.Second, func() error {
+			return ValidateConnectivity(ctx, log, clientset, ConnectivityValidation{
+				ClusterName: clusterName,
+				Image:       opts.probeImage,
+				Targets:     targets,
+			})
+		})
+		result.Checks = append(result.Checks, CheckResult{Name: CheckConnectivity, Err: err, Duration: time.Since(start)})
+	}
+
+	return result
+}
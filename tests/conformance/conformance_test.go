@@ -0,0 +1,231 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func healthyDeployment(namespace, name string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}},
+		},
+		Status: appsv1.DeploymentStatus{
+			Replicas:          2,
+			ReadyReplicas:     2,
+			UpdatedReplicas:   2,
+			AvailableReplicas: 2,
+		},
+	}
+}
+
+func healthyDaemonSet(namespace, name string) *appsv1.DaemonSet {
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}},
+		},
+		Status: appsv1.DaemonSetStatus{
+			DesiredNumberScheduled: 1,
+			NumberReady:            1,
+			UpdatedNumberScheduled: 1,
+			NumberAvailable:        1,
+		},
+	}
+}
+
+func awsAuthConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "aws-auth"},
+		Data:       map[string]string{"mapRoles": "[]"},
+	}
+}
+
+// healthyCluster returns the objects of a cluster that passes the default checks, without its aws-auth ConfigMap.
+func healthyCluster() []runtime.Object {
+	return []runtime.Object{
+		healthyDeployment("kube-system", "coredns"),
+		healthyDaemonSet("kube-system", "aws-node"),
+		healthyDaemonSet("kube-system", "kube-proxy"),
+	}
+}
+
+func TestValidateCluster(t *testing.T) {
+	t.Parallel()
+
+	unhealthyCoreDNS := healthyDeployment("kube-system", "coredns")
+	unhealthyCoreDNS.Status.ReadyReplicas = 1
+	crashingPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "kube-system",
+			Name:      "coredns-1",
+			Labels:    map[string]string{"app": "coredns"},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:         "coredns",
+					RestartCount: 5,
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		authenticationMode string
+		objects            []runtime.Object
+		failedChecks       []string
+		logged             []string
+	}{
+		{
+			name:               "healthy CONFIG_MAP cluster",
+			authenticationMode: "CONFIG_MAP",
+			objects:            append(healthyCluster(), awsAuthConfigMap()),
+		},
+		{
+			name:               "healthy API cluster",
+			authenticationMode: "API",
+			objects:            healthyCluster(),
+		},
+		{
+			name:               "API_AND_CONFIG_MAP cluster without aws-auth ConfigMap",
+			authenticationMode: "API_AND_CONFIG_MAP",
+			objects:            healthyCluster(),
+			failedChecks:       []string{CheckAuthenticationMode},
+		},
+		{
+			name:               "API cluster with aws-auth ConfigMap",
+			authenticationMode: "API",
+			objects:            append(healthyCluster(), awsAuthConfigMap()),
+			failedChecks:       []string{CheckAuthenticationMode},
+		},
+		{
+			name:               "unhealthy deployment",
+			authenticationMode: "API",
+			objects: []runtime.Object{
+				unhealthyCoreDNS,
+				crashingPod,
+				healthyDaemonSet("kube-system", "aws-node"),
+				healthyDaemonSet("kube-system", "kube-proxy"),
+			},
+			failedChecks: []string{"deployment kube-system/coredns"},
+			logged: []string{
+				"Pod kube-system/coredns-1 of cluster my-cluster:",
+				"Waiting - Reason: CrashLoopBackOff",
+			},
+		},
+		{
+			name:               "missing daemonset",
+			authenticationMode: "API",
+			objects: []runtime.Object{
+				healthyDeployment("kube-system", "coredns"),
+				healthyDaemonSet("kube-system", "aws-node"),
+			},
+			failedChecks: []string{"daemonset kube-system/kube-proxy"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Failing checks are retried until the context is done.
+			ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+			defer cancel()
+
+			log := &recordingLogger{}
+			opts := DefaultOptions()
+			opts.logger = log
+
+			aws := newFakeAWS()
+			clientset, dynamicClient := newFakeClients(tt.objects...)
+			result := validateCluster(ctx, "my-cluster", tt.authenticationMode, clientset, dynamicClient, aws, aws, Capacity{}, nil, nil, *opts)
+
+			assert.Equal(t, "my-cluster", result.ClusterName)
+			assert.Equal(t, tt.authenticationMode, result.AuthenticationMode)
+			assert.Equal(t, "v1.33.1-eks-1", result.ServerVersion)
+
+			var checks, failedChecks []string
+			for _, check := range result.Checks {
+				checks = append(checks, check.Name)
+				if !check.Passed() {
+					failedChecks = append(failedChecks, check.Name)
+				}
+			}
+			assert.Equal(t, []string{
+				CheckAPIServer,
+				CheckNodeGroups,
+				CheckAuthenticationMode,
+				"deployment kube-system/coredns",
+				"daemonset kube-system/aws-node",
+				"daemonset kube-system/kube-proxy",
+			}, checks)
+			assert.Equal(t, tt.failedChecks, failedChecks)
+			assert.Equal(t, len(tt.failedChecks) == 0, result.Healthy())
+
+			require.Len(t, result.Workloads, 3)
+			for _, workload := range result.Workloads {
+				assert.Equal(t, !slices.Contains(tt.failedChecks, workload.String()), workload.Ready, workload.String())
+			}
+
+			for _, message := range tt.logged {
+				assert.True(t, log.contains(message), "expected %q to be logged", message)
+			}
+		})
+	}
+}
+
+func TestResultErr(t *testing.T) {
+	t.Parallel()
+
+	result := &Result{
+		Clusters: []ClusterResult{
+			{
+				ClusterName: "healthy",
+				Checks:      []CheckResult{{Name: CheckAPIServer}},
+			},
+			{
+				ClusterName: "unhealthy",
+				Checks: []CheckResult{
+					{Name: CheckAPIServer},
+					{Name: CheckNodeGroups, Err: assert.AnError},
+				},
+			},
+		},
+	}
+
+	assert.True(t, result.Clusters[0].Healthy())
+	assert.False(t, result.Healthy())
+	require.Error(t, result.Err())
+	assert.ErrorIs(t, result.Err(), assert.AnError)
+	assert.Contains(t, result.Err().Error(), "cluster unhealthy is unhealthy")
+}
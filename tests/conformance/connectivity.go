@@ -0,0 +1,303 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DefaultProbeImage is the image of the probe pods. The Kubernetes e2e test image ships the agnhost binary, which
+// serves HTTP and checks TCP connectivity, and nslookup.
+const DefaultProbeImage = "registry.k8s.io/e2e-test-images/agnhost:2.47"
+
+const (
+	// probeServerPort is the port the probe servers listen on.
+	probeServerPort = 8080
+	// probeServiceName is the name of the Service in front of the probe servers.
+	probeServiceName = "probe"
+	// probeTargetLabel is the label of the probe pods that holds the index of their target.
+	probeTargetLabel = "conformance.pulumi.com/probe-target"
+	// probeRoleLabel is the label of the probe pods that is "server" for the probe servers and "client" for the
+	// pods running the probes.
+	probeRoleLabel = "conformance.pulumi.com/probe-role"
+)
+
+// probeScript runs the probes of a client pod. Every failed probe is written to the termination log, so the failures
+// can be read from the status of the pod. The script exits with a non-zero code if any probe failed.
+const probeScript = `failed=0
+probe() {
+  name="$1"
+  shift
+  if output=$("$@" 2>&1); then
+    echo "PASS $name"
+  else
+    echo "FAIL $name: $output" | tee -a /dev/termination-log
+    failed=1
+  fi
+}
+probe "DNS resolution of $SERVICE_NAME" nslookup "$SERVICE_NAME"
+probe "Service ClusterIP $SERVICE_IP" /agnhost connect --timeout=5s "$SERVICE_IP:80"
+probe "API server $KUBERNETES_SERVICE_HOST" /agnhost connect --timeout=5s "$KUBERNETES_SERVICE_HOST:$KUBERNETES_SERVICE_PORT"
+for ip in $PEER_IPS; do
+  probe "pod $ip" /agnhost connect --timeout=5s "$ip:8080"
+done
+exit $failed
+`
+
+// ProbeTarget is a group of nodes, e.g. the nodes of a node group, that a probe pod is scheduled on.
+type ProbeTarget struct {
+	// Name identifies the target in errors and logs.
+	Name string
+	// NodeSelector selects the nodes of the target by their labels.
+	NodeSelector map[string]string
+}
+
+// ConnectivityValidation describes the data plane connectivity probe of a cluster.
+type ConnectivityValidation struct {
+	ClusterName string
+	// Image is the image of the probe pods. Defaults to DefaultProbeImage.
+	Image   string
+	Targets []ProbeTarget
+}
+
+// probeTargetsFromNodeGroups returns a target for the first node of every node group, selected by its hostname. If
+// that results in a single target, the second node of the node group is added so cross-node traffic can be probed.
+func probeTargetsFromNodeGroups(nodeGroups []NodeGroupResult) []ProbeTarget {
+	target := func(nodeGroup NodeGroupResult, node NodeResult) ProbeTarget {
+		return ProbeTarget{
+			Name:         fmt.Sprintf("%s/%s", nodeGroup.Name, node.Name),
+			NodeSelector: map[string]string{corev1.LabelHostname: node.Name},
+		}
+	}
+
+	var targets []ProbeTarget
+	for _, nodeGroup := range nodeGroups {
+		if len(nodeGroup.Nodes) > 0 {
+			targets = append(targets, target(nodeGroup, nodeGroup.Nodes[0]))
+		}
+	}
+	if len(targets) == 1 {
+		for _, nodeGroup := range nodeGroups {
+			if len(nodeGroup.Nodes) > 1 {
+				targets = append(targets, target(nodeGroup, nodeGroup.Nodes[1]))
+				break
+			}
+		}
+	}
+	return targets
+}
+
+// ValidateConnectivity validates the data plane of a cluster by launching short-lived probe pods on the nodes of
+// every target. A probe server is scheduled on every target, and a probe client on the node of every probe server.
+// The clients check that cluster DNS resolves the Service of the probe servers, that the ClusterIP of the Service is
+// reachable, that the API server is reachable and that the probe servers on the other nodes are reachable. This
+// catches broken security group rules between the nodes and towards the control plane. The probe pods are deleted
+// together with their namespace before returning.
+func ValidateConnectivity(ctx context.Context, log Logger, clientset kubernetes.Interface, args ConnectivityValidation) error {
+	if len(args.Targets) == 0 {
+		return fmt.Errorf("no probe targets for cluster %s, the connectivity probe needs at least one node", args.ClusterName)
+	}
+	image := args.Image
+	if image == "" {
+		image = DefaultProbeImage
+	}
+
+	namespace, err := clientset.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "conformance-probe-" + rand.String(5)},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create namespace of probe pods in cluster %s: %v", args.ClusterName, err)
+	}
+	cleanups := newCleanups(fmt.Sprintf("namespace %s of probe pods in cluster %s", namespace.Name, args.ClusterName), time.Minute)
+	defer cleanups.run(ctx, log)
+	cleanups.add(func(ctx context.Context) error {
+		return clientset.CoreV1().Namespaces().Delete(ctx, namespace.Name, metav1.DeleteOptions{})
+	})
+
+	service, err := clientset.CoreV1().Services(namespace.Name).Create(ctx, &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: probeServiceName},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{probeRoleLabel: "server"},
+			Ports: []corev1.ServicePort{{
+				Port:       80,
+				TargetPort: intstr.FromInt(probeServerPort),
+			}},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create probe service in cluster %s: %v", args.ClusterName, err)
+	}
+
+	servers := make([]*corev1.Pod, len(args.Targets))
+	for i, target := range args.Targets {
+		pod := probePod(image, fmt.Sprintf("server-%d", i), i, "server", target.NodeSelector)
+		pod.Spec.Containers[0].Args = []string{"netexec", fmt.Sprintf("--http-port=%d", probeServerPort)}
+		pod.Spec.Containers[0].Ports = []corev1.ContainerPort{{ContainerPort: probeServerPort}}
+		pod.Spec.Containers[0].ReadinessProbe = &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt(probeServerPort)},
+			},
+		}
+		if servers[i], err = clientset.CoreV1().Pods(namespace.Name).Create(ctx, pod, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create probe server of target %s in cluster %s: %v", target.Name, args.ClusterName, err)
+		}
+	}
+
+	for i, target := range args.Targets {
+		err := RetryWithExponentialBackoff(ctx, time.Second, func() error {
+			pod, err := clientset.CoreV1().Pods(namespace.Name).Get(ctx, servers[i].Name, metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to get probe server of target %s in cluster %s: %v", target.Name, args.ClusterName, err)
+			}
+			if !isPodReady(pod) || pod.Status.PodIP == "" {
+				return fmt.Errorf("probe server of target %s in cluster %s is not ready: %s", target.Name, args.ClusterName, describePodStatus(pod))
+			}
+			servers[i] = pod
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		log.Logf("Probe server of target %s in cluster %s is ready on node %s with IP %s", target.Name, args.ClusterName, servers[i].Spec.NodeName, servers[i].Status.PodIP)
+	}
+
+	clients := make([]*corev1.Pod, len(args.Targets))
+	for i, target := range args.Targets {
+		var peerIPs []string
+		for _, server := range servers {
+			if server.Spec.NodeName != servers[i].Spec.NodeName {
+				peerIPs = append(peerIPs, server.Status.PodIP)
+			}
+		}
+		if len(peerIPs) == 0 {
+			log.Logf("Not probing cross-node traffic from target %s in cluster %s, there are no probe servers on other nodes", target.Name, args.ClusterName)
+		}
+
+		// The client runs on the node of the probe server of its target, so the peers are on different nodes.
+		pod := probePod(image, fmt.Sprintf("client-%d", i), i, "client", nil)
+		pod.Spec.NodeName = servers[i].Spec.NodeName
+		pod.Spec.Containers[0].Command = []string{"/bin/sh", "-c", probeScript}
+		pod.Spec.Containers[0].Env = []corev1.EnvVar{
+			{Name: "SERVICE_NAME", Value: fmt.Sprintf("%s.%s.svc.cluster.local", service.Name, namespace.Name)},
+			{Name: "SERVICE_IP", Value: service.Spec.ClusterIP},
+			{Name: "PEER_IPS", Value: strings.Join(peerIPs, " ")},
+		}
+		if clients[i], err = clientset.CoreV1().Pods(namespace.Name).Create(ctx, pod, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create probe client of target %s in cluster %s: %v", target.Name, args.ClusterName, err)
+		}
+	}
+
+	var errs []error
+	for i, target := range args.Targets {
+		var client *corev1.Pod
+		err := RetryWithExponentialBackoff(ctx, time.Second, func() error {
+			pod, err := clientset.CoreV1().Pods(namespace.Name).Get(ctx, clients[i].Name, metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to get probe client of target %s in cluster %s: %v", target.Name, args.ClusterName, err)
+			}
+			if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+				return fmt.Errorf("probe client of target %s in cluster %s has not completed: %s", target.Name, args.ClusterName, describePodStatus(pod))
+			}
+			client = pod
+			return nil
+		})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if client.Status.Phase == corev1.PodFailed {
+			errs = append(errs, fmt.Errorf("connectivity probe of target %s on node %s in cluster %s failed:\n%s", target.Name, client.Spec.NodeName, args.ClusterName, terminationMessage(client)))
+			continue
+		}
+		log.Logf("Connectivity probe of target %s on node %s in cluster %s succeeded", target.Name, client.Spec.NodeName, args.ClusterName)
+	}
+
+	return errors.Join(errs...)
+}
+
+// probePod returns a probe pod of a target. The probe pods tolerate all taints, so they can be scheduled on any node
+// group.
+func probePod(image, name string, target int, role string, nodeSelector map[string]string) *corev1.Pod {
+	selector := map[string]string{corev1.LabelOSStable: "linux"}
+	for k, v := range nodeSelector {
+		selector[k] = v
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				probeTargetLabel: fmt.Sprint(target),
+				probeRoleLabel:   role,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			NodeSelector:  selector,
+			Tolerations:   []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+			Containers: []corev1.Container{{
+				Name:  "probe",
+				Image: image,
+			}},
+		},
+	}
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// describePodStatus describes why a pod is pending or not ready, e.g. because it can't be scheduled.
+func describePodStatus(pod *corev1.Pod) string {
+	description := fmt.Sprintf("phase %s", pod.Status.Phase)
+	for _, condition := range pod.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue && condition.Message != "" {
+			description += fmt.Sprintf(", %s: %s", condition.Type, condition.Message)
+		}
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Waiting != nil && status.State.Waiting.Reason != "" {
+			description += fmt.Sprintf(", container %s is waiting: %s", status.Name, status.State.Waiting.Reason)
+		}
+	}
+	return description
+}
+
+// terminationMessage returns the termination messages of the containers of a pod.
+func terminationMessage(pod *corev1.Pod) string {
+	var messages []string
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated != nil {
+			messages = append(messages, strings.TrimSpace(status.State.Terminated.Message))
+		}
+	}
+	return strings.Join(messages, "\n")
+}
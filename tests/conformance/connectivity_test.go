@@ -0,0 +1,286 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// simulateProbes makes the fake clientset behave like a cluster running the probe pods. Probe servers are scheduled
+// on the node selected by their hostname and become ready, unless schedulable returns false for the node. Probe
+// clients complete with the termination message returned by probe, and fail if the message isn't empty.
+func simulateProbes(clientset *fake.Clientset, schedulable func(node string) bool, probe func(pod *corev1.Pod) string) {
+	var servers int
+	clientset.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+		if pod.Labels[probeRoleLabel] == "server" {
+			node := pod.Spec.NodeSelector[corev1.LabelHostname]
+			if !schedulable(node) {
+				pod.Status.Phase = corev1.PodPending
+				pod.Status.Conditions = []corev1.PodCondition{{
+					Type:    corev1.PodScheduled,
+					Status:  corev1.ConditionFalse,
+					Message: "0/2 nodes are available: 2 Insufficient cpu.",
+				}}
+				return false, nil, nil
+			}
+			servers++
+			pod.Spec.NodeName = node
+			pod.Status.Phase = corev1.PodRunning
+			pod.Status.PodIP = fmt.Sprintf("10.0.0.%d", servers)
+			pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+			return false, nil, nil
+		}
+
+		message := probe(pod)
+		pod.Status.Phase = corev1.PodSucceeded
+		if message != "" {
+			pod.Status.Phase = corev1.PodFailed
+		}
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+			Name:  "probe",
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: message}},
+		}}
+		return false, nil, nil
+	})
+	clientset.PrependReactor("create", "services", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		service := action.(k8stesting.CreateAction).GetObject().(*corev1.Service)
+		service.Spec.ClusterIP = "172.20.0.10"
+		return false, nil, nil
+	})
+}
+
+func envValue(pod *corev1.Pod, name string) string {
+	for _, env := range pod.Spec.Containers[0].Env {
+		if env.Name == name {
+			return env.Value
+		}
+	}
+	return ""
+}
+
+func TestValidateConnectivity(t *testing.T) {
+	t.Parallel()
+
+	targets := []ProbeTarget{
+		{Name: "ng-1", NodeSelector: map[string]string{corev1.LabelHostname: "node-1"}},
+		{Name: "ng-2", NodeSelector: map[string]string{corev1.LabelHostname: "node-2"}},
+	}
+
+	tests := []struct {
+		name        string
+		targets     []ProbeTarget
+		schedulable func(node string) bool
+		probe       func(pod *corev1.Pod) string
+		wantErr     []string
+		logged      []string
+	}{
+		{
+			name:    "healthy data plane",
+			targets: targets,
+			logged: []string{
+				"Connectivity probe of target ng-1 on node node-1 in cluster my-cluster succeeded",
+				"Connectivity probe of target ng-2 on node node-2 in cluster my-cluster succeeded",
+			},
+		},
+		{
+			name:    "blocked cross-node traffic",
+			targets: targets,
+			probe: func(pod *corev1.Pod) string {
+				if pod.Spec.NodeName == "node-2" {
+					return "FAIL pod 10.0.0.1: connect: i/o timeout"
+				}
+				return ""
+			},
+			wantErr: []string{
+				"connectivity probe of target ng-2 on node node-2 in cluster my-cluster failed",
+				"FAIL pod 10.0.0.1: connect: i/o timeout",
+			},
+		},
+		{
+			name:        "unschedulable probe server",
+			targets:     targets,
+			schedulable: func(node string) bool { return node != "node-2" },
+			wantErr: []string{
+				"probe server of target ng-2 in cluster my-cluster is not ready",
+				"PodScheduled: 0/2 nodes are available: 2 Insufficient cpu.",
+			},
+		},
+		{
+			name: "single node",
+			targets: []ProbeTarget{
+				{Name: "ng-1", NodeSelector: map[string]string{corev1.LabelHostname: "node-1"}},
+			},
+			logged: []string{
+				"Not probing cross-node traffic from target ng-1 in cluster my-cluster",
+			},
+		},
+		{
+			name:    "no targets",
+			wantErr: []string{"no probe targets for cluster my-cluster"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+			defer cancel()
+
+			schedulable, probe := tt.schedulable, tt.probe
+			if schedulable == nil {
+				schedulable = func(string) bool { return true }
+			}
+			if probe == nil {
+				probe = func(*corev1.Pod) string { return "" }
+			}
+
+			clientset, _ := newFakeClients()
+			simulateProbes(clientset, schedulable, probe)
+
+			log := &recordingLogger{}
+			err := ValidateConnectivity(ctx, log, clientset, ConnectivityValidation{
+				ClusterName: "my-cluster",
+				Targets:     tt.targets,
+			})
+			if len(tt.wantErr) > 0 {
+				require.Error(t, err)
+				for _, want := range tt.wantErr {
+					assert.Contains(t, err.Error(), want)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+			for _, message := range tt.logged {
+				assert.True(t, log.contains(message), "expected %q to be logged", message)
+			}
+
+			namespaces, err := clientset.CoreV1().Namespaces().List(context.Background(), metav1.ListOptions{})
+			require.NoError(t, err)
+			assert.Empty(t, namespaces.Items, "the namespace of the probe pods should be deleted")
+		})
+	}
+}
+
+func TestConnectivityProbePods(t *testing.T) {
+	t.Parallel()
+
+	clientset, _ := newFakeClients()
+	var clients []*corev1.Pod
+	simulateProbes(clientset, func(string) bool { return true }, func(pod *corev1.Pod) string {
+		clients = append(clients, pod.DeepCopy())
+		return ""
+	})
+
+	err := ValidateConnectivity(context.Background(), nopLogger{}, clientset, ConnectivityValidation{
+		ClusterName: "my-cluster",
+		Image:       "example.com/agnhost:latest",
+		Targets: []ProbeTarget{
+			{Name: "ng-1", NodeSelector: map[string]string{corev1.LabelHostname: "node-1"}},
+			{Name: "ng-2", NodeSelector: map[string]string{corev1.LabelHostname: "node-2"}},
+			{Name: "ng-2-again", NodeSelector: map[string]string{corev1.LabelHostname: "node-2"}},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, clients, 3)
+	for _, client := range clients {
+		assert.Equal(t, "example.com/agnhost:latest", client.Spec.Containers[0].Image)
+		assert.Equal(t, "172.20.0.10", envValue(client, "SERVICE_IP"))
+		assert.Regexp(t, `^probe\.conformance-probe-[a-z0-9]+\.svc\.cluster\.local$`, envValue(client, "SERVICE_NAME"))
+		assert.Equal(t, []corev1.Toleration{{Operator: corev1.TolerationOpExists}}, client.Spec.Tolerations)
+	}
+
+	// The peers of a client are the probe servers on the other nodes.
+	assert.Equal(t, "node-1", clients[0].Spec.NodeName)
+	assert.Equal(t, "10.0.0.2 10.0.0.3", envValue(clients[0], "PEER_IPS"))
+	assert.Equal(t, "node-2", clients[1].Spec.NodeName)
+	assert.Equal(t, "10.0.0.1", envValue(clients[1], "PEER_IPS"))
+	assert.Equal(t, "node-2", clients[2].Spec.NodeName)
+	assert.Equal(t, "10.0.0.1", envValue(clients[2], "PEER_IPS"))
+}
+
+func TestProbeTargetsFromNodeGroups(t *testing.T) {
+	t.Parallel()
+
+	nodes := func(names ...string) []NodeResult {
+		var result []NodeResult
+		for _, name := range names {
+			result = append(result, NodeResult{Name: name})
+		}
+		return result
+	}
+	targetNames := func(targets []ProbeTarget) []string {
+		var names []string
+		for _, target := range targets {
+			assert.True(t, strings.HasSuffix(target.Name, "/"+target.NodeSelector[corev1.LabelHostname]), target.Name)
+			names = append(names, target.Name)
+		}
+		return names
+	}
+
+	tests := []struct {
+		name       string
+		nodeGroups []NodeGroupResult
+		want       []string
+	}{
+		{
+			name: "first node of every node group",
+			nodeGroups: []NodeGroupResult{
+				{Name: "asg-a", Nodes: nodes("node-1", "node-2")},
+				{Name: "asg-b", Nodes: nodes("node-3")},
+				{Name: "asg-c"},
+			},
+			want: []string{"asg-a/node-1", "asg-b/node-3"},
+		},
+		{
+			name: "two nodes of a single node group",
+			nodeGroups: []NodeGroupResult{
+				{Name: "asg-a", Nodes: nodes("node-1", "node-2", "node-3")},
+			},
+			want: []string{"asg-a/node-1", "asg-a/node-2"},
+		},
+		{
+			name: "single node",
+			nodeGroups: []NodeGroupResult{
+				{Name: "asg-a", Nodes: nodes("node-1")},
+			},
+			want: []string{"asg-a/node-1"},
+		},
+		{
+			name: "no nodes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, targetNames(probeTargetsFromNodeGroups(tt.nodeGroups)))
+		})
+	}
+}
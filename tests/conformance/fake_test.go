@@ -0,0 +1,381 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgTypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	eksTypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// fakeInstance is an EC2 instance of an Auto Scaling Group known to fakeAWS.
+type fakeInstance struct {
+	asgName string
+	state   types.InstanceStateName
+	status  types.SummaryStatus
+}
+
+// fakeAWS is an in-memory implementation of AutoScalingAPI and EC2API. Instances that are unknown to it are omitted
+// from the responses, like the real APIs do.
+type fakeAWS struct {
+	instances map[string]fakeInstance
+	// maxInstanceIDs is the maximum number of instance IDs that may be passed to DescribeAutoScalingInstances.
+	maxInstanceIDs int
+
+	// mu guards volumes, which are changed while the fake is in use.
+	mu      sync.Mutex
+	volumes map[string]types.Volume
+}
+
+var (
+	_ AutoScalingAPI = (*fakeAWS)(nil)
+	_ EC2API         = (*fakeAWS)(nil)
+)
+
+func newFakeAWS() *fakeAWS {
+	return &fakeAWS{
+		instances:      make(map[string]fakeInstance),
+		maxInstanceIDs: 50,
+		volumes:        make(map[string]types.Volume),
+	}
+}
+
+// addInstance adds a running and healthy instance to an Auto Scaling Group. Instances with an empty asgName are not
+// part of any Auto Scaling Group, like the instances of EKS Auto Mode.
+func (f *fakeAWS) addInstance(asgName, instanceID string) {
+	f.instances[instanceID] = fakeInstance{
+		asgName: asgName,
+		state:   types.InstanceStateNameRunning,
+		status:  types.SummaryStatusOk,
+	}
+}
+
+func (f *fakeAWS) DescribeAutoScalingInstances(_ context.Context, params *autoscaling.DescribeAutoScalingInstancesInput, _ ...func(*autoscaling.Options)) (*autoscaling.DescribeAutoScalingInstancesOutput, error) {
+	if len(params.InstanceIds) > f.maxInstanceIDs {
+		return nil, fmt.Errorf("at most %d instance IDs may be described at once, got %d", f.maxInstanceIDs, len(params.InstanceIds))
+	}
+
+	output := &autoscaling.DescribeAutoScalingInstancesOutput{}
+	for _, id := range params.InstanceIds {
+		instance, ok := f.instances[id]
+		if !ok || instance.asgName == "" {
+			continue
+		}
+		output.AutoScalingInstances = append(output.AutoScalingInstances, asgTypes.AutoScalingInstanceDetails{
+			AutoScalingGroupName: aws.String(instance.asgName),
+			InstanceId:           aws.String(id),
+			LifecycleState:       aws.String("InService"),
+		})
+	}
+	return output, nil
+}
+
+func (f *fakeAWS) DescribeInstanceStatus(_ context.Context, params *ec2.DescribeInstanceStatusInput, _ ...func(*ec2.Options)) (*ec2.DescribeInstanceStatusOutput, error) {
+	output := &ec2.DescribeInstanceStatusOutput{}
+	for _, id := range params.InstanceIds {
+		instance, ok := f.instances[id]
+		if !ok {
+			continue
+		}
+		output.InstanceStatuses = append(output.InstanceStatuses, types.InstanceStatus{
+			InstanceId:     aws.String(id),
+			InstanceState:  &types.InstanceState{Name: instance.state},
+			InstanceStatus: &types.InstanceStatusSummary{Status: instance.status},
+			SystemStatus:   &types.InstanceStatusSummary{Status: instance.status},
+		})
+	}
+	return output, nil
+}
+
+// putVolume adds or replaces an EBS volume.
+func (f *fakeAWS) putVolume(volume types.Volume) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.volumes[aws.ToString(volume.VolumeId)] = volume
+}
+
+// volume returns an EBS volume and whether it exists.
+func (f *fakeAWS) volume(volumeID string) (types.Volume, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	volume, ok := f.volumes[volumeID]
+	return volume, ok
+}
+
+func (f *fakeAWS) DescribeVolumes(_ context.Context, params *ec2.DescribeVolumesInput, _ ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error) {
+	output := &ec2.DescribeVolumesOutput{}
+	for _, id := range params.VolumeIds {
+		if volume, ok := f.volume(id); ok {
+			output.Volumes = append(output.Volumes, volume)
+		}
+	}
+	return output, nil
+}
+
+func (f *fakeAWS) DeleteVolume(_ context.Context, params *ec2.DeleteVolumeInput, _ ...func(*ec2.Options)) (*ec2.DeleteVolumeOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.volumes[aws.ToString(params.VolumeId)]; !ok {
+		return nil, fmt.Errorf("volume %s does not exist", aws.ToString(params.VolumeId))
+	}
+	delete(f.volumes, aws.ToString(params.VolumeId))
+	return &ec2.DeleteVolumeOutput{}, nil
+}
+
+// fakeEKS is an in-memory implementation of EKSAPI for a single cluster.
+type fakeEKS struct {
+	clusterName string
+	oidcIssuer  string
+	addons      map[string]eksTypes.Addon
+	// associations are the pod identity associations of the cluster, keyed by their IDs.
+	associations map[string]eksTypes.PodIdentityAssociation
+	// accessEntries are the access policies associated with the principals of the access entries of the cluster,
+	// keyed by the principal ARNs.
+	accessEntries map[string][]eksTypes.AssociatedAccessPolicy
+}
+
+var _ EKSAPI = (*fakeEKS)(nil)
+
+func newFakeEKS(clusterName string) *fakeEKS {
+	return &fakeEKS{
+		clusterName:   clusterName,
+		oidcIssuer:    "https://oidc.eks.us-west-2.amazonaws.com/id/EXAMPLED539D4633E53DE1B71EXAMPLE",
+		addons:        make(map[string]eksTypes.Addon),
+		associations:  make(map[string]eksTypes.PodIdentityAssociation),
+		accessEntries: make(map[string][]eksTypes.AssociatedAccessPolicy),
+	}
+}
+
+func (f *fakeEKS) checkCluster(name *string) error {
+	if aws.ToString(name) != f.clusterName {
+		return &eksTypes.ResourceNotFoundException{Message: aws.String("No cluster found for name: " + aws.ToString(name))}
+	}
+	return nil
+}
+
+func (f *fakeEKS) DescribeAddon(_ context.Context, params *eks.DescribeAddonInput, _ ...func(*eks.Options)) (*eks.DescribeAddonOutput, error) {
+	if err := f.checkCluster(params.ClusterName); err != nil {
+		return nil, err
+	}
+	addon, ok := f.addons[aws.ToString(params.AddonName)]
+	if !ok {
+		return nil, &eksTypes.ResourceNotFoundException{Message: aws.String("No addon: " + aws.ToString(params.AddonName))}
+	}
+	return &eks.DescribeAddonOutput{Addon: &addon}, nil
+}
+
+func (f *fakeEKS) DescribeCluster(_ context.Context, params *eks.DescribeClusterInput, _ ...func(*eks.Options)) (*eks.DescribeClusterOutput, error) {
+	if err := f.checkCluster(params.Name); err != nil {
+		return nil, err
+	}
+	return &eks.DescribeClusterOutput{Cluster: &eksTypes.Cluster{
+		Name:     aws.String(f.clusterName),
+		Identity: &eksTypes.Identity{Oidc: &eksTypes.OIDC{Issuer: aws.String(f.oidcIssuer)}},
+	}}, nil
+}
+
+func (f *fakeEKS) CreatePodIdentityAssociation(_ context.Context, params *eks.CreatePodIdentityAssociationInput, _ ...func(*eks.Options)) (*eks.CreatePodIdentityAssociationOutput, error) {
+	if err := f.checkCluster(params.ClusterName); err != nil {
+		return nil, err
+	}
+	association := eksTypes.PodIdentityAssociation{
+		AssociationId:  aws.String(fmt.Sprintf("a-%d", len(f.associations))),
+		ClusterName:    params.ClusterName,
+		Namespace:      params.Namespace,
+		ServiceAccount: params.ServiceAccount,
+		RoleArn:        params.RoleArn,
+	}
+	f.associations[*association.AssociationId] = association
+	return &eks.CreatePodIdentityAssociationOutput{Association: &association}, nil
+}
+
+func (f *fakeEKS) DeletePodIdentityAssociation(_ context.Context, params *eks.DeletePodIdentityAssociationInput, _ ...func(*eks.Options)) (*eks.DeletePodIdentityAssociationOutput, error) {
+	if err := f.checkCluster(params.ClusterName); err != nil {
+		return nil, err
+	}
+	association, ok := f.associations[aws.ToString(params.AssociationId)]
+	if !ok {
+		return nil, &eksTypes.ResourceNotFoundException{Message: aws.String("No association: " + aws.ToString(params.AssociationId))}
+	}
+	delete(f.associations, aws.ToString(params.AssociationId))
+	return &eks.DeletePodIdentityAssociationOutput{Association: &association}, nil
+}
+
+// page returns the item at the index of the pagination token, and the token of the next item. The fake returns a
+// single item per page to exercise the pagination of the callers.
+func page[T any](items []T, token *string) ([]T, *string) {
+	i, _ := strconv.Atoi(aws.ToString(token))
+	if i >= len(items) {
+		return nil, nil
+	}
+	var next *string
+	if i+1 < len(items) {
+		next = aws.String(strconv.Itoa(i + 1))
+	}
+	return items[i : i+1], next
+}
+
+func (f *fakeEKS) ListAccessEntries(_ context.Context, params *eks.ListAccessEntriesInput, _ ...func(*eks.Options)) (*eks.ListAccessEntriesOutput, error) {
+	if err := f.checkCluster(params.ClusterName); err != nil {
+		return nil, err
+	}
+	principalArns := slices.Sorted(maps.Keys(f.accessEntries))
+	entries, next := page(principalArns, params.NextToken)
+	return &eks.ListAccessEntriesOutput{AccessEntries: entries, NextToken: next}, nil
+}
+
+func (f *fakeEKS) ListAssociatedAccessPolicies(_ context.Context, params *eks.ListAssociatedAccessPoliciesInput, _ ...func(*eks.Options)) (*eks.ListAssociatedAccessPoliciesOutput, error) {
+	if err := f.checkCluster(params.ClusterName); err != nil {
+		return nil, err
+	}
+	policies, ok := f.accessEntries[aws.ToString(params.PrincipalArn)]
+	if !ok {
+		return nil, &eksTypes.ResourceNotFoundException{Message: aws.String("The specified principalArn could not be found.")}
+	}
+	associated, next := page(policies, params.NextToken)
+	return &eks.ListAssociatedAccessPoliciesOutput{
+		ClusterName:              params.ClusterName,
+		PrincipalArn:             params.PrincipalArn,
+		AssociatedAccessPolicies: associated,
+		NextToken:                next,
+	}, nil
+}
+
+// recordingLogger is a Logger that records all messages. It is safe for concurrent use.
+type recordingLogger struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (l *recordingLogger) Logf(format string, args ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.messages = append(l.messages, fmt.Sprintf(format, args...))
+}
+
+// contains returns true if any recorded message contains s.
+func (l *recordingLogger) contains(s string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, message := range l.messages {
+		if strings.Contains(message, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// readyNode returns a ready node backed by the EC2 instance with the given ID.
+func readyNode(name, instanceID string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: corev1.NodeSpec{
+			ProviderID: "aws:///us-west-2a/" + instanceID,
+		},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+}
+
+// autoModeNode returns a ready EKS Auto Mode node of a node pool that is backed by the EC2 instance with the given ID.
+func autoModeNode(name, nodePool, instanceID string) *corev1.Node {
+	node := readyNode(name, instanceID)
+	node.Labels = map[string]string{
+		computeTypeLabel: "auto",
+		nodePoolLabel:    nodePool,
+	}
+	return node
+}
+
+// fargateNode returns a ready Fargate node that was scheduled by the given Fargate profile.
+func fargateNode(name, profile string) *corev1.Node {
+	node := readyNode(name, "")
+	node.Spec.ProviderID = "aws:///us-west-2a/fa1b2c3d4e/" + name
+	node.Labels = map[string]string{
+		computeTypeLabel:    "fargate",
+		fargateProfileLabel: profile,
+	}
+	return node
+}
+
+// readyNodeClaim returns a ready NodeClaim of a node pool whose node joined the cluster.
+func readyNodeClaim(name, nodePool, nodeName, instanceID string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "karpenter.sh/v1",
+		"kind":       "NodeClaim",
+		"metadata": map[string]interface{}{
+			"name":   name,
+			"labels": map[string]interface{}{nodePoolLabel: nodePool},
+		},
+		"status": map[string]interface{}{
+			"nodeName":   nodeName,
+			"providerID": "aws:///us-west-2a/" + instanceID,
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Launched", "status": "True"},
+				map[string]interface{}{"type": "Ready", "status": "True"},
+			},
+		},
+	}}
+}
+
+// newFakeClients returns a fake clientset holding the typed objects and a fake dynamic client holding the
+// unstructured objects, e.g. NodeClaims.
+func newFakeClients(objects ...runtime.Object) (*fake.Clientset, *dynamicfake.FakeDynamicClient) {
+	var typed, custom []runtime.Object
+	for _, obj := range objects {
+		if _, ok := obj.(*unstructured.Unstructured); ok {
+			custom = append(custom, obj)
+		} else {
+			typed = append(typed, obj)
+		}
+	}
+
+	clientset := fake.NewSimpleClientset(typed...)
+	clientset.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{
+		Major:      "1",
+		Minor:      "33",
+		GitVersion: "v1.33.1-eks-1",
+	}
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		nodeClaimsResource: "NodeClaimList",
+	}, custom...)
+
+	return clientset, dynamicClient
+}
@@ -0,0 +1,234 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DefaultCallerIdentityImage is the image of the pod that calls sts:GetCallerIdentity.
+const DefaultCallerIdentityImage = "public.ecr.aws/aws-cli/aws-cli:2.22.35"
+
+// irsaRoleArnAnnotation is the annotation of a ServiceAccount that binds it to an IAM role through IRSA.
+const irsaRoleArnAnnotation = "eks.amazonaws.com/role-arn"
+
+// IdentityMode is the mechanism that binds a ServiceAccount to an IAM role.
+type IdentityMode string
+
+const (
+	// IdentityModeIRSA binds the ServiceAccount to the role with the eks.amazonaws.com/role-arn annotation. The pod
+	// assumes the role with a web identity token issued by the OIDC provider of the cluster.
+	IdentityModeIRSA IdentityMode = "IRSA"
+	// IdentityModePodIdentity binds the ServiceAccount to the role with an EKS Pod Identity association. The pod
+	// receives the credentials of the role from the eks-pod-identity-agent addon.
+	IdentityModePodIdentity IdentityMode = "PodIdentity"
+)
+
+// WorkloadIdentityValidation describes the IAM role that pods of a ServiceAccount are expected to assume.
+type WorkloadIdentityValidation struct {
+	ClusterName string
+	Mode        IdentityMode
+	// RoleArn is the ARN of the IAM role the pod is expected to assume. The trust policy of the role has to allow the
+	// ServiceAccount to assume it.
+	RoleArn string
+	// OIDCProviderArn is the ARN of the IAM OIDC provider of the cluster, e.g. the `oidcProviderArn` output of the
+	// cluster. It is required for IRSA.
+	OIDCProviderArn string
+	// Namespace and ServiceAccountName identify the ServiceAccount that is created for the pod. The namespace has to
+	// exist.
+	Namespace          string
+	ServiceAccountName string
+	// Region is the AWS region of the STS endpoint the pod calls. If empty, the region injected by EKS is used.
+	Region string
+	// Image is the image of the pod. It has to contain the AWS CLI. Defaults to DefaultCallerIdentityImage.
+	Image string
+}
+
+// ValidateWorkloadIdentity validates that pods of a cluster receive the credentials of an IAM role through IRSA or EKS
+// Pod Identity. It creates a ServiceAccount that is bound to the role and runs a pod with it that calls
+// sts:GetCallerIdentity, then asserts that the caller is a session of the role. For IRSA, it also validates that the
+// OIDC provider belongs to the cluster. The ServiceAccount, pod and Pod Identity association are deleted before
+// returning.
+func ValidateWorkloadIdentity(ctx context.Context, log Logger, clientset kubernetes.Interface, eksClient EKSAPI, args WorkloadIdentityValidation) error {
+	image := args.Image
+	if image == "" {
+		image = DefaultCallerIdentityImage
+	}
+
+	cleanups := newCleanups("workload identity validation of cluster "+args.ClusterName, time.Minute)
+	defer cleanups.run(ctx, log)
+
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Namespace: args.Namespace, Name: args.ServiceAccountName},
+	}
+	switch args.Mode {
+	case IdentityModeIRSA:
+		if err := validateOIDCProvider(ctx, eksClient, args.ClusterName, args.OIDCProviderArn); err != nil {
+			return err
+		}
+		serviceAccount.Annotations = map[string]string{irsaRoleArnAnnotation: args.RoleArn}
+	case IdentityModePodIdentity:
+		association, err := eksClient.CreatePodIdentityAssociation(ctx, &eks.CreatePodIdentityAssociationInput{
+			ClusterName:    aws.String(args.ClusterName),
+			Namespace:      aws.String(args.Namespace),
+			ServiceAccount: aws.String(args.ServiceAccountName),
+			RoleArn:        aws.String(args.RoleArn),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create pod identity association for %s/%s in cluster %s: %v", args.Namespace, args.ServiceAccountName, args.ClusterName, err)
+		}
+		associationID := association.Association.AssociationId
+		cleanups.add(func(ctx context.Context) error {
+			_, err := eksClient.DeletePodIdentityAssociation(ctx, &eks.DeletePodIdentityAssociationInput{
+				ClusterName:   aws.String(args.ClusterName),
+				AssociationId: associationID,
+			})
+			return err
+		})
+		log.Logf("Created pod identity association %s for %s/%s in cluster %s", aws.ToString(associationID), args.Namespace, args.ServiceAccountName, args.ClusterName)
+	default:
+		return fmt.Errorf("unknown identity mode %q", args.Mode)
+	}
+
+	_, err := clientset.CoreV1().ServiceAccounts(args.Namespace).Create(ctx, serviceAccount, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		// Left over by an earlier attempt that couldn't clean up.
+		_, err = clientset.CoreV1().ServiceAccounts(args.Namespace).Update(ctx, serviceAccount, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create service account %s/%s in cluster %s: %v", args.Namespace, args.ServiceAccountName, args.ClusterName, err)
+	}
+	cleanups.add(func(ctx context.Context) error {
+		return clientset.CoreV1().ServiceAccounts(args.Namespace).Delete(ctx, args.ServiceAccountName, metav1.DeleteOptions{})
+	})
+
+	pod := callerIdentityPod(image, args)
+	if _, err := clientset.CoreV1().Pods(args.Namespace).Create(ctx, pod, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("failed to create pod %s/%s in cluster %s: %v", args.Namespace, pod.Name, args.ClusterName, err)
+	}
+	cleanups.add(func(ctx context.Context) error {
+		return clientset.CoreV1().Pods(args.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+	})
+
+	var completed *corev1.Pod
+	err = RetryWithExponentialBackoff(ctx, time.Second, func() error {
+		p, err := clientset.CoreV1().Pods(args.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get pod %s/%s in cluster %s: %v", args.Namespace, pod.Name, args.ClusterName, err)
+		}
+		if p.Status.Phase != corev1.PodSucceeded && p.Status.Phase != corev1.PodFailed {
+			return fmt.Errorf("pod %s/%s in cluster %s has not completed: %s", args.Namespace, pod.Name, args.ClusterName, describePodStatus(p))
+		}
+		completed = p
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	output := terminationMessage(completed)
+	if completed.Status.Phase == corev1.PodFailed {
+		return fmt.Errorf("sts:GetCallerIdentity failed in pod %s/%s of cluster %s: %s", args.Namespace, pod.Name, args.ClusterName, output)
+	}
+	if err := validateAssumedRole(output, args.RoleArn); err != nil {
+		return fmt.Errorf("pod of service account %s/%s in cluster %s assumed the wrong role: %w", args.Namespace, args.ServiceAccountName, args.ClusterName, err)
+	}
+
+	log.Logf("Pod of service account %s/%s in cluster %s assumed role %s as %s", args.Namespace, args.ServiceAccountName, args.ClusterName, args.RoleArn, output)
+	return nil
+}
+
+// callerIdentityPod returns a pod that writes the ARN of its caller identity, or the error of the AWS CLI, to its
+// termination log.
+func callerIdentityPod(image string, args WorkloadIdentityValidation) *corev1.Pod {
+	container := corev1.Container{
+		Name:    "caller-identity",
+		Image:   image,
+		Command: []string{"/bin/sh", "-c", "aws sts get-caller-identity --query Arn --output text > /dev/termination-log 2>&1"},
+	}
+	if args.Region != "" {
+		container.Env = []corev1.EnvVar{
+			{Name: "AWS_REGION", Value: args.Region},
+			{Name: "AWS_STS_REGIONAL_ENDPOINTS", Value: "regional"},
+		}
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: args.Namespace,
+			Name:      "caller-identity-" + rand.String(5),
+		},
+		Spec: corev1.PodSpec{
+			ServiceAccountName: args.ServiceAccountName,
+			RestartPolicy:      corev1.RestartPolicyNever,
+			NodeSelector:       map[string]string{corev1.LabelOSStable: "linux"},
+			Containers:         []corev1.Container{container},
+		},
+	}
+}
+
+// validateOIDCProvider validates that the IAM OIDC provider is the provider of the OIDC issuer of the cluster. The ARN
+// of the provider ends with the issuer URL without its scheme, e.g.
+// arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/EXAMPLE.
+func validateOIDCProvider(ctx context.Context, eksClient EKSAPI, clusterName, oidcProviderArn string) error {
+	if oidcProviderArn == "" {
+		return fmt.Errorf("the OIDC provider ARN of cluster %s is required for IRSA", clusterName)
+	}
+
+	resp, err := eksClient.DescribeCluster(ctx, &eks.DescribeClusterInput{Name: aws.String(clusterName)})
+	if err != nil {
+		return fmt.Errorf("failed to describe cluster %s: %v", clusterName, err)
+	}
+	if resp.Cluster.Identity == nil || resp.Cluster.Identity.Oidc == nil || resp.Cluster.Identity.Oidc.Issuer == nil {
+		return fmt.Errorf("cluster %s has no OIDC issuer", clusterName)
+	}
+
+	issuer := strings.TrimPrefix(*resp.Cluster.Identity.Oidc.Issuer, "https://")
+	if !strings.HasSuffix(oidcProviderArn, ":oidc-provider/"+issuer) {
+		return fmt.Errorf("OIDC provider %s does not belong to issuer %s of cluster %s", oidcProviderArn, issuer, clusterName)
+	}
+	return nil
+}
+
+// validateAssumedRole validates that the caller ARN is a session of the role, e.g.
+// arn:aws:sts::123456789012:assumed-role/my-role/session for arn:aws:iam::123456789012:role/path/my-role.
+func validateAssumedRole(callerArn, roleArn string) error {
+	role, err := arn.Parse(roleArn)
+	if err != nil {
+		return fmt.Errorf("invalid role ARN %q: %v", roleArn, err)
+	}
+	caller, err := arn.Parse(callerArn)
+	if err != nil {
+		return fmt.Errorf("invalid caller ARN %q: %v", callerArn, err)
+	}
+
+	roleName := role.Resource[strings.LastIndex(role.Resource, "/")+1:]
+	if caller.Service != "sts" || caller.AccountID != role.AccountID || !strings.HasPrefix(caller.Resource, "assumed-role/"+roleName+"/") {
+		return fmt.Errorf("expected a session of role %s, got caller %s", roleArn, callerArn)
+	}
+	return nil
+}
@@ -0,0 +1,260 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const (
+	testRoleArn         = "arn:aws:iam::123456789012:role/irsa/my-role"
+	testOIDCProviderArn = "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/EXAMPLED539D4633E53DE1B71EXAMPLE"
+)
+
+// simulateCallerIdentity makes the fake clientset complete pods with the output of sts:GetCallerIdentity. The pods
+// fail if failed is true. The ServiceAccounts of the pods are recorded in serviceAccounts.
+func simulateCallerIdentity(clientset *fake.Clientset, output string, failed bool, serviceAccounts *[]*corev1.ServiceAccount) {
+	clientset.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		*serviceAccounts = append(*serviceAccounts, action.(k8stesting.CreateAction).GetObject().(*corev1.ServiceAccount).DeepCopy())
+		return false, nil, nil
+	})
+	clientset.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+		pod.Status.Phase = corev1.PodSucceeded
+		if failed {
+			pod.Status.Phase = corev1.PodFailed
+		}
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+			Name:  "caller-identity",
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: output + "\n"}},
+		}}
+		return false, nil, nil
+	})
+}
+
+func TestValidateWorkloadIdentity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		args       WorkloadIdentityValidation
+		output     string
+		failed     bool
+		annotation string
+		wantErr    string
+	}{
+		{
+			name: "IRSA",
+			args: WorkloadIdentityValidation{
+				Mode:            IdentityModeIRSA,
+				RoleArn:         testRoleArn,
+				OIDCProviderArn: testOIDCProviderArn,
+			},
+			output:     "arn:aws:sts::123456789012:assumed-role/my-role/botocore-session-1700000000",
+			annotation: testRoleArn,
+		},
+		{
+			name: "IRSA with the wrong role",
+			args: WorkloadIdentityValidation{
+				Mode:            IdentityModeIRSA,
+				RoleArn:         testRoleArn,
+				OIDCProviderArn: testOIDCProviderArn,
+			},
+			output:  "arn:aws:sts::123456789012:assumed-role/my-node-role/i-0123456789abcdef0",
+			wantErr: "pod of service account apps/identity in cluster my-cluster assumed the wrong role: expected a session of role " + testRoleArn,
+		},
+		{
+			name: "IRSA with the OIDC provider of another cluster",
+			args: WorkloadIdentityValidation{
+				Mode:            IdentityModeIRSA,
+				RoleArn:         testRoleArn,
+				OIDCProviderArn: "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/OTHER",
+			},
+			wantErr: "does not belong to issuer oidc.eks.us-west-2.amazonaws.com/id/EXAMPLED539D4633E53DE1B71EXAMPLE of cluster my-cluster",
+		},
+		{
+			name: "IRSA without OIDC provider",
+			args: WorkloadIdentityValidation{
+				Mode:    IdentityModeIRSA,
+				RoleArn: testRoleArn,
+			},
+			wantErr: "the OIDC provider ARN of cluster my-cluster is required for IRSA",
+		},
+		{
+			name: "Pod Identity",
+			args: WorkloadIdentityValidation{
+				Mode:    IdentityModePodIdentity,
+				RoleArn: testRoleArn,
+			},
+			output: "arn:aws:sts::123456789012:assumed-role/my-role/eks-my-cluster-caller-id-abcde",
+		},
+		{
+			name: "Pod Identity without credentials",
+			args: WorkloadIdentityValidation{
+				Mode:    IdentityModePodIdentity,
+				RoleArn: testRoleArn,
+			},
+			output:  "Unable to locate credentials. You can configure credentials by running \"aws configure\".",
+			failed:  true,
+			wantErr: "sts:GetCallerIdentity failed in pod apps/caller-identity-",
+		},
+		{
+			name:    "unknown mode",
+			args:    WorkloadIdentityValidation{Mode: "kiam"},
+			wantErr: `unknown identity mode "kiam"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+			defer cancel()
+
+			clientset, _ := newFakeClients()
+			var serviceAccounts []*corev1.ServiceAccount
+			simulateCallerIdentity(clientset, tt.output, tt.failed, &serviceAccounts)
+			eksClient := newFakeEKS("my-cluster")
+
+			args := tt.args
+			args.ClusterName = "my-cluster"
+			args.Namespace = "apps"
+			args.ServiceAccountName = "identity"
+
+			log := &recordingLogger{}
+			err := ValidateWorkloadIdentity(ctx, log, clientset, eksClient, args)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, serviceAccounts, 1)
+				assert.Equal(t, tt.annotation, serviceAccounts[0].Annotations[irsaRoleArnAnnotation])
+				assert.True(t, log.contains("Pod of service account apps/identity in cluster my-cluster assumed role "+testRoleArn))
+			}
+
+			// Everything that was created is cleaned up.
+			assert.Empty(t, eksClient.associations)
+			remainingServiceAccounts, err := clientset.CoreV1().ServiceAccounts("apps").List(context.Background(), metav1.ListOptions{})
+			require.NoError(t, err)
+			assert.Empty(t, remainingServiceAccounts.Items)
+			pods, err := clientset.CoreV1().Pods("apps").List(context.Background(), metav1.ListOptions{})
+			require.NoError(t, err)
+			assert.Empty(t, pods.Items)
+		})
+	}
+}
+
+func TestValidateWorkloadIdentityReplacesLeftoverServiceAccount(t *testing.T) {
+	t.Parallel()
+
+	leftover := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{
+		Namespace:   "apps",
+		Name:        "identity",
+		Annotations: map[string]string{irsaRoleArnAnnotation: "arn:aws:iam::123456789012:role/old-role"},
+	}}
+	clientset, _ := newFakeClients(leftover)
+
+	var updated *corev1.ServiceAccount
+	clientset.PrependReactor("update", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		updated = action.(k8stesting.UpdateAction).GetObject().(*corev1.ServiceAccount).DeepCopy()
+		return false, nil, nil
+	})
+	var serviceAccounts []*corev1.ServiceAccount
+	simulateCallerIdentity(clientset, "arn:aws:sts::123456789012:assumed-role/my-role/botocore-session-1", false, &serviceAccounts)
+
+	err := ValidateWorkloadIdentity(context.Background(), nopLogger{}, clientset, newFakeEKS("my-cluster"), WorkloadIdentityValidation{
+		ClusterName:        "my-cluster",
+		Mode:               IdentityModeIRSA,
+		RoleArn:            testRoleArn,
+		OIDCProviderArn:    testOIDCProviderArn,
+		Namespace:          "apps",
+		ServiceAccountName: "identity",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, updated)
+	assert.Equal(t, testRoleArn, updated.Annotations[irsaRoleArnAnnotation])
+}
+
+func TestValidateAssumedRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		callerArn string
+		roleArn   string
+		wantErr   string
+	}{
+		{
+			name:      "session of the role",
+			callerArn: "arn:aws:sts::123456789012:assumed-role/my-role/session",
+			roleArn:   "arn:aws:iam::123456789012:role/my-role",
+		},
+		{
+			name:      "session of a role with a path",
+			callerArn: "arn:aws:sts::123456789012:assumed-role/my-role/session",
+			roleArn:   "arn:aws:iam::123456789012:role/a/b/my-role",
+		},
+		{
+			name:      "role with a common prefix",
+			callerArn: "arn:aws:sts::123456789012:assumed-role/my-role-2/session",
+			roleArn:   "arn:aws:iam::123456789012:role/my-role",
+			wantErr:   "expected a session of role",
+		},
+		{
+			name:      "role of another account",
+			callerArn: "arn:aws:sts::210987654321:assumed-role/my-role/session",
+			roleArn:   "arn:aws:iam::123456789012:role/my-role",
+			wantErr:   "expected a session of role",
+		},
+		{
+			name:      "IAM user",
+			callerArn: "arn:aws:iam::123456789012:user/my-role",
+			roleArn:   "arn:aws:iam::123456789012:role/my-role",
+			wantErr:   "expected a session of role",
+		},
+		{
+			name:      "not an ARN",
+			callerArn: "Unable to locate credentials",
+			roleArn:   "arn:aws:iam::123456789012:role/my-role",
+			wantErr:   `invalid caller ARN "Unable to locate credentials"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateAssumedRole(tt.callerArn, tt.roleArn)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
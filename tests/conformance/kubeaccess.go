@@ -0,0 +1,189 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// IsKubeconfigValid checks that the kubeconfig provided is valid and error-free.
+func IsKubeconfigValid(kubeconfig []byte) error {
+	// Create a ClientConfig to confirm & validate that the kubeconfig provided is valid
+	clientConfig, err := clientcmd.NewClientConfigFromBytes(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	// Get the raw ClientConfig API Config
+	rawConfig, err := clientConfig.RawConfig()
+	if err != nil {
+		return err
+	}
+
+	// Confirms there are no errors or conflicts in the cliendcmd config.
+	err = clientcmd.Validate(rawConfig)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// KubeAccess holds the Kubernetes client-go client bag of tools to work with the
+// APIServer: the RESTConfig, the Clientset for the various API groups and the
+// DynamicClient for custom resources.
+type KubeAccess struct {
+	RESTConfig    *restclient.Config
+	Clientset     *kubernetes.Clientset
+	DynamicClient dynamic.Interface
+}
+
+// KubeconfigToKubeAccess creates a KubeAccess object from a serialized kubeconfig.
+func KubeconfigToKubeAccess(kubeconfig []byte) (*KubeAccess, error) {
+	if err := IsKubeconfigValid(kubeconfig); err != nil {
+		return nil, err
+	}
+
+	// Create a REST config that uses the current context in the kubeconfig.
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the Clientset using the REST config
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the DynamicClient using the REST config
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KubeAccess{
+		RESTConfig:    restConfig,
+		Clientset:     clientset,
+		DynamicClient: dynamicClient,
+	}, nil
+}
+
+// clusterKubeAccessMap implements a map of Kubernetes cluster names to their
+// respective KubeAccess bag.
+type clusterKubeAccessMap map[string]*KubeAccess
+
+// ErrClusterNameNotFound is returned when the name of the EKS cluster can't be determined from a kubeconfig because
+// its user doesn't authenticate with `aws eks get-token --cluster-name <name>`.
+var ErrClusterNameNotFound = errors.New("cluster name not found in kubeconfig exec provider args")
+
+// mapClusterToKubeAccess creates a map of the EKS cluster name to its
+// KubeAccess client tool bag, based on the kubeconfigs.
+func mapClusterToKubeAccess(kubeconfigs ...interface{}) (clusterKubeAccessMap, error) {
+	// Map EKS cluster names to its KubeAccess.
+	clusterToKubeAccess := make(clusterKubeAccessMap)
+	for i, kubeconfig := range kubeconfigs {
+		var kc []byte
+
+		switch v := kubeconfig.(type) {
+		case string:
+			kc = []byte(v)
+		default:
+			var err error
+			kc, err = json.Marshal(kubeconfig)
+			if err != nil {
+				return nil, fmt.Errorf("kubeconfig %d: %w", i, err)
+			}
+		}
+
+		kubeAccess, err := KubeconfigToKubeAccess(kc)
+		if err != nil {
+			return nil, fmt.Errorf("kubeconfig %d: %w", i, err)
+		}
+
+		clusterName, err := clusterNameFromExecProvider(kubeAccess.RESTConfig.ExecProvider)
+		if err != nil {
+			return nil, fmt.Errorf("kubeconfig %d: %w", i, err)
+		}
+		clusterToKubeAccess[clusterName] = kubeAccess
+	}
+
+	return clusterToKubeAccess, nil
+}
+
+// clusterNameFromExecProvider parses the kubeconfig user auth exec args for the cluster name.
+func clusterNameFromExecProvider(execProvider *clientcmdapi.ExecConfig) (string, error) {
+	if execProvider == nil {
+		return "", ErrClusterNameNotFound
+	}
+
+	for idx, arg := range execProvider.Args {
+		if arg == "--cluster-name" && idx+1 < len(execProvider.Args) {
+			return execProvider.Args[idx+1], nil
+		}
+	}
+
+	return "", ErrClusterNameNotFound
+}
+
+// ClientsetFromKubeconfig creates a Clientset for the cluster of a kubeconfig. The kubeconfig can either be passed
+// as a string or as the object of the `kubeconfig` output of a cluster.
+func ClientsetFromKubeconfig(kubeconfig any) (*kubernetes.Clientset, error) {
+	clusterMap, err := mapClusterToKubeAccess(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	if len(clusterMap) == 0 {
+		return nil, fmt.Errorf("missing cluster kubeconfig")
+	}
+
+	var clientSet *kubernetes.Clientset
+	for _, kubeAccess := range clusterMap {
+		clientSet = kubeAccess.Clientset
+	}
+	return clientSet, nil
+}
+
+// LoadAWSConfig loads the default AWS configuration, using the profile and region set by the AWS_PROFILE and
+// AWS_REGION environment variables.
+func LoadAWSConfig(ctx context.Context) (aws.Config, error) {
+	loadOpts := []func(*config.LoadOptions) error{}
+	if profile, ok := os.LookupEnv("AWS_PROFILE"); ok && profile != "" {
+		loadOpts = append(loadOpts, config.WithSharedConfigProfile(profile))
+	}
+	if region, ok := os.LookupEnv("AWS_REGION"); ok && region != "" {
+		loadOpts = append(loadOpts, config.WithRegion(region))
+	}
+
+	// Increase the number of retry attempts from 3 to 20 to avoid flakiness
+	// Many of our test take a similar amount of time to complete and we have
+	// high parallelism, so there's spikes of requests for test verification.
+	// This will not add substantial time to the test run, but will reduce
+	// flakiness.
+	loadOpts = append(loadOpts, config.WithRetryMaxAttempts(20))
+	return config.LoadDefaultConfig(ctx, loadOpts...)
+}
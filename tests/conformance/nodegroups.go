@@ -0,0 +1,563 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// clusterAuthenticationModeMap implements a map of Kubernetes cluster names to their authentication mode.
+type clusterAuthenticationModeMap map[string]string
+
+func mapClusterToAuthenticationMode(resources []apitype.ResourceV3) (clusterAuthenticationModeMap, error) {
+	clusterToAuthenticationMode := make(clusterAuthenticationModeMap)
+
+	for _, res := range resources {
+		if res.Type.String() == "aws:eks/cluster:Cluster" {
+			clusterName, err := property[string](res, "outputs.name")
+			if err != nil {
+				return nil, err
+			}
+
+			authenticationMode, err := property[string](res, "inputs.accessConfig.authenticationMode")
+			if errors.Is(err, ErrMissingProperty) {
+				// EKS defaults to "CONFIG_MAP" if not specified.
+				authenticationMode = "CONFIG_MAP"
+			} else if err != nil {
+				return nil, err
+			}
+
+			clusterToAuthenticationMode[clusterName] = authenticationMode
+		}
+	}
+
+	return clusterToAuthenticationMode, nil
+}
+
+type cloudFormationTemplateBody struct {
+	Resources struct {
+		NodeGroup struct {
+			Properties struct {
+				DesiredCapacity int                 `yaml:"DesiredCapacity"`
+				Tags            []map[string]string `yaml:"Tags"`
+			} `yaml:"Properties"`
+		} `yaml:"NodeGroup"`
+	} `yaml:"Resources"`
+}
+
+// NodeGroup holds the expected state of the Auto Scaling Group of a node group.
+type NodeGroup struct {
+	// DesiredSize is the number of instances the Auto Scaling Group is expected to have.
+	DesiredSize int
+}
+
+// Capacity holds the compute capacity that is expected to be available to a cluster.
+type Capacity struct {
+	// NodeGroups maps the names of the Auto Scaling Groups of self-managed and managed node groups to their expected
+	// state.
+	NodeGroups map[string]NodeGroup
+	// AutoMode is true if EKS Auto Mode is enabled for the cluster.
+	AutoMode bool
+	// FargateProfiles holds the names of the Fargate profiles of the cluster.
+	FargateProfiles []string
+}
+
+// clusterCapacityMap implements a map of Kubernetes cluster names to their
+// respective compute capacity.
+type clusterCapacityMap map[string]*Capacity
+
+// get returns the capacity of a cluster, adding it to the map if needed.
+func (m clusterCapacityMap) get(clusterName string) *Capacity {
+	capacity, ok := m[clusterName]
+	if !ok {
+		capacity = &Capacity{NodeGroups: make(map[string]NodeGroup)}
+		m[clusterName] = capacity
+	}
+	return capacity
+}
+
+func mapClusterToCapacity(resources []apitype.ResourceV3) (clusterCapacityMap, error) {
+	clusterCapacityMap := make(clusterCapacityMap)
+
+	// Map cluster to its NodeGroups.
+	cfnPrefix := "arn:aws:cloudformation"                  // self-managed CF-based node groups
+	mngType := "aws:eks/nodeGroup:NodeGroup"               // AWS managed node groups
+	ng2Type := "aws:autoscaling/group:Group"               // self-managed ASG-based node groups
+	clusterType := "aws:eks/cluster:Cluster"               // EKS Auto Mode
+	fargateType := "aws:eks/fargateProfile:FargateProfile" // Fargate profiles
+
+	for _, res := range resources {
+		var clusterName, asgName string
+		var nodeGroup NodeGroup
+		var err error
+
+		switch {
+		case strings.HasPrefix(res.ID.String(), cfnPrefix):
+			clusterName, asgName, nodeGroup, err = cloudFormationNodeGroup(res)
+		case res.Type.String() == mngType:
+			clusterName, asgName, nodeGroup, err = managedNodeGroup(res)
+		case res.Type.String() == ng2Type:
+			clusterName, asgName, nodeGroup, err = autoScalingNodeGroup(res)
+		case res.Type.String() == clusterType:
+			if err := mapAutoMode(res, clusterCapacityMap); err != nil {
+				return nil, err
+			}
+			continue
+		case res.Type.String() == fargateType:
+			if err := mapFargateProfile(res, clusterCapacityMap); err != nil {
+				return nil, err
+			}
+			continue
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if clusterName == "" {
+			// Not a node group of an EKS cluster.
+			continue
+		}
+
+		clusterCapacityMap.get(clusterName).NodeGroups[asgName] = nodeGroup
+	}
+
+	return clusterCapacityMap, nil
+}
+
+// mapAutoMode records whether EKS Auto Mode is enabled for a cluster.
+func mapAutoMode(res apitype.ResourceV3, clusterCapacityMap clusterCapacityMap) error {
+	clusterName, err := property[string](res, "outputs.name")
+	if err != nil {
+		return err
+	}
+
+	enabled, err := property[bool](res, "outputs.computeConfig.enabled")
+	if errors.Is(err, ErrMissingProperty) {
+		// Clusters created before EKS Auto Mode was supported don't have a compute config.
+		enabled = false
+	} else if err != nil {
+		return err
+	}
+
+	clusterCapacityMap.get(clusterName).AutoMode = enabled
+	return nil
+}
+
+// mapFargateProfile adds a Fargate profile to the capacity of its cluster.
+func mapFargateProfile(res apitype.ResourceV3, clusterCapacityMap clusterCapacityMap) error {
+	clusterName, err := property[string](res, "outputs.clusterName")
+	if err != nil {
+		return err
+	}
+
+	profileName, err := property[string](res, "outputs.fargateProfileName")
+	if err != nil {
+		return err
+	}
+
+	capacity := clusterCapacityMap.get(clusterName)
+	capacity.FargateProfiles = append(capacity.FargateProfiles, profileName)
+	return nil
+}
+
+// clusterOwnershipTagPrefix is the prefix of the tag that associates AWS resources, like the Auto Scaling Groups of
+// self-managed node groups, with an EKS cluster.
+const clusterOwnershipTagPrefix = "kubernetes.io/cluster/"
+
+// clusterNameFromTag returns the name of the cluster if the tag is a cluster ownership tag.
+func clusterNameFromTag(key, value string) (string, bool) {
+	clusterName, ok := strings.CutPrefix(key, clusterOwnershipTagPrefix)
+	if !ok || clusterName == "" || (value != "owned" && value != "shared") {
+		return "", false
+	}
+	return clusterName, true
+}
+
+// cloudFormationNodeGroup extracts the cluster name, ASG name and expected state of a node group from the state of
+// its CloudFormation stack. The cluster name is empty if the stack doesn't belong to a node group.
+func cloudFormationNodeGroup(res apitype.ResourceV3) (string, string, NodeGroup, error) {
+	body, err := property[string](res, "outputs.templateBody")
+	if err != nil {
+		return "", "", NodeGroup{}, err
+	}
+
+	var templateBody cloudFormationTemplateBody
+	if err := yaml.Unmarshal([]byte(body), &templateBody); err != nil {
+		return "", "", NodeGroup{}, &StateError{URN: res.URN, Property: "outputs.templateBody", Err: err}
+	}
+
+	// Find the cluster ownership tag of the ASG to extract the cluster name.
+	var clusterName string
+	for _, tag := range templateBody.Resources.NodeGroup.Properties.Tags {
+		if name, ok := clusterNameFromTag(tag["Key"], tag["Value"]); ok {
+			clusterName = name
+		}
+	}
+	if clusterName == "" {
+		return "", "", NodeGroup{}, nil
+	}
+
+	asgName, err := property[string](res, "outputs.outputs.NodeGroup")
+	if err != nil {
+		return "", "", NodeGroup{}, err
+	}
+
+	return clusterName, asgName, NodeGroup{
+		DesiredSize: templateBody.Resources.NodeGroup.Properties.DesiredCapacity,
+	}, nil
+}
+
+// managedNodeGroup extracts the cluster name, ASG name and expected state of an EKS managed node group.
+func managedNodeGroup(res apitype.ResourceV3) (string, string, NodeGroup, error) {
+	clusterName, err := property[string](res, "inputs.clusterName")
+	if err != nil {
+		return "", "", NodeGroup{}, err
+	}
+
+	desiredSize, err := property[float64](res, "inputs.scalingConfig.desiredSize")
+	if err != nil {
+		return "", "", NodeGroup{}, err
+	}
+
+	// Extract the ASG name
+	nodeGroupResources, err := property[[]any](res, "outputs.resources")
+	if err != nil {
+		return "", "", NodeGroup{}, err
+	}
+	var asgName string
+	for i, resource := range nodeGroupResources {
+		path := fmt.Sprintf("outputs.resources[%d]", i)
+		autoscalingGroups, err := lookup[[]any](res.URN, path, resource, "autoscalingGroups")
+		if err != nil {
+			return "", "", NodeGroup{}, err
+		}
+		for j, autoscalingGroup := range autoscalingGroups {
+			name, err := lookup[string](res.URN, fmt.Sprintf("%s.autoscalingGroups[%d]", path, j), autoscalingGroup, "name")
+			if err != nil {
+				return "", "", NodeGroup{}, err
+			}
+			asgName = name
+		}
+	}
+	if asgName == "" {
+		return "", "", NodeGroup{}, &StateError{URN: res.URN, Property: "outputs.resources[].autoscalingGroups[].name", Err: ErrMissingProperty}
+	}
+
+	return clusterName, asgName, NodeGroup{DesiredSize: int(desiredSize)}, nil
+}
+
+// autoScalingNodeGroup extracts the cluster name, ASG name and expected state of a self-managed node group
+// (NodeGroupV2) from the state of its Auto Scaling Group. The cluster name is empty if the Auto Scaling Group doesn't
+// belong to a node group.
+func autoScalingNodeGroup(res apitype.ResourceV3) (string, string, NodeGroup, error) {
+	tags, err := property[[]any](res, "outputs.tags")
+	if err != nil {
+		return "", "", NodeGroup{}, err
+	}
+
+	// Find the cluster ownership tag within the returned array.
+	var clusterName string
+	for i, tag := range tags {
+		path := fmt.Sprintf("outputs.tags[%d]", i)
+		key, err := lookup[string](res.URN, path, tag, "key")
+		if err != nil {
+			return "", "", NodeGroup{}, err
+		}
+		if !strings.HasPrefix(key, clusterOwnershipTagPrefix) {
+			continue
+		}
+		value, err := lookup[string](res.URN, path, tag, "value")
+		if err != nil {
+			return "", "", NodeGroup{}, err
+		}
+		if name, ok := clusterNameFromTag(key, value); ok {
+			clusterName = name
+		}
+	}
+	if clusterName == "" {
+		return "", "", NodeGroup{}, nil
+	}
+
+	asgName, err := property[string](res, "outputs.name")
+	if err != nil {
+		return "", "", NodeGroup{}, err
+	}
+
+	desiredSize, err := property[float64](res, "outputs.desiredCapacity")
+	if err != nil {
+		return "", "", NodeGroup{}, err
+	}
+
+	return clusterName, asgName, NodeGroup{DesiredSize: int(desiredSize)}, nil
+}
+
+// EC2InstanceIDPattern matches both old (8 character) and new (17 character) EC2 instance IDs
+// Examples: i-1234567f, i-0123456789abcdef0
+var eC2InstanceIDPattern = regexp.MustCompile(`^i-[a-f0-9]{8,17}$`)
+
+// GetEC2InstanceIDs extracts the EC2 instance IDs from the given list of nodes.
+// It returns a map of instance IDs to nodes.
+func GetEC2InstanceIDs(nodes *corev1.NodeList) map[string]*corev1.Node {
+	if nodes == nil {
+		return nil
+	}
+
+	instanceIDToNode := make(map[string]*corev1.Node)
+
+	// Extract instance IDs from all nodes
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		// Extract EC2 instance ID from node provider ID
+		// Provider ID format: aws:///az/i-1234567890abcdef0
+		providerID := node.Spec.ProviderID
+		instanceID := strings.TrimPrefix(providerID, "aws:///")
+		instanceID = strings.TrimPrefix(instanceID, strings.Split(instanceID, "/")[0]+"/")
+
+		// the list of k8s nodes can include nodes that are not EC2 instances (e.g. Fargate),
+		// those are not part of any ASGs. We already validated that all nodes are ready, so we can
+		// skip the nodes that are not EC2 instances. Their ID is not valid input to the
+		// DescribeAutoScalingInstances API used later and would cause an error.
+		if eC2InstanceIDPattern.MatchString(instanceID) {
+			instanceIDToNode[instanceID] = node
+		}
+	}
+
+	return instanceIDToNode
+}
+
+// NodeResult identifies a node of a cluster and the EC2 instance backing it.
+type NodeResult struct {
+	Name       string `json:"name"`
+	InstanceID string `json:"instanceId"`
+}
+
+// NodeGroupResult reports how the instances of the Auto Scaling Group of a node group match the nodes of a cluster.
+type NodeGroupResult struct {
+	// Name is the name of the Auto Scaling Group.
+	Name string `json:"name"`
+	// DesiredSize is the number of instances the Auto Scaling Group is expected to have.
+	DesiredSize int `json:"desiredSize"`
+	// Nodes are the nodes that are backed by instances of the Auto Scaling Group, sorted by name.
+	Nodes []NodeResult `json:"nodes"`
+}
+
+// ValidateNodeGroupInstances validates that all nodes in the cluster are healthy and have successfully joined.
+// It checks that the Auto Scaling Groups of the node groups have the expected number of running instances, that the
+// NodeClaims of EKS Auto Mode are ready and that Fargate nodes were scheduled by the Fargate profiles of the cluster.
+func ValidateNodeGroupInstances(ctx context.Context, log Logger, clientset kubernetes.Interface, dynamicClient dynamic.Interface, asgClient AutoScalingAPI, ec2Client EC2API, clusterName string, expected Capacity) error {
+	_, err := validateNodeGroupInstances(ctx, log, clientset, dynamicClient, asgClient, ec2Client, clusterName, expected)
+	return err
+}
+
+// validateNodeGroupInstances implements ValidateNodeGroupInstances. It also returns how the instances of the expected
+// node groups match the nodes of the cluster. If a check fails, the results contain the instances found until then.
+func validateNodeGroupInstances(ctx context.Context, log Logger, clientset kubernetes.Interface, dynamicClient dynamic.Interface, asgClient AutoScalingAPI, ec2Client EC2API, clusterName string, expected Capacity) ([]NodeGroupResult, error) {
+	// The results are built from the instances found so far, so they're also returned if a check fails.
+	asgNodes := make(map[string][]NodeResult)
+	nodeGroupResults := func() []NodeGroupResult {
+		asgNames := make([]string, 0, len(expected.NodeGroups))
+		for asgName := range expected.NodeGroups {
+			asgNames = append(asgNames, asgName)
+		}
+		sort.Strings(asgNames)
+
+		results := make([]NodeGroupResult, 0, len(asgNames))
+		for _, asgName := range asgNames {
+			nodes := append([]NodeResult{}, asgNodes[asgName]...)
+			sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+			results = append(results, NodeGroupResult{
+				Name:        asgName,
+				DesiredSize: expected.NodeGroups[asgName].DesiredSize,
+				Nodes:       nodes,
+			})
+		}
+		return results
+	}
+
+	// Get all nodes in the cluster
+	nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nodeGroupResults(), fmt.Errorf("failed to list nodes: %v", err)
+	}
+
+	// Validate that all nodes are in Ready state
+	for _, node := range nodes.Items {
+		var isReady bool
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == corev1.NodeReady {
+				if condition.Status != corev1.ConditionTrue {
+					return nodeGroupResults(), fmt.Errorf("node %s is not ready: %s", node.Name, condition.Message)
+				}
+				isReady = true
+				break
+			}
+		}
+		if !isReady {
+			return nodeGroupResults(), fmt.Errorf("node %s does not have Ready condition", node.Name)
+		}
+
+		// Check for any taints that might indicate node problems
+		for _, taint := range node.Spec.Taints {
+			if taint.Effect == corev1.TaintEffectNoSchedule || taint.Effect == corev1.TaintEffectNoExecute {
+				// These taints indicate the node is not ready
+				if strings.Contains(taint.Key, "node.kubernetes.io/not-ready") ||
+					strings.Contains(taint.Key, "node.kubernetes.io/unschedulable") {
+					return nodeGroupResults(), fmt.Errorf("node %s is not ready - has taint: %s", node.Name, taint.Key)
+				}
+			}
+		}
+	}
+
+	// Auto Mode nodes are not part of any ASG, their instances are looked up through their NodeClaims.
+	instanceIDToNode := GetEC2InstanceIDs(nodes)
+	instanceIDs := make([]string, 0, len(instanceIDToNode))
+	for instanceID, node := range instanceIDToNode {
+		if node.Labels[computeTypeLabel] != "auto" {
+			instanceIDs = append(instanceIDs, instanceID)
+		}
+	}
+
+	// For each node, get its EC2 instance ID, find which ASG it belongs to and verify that EC2 reports the instance as running.
+	// Process instance IDs in batches of 50 because that's the maximum number of instance IDs that can be passed to the DescribeAutoScalingInstances API.
+	for i := 0; i < len(instanceIDs); i += 50 {
+		end := i + 50
+		if end > len(instanceIDs) {
+			end = len(instanceIDs)
+		}
+		batch := instanceIDs[i:end]
+
+		// Get ASG info for this batch of instances
+		input := &autoscaling.DescribeAutoScalingInstancesInput{
+			InstanceIds: batch,
+		}
+		result, err := asgClient.DescribeAutoScalingInstances(ctx, input)
+		if err != nil {
+			return nodeGroupResults(), fmt.Errorf("failed to describe ASG instances: %v", err)
+		}
+
+		instanceToAsg := make(map[string]string)
+		for _, instance := range result.AutoScalingInstances {
+			asgName := *instance.AutoScalingGroupName
+			asgNodes[asgName] = append(asgNodes[asgName], NodeResult{
+				Name:       instanceIDToNode[*instance.InstanceId].Name,
+				InstanceID: *instance.InstanceId,
+			})
+			instanceToAsg[*instance.InstanceId] = asgName
+		}
+
+		err = validateInstanceStatuses(ctx, ec2Client, batch, func(instanceID string) string {
+			return "ASG " + instanceToAsg[instanceID]
+		})
+		if err != nil {
+			return nodeGroupResults(), err
+		}
+	}
+
+	// Verify each ASG has the expected number of instances
+	results := nodeGroupResults()
+	var errs []error
+	for _, ng := range results {
+		if len(ng.Nodes) != ng.DesiredSize {
+			errs = append(errs, fmt.Errorf("ASG %s in cluster %s has %d instances but has a desired size of %d",
+				ng.Name,
+				clusterName,
+				len(ng.Nodes),
+				ng.DesiredSize))
+			continue
+		}
+		log.Logf("ASG %s in cluster %s has expected number of instances: %d", ng.Name, clusterName, len(ng.Nodes))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return results, err
+	}
+
+	if expected.AutoMode {
+		instanceIDToClaim, err := validateAutoModeNodes(ctx, log, dynamicClient, clusterName, nodes)
+		if err != nil {
+			return results, err
+		}
+
+		claimInstanceIDs := make([]string, 0, len(instanceIDToClaim))
+		for instanceID := range instanceIDToClaim {
+			claimInstanceIDs = append(claimInstanceIDs, instanceID)
+		}
+		for i := 0; i < len(claimInstanceIDs); i += 50 {
+			end := i + 50
+			if end > len(claimInstanceIDs) {
+				end = len(claimInstanceIDs)
+			}
+			err := validateInstanceStatuses(ctx, ec2Client, claimInstanceIDs[i:end], func(instanceID string) string {
+				return "NodeClaim " + instanceIDToClaim[instanceID]
+			})
+			if err != nil {
+				return results, err
+			}
+		}
+	}
+
+	if len(expected.FargateProfiles) > 0 {
+		if err := validateFargateNodes(log, clusterName, nodes, expected.FargateProfiles); err != nil {
+			return results, err
+		}
+	}
+
+	log.Logf("All node groups of cluster %s are healthy and have the expected number of instances", clusterName)
+
+	return results, nil
+}
+
+// validateInstanceStatuses verifies that EC2 reports the given instances as running and healthy. The owner function
+// describes the owner of an instance for error messages, e.g. "ASG my-asg".
+func validateInstanceStatuses(ctx context.Context, ec2Client EC2API, instanceIDs []string, owner func(instanceID string) string) error {
+	describeInput := &ec2.DescribeInstanceStatusInput{
+		InstanceIds: instanceIDs,
+	}
+	statusResult, err := ec2Client.DescribeInstanceStatus(ctx, describeInput)
+	if err != nil {
+		return fmt.Errorf("failed to describe instance statuses: %v", err)
+	}
+
+	for _, status := range statusResult.InstanceStatuses {
+		instanceID := *status.InstanceId
+		if status.InstanceState.Name != types.InstanceStateNameRunning {
+			return fmt.Errorf("instance %s of %s is not in running state, current state: %s", instanceID, owner(instanceID), status.InstanceState.Name)
+		}
+		if status.InstanceStatus.Status != types.SummaryStatusOk || status.SystemStatus.Status != types.SummaryStatusOk {
+			return fmt.Errorf("instance %s of %s is not healthy. Instance status: %s, System status: %s",
+				instanceID,
+				owner(instanceID),
+				status.InstanceStatus.Status,
+				status.SystemStatus.Status)
+		}
+	}
+
+	return nil
+}
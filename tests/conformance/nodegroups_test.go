@@ -0,0 +1,320 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestGetEC2InstanceIDs(t *testing.T) {
+	t.Parallel()
+
+	ec2Node := readyNode("ec2", "i-0123456789abcdef0")
+	fargateNode := readyNode("fargate", "")
+	fargateNode.Spec.ProviderID = "aws:///us-west-2a/fa1b2c3d4e-5f6a7b8c9d0e4f1a2b3c4d5e6f7a8b9c/fargate-ip-10-0-1-1.us-west-2.compute.internal"
+
+	ids := GetEC2InstanceIDs(&corev1.NodeList{Items: []corev1.Node{*ec2Node, *fargateNode}})
+
+	require.Len(t, ids, 1)
+	assert.Equal(t, "ec2", ids["i-0123456789abcdef0"].Name)
+}
+
+func TestValidateNodeGroupInstances(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		setup    func(aws *fakeAWS) []runtime.Object
+		expected Capacity
+		wantErr  string
+		logged   []string
+	}{
+		{
+			name: "healthy node groups",
+			setup: func(aws *fakeAWS) []runtime.Object {
+				aws.addInstance("ng-1", "i-00000001")
+				aws.addInstance("ng-1", "i-00000002")
+				aws.addInstance("ng-2", "i-00000003")
+				return []runtime.Object{
+					readyNode("node-1", "i-00000001"),
+					readyNode("node-2", "i-00000002"),
+					readyNode("node-3", "i-00000003"),
+				}
+			},
+			expected: Capacity{NodeGroups: map[string]NodeGroup{
+				"ng-1": {DesiredSize: 2},
+				"ng-2": {DesiredSize: 1},
+			}},
+		},
+		{
+			name: "instance that didn't join the cluster",
+			setup: func(aws *fakeAWS) []runtime.Object {
+				aws.addInstance("ng-1", "i-00000001")
+				aws.addInstance("ng-1", "i-00000002")
+				return []runtime.Object{
+					readyNode("node-1", "i-00000001"),
+				}
+			},
+			expected: Capacity{NodeGroups: map[string]NodeGroup{
+				"ng-1": {DesiredSize: 2},
+			}},
+			wantErr: "ASG ng-1 in cluster my-cluster has 1 instances but has a desired size of 2",
+		},
+		{
+			name: "node that isn't ready",
+			setup: func(aws *fakeAWS) []runtime.Object {
+				aws.addInstance("ng-1", "i-00000001")
+				node := readyNode("node-1", "i-00000001")
+				node.Status.Conditions[0].Status = corev1.ConditionFalse
+				node.Status.Conditions[0].Message = "kubelet stopped posting node status"
+				return []runtime.Object{node}
+			},
+			expected: Capacity{NodeGroups: map[string]NodeGroup{
+				"ng-1": {DesiredSize: 1},
+			}},
+			wantErr: "node node-1 is not ready: kubelet stopped posting node status",
+		},
+		{
+			name: "node with a not-ready taint",
+			setup: func(aws *fakeAWS) []runtime.Object {
+				aws.addInstance("ng-1", "i-00000001")
+				node := readyNode("node-1", "i-00000001")
+				node.Spec.Taints = []corev1.Taint{
+					{Key: "node.kubernetes.io/not-ready", Effect: corev1.TaintEffectNoSchedule},
+				}
+				return []runtime.Object{node}
+			},
+			expected: Capacity{NodeGroups: map[string]NodeGroup{
+				"ng-1": {DesiredSize: 1},
+			}},
+			wantErr: "node node-1 is not ready - has taint: node.kubernetes.io/not-ready",
+		},
+		{
+			name: "instance that isn't running",
+			setup: func(aws *fakeAWS) []runtime.Object {
+				aws.addInstance("ng-1", "i-00000001")
+				aws.instances["i-00000001"] = fakeInstance{
+					asgName: "ng-1",
+					state:   types.InstanceStateNameStopping,
+					status:  types.SummaryStatusOk,
+				}
+				return []runtime.Object{readyNode("node-1", "i-00000001")}
+			},
+			expected: Capacity{NodeGroups: map[string]NodeGroup{
+				"ng-1": {DesiredSize: 1},
+			}},
+			wantErr: "instance i-00000001 of ASG ng-1 is not in running state, current state: stopping",
+		},
+		{
+			name: "instance that is impaired",
+			setup: func(aws *fakeAWS) []runtime.Object {
+				aws.instances["i-00000001"] = fakeInstance{
+					asgName: "ng-1",
+					state:   types.InstanceStateNameRunning,
+					status:  types.SummaryStatusImpaired,
+				}
+				return []runtime.Object{readyNode("node-1", "i-00000001")}
+			},
+			expected: Capacity{NodeGroups: map[string]NodeGroup{
+				"ng-1": {DesiredSize: 1},
+			}},
+			wantErr: "instance i-00000001 of ASG ng-1 is not healthy",
+		},
+		{
+			name: "more instances than can be described at once",
+			setup: func(aws *fakeAWS) []runtime.Object {
+				var nodes []runtime.Object
+				for i := 0; i < 120; i++ {
+					id := fmt.Sprintf("i-%08x", i)
+					aws.addInstance("ng-1", id)
+					nodes = append(nodes, readyNode(fmt.Sprintf("node-%d", i), id))
+				}
+				return nodes
+			},
+			expected: Capacity{NodeGroups: map[string]NodeGroup{
+				"ng-1": {DesiredSize: 120},
+			}},
+		},
+		{
+			name: "Fargate nodes are not part of any node group",
+			setup: func(aws *fakeAWS) []runtime.Object {
+				aws.addInstance("ng-1", "i-00000001")
+				fargateNode := readyNode("fargate-1", "")
+				fargateNode.Spec.ProviderID = "aws:///us-west-2a/fa1b2c3d4e/fargate-ip-10-0-1-1.us-west-2.compute.internal"
+				return []runtime.Object{readyNode("node-1", "i-00000001"), fargateNode}
+			},
+			expected: Capacity{NodeGroups: map[string]NodeGroup{
+				"ng-1": {DesiredSize: 1},
+			}},
+		},
+		{
+			name: "Auto Mode nodes",
+			setup: func(aws *fakeAWS) []runtime.Object {
+				aws.addInstance("ng-1", "i-00000001")
+				aws.addInstance("", "i-00000002")
+				aws.addInstance("", "i-00000003")
+				return []runtime.Object{
+					readyNode("node-1", "i-00000001"),
+					autoModeNode("auto-1", "general-purpose", "i-00000002"),
+					autoModeNode("auto-2", "system", "i-00000003"),
+					readyNodeClaim("general-purpose-abcde", "general-purpose", "auto-1", "i-00000002"),
+					readyNodeClaim("system-abcde", "system", "auto-2", "i-00000003"),
+				}
+			},
+			expected: Capacity{
+				NodeGroups: map[string]NodeGroup{"ng-1": {DesiredSize: 1}},
+				AutoMode:   true,
+			},
+			logged: []string{
+				"Node pool general-purpose in cluster my-cluster has 1 ready NodeClaims",
+				"Node pool system in cluster my-cluster has 1 ready NodeClaims",
+			},
+		},
+		{
+			name: "Auto Mode without any nodes",
+			setup: func(aws *fakeAWS) []runtime.Object {
+				return nil
+			},
+			expected: Capacity{AutoMode: true},
+		},
+		{
+			name: "NodeClaim that isn't ready",
+			setup: func(aws *fakeAWS) []runtime.Object {
+				aws.addInstance("", "i-00000001")
+				claim := readyNodeClaim("general-purpose-abcde", "general-purpose", "auto-1", "i-00000001")
+				conditions := claim.Object["status"].(map[string]interface{})["conditions"].([]interface{})
+				conditions[1] = map[string]interface{}{
+					"type":    "Ready",
+					"status":  "False",
+					"reason":  "NotInitialized",
+					"message": "Initialized=False",
+				}
+				return []runtime.Object{autoModeNode("auto-1", "general-purpose", "i-00000001"), claim}
+			},
+			expected: Capacity{AutoMode: true},
+			wantErr:  "NodeClaim general-purpose-abcde of cluster my-cluster is not ready: NotInitialized Initialized=False",
+		},
+		{
+			name: "NodeClaim whose node didn't join the cluster",
+			setup: func(aws *fakeAWS) []runtime.Object {
+				aws.addInstance("", "i-00000001")
+				return []runtime.Object{readyNodeClaim("general-purpose-abcde", "general-purpose", "auto-1", "i-00000001")}
+			},
+			expected: Capacity{AutoMode: true},
+			wantErr:  `node "auto-1" of NodeClaim general-purpose-abcde has not joined cluster my-cluster`,
+		},
+		{
+			name: "Auto Mode node without NodeClaim",
+			setup: func(aws *fakeAWS) []runtime.Object {
+				aws.addInstance("", "i-00000001")
+				return []runtime.Object{autoModeNode("auto-1", "general-purpose", "i-00000001")}
+			},
+			expected: Capacity{AutoMode: true},
+			wantErr:  "Auto Mode node auto-1 of cluster my-cluster is not claimed by any NodeClaim",
+		},
+		{
+			name: "Auto Mode instance that is impaired",
+			setup: func(aws *fakeAWS) []runtime.Object {
+				aws.instances["i-00000001"] = fakeInstance{
+					state:  types.InstanceStateNameRunning,
+					status: types.SummaryStatusImpaired,
+				}
+				return []runtime.Object{
+					autoModeNode("auto-1", "general-purpose", "i-00000001"),
+					readyNodeClaim("general-purpose-abcde", "general-purpose", "auto-1", "i-00000001"),
+				}
+			},
+			expected: Capacity{AutoMode: true},
+			wantErr:  "instance i-00000001 of NodeClaim general-purpose-abcde is not healthy",
+		},
+		{
+			name: "Fargate nodes of the cluster's profiles",
+			setup: func(aws *fakeAWS) []runtime.Object {
+				return []runtime.Object{
+					fargateNode("fargate-ip-10-0-1-1", "default"),
+					fargateNode("fargate-ip-10-0-1-2", "default"),
+				}
+			},
+			expected: Capacity{FargateProfiles: []string{"default", "system"}},
+			logged: []string{
+				"Fargate profile default in cluster my-cluster has 2 nodes",
+				"Fargate profile system in cluster my-cluster has 0 nodes",
+			},
+		},
+		{
+			name: "Fargate node of an unknown profile",
+			setup: func(aws *fakeAWS) []runtime.Object {
+				return []runtime.Object{fargateNode("fargate-ip-10-0-1-1", "other")}
+			},
+			expected: Capacity{FargateProfiles: []string{"default"}},
+			wantErr:  `Fargate node fargate-ip-10-0-1-1 of cluster my-cluster was scheduled by unknown Fargate profile "other"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			aws := newFakeAWS()
+			clientset, dynamicClient := newFakeClients(tt.setup(aws)...)
+			log := &recordingLogger{}
+
+			err := ValidateNodeGroupInstances(context.Background(), log, clientset, dynamicClient, aws, aws, "my-cluster", tt.expected)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, log.contains("All node groups of cluster my-cluster are healthy"))
+			for _, message := range tt.logged {
+				assert.True(t, log.contains(message), "expected %q to be logged", message)
+			}
+		})
+	}
+}
+
+func TestValidateNodeGroupInstancesReturnsPartialResults(t *testing.T) {
+	t.Parallel()
+
+	aws := newFakeAWS()
+	aws.addInstance("ng-1", "i-00000001")
+	aws.instances["i-00000001"] = fakeInstance{
+		asgName: "ng-1",
+		state:   types.InstanceStateNameStopping,
+		status:  types.SummaryStatusOk,
+	}
+	clientset, dynamicClient := newFakeClients(readyNode("node-1", "i-00000001"))
+	expected := Capacity{NodeGroups: map[string]NodeGroup{
+		"ng-1": {DesiredSize: 1},
+		"ng-2": {DesiredSize: 1},
+	}}
+
+	results, err := validateNodeGroupInstances(context.Background(), &recordingLogger{}, clientset, dynamicClient, aws, aws, "my-cluster", expected)
+
+	require.ErrorContains(t, err, "instance i-00000001 of ASG ng-1 is not in running state")
+	assert.Equal(t, []NodeGroupResult{
+		{Name: "ng-1", DesiredSize: 1, Nodes: []NodeResult{{Name: "node-1", InstanceID: "i-00000001"}}},
+		{Name: "ng-2", DesiredSize: 1, Nodes: []NodeResult{}},
+	}, results)
+}
@@ -0,0 +1,196 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// jsonReport is the JSON representation of a Result.
+type jsonReport struct {
+	Healthy  bool          `json:"healthy"`
+	Clusters []jsonCluster `json:"clusters"`
+}
+
+type jsonCluster struct {
+	ClusterName        string            `json:"clusterName"`
+	Healthy            bool              `json:"healthy"`
+	AuthenticationMode string            `json:"authenticationMode"`
+	ServerVersion      string            `json:"serverVersion,omitempty"`
+	NodeGroups         []NodeGroupResult `json:"nodeGroups,omitempty"`
+	Workloads          []WorkloadResult  `json:"workloads,omitempty"`
+	Checks             []jsonCheck       `json:"checks"`
+}
+
+type jsonCheck struct {
+	Name            string  `json:"name"`
+	Passed          bool    `json:"passed"`
+	Error           string  `json:"error,omitempty"`
+	DurationSeconds float64 `json:"durationSeconds"`
+}
+
+// WriteJSON writes the result as an indented JSON document.
+func (r *Result) WriteJSON(w io.Writer) error {
+	report := jsonReport{
+		Healthy:  r.Healthy(),
+		Clusters: make([]jsonCluster, 0, len(r.Clusters)),
+	}
+	for _, cluster := range r.Clusters {
+		c := jsonCluster{
+			ClusterName:        cluster.ClusterName,
+			Healthy:            cluster.Healthy(),
+			AuthenticationMode: cluster.AuthenticationMode,
+			ServerVersion:      cluster.ServerVersion,
+			NodeGroups:         cluster.NodeGroups,
+			Workloads:          cluster.Workloads,
+			Checks:             make([]jsonCheck, 0, len(cluster.Checks)),
+		}
+		for _, check := range cluster.Checks {
+			jc := jsonCheck{
+				Name:            check.Name,
+				Passed:          check.Passed(),
+				DurationSeconds: check.Duration.Seconds(),
+			}
+			if check.Err != nil {
+				jc.Error = check.Err.Error()
+			}
+			c.Checks = append(c.Checks, jc)
+		}
+		report.Clusters = append(report.Clusters, c)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// The JUnit XML representation of a Result has a test suite per cluster and a test case per check.
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Time       string          `xml:"time,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	TestCases  []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the result as a JUnit XML document with a test suite per cluster and a test case per check. The
+// test cases of failed checks of node groups and workloads include the details of the node groups and unhealthy pods.
+func (r *Result) WriteJUnit(w io.Writer) error {
+	suites := junitTestSuites{Name: "conformance"}
+	var totalSeconds float64
+	for _, cluster := range r.Clusters {
+		suite := junitTestSuite{
+			Name: cluster.ClusterName,
+			Properties: []junitProperty{
+				{Name: "authenticationMode", Value: cluster.AuthenticationMode},
+				{Name: "serverVersion", Value: cluster.ServerVersion},
+			},
+		}
+
+		var suiteSeconds float64
+		for _, check := range cluster.Checks {
+			testCase := junitTestCase{
+				Name:      check.Name,
+				ClassName: cluster.ClusterName,
+				Time:      formatSeconds(check.Duration.Seconds()),
+			}
+			if check.Err != nil {
+				testCase.Failure = &junitFailure{Message: check.Err.Error(), Text: check.Err.Error()}
+				testCase.SystemOut = cluster.checkDetails(check.Name)
+				suite.Failures++
+			}
+			suite.TestCases = append(suite.TestCases, testCase)
+			suiteSeconds += check.Duration.Seconds()
+		}
+		suite.Tests = len(suite.TestCases)
+		suite.Time = formatSeconds(suiteSeconds)
+
+		suites.Suites = append(suites.Suites, suite)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		totalSeconds += suiteSeconds
+	}
+	suites.Time = formatSeconds(totalSeconds)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// checkDetails describes the node groups or the unhealthy pods of a workload that belong to a check.
+func (c ClusterResult) checkDetails(checkName string) string {
+	var lines []string
+	if checkName == CheckNodeGroups {
+		for _, ng := range c.NodeGroups {
+			lines = append(lines, fmt.Sprintf("ASG %s: %d/%d instances joined the cluster", ng.Name, len(ng.Nodes), ng.DesiredSize))
+			for _, node := range ng.Nodes {
+				lines = append(lines, fmt.Sprintf("  %s (%s)", node.Name, node.InstanceID))
+			}
+		}
+	}
+	for _, workload := range c.Workloads {
+		if checkName != workload.String() {
+			continue
+		}
+		for _, pod := range workload.UnhealthyPods {
+			lines = append(lines, pod.describe(c.ClusterName))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatSeconds(seconds float64) string {
+	return fmt.Sprintf("%.3f", seconds)
+}
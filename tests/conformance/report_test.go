@@ -0,0 +1,178 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testResult() *Result {
+	return &Result{Clusters: []ClusterResult{
+		{
+			ClusterName:        "cluster-a",
+			AuthenticationMode: "API",
+			ServerVersion:      "v1.33.1-eks-1",
+			NodeGroups: []NodeGroupResult{{
+				Name:        "asg-a",
+				DesiredSize: 1,
+				Nodes:       []NodeResult{{Name: "node-1", InstanceID: "i-0123456789abcdef0"}},
+			}},
+			Workloads: []WorkloadResult{{Kind: "deployment", Namespace: "kube-system", Name: "coredns", Ready: true}},
+			Checks: []CheckResult{
+				{Name: CheckAPIServer, Duration: time.Second},
+				{Name: CheckNodeGroups, Duration: 2 * time.Second},
+				{Name: "deployment kube-system/coredns", Duration: 500 * time.Millisecond},
+			},
+		},
+		{
+			ClusterName:        "cluster-b",
+			AuthenticationMode: "CONFIG_MAP",
+			ServerVersion:      "v1.33.1-eks-1",
+			Workloads: []WorkloadResult{{
+				Kind:      "daemonset",
+				Namespace: "kube-system",
+				Name:      "aws-node",
+				UnhealthyPods: []PodResult{{
+					Namespace: "kube-system",
+					Name:      "aws-node-abcde",
+					Phase:     "Pending",
+					Events:    []EventResult{{Type: "Warning", Reason: "FailedScheduling", Message: "0/1 nodes are available", Count: 3}},
+				}},
+			}},
+			Checks: []CheckResult{
+				{Name: CheckAPIServer, Duration: time.Second},
+				{Name: "daemonset kube-system/aws-node", Err: errors.New("daemonset kube-system/aws-node is not healthy"), Duration: time.Minute},
+			},
+		},
+	}}
+}
+
+func TestWriteJSON(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, testResult().WriteJSON(&buf))
+
+	var report jsonReport
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &report))
+
+	assert.False(t, report.Healthy)
+	require.Len(t, report.Clusters, 2)
+
+	healthy := report.Clusters[0]
+	assert.True(t, healthy.Healthy)
+	assert.Equal(t, "API", healthy.AuthenticationMode)
+	assert.Equal(t, "v1.33.1-eks-1", healthy.ServerVersion)
+	assert.Equal(t, testResult().Clusters[0].NodeGroups, healthy.NodeGroups)
+	assert.Equal(t, []jsonCheck{
+		{Name: CheckAPIServer, Passed: true, DurationSeconds: 1},
+		{Name: CheckNodeGroups, Passed: true, DurationSeconds: 2},
+		{Name: "deployment kube-system/coredns", Passed: true, DurationSeconds: 0.5},
+	}, healthy.Checks)
+
+	unhealthy := report.Clusters[1]
+	assert.False(t, unhealthy.Healthy)
+	assert.Empty(t, unhealthy.NodeGroups)
+	assert.Equal(t, testResult().Clusters[1].Workloads, unhealthy.Workloads)
+	assert.Equal(t, jsonCheck{
+		Name:            "daemonset kube-system/aws-node",
+		Error:           "daemonset kube-system/aws-node is not healthy",
+		DurationSeconds: 60,
+	}, unhealthy.Checks[1])
+}
+
+func TestWriteJUnit(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, testResult().WriteJUnit(&buf))
+	assert.Contains(t, buf.String(), xml.Header)
+
+	var suites junitTestSuites
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &suites))
+
+	assert.Equal(t, 5, suites.Tests)
+	assert.Equal(t, 1, suites.Failures)
+	assert.Equal(t, "64.500", suites.Time)
+	require.Len(t, suites.Suites, 2)
+
+	healthy := suites.Suites[0]
+	assert.Equal(t, "cluster-a", healthy.Name)
+	assert.Equal(t, 3, healthy.Tests)
+	assert.Equal(t, 0, healthy.Failures)
+	assert.Equal(t, []junitProperty{
+		{Name: "authenticationMode", Value: "API"},
+		{Name: "serverVersion", Value: "v1.33.1-eks-1"},
+	}, healthy.Properties)
+	for _, testCase := range healthy.TestCases {
+		assert.Nil(t, testCase.Failure, testCase.Name)
+		assert.Empty(t, testCase.SystemOut, testCase.Name)
+	}
+
+	unhealthy := suites.Suites[1]
+	assert.Equal(t, "cluster-b", unhealthy.Name)
+	assert.Equal(t, 1, unhealthy.Failures)
+	failed := unhealthy.TestCases[1]
+	assert.Equal(t, "daemonset kube-system/aws-node", failed.Name)
+	assert.Equal(t, "cluster-b", failed.ClassName)
+	assert.Equal(t, "60.000", failed.Time)
+	require.NotNil(t, failed.Failure)
+	assert.Equal(t, "daemonset kube-system/aws-node is not healthy", failed.Failure.Message)
+	assert.Contains(t, failed.SystemOut, "Pod kube-system/aws-node-abcde of cluster cluster-b")
+	assert.Contains(t, failed.SystemOut, "FailedScheduling")
+}
+
+func TestLogUnhealthyPodInfoEvents(t *testing.T) {
+	t.Parallel()
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "coredns-abcde", Labels: map[string]string{"app": "coredns"}},
+		Status:     corev1.PodStatus{Phase: corev1.PodPending},
+	}
+	event := func(name, podName string) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Namespace: "kube-system", Name: name},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Namespace: "kube-system", Name: podName},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "FailedScheduling",
+			Message:        "0/1 nodes are available",
+			Count:          2,
+		}
+	}
+
+	clientset, _ := newFakeClients(pod, event("coredns-abcde.1", "coredns-abcde"), event("other.1", "other"))
+	pods, err := logUnhealthyPodInfo(context.Background(), nopLogger{}, clientset, "kube-system", "app=coredns", "cluster-a")
+	require.NoError(t, err)
+
+	require.Len(t, pods, 1)
+	assert.Equal(t, "Pending", pods[0].Phase)
+	assert.Equal(t, []EventResult{{
+		Type:    corev1.EventTypeWarning,
+		Reason:  "FailedScheduling",
+		Message: "0/1 nodes are available",
+		Count:   2,
+	}}, pods[0].Events)
+}
@@ -0,0 +1,108 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+var (
+	// ErrMissingProperty is returned when a property that is required to validate a cluster is missing from the state
+	// of a resource.
+	ErrMissingProperty = errors.New("missing property")
+	// ErrUnexpectedType is returned when a property of a resource has a different type than expected.
+	ErrUnexpectedType = errors.New("unexpected property type")
+)
+
+// StateError is returned when the state of a resource in a stack export can't be interpreted. It wraps
+// ErrMissingProperty, ErrUnexpectedType or the error encountered while decoding the property.
+type StateError struct {
+	// URN is the URN of the resource.
+	URN resource.URN
+	// Property is the path of the property, e.g. "inputs.scalingConfig.desiredSize".
+	Property string
+	Err      error
+}
+
+func (e *StateError) Error() string {
+	return fmt.Sprintf("invalid state of %s: %s: %v", e.URN, e.Property, e.Err)
+}
+
+func (e *StateError) Unwrap() error {
+	return e.Err
+}
+
+// property returns the value at the given path of the inputs or outputs of a resource, e.g.
+// "outputs.scalingConfig.desiredSize". JSON numbers are stored as float64 in the state.
+func property[T any](res apitype.ResourceV3, path string) (T, error) {
+	keys := strings.Split(path, ".")
+
+	var v any
+	switch keys[0] {
+	case "inputs":
+		v = res.Inputs
+	case "outputs":
+		v = res.Outputs
+	default:
+		var zero T
+		return zero, fmt.Errorf("property path %q must start with inputs or outputs", path)
+	}
+
+	return lookup[T](res.URN, keys[0], v, keys[1:]...)
+}
+
+// lookup returns the value that is nested under the given keys of v. The path of v is used to report errors. Null
+// values are treated like missing properties.
+func lookup[T any](urn resource.URN, path string, v any, keys ...string) (T, error) {
+	var zero T
+	for _, key := range keys {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return zero, &StateError{URN: urn, Property: path, Err: fmt.Errorf("%w: expected an object, got %T", ErrUnexpectedType, v)}
+		}
+
+		path += "." + key
+		if v, ok = m[key]; !ok || v == nil {
+			return zero, &StateError{URN: urn, Property: path, Err: ErrMissingProperty}
+		}
+	}
+
+	t, ok := v.(T)
+	if !ok {
+		return zero, &StateError{URN: urn, Property: path, Err: fmt.Errorf("%w: expected %T, got %T", ErrUnexpectedType, zero, v)}
+	}
+	return t, nil
+}
+
+// mapComponentToClusterName maps the URNs of the eks:index:Cluster components to the names of their EKS clusters.
+func mapComponentToClusterName(resources []apitype.ResourceV3) (map[resource.URN]string, error) {
+	componentClusterNames := make(map[resource.URN]string)
+	for _, res := range resources {
+		if res.Type.String() != "aws:eks/cluster:Cluster" || res.Parent.Type().String() != "eks:index:Cluster" {
+			continue
+		}
+		clusterName, err := property[string](res, "outputs.name")
+		if err != nil {
+			return nil, err
+		}
+		componentClusterNames[res.Parent] = clusterName
+	}
+	return componentClusterNames, nil
+}
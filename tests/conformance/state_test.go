@@ -0,0 +1,419 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The fixtures in testdata are stack exports of the examples, trimmed down to the resources that are relevant for the
+// health checks. The kubeconfigs of the clusters are exported as stack outputs starting with "kubeconfig".
+
+// loadFixture returns the resources and the kubeconfigs of a stack export in testdata.
+func loadFixture(t *testing.T, name string) ([]apitype.ResourceV3, []any) {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+
+	var untyped apitype.UntypedDeployment
+	require.NoError(t, json.Unmarshal(data, &untyped))
+	require.Equal(t, 3, untyped.Version)
+
+	var deployment apitype.DeploymentV3
+	require.NoError(t, json.Unmarshal(untyped.Deployment, &deployment))
+
+	var kubeconfigs []any
+	for _, res := range deployment.Resources {
+		if res.Type.String() != "pulumi:pulumi:Stack" {
+			continue
+		}
+		var keys []string
+		for key := range res.Outputs {
+			if strings.HasPrefix(key, "kubeconfig") {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			kubeconfigs = append(kubeconfigs, res.Outputs[key])
+		}
+	}
+
+	return deployment.Resources, kubeconfigs
+}
+
+func TestMapFixtures(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fixture             string
+		capacity            clusterCapacityMap
+		authenticationModes clusterAuthenticationModeMap
+	}{
+		{
+			fixture: "cloudformation-nodegroup.json",
+			capacity: clusterCapacityMap{
+				"example-cluster-1-eksCluster-291f2c0": {NodeGroups: map[string]NodeGroup{"example-cluster-1-98075617-NodeGroup-rGtjFQzWEDKH": {DesiredSize: 2}}},
+				"example-cluster-2-eksCluster-d18d041": {NodeGroups: map[string]NodeGroup{"example-cluster-2-5569318c-NodeGroup-Htf8lVgIfCM2": {DesiredSize: 2}}},
+				"example-cluster-3-eksCluster-fda9817": {NodeGroups: map[string]NodeGroup{"example-cluster-3-e2523b81-NodeGroup-3HGnijVvowjr": {DesiredSize: 1}}},
+				"example-cluster-4-eksCluster-8136b4b": {NodeGroups: map[string]NodeGroup{"example-cluster-4-36a28ea8-NodeGroup-PmjqkL0YFJ2r": {DesiredSize: 2}}},
+			},
+			authenticationModes: clusterAuthenticationModeMap{
+				"example-cluster-1-eksCluster-291f2c0": "CONFIG_MAP",
+				"example-cluster-2-eksCluster-d18d041": "CONFIG_MAP",
+				"example-cluster-3-eksCluster-fda9817": "CONFIG_MAP",
+				"example-cluster-4-eksCluster-8136b4b": "CONFIG_MAP",
+			},
+		},
+		{
+			fixture: "nodegroup-v2.json",
+			capacity: clusterCapacityMap{
+				"example-cluster-1-eksCluster-8247904": {NodeGroups: map[string]NodeGroup{"example-cluster-1-c9dfbc3": {DesiredSize: 2}}},
+				"example-cluster-2-eksCluster-deb50a5": {NodeGroups: map[string]NodeGroup{"example-cluster-2-9e1c7e4": {DesiredSize: 2}}},
+				"example-cluster-3-eksCluster-0c99241": {NodeGroups: map[string]NodeGroup{"example-cluster-3-ef3ad10": {DesiredSize: 1}}},
+				"example-cluster-4-eksCluster-4df5757": {NodeGroups: map[string]NodeGroup{"example-cluster-4-fdd1df9": {DesiredSize: 2}}},
+			},
+			authenticationModes: clusterAuthenticationModeMap{
+				"example-cluster-1-eksCluster-8247904": "CONFIG_MAP",
+				"example-cluster-2-eksCluster-deb50a5": "CONFIG_MAP",
+				"example-cluster-3-eksCluster-0c99241": "CONFIG_MAP",
+				"example-cluster-4-eksCluster-4df5757": "CONFIG_MAP",
+			},
+		},
+		{
+			fixture: "managed-nodegroup.json",
+			capacity: clusterCapacityMap{
+				"example-managed-nodegroups-eksCluster-35ddec1": {NodeGroups: map[string]NodeGroup{
+					"eks-aws-managed-ng1-46c96a2b-7a43-86dc-1ea3-be8d16c445d1":             {DesiredSize: 2},
+					"eks-aws-managed-ng2-7cc96a2b-7ab1-2038-ed53-33eeeed6574c":             {DesiredSize: 1},
+					"eks-example-managed-ng0-fef99ee-c2c96a2b-7c3a-411f-7263-9a1e4f7ce38f": {DesiredSize: 2},
+					"eks-example-managed-ng3-f80d68f-16c96a2b-7950-249c-dbdb-5547c3d420a1": {DesiredSize: 2},
+					"eks-example-managed-ng4-480d986-1ac96a2b-7c3e-3e4c-6485-7867310c2aa9": {DesiredSize: 2},
+				}},
+			},
+			authenticationModes: clusterAuthenticationModeMap{
+				"example-managed-nodegroups-eksCluster-35ddec1": "CONFIG_MAP",
+			},
+		},
+		{
+			fixture: "fargate.json",
+			capacity: clusterCapacityMap{
+				"example-cluster-fargate-eksCluster-bad46bb": {
+					NodeGroups:      map[string]NodeGroup{},
+					FargateProfiles: []string{"example-cluster-fargate-fargateProfile-d0f6470"},
+				},
+			},
+			authenticationModes: clusterAuthenticationModeMap{
+				"example-cluster-fargate-eksCluster-bad46bb": "CONFIG_MAP",
+			},
+		},
+		{
+			fixture: "auto-mode.json",
+			capacity: clusterCapacityMap{
+				"example-cluster-3-eksCluster-0c99241": {NodeGroups: map[string]NodeGroup{}, AutoMode: true},
+			},
+			authenticationModes: clusterAuthenticationModeMap{
+				"example-cluster-3-eksCluster-0c99241": "API",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			t.Parallel()
+
+			resources, kubeconfigs := loadFixture(t, tt.fixture)
+
+			capacity, err := mapClusterToCapacity(resources)
+			require.NoError(t, err)
+			assert.Equal(t, tt.capacity, capacity)
+
+			authenticationModes, err := mapClusterToAuthenticationMode(resources)
+			require.NoError(t, err)
+			assert.Equal(t, tt.authenticationModes, authenticationModes)
+
+			// Every cluster of the stack can be reached through one of its kubeconfigs.
+			kubeAccess, err := mapClusterToKubeAccess(kubeconfigs...)
+			require.NoError(t, err)
+			var clusterNames []string
+			for clusterName, access := range kubeAccess {
+				clusterNames = append(clusterNames, clusterName)
+				assert.NotNil(t, access.Clientset)
+			}
+			var expectedClusterNames []string
+			for clusterName := range tt.authenticationModes {
+				expectedClusterNames = append(expectedClusterNames, clusterName)
+			}
+			assert.ElementsMatch(t, expectedClusterNames, clusterNames)
+		})
+	}
+}
+
+func TestMapMalformedState(t *testing.T) {
+	t.Parallel()
+
+	cluster := func(inputs, outputs map[string]any) apitype.ResourceV3 {
+		return apitype.ResourceV3{
+			URN:     "urn:pulumi:test::example::aws:eks/cluster:Cluster::cluster",
+			Type:    "aws:eks/cluster:Cluster",
+			Inputs:  inputs,
+			Outputs: outputs,
+		}
+	}
+	managedNodeGroup := func(inputs, outputs map[string]any) apitype.ResourceV3 {
+		return apitype.ResourceV3{
+			URN:     "urn:pulumi:test::example::aws:eks/nodeGroup:NodeGroup::mng",
+			Type:    "aws:eks/nodeGroup:NodeGroup",
+			Inputs:  inputs,
+			Outputs: outputs,
+		}
+	}
+	autoScalingGroup := func(outputs map[string]any) apitype.ResourceV3 {
+		return apitype.ResourceV3{
+			URN:     "urn:pulumi:test::example::aws:autoscaling/group:Group::asg",
+			Type:    "aws:autoscaling/group:Group",
+			Outputs: outputs,
+		}
+	}
+	cloudFormationStack := func(outputs map[string]any) apitype.ResourceV3 {
+		return apitype.ResourceV3{
+			URN:     "urn:pulumi:test::example::aws:cloudformation/stack:Stack::cfn",
+			Type:    "aws:cloudformation/stack:Stack",
+			ID:      "arn:aws:cloudformation:us-west-2:123456789012:stack/cfn/1",
+			Outputs: outputs,
+		}
+	}
+	mapCapacity := func(resources []apitype.ResourceV3) error {
+		_, err := mapClusterToCapacity(resources)
+		return err
+	}
+	clusterTemplateBody := `
+Resources:
+  NodeGroup:
+    Properties:
+      DesiredCapacity: 2
+      Tags:
+        - Key: kubernetes.io/cluster/cluster
+          Value: owned
+`
+	mapAuthenticationModes := func(resources []apitype.ResourceV3) error {
+		_, err := mapClusterToAuthenticationMode(resources)
+		return err
+	}
+
+	tests := []struct {
+		name     string
+		mapper   func([]apitype.ResourceV3) error
+		resource apitype.ResourceV3
+		property string
+		// sentinel is the error that is wrapped, if any.
+		sentinel error
+	}{
+		{
+			name:     "cluster without name",
+			mapper:   mapAuthenticationModes,
+			resource: cluster(nil, map[string]any{}),
+			property: "outputs.name",
+			sentinel: ErrMissingProperty,
+		},
+		{
+			name:     "access config that isn't an object",
+			mapper:   mapAuthenticationModes,
+			resource: cluster(map[string]any{"accessConfig": "API"}, map[string]any{"name": "cluster"}),
+			property: "inputs.accessConfig",
+			sentinel: ErrUnexpectedType,
+		},
+		{
+			name:     "compute config that isn't a boolean",
+			mapper:   mapCapacity,
+			resource: cluster(nil, map[string]any{"name": "cluster", "computeConfig": map[string]any{"enabled": "true"}}),
+			property: "outputs.computeConfig.enabled",
+			sentinel: ErrUnexpectedType,
+		},
+		{
+			name:   "desired size that isn't a number",
+			mapper: mapCapacity,
+			resource: managedNodeGroup(map[string]any{
+				"clusterName":   "cluster",
+				"scalingConfig": map[string]any{"desiredSize": "2"},
+			}, nil),
+			property: "inputs.scalingConfig.desiredSize",
+			sentinel: ErrUnexpectedType,
+		},
+		{
+			name:   "managed node group without resources",
+			mapper: mapCapacity,
+			resource: managedNodeGroup(map[string]any{
+				"clusterName":   "cluster",
+				"scalingConfig": map[string]any{"desiredSize": 2.0},
+			}, map[string]any{}),
+			property: "outputs.resources",
+			sentinel: ErrMissingProperty,
+		},
+		{
+			name:   "managed node group without auto scaling groups",
+			mapper: mapCapacity,
+			resource: managedNodeGroup(map[string]any{
+				"clusterName":   "cluster",
+				"scalingConfig": map[string]any{"desiredSize": 2.0},
+			}, map[string]any{"resources": []any{map[string]any{"autoscalingGroups": []any{}}}}),
+			property: "outputs.resources[].autoscalingGroups[].name",
+			sentinel: ErrMissingProperty,
+		},
+		{
+			name:   "tag that isn't an object",
+			mapper: mapCapacity,
+			resource: autoScalingGroup(map[string]any{
+				"tags": []any{"Name"},
+			}),
+			property: "outputs.tags[0]",
+			sentinel: ErrUnexpectedType,
+		},
+		{
+			name:   "null desired capacity",
+			mapper: mapCapacity,
+			resource: autoScalingGroup(map[string]any{
+				"tags":            []any{map[string]any{"key": "kubernetes.io/cluster/cluster", "value": "owned"}},
+				"name":            "asg",
+				"desiredCapacity": nil,
+			}),
+			property: "outputs.desiredCapacity",
+			sentinel: ErrMissingProperty,
+		},
+		{
+			name:     "template body that isn't a string",
+			mapper:   mapCapacity,
+			resource: cloudFormationStack(map[string]any{"templateBody": 42.0}),
+			property: "outputs.templateBody",
+			sentinel: ErrUnexpectedType,
+		},
+		{
+			name:     "template body that isn't valid YAML",
+			mapper:   mapCapacity,
+			resource: cloudFormationStack(map[string]any{"templateBody": "Resources: ["}),
+			property: "outputs.templateBody",
+		},
+		{
+			name:     "CloudFormation stack without node group output",
+			mapper:   mapCapacity,
+			resource: cloudFormationStack(map[string]any{"templateBody": clusterTemplateBody, "outputs": map[string]any{}}),
+			property: "outputs.outputs.NodeGroup",
+			sentinel: ErrMissingProperty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.mapper([]apitype.ResourceV3{tt.resource})
+			require.Error(t, err)
+
+			var stateErr *StateError
+			require.ErrorAs(t, err, &stateErr)
+			assert.Equal(t, tt.resource.URN, stateErr.URN)
+			assert.Equal(t, tt.property, stateErr.Property)
+			if tt.sentinel != nil {
+				assert.ErrorIs(t, err, tt.sentinel)
+			}
+		})
+	}
+}
+
+func TestMapClusterToKubeAccessWithoutClusterName(t *testing.T) {
+	t.Parallel()
+
+	kubeconfig := func(user map[string]any) map[string]any {
+		return map[string]any{
+			"apiVersion": "v1",
+			"clusters": []any{map[string]any{
+				"name":    "kubernetes",
+				"cluster": map[string]any{"server": "https://example.eks.amazonaws.com"},
+			}},
+			"contexts": []any{map[string]any{
+				"name":    "aws",
+				"context": map[string]any{"cluster": "kubernetes", "user": "aws"},
+			}},
+			"current-context": "aws",
+			"kind":            "Config",
+			"users":           []any{map[string]any{"name": "aws", "user": user}},
+		}
+	}
+
+	tests := []struct {
+		name string
+		user map[string]any
+	}{
+		{
+			name: "token",
+			user: map[string]any{"token": "secret"},
+		},
+		{
+			name: "missing cluster name arg",
+			user: map[string]any{"exec": map[string]any{
+				"apiVersion": "client.authentication.k8s.io/v1beta1",
+				"command":    "aws",
+				"args":       []any{"eks", "get-token", "--cluster-name"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := mapClusterToKubeAccess(kubeconfig(tt.user))
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrClusterNameNotFound)
+		})
+	}
+}
+
+func TestMapClusterToCapacityWithoutClusterTag(t *testing.T) {
+	t.Parallel()
+
+	// Auto Scaling Groups and CloudFormation stacks that aren't owned by a cluster are not node groups.
+	resources := []apitype.ResourceV3{
+		{
+			URN:  "urn:pulumi:test::example::aws:autoscaling/group:Group::asg",
+			Type: "aws:autoscaling/group:Group",
+			Outputs: map[string]any{
+				"tags":            []any{map[string]any{"key": "Name", "value": "web-worker"}},
+				"name":            "web",
+				"desiredCapacity": 3.0,
+			},
+		},
+		{
+			URN:     "urn:pulumi:test::example::aws:cloudformation/stack:Stack::cfn",
+			Type:    "aws:cloudformation/stack:Stack",
+			ID:      "arn:aws:cloudformation:us-west-2:123456789012:stack/cfn/1",
+			Outputs: map[string]any{"templateBody": "Resources: {}"},
+		},
+	}
+
+	capacity, err := mapClusterToCapacity(resources)
+	require.NoError(t, err)
+	assert.Empty(t, capacity)
+}
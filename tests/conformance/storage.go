@@ -0,0 +1,470 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/kubernetes"
+)
+
+// StorageClass is a StorageClass of the `storageClasses` of a cluster, described by the inputs of its
+// eks:index:StorageClass.
+type StorageClass struct {
+	// Key is the key of the StorageClass in the `storageClasses` of the cluster, or the volume type if a single
+	// volume type was given.
+	Key string
+	// Name is the name of the Kubernetes StorageClass.
+	Name string
+	// Type is the EBS volume type, e.g. "gp2".
+	Type      string
+	Encrypted bool
+	// KmsKeyID is the ARN of the KMS key that encrypts the volumes. It is empty if the volumes are encrypted with the
+	// default key.
+	KmsKeyID             string
+	AllowVolumeExpansion bool
+	// VolumeBindingMode is "Immediate" or "WaitForFirstConsumer".
+	VolumeBindingMode storagev1.VolumeBindingMode
+	// ReclaimPolicy is "Delete" or "Retain".
+	ReclaimPolicy corev1.PersistentVolumeReclaimPolicy
+}
+
+// clusterStorageClassMap implements a map of Kubernetes cluster names to their StorageClasses.
+type clusterStorageClassMap map[string][]StorageClass
+
+// mapClusterToStorageClasses looks up the StorageClasses that eks:index:Cluster components created for their
+// `storageClasses`. The StorageClasses are children of the components and named after them and their keys. Their
+// inputs are the inputs of the eks:index:StorageClass, translated into StorageClass parameters.
+func mapClusterToStorageClasses(resources []apitype.ResourceV3) (clusterStorageClassMap, error) {
+	componentClusterNames, err := mapComponentToClusterName(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterStorageClasses := make(clusterStorageClassMap)
+	for _, res := range resources {
+		if res.Type.String() != "kubernetes:storage.k8s.io/v1:StorageClass" {
+			continue
+		}
+		clusterName, ok := componentClusterNames[res.Parent]
+		if !ok {
+			// Not created for the `storageClasses` of a cluster.
+			continue
+		}
+
+		storageClass, err := storageClassFromState(res)
+		if err != nil {
+			return nil, err
+		}
+		clusterStorageClasses[clusterName] = append(clusterStorageClasses[clusterName], storageClass)
+	}
+
+	for _, storageClasses := range clusterStorageClasses {
+		sort.Slice(storageClasses, func(i, j int) bool { return storageClasses[i].Key < storageClasses[j].Key })
+	}
+	return clusterStorageClasses, nil
+}
+
+func storageClassFromState(res apitype.ResourceV3) (StorageClass, error) {
+	// The StorageClasses are named "<component name>-<key>", with the component name in lower case.
+	storageClass := StorageClass{
+		Key:               strings.TrimPrefix(res.URN.Name(), strings.ToLower(res.Parent.Name())+"-"),
+		VolumeBindingMode: storagev1.VolumeBindingImmediate,
+		ReclaimPolicy:     corev1.PersistentVolumeReclaimDelete,
+	}
+
+	var err error
+	if storageClass.Name, err = property[string](res, "outputs.metadata.name"); err != nil {
+		return StorageClass{}, err
+	}
+	if storageClass.Type, err = property[string](res, "outputs.parameters.type"); err != nil {
+		return StorageClass{}, err
+	}
+
+	encrypted, err := property[string](res, "outputs.parameters.encrypted")
+	if err == nil {
+		if storageClass.Encrypted, err = strconv.ParseBool(encrypted); err != nil {
+			return StorageClass{}, &StateError{URN: res.URN, Property: "outputs.parameters.encrypted", Err: err}
+		}
+	} else if !errors.Is(err, ErrMissingProperty) {
+		return StorageClass{}, err
+	}
+
+	storageClass.KmsKeyID, err = property[string](res, "outputs.parameters.kmsKeyId")
+	if err != nil && !errors.Is(err, ErrMissingProperty) {
+		return StorageClass{}, err
+	}
+
+	storageClass.AllowVolumeExpansion, err = property[bool](res, "outputs.allowVolumeExpansion")
+	if err != nil && !errors.Is(err, ErrMissingProperty) {
+		return StorageClass{}, err
+	}
+
+	bindingMode, err := property[string](res, "outputs.volumeBindingMode")
+	if err == nil {
+		storageClass.VolumeBindingMode = storagev1.VolumeBindingMode(bindingMode)
+	} else if !errors.Is(err, ErrMissingProperty) {
+		return StorageClass{}, err
+	}
+
+	reclaimPolicy, err := property[string](res, "outputs.reclaimPolicy")
+	if err == nil {
+		storageClass.ReclaimPolicy = corev1.PersistentVolumeReclaimPolicy(reclaimPolicy)
+	} else if !errors.Is(err, ErrMissingProperty) {
+		return StorageClass{}, err
+	}
+
+	return storageClass, nil
+}
+
+// filterStorageClasses keeps the StorageClasses with the given keys. All StorageClasses are kept if no keys are given.
+// It fails if no cluster has a StorageClass with one of the keys.
+func filterStorageClasses(clusterStorageClasses clusterStorageClassMap, keys []string) (clusterStorageClassMap, error) {
+	if len(keys) == 0 {
+		return clusterStorageClasses, nil
+	}
+
+	found := make(map[string]bool)
+	filtered := make(clusterStorageClassMap)
+	for clusterName, storageClasses := range clusterStorageClasses {
+		for _, storageClass := range storageClasses {
+			if slices.Contains(keys, storageClass.Key) {
+				filtered[clusterName] = append(filtered[clusterName], storageClass)
+				found[storageClass.Key] = true
+			}
+		}
+	}
+
+	for _, key := range keys {
+		if !found[key] {
+			return nil, fmt.Errorf("no cluster has a storage class with key %q", key)
+		}
+	}
+	return filtered, nil
+}
+
+// minVolumeSizeGiB is the minimum size of the EBS volume types, in GiB.
+var minVolumeSizeGiB = map[string]int64{
+	"io1": 4,
+	"io2": 4,
+	"st1": 125,
+	"sc1": 125,
+}
+
+// storageCheckName returns the name of the check of a StorageClass, e.g. "storageclass mygp2".
+func storageCheckName(storageClass StorageClass) string {
+	return "storageclass " + storageClass.Key
+}
+
+// ValidateStorageClass validates that a StorageClass provisions the EBS volumes it describes. It creates a
+// PersistentVolumeClaim and a pod that consumes it, and validates that the claim is bound according to the volume
+// binding mode. Then it validates the type, encryption and KMS key of the EBS volume through the EC2 API. If the
+// StorageClass allows volume expansion, the claim is resized and the size of the volume validated, otherwise the
+// resize has to be rejected. The claim and pod are deleted before returning, as are volumes that are retained by the
+// reclaim policy.
+func ValidateStorageClass(ctx context.Context, log Logger, clientset kubernetes.Interface, ec2Client EC2API, clusterName string, expected StorageClass) error {
+	storageClass, err := clientset.StorageV1().StorageClasses().Get(ctx, expected.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get StorageClass %s of cluster %s: %v", expected.Name, clusterName, err)
+	}
+	bindingMode := storagev1.VolumeBindingImmediate
+	if storageClass.VolumeBindingMode != nil {
+		bindingMode = *storageClass.VolumeBindingMode
+	}
+	if bindingMode != expected.VolumeBindingMode {
+		return fmt.Errorf("expected StorageClass %s of cluster %s to have volume binding mode %s, got %s", expected.Name, clusterName, expected.VolumeBindingMode, bindingMode)
+	}
+
+	namespace, err := clientset.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "conformance-storage-" + rand.String(5)},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create namespace for StorageClass %s in cluster %s: %v", expected.Name, clusterName, err)
+	}
+
+	// The name of the PersistentVolume, once the claim is bound.
+	var volumeName string
+	cleanups := newCleanups(fmt.Sprintf("StorageClass %s validation of cluster %s", expected.Name, clusterName), 5*time.Minute)
+	defer cleanups.run(ctx, log)
+	cleanups.add(func(ctx context.Context) error {
+		return cleanupStorage(ctx, log, clientset, ec2Client, clusterName, namespace.Name, volumeName)
+	})
+
+	size := resource.MustParse(fmt.Sprintf("%dGi", max(minVolumeSizeGiB[expected.Type], 1)))
+	claim, err := clientset.CoreV1().PersistentVolumeClaims(namespace.Name).Create(ctx, &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "claim"},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: aws.String(expected.Name),
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: size},
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create PersistentVolumeClaim for StorageClass %s in cluster %s: %v", expected.Name, clusterName, err)
+	}
+
+	switch bindingMode {
+	case storagev1.VolumeBindingImmediate:
+		// The claim is bound without a consumer.
+		if claim, err = waitForClaimBound(ctx, clientset, claim); err != nil {
+			return fmt.Errorf("StorageClass %s of cluster %s: %w", expected.Name, clusterName, err)
+		}
+		volumeName = claim.Spec.VolumeName
+	case storagev1.VolumeBindingWaitForFirstConsumer:
+		// The claim is only bound once a pod consumes it.
+		if claim.Status.Phase == corev1.ClaimBound || claim.Spec.VolumeName != "" {
+			return fmt.Errorf("expected PersistentVolumeClaim of StorageClass %s in cluster %s to wait for its first consumer, but it is %s", expected.Name, clusterName, claim.Status.Phase)
+		}
+	}
+
+	pod, err := clientset.CoreV1().Pods(namespace.Name).Create(ctx, storageConsumerPod(claim.Name), metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create consumer pod for StorageClass %s in cluster %s: %v", expected.Name, clusterName, err)
+	}
+	err = RetryWithExponentialBackoff(ctx, time.Second, func() error {
+		if pod, err = clientset.CoreV1().Pods(namespace.Name).Get(ctx, pod.Name, metav1.GetOptions{}); err != nil {
+			return fmt.Errorf("failed to get consumer pod of StorageClass %s in cluster %s: %v", expected.Name, clusterName, err)
+		}
+		if !isPodReady(pod) {
+			return fmt.Errorf("consumer pod of StorageClass %s in cluster %s is not ready: %s", expected.Name, clusterName, describePodStatus(pod))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if claim, err = waitForClaimBound(ctx, clientset, claim); err != nil {
+		return fmt.Errorf("StorageClass %s of cluster %s: %w", expected.Name, clusterName, err)
+	}
+	volumeName = claim.Spec.VolumeName
+
+	volumeID, err := ebsVolumeID(ctx, clientset, volumeName)
+	if err != nil {
+		return fmt.Errorf("StorageClass %s of cluster %s: %w", expected.Name, clusterName, err)
+	}
+	volume, err := describeVolume(ctx, ec2Client, volumeID)
+	if err != nil {
+		return fmt.Errorf("StorageClass %s of cluster %s: %w", expected.Name, clusterName, err)
+	}
+
+	var errs []error
+	if string(volume.VolumeType) != expected.Type {
+		errs = append(errs, fmt.Errorf("expected volume %s to have type %s, got %s", volumeID, expected.Type, volume.VolumeType))
+	}
+	if aws.ToBool(volume.Encrypted) != expected.Encrypted {
+		errs = append(errs, fmt.Errorf("expected volume %s to be encrypted: %t, got %t", volumeID, expected.Encrypted, aws.ToBool(volume.Encrypted)))
+	}
+	if expected.KmsKeyID != "" && aws.ToString(volume.KmsKeyId) != expected.KmsKeyID {
+		errs = append(errs, fmt.Errorf("expected volume %s to be encrypted with KMS key %s, got %s", volumeID, expected.KmsKeyID, aws.ToString(volume.KmsKeyId)))
+	}
+	if bindingMode == storagev1.VolumeBindingWaitForFirstConsumer {
+		if err := validateVolumeZone(ctx, clientset, volume, pod.Spec.NodeName); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("StorageClass %s of cluster %s provisioned an unexpected volume: %w", expected.Name, clusterName, errors.Join(errs...))
+	}
+	log.Logf("StorageClass %s of cluster %s provisioned %s volume %s in %s", expected.Name, clusterName, volume.VolumeType, volumeID, aws.ToString(volume.AvailabilityZone))
+
+	if err := validateVolumeExpansion(ctx, log, clientset, ec2Client, claim, volumeID, expected); err != nil {
+		return fmt.Errorf("StorageClass %s of cluster %s: %w", expected.Name, clusterName, err)
+	}
+	return nil
+}
+
+// storageConsumerPod returns a pod that writes to the volume of a PersistentVolumeClaim. It only becomes ready once
+// the write succeeded.
+func storageConsumerPod(claimName string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "consumer"},
+		Spec: corev1.PodSpec{
+			NodeSelector: map[string]string{corev1.LabelOSStable: "linux"},
+			Containers: []corev1.Container{{
+				Name:    "consumer",
+				Image:   DefaultProbeImage,
+				Command: []string{"/bin/sh", "-c", "echo conformance > /data/probe && exec /agnhost pause"},
+				ReadinessProbe: &corev1.Probe{
+					ProbeHandler: corev1.ProbeHandler{
+						Exec: &corev1.ExecAction{Command: []string{"cat", "/data/probe"}},
+					},
+				},
+				VolumeMounts: []corev1.VolumeMount{{Name: "data", MountPath: "/data"}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "data",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+				},
+			}},
+		},
+	}
+}
+
+func waitForClaimBound(ctx context.Context, clientset kubernetes.Interface, claim *corev1.PersistentVolumeClaim) (*corev1.PersistentVolumeClaim, error) {
+	err := RetryWithExponentialBackoff(ctx, time.Second, func() error {
+		c, err := clientset.CoreV1().PersistentVolumeClaims(claim.Namespace).Get(ctx, claim.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get PersistentVolumeClaim %s/%s: %v", claim.Namespace, claim.Name, err)
+		}
+		if c.Status.Phase != corev1.ClaimBound {
+			return fmt.Errorf("PersistentVolumeClaim %s/%s is %s", claim.Namespace, claim.Name, c.Status.Phase)
+		}
+		claim = c
+		return nil
+	})
+	return claim, err
+}
+
+// ebsVolumeID returns the ID of the EBS volume of a PersistentVolume. The volumes of StorageClasses with the in-tree
+// kubernetes.io/aws-ebs provisioner are provisioned by the EBS CSI driver since the CSI migration.
+func ebsVolumeID(ctx context.Context, clientset kubernetes.Interface, volumeName string) (string, error) {
+	volume, err := clientset.CoreV1().PersistentVolumes().Get(ctx, volumeName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get PersistentVolume %s: %v", volumeName, err)
+	}
+
+	switch {
+	case volume.Spec.CSI != nil:
+		return volume.Spec.CSI.VolumeHandle, nil
+	case volume.Spec.AWSElasticBlockStore != nil:
+		// Volume ID format: aws://us-west-2a/vol-1234567890abcdef0
+		id := volume.Spec.AWSElasticBlockStore.VolumeID
+		return id[strings.LastIndex(id, "/")+1:], nil
+	default:
+		return "", fmt.Errorf("PersistentVolume %s is not an EBS volume", volumeName)
+	}
+}
+
+func describeVolume(ctx context.Context, ec2Client EC2API, volumeID string) (types.Volume, error) {
+	resp, err := ec2Client.DescribeVolumes(ctx, &ec2.DescribeVolumesInput{VolumeIds: []string{volumeID}})
+	if err != nil {
+		return types.Volume{}, fmt.Errorf("failed to describe volume %s: %v", volumeID, err)
+	}
+	if len(resp.Volumes) != 1 {
+		return types.Volume{}, fmt.Errorf("volume %s not found", volumeID)
+	}
+	return resp.Volumes[0], nil
+}
+
+// validateVolumeZone validates that a volume was provisioned in the availability zone of the node of its first
+// consumer.
+func validateVolumeZone(ctx context.Context, clientset kubernetes.Interface, volume types.Volume, nodeName string) error {
+	node, err := clientset.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get node %s: %v", nodeName, err)
+	}
+	if zone := node.Labels[corev1.LabelTopologyZone]; zone != aws.ToString(volume.AvailabilityZone) {
+		return fmt.Errorf("expected volume %s to be provisioned in zone %s of its first consumer on node %s, got %s", aws.ToString(volume.VolumeId), zone, nodeName, aws.ToString(volume.AvailabilityZone))
+	}
+	return nil
+}
+
+// validateVolumeExpansion resizes a bound PersistentVolumeClaim by 1GiB. If the StorageClass allows volume expansion,
+// it validates that the claim and its EBS volume are resized, otherwise that the resize is rejected.
+func validateVolumeExpansion(ctx context.Context, log Logger, clientset kubernetes.Interface, ec2Client EC2API, claim *corev1.PersistentVolumeClaim, volumeID string, expected StorageClass) error {
+	size := claim.Spec.Resources.Requests[corev1.ResourceStorage]
+	size.Add(resource.MustParse("1Gi"))
+
+	resized := claim.DeepCopy()
+	resized.Spec.Resources.Requests[corev1.ResourceStorage] = size
+	_, err := clientset.CoreV1().PersistentVolumeClaims(claim.Namespace).Update(ctx, resized, metav1.UpdateOptions{})
+	if !expected.AllowVolumeExpansion {
+		if err == nil {
+			return fmt.Errorf("expected the resize of PersistentVolumeClaim %s/%s to be rejected, because volume expansion is not allowed", claim.Namespace, claim.Name)
+		}
+		log.Logf("Resize of PersistentVolumeClaim %s/%s was rejected as expected: %v", claim.Namespace, claim.Name, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to resize PersistentVolumeClaim %s/%s: %v", claim.Namespace, claim.Name, err)
+	}
+
+	return RetryWithExponentialBackoff(ctx, time.Second, func() error {
+		c, err := clientset.CoreV1().PersistentVolumeClaims(claim.Namespace).Get(ctx, claim.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get PersistentVolumeClaim %s/%s: %v", claim.Namespace, claim.Name, err)
+		}
+		if capacity := c.Status.Capacity[corev1.ResourceStorage]; capacity.Cmp(size) < 0 {
+			return fmt.Errorf("PersistentVolumeClaim %s/%s has not been resized to %s, its capacity is %s", claim.Namespace, claim.Name, size.String(), capacity.String())
+		}
+
+		volume, err := describeVolume(ctx, ec2Client, volumeID)
+		if err != nil {
+			return err
+		}
+		if wantGiB := size.Value() >> 30; int64(aws.ToInt32(volume.Size)) != wantGiB {
+			return fmt.Errorf("expected volume %s to be resized to %dGiB, got %dGiB", volumeID, wantGiB, aws.ToInt32(volume.Size))
+		}
+		log.Logf("PersistentVolumeClaim %s/%s and volume %s were resized to %s", claim.Namespace, claim.Name, volumeID, size.String())
+		return nil
+	})
+}
+
+// cleanupStorage deletes the namespace of the StorageClass validation. Deleting the claim deletes its volume, unless
+// it is retained by the reclaim policy of the StorageClass. Retained volumes are deleted explicitly.
+func cleanupStorage(ctx context.Context, log Logger, clientset kubernetes.Interface, ec2Client EC2API, clusterName, namespace, volumeName string) error {
+	var errs []error
+	if err := clientset.CoreV1().Namespaces().Delete(ctx, namespace, metav1.DeleteOptions{}); err != nil {
+		errs = append(errs, fmt.Errorf("failed to delete namespace %s: %v", namespace, err))
+	}
+	if volumeName == "" {
+		return errors.Join(errs...)
+	}
+
+	volume, err := clientset.CoreV1().PersistentVolumes().Get(ctx, volumeName, metav1.GetOptions{})
+	if err != nil || volume.Spec.PersistentVolumeReclaimPolicy != corev1.PersistentVolumeReclaimRetain {
+		// The volume is deleted together with the claim.
+		return errors.Join(errs...)
+	}
+
+	volumeID, err := ebsVolumeID(ctx, clientset, volumeName)
+	if err != nil {
+		return errors.Join(append(errs, fmt.Errorf("failed to find the volume of retained PersistentVolume %s: %v", volumeName, err))...)
+	}
+	if err := clientset.CoreV1().PersistentVolumes().Delete(ctx, volumeName, metav1.DeleteOptions{}); err != nil {
+		errs = append(errs, fmt.Errorf("failed to delete retained PersistentVolume %s: %v", volumeName, err))
+	}
+
+	// The volume can only be deleted once it was detached from the node of the consumer pod.
+	err = RetryWithExponentialBackoff(ctx, 5*time.Second, func() error {
+		_, err := ec2Client.DeleteVolume(ctx, &ec2.DeleteVolumeInput{VolumeId: aws.String(volumeID)})
+		return err
+	})
+	if err != nil {
+		return errors.Join(append(errs, fmt.Errorf("failed to delete retained volume %s: %v", volumeID, err))...)
+	}
+	log.Logf("Deleted retained volume %s of cluster %s", volumeID, clusterName)
+	return errors.Join(errs...)
+}
@@ -0,0 +1,419 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+)
+
+// storageClassResources returns the state of a cluster component with storage classes. Like the component, it names
+// the StorageClasses after the lower case component name.
+func storageClassResources(clusterComponent string, storageClasses map[string]map[string]any) []apitype.ResourceV3 {
+	componentURN := resource.URN("urn:pulumi:test::storage-classes::eks:index:Cluster::" + clusterComponent)
+	resources := []apitype.ResourceV3{
+		{URN: componentURN, Type: "eks:index:Cluster"},
+		{
+			URN:     resource.URN("urn:pulumi:test::storage-classes::eks:index:Cluster$aws:eks/cluster:Cluster::" + clusterComponent + "-eksCluster"),
+			Type:    "aws:eks/cluster:Cluster",
+			Parent:  componentURN,
+			Outputs: map[string]any{"name": clusterComponent + "-eksCluster-1234567"},
+		},
+	}
+	for key, outputs := range storageClasses {
+		resources = append(resources, apitype.ResourceV3{
+			URN:     resource.URN("urn:pulumi:test::storage-classes::eks:index:Cluster$kubernetes:storage.k8s.io/v1:StorageClass::" + strings.ToLower(clusterComponent) + "-" + key),
+			Type:    "kubernetes:storage.k8s.io/v1:StorageClass",
+			Parent:  componentURN,
+			Outputs: outputs,
+		})
+	}
+	return resources
+}
+
+func TestMapClusterToStorageClasses(t *testing.T) {
+	t.Parallel()
+
+	resources := append(
+		storageClassResources("storage-classes-1", map[string]map[string]any{
+			"io1": {
+				"metadata":   map[string]any{"name": "storage-classes-1-io1-abcdefg"},
+				"parameters": map[string]any{"type": "io1"},
+			},
+		}),
+		storageClassResources("Storage-Classes-2", map[string]map[string]any{
+			"mysc1": {
+				"metadata":   map[string]any{"name": "storage-classes-2-mysc1-abcdefg"},
+				"parameters": map[string]any{"type": "sc1"},
+			},
+			"mygp2": {
+				"metadata":             map[string]any{"name": "storage-classes-2-mygp2-abcdefg"},
+				"parameters":           map[string]any{"type": "gp2", "encrypted": "true", "kmsKeyId": "arn:aws:kms:us-west-2:123456789012:key/abcd"},
+				"allowVolumeExpansion": true,
+				"volumeBindingMode":    "WaitForFirstConsumer",
+				"reclaimPolicy":        "Retain",
+			},
+		})...,
+	)
+	// StorageClasses that weren't created for the `storageClasses` of a cluster are ignored.
+	resources = append(resources, apitype.ResourceV3{
+		URN:     "urn:pulumi:test::storage-classes::kubernetes:storage.k8s.io/v1:StorageClass::other",
+		Type:    "kubernetes:storage.k8s.io/v1:StorageClass",
+		Outputs: map[string]any{"metadata": map[string]any{"name": "other"}},
+	})
+
+	storageClasses, err := mapClusterToStorageClasses(resources)
+	require.NoError(t, err)
+	assert.Equal(t, clusterStorageClassMap{
+		"storage-classes-1-eksCluster-1234567": {
+			{
+				Key:               "io1",
+				Name:              "storage-classes-1-io1-abcdefg",
+				Type:              "io1",
+				VolumeBindingMode: storagev1.VolumeBindingImmediate,
+				ReclaimPolicy:     corev1.PersistentVolumeReclaimDelete,
+			},
+		},
+		"Storage-Classes-2-eksCluster-1234567": {
+			{
+				Key:                  "mygp2",
+				Name:                 "storage-classes-2-mygp2-abcdefg",
+				Type:                 "gp2",
+				Encrypted:            true,
+				KmsKeyID:             "arn:aws:kms:us-west-2:123456789012:key/abcd",
+				AllowVolumeExpansion: true,
+				VolumeBindingMode:    storagev1.VolumeBindingWaitForFirstConsumer,
+				ReclaimPolicy:        corev1.PersistentVolumeReclaimRetain,
+			},
+			{
+				Key:               "mysc1",
+				Name:              "storage-classes-2-mysc1-abcdefg",
+				Type:              "sc1",
+				VolumeBindingMode: storagev1.VolumeBindingImmediate,
+				ReclaimPolicy:     corev1.PersistentVolumeReclaimDelete,
+			},
+		},
+	}, storageClasses)
+
+	filtered, err := filterStorageClasses(storageClasses, []string{"mygp2"})
+	require.NoError(t, err)
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "mygp2", filtered["Storage-Classes-2-eksCluster-1234567"][0].Key)
+
+	_, err = filterStorageClasses(storageClasses, []string{"mygp3"})
+	assert.ErrorContains(t, err, `no cluster has a storage class with key "mygp3"`)
+}
+
+func TestMapClusterToStorageClassesMalformedState(t *testing.T) {
+	t.Parallel()
+
+	resources := storageClassResources("storage-classes", map[string]map[string]any{
+		"gp2": {
+			"metadata":   map[string]any{"name": "storage-classes-gp2-abcdefg"},
+			"parameters": map[string]any{"type": "gp2", "encrypted": "yes"},
+		},
+	})
+
+	_, err := mapClusterToStorageClasses(resources)
+	var stateErr *StateError
+	require.True(t, errors.As(err, &stateErr), "expected a StateError, got %v", err)
+	assert.Equal(t, "outputs.parameters.encrypted", stateErr.Property)
+}
+
+var pvcResource = schema.GroupVersionResource{Version: "v1", Resource: "persistentvolumeclaims"}
+
+// fakeEBS simulates the EBS CSI driver for the fake clientset. It provisions volumes in the zone of volumeZone, or of
+// the node of the first consumer for WaitForFirstConsumer, and expands them if the StorageClass allows it.
+type fakeEBS struct {
+	clientset  *fake.Clientset
+	aws        *fakeAWS
+	nodeZone   string
+	volumeZone string
+	// volume is the template of the provisioned volumes.
+	volume types.Volume
+}
+
+func (f *fakeEBS) storageClass(claim *corev1.PersistentVolumeClaim) *storagev1.StorageClass {
+	obj, err := f.clientset.Tracker().Get(schema.GroupVersionResource{Group: "storage.k8s.io", Version: "v1", Resource: "storageclasses"}, "", *claim.Spec.StorageClassName)
+	if err != nil {
+		panic(err)
+	}
+	return obj.(*storagev1.StorageClass)
+}
+
+// provision creates the PersistentVolume and EBS volume of a claim and binds the claim.
+func (f *fakeEBS) provision(claim *corev1.PersistentVolumeClaim, zone string) {
+	storageClass := f.storageClass(claim)
+	size := claim.Spec.Resources.Requests[corev1.ResourceStorage]
+
+	volume := f.volume
+	volume.VolumeId = aws.String("vol-0123456789abcdef0")
+	volume.AvailabilityZone = aws.String(zone)
+	volume.Size = aws.Int32(int32(size.Value() >> 30))
+	f.aws.putVolume(volume)
+
+	reclaimPolicy := corev1.PersistentVolumeReclaimDelete
+	if storageClass.ReclaimPolicy != nil {
+		reclaimPolicy = *storageClass.ReclaimPolicy
+	}
+	if err := f.clientset.Tracker().Add(&corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pvc-1"},
+		Spec: corev1.PersistentVolumeSpec{
+			PersistentVolumeReclaimPolicy: reclaimPolicy,
+			PersistentVolumeSource: corev1.PersistentVolumeSource{
+				CSI: &corev1.CSIPersistentVolumeSource{Driver: "ebs.csi.aws.com", VolumeHandle: *volume.VolumeId},
+			},
+		},
+	}); err != nil {
+		panic(err)
+	}
+
+	claim.Spec.VolumeName = "pvc-1"
+	claim.Status.Phase = corev1.ClaimBound
+	claim.Status.Capacity = corev1.ResourceList{corev1.ResourceStorage: size}
+}
+
+func (f *fakeEBS) install() {
+	f.clientset.PrependReactor("create", "persistentvolumeclaims", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		claim := action.(k8stesting.CreateAction).GetObject().(*corev1.PersistentVolumeClaim)
+		claim.Status.Phase = corev1.ClaimPending
+		if mode := f.storageClass(claim).VolumeBindingMode; mode == nil || *mode == storagev1.VolumeBindingImmediate {
+			f.provision(claim, f.volumeZone)
+		}
+		return false, nil, nil
+	})
+	f.clientset.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+		namespace := action.GetNamespace()
+		pod.Spec.NodeName = "node-1"
+		pod.Status.Phase = corev1.PodRunning
+		pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+
+		obj, err := f.clientset.Tracker().Get(pvcResource, namespace, pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+		if err != nil {
+			return true, nil, err
+		}
+		if claim := obj.(*corev1.PersistentVolumeClaim); claim.Status.Phase != corev1.ClaimBound {
+			zone := f.volumeZone
+			if zone == "" {
+				zone = f.nodeZone
+			}
+			f.provision(claim, zone)
+			if err := f.clientset.Tracker().Update(pvcResource, claim, namespace); err != nil {
+				return true, nil, err
+			}
+		}
+		return false, nil, nil
+	})
+	f.clientset.PrependReactor("update", "persistentvolumeclaims", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		claim := action.(k8stesting.UpdateAction).GetObject().(*corev1.PersistentVolumeClaim)
+		if !ptr.Deref(f.storageClass(claim).AllowVolumeExpansion, false) {
+			return true, nil, apierrors.NewForbidden(pvcResource.GroupResource(), claim.Name, errors.New("only dynamically provisioned pvc can be resized and the storageclass that provisions the pvc must support resize"))
+		}
+
+		size := claim.Spec.Resources.Requests[corev1.ResourceStorage]
+		claim.Status.Capacity = corev1.ResourceList{corev1.ResourceStorage: size}
+		volume, _ := f.aws.volume("vol-0123456789abcdef0")
+		volume.Size = aws.Int32(int32(size.Value() >> 30))
+		f.aws.putVolume(volume)
+		return false, nil, nil
+	})
+}
+
+func TestValidateStorageClass(t *testing.T) {
+	t.Parallel()
+
+	kmsKeyID := "arn:aws:kms:us-west-2:123456789012:key/abcd"
+	gp2 := StorageClass{
+		Key:               "gp2",
+		Name:              "cluster-gp2",
+		Type:              "gp2",
+		Encrypted:         true,
+		KmsKeyID:          kmsKeyID,
+		VolumeBindingMode: storagev1.VolumeBindingImmediate,
+		ReclaimPolicy:     corev1.PersistentVolumeReclaimDelete,
+	}
+	gp2Volume := types.Volume{VolumeType: types.VolumeTypeGp2, Encrypted: aws.Bool(true), KmsKeyId: aws.String(kmsKeyID)}
+
+	expandable := gp2
+	expandable.AllowVolumeExpansion = true
+	expandable.VolumeBindingMode = storagev1.VolumeBindingWaitForFirstConsumer
+
+	retained := gp2
+	retained.ReclaimPolicy = corev1.PersistentVolumeReclaimRetain
+
+	sc1 := StorageClass{
+		Key:               "sc1",
+		Name:              "cluster-sc1",
+		Type:              "sc1",
+		VolumeBindingMode: storagev1.VolumeBindingImmediate,
+		ReclaimPolicy:     corev1.PersistentVolumeReclaimDelete,
+	}
+
+	k8sStorageClass := func(sc StorageClass) *storagev1.StorageClass {
+		return &storagev1.StorageClass{
+			ObjectMeta:           metav1.ObjectMeta{Name: sc.Name},
+			Provisioner:          "kubernetes.io/aws-ebs",
+			AllowVolumeExpansion: ptr.To(sc.AllowVolumeExpansion),
+			VolumeBindingMode:    ptr.To(sc.VolumeBindingMode),
+			ReclaimPolicy:        ptr.To(sc.ReclaimPolicy),
+		}
+	}
+
+	tests := []struct {
+		name string
+		// expected is the StorageClass as described by the stack state, actual the one in the cluster.
+		expected, actual StorageClass
+		volume           types.Volume
+		volumeZone       string
+		wantErr          string
+		wantSize         int32
+		logged           string
+	}{
+		{
+			name:     "immediate binding",
+			expected: gp2,
+			actual:   gp2,
+			volume:   gp2Volume,
+			wantSize: 1,
+			logged:   "was rejected as expected",
+		},
+		{
+			name:     "minimum size of sc1 volumes",
+			expected: sc1,
+			actual:   sc1,
+			volume:   types.Volume{VolumeType: types.VolumeTypeSc1, Encrypted: aws.Bool(false)},
+			wantSize: 125,
+		},
+		{
+			name:     "volume expansion",
+			expected: expandable,
+			actual:   expandable,
+			volume:   gp2Volume,
+			wantSize: 2,
+			logged:   "were resized to 2Gi",
+		},
+		{
+			name:       "volume in another zone than its first consumer",
+			expected:   expandable,
+			actual:     expandable,
+			volume:     gp2Volume,
+			volumeZone: "us-west-2b",
+			wantErr:    "expected volume vol-0123456789abcdef0 to be provisioned in zone us-west-2a of its first consumer on node node-1, got us-west-2b",
+		},
+		{
+			name:     "volume expansion that is not allowed by the cluster",
+			expected: expandable,
+			actual: func() StorageClass {
+				sc := expandable
+				sc.AllowVolumeExpansion = false
+				return sc
+			}(),
+			volume:  gp2Volume,
+			wantErr: "failed to resize PersistentVolumeClaim",
+		},
+		{
+			name:     "different volume binding mode",
+			expected: expandable,
+			actual:   gp2,
+			wantErr:  "expected StorageClass cluster-gp2 of cluster my-cluster to have volume binding mode WaitForFirstConsumer, got Immediate",
+		},
+		{
+			name:     "wrong volume type and key",
+			expected: gp2,
+			actual:   gp2,
+			volume:   types.Volume{VolumeType: types.VolumeTypeGp3, Encrypted: aws.Bool(true), KmsKeyId: aws.String("arn:aws:kms:us-west-2:123456789012:key/default")},
+			wantErr:  "expected volume vol-0123456789abcdef0 to have type gp2, got gp3\nexpected volume vol-0123456789abcdef0 to be encrypted with KMS key " + kmsKeyID,
+		},
+		{
+			name:     "unencrypted volume",
+			expected: gp2,
+			actual:   gp2,
+			volume:   types.Volume{VolumeType: types.VolumeTypeGp2, Encrypted: aws.Bool(false)},
+			wantErr:  "expected volume vol-0123456789abcdef0 to be encrypted: true, got false",
+		},
+		{
+			name:     "retained volume",
+			expected: retained,
+			actual:   retained,
+			volume:   gp2Volume,
+			logged:   "Deleted retained volume vol-0123456789abcdef0 of cluster my-cluster",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+			defer cancel()
+
+			node := readyNode("node-1", "i-0123456789abcdef0")
+			node.Labels = map[string]string{corev1.LabelTopologyZone: "us-west-2a"}
+			clientset, _ := newFakeClients(node, k8sStorageClass(tt.actual))
+			aws := newFakeAWS()
+			ebs := &fakeEBS{clientset: clientset, aws: aws, nodeZone: "us-west-2a", volumeZone: tt.volumeZone, volume: tt.volume}
+			ebs.install()
+
+			log := &recordingLogger{}
+			err := ValidateStorageClass(ctx, log, clientset, aws, "my-cluster", tt.expected)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.logged != "" {
+				assert.True(t, log.contains(tt.logged), "expected %q to be logged", tt.logged)
+			}
+			if tt.wantSize != 0 {
+				volume, ok := aws.volume("vol-0123456789abcdef0")
+				require.True(t, ok)
+				assert.Equal(t, tt.wantSize, *volume.Size)
+			}
+
+			namespaces, err := clientset.CoreV1().Namespaces().List(context.Background(), metav1.ListOptions{})
+			require.NoError(t, err)
+			assert.Empty(t, namespaces.Items, "the namespace of the claim should be deleted")
+
+			if tt.expected.ReclaimPolicy == corev1.PersistentVolumeReclaimRetain {
+				_, ok := aws.volume("vol-0123456789abcdef0")
+				assert.False(t, ok, "the retained volume should be deleted")
+				volumes, err := clientset.CoreV1().PersistentVolumes().List(context.Background(), metav1.ListOptions{})
+				require.NoError(t, err)
+				assert.Empty(t, volumes.Items)
+			}
+		})
+	}
+}
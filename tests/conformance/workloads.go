@@ -0,0 +1,508 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Each resource request will be fetched from the Kubernetes API Server at a
+// max of 20 retries, with 15 seconds in between each attempt.
+// This creates a max wait time of up to 5 minutes that a resource request
+// must successfully return within, before moving on.
+
+// MaxRetries is the maximum number of retries that a resource will be
+// attempted to be fetched from the Kubernetes API Server.
+const MaxRetries = 20
+
+// RetryInterval is the number of seconds to sleep in between requests
+// to the Kubernetes API Server.
+const RetryInterval = 15
+
+// assertAwsAuthConfigMapExists ensures that the EKS aws-auth ConfigMap exists and has data.
+func assertAwsAuthConfigMapExists(ctx context.Context, log Logger, clientset kubernetes.Interface, clusterName string) error {
+	configMapName, namespace := "aws-auth", "kube-system"
+
+	awsAuth, err := clientset.CoreV1().ConfigMaps(namespace).Get(ctx, configMapName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get aws-auth ConfigMap: %w", err)
+	}
+
+	if awsAuth.Data == nil {
+		return fmt.Errorf("aws-auth ConfigMap %q is nil", configMapName)
+	}
+
+	if len(awsAuth.Data) == 0 {
+		return fmt.Errorf("aws-auth ConfigMap %q is empty", configMapName)
+	}
+
+	log.Logf("%q ConfigMap exists in namespace %q of cluster %q and has data", configMapName, namespace, clusterName)
+	return nil
+}
+
+// assertAwsAuthConfigMapNotExists ensures that the EKS aws-auth ConfigMap does not exist.
+func assertAwsAuthConfigMapNotExists(ctx context.Context, log Logger, clientset kubernetes.Interface, clusterName string) error {
+	configMapName, namespace := "aws-auth", "kube-system"
+
+	_, err := clientset.CoreV1().ConfigMaps(namespace).Get(ctx, configMapName, metav1.GetOptions{})
+	if err == nil {
+		return fmt.Errorf("%q ConfigMap still exists in namespace %q", configMapName, namespace)
+	}
+
+	log.Logf("Verified that the %q ConfigMap does not exist in namespace %q of cluster %q", configMapName, namespace, clusterName)
+	return nil
+}
+
+// WaitForDaemonSet waits until all pods of a daemonset are ready and returns the daemonset.
+// It gives up after MaxRetries attempts, with RetryInterval seconds in between each attempt.
+func WaitForDaemonSet(ctx context.Context, log Logger, clientset kubernetes.Interface, namespace, name string) (*appsv1.DaemonSet, error) {
+	for i := 0; i < MaxRetries; i++ {
+		ds, ready := IsDaemonSetReady(ctx, clientset, namespace, name)
+		if ready {
+			return ds, nil
+		}
+
+		log.Logf("Waiting for Daemonset %s/%s to be ready. Retrying...\n", namespace, name)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(RetryInterval * time.Second):
+		}
+	}
+
+	return nil, fmt.Errorf("daemonset wasn't ready in time")
+}
+
+// IsDaemonSetReady returns the daemonset and whether all of its scheduled pods are ready.
+func IsDaemonSetReady(ctx context.Context, clientset kubernetes.Interface, namespace, name string) (*appsv1.DaemonSet, bool) {
+	// Attempt to retrieve Deployment.
+	o, err := clientset.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, false
+	}
+
+	return o, o.Status.DesiredNumberScheduled == o.Status.NumberReady
+}
+
+// ReadinessPredicate decides whether a workload is ready. It returns an error describing why the workload isn't
+// ready, or nil if it is.
+type ReadinessPredicate[T any] func(workload T) error
+
+// Workload is a Kubernetes workload whose readiness is validated by ValidateClusters. Workloads are created with
+// DeploymentWorkload, DaemonSetWorkload, StatefulSetWorkload and JobWorkload.
+type Workload struct {
+	// Kind is the lower-case kind of the workload, e.g. "deployment".
+	Kind     string
+	Resource KubernetesResource
+	// check looks up the workload and validates its readiness. It returns the label selector of the workload's
+	// pods if the workload exists.
+	check func(ctx context.Context, clientset kubernetes.Interface) (string, error)
+}
+
+func (w Workload) String() string {
+	return w.Kind + " " + w.Resource.String()
+}
+
+func newWorkload[T any](
+	kind, namespace, name string,
+	get func(ctx context.Context, clientset kubernetes.Interface, namespace, name string) (T, error),
+	selector func(T) *metav1.LabelSelector,
+	predicates []ReadinessPredicate[T],
+) Workload {
+	resource := KubernetesResource{Namespace: namespace, Name: name}
+	return Workload{
+		Kind:     kind,
+		Resource: resource,
+		check: func(ctx context.Context, clientset kubernetes.Interface) (string, error) {
+			workload, err := get(ctx, clientset, namespace, name)
+			if err != nil {
+				return "", fmt.Errorf("failed to get %s %s: %v", kind, resource, err)
+			}
+
+			labelSelector := metav1.FormatLabelSelector(selector(workload))
+			for _, ready := range predicates {
+				if err := ready(workload); err != nil {
+					return labelSelector, fmt.Errorf("%s %s is not healthy: %w", kind, resource, err)
+				}
+			}
+			return labelSelector, nil
+		},
+	}
+}
+
+// DeploymentWorkload returns a Deployment whose readiness is decided by the given predicates. Defaults to
+// DeploymentReady if no predicates are given.
+func DeploymentWorkload(namespace, name string, ready ...ReadinessPredicate[*appsv1.Deployment]) Workload {
+	if len(ready) == 0 {
+		ready = []ReadinessPredicate[*appsv1.Deployment]{DeploymentReady}
+	}
+	return newWorkload("deployment", namespace, name,
+		func(ctx context.Context, clientset kubernetes.Interface, namespace, name string) (*appsv1.Deployment, error) {
+			return clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		func(d *appsv1.Deployment) *metav1.LabelSelector { return d.Spec.Selector },
+		ready)
+}
+
+// DaemonSetWorkload returns a DaemonSet whose readiness is decided by the given predicates. Defaults to
+// DaemonSetReady if no predicates are given.
+func DaemonSetWorkload(namespace, name string, ready ...ReadinessPredicate[*appsv1.DaemonSet]) Workload {
+	if len(ready) == 0 {
+		ready = []ReadinessPredicate[*appsv1.DaemonSet]{DaemonSetReady}
+	}
+	return newWorkload("daemonset", namespace, name,
+		func(ctx context.Context, clientset kubernetes.Interface, namespace, name string) (*appsv1.DaemonSet, error) {
+			return clientset.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		func(ds *appsv1.DaemonSet) *metav1.LabelSelector { return ds.Spec.Selector },
+		ready)
+}
+
+// StatefulSetWorkload returns a StatefulSet whose readiness is decided by the given predicates. Defaults to
+// StatefulSetReady if no predicates are given.
+func StatefulSetWorkload(namespace, name string, ready ...ReadinessPredicate[*appsv1.StatefulSet]) Workload {
+	if len(ready) == 0 {
+		ready = []ReadinessPredicate[*appsv1.StatefulSet]{StatefulSetReady}
+	}
+	return newWorkload("statefulset", namespace, name,
+		func(ctx context.Context, clientset kubernetes.Interface, namespace, name string) (*appsv1.StatefulSet, error) {
+			return clientset.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		func(sts *appsv1.StatefulSet) *metav1.LabelSelector { return sts.Spec.Selector },
+		ready)
+}
+
+// JobWorkload returns a Job whose readiness is decided by the given predicates. Defaults to JobComplete if no
+// predicates are given.
+func JobWorkload(namespace, name string, ready ...ReadinessPredicate[*batchv1.Job]) Workload {
+	if len(ready) == 0 {
+		ready = []ReadinessPredicate[*batchv1.Job]{JobComplete}
+	}
+	return newWorkload("job", namespace, name,
+		func(ctx context.Context, clientset kubernetes.Interface, namespace, name string) (*batchv1.Job, error) {
+			return clientset.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		func(job *batchv1.Job) *metav1.LabelSelector { return job.Spec.Selector },
+		ready)
+}
+
+// DeploymentReady is ready if all replicas of the deployment are ready, up-to-date and available.
+func DeploymentReady(deployment *appsv1.Deployment) error {
+	if deployment.Status.ReadyReplicas != deployment.Status.Replicas {
+		return fmt.Errorf("%d/%d replicas are ready", deployment.Status.ReadyReplicas, deployment.Status.Replicas)
+	}
+
+	if deployment.Status.UpdatedReplicas != deployment.Status.Replicas {
+		return fmt.Errorf("%d/%d replicas are up-to-date", deployment.Status.UpdatedReplicas, deployment.Status.Replicas)
+	}
+
+	if deployment.Status.AvailableReplicas != deployment.Status.Replicas {
+		return fmt.Errorf("%d/%d replicas are available", deployment.Status.AvailableReplicas, deployment.Status.Replicas)
+	}
+
+	return nil
+}
+
+// DaemonSetReady is ready if all scheduled pods of the daemonset are ready, up-to-date and available.
+func DaemonSetReady(daemonset *appsv1.DaemonSet) error {
+	if daemonset.Status.NumberReady != daemonset.Status.DesiredNumberScheduled {
+		return fmt.Errorf("%d/%d pods are ready", daemonset.Status.NumberReady, daemonset.Status.DesiredNumberScheduled)
+	}
+
+	if daemonset.Status.UpdatedNumberScheduled != daemonset.Status.DesiredNumberScheduled {
+		return fmt.Errorf("%d/%d pods are up-to-date", daemonset.Status.UpdatedNumberScheduled, daemonset.Status.DesiredNumberScheduled)
+	}
+
+	if daemonset.Status.NumberAvailable != daemonset.Status.DesiredNumberScheduled {
+		return fmt.Errorf("%d/%d pods are available", daemonset.Status.NumberAvailable, daemonset.Status.DesiredNumberScheduled)
+	}
+
+	return nil
+}
+
+// StatefulSetReady is ready if the desired number of replicas of the statefulset are ready, up-to-date and available.
+func StatefulSetReady(statefulset *appsv1.StatefulSet) error {
+	// The number of replicas defaults to 1.
+	replicas := int32(1)
+	if statefulset.Spec.Replicas != nil {
+		replicas = *statefulset.Spec.Replicas
+	}
+
+	if statefulset.Status.ReadyReplicas != replicas {
+		return fmt.Errorf("%d/%d replicas are ready", statefulset.Status.ReadyReplicas, replicas)
+	}
+
+	if statefulset.Status.UpdatedReplicas != replicas {
+		return fmt.Errorf("%d/%d replicas are up-to-date", statefulset.Status.UpdatedReplicas, replicas)
+	}
+
+	if statefulset.Status.AvailableReplicas != replicas {
+		return fmt.Errorf("%d/%d replicas are available", statefulset.Status.AvailableReplicas, replicas)
+	}
+
+	return nil
+}
+
+// JobComplete is ready if the job has completed successfully.
+func JobComplete(job *batchv1.Job) error {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return nil
+		case batchv1.JobFailed:
+			return fmt.Errorf("job failed: %s %s", condition.Reason, condition.Message)
+		}
+	}
+
+	return fmt.Errorf("job has not completed: %d active, %d succeeded, %d failed pods", job.Status.Active, job.Status.Succeeded, job.Status.Failed)
+}
+
+// ValidateDeploymentHealth checks if a Kubernetes deployment is healthy by verifying its status.
+// It returns an error if the deployment is not found or not healthy.
+func ValidateDeploymentHealth(ctx context.Context, clientset kubernetes.Interface, namespace, deploymentName string) error {
+	_, err := DeploymentWorkload(namespace, deploymentName).check(ctx, clientset)
+	return err
+}
+
+// ValidateDaemonSetHealth checks if a Kubernetes daemonset is healthy by verifying its status.
+// It returns an error if the daemonset is not found or not healthy.
+func ValidateDaemonSetHealth(ctx context.Context, clientset kubernetes.Interface, namespace, daemonsetName string) error {
+	_, err := DaemonSetWorkload(namespace, daemonsetName).check(ctx, clientset)
+	return err
+}
+
+// WorkloadResult reports the readiness of a workload.
+type WorkloadResult struct {
+	// Kind is the lower-case kind of the workload, e.g. "deployment".
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Ready     bool   `json:"ready"`
+	// UnhealthyPods describes the pods of the workload if it isn't ready.
+	UnhealthyPods []PodResult `json:"unhealthyPods,omitempty"`
+}
+
+// String returns the name of the check of the workload, e.g. "deployment kube-system/coredns".
+func (w WorkloadResult) String() string {
+	return w.Kind + " " + KubernetesResource{Namespace: w.Namespace, Name: w.Name}.String()
+}
+
+// PodResult describes the status of a pod of an unhealthy workload.
+type PodResult struct {
+	Namespace  string               `json:"namespace"`
+	Name       string               `json:"name"`
+	Phase      string               `json:"phase"`
+	HostIP     string               `json:"hostIP,omitempty"`
+	PodIP      string               `json:"podIP,omitempty"`
+	Containers []ContainerResult    `json:"containers,omitempty"`
+	Conditions []PodConditionResult `json:"conditions,omitempty"`
+	// Events are the Kubernetes events that were recorded for the pod.
+	Events []EventResult `json:"events,omitempty"`
+}
+
+// ContainerResult describes the status of a container of a pod.
+type ContainerResult struct {
+	Name         string `json:"name"`
+	Ready        bool   `json:"ready"`
+	RestartCount int32  `json:"restartCount"`
+	// State is "waiting", "running" or "terminated".
+	State    string `json:"state,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+	Message  string `json:"message,omitempty"`
+	ExitCode int32  `json:"exitCode,omitempty"`
+}
+
+// PodConditionResult describes a condition of a pod.
+type PodConditionResult struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// EventResult describes a Kubernetes event of a pod.
+type EventResult struct {
+	Type    string `json:"type"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+	Count   int32  `json:"count"`
+}
+
+// describe formats the status of the pod for humans.
+func (p PodResult) describe(clusterName string) string {
+	var logLines []string
+	logLines = append(logLines, fmt.Sprintf("Pod %s/%s of cluster %s:", p.Namespace, p.Name, clusterName))
+	logLines = append(logLines, fmt.Sprintf("  Phase: %s", p.Phase))
+	logLines = append(logLines, fmt.Sprintf("  Host IP: %s", p.HostIP))
+	logLines = append(logLines, fmt.Sprintf("  Pod IP: %s", p.PodIP))
+
+	for _, container := range p.Containers {
+		logLines = append(logLines, fmt.Sprintf("  Container %s:", container.Name))
+		logLines = append(logLines, fmt.Sprintf("    Ready: %v", container.Ready))
+		logLines = append(logLines, fmt.Sprintf("    RestartCount: %d", container.RestartCount))
+
+		switch container.State {
+		case "waiting":
+			logLines = append(logLines, fmt.Sprintf("    Waiting - Reason: %s", container.Reason))
+			logLines = append(logLines, fmt.Sprintf("    Waiting - Message: %s", container.Message))
+		case "terminated":
+			logLines = append(logLines, fmt.Sprintf("    Terminated - Reason: %s", container.Reason))
+			logLines = append(logLines, fmt.Sprintf("    Terminated - Message: %s", container.Message))
+			logLines = append(logLines, fmt.Sprintf("    Terminated - Exit Code: %d", container.ExitCode))
+		}
+	}
+
+	logLines = append(logLines, "  Conditions:")
+	for _, condition := range p.Conditions {
+		logLines = append(logLines, fmt.Sprintf("    %s: %s (Reason: %s, Message: %s)",
+			condition.Type,
+			condition.Status,
+			condition.Reason,
+			condition.Message))
+	}
+
+	if len(p.Events) > 0 {
+		logLines = append(logLines, "  Events:")
+		for _, event := range p.Events {
+			logLines = append(logLines, fmt.Sprintf("    %s %s (x%d): %s", event.Type, event.Reason, event.Count, event.Message))
+		}
+	}
+
+	return strings.Join(logLines, "\n")
+}
+
+// logUnhealthyPodInfo logs debug information about pods belonging to a controller and returns it.
+func logUnhealthyPodInfo(ctx context.Context, log Logger, clientset kubernetes.Interface, namespace string, labelSelector string, clusterName string) ([]PodResult, error) {
+	// List pods matching the provided label selector
+	pods, err := clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labelSelector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods of cluster %s: %v", clusterName, err)
+	}
+
+	podResults := make([]PodResult, 0, len(pods.Items))
+	for _, pod := range pods.Items {
+		podResult := PodResult{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			Phase:     string(pod.Status.Phase),
+			HostIP:    pod.Status.HostIP,
+			PodIP:     pod.Status.PodIP,
+		}
+
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			container := ContainerResult{
+				Name:         containerStatus.Name,
+				Ready:        containerStatus.Ready,
+				RestartCount: containerStatus.RestartCount,
+			}
+			switch {
+			case containerStatus.State.Waiting != nil:
+				container.State = "waiting"
+				container.Reason = containerStatus.State.Waiting.Reason
+				container.Message = containerStatus.State.Waiting.Message
+			case containerStatus.State.Terminated != nil:
+				container.State = "terminated"
+				container.Reason = containerStatus.State.Terminated.Reason
+				container.Message = containerStatus.State.Terminated.Message
+				container.ExitCode = containerStatus.State.Terminated.ExitCode
+			case containerStatus.State.Running != nil:
+				container.State = "running"
+			}
+			podResult.Containers = append(podResult.Containers, container)
+		}
+
+		for _, condition := range pod.Status.Conditions {
+			podResult.Conditions = append(podResult.Conditions, PodConditionResult{
+				Type:    string(condition.Type),
+				Status:  string(condition.Status),
+				Reason:  condition.Reason,
+				Message: condition.Message,
+			})
+		}
+
+		events, err := clientset.CoreV1().Events(pod.Namespace).List(ctx, metav1.ListOptions{
+			FieldSelector: fields.AndSelectors(
+				fields.OneTermEqualSelector("involvedObject.kind", "Pod"),
+				fields.OneTermEqualSelector("involvedObject.name", pod.Name),
+			).String(),
+		})
+		if err != nil {
+			log.Logf("Failed to list events of pod %s/%s of cluster %s: %v", pod.Namespace, pod.Name, clusterName, err)
+		} else {
+			for _, event := range events.Items {
+				if event.InvolvedObject.Kind != "Pod" || event.InvolvedObject.Name != pod.Name {
+					continue
+				}
+				podResult.Events = append(podResult.Events, EventResult{
+					Type:    event.Type,
+					Reason:  event.Reason,
+					Message: event.Message,
+					Count:   event.Count,
+				})
+			}
+		}
+
+		log.Logf("%s", podResult.describe(clusterName))
+		podResults = append(podResults, podResult)
+	}
+
+	return podResults, nil
+}
+
+// RetryWithExponentialBackoff retries a function with exponential backoff until it succeeds or context is cancelled.
+// It takes:
+// - ctx: context for cancellation
+// - initialDelay: initial delay duration between retries (will be doubled each retry)
+// - operation: the function to retry that returns (error)
+// Returns the last error encountered if context is cancelled
+func RetryWithExponentialBackoff(ctx context.Context, initialDelay time.Duration, operation func() error) error {
+	var err error
+	currentDelay := initialDelay
+
+	for {
+		select {
+		case <-ctx.Done():
+			return err
+		default:
+			if err = operation(); err == nil {
+				return nil
+			}
+
+			timer := time.NewTimer(currentDelay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+				currentDelay *= 2
+			}
+		}
+	}
+}
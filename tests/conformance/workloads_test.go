@@ -0,0 +1,258 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func healthyStatefulSet(namespace, name string, replicas *int32) *appsv1.StatefulSet {
+	ready := int32(1)
+	if replicas != nil {
+		ready = *replicas
+	}
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}},
+		},
+		Status: appsv1.StatefulSetStatus{
+			Replicas:          ready,
+			ReadyReplicas:     ready,
+			UpdatedReplicas:   ready,
+			AvailableReplicas: ready,
+		},
+	}
+}
+
+func job(namespace, name string, conditions ...batchv1.JobCondition) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: batchv1.JobSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"job-name": name}},
+		},
+		Status: batchv1.JobStatus{Conditions: conditions},
+	}
+}
+
+func TestWorkloadReadiness(t *testing.T) {
+	t.Parallel()
+
+	unavailableDeployment := healthyDeployment("default", "web")
+	unavailableDeployment.Status.AvailableReplicas = 1
+
+	outdatedDaemonSet := healthyDaemonSet("kube-system", "aws-node")
+	outdatedDaemonSet.Status.UpdatedNumberScheduled = 0
+
+	unreadyStatefulSet := healthyStatefulSet("default", "db", ptr.To[int32](3))
+	unreadyStatefulSet.Status.ReadyReplicas = 2
+
+	atLeastThreeReplicas := func(deployment *appsv1.Deployment) error {
+		if deployment.Status.ReadyReplicas < 3 {
+			return fmt.Errorf("only %d replicas are ready", deployment.Status.ReadyReplicas)
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name          string
+		workload      Workload
+		objects       []runtime.Object
+		labelSelector string
+		wantErr       string
+	}{
+		{
+			name:          "healthy deployment",
+			workload:      DeploymentWorkload("default", "web"),
+			objects:       []runtime.Object{healthyDeployment("default", "web")},
+			labelSelector: "app=web",
+		},
+		{
+			name:          "deployment with unavailable replicas",
+			workload:      DeploymentWorkload("default", "web"),
+			objects:       []runtime.Object{unavailableDeployment},
+			labelSelector: "app=web",
+			wantErr:       "deployment default/web is not healthy: 1/2 replicas are available",
+		},
+		{
+			name:          "deployment with custom readiness predicates",
+			workload:      DeploymentWorkload("default", "web", DeploymentReady, atLeastThreeReplicas),
+			objects:       []runtime.Object{healthyDeployment("default", "web")},
+			labelSelector: "app=web",
+			wantErr:       "deployment default/web is not healthy: only 2 replicas are ready",
+		},
+		{
+			name:     "missing deployment",
+			workload: DeploymentWorkload("default", "web"),
+			wantErr:  "failed to get deployment default/web",
+		},
+		{
+			name:          "daemonset with outdated pods",
+			workload:      DaemonSetWorkload("kube-system", "aws-node"),
+			objects:       []runtime.Object{outdatedDaemonSet},
+			labelSelector: "app=aws-node",
+			wantErr:       "daemonset kube-system/aws-node is not healthy: 0/1 pods are up-to-date",
+		},
+		{
+			name:          "statefulset with default replicas",
+			workload:      StatefulSetWorkload("default", "db"),
+			objects:       []runtime.Object{healthyStatefulSet("default", "db", nil)},
+			labelSelector: "app=db",
+		},
+		{
+			name:          "statefulset with unready replicas",
+			workload:      StatefulSetWorkload("default", "db"),
+			objects:       []runtime.Object{unreadyStatefulSet},
+			labelSelector: "app=db",
+			wantErr:       "statefulset default/db is not healthy: 2/3 replicas are ready",
+		},
+		{
+			name:     "completed job",
+			workload: JobWorkload("default", "migrate"),
+			objects: []runtime.Object{job("default", "migrate", batchv1.JobCondition{
+				Type:   batchv1.JobComplete,
+				Status: corev1.ConditionTrue,
+			})},
+			labelSelector: "job-name=migrate",
+		},
+		{
+			name:          "running job",
+			workload:      JobWorkload("default", "migrate"),
+			objects:       []runtime.Object{job("default", "migrate")},
+			labelSelector: "job-name=migrate",
+			wantErr:       "job default/migrate is not healthy: job has not completed",
+		},
+		{
+			name:     "failed job",
+			workload: JobWorkload("default", "migrate"),
+			objects: []runtime.Object{job("default", "migrate", batchv1.JobCondition{
+				Type:    batchv1.JobFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "BackoffLimitExceeded",
+				Message: "Job has reached the specified backoff limit",
+			})},
+			labelSelector: "job-name=migrate",
+			wantErr:       "job default/migrate is not healthy: job failed: BackoffLimitExceeded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			clientset, _ := newFakeClients(tt.objects...)
+			labelSelector, err := tt.workload.check(context.Background(), clientset)
+			assert.Equal(t, tt.labelSelector, labelSelector)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWorkloadOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		options   []Option
+		workloads []string
+	}{
+		{
+			name: "defaults",
+			workloads: []string{
+				"deployment kube-system/coredns",
+				"daemonset kube-system/aws-node",
+				"daemonset kube-system/kube-proxy",
+			},
+		},
+		{
+			name: "additional workloads",
+			options: []Option{
+				ValidateWorkloads(StatefulSetWorkload("default", "db"), JobWorkload("default", "migrate")),
+			},
+			workloads: []string{
+				"deployment kube-system/coredns",
+				"daemonset kube-system/aws-node",
+				"daemonset kube-system/kube-proxy",
+				"statefulset default/db",
+				"job default/migrate",
+			},
+		},
+		{
+			name: "replaced workloads",
+			options: []Option{
+				ValidateWorkloads(StatefulSetWorkload("default", "db")),
+				ReplaceWorkloads(DeploymentWorkload("kube-system", "coredns"), DeploymentWorkload("nginx", "nginx")),
+			},
+			workloads: []string{
+				"deployment kube-system/coredns",
+				"deployment nginx/nginx",
+			},
+		},
+		{
+			name: "replaced deployments",
+			options: []Option{
+				ValidateDeployments(KubernetesResource{Namespace: "nginx", Name: "nginx"}),
+			},
+			workloads: []string{
+				"daemonset kube-system/aws-node",
+				"daemonset kube-system/kube-proxy",
+				"deployment nginx/nginx",
+			},
+		},
+		{
+			name: "no daemonsets",
+			options: []Option{
+				ValidateDaemonSets(),
+			},
+			workloads: []string{
+				"deployment kube-system/coredns",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := DefaultOptions()
+			for _, option := range tt.options {
+				option.Apply(opts)
+			}
+
+			var workloads []string
+			for _, workload := range opts.workloads {
+				workloads = append(workloads, workload.String())
+			}
+			assert.Equal(t, tt.workloads, workloads)
+		})
+	}
+}
@@ -113,7 +113,7 @@ func TestAccNodeGroup(t *testing.T) {
 			RunUpdateTest: false,
 			Dir:           path.Join(getExamples(t), "nodegroup"),
 			ExtraRuntimeValidation: func(t *testing.T, info integration.RuntimeValidationStackInfo) {
-				utils.ValidateClusters(t, info.Deployment.Resources, utils.WithKubeConfigs(info.Outputs["kubeconfig1"], info.Outputs["kubeconfig2"]))
+				utils.ValidateClusters(t, info.Deployment.Resources, utils.WithKubeConfigs(info.Outputs["kubeconfig1"], info.Outputs["kubeconfig2"]), utils.ProbeConnectivity())
 			},
 			EditDirs: []integration.EditDir{
 				{
@@ -134,7 +134,7 @@ func TestAccManagedNodeGroup(t *testing.T) {
 			RunUpdateTest: false,
 			Dir:           path.Join(getExamples(t), "managed-nodegroups"),
 			ExtraRuntimeValidation: func(t *testing.T, info integration.RuntimeValidationStackInfo) {
-				utils.ValidateClusters(t, info.Deployment.Resources, utils.WithKubeConfigs(info.Outputs["kubeconfig"]))
+				utils.ValidateClusters(t, info.Deployment.Resources, utils.WithKubeConfigs(info.Outputs["kubeconfig"]), utils.ProbeConnectivity())
 			},
 		})
 
@@ -227,7 +227,11 @@ func TestAccStorageClasses(t *testing.T) {
 		With(integration.ProgramTestOptions{
 			Dir: path.Join(getExamples(t), "storage-classes"),
 			ExtraRuntimeValidation: func(t *testing.T, info integration.RuntimeValidationStackInfo) {
-				utils.ValidateClusters(t, info.Deployment.Resources, utils.WithKubeConfigs(info.Outputs["kubeconfig1"], info.Outputs["kubeconfig2"]))
+				// Only the second cluster has the EBS CSI driver that provisions the volumes of its storage classes.
+				utils.ValidateClusters(t, info.Deployment.Resources,
+					utils.WithKubeConfigs(info.Outputs["kubeconfig1"], info.Outputs["kubeconfig2"]),
+					utils.ValidateStorageClasses("mygp2", "mysc1"),
+				)
 			},
 		})
 
@@ -240,6 +244,16 @@ func TestAccOidcIam(t *testing.T) {
 			Dir: path.Join(getExamples(t), "oidc-iam-sa"),
 			ExtraRuntimeValidation: func(t *testing.T, info integration.RuntimeValidationStackInfo) {
 				utils.ValidateClusters(t, info.Deployment.Resources, utils.WithKubeConfigs(info.Outputs["kubeconfig"]))
+
+				// The trust policy of the role allows the identity service account to assume it.
+				utils.ValidateWorkloadIdentity(t, createEksClient(t), info.Outputs["kubeconfig"], utils.WorkloadIdentityValidation{
+					ClusterName:        info.Outputs["clusterName"].(string),
+					Mode:               utils.IdentityModeIRSA,
+					RoleArn:            info.Outputs["saRoleArn"].(string),
+					OIDCProviderArn:    info.Outputs["oidcProviderArn"].(string),
+					Namespace:          info.Outputs["appsNamespaceName"].(string),
+					ServiceAccountName: "identity",
+				})
 			},
 		})
 
@@ -321,7 +335,7 @@ func TestAccExtraSecurityGroups(t *testing.T) {
 		With(integration.ProgramTestOptions{
 			Dir: path.Join(getExamples(t), "extra-sg"),
 			ExtraRuntimeValidation: func(t *testing.T, info integration.RuntimeValidationStackInfo) {
-				utils.ValidateClusters(t, info.Deployment.Resources, utils.WithKubeConfigs(info.Outputs["kubeconfig"]))
+				utils.ValidateClusters(t, info.Deployment.Resources, utils.WithKubeConfigs(info.Outputs["kubeconfig"]), utils.ProbeConnectivity())
 			},
 		})
 
@@ -507,12 +521,31 @@ func TestAccAuthenticationMode(t *testing.T) {
 		With(integration.ProgramTestOptions{
 			Dir: path.Join(getExamples(t), "authentication-mode"),
 			ExtraRuntimeValidation: func(t *testing.T, info integration.RuntimeValidationStackInfo) {
+				// The role is mapped to test-group, which isn't bound to any RBAC roles. Its access entries grant it
+				// the view policy in the default namespace, and take precedence over aws-auth.
+				roleArn := info.Outputs["iamRoleArn"].(string)
+				viewDefault := []utils.AccessRule{
+					{Namespace: "default", Resource: "pods", Verbs: []string{"get", "list", "watch"}},
+					{Namespace: "kube-system", Resource: "pods"},
+				}
+
 				// Verify that the clusters with all three authentication modes are working.
-				utils.ValidateClusters(t, info.Deployment.Resources, utils.WithKubeConfigs(
-					info.Outputs["kubeconfigConfigMap"],
-					info.Outputs["kubeconfigBoth"],
-					info.Outputs["kubeconfigApi"],
-				))
+				utils.ValidateClusters(t, info.Deployment.Resources,
+					utils.WithKubeConfigs(
+						info.Outputs["kubeconfigConfigMap"],
+						info.Outputs["kubeconfigBoth"],
+						info.Outputs["kubeconfigApi"],
+					),
+					utils.ValidateAccess(
+						utils.PrincipalAccess{
+							PrincipalArn: roleArn,
+							Kubeconfig:   info.Outputs["roleKubeconfigConfigMap"],
+							Rules:        []utils.AccessRule{{Namespace: "default", Resource: "pods"}},
+						},
+						utils.PrincipalAccess{PrincipalArn: roleArn, Kubeconfig: info.Outputs["roleKubeconfigBoth"], Rules: viewDefault},
+						utils.PrincipalAccess{PrincipalArn: roleArn, Kubeconfig: info.Outputs["roleKubeconfigApi"], Rules: viewDefault},
+					),
+				)
 			},
 		})
 
@@ -995,10 +1028,10 @@ func TestAccEksAutoMode(t *testing.T) {
 			ExtraRuntimeValidation: func(t *testing.T, info integration.RuntimeValidationStackInfo) {
 				utils.ValidateClusters(t, info.Deployment.Resources,
 					utils.WithKubeConfigs(info.Outputs["kubeconfig"]),
-					utils.ValidateDaemonSets(), // no daemonsets in auto mode
-					utils.ValidateDeployments(
-						utils.KubernetesResource{Name: "nginx", Namespace: "nginx"},
-						utils.KubernetesResource{Name: "coredns", Namespace: "kube-system"},
+					// no aws-node and kube-proxy daemonsets in auto mode
+					utils.ReplaceWorkloads(
+						utils.DeploymentWorkload("nginx", "nginx"),
+						utils.DeploymentWorkload("kube-system", "coredns"),
 					),
 				)
 
@@ -1059,10 +1092,10 @@ func TestAccEksAutoModeUpgrade(t *testing.T) {
 					ExtraRuntimeValidation: func(t *testing.T, info integration.RuntimeValidationStackInfo) {
 						utils.ValidateClusters(t, info.Deployment.Resources,
 							utils.WithKubeConfigs(info.Outputs["kubeconfig"]),
-							utils.ValidateDaemonSets(), // no daemonsets in auto mode
-							utils.ValidateDeployments(
-								utils.KubernetesResource{Name: "nginx", Namespace: "nginx"},
-								utils.KubernetesResource{Name: "coredns", Namespace: "kube-system"},
+							// no aws-node and kube-proxy daemonsets in auto mode
+							utils.ReplaceWorkloads(
+								utils.DeploymentWorkload("nginx", "nginx"),
+								utils.DeploymentWorkload("kube-system", "coredns"),
 							),
 						)
 					},
@@ -1129,10 +1162,9 @@ func TestAccAutoModeCustomRole(t *testing.T) {
 			ExtraRuntimeValidation: func(t *testing.T, info integration.RuntimeValidationStackInfo) {
 				utils.ValidateClusters(t, info.Deployment.Resources,
 					utils.WithKubeConfigs(info.Outputs["kubeconfig"]),
-					utils.ValidateDaemonSets(), // no daemonsets in auto mode
-					utils.ValidateDeployments(
-						utils.KubernetesResource{Name: "nginx", Namespace: "nginx"},
-						utils.KubernetesResource{Name: "coredns", Namespace: "kube-system"},
+					// no aws-node and kube-proxy daemonsets in auto mode
+					utils.ReplaceWorkloads(
+						utils.DeploymentWorkload("kube-system", "coredns"),
 					),
 				)
 			},
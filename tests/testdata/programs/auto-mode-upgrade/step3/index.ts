@@ -40,6 +40,7 @@ const ns = new k8s.core.v1.Namespace(appName, {
 
 const deployment = new k8s.apps.v1.Deployment(appName, {
     metadata: {
+        name: appName,
         namespace: ns.metadata.name
     },
     spec: {